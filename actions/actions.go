@@ -2,28 +2,58 @@ package actions
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
 	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/specifications"
 	"github.com/tempcke/rpm/usecase"
+	"github.com/tempcke/schedule"
 )
 
 var _ specifications.Driver = (*Actions)(nil)
 
 type (
 	Actions struct {
-		propRepo   usecase.PropertyRepo
-		tenantRepo usecase.TenantRepo
+		propRepo    usecase.PropertyRepo
+		tenantRepo  usecase.TenantRepo
+		leaseRepo   usecase.LeaseRepo
+		ledgerRepo  usecase.LedgerRepo
+		lateFeeRepo usecase.LateFeeRepo
+		depositRepo usecase.DepositRepo
+		appRepo     usecase.ApplicationRepo
+		listingRepo usecase.ListingRepo
+		unitRepo    usecase.UnitRepo
+		outboxRepo  usecase.OutboxRepo
+		webhookRepo usecase.WebhookRepo
+		auditRepo   usecase.AuditRepo
+		clock       clockwork.Clock
+		events      event.Publisher
 	}
 	Repo interface {
 		usecase.PropertyRepo
 		usecase.TenantRepo
+		usecase.LeaseRepo
+		usecase.LedgerRepo
+		usecase.LateFeeRepo
+		usecase.DepositRepo
+		usecase.ApplicationRepo
+		usecase.ListingRepo
+		usecase.UnitRepo
+		usecase.OutboxRepo
+		usecase.WebhookRepo
+		usecase.AuditRepo
 	}
 )
 
-func NewActions() Actions               { return Actions{} }
-func NewActionsWithRepo(r Repo) Actions { return Actions{propRepo: r, tenantRepo: r} }
+func NewActions() Actions { return Actions{} }
+func NewActionsWithRepo(r Repo) Actions {
+	return Actions{propRepo: r, tenantRepo: r, leaseRepo: r, ledgerRepo: r, lateFeeRepo: r, depositRepo: r, appRepo: r, listingRepo: r, unitRepo: r, outboxRepo: r, webhookRepo: r, auditRepo: r}
+}
 func (a Actions) WithPropertyRepo(r usecase.PropertyRepo) Actions {
 	a.propRepo = r
 	return a
@@ -33,6 +63,60 @@ func (a Actions) WithTenantRepo(r usecase.TenantRepo) Actions {
 	return a
 }
 
+func (a Actions) WithLeaseRepo(r usecase.LeaseRepo) Actions {
+	a.leaseRepo = r
+	return a
+}
+func (a Actions) WithLedgerRepo(r usecase.LedgerRepo) Actions {
+	a.ledgerRepo = r
+	return a
+}
+func (a Actions) WithLateFeeRepo(r usecase.LateFeeRepo) Actions {
+	a.lateFeeRepo = r
+	return a
+}
+func (a Actions) WithDepositRepo(r usecase.DepositRepo) Actions {
+	a.depositRepo = r
+	return a
+}
+func (a Actions) WithApplicationRepo(r usecase.ApplicationRepo) Actions {
+	a.appRepo = r
+	return a
+}
+func (a Actions) WithListingRepo(r usecase.ListingRepo) Actions {
+	a.listingRepo = r
+	return a
+}
+func (a Actions) WithUnitRepo(r usecase.UnitRepo) Actions {
+	a.unitRepo = r
+	return a
+}
+func (a Actions) WithOutboxRepo(r usecase.OutboxRepo) Actions {
+	a.outboxRepo = r
+	return a
+}
+func (a Actions) WithWebhookRepo(r usecase.WebhookRepo) Actions {
+	a.webhookRepo = r
+	return a
+}
+func (a Actions) WithAuditRepo(r usecase.AuditRepo) Actions {
+	a.auditRepo = r
+	return a
+}
+
+// WithClock decides what today is for actions which depend on the date
+func (a Actions) WithClock(c clockwork.Clock) Actions {
+	a.clock = c
+	return a
+}
+
+// WithEventPublisher to publish to after properties, tenants, leases and
+// listings are stored
+func (a Actions) WithEventPublisher(p event.Publisher) Actions {
+	a.events = p
+	return a
+}
+
 func (a Actions) StoreProperty(ctx context.Context, p entity.Property) (entity.ID, error) {
 	if p.ID == "" {
 		p.ID = uuid.NewString()
@@ -45,6 +129,18 @@ func (a Actions) StoreProperty(ctx context.Context, p entity.Property) (entity.I
 func (a Actions) RemoveProperty(ctx context.Context, id string) error {
 	return a.propertyMan().Remove(ctx, id)
 }
+func (a Actions) RestoreProperty(ctx context.Context, id string) (*entity.Property, error) {
+	p, err := a.propertyMan().Restore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// PurgeProperties removed longer ago than the retention
+func (a Actions) PurgeProperties(ctx context.Context, retention time.Duration) (int, error) {
+	return a.propertyMan().Purge(ctx, retention)
+}
 func (a Actions) ListProperties(ctx context.Context, f usecase.PropertyFilter) ([]entity.Property, error) {
 	list, err := a.propertyMan().List(ctx, f)
 	if err != nil {
@@ -63,7 +159,26 @@ func (a Actions) GetProperty(ctx context.Context, id string) (*entity.Property,
 	return &p, nil
 }
 func (a Actions) propertyMan() usecase.PropertyManager {
-	return usecase.NewPropertyManager(a.propRepo)
+	return usecase.NewPropertyManager(a.propRepo).WithLeases(a.leaseRepo).WithClock(a.clock).WithPublisher(a.events)
+}
+
+func (a Actions) StoreUnit(ctx context.Context, u entity.Unit) (*entity.Unit, error) {
+	if u.ID == "" {
+		u.ID = uuid.NewString()
+	}
+	return a.unitMan().Store(ctx, u)
+}
+func (a Actions) GetUnit(ctx context.Context, propertyID, id entity.ID) (*entity.Unit, error) {
+	return a.unitMan().Get(ctx, propertyID, id)
+}
+func (a Actions) ListUnits(ctx context.Context, propertyID entity.ID) ([]entity.Unit, error) {
+	return a.unitMan().List(ctx, propertyID)
+}
+func (a Actions) RemoveUnit(ctx context.Context, propertyID, id entity.ID) error {
+	return a.unitMan().Remove(ctx, propertyID, id)
+}
+func (a Actions) unitMan() usecase.UnitManager {
+	return usecase.NewUnitManager(a.unitRepo).WithLeases(a.leaseRepo).WithClock(a.clock)
 }
 
 func (a Actions) StoreTenant(ctx context.Context, e entity.Tenant) (*entity.Tenant, error) {
@@ -75,9 +190,252 @@ func (a Actions) StoreTenant(ctx context.Context, e entity.Tenant) (*entity.Tena
 func (a Actions) GetTenant(ctx context.Context, id entity.ID) (*entity.Tenant, error) {
 	return a.tenantMan().Get(ctx, id)
 }
-func (a Actions) ListTenants(ctx context.Context) ([]entity.Tenant, error) {
-	return a.tenantMan().List(ctx)
+func (a Actions) ListTenants(ctx context.Context, f ...filters.TenantFilter) ([]entity.Tenant, error) {
+	return a.tenantMan().List(ctx, f...)
+}
+func (a Actions) PatchTenant(ctx context.Context, id entity.ID, patch entity.TenantPatch) (*entity.Tenant, error) {
+	return a.tenantMan().Patch(ctx, id, patch)
+}
+func (a Actions) AddTenantPhone(ctx context.Context, id entity.ID, phone entity.Phone) (*entity.Tenant, error) {
+	return a.tenantMan().AddPhone(ctx, id, phone)
+}
+func (a Actions) UpdateTenantPhone(ctx context.Context, id entity.ID, number string, phone entity.Phone) (*entity.Tenant, error) {
+	return a.tenantMan().UpdatePhone(ctx, id, number, phone)
+}
+func (a Actions) RemoveTenantPhone(ctx context.Context, id entity.ID, number string) error {
+	_, err := a.tenantMan().RemovePhone(ctx, id, number)
+	return err
+}
+func (a Actions) RemoveTenant(ctx context.Context, id entity.ID) error {
+	return a.tenantMan().Remove(ctx, id)
+}
+func (a Actions) RestoreTenant(ctx context.Context, id entity.ID) (*entity.Tenant, error) {
+	return a.tenantMan().Restore(ctx, id)
+}
+
+// PurgeTenants removed longer ago than the retention
+func (a Actions) PurgeTenants(ctx context.Context, retention time.Duration) (int, error) {
+	return a.tenantMan().Purge(ctx, retention)
 }
 func (a Actions) tenantMan() usecase.TenantManager {
-	return usecase.NewTenantManager(a.tenantRepo)
+	return usecase.NewTenantManager(a.tenantRepo).WithLeases(a.leaseRepo).WithClock(a.clock).WithPublisher(a.events)
+}
+
+func (a Actions) LeaseProperty(ctx context.Context, e entity.Lease) (*entity.Lease, error) {
+	if e.ID == "" {
+		e.ID = uuid.NewString()
+	}
+	return a.leaseMan().Store(ctx, e)
+}
+func (a Actions) GetLease(ctx context.Context, id entity.ID) (*entity.Lease, error) {
+	return a.leaseMan().Get(ctx, id)
+}
+func (a Actions) ListLeases(ctx context.Context, f ...filters.LeaseFilter) ([]entity.Lease, error) {
+	return a.leaseMan().List(ctx, f...)
+}
+func (a Actions) TerminateLease(ctx context.Context, id entity.ID, endDate schedule.Date, reason string) (*entity.Lease, error) {
+	return a.leaseMan().Terminate(ctx, id, endDate, reason)
+}
+func (a Actions) RenewLease(ctx context.Context, id entity.ID, r entity.LeaseRenewal) (*entity.Lease, error) {
+	if r.LeaseID == "" {
+		r.LeaseID = uuid.NewString()
+	}
+	return a.leaseMan().Renew(ctx, id, r)
+}
+func (a Actions) AmendLease(ctx context.Context, id entity.ID, am entity.LeaseAmendment) (*entity.Lease, error) {
+	return a.leaseMan().Amend(ctx, id, am)
+}
+func (a Actions) GetLeaseHistory(ctx context.Context, id entity.ID) (*entity.LeaseHistory, error) {
+	return a.leaseMan().History(ctx, id)
+}
+func (a Actions) GetRentSchedule(ctx context.Context, id entity.ID, opts entity.ScheduleOptions) ([]entity.RentDue, error) {
+	return a.leaseMan().RentSchedule(ctx, id, opts)
+}
+func (a Actions) leaseMan() usecase.LeaseManager {
+	return usecase.NewLeaseManager(a.leaseRepo).WithUnits(a.unitRepo).WithParties(a.propRepo, a.tenantRepo).WithPublisher(a.events)
+}
+
+func (a Actions) PostLedgerEntry(ctx context.Context, e entity.LedgerEntry) (*entity.LedgerEntry, error) {
+	if e.ID == "" {
+		e.ID = uuid.NewString()
+	}
+	return a.ledgerMan().Post(ctx, e)
+}
+func (a Actions) ReverseLedgerEntry(ctx context.Context, leaseID, entryID entity.ID, date schedule.Date, memo string) (*entity.LedgerEntry, error) {
+	return a.ledgerMan().Reverse(ctx, leaseID, entryID, date, memo)
+}
+func (a Actions) GetBalance(ctx context.Context, leaseID entity.ID, asOf schedule.Date) (entity.Money, error) {
+	return a.ledgerMan().Balance(ctx, leaseID, asOf)
+}
+func (a Actions) GetStatement(ctx context.Context, leaseID entity.ID, from, until schedule.Date) (*entity.Statement, error) {
+	return a.ledgerMan().Statement(ctx, leaseID, from, until)
+}
+func (a Actions) ledgerMan() usecase.LedgerManager {
+	return usecase.NewLedgerManager(a.ledgerRepo)
+}
+
+func (a Actions) StoreLateFeePolicy(ctx context.Context, p entity.LateFeePolicy) (*entity.LateFeePolicy, error) {
+	if p.ID == "" {
+		p.ID = uuid.NewString()
+	}
+	return a.lateFeeMan().StorePolicy(ctx, p)
+}
+func (a Actions) GetLateFeePolicy(ctx context.Context, leaseID entity.ID) (*entity.LateFeePolicy, error) {
+	return a.lateFeeMan().Policy(ctx, leaseID)
+}
+func (a Actions) AssessLateFees(ctx context.Context, leaseID entity.ID, asOf schedule.Date) ([]entity.LateFee, error) {
+	return a.lateFeeMan().Assess(ctx, leaseID, asOf)
+}
+func (a Actions) ApplyLateFees(ctx context.Context, leaseID entity.ID, asOf schedule.Date) ([]entity.LedgerEntry, error) {
+	return a.lateFeeMan().Apply(ctx, leaseID, asOf)
+}
+func (a Actions) lateFeeMan() usecase.LateFeeManager {
+	return usecase.NewLateFeeManager(a.lateFeeRepo).WithClock(a.clock)
+}
+
+func (a Actions) RecordDepositReceipt(ctx context.Context, r entity.DepositReceipt) (*entity.DepositReceipt, error) {
+	if r.ID == "" {
+		r.ID = uuid.NewString()
+	}
+	return a.depositMan().Receive(ctx, r)
+}
+func (a Actions) GetDeposit(ctx context.Context, leaseID entity.ID) (*entity.DepositAccount, error) {
+	return a.depositMan().Account(ctx, leaseID)
+}
+func (a Actions) DisposeDeposit(ctx context.Context, d entity.DepositDisposition) (*entity.DepositDisposition, error) {
+	if d.ID == "" {
+		d.ID = uuid.NewString()
+	}
+	return a.depositMan().Dispose(ctx, d)
+}
+func (a Actions) GetDepositStatement(ctx context.Context, leaseID entity.ID) (string, error) {
+	return a.depositMan().Statement(ctx, leaseID)
+}
+func (a Actions) depositMan() usecase.DepositManager {
+	return usecase.NewDepositManager(a.depositRepo)
+}
+
+func (a Actions) SubmitApplication(ctx context.Context, app entity.RentalApplication) (*entity.RentalApplication, error) {
+	if app.ID == "" {
+		app.ID = uuid.NewString()
+	}
+	return a.appMan().Submit(ctx, app)
+}
+func (a Actions) GetApplication(ctx context.Context, id entity.ID) (*entity.RentalApplication, error) {
+	return a.appMan().Get(ctx, id)
+}
+func (a Actions) ListApplications(ctx context.Context, f filters.ApplicationFilter) ([]entity.RentalApplication, error) {
+	return a.appMan().List(ctx, f)
+}
+func (a Actions) UpdateApplicationStatus(ctx context.Context, id entity.ID, status entity.ApplicationStatus, note string) (*entity.RentalApplication, error) {
+	return a.appMan().Transition(ctx, id, status, note)
+}
+func (a Actions) ConvertApplication(ctx context.Context, id entity.ID) (*usecase.ApplicationConversion, error) {
+	return a.appMan().Convert(ctx, id)
+}
+func (a Actions) appMan() usecase.ApplicationManager {
+	return usecase.NewApplicationManager(a.appRepo).WithPublisher(a.events)
+}
+
+func (a Actions) StoreScreeningPolicy(ctx context.Context, p entity.ScreeningPolicy) (*entity.ScreeningPolicy, error) {
+	if p.ID == "" {
+		p.ID = uuid.NewString()
+	}
+	return a.screeningMan().StorePolicy(ctx, p)
+}
+func (a Actions) GetScreeningPolicy(ctx context.Context, propertyID entity.ID) (*entity.ScreeningPolicy, error) {
+	return a.screeningMan().Policy(ctx, propertyID)
+}
+func (a Actions) ScreenApplication(ctx context.Context, applicationID entity.ID) (*entity.ScreeningReport, error) {
+	return a.screeningMan().Screen(ctx, applicationID)
+}
+func (a Actions) ListScreeningReports(ctx context.Context, f filters.ScreeningReportFilter) ([]entity.ScreeningReport, error) {
+	return a.screeningMan().Reports(ctx, f)
+}
+func (a Actions) screeningMan() usecase.ScreeningManager {
+	return usecase.NewScreeningManager(a.appRepo)
+}
+
+func (a Actions) StoreListing(ctx context.Context, l entity.Listing) (*entity.Listing, error) {
+	if l.ID == "" {
+		l.ID = uuid.NewString()
+	}
+	return a.listingMan().Store(ctx, l)
+}
+func (a Actions) GetListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	return a.listingMan().Get(ctx, propertyID)
+}
+func (a Actions) PublishListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	return a.listingMan().Publish(ctx, propertyID)
+}
+func (a Actions) UnpublishListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	return a.listingMan().Unpublish(ctx, propertyID)
+}
+func (a Actions) ListPublicListings(ctx context.Context, f filters.ListingFilter) ([]usecase.PublicListing, error) {
+	return a.listingMan().Public(ctx, f)
+}
+func (a Actions) listingMan() usecase.ListingManager {
+	return usecase.NewListingManager(a.listingRepo).WithClock(a.clock).WithPublisher(a.events)
+}
+
+func (a Actions) ListOutbox(ctx context.Context, f filters.OutboxFilter) ([]event.Message, error) {
+	return a.outboxMan().List(ctx, f)
+}
+func (a Actions) GetOutboxMessage(ctx context.Context, id string) (*event.Message, error) {
+	return a.outboxMan().Get(ctx, id)
+}
+func (a Actions) ReplayOutboxMessage(ctx context.Context, id string) (*event.Message, error) {
+	return a.outboxMan().Replay(ctx, id)
+}
+
+// RelayOutbox delivers the pending events which are due to the subscribers
+func (a Actions) RelayOutbox(ctx context.Context, d event.Deliverer) (int, error) {
+	return a.outboxMan().Relay(ctx, d)
+}
+func (a Actions) outboxMan() usecase.OutboxManager {
+	return usecase.NewOutboxManager(a.outboxRepo).WithClock(a.clock)
+}
+
+func (a Actions) StoreWebhook(ctx context.Context, w entity.Webhook) (*entity.Webhook, error) {
+	if w.ID == "" {
+		w.ID = uuid.NewString()
+	}
+	return a.webhookMan().Store(ctx, w)
+}
+func (a Actions) GetWebhook(ctx context.Context, id entity.ID) (*entity.Webhook, error) {
+	return a.webhookMan().Get(ctx, id)
+}
+func (a Actions) ListWebhooks(ctx context.Context) ([]entity.Webhook, error) {
+	return a.webhookMan().List(ctx)
+}
+func (a Actions) RemoveWebhook(ctx context.Context, id entity.ID) error {
+	return a.webhookMan().Remove(ctx, id)
+}
+func (a Actions) ListWebhookDeliveries(ctx context.Context, f filters.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	return a.webhookMan().ListDeliveries(ctx, f)
+}
+func (a Actions) GetWebhookDelivery(ctx context.Context, id entity.ID) (*entity.WebhookDelivery, error) {
+	return a.webhookMan().GetDelivery(ctx, id)
+}
+func (a Actions) RedeliverWebhook(ctx context.Context, deliveryID entity.ID) (*entity.WebhookDelivery, error) {
+	return a.webhookMan().Redeliver(ctx, deliveryID)
+}
+
+// EnqueueWebhooks is an event.Handler recording a delivery of the event for
+// every webhook subscribed to it
+func (a Actions) EnqueueWebhooks(ctx context.Context, e event.Event) error {
+	return a.webhookMan().Enqueue(ctx, e)
+}
+
+// DeliverWebhooks sends the pending deliveries which are due
+func (a Actions) DeliverWebhooks(ctx context.Context) (int, error) {
+	return a.webhookMan().Deliver(ctx)
+}
+func (a Actions) webhookMan() usecase.WebhookManager {
+	return usecase.NewWebhookManager(a.webhookRepo).WithClock(a.clock)
+}
+
+// ListAudit entries of the changes matching the filter, oldest first
+func (a Actions) ListAudit(ctx context.Context, f filters.AuditFilter) ([]audit.Entry, error) {
+	return usecase.NewAuditManager(a.auditRepo).List(ctx, f)
 }
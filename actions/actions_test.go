@@ -13,5 +13,5 @@ func TestActions_specifications(t *testing.T) {
 		repo   = repository.NewInMemoryRepo()
 		driver = actions.NewActionsWithRepo(repo)
 	)
-	specifications.RunAllTests(t, driver, driver)
+	specifications.RunAllTests(t, driver)
 }
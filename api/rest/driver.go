@@ -9,13 +9,19 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/tempcke/path"
 	"github.com/tempcke/rpm/api/rest/openapi"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/internal/test"
 	"github.com/tempcke/rpm/usecase"
+	"github.com/tempcke/schedule"
 )
 
 type (
@@ -24,125 +30,1148 @@ type (
 		BaseURL string
 		Client  httpClient
 		Logger  *slog.Logger
+
+		// APIKey and APISecret are sent with every request
+		// when empty the API_KEY and API_SECRET of the test config are sent
+		APIKey    string
+		APISecret string
+	}
+	httpClient interface { // *http.Client
+		Do(req *http.Request) (*http.Response, error)
+	}
+)
+
+func (d Driver) StoreProperty(ctx context.Context, p entity.Property) (string, error) {
+	body := openapi.NewStorePropertyReq(p)
+	url := d.BaseURL + "/property"
+	req := postReq(url, body, d.headers())
+	if p.ID != "" {
+		url = d.BaseURL + "/property/" + p.GetID()
+		req = putReq(url, body, d.headers())
+	}
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	var created openapi.StorePropertyRes
+	if err := d.decodeResponse(res, &created); err != nil {
+		return "", err
+	}
+	return created.Property.GetID(), nil
+}
+func (d Driver) GetProperty(ctx context.Context, id ID) (*entity.Property, error) {
+	url := d.BaseURL + "/property/" + id
+	req := getReq(url, d.headers()).WithContext(ctx)
+	res, err := d.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if code := res.StatusCode; code >= 400 {
+		return nil, fmt.Errorf("expected 200 response, got %d", code)
+	}
+	var p openapi.GetPropertyRes
+	if err := json.NewDecoder(res.Body).Decode(&p); err != nil {
+		return nil, err
+	}
+	property := p.Property.ToProperty()
+	return &property, nil
+}
+func (d Driver) ListProperties(ctx context.Context, f usecase.PropertyFilter) ([]entity.Property, error) {
+	var (
+		route = "/property"
+		args  = sMap{"search": f.Search}
+	)
+	if f.IncludeDeleted {
+		args["includeDeleted"] = strconv.FormatBool(f.IncludeDeleted)
+	}
+	var properties = make([]entity.Property, 0)
+	for {
+		var (
+			p    = d.path(route).WithQueryArgs(args.withPage(f.Page))
+			req  = getReq(p.String(), d.headers()).WithContext(ctx)
+			list openapi.ListPropertiesRes
+		)
+		res, err := d.Client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.decodeResponse(res, &list); err != nil {
+			return nil, err
+		}
+		properties = append(properties, list.ToProperties()...)
+		// without a page size every page is read so the whole list is returned
+		if f.Page.Size > 0 || list.NextCursor == nil {
+			return properties, nil
+		}
+		f.Page.Cursor = *list.NextCursor
+	}
+}
+func (d Driver) RemoveProperty(ctx context.Context, id ID) error {
+	url := d.BaseURL + "/property/" + id
+	req := delReq(url, d.headers()).WithContext(ctx)
+	res, err := d.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	if code := res.StatusCode; code >= 400 {
+		return fmt.Errorf("expected 204 response, got %d", code)
+	}
+	return nil
+}
+func (d Driver) RestoreProperty(ctx context.Context, id ID) (*entity.Property, error) {
+	url := d.BaseURL + "/property/" + id + "/restore"
+	req := postReq(url, nil, d.headers()).WithContext(ctx)
+	res, err := d.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if code := res.StatusCode; code != http.StatusOK {
+		return nil, fmt.Errorf("expected 200 response, got %d", code)
+	}
+	var p openapi.GetPropertyRes
+	if err := json.NewDecoder(res.Body).Decode(&p); err != nil {
+		return nil, err
+	}
+	property := p.Property.ToProperty()
+	return &property, nil
+}
+
+func (d Driver) StoreUnit(ctx context.Context, u entity.Unit) (*entity.Unit, error) {
+	body := openapi.NewStoreUnitReq(u)
+	route := "/property/" + u.PropertyID + "/unit"
+	req := postReq(d.url(route), body, d.headers())
+	if u.ID != "" {
+		route += "/" + u.ID
+		req = putReq(d.url(route), body, d.headers())
+	}
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.unitRes(res)
+}
+func (d Driver) GetUnit(ctx context.Context, propertyID, id entity.ID) (*entity.Unit, error) {
+	var (
+		route = "/property/" + propertyID + "/unit/" + id
+		req   = getReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.unitRes(res)
+}
+func (d Driver) ListUnits(ctx context.Context, propertyID entity.ID) ([]entity.Unit, error) {
+	var (
+		route = "/property/" + propertyID + "/unit"
+		req   = getReq(d.url(route), d.headers())
+		list  openapi.UnitList
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.decodeResponse(res, &list); err != nil {
+		return nil, err
+	}
+	return list.ToUnits(), nil
+}
+func (d Driver) RemoveUnit(ctx context.Context, propertyID, id entity.ID) error {
+	var (
+		route = "/property/" + propertyID + "/unit/" + id
+		req   = delReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("expected 204 response, got %d", res.StatusCode)
+	}
+	return nil
+}
+func (d Driver) unitRes(r *http.Response) (*entity.Unit, error) {
+	var res openapi.UnitRes
+	if err := d.decodeResponse(r, &res); err != nil {
+		return nil, err
+	}
+	return res.Unit.ToUnit(), nil
+}
+
+func (d Driver) StoreTenant(ctx context.Context, tenant entity.Tenant) (*entity.Tenant, error) {
+	body := openapi.NewStoreTenantReq(tenant)
+	route := "/tenant"
+	req := postReq(d.url(route), body, d.headers())
+	if tenant.ID != "" {
+		route = "/tenant/" + tenant.ID
+		req = putReq(d.url(route), body, d.headers())
+	}
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.getTenantRes(res)
+}
+func (d Driver) GetTenant(ctx context.Context, id entity.ID) (*entity.Tenant, error) {
+	var (
+		route = "/tenant/" + id
+		req   = getReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.getTenantRes(res)
+}
+func (d Driver) ListTenants(ctx context.Context, filter ...filters.TenantFilter) ([]entity.Tenant, error) {
+	var (
+		route = "/tenant"
+		f     = filters.MergeTenantFilters(filter...)
+		args  = sMap{
+			"name":              f.Name,
+			"phone":             f.Phone,
+			"dlState":           f.DLState,
+			"leasingPropertyID": f.LeasingPropertyID,
+		}
+		tenants []entity.Tenant
+	)
+	if !f.DOBFrom.IsZero() {
+		args["dobFrom"] = f.DOBFrom.String()
+	}
+	if !f.DOBUntil.IsZero() {
+		args["dobUntil"] = f.DOBUntil.String()
+	}
+	if f.IncludeDeleted {
+		args["includeDeleted"] = strconv.FormatBool(f.IncludeDeleted)
+	}
+	for {
+		var list openapi.TenantList
+		p := d.path(route).WithQueryArgs(args.withPage(f.Page))
+		if len(f.IDs) > 0 {
+			p = p.WithQuery("id", f.IDs...)
+		}
+		req := getReq(p.String(), d.headers())
+		res, err := d.Client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if err := d.decodeResponse(res, &list); err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, list.ToTenants()...)
+		// without a page size every page is read so the whole list is returned
+		if f.Page.Size > 0 || list.NextCursor == nil {
+			return tenants, nil
+		}
+		f.Page.Cursor = *list.NextCursor
+	}
+}
+func (d Driver) PatchTenant(ctx context.Context, id entity.ID, patch entity.TenantPatch) (*entity.Tenant, error) {
+	var (
+		route = "/tenant/" + id
+		req   = patchReq(d.url(route), openapi.NewTenantMergePatch(patch), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.getTenantRes(res)
+}
+func (d Driver) RemoveTenant(ctx context.Context, id entity.ID) error {
+	var (
+		route = "/tenant/" + id
+		req   = delReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	if code := res.StatusCode; code != http.StatusNoContent {
+		return fmt.Errorf("expected 204 response, got %d", code)
+	}
+	return nil
+}
+func (d Driver) RestoreTenant(ctx context.Context, id entity.ID) (*entity.Tenant, error) {
+	var (
+		route = "/tenant/" + id + "/restore"
+		req   = postReq(d.url(route), nil, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.getTenantRes(res)
+}
+func (d Driver) AddTenantPhone(ctx context.Context, id entity.ID, phone entity.Phone) (*entity.Tenant, error) {
+	var (
+		route = "/tenant/" + id + "/phone"
+		req   = postReq(d.url(route), openapi.ToPhone(phone), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.getTenantRes(res)
+}
+func (d Driver) UpdateTenantPhone(ctx context.Context, id entity.ID, number string, phone entity.Phone) (*entity.Tenant, error) {
+	var (
+		route = "/tenant/" + id + "/phone/" + url.PathEscape(number)
+		req   = putReq(d.url(route), openapi.ToPhone(phone), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.getTenantRes(res)
+}
+func (d Driver) RemoveTenantPhone(ctx context.Context, id entity.ID, number string) error {
+	var (
+		route = "/tenant/" + id + "/phone/" + url.PathEscape(number)
+		req   = delReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	if code := res.StatusCode; code != http.StatusNoContent {
+		return fmt.Errorf("expected 204 response, got %d", code)
+	}
+	return nil
+}
+func (d Driver) getTenantRes(r *http.Response) (*entity.Tenant, error) {
+	var res openapi.GetTenantRes
+	if err := d.decodeResponse(r, &res); err != nil {
+		return nil, err
+	}
+	return res.Tenant.ToTenant(), nil
+}
+
+func (d Driver) LeaseProperty(ctx context.Context, lease entity.Lease) (*entity.Lease, error) {
+	var (
+		route = "/lease"
+		body  = openapi.NewLeasePropertyReq(lease)
+		req   = postReq(d.url(route), body, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.getLeaseRes(res)
+}
+func (d Driver) GetLease(ctx context.Context, id entity.ID) (*entity.Lease, error) {
+	var (
+		route = "/lease/" + id
+		req   = getReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.getLeaseRes(res)
+}
+func (d Driver) ListLeases(ctx context.Context, filter ...filters.LeaseFilter) ([]entity.Lease, error) {
+	var (
+		route  = "/lease"
+		f      = filters.MergeLeaseFilters(filter...)
+		args   = sMap{"propertyID": f.PropertyID, "unitID": f.UnitID}
+		leases []entity.Lease
+	)
+	for {
+		var list openapi.LeaseList
+		req := getReq(d.path(route).WithQueryArgs(args.withPage(f.Page)).String(), d.headers())
+		res, err := d.Client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if err := d.decodeResponse(res, &list); err != nil {
+			return nil, err
+		}
+		leases = append(leases, list.ToLeases()...)
+		// without a page size every page is read so the whole list is returned
+		if f.Page.Size > 0 || list.NextCursor == nil {
+			return leases, nil
+		}
+		f.Page.Cursor = *list.NextCursor
+	}
+}
+func (d Driver) TerminateLease(ctx context.Context, id entity.ID, endDate schedule.Date, reason string) (*entity.Lease, error) {
+	var (
+		route = "/lease/" + id + "/terminate"
+		body  = openapi.NewTerminateLeaseReq(endDate, reason)
+		req   = postReq(d.url(route), body, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.getLeaseRes(res)
+}
+func (d Driver) RenewLease(ctx context.Context, id entity.ID, r entity.LeaseRenewal) (*entity.Lease, error) {
+	var (
+		route = "/lease/" + id + "/renew"
+		body  = openapi.NewRenewLeaseReq(r)
+		req   = postReq(d.url(route), body, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.getLeaseRes(res)
+}
+func (d Driver) AmendLease(ctx context.Context, id entity.ID, a entity.LeaseAmendment) (*entity.Lease, error) {
+	var (
+		route = "/lease/" + id + "/amend"
+		body  = openapi.NewAmendLeaseReq(a)
+		req   = postReq(d.url(route), body, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.getLeaseRes(res)
+}
+
+// GetLeaseHistory is part of the GetLease response
+func (d Driver) GetLeaseHistory(ctx context.Context, id entity.ID) (*entity.LeaseHistory, error) {
+	var (
+		route = "/lease/" + id
+		req   = getReq(d.url(route), d.headers())
+		data  openapi.GetLeaseRes
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.decodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+	return data.ToLeaseHistory(), nil
+}
+func (d Driver) GetRentSchedule(ctx context.Context, id entity.ID, opts entity.ScheduleOptions) ([]entity.RentDue, error) {
+	var (
+		route  = "/lease/" + id + "/schedule"
+		params = openapi.NewGetRentScheduleParams(opts)
+		args   = make(sMap)
+		list   openapi.RentSchedule
+	)
+	if params.DueDay != nil {
+		args["dueDay"] = strconv.Itoa(*params.DueDay)
+	}
+	if params.Until != nil {
+		args["until"] = params.Until.String()
+	}
+	req := getReq(d.path(route).WithQueryArgs(args).String(), d.headers())
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.decodeResponse(res, &list); err != nil {
+		return nil, err
+	}
+	return list.ToRentDues(), nil
+}
+func (d Driver) getLeaseRes(r *http.Response) (*entity.Lease, error) {
+	var res openapi.GetLeaseRes
+	if err := d.decodeResponse(r, &res); err != nil {
+		return nil, err
+	}
+	return res.Lease.ToLease(), nil
+}
+func (d Driver) PostLedgerEntry(ctx context.Context, e entity.LedgerEntry) (*entity.LedgerEntry, error) {
+	var (
+		route = "/lease/" + e.LeaseID + "/ledger"
+		body  = openapi.NewPostLedgerEntryReq(e)
+		req   = postReq(d.url(route), body, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.ledgerEntryRes(res)
+}
+func (d Driver) ReverseLedgerEntry(ctx context.Context, leaseID, entryID entity.ID, date schedule.Date, memo string) (*entity.LedgerEntry, error) {
+	var (
+		route = "/lease/" + leaseID + "/ledger/" + entryID + "/reverse"
+		body  = openapi.NewReverseLedgerEntryReq(date, memo)
+		req   = postReq(d.url(route), body, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.ledgerEntryRes(res)
+}
+func (d Driver) GetBalance(ctx context.Context, leaseID entity.ID, asOf schedule.Date) (entity.Money, error) {
+	var (
+		route   = "/lease/" + leaseID + "/balance"
+		args    = make(sMap)
+		balance openapi.Balance
+	)
+	if !asOf.IsZero() {
+		args["asOf"] = asOf.String()
+	}
+	req := getReq(d.path(route).WithQueryArgs(args).String(), d.headers())
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return entity.Money{}, err
+	}
+	if err := d.decodeResponse(res, &balance); err != nil {
+		return entity.Money{}, err
+	}
+	return balance.Balance.ToMoney(), nil
+}
+func (d Driver) GetStatement(ctx context.Context, leaseID entity.ID, from, until schedule.Date) (*entity.Statement, error) {
+	var (
+		route     = "/lease/" + leaseID + "/ledger"
+		args      = make(sMap)
+		statement openapi.Statement
+	)
+	if !from.IsZero() {
+		args["from"] = from.String()
+	}
+	if !until.IsZero() {
+		args["until"] = until.String()
+	}
+	req := getReq(d.path(route).WithQueryArgs(args).String(), d.headers())
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.decodeResponse(res, &statement); err != nil {
+		return nil, err
+	}
+	return statement.ToStatement(), nil
+}
+func (d Driver) ledgerEntryRes(r *http.Response) (*entity.LedgerEntry, error) {
+	var res openapi.LedgerEntryRes
+	if err := d.decodeResponse(r, &res); err != nil {
+		return nil, err
+	}
+	return res.Entry.ToLedgerEntry(), nil
+}
+func (d Driver) StoreLateFeePolicy(ctx context.Context, p entity.LateFeePolicy) (*entity.LateFeePolicy, error) {
+	var (
+		route = "/property/" + p.PropertyID + "/latefee/policy"
+		body  = openapi.NewStoreLateFeePolicyReq(p)
+	)
+	if p.LeaseID != "" {
+		route = "/lease/" + p.LeaseID + "/latefee/policy"
+	}
+	res, err := d.Client.Do(putReq(d.url(route), body, d.headers()).WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.lateFeePolicyRes(res)
+}
+func (d Driver) GetLateFeePolicy(ctx context.Context, leaseID entity.ID) (*entity.LateFeePolicy, error) {
+	var (
+		route = "/lease/" + leaseID + "/latefee/policy"
+		req   = getReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.lateFeePolicyRes(res)
+}
+func (d Driver) AssessLateFees(ctx context.Context, leaseID entity.ID, asOf schedule.Date) ([]entity.LateFee, error) {
+	var (
+		route = "/lease/" + leaseID + "/latefee"
+		args  = make(sMap)
+		list  openapi.LateFeeList
+	)
+	if !asOf.IsZero() {
+		args["asOf"] = asOf.String()
+	}
+	req := getReq(d.path(route).WithQueryArgs(args).String(), d.headers())
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.decodeResponse(res, &list); err != nil {
+		return nil, err
+	}
+	return list.ToLateFees(), nil
+}
+func (d Driver) ApplyLateFees(ctx context.Context, leaseID entity.ID, asOf schedule.Date) ([]entity.LedgerEntry, error) {
+	var (
+		route = "/lease/" + leaseID + "/latefee"
+		body  = openapi.NewApplyLateFeesReq(asOf)
+		req   = postReq(d.url(route), body, d.headers())
+		list  openapi.LedgerEntryList
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.decodeResponse(res, &list); err != nil {
+		return nil, err
+	}
+	return list.ToLedgerEntries(), nil
+}
+func (d Driver) lateFeePolicyRes(r *http.Response) (*entity.LateFeePolicy, error) {
+	var res openapi.LateFeePolicyRes
+	if err := d.decodeResponse(r, &res); err != nil {
+		return nil, err
+	}
+	return res.Policy.ToLateFeePolicy(), nil
+}
+func (d Driver) RecordDepositReceipt(ctx context.Context, r entity.DepositReceipt) (*entity.DepositReceipt, error) {
+	var (
+		route = "/lease/" + r.LeaseID + "/deposit/receipt"
+		body  = openapi.NewRecordDepositReceiptReq(r)
+		req   = postReq(d.url(route), body, d.headers())
+		out   openapi.DepositReceiptRes
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.decodeResponse(res, &out); err != nil {
+		return nil, err
+	}
+	return out.Receipt.ToDepositReceipt(), nil
+}
+func (d Driver) GetDeposit(ctx context.Context, leaseID entity.ID) (*entity.DepositAccount, error) {
+	var (
+		route   = "/lease/" + leaseID + "/deposit"
+		req     = getReq(d.url(route), d.headers())
+		account openapi.DepositAccount
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.decodeResponse(res, &account); err != nil {
+		return nil, err
+	}
+	return account.ToDepositAccount(), nil
+}
+func (d Driver) DisposeDeposit(ctx context.Context, disposition entity.DepositDisposition) (*entity.DepositDisposition, error) {
+	var (
+		route = "/lease/" + disposition.LeaseID + "/deposit/disposition"
+		body  = openapi.NewDisposeDepositReq(disposition)
+		req   = postReq(d.url(route), body, d.headers())
+		out   openapi.DepositDispositionRes
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.decodeResponse(res, &out); err != nil {
+		return nil, err
+	}
+	return out.Disposition.ToDepositDisposition(), nil
+}
+func (d Driver) GetDepositStatement(ctx context.Context, leaseID entity.ID) (string, error) {
+	var (
+		route = "/lease/" + leaseID + "/deposit/statement"
+		req   = getReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
 	}
-	httpClient interface { // *http.Client
-		Do(req *http.Request) (*http.Response, error)
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode >= 400 {
+		return "", d.decodeResponse(res, nil)
 	}
-)
+	text, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(text), nil
+}
 
-func (d Driver) StoreProperty(ctx context.Context, p entity.Property) (string, error) {
-	body := openapi.NewStorePropertyReq(p)
-	url := d.BaseURL + "/property"
-	req := postReq(url, body, d.headers())
-	if p.ID != "" {
-		url = d.BaseURL + "/property/" + p.GetID()
-		req = putReq(url, body, d.headers())
+func (d Driver) SubmitApplication(ctx context.Context, app entity.RentalApplication) (*entity.RentalApplication, error) {
+	var (
+		route = "/application"
+		body  = openapi.NewSubmitApplicationReq(app)
+		req   = postReq(d.url(route), body, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
 	}
+	return d.getApplicationRes(res)
+}
+func (d Driver) GetApplication(ctx context.Context, id entity.ID) (*entity.RentalApplication, error) {
+	var (
+		route = "/application/" + id
+		req   = getReq(d.url(route), d.headers())
+	)
 	res, err := d.Client.Do(req.WithContext(ctx))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	var created openapi.StorePropertyRes
-	if err := json.NewDecoder(res.Body).Decode(&created); err != nil {
-		return "", err
+	return d.getApplicationRes(res)
+}
+func (d Driver) ListApplications(ctx context.Context, f filters.ApplicationFilter) ([]entity.RentalApplication, error) {
+	var (
+		route  = "/application"
+		params = openapi.NewListApplicationsParams(f)
+		args   = make(sMap)
+		apps   []entity.RentalApplication
+	)
+	if params.PropertyID != nil {
+		args["propertyID"] = *params.PropertyID
+	}
+	if params.Status != nil {
+		args["status"] = *params.Status
+	}
+	for {
+		var list openapi.ApplicationList
+		req := getReq(d.path(route).WithQueryArgs(args.withPage(f.Page)).String(), d.headers())
+		res, err := d.Client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if err := d.decodeResponse(res, &list); err != nil {
+			return nil, err
+		}
+		apps = append(apps, list.ToRentalApplications()...)
+		// without a page size every page is read so the whole list is returned
+		if f.Page.Size > 0 || list.NextCursor == nil {
+			return apps, nil
+		}
+		f.Page.Cursor = *list.NextCursor
 	}
-	return created.Property.GetID(), nil
 }
-func (d Driver) GetProperty(ctx context.Context, id ID) (*entity.Property, error) {
-	url := d.BaseURL + "/property/" + id
-	req := getReq(url, d.headers()).WithContext(ctx)
-	res, err := d.Client.Do(req)
+func (d Driver) UpdateApplicationStatus(ctx context.Context, id entity.ID, status entity.ApplicationStatus, note string) (*entity.RentalApplication, error) {
+	var (
+		route = "/application/" + id + "/status"
+		body  = openapi.NewUpdateApplicationStatusReq(status, note)
+		req   = postReq(d.url(route), body, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
-	if code := res.StatusCode; code >= 400 {
-		return nil, fmt.Errorf("expected 200 response, got %d", code)
+	return d.getApplicationRes(res)
+}
+func (d Driver) ConvertApplication(ctx context.Context, id entity.ID) (*usecase.ApplicationConversion, error) {
+	var (
+		route = "/application/" + id + "/convert"
+		req   = postReq(d.url(route), nil, d.headers())
+		out   openapi.ApplicationConversion
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
 	}
-	var p openapi.GetPropertyRes
-	if err := json.NewDecoder(res.Body).Decode(&p); err != nil {
+	if err := d.decodeResponse(res, &out); err != nil {
 		return nil, err
 	}
-	property := p.Property.ToProperty()
-	return &property, nil
+	return out.ToApplicationConversion(), nil
 }
-func (d Driver) ListProperties(ctx context.Context, f usecase.PropertyFilter) ([]entity.Property, error) {
+func (d Driver) getApplicationRes(res *http.Response) (*entity.RentalApplication, error) {
+	var data openapi.ApplicationRes
+	if err := d.decodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+	return data.Application.ToRentalApplication(), nil
+}
+func (d Driver) StoreScreeningPolicy(ctx context.Context, p entity.ScreeningPolicy) (*entity.ScreeningPolicy, error) {
 	var (
-		route = "/property"
-		p     = d.path(route).WithQueryArgs(sMap{"search": f.Search})
-		req   = getReq(p.String(), d.headers()).WithContext(ctx)
+		route = "/property/" + p.PropertyID + "/screening/policy"
+		body  = openapi.NewStoreScreeningPolicyReq(p)
+		req   = putReq(d.url(route), body, d.headers())
 	)
-	res, err := d.Client.Do(req)
+	res, err := d.Client.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
-	var list openapi.ListPropertiesRes
-	if err := json.NewDecoder(res.Body).Decode(&list); err != nil {
+	return d.screeningPolicyRes(res)
+}
+func (d Driver) GetScreeningPolicy(ctx context.Context, propertyID entity.ID) (*entity.ScreeningPolicy, error) {
+	var (
+		route = "/property/" + propertyID + "/screening/policy"
+		req   = getReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
 		return nil, err
 	}
-	return list.ToProperties(), nil
+	return d.screeningPolicyRes(res)
 }
-func (d Driver) RemoveProperty(ctx context.Context, id ID) error {
-	url := d.BaseURL + "/property/" + id
-	req := delReq(url, d.headers()).WithContext(ctx)
-	res, err := d.Client.Do(req)
+func (d Driver) ScreenApplication(ctx context.Context, applicationID entity.ID) (*entity.ScreeningReport, error) {
+	var (
+		route = "/application/" + applicationID + "/screening"
+		req   = postReq(d.url(route), nil, d.headers())
+		out   openapi.ScreeningReportRes
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if code := res.StatusCode; code >= 400 {
-		return fmt.Errorf("expected 204 response, got %d", code)
+	if err := d.decodeResponse(res, &out); err != nil {
+		return nil, err
 	}
-	return nil
+	return out.Report.ToScreeningReport(), nil
+}
+func (d Driver) ListScreeningReports(ctx context.Context, f filters.ScreeningReportFilter) ([]entity.ScreeningReport, error) {
+	var (
+		route = "/application/" + f.ApplicationID + "/screening"
+		out   = make([]entity.ScreeningReport, 0)
+	)
+	for {
+		var list openapi.ScreeningReportList
+		req := getReq(d.path(route).WithQueryArgs(make(sMap).withPage(f.Page)).String(), d.headers())
+		res, err := d.Client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if err := d.decodeResponse(res, &list); err != nil {
+			return nil, err
+		}
+		out = append(out, list.ToScreeningReports()...)
+		// without a page size every page is read so the whole list is returned
+		if f.Page.Size > 0 || list.NextCursor == nil {
+			return out, nil
+		}
+		f.Page.Cursor = *list.NextCursor
+	}
+}
+func (d Driver) StoreListing(ctx context.Context, l entity.Listing) (*entity.Listing, error) {
+	var (
+		route = "/property/" + l.PropertyID + "/listing"
+		body  = openapi.NewStoreListingReq(l)
+		req   = putReq(d.url(route), body, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.listingRes(res)
+}
+func (d Driver) GetListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	var (
+		route = "/property/" + propertyID + "/listing"
+		req   = getReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.listingRes(res)
+}
+func (d Driver) PublishListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	var (
+		route = "/property/" + propertyID + "/listing/publish"
+		req   = postReq(d.url(route), nil, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.listingRes(res)
+}
+func (d Driver) UnpublishListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	var (
+		route = "/property/" + propertyID + "/listing/unpublish"
+		req   = postReq(d.url(route), nil, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.listingRes(res)
 }
 
-func (d Driver) StoreTenant(ctx context.Context, tenant entity.Tenant) (*entity.Tenant, error) {
-	body := openapi.NewStoreTenantReq(tenant)
-	route := "/tenant"
+// ListPublicListings is called without credentials just like the marketing site does
+func (d Driver) ListPublicListings(ctx context.Context, f filters.ListingFilter) ([]usecase.PublicListing, error) {
+	var (
+		route  = "/listings"
+		params = openapi.NewListPublicListingsParams(f)
+		args   = make(sMap)
+		out    = make([]usecase.PublicListing, 0)
+	)
+	if params.City != nil {
+		args["city"] = *params.City
+	}
+	if params.MinRent != nil {
+		args["minRent"] = strconv.Itoa(*params.MinRent)
+	}
+	if params.MaxRent != nil {
+		args["maxRent"] = strconv.Itoa(*params.MaxRent)
+	}
+	if params.Currency != nil {
+		args["currency"] = *params.Currency
+	}
+	if params.PetsAllowed != nil {
+		args["petsAllowed"] = strconv.FormatBool(*params.PetsAllowed)
+	}
+	for {
+		var list openapi.PublicListingList
+		req := getReq(d.path(route).WithQueryArgs(args.withPage(f.Page)).String(), nil)
+		res, err := d.Client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if err := d.decodeResponse(res, &list); err != nil {
+			return nil, err
+		}
+		out = append(out, list.ToPublicListings()...)
+		// without a page size every page is read so the whole list is returned
+		if f.Page.Size > 0 || list.NextCursor == nil {
+			return out, nil
+		}
+		f.Page.Cursor = *list.NextCursor
+	}
+}
+func (d Driver) ListOutbox(ctx context.Context, f filters.OutboxFilter) ([]event.Message, error) {
+	var (
+		route  = "/admin/outbox"
+		params = openapi.NewListOutboxParams(f)
+		args   = make(sMap)
+		list   = make([]event.Message, 0)
+	)
+	if params.Status != nil {
+		args["status"] = string(*params.Status)
+	}
+	if params.Name != nil {
+		args["name"] = *params.Name
+	}
+	for {
+		var res openapi.OutboxMessageList
+		req := getReq(d.path(route).WithQueryArgs(args.withPage(f.Page)).String(), d.headers())
+		r, err := d.Client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if err := d.decodeResponse(r, &res); err != nil {
+			return nil, err
+		}
+		for _, m := range res.Messages {
+			msg, err := m.ToMessage()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, *msg)
+		}
+		// without a page size every page is read so the whole list is returned
+		if f.Page.Size > 0 || res.NextCursor == nil {
+			return list, nil
+		}
+		f.Page.Cursor = *res.NextCursor
+	}
+}
+func (d Driver) GetOutboxMessage(ctx context.Context, id string) (*event.Message, error) {
+	var (
+		route = "/admin/outbox/" + id
+		req   = getReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.outboxMessageRes(res)
+}
+func (d Driver) ReplayOutboxMessage(ctx context.Context, id string) (*event.Message, error) {
+	var (
+		route = "/admin/outbox/" + id + "/replay"
+		req   = postReq(d.url(route), nil, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.outboxMessageRes(res)
+}
+func (d Driver) ListAudit(ctx context.Context, f filters.AuditFilter) ([]audit.Entry, error) {
+	var (
+		route  = "/audit"
+		params = openapi.NewListAuditParams(f)
+		args   = make(sMap)
+		list   = make([]audit.Entry, 0)
+	)
+	if params.Entity != nil {
+		args["entity"] = string(*params.Entity)
+	}
+	if params.EntityID != nil {
+		args["entityID"] = *params.EntityID
+	}
+	if params.Actor != nil {
+		args["actor"] = *params.Actor
+	}
+	for {
+		var res openapi.AuditList
+		req := getReq(d.path(route).WithQueryArgs(args.withPage(f.Page)).String(), d.headers())
+		r, err := d.Client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if err := d.decodeResponse(r, &res); err != nil {
+			return nil, err
+		}
+		for _, e := range res.Entries {
+			entry, err := e.ToEntry()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, *entry)
+		}
+		// without a page size every page is read so the whole list is returned
+		if f.Page.Size > 0 || res.NextCursor == nil {
+			return list, nil
+		}
+		f.Page.Cursor = *res.NextCursor
+	}
+}
+func (d Driver) StoreWebhook(ctx context.Context, w entity.Webhook) (*entity.Webhook, error) {
+	body := openapi.NewStoreWebhookReq(w)
+	route := "/webhook"
 	req := postReq(d.url(route), body, d.headers())
-	if tenant.ID != "" {
-		route = "/tenant/" + tenant.ID
+	if w.ID != "" {
+		route = "/webhook/" + w.ID
 		req = putReq(d.url(route), body, d.headers())
 	}
 	res, err := d.Client.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
-	return d.getTenantRes(res)
+	return d.webhookRes(res)
 }
-func (d Driver) GetTenant(ctx context.Context, id entity.ID) (*entity.Tenant, error) {
+func (d Driver) GetWebhook(ctx context.Context, id entity.ID) (*entity.Webhook, error) {
 	var (
-		route = "/tenant/" + id
+		route = "/webhook/" + id
 		req   = getReq(d.url(route), d.headers())
 	)
 	res, err := d.Client.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
-	return d.getTenantRes(res)
+	return d.webhookRes(res)
 }
-func (d Driver) ListTenants(ctx context.Context) ([]entity.Tenant, error) {
+func (d Driver) ListWebhooks(ctx context.Context) ([]entity.Webhook, error) {
 	var (
-		route = "/tenant"
+		route = "/webhook"
 		req   = getReq(d.url(route), d.headers())
-		list  openapi.TenantList
+		list  openapi.WebhookList
 	)
 	res, err := d.Client.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
-	if err := json.NewDecoder(res.Body).Decode(&list); err != nil {
+	if err := d.decodeResponse(res, &list); err != nil {
 		return nil, err
 	}
-	return list.ToTenants(), nil
+	out := make([]entity.Webhook, len(list.Webhooks))
+	for i, w := range list.Webhooks {
+		out[i] = w.ToWebhook()
+	}
+	return out, nil
 }
-func (d Driver) getTenantRes(r *http.Response) (*entity.Tenant, error) {
-	var res openapi.GetTenantRes
+func (d Driver) RemoveWebhook(ctx context.Context, id entity.ID) error {
+	var (
+		route = "/webhook/" + id
+		req   = delReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("expected 204 response, got %d", res.StatusCode)
+	}
+	return nil
+}
+func (d Driver) ListWebhookDeliveries(ctx context.Context, f filters.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	var (
+		route = "/webhook/" + f.WebhookID + "/delivery"
+		args  = sMap{"status": f.Status}
+		out   = make([]entity.WebhookDelivery, 0)
+	)
+	for {
+		var list openapi.WebhookDeliveryList
+		req := getReq(d.path(route).WithQueryArgs(args.withPage(f.Page)).String(), d.headers())
+		res, err := d.Client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if err := d.decodeResponse(res, &list); err != nil {
+			return nil, err
+		}
+		for _, del := range list.Deliveries {
+			delivery, err := del.ToWebhookDelivery()
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, *delivery)
+		}
+		// without a page size every page is read so the whole list is returned
+		if f.Page.Size > 0 || list.NextCursor == nil {
+			return out, nil
+		}
+		f.Page.Cursor = *list.NextCursor
+	}
+}
+func (d Driver) GetWebhookDelivery(ctx context.Context, id entity.ID) (*entity.WebhookDelivery, error) {
+	var (
+		route = "/webhook/delivery/" + id
+		req   = getReq(d.url(route), d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.webhookDeliveryRes(res)
+}
+func (d Driver) RedeliverWebhook(ctx context.Context, deliveryID entity.ID) (*entity.WebhookDelivery, error) {
+	var (
+		route = "/webhook/delivery/" + deliveryID + "/redeliver"
+		req   = postReq(d.url(route), nil, d.headers())
+	)
+	res, err := d.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return d.webhookDeliveryRes(res)
+}
+func (d Driver) webhookRes(r *http.Response) (*entity.Webhook, error) {
+	var res openapi.WebhookRes
 	if err := d.decodeResponse(r, &res); err != nil {
 		return nil, err
 	}
-	return res.Tenant.ToTenant(), nil
+	w := res.Webhook.ToWebhook()
+	return &w, nil
+}
+func (d Driver) webhookDeliveryRes(r *http.Response) (*entity.WebhookDelivery, error) {
+	var res openapi.WebhookDeliveryRes
+	if err := d.decodeResponse(r, &res); err != nil {
+		return nil, err
+	}
+	return res.Delivery.ToWebhookDelivery()
+}
+func (d Driver) outboxMessageRes(r *http.Response) (*event.Message, error) {
+	var res openapi.OutboxMessageRes
+	if err := d.decodeResponse(r, &res); err != nil {
+		return nil, err
+	}
+	return res.Message.ToMessage()
+}
+func (d Driver) screeningPolicyRes(r *http.Response) (*entity.ScreeningPolicy, error) {
+	var res openapi.ScreeningPolicyRes
+	if err := d.decodeResponse(r, &res); err != nil {
+		return nil, err
+	}
+	return res.Policy.ToScreeningPolicy(), nil
+}
+func (d Driver) listingRes(r *http.Response) (*entity.Listing, error) {
+	var res openapi.ListingRes
+	if err := d.decodeResponse(r, &res); err != nil {
+		return nil, err
+	}
+	return res.Listing.ToListing(), nil
 }
 
 func (d Driver) headers() map[string]string {
+	if d.APIKey != "" {
+		return map[string]string{
+			HeaderAPIKey:    d.APIKey,
+			HeaderAPISecret: d.APISecret,
+		}
+	}
 	c := test.Config()
 	headers := map[string]string{
 		HeaderAPIKey:    c.GetString(internal.EnvAPIKey),
@@ -207,6 +1236,11 @@ func postReq(route string, body any, headers map[string]string) *http.Request {
 func putReq(route string, body any, headers map[string]string) *http.Request {
 	return httpReq(http.MethodPut, route, body, headers)
 }
+func patchReq(route string, body any, headers map[string]string) *http.Request {
+	req := httpReq(http.MethodPatch, route, body, headers)
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	return req
+}
 func httpReq(method string, route string, body interface{}, headers map[string]string) *http.Request {
 	req, err := newReqBuilder(method, route).
 		WithBody(body).WithHeaders(headers).Build()
@@ -217,6 +1251,17 @@ func httpReq(method string, route string, body interface{}, headers map[string]s
 }
 
 type sMap map[string]string
+
+// withPage adds the query parameters of the page of a list
+func (m sMap) withPage(p filters.Page) sMap {
+	m["sort"] = p.SortString()
+	m["cursor"] = p.Cursor
+	if p.Size > 0 {
+		m["pageSize"] = strconv.Itoa(p.Size)
+	}
+	return m
+}
+
 type reqBuilder struct {
 	method, route string
 	body          any
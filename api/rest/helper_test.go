@@ -8,6 +8,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/api/rest/openapi"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
 )
 
 func getReq(t testing.TB, route string, headers map[string]string) *http.Request {
@@ -22,6 +25,11 @@ func postReq(t testing.TB, route string, body any, headers map[string]string) *h
 func putReq(t testing.TB, route string, body any, headers map[string]string) *http.Request {
 	return httpReq(t, http.MethodPut, route, body, headers)
 }
+func patchReq(t testing.TB, route string, body any, headers map[string]string) *http.Request {
+	req := httpReq(t, http.MethodPatch, route, body, headers)
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	return req
+}
 func httpReq(t testing.TB, method string, route string, body interface{}, headers map[string]string) *http.Request {
 	t.Helper()
 	req, err := newReqBuilder(method, route).
@@ -36,6 +44,22 @@ func handleReq(t testing.TB, h http.Handler, req *http.Request) *http.Response {
 	return rr.Result()
 }
 
+// storeParties puts a property and tenants with the ids of the lease, a lease
+// is only stored when they exist
+func storeParties(t testing.TB, h http.Handler, headers map[string]string, lease entity.Lease) {
+	t.Helper()
+	p := fake.Property()
+	p.ID = lease.PropertyID
+	res := handleReq(t, h, putReq(t, "/property/"+p.ID, openapi.NewStorePropertyReq(p), headers))
+	require.Less(t, res.StatusCode, http.StatusMultipleChoices, "store property")
+	for _, id := range lease.TenantIDs {
+		tenant := fake.Tenant()
+		tenant.ID = id
+		res := handleReq(t, h, putReq(t, "/tenant/"+id, openapi.NewStoreTenantReq(tenant), headers))
+		require.Less(t, res.StatusCode, http.StatusMultipleChoices, "store tenant")
+	}
+}
+
 type reqBuilder struct {
 	method, route string
 	body          any
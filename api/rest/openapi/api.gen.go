@@ -1,6 +1,6 @@
 // Package openapi provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.1.0 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package openapi
 
 import (
@@ -14,15 +14,99 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List outbox events
+	// (GET /admin/outbox)
+	ListOutbox(w http.ResponseWriter, r *http.Request, params ListOutboxParams)
+	// Get outbox event
+	// (GET /admin/outbox/{messageID})
+	GetOutboxMessage(w http.ResponseWriter, r *http.Request, messageID string)
+	// Replay outbox event
+	// (POST /admin/outbox/{messageID}/replay)
+	ReplayOutboxMessage(w http.ResponseWriter, r *http.Request, messageID string)
+	// List rental applications
+	// (GET /application)
+	ListApplications(w http.ResponseWriter, r *http.Request, params ListApplicationsParams)
+	// Submit rental application
+	// (POST /application)
+	SubmitApplication(w http.ResponseWriter, r *http.Request)
+	// Get rental application
+	// (GET /application/{applicationID})
+	GetApplication(w http.ResponseWriter, r *http.Request, applicationID string)
+	// Convert application
+	// (POST /application/{applicationID}/convert)
+	ConvertApplication(w http.ResponseWriter, r *http.Request, applicationID string)
+	// List screening reports
+	// (GET /application/{applicationID}/screening)
+	ListScreeningReports(w http.ResponseWriter, r *http.Request, applicationID string, params ListScreeningReportsParams)
+	// Screen application
+	// (POST /application/{applicationID}/screening)
+	ScreenApplication(w http.ResponseWriter, r *http.Request, applicationID string)
+	// Update application status
+	// (POST /application/{applicationID}/status)
+	UpdateApplicationStatus(w http.ResponseWriter, r *http.Request, applicationID string)
+	// List audit log
+	// (GET /audit)
+	ListAudit(w http.ResponseWriter, r *http.Request, params ListAuditParams)
 	// List leases
 	// (GET /lease)
-	ListLeases(w http.ResponseWriter, r *http.Request)
+	ListLeases(w http.ResponseWriter, r *http.Request, params ListLeasesParams)
 	// Lease property
 	// (POST /lease)
 	LeaseProperty(w http.ResponseWriter, r *http.Request)
 	// Get Lease
 	// (GET /lease/{leaseID})
 	GetLease(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Amend lease
+	// (POST /lease/{leaseID}/amend)
+	AmendLease(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Ledger balance
+	// (GET /lease/{leaseID}/balance)
+	GetBalance(w http.ResponseWriter, r *http.Request, leaseID string, params GetBalanceParams)
+	// Security deposit
+	// (GET /lease/{leaseID}/deposit)
+	GetDeposit(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Dispose deposit
+	// (POST /lease/{leaseID}/deposit/disposition)
+	DisposeDeposit(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Record deposit receipt
+	// (POST /lease/{leaseID}/deposit/receipt)
+	RecordDepositReceipt(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Deposit statement
+	// (GET /lease/{leaseID}/deposit/statement)
+	GetDepositStatement(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Assess late fees
+	// (GET /lease/{leaseID}/latefee)
+	AssessLateFees(w http.ResponseWriter, r *http.Request, leaseID string, params AssessLateFeesParams)
+	// Apply late fees
+	// (POST /lease/{leaseID}/latefee)
+	ApplyLateFees(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Get late fee policy
+	// (GET /lease/{leaseID}/latefee/policy)
+	GetLateFeePolicy(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Store lease late fee policy
+	// (PUT /lease/{leaseID}/latefee/policy)
+	StoreLeaseLateFeePolicy(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Ledger statement
+	// (GET /lease/{leaseID}/ledger)
+	GetStatement(w http.ResponseWriter, r *http.Request, leaseID string, params GetStatementParams)
+	// Post ledger entry
+	// (POST /lease/{leaseID}/ledger)
+	PostLedgerEntry(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Reverse ledger entry
+	// (POST /lease/{leaseID}/ledger/{entryID}/reverse)
+	ReverseLedgerEntry(w http.ResponseWriter, r *http.Request, leaseID string, entryID string)
+	// Renew lease
+	// (POST /lease/{leaseID}/renew)
+	RenewLease(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Rent schedule
+	// (GET /lease/{leaseID}/schedule)
+	GetRentSchedule(w http.ResponseWriter, r *http.Request, leaseID string, params GetRentScheduleParams)
+	// Terminate lease
+	// (POST /lease/{leaseID}/terminate)
+	TerminateLease(w http.ResponseWriter, r *http.Request, leaseID string)
+	// Public listings
+	// (GET /listings)
+	ListPublicListings(w http.ResponseWriter, r *http.Request, params ListPublicListingsParams)
 	// List properties
 	// (GET /property)
 	ListProperties(w http.ResponseWriter, r *http.Request, params ListPropertiesParams)
@@ -38,27 +122,174 @@ type ServerInterface interface {
 	// Store Property
 	// (PUT /property/{propertyID})
 	StoreProperty(w http.ResponseWriter, r *http.Request, propertyID string)
+	// Store property late fee policy
+	// (PUT /property/{propertyID}/latefee/policy)
+	StorePropertyLateFeePolicy(w http.ResponseWriter, r *http.Request, propertyID string)
+	// Get property listing
+	// (GET /property/{propertyID}/listing)
+	GetListing(w http.ResponseWriter, r *http.Request, propertyID string)
+	// Store property listing
+	// (PUT /property/{propertyID}/listing)
+	StoreListing(w http.ResponseWriter, r *http.Request, propertyID string)
+	// Publish property listing
+	// (POST /property/{propertyID}/listing/publish)
+	PublishListing(w http.ResponseWriter, r *http.Request, propertyID string)
+	// Unpublish property listing
+	// (POST /property/{propertyID}/listing/unpublish)
+	UnpublishListing(w http.ResponseWriter, r *http.Request, propertyID string)
+	// Restore a removed property
+	// (POST /property/{propertyID}/restore)
+	RestoreProperty(w http.ResponseWriter, r *http.Request, propertyID string)
+	// Get property screening policy
+	// (GET /property/{propertyID}/screening/policy)
+	GetScreeningPolicy(w http.ResponseWriter, r *http.Request, propertyID string)
+	// Store property screening policy
+	// (PUT /property/{propertyID}/screening/policy)
+	StoreScreeningPolicy(w http.ResponseWriter, r *http.Request, propertyID string)
+	// List units
+	// (GET /property/{propertyID}/unit)
+	ListUnits(w http.ResponseWriter, r *http.Request, propertyID string)
+	// Add unit
+	// (POST /property/{propertyID}/unit)
+	AddUnit(w http.ResponseWriter, r *http.Request, propertyID string)
+	// Delete unit
+	// (DELETE /property/{propertyID}/unit/{unitID})
+	DeleteUnit(w http.ResponseWriter, r *http.Request, propertyID string, unitID string)
+	// Get unit
+	// (GET /property/{propertyID}/unit/{unitID})
+	GetUnit(w http.ResponseWriter, r *http.Request, propertyID string, unitID string)
+	// Store unit
+	// (PUT /property/{propertyID}/unit/{unitID})
+	StoreUnit(w http.ResponseWriter, r *http.Request, propertyID string, unitID string)
 	// List Tenants
 	// (GET /tenant)
-	ListTenants(w http.ResponseWriter, r *http.Request)
+	ListTenants(w http.ResponseWriter, r *http.Request, params ListTenantsParams)
 	// Add Tenant
 	// (POST /tenant)
 	AddTenant(w http.ResponseWriter, r *http.Request)
+	// Deletes a tenant
+	// (DELETE /tenant/{tenantID})
+	DeleteTenant(w http.ResponseWriter, r *http.Request, tenantID string)
 	// Get Tenant
 	// (GET /tenant/{tenantID})
 	GetTenant(w http.ResponseWriter, r *http.Request, tenantID string)
+	// Patch Tenant
+	// (PATCH /tenant/{tenantID})
+	PatchTenant(w http.ResponseWriter, r *http.Request, tenantID string)
 	// Store Tenant
 	// (PUT /tenant/{tenantID})
 	StoreTenant(w http.ResponseWriter, r *http.Request, tenantID string)
+	// Add a phone to the tenant
+	// (POST /tenant/{tenantID}/phone)
+	AddTenantPhone(w http.ResponseWriter, r *http.Request, tenantID string)
+	// Remove a phone from the tenant
+	// (DELETE /tenant/{tenantID}/phone/{number})
+	RemoveTenantPhone(w http.ResponseWriter, r *http.Request, tenantID string, number string)
+	// Update a phone of the tenant
+	// (PUT /tenant/{tenantID}/phone/{number})
+	UpdateTenantPhone(w http.ResponseWriter, r *http.Request, tenantID string, number string)
+	// Restore a removed tenant
+	// (POST /tenant/{tenantID}/restore)
+	RestoreTenant(w http.ResponseWriter, r *http.Request, tenantID string)
+	// List webhooks
+	// (GET /webhook)
+	ListWebhooks(w http.ResponseWriter, r *http.Request)
+	// Add webhook
+	// (POST /webhook)
+	AddWebhook(w http.ResponseWriter, r *http.Request)
+	// Get webhook delivery
+	// (GET /webhook/delivery/{deliveryID})
+	GetWebhookDelivery(w http.ResponseWriter, r *http.Request, deliveryID string)
+	// Redeliver webhook delivery
+	// (POST /webhook/delivery/{deliveryID}/redeliver)
+	RedeliverWebhook(w http.ResponseWriter, r *http.Request, deliveryID string)
+	// Delete webhook
+	// (DELETE /webhook/{webhookID})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookID string)
+	// Get webhook
+	// (GET /webhook/{webhookID})
+	GetWebhook(w http.ResponseWriter, r *http.Request, webhookID string)
+	// Store webhook
+	// (PUT /webhook/{webhookID})
+	StoreWebhook(w http.ResponseWriter, r *http.Request, webhookID string)
+	// List webhook deliveries
+	// (GET /webhook/{webhookID}/delivery)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookID string, params ListWebhookDeliveriesParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// List outbox events
+// (GET /admin/outbox)
+func (_ Unimplemented) ListOutbox(w http.ResponseWriter, r *http.Request, params ListOutboxParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get outbox event
+// (GET /admin/outbox/{messageID})
+func (_ Unimplemented) GetOutboxMessage(w http.ResponseWriter, r *http.Request, messageID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replay outbox event
+// (POST /admin/outbox/{messageID}/replay)
+func (_ Unimplemented) ReplayOutboxMessage(w http.ResponseWriter, r *http.Request, messageID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List rental applications
+// (GET /application)
+func (_ Unimplemented) ListApplications(w http.ResponseWriter, r *http.Request, params ListApplicationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Submit rental application
+// (POST /application)
+func (_ Unimplemented) SubmitApplication(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get rental application
+// (GET /application/{applicationID})
+func (_ Unimplemented) GetApplication(w http.ResponseWriter, r *http.Request, applicationID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Convert application
+// (POST /application/{applicationID}/convert)
+func (_ Unimplemented) ConvertApplication(w http.ResponseWriter, r *http.Request, applicationID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List screening reports
+// (GET /application/{applicationID}/screening)
+func (_ Unimplemented) ListScreeningReports(w http.ResponseWriter, r *http.Request, applicationID string, params ListScreeningReportsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Screen application
+// (POST /application/{applicationID}/screening)
+func (_ Unimplemented) ScreenApplication(w http.ResponseWriter, r *http.Request, applicationID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update application status
+// (POST /application/{applicationID}/status)
+func (_ Unimplemented) UpdateApplicationStatus(w http.ResponseWriter, r *http.Request, applicationID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List audit log
+// (GET /audit)
+func (_ Unimplemented) ListAudit(w http.ResponseWriter, r *http.Request, params ListAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List leases
 // (GET /lease)
-func (_ Unimplemented) ListLeases(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) ListLeases(w http.ResponseWriter, r *http.Request, params ListLeasesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -74,6 +305,108 @@ func (_ Unimplemented) GetLease(w http.ResponseWriter, r *http.Request, leaseID
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Amend lease
+// (POST /lease/{leaseID}/amend)
+func (_ Unimplemented) AmendLease(w http.ResponseWriter, r *http.Request, leaseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Ledger balance
+// (GET /lease/{leaseID}/balance)
+func (_ Unimplemented) GetBalance(w http.ResponseWriter, r *http.Request, leaseID string, params GetBalanceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Security deposit
+// (GET /lease/{leaseID}/deposit)
+func (_ Unimplemented) GetDeposit(w http.ResponseWriter, r *http.Request, leaseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Dispose deposit
+// (POST /lease/{leaseID}/deposit/disposition)
+func (_ Unimplemented) DisposeDeposit(w http.ResponseWriter, r *http.Request, leaseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record deposit receipt
+// (POST /lease/{leaseID}/deposit/receipt)
+func (_ Unimplemented) RecordDepositReceipt(w http.ResponseWriter, r *http.Request, leaseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Deposit statement
+// (GET /lease/{leaseID}/deposit/statement)
+func (_ Unimplemented) GetDepositStatement(w http.ResponseWriter, r *http.Request, leaseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Assess late fees
+// (GET /lease/{leaseID}/latefee)
+func (_ Unimplemented) AssessLateFees(w http.ResponseWriter, r *http.Request, leaseID string, params AssessLateFeesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Apply late fees
+// (POST /lease/{leaseID}/latefee)
+func (_ Unimplemented) ApplyLateFees(w http.ResponseWriter, r *http.Request, leaseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get late fee policy
+// (GET /lease/{leaseID}/latefee/policy)
+func (_ Unimplemented) GetLateFeePolicy(w http.ResponseWriter, r *http.Request, leaseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Store lease late fee policy
+// (PUT /lease/{leaseID}/latefee/policy)
+func (_ Unimplemented) StoreLeaseLateFeePolicy(w http.ResponseWriter, r *http.Request, leaseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Ledger statement
+// (GET /lease/{leaseID}/ledger)
+func (_ Unimplemented) GetStatement(w http.ResponseWriter, r *http.Request, leaseID string, params GetStatementParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Post ledger entry
+// (POST /lease/{leaseID}/ledger)
+func (_ Unimplemented) PostLedgerEntry(w http.ResponseWriter, r *http.Request, leaseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reverse ledger entry
+// (POST /lease/{leaseID}/ledger/{entryID}/reverse)
+func (_ Unimplemented) ReverseLedgerEntry(w http.ResponseWriter, r *http.Request, leaseID string, entryID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Renew lease
+// (POST /lease/{leaseID}/renew)
+func (_ Unimplemented) RenewLease(w http.ResponseWriter, r *http.Request, leaseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rent schedule
+// (GET /lease/{leaseID}/schedule)
+func (_ Unimplemented) GetRentSchedule(w http.ResponseWriter, r *http.Request, leaseID string, params GetRentScheduleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Terminate lease
+// (POST /lease/{leaseID}/terminate)
+func (_ Unimplemented) TerminateLease(w http.ResponseWriter, r *http.Request, leaseID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Public listings
+// (GET /listings)
+func (_ Unimplemented) ListPublicListings(w http.ResponseWriter, r *http.Request, params ListPublicListingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List properties
 // (GET /property)
 func (_ Unimplemented) ListProperties(w http.ResponseWriter, r *http.Request, params ListPropertiesParams) {
@@ -104,9 +437,87 @@ func (_ Unimplemented) StoreProperty(w http.ResponseWriter, r *http.Request, pro
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Store property late fee policy
+// (PUT /property/{propertyID}/latefee/policy)
+func (_ Unimplemented) StorePropertyLateFeePolicy(w http.ResponseWriter, r *http.Request, propertyID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get property listing
+// (GET /property/{propertyID}/listing)
+func (_ Unimplemented) GetListing(w http.ResponseWriter, r *http.Request, propertyID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Store property listing
+// (PUT /property/{propertyID}/listing)
+func (_ Unimplemented) StoreListing(w http.ResponseWriter, r *http.Request, propertyID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Publish property listing
+// (POST /property/{propertyID}/listing/publish)
+func (_ Unimplemented) PublishListing(w http.ResponseWriter, r *http.Request, propertyID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unpublish property listing
+// (POST /property/{propertyID}/listing/unpublish)
+func (_ Unimplemented) UnpublishListing(w http.ResponseWriter, r *http.Request, propertyID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore a removed property
+// (POST /property/{propertyID}/restore)
+func (_ Unimplemented) RestoreProperty(w http.ResponseWriter, r *http.Request, propertyID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get property screening policy
+// (GET /property/{propertyID}/screening/policy)
+func (_ Unimplemented) GetScreeningPolicy(w http.ResponseWriter, r *http.Request, propertyID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Store property screening policy
+// (PUT /property/{propertyID}/screening/policy)
+func (_ Unimplemented) StoreScreeningPolicy(w http.ResponseWriter, r *http.Request, propertyID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List units
+// (GET /property/{propertyID}/unit)
+func (_ Unimplemented) ListUnits(w http.ResponseWriter, r *http.Request, propertyID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add unit
+// (POST /property/{propertyID}/unit)
+func (_ Unimplemented) AddUnit(w http.ResponseWriter, r *http.Request, propertyID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete unit
+// (DELETE /property/{propertyID}/unit/{unitID})
+func (_ Unimplemented) DeleteUnit(w http.ResponseWriter, r *http.Request, propertyID string, unitID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get unit
+// (GET /property/{propertyID}/unit/{unitID})
+func (_ Unimplemented) GetUnit(w http.ResponseWriter, r *http.Request, propertyID string, unitID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Store unit
+// (PUT /property/{propertyID}/unit/{unitID})
+func (_ Unimplemented) StoreUnit(w http.ResponseWriter, r *http.Request, propertyID string, unitID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List Tenants
 // (GET /tenant)
-func (_ Unimplemented) ListTenants(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) ListTenants(w http.ResponseWriter, r *http.Request, params ListTenantsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -116,18 +527,102 @@ func (_ Unimplemented) AddTenant(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Deletes a tenant
+// (DELETE /tenant/{tenantID})
+func (_ Unimplemented) DeleteTenant(w http.ResponseWriter, r *http.Request, tenantID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Tenant
 // (GET /tenant/{tenantID})
 func (_ Unimplemented) GetTenant(w http.ResponseWriter, r *http.Request, tenantID string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Patch Tenant
+// (PATCH /tenant/{tenantID})
+func (_ Unimplemented) PatchTenant(w http.ResponseWriter, r *http.Request, tenantID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Store Tenant
 // (PUT /tenant/{tenantID})
 func (_ Unimplemented) StoreTenant(w http.ResponseWriter, r *http.Request, tenantID string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Add a phone to the tenant
+// (POST /tenant/{tenantID}/phone)
+func (_ Unimplemented) AddTenantPhone(w http.ResponseWriter, r *http.Request, tenantID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a phone from the tenant
+// (DELETE /tenant/{tenantID}/phone/{number})
+func (_ Unimplemented) RemoveTenantPhone(w http.ResponseWriter, r *http.Request, tenantID string, number string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a phone of the tenant
+// (PUT /tenant/{tenantID}/phone/{number})
+func (_ Unimplemented) UpdateTenantPhone(w http.ResponseWriter, r *http.Request, tenantID string, number string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore a removed tenant
+// (POST /tenant/{tenantID}/restore)
+func (_ Unimplemented) RestoreTenant(w http.ResponseWriter, r *http.Request, tenantID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List webhooks
+// (GET /webhook)
+func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add webhook
+// (POST /webhook)
+func (_ Unimplemented) AddWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get webhook delivery
+// (GET /webhook/delivery/{deliveryID})
+func (_ Unimplemented) GetWebhookDelivery(w http.ResponseWriter, r *http.Request, deliveryID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Redeliver webhook delivery
+// (POST /webhook/delivery/{deliveryID}/redeliver)
+func (_ Unimplemented) RedeliverWebhook(w http.ResponseWriter, r *http.Request, deliveryID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete webhook
+// (DELETE /webhook/{webhookID})
+func (_ Unimplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get webhook
+// (GET /webhook/{webhookID})
+func (_ Unimplemented) GetWebhook(w http.ResponseWriter, r *http.Request, webhookID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Store webhook
+// (PUT /webhook/{webhookID})
+func (_ Unimplemented) StoreWebhook(w http.ResponseWriter, r *http.Request, webhookID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List webhook deliveries
+// (GET /webhook/{webhookID}/delivery)
+func (_ Unimplemented) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookID string, params ListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -137,311 +632,2635 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// ListLeases operation middleware
-func (siw *ServerInterfaceWrapper) ListLeases(w http.ResponseWriter, r *http.Request) {
+// ListOutbox operation middleware
+func (siw *ServerInterfaceWrapper) ListOutbox(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOutboxParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOutbox(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOutboxMessage operation middleware
+func (siw *ServerInterfaceWrapper) GetOutboxMessage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "messageID" -------------
+	var messageID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "messageID", chi.URLParam(r, "messageID"), &messageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "messageID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOutboxMessage(w, r, messageID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplayOutboxMessage operation middleware
+func (siw *ServerInterfaceWrapper) ReplayOutboxMessage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "messageID" -------------
+	var messageID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "messageID", chi.URLParam(r, "messageID"), &messageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "messageID", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, KeyScopes, []string{})
 
 	ctx = context.WithValue(ctx, SecretScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListLeases(w, r)
+		siw.Handler.ReplayOutboxMessage(w, r, messageID)
 	}))
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListApplications operation middleware
+func (siw *ServerInterfaceWrapper) ListApplications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApplicationsParams
+
+	// ------------- Optional query parameter "propertyID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "propertyID", r.URL.Query(), &params.PropertyID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitApplication operation middleware
+func (siw *ServerInterfaceWrapper) SubmitApplication(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitApplication(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApplication operation middleware
+func (siw *ServerInterfaceWrapper) GetApplication(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "applicationID" -------------
+	var applicationID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "applicationID", chi.URLParam(r, "applicationID"), &applicationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApplication(w, r, applicationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ConvertApplication operation middleware
+func (siw *ServerInterfaceWrapper) ConvertApplication(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "applicationID" -------------
+	var applicationID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "applicationID", chi.URLParam(r, "applicationID"), &applicationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConvertApplication(w, r, applicationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListScreeningReports operation middleware
+func (siw *ServerInterfaceWrapper) ListScreeningReports(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "applicationID" -------------
+	var applicationID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "applicationID", chi.URLParam(r, "applicationID"), &applicationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListScreeningReportsParams
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListScreeningReports(w, r, applicationID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ScreenApplication operation middleware
+func (siw *ServerInterfaceWrapper) ScreenApplication(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "applicationID" -------------
+	var applicationID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "applicationID", chi.URLParam(r, "applicationID"), &applicationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ScreenApplication(w, r, applicationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateApplicationStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateApplicationStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "applicationID" -------------
+	var applicationID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "applicationID", chi.URLParam(r, "applicationID"), &applicationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateApplicationStatus(w, r, applicationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAudit operation middleware
+func (siw *ServerInterfaceWrapper) ListAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditParams
+
+	// ------------- Optional query parameter "entity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity", r.URL.Query(), &params.Entity)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entityID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityID", r.URL.Query(), &params.EntityID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListLeases operation middleware
+func (siw *ServerInterfaceWrapper) ListLeases(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLeasesParams
+
+	// ------------- Optional query parameter "propertyID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "propertyID", r.URL.Query(), &params.PropertyID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "unitID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unitID", r.URL.Query(), &params.UnitID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unitID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLeases(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LeaseProperty operation middleware
+func (siw *ServerInterfaceWrapper) LeaseProperty(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LeaseProperty(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLease operation middleware
+func (siw *ServerInterfaceWrapper) GetLease(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLease(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AmendLease operation middleware
+func (siw *ServerInterfaceWrapper) AmendLease(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AmendLease(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBalance operation middleware
+func (siw *ServerInterfaceWrapper) GetBalance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBalanceParams
+
+	// ------------- Optional query parameter "asOf" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asOf", r.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "asOf", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBalance(w, r, leaseID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDeposit operation middleware
+func (siw *ServerInterfaceWrapper) GetDeposit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeposit(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DisposeDeposit operation middleware
+func (siw *ServerInterfaceWrapper) DisposeDeposit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisposeDeposit(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RecordDepositReceipt operation middleware
+func (siw *ServerInterfaceWrapper) RecordDepositReceipt(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecordDepositReceipt(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDepositStatement operation middleware
+func (siw *ServerInterfaceWrapper) GetDepositStatement(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDepositStatement(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AssessLateFees operation middleware
+func (siw *ServerInterfaceWrapper) AssessLateFees(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AssessLateFeesParams
+
+	// ------------- Optional query parameter "asOf" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asOf", r.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "asOf", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AssessLateFees(w, r, leaseID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApplyLateFees operation middleware
+func (siw *ServerInterfaceWrapper) ApplyLateFees(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyLateFees(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLateFeePolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetLateFeePolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLateFeePolicy(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StoreLeaseLateFeePolicy operation middleware
+func (siw *ServerInterfaceWrapper) StoreLeaseLateFeePolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StoreLeaseLateFeePolicy(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatement operation middleware
+func (siw *ServerInterfaceWrapper) GetStatement(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatementParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatement(w, r, leaseID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostLedgerEntry operation middleware
+func (siw *ServerInterfaceWrapper) PostLedgerEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLedgerEntry(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReverseLedgerEntry operation middleware
+func (siw *ServerInterfaceWrapper) ReverseLedgerEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entryID" -------------
+	var entryID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entryID", chi.URLParam(r, "entryID"), &entryID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entryID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReverseLedgerEntry(w, r, leaseID, entryID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RenewLease operation middleware
+func (siw *ServerInterfaceWrapper) RenewLease(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenewLease(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRentSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetRentSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRentScheduleParams
+
+	// ------------- Optional query parameter "dueDay" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dueDay", r.URL.Query(), &params.DueDay)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dueDay", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRentSchedule(w, r, leaseID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TerminateLease operation middleware
+func (siw *ServerInterfaceWrapper) TerminateLease(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "leaseID" -------------
+	var leaseID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TerminateLease(w, r, leaseID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPublicListings operation middleware
+func (siw *ServerInterfaceWrapper) ListPublicListings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPublicListingsParams
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", r.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "city", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minRent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minRent", r.URL.Query(), &params.MinRent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minRent", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxRent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxRent", r.URL.Query(), &params.MaxRent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxRent", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "petsAllowed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "petsAllowed", r.URL.Query(), &params.PetsAllowed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "petsAllowed", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPublicListings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProperties operation middleware
+func (siw *ServerInterfaceWrapper) ListProperties(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPropertiesParams
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeDeleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeDeleted", r.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeDeleted", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProperties(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddProperty operation middleware
+func (siw *ServerInterfaceWrapper) AddProperty(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddProperty(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProperty operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProperty(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProperty(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPropertyById operation middleware
+func (siw *ServerInterfaceWrapper) GetPropertyById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPropertyById(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StoreProperty operation middleware
+func (siw *ServerInterfaceWrapper) StoreProperty(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StoreProperty(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StorePropertyLateFeePolicy operation middleware
+func (siw *ServerInterfaceWrapper) StorePropertyLateFeePolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StorePropertyLateFeePolicy(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetListing operation middleware
+func (siw *ServerInterfaceWrapper) GetListing(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetListing(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StoreListing operation middleware
+func (siw *ServerInterfaceWrapper) StoreListing(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StoreListing(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PublishListing operation middleware
+func (siw *ServerInterfaceWrapper) PublishListing(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishListing(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnpublishListing operation middleware
+func (siw *ServerInterfaceWrapper) UnpublishListing(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnpublishListing(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreProperty operation middleware
+func (siw *ServerInterfaceWrapper) RestoreProperty(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreProperty(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScreeningPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetScreeningPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScreeningPolicy(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StoreScreeningPolicy operation middleware
+func (siw *ServerInterfaceWrapper) StoreScreeningPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StoreScreeningPolicy(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUnits operation middleware
+func (siw *ServerInterfaceWrapper) ListUnits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUnits(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddUnit operation middleware
+func (siw *ServerInterfaceWrapper) AddUnit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddUnit(w, r, propertyID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUnit operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUnit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "unitID" -------------
+	var unitID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "unitID", chi.URLParam(r, "unitID"), &unitID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unitID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUnit(w, r, propertyID, unitID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUnit operation middleware
+func (siw *ServerInterfaceWrapper) GetUnit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "unitID" -------------
+	var unitID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "unitID", chi.URLParam(r, "unitID"), &unitID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unitID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUnit(w, r, propertyID, unitID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StoreUnit operation middleware
+func (siw *ServerInterfaceWrapper) StoreUnit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "propertyID" -------------
+	var propertyID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "unitID" -------------
+	var unitID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "unitID", chi.URLParam(r, "unitID"), &unitID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unitID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StoreUnit(w, r, propertyID, unitID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTenants operation middleware
+func (siw *ServerInterfaceWrapper) ListTenants(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTenantsParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "phone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "phone", r.URL.Query(), &params.Phone)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "phone", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dlState" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dlState", r.URL.Query(), &params.DlState)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dlState", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dobFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dobFrom", r.URL.Query(), &params.DobFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dobFrom", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dobUntil" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dobUntil", r.URL.Query(), &params.DobUntil)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dobUntil", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "leasingPropertyID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "leasingPropertyID", r.URL.Query(), &params.LeasingPropertyID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leasingPropertyID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeDeleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeDeleted", r.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeDeleted", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTenants(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTenant operation middleware
+func (siw *ServerInterfaceWrapper) AddTenant(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTenant(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTenant operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTenant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenantID" -------------
+	var tenantID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantID", chi.URLParam(r, "tenantID"), &tenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTenant(w, r, tenantID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenant operation middleware
+func (siw *ServerInterfaceWrapper) GetTenant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenantID" -------------
+	var tenantID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantID", chi.URLParam(r, "tenantID"), &tenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenant(w, r, tenantID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchTenant operation middleware
+func (siw *ServerInterfaceWrapper) PatchTenant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenantID" -------------
+	var tenantID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantID", chi.URLParam(r, "tenantID"), &tenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchTenant(w, r, tenantID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StoreTenant operation middleware
+func (siw *ServerInterfaceWrapper) StoreTenant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenantID" -------------
+	var tenantID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantID", chi.URLParam(r, "tenantID"), &tenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StoreTenant(w, r, tenantID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTenantPhone operation middleware
+func (siw *ServerInterfaceWrapper) AddTenantPhone(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenantID" -------------
+	var tenantID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantID", chi.URLParam(r, "tenantID"), &tenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTenantPhone(w, r, tenantID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveTenantPhone operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTenantPhone(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenantID" -------------
+	var tenantID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantID", chi.URLParam(r, "tenantID"), &tenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "number" -------------
+	var number string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "number", chi.URLParam(r, "number"), &number, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "number", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyScopes, []string{})
+
+	ctx = context.WithValue(ctx, SecretScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveTenantPhone(w, r, tenantID, number)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTenantPhone operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTenantPhone(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenantID" -------------
+	var tenantID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantID", chi.URLParam(r, "tenantID"), &tenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantID", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Path parameter "number" -------------
+	var number string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "number", chi.URLParam(r, "number"), &number, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "number", Err: err})
+		return
+	}
 
-// LeaseProperty operation middleware
-func (siw *ServerInterfaceWrapper) LeaseProperty(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, KeyScopes, []string{})
 
 	ctx = context.WithValue(ctx, SecretScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.LeaseProperty(w, r)
+		siw.Handler.UpdateTenantPhone(w, r, tenantID, number)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetLease operation middleware
-func (siw *ServerInterfaceWrapper) GetLease(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// RestoreTenant operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTenant(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "leaseID" -------------
-	var leaseID string
+	// ------------- Path parameter "tenantID" -------------
+	var tenantID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "leaseID", chi.URLParam(r, "leaseID"), &leaseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantID", chi.URLParam(r, "tenantID"), &tenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "leaseID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantID", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, KeyScopes, []string{})
 
 	ctx = context.WithValue(ctx, SecretScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLease(w, r, leaseID)
+		siw.Handler.RestoreTenant(w, r, tenantID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ListProperties operation middleware
-func (siw *ServerInterfaceWrapper) ListProperties(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, KeyScopes, []string{})
 
 	ctx = context.WithValue(ctx, SecretScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPropertiesParams
-
-	// ------------- Optional query parameter "search" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProperties(w, r, params)
+		siw.Handler.ListWebhooks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// AddProperty operation middleware
-func (siw *ServerInterfaceWrapper) AddProperty(w http.ResponseWriter, r *http.Request) {
+// AddWebhook operation middleware
+func (siw *ServerInterfaceWrapper) AddWebhook(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, KeyScopes, []string{})
 
 	ctx = context.WithValue(ctx, SecretScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddProperty(w, r)
+		siw.Handler.AddWebhook(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DeleteProperty operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProperty(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetWebhookDelivery operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDelivery(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "propertyID" -------------
-	var propertyID string
+	// ------------- Path parameter "deliveryID" -------------
+	var deliveryID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryID", chi.URLParam(r, "deliveryID"), &deliveryID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryID", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, KeyScopes, []string{})
 
 	ctx = context.WithValue(ctx, SecretScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProperty(w, r, propertyID)
+		siw.Handler.GetWebhookDelivery(w, r, deliveryID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetPropertyById operation middleware
-func (siw *ServerInterfaceWrapper) GetPropertyById(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// RedeliverWebhook operation middleware
+func (siw *ServerInterfaceWrapper) RedeliverWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "propertyID" -------------
-	var propertyID string
+	// ------------- Path parameter "deliveryID" -------------
+	var deliveryID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryID", chi.URLParam(r, "deliveryID"), &deliveryID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryID", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, KeyScopes, []string{})
 
 	ctx = context.WithValue(ctx, SecretScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPropertyById(w, r, propertyID)
+		siw.Handler.RedeliverWebhook(w, r, deliveryID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// StoreProperty operation middleware
-func (siw *ServerInterfaceWrapper) StoreProperty(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "propertyID" -------------
-	var propertyID string
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "propertyID", chi.URLParam(r, "propertyID"), &propertyID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", chi.URLParam(r, "webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "propertyID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, KeyScopes, []string{})
 
 	ctx = context.WithValue(ctx, SecretScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StoreProperty(w, r, propertyID)
+		siw.Handler.DeleteWebhook(w, r, webhookID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ListTenants operation middleware
-func (siw *ServerInterfaceWrapper) ListTenants(w http.ResponseWriter, r *http.Request) {
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", chi.URLParam(r, "webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, KeyScopes, []string{})
 
 	ctx = context.WithValue(ctx, SecretScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTenants(w, r)
+		siw.Handler.GetWebhook(w, r, webhookID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// AddTenant operation middleware
-func (siw *ServerInterfaceWrapper) AddTenant(w http.ResponseWriter, r *http.Request) {
+// StoreWebhook operation middleware
+func (siw *ServerInterfaceWrapper) StoreWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", chi.URLParam(r, "webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, KeyScopes, []string{})
 
 	ctx = context.WithValue(ctx, SecretScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddTenant(w, r)
+		siw.Handler.StoreWebhook(w, r, webhookID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetTenant operation middleware
-func (siw *ServerInterfaceWrapper) GetTenant(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "tenantID" -------------
-	var tenantID string
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tenantID", chi.URLParam(r, "tenantID"), &tenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", chi.URLParam(r, "webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, KeyScopes, []string{})
 
 	ctx = context.WithValue(ctx, SecretScopes, []string{})
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTenant(w, r, tenantID)
-	}))
+	r = r.WithContext(ctx)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "status" -------------
 
-// StoreTenant operation middleware
-func (siw *ServerInterfaceWrapper) StoreTenant(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "sort" -------------
 
-	// ------------- Path parameter "tenantID" -------------
-	var tenantID string
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tenantID", chi.URLParam(r, "tenantID"), &tenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenantID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, KeyScopes, []string{})
+	// ------------- Optional query parameter "cursor" -------------
 
-	ctx = context.WithValue(ctx, SecretScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StoreTenant(w, r, tenantID)
+		siw.Handler.ListWebhookDeliveries(w, r, webhookID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 type UnescapedCookieParamError struct {
@@ -557,6 +3376,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/outbox", wrapper.ListOutbox)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/outbox/{messageID}", wrapper.GetOutboxMessage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/outbox/{messageID}/replay", wrapper.ReplayOutboxMessage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/application", wrapper.ListApplications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application", wrapper.SubmitApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/application/{applicationID}", wrapper.GetApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationID}/convert", wrapper.ConvertApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/application/{applicationID}/screening", wrapper.ListScreeningReports)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationID}/screening", wrapper.ScreenApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationID}/status", wrapper.UpdateApplicationStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.ListAudit)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/lease", wrapper.ListLeases)
 	})
@@ -566,6 +3418,57 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/lease/{leaseID}", wrapper.GetLease)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lease/{leaseID}/amend", wrapper.AmendLease)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/lease/{leaseID}/balance", wrapper.GetBalance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/lease/{leaseID}/deposit", wrapper.GetDeposit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lease/{leaseID}/deposit/disposition", wrapper.DisposeDeposit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lease/{leaseID}/deposit/receipt", wrapper.RecordDepositReceipt)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/lease/{leaseID}/deposit/statement", wrapper.GetDepositStatement)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/lease/{leaseID}/latefee", wrapper.AssessLateFees)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lease/{leaseID}/latefee", wrapper.ApplyLateFees)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/lease/{leaseID}/latefee/policy", wrapper.GetLateFeePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/lease/{leaseID}/latefee/policy", wrapper.StoreLeaseLateFeePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/lease/{leaseID}/ledger", wrapper.GetStatement)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lease/{leaseID}/ledger", wrapper.PostLedgerEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lease/{leaseID}/ledger/{entryID}/reverse", wrapper.ReverseLedgerEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lease/{leaseID}/renew", wrapper.RenewLease)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/lease/{leaseID}/schedule", wrapper.GetRentSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/lease/{leaseID}/terminate", wrapper.TerminateLease)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/listings", wrapper.ListPublicListings)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/property", wrapper.ListProperties)
 	})
@@ -581,18 +3484,99 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/property/{propertyID}", wrapper.StoreProperty)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/property/{propertyID}/latefee/policy", wrapper.StorePropertyLateFeePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/property/{propertyID}/listing", wrapper.GetListing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/property/{propertyID}/listing", wrapper.StoreListing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/property/{propertyID}/listing/publish", wrapper.PublishListing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/property/{propertyID}/listing/unpublish", wrapper.UnpublishListing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/property/{propertyID}/restore", wrapper.RestoreProperty)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/property/{propertyID}/screening/policy", wrapper.GetScreeningPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/property/{propertyID}/screening/policy", wrapper.StoreScreeningPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/property/{propertyID}/unit", wrapper.ListUnits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/property/{propertyID}/unit", wrapper.AddUnit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/property/{propertyID}/unit/{unitID}", wrapper.DeleteUnit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/property/{propertyID}/unit/{unitID}", wrapper.GetUnit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/property/{propertyID}/unit/{unitID}", wrapper.StoreUnit)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/tenant", wrapper.ListTenants)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/tenant", wrapper.AddTenant)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tenant/{tenantID}", wrapper.DeleteTenant)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/tenant/{tenantID}", wrapper.GetTenant)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/tenant/{tenantID}", wrapper.PatchTenant)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/tenant/{tenantID}", wrapper.StoreTenant)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tenant/{tenantID}/phone", wrapper.AddTenantPhone)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tenant/{tenantID}/phone/{number}", wrapper.RemoveTenantPhone)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/tenant/{tenantID}/phone/{number}", wrapper.UpdateTenantPhone)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tenant/{tenantID}/restore", wrapper.RestoreTenant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook", wrapper.AddWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook/delivery/{deliveryID}", wrapper.GetWebhookDelivery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook/delivery/{deliveryID}/redeliver", wrapper.RedeliverWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhook/{webhookID}", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook/{webhookID}", wrapper.GetWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/webhook/{webhookID}", wrapper.StoreWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook/{webhookID}/delivery", wrapper.ListWebhookDeliveries)
+	})
 
 	return r
 }
@@ -1,9 +1,11 @@
 // Package openapi provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.1.0 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package openapi
 
 import (
+	"time"
+
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -12,6 +14,44 @@ const (
 	SecretScopes = "secret.Scopes"
 )
 
+// Defines values for ApplicationStatus.
+const (
+	Approved    ApplicationStatus = "approved"
+	Denied      ApplicationStatus = "denied"
+	Submitted   ApplicationStatus = "submitted"
+	UnderReview ApplicationStatus = "under_review"
+	Withdrawn   ApplicationStatus = "withdrawn"
+)
+
+// Defines values for AuditEntryAction.
+const (
+	AuditCreate  AuditEntryAction = "create"
+	AuditDelete  AuditEntryAction = "delete"
+	AuditRestore AuditEntryAction = "restore"
+	AuditUpdate  AuditEntryAction = "update"
+)
+
+// Defines values for AuditEntryTransport.
+const (
+	TransportGRPC AuditEntryTransport = "grpc"
+	TransportREST AuditEntryTransport = "rest"
+)
+
+// Defines values for DeductionCategory.
+const (
+	Cleaning   DeductionCategory = "cleaning"
+	Damage     DeductionCategory = "damage"
+	Other      DeductionCategory = "other"
+	UnpaidRent DeductionCategory = "unpaid_rent"
+)
+
+// Defines values for DepositAccountStatus.
+const (
+	Disposed DepositAccountStatus = "disposed"
+	Due      DepositAccountStatus = "due"
+	Held     DepositAccountStatus = "held"
+)
+
 // Defines values for LeaseRentInterval.
 const (
 	LeaseRentIntervalDaily   LeaseRentInterval = "daily"
@@ -19,6 +59,28 @@ const (
 	LeaseRentIntervalWeekly  LeaseRentInterval = "weekly"
 )
 
+// Defines values for LeaseVersionChange.
+const (
+	Amended    LeaseVersionChange = "amended"
+	Created    LeaseVersionChange = "created"
+	Renewed    LeaseVersionChange = "renewed"
+	Terminated LeaseVersionChange = "terminated"
+)
+
+// Defines values for LedgerEntryType.
+const (
+	LedgerEntryTypeCharge  LedgerEntryType = "charge"
+	LedgerEntryTypeCredit  LedgerEntryType = "credit"
+	LedgerEntryTypePayment LedgerEntryType = "payment"
+	LedgerEntryTypeRefund  LedgerEntryType = "refund"
+)
+
+// Defines values for ListingStatus.
+const (
+	Draft     ListingStatus = "draft"
+	Published ListingStatus = "published"
+)
+
 // Defines values for MinLeaseRentInterval.
 const (
 	MinLeaseRentIntervalDaily   MinLeaseRentInterval = "daily"
@@ -26,19 +88,263 @@ const (
 	MinLeaseRentIntervalWeekly  MinLeaseRentInterval = "weekly"
 )
 
+// Defines values for MinLedgerEntryType.
+const (
+	MinLedgerEntryTypeCharge  MinLedgerEntryType = "charge"
+	MinLedgerEntryTypeCredit  MinLedgerEntryType = "credit"
+	MinLedgerEntryTypePayment MinLedgerEntryType = "payment"
+	MinLedgerEntryTypeRefund  MinLedgerEntryType = "refund"
+)
+
+// Defines values for OutboxStatus.
+const (
+	Dead      OutboxStatus = "dead"
+	Delivered OutboxStatus = "delivered"
+	Pending   OutboxStatus = "pending"
+)
+
+// Defines values for ScreeningReportResult.
+const (
+	ScreeningReportResultFail        ScreeningReportResult = "fail"
+	ScreeningReportResultNeedsReview ScreeningReportResult = "needs_review"
+	ScreeningReportResultPass        ScreeningReportResult = "pass"
+)
+
+// Defines values for ScreeningRuleAction.
+const (
+	ScreeningRuleActionFail   ScreeningRuleAction = "fail"
+	ScreeningRuleActionReview ScreeningRuleAction = "review"
+)
+
+// Defines values for ScreeningRuleCriterion.
+const (
+	MaxVehicles       ScreeningRuleCriterion = "max_vehicles"
+	MinIncome         ScreeningRuleCriterion = "min_income"
+	NoBankruptcy      ScreeningRuleCriterion = "no_bankruptcy"
+	NoCrimeConviction ScreeningRuleCriterion = "no_crime_conviction"
+	NoPets            ScreeningRuleCriterion = "no_pets"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	DeliveryDead      WebhookDeliveryStatus = "dead"
+	DeliveryDelivered WebhookDeliveryStatus = "delivered"
+	DeliveryPending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for ListAuditParamsEntity.
+const (
+	ListAuditParamsEntityLease    ListAuditParamsEntity = "lease"
+	ListAuditParamsEntityProperty ListAuditParamsEntity = "property"
+	ListAuditParamsEntityTenant   ListAuditParamsEntity = "tenant"
+)
+
 // Address defines model for Address.
 type Address struct {
-	City   string `json:"city"`
+	City string `json:"city"`
+
+	// Country ISO 3166-1 alpha-2 code, US when omitted
+	Country *string `json:"country,omitempty"`
+
+	// State USPS state code when the country is US
 	State  string `json:"state"`
 	Street string `json:"street"`
-	Zip    string `json:"zip"`
+
+	// Unit apartment, suite or other unit line, omitted when the address is the whole building
+	Unit *string `json:"unit,omitempty"`
+
+	// Zip 5 digits or a ZIP+4 when the country is US
+	Zip string `json:"zip"`
+}
+
+// AmendLeaseReq defines model for AmendLeaseReq.
+type AmendLeaseReq struct {
+	AddTenantIDs *[]string `json:"addTenantIDs,omitempty"`
+
+	// EffectiveDate first day the amended terms apply, must be within the current term
+	EffectiveDate   openapi_types.Date `json:"effectiveDate"`
+	Reason          *string            `json:"reason,omitempty"`
+	RemoveTenantIDs *[]string          `json:"removeTenantIDs,omitempty"`
+	RentAmount      *Money             `json:"rentAmount,omitempty"`
+}
+
+// Applicant defines model for Applicant.
+type Applicant struct {
+	BankruptcyDesc  *string `json:"bankruptcyDesc,omitempty"`
+	BankruptcyFiled *bool   `json:"bankruptcyFiled,omitempty"`
+	CrimeConviction *bool   `json:"crimeConviction,omitempty"`
+	CrimeDesc       *string `json:"crimeDesc,omitempty"`
+
+	// DlNum masked as *****3153 unless the request was made with the pii credentials
+	DlNum   *string `json:"dlNum,omitempty"`
+	DlState *string `json:"dlState,omitempty"`
+
+	// Dob 0001-01-01 unless the request was made with the pii credentials
+	Dob           openapi_types.Date `json:"dob"`
+	FullName      string             `json:"fullName"`
+	HasPets       *bool              `json:"hasPets,omitempty"`
+	MonthlyIncome *Money             `json:"monthlyIncome,omitempty"`
+	PetsDesc      *string            `json:"petsDesc,omitempty"`
+
+	// Ssn masked as ***-**-6789 unless the request was made with the pii credentials
+	Ssn          *string `json:"ssn,omitempty"`
+	VehicleCount *int    `json:"vehicleCount,omitempty"`
+	VehicleDesc  *string `json:"vehicleDesc,omitempty"`
+}
+
+// Application defines model for Application.
+type Application struct {
+	Applicants  []Applicant        `json:"applicants"`
+	Id          string             `json:"id"`
+	MoveInDate  openapi_types.Date `json:"moveInDate"`
+	Notes       []ApplicationNote  `json:"notes"`
+	PropertyID  string             `json:"propertyID"`
+	Status      ApplicationStatus  `json:"status"`
+	SubmittedAt time.Time          `json:"submittedAt"`
+
+	// TenantIDs the tenants created from the applicants once converted
+	TenantIDs *[]string `json:"tenantIDs,omitempty"`
+}
+
+// ApplicationConversion defines model for ApplicationConversion.
+type ApplicationConversion struct {
+	Application Application `json:"application"`
+	Lease       Lease       `json:"lease"`
+	Tenants     []Tenant    `json:"tenants"`
+}
+
+// ApplicationList defines model for ApplicationList.
+type ApplicationList struct {
+	Applications []Application `json:"applications"`
+
+	// NextCursor cursor of the next page, only sent when there may be one
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// ApplicationNote defines model for ApplicationNote.
+type ApplicationNote struct {
+	CreatedAt time.Time         `json:"createdAt"`
+	Note      string            `json:"note"`
+	Status    ApplicationStatus `json:"status"`
+}
+
+// ApplicationRes defines model for ApplicationRes.
+type ApplicationRes struct {
+	Application Application `json:"application"`
+}
+
+// ApplicationStatus defines model for ApplicationStatus.
+type ApplicationStatus string
+
+// ApplyLateFeesReq defines model for ApplyLateFeesReq.
+type ApplyLateFeesReq struct {
+	// AsOf defaults to today and can not be in the future
+	AsOf *openapi_types.Date `json:"asOf,omitempty"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action AuditEntryAction `json:"action"`
+
+	// Actor the api key the change was made with
+	Actor     *string   `json:"actor,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Diff the old and new value of every field which changed
+	Diff       map[string]interface{} `json:"diff"`
+	EntityID   string                 `json:"entityID"`
+	EntityType string                 `json:"entityType"`
+	Id         string                 `json:"id"`
+	Transport  *AuditEntryTransport   `json:"transport,omitempty"`
+}
+
+// AuditEntryAction defines model for AuditEntry.Action.
+type AuditEntryAction string
+
+// AuditEntryTransport defines model for AuditEntry.Transport.
+type AuditEntryTransport string
+
+// AuditList defines model for AuditList.
+type AuditList struct {
+	Entries []AuditEntry `json:"entries"`
+
+	// NextCursor cursor of the next page, only sent when there may be one
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// Balance defines model for Balance.
+type Balance struct {
+	AsOf    *openapi_types.Date `json:"asOf,omitempty"`
+	Balance Money               `json:"balance"`
+	LeaseID string              `json:"leaseID"`
+}
+
+// Deduction defines model for Deduction.
+type Deduction struct {
+	Amount      Money             `json:"amount"`
+	Category    DeductionCategory `json:"category"`
+	Description string            `json:"description"`
+}
+
+// DeductionCategory defines model for Deduction.Category.
+type DeductionCategory string
+
+// DepositAccount defines model for DepositAccount.
+type DepositAccount struct {
+	Disposition *DepositDisposition  `json:"disposition,omitempty"`
+	Held        Money                `json:"held"`
+	LeaseID     string               `json:"leaseID"`
+	Receipts    []DepositReceipt     `json:"receipts"`
+	Required    Money                `json:"required"`
+	Status      DepositAccountStatus `json:"status"`
+}
+
+// DepositAccountStatus defines model for DepositAccount.Status.
+type DepositAccountStatus string
+
+// DepositDisposition defines model for DepositDisposition.
+type DepositDisposition struct {
+	Deductions  []Deduction        `json:"deductions"`
+	Held        Money              `json:"held"`
+	Id          string             `json:"id"`
+	LeaseID     string             `json:"leaseID"`
+	MoveOutDate openapi_types.Date `json:"moveOutDate"`
+	Owed        Money              `json:"owed"`
+	Refund      Money              `json:"refund"`
+}
+
+// DepositDispositionRes defines model for DepositDispositionRes.
+type DepositDispositionRes struct {
+	Disposition DepositDisposition `json:"disposition"`
+}
+
+// DepositReceipt defines model for DepositReceipt.
+type DepositReceipt struct {
+	Amount  Money              `json:"amount"`
+	Date    openapi_types.Date `json:"date"`
+	Id      string             `json:"id"`
+	LeaseID string             `json:"leaseID"`
+	Memo    *string            `json:"memo,omitempty"`
+}
+
+// DepositReceiptRes defines model for DepositReceiptRes.
+type DepositReceiptRes struct {
+	Receipt DepositReceipt `json:"receipt"`
+}
+
+// DisposeDepositReq defines model for DisposeDepositReq.
+type DisposeDepositReq struct {
+	Disposition MinDepositDisposition `json:"disposition"`
 }
 
 // Error defines model for Error.
 type Error struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-	Type    string `json:"type"`
+	Code int32 `json:"code"`
+
+	// Fields every invalid field when type is validation
+	Fields  *[]FieldError `json:"fields,omitempty"`
+	Message string        `json:"message"`
+	Type    string        `json:"type"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
@@ -46,9 +352,17 @@ type ErrorResponse struct {
 	Error Error `json:"error"`
 }
 
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
 // GetLeaseRes defines model for GetLeaseRes.
 type GetLeaseRes struct {
-	Lease Lease `json:"lease"`
+	// History only returned by getLease
+	History *[]LeaseVersion `json:"history,omitempty"`
+	Lease   Lease           `json:"lease"`
 }
 
 // GetPropertyRes defines model for GetPropertyRes.
@@ -61,18 +375,59 @@ type GetTenantRes struct {
 	Tenant Tenant `json:"tenant"`
 }
 
+// LateFee defines model for LateFee.
+type LateFee struct {
+	Amount   Money              `json:"amount"`
+	DaysLate int                `json:"daysLate"`
+	DueDate  openapi_types.Date `json:"dueDate"`
+
+	// Ref ref of the ledger entries charged for this fee
+	Ref    string `json:"ref"`
+	Unpaid Money  `json:"unpaid"`
+}
+
+// LateFeeList defines model for LateFeeList.
+type LateFeeList struct {
+	Fees []LateFee `json:"fees"`
+}
+
+// LateFeePolicy defines model for LateFeePolicy.
+type LateFeePolicy struct {
+	DailyFee Money `json:"dailyFee"`
+	FlatFee  Money `json:"flatFee"`
+
+	// GraceDays days after the due date before rent is late
+	GraceDays  int     `json:"graceDays"`
+	Id         string  `json:"id"`
+	LeaseID    *string `json:"leaseID,omitempty"`
+	MaxFee     Money   `json:"maxFee"`
+	PropertyID *string `json:"propertyID,omitempty"`
+
+	// RentPercent basis points of the late payment, 500 is 5%
+	RentPercent int `json:"rentPercent"`
+}
+
+// LateFeePolicyRes defines model for LateFeePolicyRes.
+type LateFeePolicyRes struct {
+	Policy LateFeePolicy `json:"policy"`
+}
+
 // Lease defines model for Lease.
 type Lease struct {
-	// Currency will default to USD when empty
-	Currency     string             `json:"currency"`
-	Deposit      int                `json:"deposit"`
-	EndDate      openapi_types.Date `json:"endDate"`
-	Id           string             `json:"id"`
-	PropertyID   string             `json:"propertyID"`
-	RentAmount   *int               `json:"rentAmount,omitempty"`
+	Deposit    Money              `json:"deposit"`
+	EndDate    openapi_types.Date `json:"endDate"`
+	Id         string             `json:"id"`
+	PropertyID string             `json:"propertyID"`
+
+	// RenewsID the lease this one renews
+	RenewsID     *string            `json:"renewsID,omitempty"`
+	RentAmount   Money              `json:"rentAmount"`
 	RentInterval LeaseRentInterval  `json:"rentInterval"`
 	StartDate    openapi_types.Date `json:"startDate"`
-	TenantIDs    *[]string          `json:"tenantIDs,omitempty"`
+	TenantIDs    []string           `json:"tenantIDs"`
+
+	// UnitID the unit of the property leased, required when the property has more than one
+	UnitID *string `json:"unitID,omitempty"`
 }
 
 // LeaseRentInterval defines model for Lease.RentInterval.
@@ -81,6 +436,9 @@ type LeaseRentInterval string
 // LeaseList defines model for LeaseList.
 type LeaseList struct {
 	Leases []Lease `json:"leases"`
+
+	// NextCursor cursor of the next page, only sent when there may be one
+	NextCursor *string `json:"nextCursor,omitempty"`
 }
 
 // LeasePropertyReq defines model for LeasePropertyReq.
@@ -88,55 +446,436 @@ type LeasePropertyReq struct {
 	Lease MinLease `json:"lease"`
 }
 
+// LeaseVersion defines model for LeaseVersion.
+type LeaseVersion struct {
+	Change LeaseVersionChange `json:"change"`
+
+	// EffectiveDate first day the terms apply, or the last day of a terminated lease
+	EffectiveDate openapi_types.Date `json:"effectiveDate"`
+	Lease         Lease              `json:"lease"`
+	LeaseID       string             `json:"leaseID"`
+	Reason        *string            `json:"reason,omitempty"`
+	Version       int                `json:"version"`
+}
+
+// LeaseVersionChange defines model for LeaseVersion.Change.
+type LeaseVersionChange string
+
+// LedgerEntry defines model for LedgerEntry.
+type LedgerEntry struct {
+	Amount  Money              `json:"amount"`
+	Date    openapi_types.Date `json:"date"`
+	Id      string             `json:"id"`
+	LeaseID string             `json:"leaseID"`
+	Memo    *string            `json:"memo,omitempty"`
+
+	// Ref what the entry is for, ex: the rent due date a late fee was charged for
+	Ref *string `json:"ref,omitempty"`
+
+	// ReversesID set when this entry cancels an earlier one
+	ReversesID *string         `json:"reversesID,omitempty"`
+	Type       LedgerEntryType `json:"type"`
+}
+
+// LedgerEntryType defines model for LedgerEntry.Type.
+type LedgerEntryType string
+
+// LedgerEntryList defines model for LedgerEntryList.
+type LedgerEntryList struct {
+	Entries []LedgerEntry `json:"entries"`
+}
+
+// LedgerEntryRes defines model for LedgerEntryRes.
+type LedgerEntryRes struct {
+	Entry LedgerEntry `json:"entry"`
+}
+
 // ListPropertiesRes defines model for ListPropertiesRes.
 type ListPropertiesRes struct {
-	Filter     *PropertyFilter `json:"filter,omitempty"`
-	Properties []Property      `json:"properties"`
+	Filter *PropertyFilter `json:"filter,omitempty"`
+
+	// NextCursor cursor of the next page, only sent when there may be one
+	NextCursor *string    `json:"nextCursor,omitempty"`
+	Properties []Property `json:"properties"`
+}
+
+// Listing defines model for Listing.
+type Listing struct {
+	AvailableFrom *openapi_types.Date `json:"availableFrom,omitempty"`
+	Description   *string             `json:"description,omitempty"`
+	Details       *RentalDetails      `json:"details,omitempty"`
+	Id            string              `json:"id"`
+
+	// Photos in the order they are shown, the first is the cover
+	Photos      *[]ListingPhoto `json:"photos,omitempty"`
+	PropertyID  string          `json:"propertyID"`
+	PublishedAt *time.Time      `json:"publishedAt,omitempty"`
+	Rent        *Money          `json:"rent,omitempty"`
+	Status      ListingStatus   `json:"status"`
+	UpdatedAt   *time.Time      `json:"updatedAt,omitempty"`
+}
+
+// ListingStatus defines model for Listing.Status.
+type ListingStatus string
+
+// ListingPhoto defines model for ListingPhoto.
+type ListingPhoto struct {
+	Caption *string `json:"caption,omitempty"`
+
+	// Height pixels
+	Height *int   `json:"height,omitempty"`
+	Url    string `json:"url"`
+
+	// Width pixels
+	Width *int `json:"width,omitempty"`
+}
+
+// ListingRes defines model for ListingRes.
+type ListingRes struct {
+	Listing Listing `json:"listing"`
+}
+
+// MinApplication defines model for MinApplication.
+type MinApplication struct {
+	Applicants []Applicant        `json:"applicants"`
+	MoveInDate openapi_types.Date `json:"moveInDate"`
+	PropertyID string             `json:"propertyID"`
+}
+
+// MinDepositDisposition defines model for MinDepositDisposition.
+type MinDepositDisposition struct {
+	Deductions  []Deduction        `json:"deductions"`
+	MoveOutDate openapi_types.Date `json:"moveOutDate"`
+}
+
+// MinDepositReceipt defines model for MinDepositReceipt.
+type MinDepositReceipt struct {
+	Amount Money              `json:"amount"`
+	Date   openapi_types.Date `json:"date"`
+	Memo   *string            `json:"memo,omitempty"`
+}
+
+// MinLateFeePolicy the fees are in the lease currency, dailyFee is charged for every day unpaid after the grace period and a zero maxFee is no cap
+type MinLateFeePolicy struct {
+	DailyFee Money `json:"dailyFee"`
+	FlatFee  Money `json:"flatFee"`
+
+	// GraceDays days after the due date before rent is late
+	GraceDays int   `json:"graceDays"`
+	MaxFee    Money `json:"maxFee"`
+
+	// RentPercent basis points of the late payment, 500 is 5%
+	RentPercent int `json:"rentPercent"`
 }
 
 // MinLease defines model for MinLease.
 type MinLease struct {
-	// Currency will default to USD when empty
-	Currency     string               `json:"currency"`
-	Deposit      int                  `json:"deposit"`
+	Deposit      Money                `json:"deposit"`
 	EndDate      openapi_types.Date   `json:"endDate"`
 	PropertyID   string               `json:"propertyID"`
-	RentAmount   *int                 `json:"rentAmount,omitempty"`
+	RentAmount   Money                `json:"rentAmount"`
 	RentInterval MinLeaseRentInterval `json:"rentInterval"`
 	StartDate    openapi_types.Date   `json:"startDate"`
-	TenantIDs    *[]string            `json:"tenantIDs,omitempty"`
+	TenantIDs    []string             `json:"tenantIDs"`
+
+	// UnitID the unit of the property leased, required when the property has more than one
+	UnitID *string `json:"unitID,omitempty"`
 }
 
 // MinLeaseRentInterval defines model for MinLease.RentInterval.
 type MinLeaseRentInterval string
 
+// MinLedgerEntry defines model for MinLedgerEntry.
+type MinLedgerEntry struct {
+	Amount Money              `json:"amount"`
+	Date   openapi_types.Date `json:"date"`
+	Memo   *string            `json:"memo,omitempty"`
+	Ref    *string            `json:"ref,omitempty"`
+	Type   MinLedgerEntryType `json:"type"`
+}
+
+// MinLedgerEntryType defines model for MinLedgerEntry.Type.
+type MinLedgerEntryType string
+
+// MinListing defines model for MinListing.
+type MinListing struct {
+	AvailableFrom *openapi_types.Date `json:"availableFrom,omitempty"`
+	Description   *string             `json:"description,omitempty"`
+	Details       *RentalDetails      `json:"details,omitempty"`
+
+	// Photos in the order they are shown, the first is the cover
+	Photos *[]ListingPhoto `json:"photos,omitempty"`
+	Rent   *Money          `json:"rent,omitempty"`
+}
+
+// MinScreeningPolicy defines model for MinScreeningPolicy.
+type MinScreeningPolicy struct {
+	Rent  *Money          `json:"rent,omitempty"`
+	Rules []ScreeningRule `json:"rules"`
+}
+
 // MinTenant defines model for MinTenant.
 type MinTenant struct {
-	DlNum    string             `json:"dlNum"`
-	DlState  string             `json:"dlState"`
+	// DlNum masked as *****3153 unless the request was made with the pii credentials
+	DlNum   string `json:"dlNum"`
+	DlState string `json:"dlState"`
+
+	// Dob 0001-01-01 unless the request was made with the pii credentials
 	Dob      openapi_types.Date `json:"dob"`
 	FullName string             `json:"fullName"`
 	Phones   []Phone            `json:"phones"`
 }
 
+// MinUnit defines model for MinUnit.
+type MinUnit struct {
+	// Baths a half bath counts as 0.5
+	Baths *float64 `json:"baths,omitempty"`
+	Beds  *int     `json:"beds,omitempty"`
+
+	// Number the number or letter of the unit within the property, empty for the only unit of a house
+	Number     *string `json:"number,omitempty"`
+	RentTarget *Money  `json:"rentTarget,omitempty"`
+	Sqft       *int    `json:"sqft,omitempty"`
+}
+
+// Money defines model for Money.
+type Money struct {
+	// Amount minor units of the currency, 125050 is 1,250.50 USD
+	Amount int `json:"amount"`
+
+	// Currency ISO 4217 currency code
+	Currency string `json:"currency"`
+}
+
+// OutboxMessage defines model for OutboxMessage.
+type OutboxMessage struct {
+	Attempts      int                    `json:"attempts"`
+	CreatedAt     time.Time              `json:"createdAt"`
+	DeliveredAt   *time.Time             `json:"deliveredAt,omitempty"`
+	Id            string                 `json:"id"`
+	LastError     *string                `json:"lastError,omitempty"`
+	Name          string                 `json:"name"`
+	NextAttemptAt *time.Time             `json:"nextAttemptAt,omitempty"`
+	Payload       map[string]interface{} `json:"payload"`
+	Status        OutboxStatus           `json:"status"`
+}
+
+// OutboxMessageList defines model for OutboxMessageList.
+type OutboxMessageList struct {
+	Messages []OutboxMessage `json:"messages"`
+
+	// NextCursor cursor of the next page, only sent when there may be one
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// OutboxMessageRes defines model for OutboxMessageRes.
+type OutboxMessageRes struct {
+	Message OutboxMessage `json:"message"`
+}
+
+// OutboxStatus defines model for OutboxStatus.
+type OutboxStatus string
+
+// PatchTenantReq JSON merge patch of a tenant, only the fields present are changed and null removes a field
+type PatchTenantReq struct {
+	DlNum    *string             `json:"dlNum"`
+	DlState  *string             `json:"dlState"`
+	Dob      *openapi_types.Date `json:"dob,omitempty"`
+	FullName *string             `json:"fullName,omitempty"`
+	Phones   *[]Phone            `json:"phones"`
+}
+
 // Phone defines model for Phone.
 type Phone struct {
 	Desc   string `json:"desc"`
 	Number string `json:"number"`
 }
 
+// PostLedgerEntryReq defines model for PostLedgerEntryReq.
+type PostLedgerEntryReq struct {
+	Entry MinLedgerEntry `json:"entry"`
+}
+
 // Property defines model for Property.
 type Property struct {
-	City   string `json:"city"`
-	Id     string `json:"id"`
+	City string `json:"city"`
+
+	// Country ISO 3166-1 alpha-2 code, US when omitted
+	Country *string `json:"country,omitempty"`
+
+	// DeletedAt when the property was removed, only listed when removed ones are included
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	Id        string     `json:"id"`
+
+	// Rank relevance to the search of a list from 0 to 1, a search is ordered by it unless another sort is given
+	Rank *float64 `json:"rank,omitempty"`
+
+	// State USPS state code when the country is US
 	State  string `json:"state"`
 	Street string `json:"street"`
-	Zip    string `json:"zip"`
+
+	// Unit apartment, suite or other unit line, omitted when the address is the whole building
+	Unit *string `json:"unit,omitempty"`
+
+	// Zip 5 digits or a ZIP+4 when the country is US
+	Zip string `json:"zip"`
 }
 
 // PropertyFilter defines model for PropertyFilter.
 type PropertyFilter struct {
-	Search *string `json:"search,omitempty"`
+	IncludeDeleted *bool   `json:"includeDeleted,omitempty"`
+	Search         *string `json:"search,omitempty"`
+}
+
+// PublicListing defines model for PublicListing.
+type PublicListing struct {
+	Listing  Listing  `json:"listing"`
+	Property Property `json:"property"`
+}
+
+// PublicListingList defines model for PublicListingList.
+type PublicListingList struct {
+	Listings []PublicListing `json:"listings"`
+
+	// NextCursor cursor of the next page, only sent when there may be one
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// RecordDepositReceiptReq defines model for RecordDepositReceiptReq.
+type RecordDepositReceiptReq struct {
+	Receipt MinDepositReceipt `json:"receipt"`
+}
+
+// RenewLeaseReq defines model for RenewLeaseReq.
+type RenewLeaseReq struct {
+	// EndDate last day of the new term, it starts the day after the lease ends
+	EndDate    openapi_types.Date `json:"endDate"`
+	Reason     *string            `json:"reason,omitempty"`
+	RentAmount *Money             `json:"rentAmount,omitempty"`
+}
+
+// RentDue defines model for RentDue.
+type RentDue struct {
+	Amount      Money              `json:"amount"`
+	DueDate     openapi_types.Date `json:"dueDate"`
+	PeriodEnd   openapi_types.Date `json:"periodEnd"`
+	PeriodStart openapi_types.Date `json:"periodStart"`
+	Prorated    bool               `json:"prorated"`
+}
+
+// RentSchedule defines model for RentSchedule.
+type RentSchedule struct {
+	Payments []RentDue `json:"payments"`
+}
+
+// RentalDetails defines model for RentalDetails.
+type RentalDetails struct {
+	AllowPets     *bool   `json:"allowPets,omitempty"`
+	AllowSmoking  *bool   `json:"allowSmoking,omitempty"`
+	ParkingDesc   *string `json:"parkingDesc,omitempty"`
+	ParkingSpaces *int    `json:"parkingSpaces,omitempty"`
+}
+
+// ReverseLedgerEntryReq defines model for ReverseLedgerEntryReq.
+type ReverseLedgerEntryReq struct {
+	Date openapi_types.Date `json:"date"`
+	Memo *string            `json:"memo,omitempty"`
+}
+
+// ScreeningFinding defines model for ScreeningFinding.
+type ScreeningFinding struct {
+	// Applicant the applicant the rule fired for, empty when it applies to all applicants together
+	Applicant *string       `json:"applicant,omitempty"`
+	Reason    string        `json:"reason"`
+	Rule      ScreeningRule `json:"rule"`
+}
+
+// ScreeningPolicy defines model for ScreeningPolicy.
+type ScreeningPolicy struct {
+	Id         string          `json:"id"`
+	PropertyID string          `json:"propertyID"`
+	Rent       *Money          `json:"rent,omitempty"`
+	Rules      []ScreeningRule `json:"rules"`
+	UpdatedAt  *time.Time      `json:"updatedAt,omitempty"`
+}
+
+// ScreeningPolicyRes defines model for ScreeningPolicyRes.
+type ScreeningPolicyRes struct {
+	Policy ScreeningPolicy `json:"policy"`
+}
+
+// ScreeningReport defines model for ScreeningReport.
+type ScreeningReport struct {
+	ApplicationID string    `json:"applicationID"`
+	CreatedAt     time.Time `json:"createdAt"`
+
+	// Findings one for every time a rule fired
+	Findings   []ScreeningFinding    `json:"findings"`
+	Id         string                `json:"id"`
+	PropertyID string                `json:"propertyID"`
+	Rent       *Money                `json:"rent,omitempty"`
+	Result     ScreeningReportResult `json:"result"`
+
+	// Rules the rules which were applied
+	Rules []ScreeningRule `json:"rules"`
+}
+
+// ScreeningReportResult defines model for ScreeningReport.Result.
+type ScreeningReportResult string
+
+// ScreeningReportList defines model for ScreeningReportList.
+type ScreeningReportList struct {
+	// NextCursor cursor of the next page, only sent when there may be one
+	NextCursor *string           `json:"nextCursor,omitempty"`
+	Reports    []ScreeningReport `json:"reports"`
+}
+
+// ScreeningReportRes defines model for ScreeningReportRes.
+type ScreeningReportRes struct {
+	Report ScreeningReport `json:"report"`
+}
+
+// ScreeningRule defines model for ScreeningRule.
+type ScreeningRule struct {
+	// Action what happens when the rule is not met
+	Action    ScreeningRuleAction    `json:"action"`
+	Criterion ScreeningRuleCriterion `json:"criterion"`
+
+	// Limit total vehicles allowed for max_vehicles, combined monthly income as a percent of rent for min_income, 300 is 3x
+	Limit *int `json:"limit,omitempty"`
+}
+
+// ScreeningRuleAction what happens when the rule is not met
+type ScreeningRuleAction string
+
+// ScreeningRuleCriterion defines model for ScreeningRule.Criterion.
+type ScreeningRuleCriterion string
+
+// Statement defines model for Statement.
+type Statement struct {
+	ClosingBalance Money               `json:"closingBalance"`
+	From           *openapi_types.Date `json:"from,omitempty"`
+	LeaseID        string              `json:"leaseID"`
+	Lines          []StatementLine     `json:"lines"`
+	OpeningBalance Money               `json:"openingBalance"`
+	Until          *openapi_types.Date `json:"until,omitempty"`
+}
+
+// StatementLine defines model for StatementLine.
+type StatementLine struct {
+	Balance Money       `json:"balance"`
+	Entry   LedgerEntry `json:"entry"`
+}
+
+// StoreLateFeePolicyReq defines model for StoreLateFeePolicyReq.
+type StoreLateFeePolicyReq struct {
+	// Policy the fees are in the lease currency, dailyFee is charged for every day unpaid after the grace period and a zero maxFee is no cap
+	Policy MinLateFeePolicy `json:"policy"`
+}
+
+// StoreListingReq defines model for StoreListingReq.
+type StoreListingReq struct {
+	Listing MinListing `json:"listing"`
 }
 
 // StorePropertyReq defines model for StorePropertyReq.
@@ -144,15 +883,41 @@ type StorePropertyReq struct {
 	Property Address `json:"property"`
 }
 
+// StoreScreeningPolicyReq defines model for StoreScreeningPolicyReq.
+type StoreScreeningPolicyReq struct {
+	Policy MinScreeningPolicy `json:"policy"`
+}
+
 // StoreTenantReq defines model for StoreTenantReq.
 type StoreTenantReq struct {
 	Tenant MinTenant `json:"tenant"`
 }
 
+// StoreUnitReq defines model for StoreUnitReq.
+type StoreUnitReq struct {
+	Unit MinUnit `json:"unit"`
+}
+
+// StoreWebhookReq defines model for StoreWebhookReq.
+type StoreWebhookReq struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+// SubmitApplicationReq defines model for SubmitApplicationReq.
+type SubmitApplicationReq struct {
+	Application MinApplication `json:"application"`
+}
+
 // Tenant defines model for Tenant.
 type Tenant struct {
-	DlNum    string             `json:"dlNum"`
-	DlState  string             `json:"dlState"`
+	// DeletedAt when the tenant was removed, only listed when removed ones are included
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
+	// DlNum masked as *****3153 unless the request was made with the pii credentials
+	DlNum   string `json:"dlNum"`
+	DlState string `json:"dlState"`
+
+	// Dob 0001-01-01 unless the request was made with the pii credentials
 	Dob      openapi_types.Date `json:"dob"`
 	FullName string             `json:"fullName"`
 	Id       string             `json:"id"`
@@ -161,26 +926,409 @@ type Tenant struct {
 
 // TenantList defines model for TenantList.
 type TenantList struct {
-	Tenants []Tenant `json:"tenants"`
+	// NextCursor cursor of the next page, only sent when there may be one
+	NextCursor *string  `json:"nextCursor,omitempty"`
+	Tenants    []Tenant `json:"tenants"`
+}
+
+// TerminateLeaseReq defines model for TerminateLeaseReq.
+type TerminateLeaseReq struct {
+	// EndDate last day of the lease, must be within the current term
+	EndDate openapi_types.Date `json:"endDate"`
+	Reason  *string            `json:"reason,omitempty"`
+}
+
+// Unit defines model for Unit.
+type Unit struct {
+	// Baths a half bath counts as 0.5
+	Baths *float64 `json:"baths,omitempty"`
+	Beds  *int     `json:"beds,omitempty"`
+	Id    string   `json:"id"`
+
+	// LeaseID the lease occupying the unit today, omitted when it is vacant
+	LeaseID *string `json:"leaseID,omitempty"`
+
+	// Number the number or letter of the unit within the property, empty for the only unit of a house
+	Number     *string `json:"number,omitempty"`
+	PropertyID string  `json:"propertyID"`
+	RentTarget *Money  `json:"rentTarget,omitempty"`
+	Sqft       *int    `json:"sqft,omitempty"`
+}
+
+// UnitList defines model for UnitList.
+type UnitList struct {
+	Units []Unit `json:"units"`
+}
+
+// UnitRes defines model for UnitRes.
+type UnitRes struct {
+	Unit Unit `json:"unit"`
+}
+
+// UpdateApplicationStatusReq defines model for UpdateApplicationStatusReq.
+type UpdateApplicationStatusReq struct {
+	Note   *string           `json:"note,omitempty"`
+	Status ApplicationStatus `json:"status"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	// CreatedAt set by the server
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Events event names, every event when empty
+	Events *[]string `json:"events,omitempty"`
+
+	// Id set by the server
+	Id *string `json:"id,omitempty"`
+
+	// Secret at least 16 characters, made up for a new webhook when empty, a replaced webhook keeps its secret unless another is given. It is only in the response when the webhook is added
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts      int        `json:"attempts"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	DeliveredAt   *time.Time `json:"deliveredAt,omitempty"`
+	Event         string     `json:"event"`
+	Id            string     `json:"id"`
+	LastError     *string    `json:"lastError,omitempty"`
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// Payload the body which is posted
+	Payload map[string]interface{} `json:"payload"`
+
+	// RedeliveryOf the delivery this is a redelivery of
+	RedeliveryOf *string               `json:"redeliveryOf,omitempty"`
+	Status       WebhookDeliveryStatus `json:"status"`
+
+	// StatusCode of the last attempt, 0 when the receiver did not answer
+	StatusCode int    `json:"statusCode"`
+	WebhookID  string `json:"webhookID"`
+}
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+
+	// NextCursor cursor of the next page, only sent when there may be one
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// WebhookDeliveryRes defines model for WebhookDeliveryRes.
+type WebhookDeliveryRes struct {
+	Delivery WebhookDelivery `json:"delivery"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// WebhookList defines model for WebhookList.
+type WebhookList struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// WebhookRes defines model for WebhookRes.
+type WebhookRes struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// PageSize defines model for PageSize.
+type PageSize = int
+
+// ListOutboxParams defines parameters for ListOutbox.
+type ListOutboxParams struct {
+	Status *OutboxStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Name Only list events with this name.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Sort Order of the list, createdAt, descending when preceded by a minus. Events with the same value are ordered by id.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// PageSize Number of rows in a page of the list.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the list starts from the beginning without it.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListApplicationsParams defines parameters for ListApplications.
+type ListApplicationsParams struct {
+	// PropertyID Only list applications for this property.
+	PropertyID *string `form:"propertyID,omitempty" json:"propertyID,omitempty"`
+
+	// Status Only list applications with this status.
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Order of the list, createdAt which is when the application was submitted, descending when preceded by a minus. Applications with the same value are ordered by id.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// PageSize Number of rows in a page of the list.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the list starts from the beginning without it.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListScreeningReportsParams defines parameters for ListScreeningReports.
+type ListScreeningReportsParams struct {
+	// Sort Order of the list, createdAt, descending when preceded by a minus. Reports with the same value are ordered by id.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// PageSize Number of rows in a page of the list.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the list starts from the beginning without it.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListAuditParams defines parameters for ListAudit.
+type ListAuditParams struct {
+	// Entity Only list changes to this type of entity.
+	Entity *ListAuditParamsEntity `form:"entity,omitempty" json:"entity,omitempty"`
+
+	// EntityID Only list changes to the entity with this id.
+	EntityID *string `form:"entityID,omitempty" json:"entityID,omitempty"`
+
+	// Actor Only list changes made by this actor, the api key they were made with.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Sort Order of the list, createdAt, descending when preceded by a minus. Entries with the same value are ordered by id.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// PageSize Number of rows in a page of the list.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the list starts from the beginning without it.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListAuditParamsEntity defines parameters for ListAudit.
+type ListAuditParamsEntity string
+
+// ListLeasesParams defines parameters for ListLeases.
+type ListLeasesParams struct {
+	// PropertyID Only list leases for this property.
+	PropertyID *string `form:"propertyID,omitempty" json:"propertyID,omitempty"`
+
+	// UnitID Only list leases for this unit.
+	UnitID *string `form:"unitID,omitempty" json:"unitID,omitempty"`
+
+	// Sort Order of the list, startDate or endDate, descending when preceded by a minus. Leases with the same value are ordered by id, leases without an end date are last when sorted by endDate.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// PageSize Number of rows in a page of the list.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the list starts from the beginning without it.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetBalanceParams defines parameters for GetBalance.
+type GetBalanceParams struct {
+	// AsOf Balance at the end of this day, defaults to every entry.
+	AsOf *openapi_types.Date `form:"asOf,omitempty" json:"asOf,omitempty"`
+}
+
+// AssessLateFeesParams defines parameters for AssessLateFees.
+type AssessLateFeesParams struct {
+	// AsOf Assess as of the end of this day, defaults to today and can not be in the future.
+	AsOf *openapi_types.Date `form:"asOf,omitempty" json:"asOf,omitempty"`
+}
+
+// GetStatementParams defines parameters for GetStatement.
+type GetStatementParams struct {
+	// From First day of the statement, defaults to the first entry.
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// Until Last day of the statement, defaults to the last entry.
+	Until *openapi_types.Date `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// GetRentScheduleParams defines parameters for GetRentSchedule.
+type GetRentScheduleParams struct {
+	// DueDay Day of the month (1-31) for monthly rent or ISO weekday (1-7) for weekly rent. Defaults to the 1st or the weekday of the start date.
+	DueDay *int `form:"dueDay,omitempty" json:"dueDay,omitempty"`
+
+	// Until Only list payments due on or before this date, required when the lease has no end date.
+	Until *openapi_types.Date `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// ListPublicListingsParams defines parameters for ListPublicListings.
+type ListPublicListingsParams struct {
+	// City Only list properties in this city, case insensitive.
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+
+	// MinRent Only list listings asking at least this rent in minor units of the currency.
+	MinRent *int `form:"minRent,omitempty" json:"minRent,omitempty"`
+
+	// MaxRent Only list listings asking at most this rent in minor units of the currency.
+	MaxRent *int `form:"maxRent,omitempty" json:"maxRent,omitempty"`
+
+	// Currency Currency of minRent and maxRent, listings asking rent in another currency are not listed.
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// PetsAllowed Only list listings which allow pets.
+	PetsAllowed *bool `form:"petsAllowed,omitempty" json:"petsAllowed,omitempty"`
+
+	// Sort Order of the list, publishedAt, descending when preceded by a minus. Listings with the same value are ordered by id.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// PageSize Number of rows in a page of the list.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the list starts from the beginning without it.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // ListPropertiesParams defines parameters for ListProperties.
 type ListPropertiesParams struct {
-	// Search This will search the address for any substring.
+	// Search Words the address must have, each may be the start of a word of the address. Street suffixes and directionals match their abbreviations, when no address matches similar ones are listed.
 	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// IncludeDeleted Include removed properties which were not purged yet.
+	IncludeDeleted *bool `form:"includeDeleted,omitempty" json:"includeDeleted,omitempty"`
+
+	// Sort Order of the list, createdAt, city, zip or rank, descending when preceded by a minus. Properties with the same value are ordered by id. A search is ordered by -rank and any other list by createdAt unless a sort is given.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// PageSize Number of rows in a page of the list.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the list starts from the beginning without it.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// ListTenantsParams defines parameters for ListTenants.
+type ListTenantsParams struct {
+	// Id Only the tenants with these ids, repeat the parameter for each id.
+	Id *[]string `form:"id,omitempty" json:"id,omitempty"`
+
+	// Name Part of the full name, case is ignored.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Phone Tenants with this phone number, only the digits are compared.
+	Phone *string `form:"phone,omitempty" json:"phone,omitempty"`
+
+	// DlState Drivers license state, case is ignored.
+	DlState *string `form:"dlState,omitempty" json:"dlState,omitempty"`
+
+	// DobFrom Born on or after this day.
+	DobFrom *openapi_types.Date `form:"dobFrom,omitempty" json:"dobFrom,omitempty"`
+
+	// DobUntil Born on or before this day.
+	DobUntil *openapi_types.Date `form:"dobUntil,omitempty" json:"dobUntil,omitempty"`
+
+	// LeasingPropertyID Tenants on a lease of the property whose term includes today.
+	LeasingPropertyID *string `form:"leasingPropertyID,omitempty" json:"leasingPropertyID,omitempty"`
+
+	// IncludeDeleted Include removed tenants which were not purged yet.
+	IncludeDeleted *bool `form:"includeDeleted,omitempty" json:"includeDeleted,omitempty"`
+
+	// Sort Order of the list, createdAt or name, descending when preceded by a minus. Tenants with the same value are ordered by id.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// PageSize Number of rows in a page of the list.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the list starts from the beginning without it.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Order of the list, createdAt, descending when preceded by a minus. Deliveries with the same value are ordered by id.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// PageSize Number of rows in a page of the list.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Cursor The nextCursor of the previous page, the list starts from the beginning without it.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// SubmitApplicationJSONRequestBody defines body for SubmitApplication for application/json ContentType.
+type SubmitApplicationJSONRequestBody = SubmitApplicationReq
+
+// UpdateApplicationStatusJSONRequestBody defines body for UpdateApplicationStatus for application/json ContentType.
+type UpdateApplicationStatusJSONRequestBody = UpdateApplicationStatusReq
+
 // LeasePropertyJSONRequestBody defines body for LeaseProperty for application/json ContentType.
 type LeasePropertyJSONRequestBody = LeasePropertyReq
 
+// AmendLeaseJSONRequestBody defines body for AmendLease for application/json ContentType.
+type AmendLeaseJSONRequestBody = AmendLeaseReq
+
+// DisposeDepositJSONRequestBody defines body for DisposeDeposit for application/json ContentType.
+type DisposeDepositJSONRequestBody = DisposeDepositReq
+
+// RecordDepositReceiptJSONRequestBody defines body for RecordDepositReceipt for application/json ContentType.
+type RecordDepositReceiptJSONRequestBody = RecordDepositReceiptReq
+
+// ApplyLateFeesJSONRequestBody defines body for ApplyLateFees for application/json ContentType.
+type ApplyLateFeesJSONRequestBody = ApplyLateFeesReq
+
+// StoreLeaseLateFeePolicyJSONRequestBody defines body for StoreLeaseLateFeePolicy for application/json ContentType.
+type StoreLeaseLateFeePolicyJSONRequestBody = StoreLateFeePolicyReq
+
+// PostLedgerEntryJSONRequestBody defines body for PostLedgerEntry for application/json ContentType.
+type PostLedgerEntryJSONRequestBody = PostLedgerEntryReq
+
+// ReverseLedgerEntryJSONRequestBody defines body for ReverseLedgerEntry for application/json ContentType.
+type ReverseLedgerEntryJSONRequestBody = ReverseLedgerEntryReq
+
+// RenewLeaseJSONRequestBody defines body for RenewLease for application/json ContentType.
+type RenewLeaseJSONRequestBody = RenewLeaseReq
+
+// TerminateLeaseJSONRequestBody defines body for TerminateLease for application/json ContentType.
+type TerminateLeaseJSONRequestBody = TerminateLeaseReq
+
 // AddPropertyJSONRequestBody defines body for AddProperty for application/json ContentType.
 type AddPropertyJSONRequestBody = StorePropertyReq
 
 // StorePropertyJSONRequestBody defines body for StoreProperty for application/json ContentType.
 type StorePropertyJSONRequestBody = StorePropertyReq
 
+// StorePropertyLateFeePolicyJSONRequestBody defines body for StorePropertyLateFeePolicy for application/json ContentType.
+type StorePropertyLateFeePolicyJSONRequestBody = StoreLateFeePolicyReq
+
+// StoreListingJSONRequestBody defines body for StoreListing for application/json ContentType.
+type StoreListingJSONRequestBody = StoreListingReq
+
+// StoreScreeningPolicyJSONRequestBody defines body for StoreScreeningPolicy for application/json ContentType.
+type StoreScreeningPolicyJSONRequestBody = StoreScreeningPolicyReq
+
+// AddUnitJSONRequestBody defines body for AddUnit for application/json ContentType.
+type AddUnitJSONRequestBody = StoreUnitReq
+
+// StoreUnitJSONRequestBody defines body for StoreUnit for application/json ContentType.
+type StoreUnitJSONRequestBody = StoreUnitReq
+
 // AddTenantJSONRequestBody defines body for AddTenant for application/json ContentType.
 type AddTenantJSONRequestBody = StoreTenantReq
 
+// PatchTenantApplicationMergePatchPlusJSONRequestBody defines body for PatchTenant for application/merge-patch+json ContentType.
+type PatchTenantApplicationMergePatchPlusJSONRequestBody = PatchTenantReq
+
 // StoreTenantJSONRequestBody defines body for StoreTenant for application/json ContentType.
 type StoreTenantJSONRequestBody = StoreTenantReq
+
+// AddTenantPhoneJSONRequestBody defines body for AddTenantPhone for application/json ContentType.
+type AddTenantPhoneJSONRequestBody = Phone
+
+// UpdateTenantPhoneJSONRequestBody defines body for UpdateTenantPhone for application/json ContentType.
+type UpdateTenantPhoneJSONRequestBody = Phone
+
+// AddWebhookJSONRequestBody defines body for AddWebhook for application/json ContentType.
+type AddWebhookJSONRequestBody = StoreWebhookReq
+
+// StoreWebhookJSONRequestBody defines body for StoreWebhook for application/json ContentType.
+type StoreWebhookJSONRequestBody = StoreWebhookReq
@@ -3,9 +3,14 @@ package openapi
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/usecase"
 	"github.com/tempcke/schedule"
 )
@@ -13,12 +18,23 @@ import (
 type (
 	StorePropertyRes = GetPropertyRes
 	StoreTenantRes   = GetTenantRes
+	LeasePropertyRes = GetLeaseRes
 )
 
 var (
 	NewStorePropertyRes = NewGetPropertyRes
 )
 
+func ToFieldErrors(in ...internal.FieldError) *[]FieldError {
+	if len(in) == 0 {
+		return nil
+	}
+	list := make([]FieldError, 0, len(in))
+	for _, e := range in {
+		list = append(list, FieldError{Field: e.Field, Reason: e.Reason})
+	}
+	return &list
+}
 func (x Error) Error() string {
 	var label = "openapi error"
 	if x.Code == 0 {
@@ -30,10 +46,12 @@ func (x Error) Error() string {
 func NewStorePropertyReq(p entity.Property) *StorePropertyReq {
 	return &StorePropertyReq{
 		Property: Address{
-			Street: p.Street,
-			City:   p.City,
-			State:  p.StateCode,
-			Zip:    p.Zip,
+			Street:  p.Street,
+			Unit:    toPointer(p.Unit),
+			City:    p.City,
+			State:   p.StateCode,
+			Zip:     p.Zip,
+			Country: toPointer(p.Country),
 		},
 	}
 }
@@ -43,29 +61,41 @@ func (x *StorePropertyReq) ToProperty() entity.Property {
 func (x *Property) GetID() string { return x.Id }
 func (x *Address) ToProperty() entity.Property {
 	p := Property{
-		Street: x.Street,
-		City:   x.City,
-		State:  x.State,
-		Zip:    x.Zip,
+		Street:  x.Street,
+		Unit:    x.Unit,
+		City:    x.City,
+		State:   x.State,
+		Zip:     x.Zip,
+		Country: x.Country,
 	}
 	return p.ToProperty()
 }
 func (x *Property) ToProperty() entity.Property {
 	return entity.Property{
-		ID:        x.Id,
-		Street:    x.Street,
-		City:      x.City,
-		StateCode: x.State,
-		Zip:       x.Zip,
+		ID: x.Id,
+		Address: entity.Address{
+			Street:    x.Street,
+			Unit:      removePointer(x.Unit),
+			City:      x.City,
+			StateCode: x.State,
+			Zip:       x.Zip,
+			Country:   removePointer(x.Country),
+		},
+		DeletedAt: removePointer(x.DeletedAt),
+		Rank:      removePointer(x.Rank),
 	}
 }
 func ToProperty(e entity.Property) *Property {
 	return &Property{
-		Id:     e.GetID(),
-		Street: e.Street,
-		City:   e.City,
-		State:  e.StateCode,
-		Zip:    e.Zip,
+		Id:        e.GetID(),
+		Street:    e.Street,
+		Unit:      toPointer(e.Unit),
+		City:      e.City,
+		State:     e.StateCode,
+		Zip:       e.Zip,
+		Country:   toPointer(e.Country),
+		DeletedAt: toPointer(e.DeletedAt),
+		Rank:      toPointer(e.Rank),
 	}
 }
 func NewGetPropertyRes(in entity.Property) GetPropertyRes {
@@ -89,17 +119,49 @@ func (x *ListPropertiesRes) ToProperties() []entity.Property {
 	}
 	return list
 }
+func (x ListPropertiesRes) WithNextCursor(cursor string) ListPropertiesRes {
+	if cursor != "" {
+		x.NextCursor = &cursor
+	}
+	return x
+}
 func (x *ListPropertiesParams) ToFilter() usecase.PropertyFilter {
 	return usecase.PropertyFilter{
-		Search: removePointer(x.Search),
+		Search:         removePointer(x.Search),
+		IncludeDeleted: removePointer(x.IncludeDeleted),
+		Page:           toPage(x.Sort, x.PageSize, x.Cursor),
+	}
+}
+
+func (x *ListTenantsParams) ToFilter() filters.TenantFilter {
+	var f = filters.NewTenantFilter().
+		WithIDs(removePointer(x.Id)...).
+		WithName(removePointer(x.Name)).
+		WithPhone(removePointer(x.Phone)).
+		WithDLState(removePointer(x.DlState)).
+		WithDOB(FromDate(removePointer(x.DobFrom)), FromDate(removePointer(x.DobUntil))).
+		WithLeasingProperty(removePointer(x.LeasingPropertyID)).
+		WithPage(toPage(x.Sort, x.PageSize, x.Cursor))
+	if removePointer(x.IncludeDeleted) {
+		f = f.WithDeleted()
 	}
+	return f
 }
 
 type Date = types.Date
 
 func ToDate(in schedule.Date) Date {
+	if in.IsZero() {
+		return Date{}
+	}
 	return Date{Time: in.ToTime()}
 }
+func FromDate(in Date) schedule.Date {
+	if in.Time.IsZero() {
+		return schedule.Date{}
+	}
+	return schedule.NewDateFromTime(in.Time)
+}
 func (x *MinTenant) ToTenant() entity.Tenant {
 	return entity.Tenant{
 		FullName:    x.FullName,
@@ -118,6 +180,7 @@ func (x *Tenant) ToTenant() *entity.Tenant {
 		Phones:      FromPhones(x.Phones...),
 		DLNum:       x.DlNum,
 		DLState:     x.DlState,
+		DeletedAt:   removePointer(x.DeletedAt),
 	}
 }
 func (x *Tenant) JSON() []byte {
@@ -153,6 +216,24 @@ func (x TenantList) ToTenants() []entity.Tenant {
 	}
 	return list
 }
+
+// toPage is the page of a list the query parameters ask for, without a page
+// size it has the default one
+func toPage(sort *string, size *PageSize, cursor *Cursor) filters.Page {
+	field, desc := filters.ParseSort(removePointer(sort))
+	return filters.NewPage().
+		WithSort(field, desc).
+		WithSize(removePointer(size)).
+		WithCursor(removePointer(cursor)).
+		Limited()
+}
+
+func (x TenantList) WithNextCursor(cursor string) TenantList {
+	if cursor != "" {
+		x.NextCursor = &cursor
+	}
+	return x
+}
 func ToTenantList(in ...entity.Tenant) TenantList {
 	var list = make([]Tenant, len(in))
 	for i, e := range in {
@@ -178,24 +259,97 @@ func NewGetTenantRes(in entity.Tenant) GetTenantRes {
 }
 func ToTenant(in entity.Tenant) *Tenant {
 	return &Tenant{
-		Id:       in.GetID(),
-		DlNum:    in.DLNum,
-		DlState:  in.DLState,
-		Dob:      ToDate(in.DateOfBirth),
-		FullName: in.FullName,
-		Phones:   ToPhones(in.Phones...),
+		Id:        in.GetID(),
+		DlNum:     in.DLNum,
+		DlState:   in.DLState,
+		Dob:       ToDate(in.DateOfBirth),
+		FullName:  in.FullName,
+		Phones:    ToPhones(in.Phones...),
+		DeletedAt: toPointer(in.DeletedAt),
 	}
 }
+
+// ToTenantPatch reads a JSON merge patch of a tenant, only the fields in the
+// body are patched and a field set to null is removed
+func ToTenantPatch(body []byte) (entity.TenantPatch, error) {
+	var (
+		fields map[string]json.RawMessage
+		req    PatchTenantReq
+		patch  = entity.NewTenantPatch()
+	)
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return patch, err
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return patch, err
+	}
+	t := entity.Tenant{
+		FullName: removePointer(req.FullName),
+		DLNum:    removePointer(req.DlNum),
+		DLState:  removePointer(req.DlState),
+	}
+	if req.Dob != nil {
+		t.DateOfBirth = FromDate(*req.Dob)
+	}
+	if req.Phones != nil {
+		t.Phones = FromPhones(*req.Phones...)
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		patch = patch.WithField(entity.TenantField(k), t)
+	}
+	return patch, nil
+}
+
+// NewTenantMergePatch is the JSON merge patch body of the patch, a cleared
+// field is sent as null
+func NewTenantMergePatch(p entity.TenantPatch) map[string]any {
+	var (
+		body     = make(map[string]any, len(p.Fields))
+		nullable = func(v string) any {
+			if v == "" {
+				return nil
+			}
+			return v
+		}
+	)
+	for _, f := range p.Fields {
+		switch f {
+		case entity.TenantFullName:
+			body[string(f)] = p.Tenant.FullName
+		case entity.TenantDateOfBirth:
+			body[string(f)] = ToDate(p.Tenant.DateOfBirth)
+		case entity.TenantDLNum:
+			body[string(f)] = nullable(p.Tenant.DLNum)
+		case entity.TenantDLState:
+			body[string(f)] = nullable(p.Tenant.DLState)
+		case entity.TenantPhones:
+			if len(p.Tenant.Phones) == 0 {
+				body[string(f)] = nil
+			} else {
+				body[string(f)] = ToPhones(p.Tenant.Phones...)
+			}
+		}
+	}
+	return body
+}
+func ToPhone(in entity.Phone) Phone {
+	return Phone{Desc: in.Note, Number: in.Number}
+}
+func (x Phone) ToPhone() entity.Phone {
+	return entity.Phone{Number: x.Number, Note: x.Desc}
+}
 func ToPhones(in ...entity.Phone) []Phone {
 	if len(in) == 0 {
 		return nil
 	}
 	var list = make([]Phone, len(in))
 	for i, e := range in {
-		list[i] = Phone{
-			Desc:   e.Note,
-			Number: e.Number,
-		}
+		list[i] = ToPhone(e)
 	}
 	return list
 }
@@ -205,14 +359,1340 @@ func FromPhones(in ...Phone) []entity.Phone {
 	}
 	var list = make([]entity.Phone, len(in))
 	for i, e := range in {
-		list[i] = entity.Phone{
-			Number: e.Number,
-			Note:   e.Desc,
+		list[i] = e.ToPhone()
+	}
+	return list
+}
+
+func ToMoney(in entity.Money) Money {
+	return Money{Amount: in.Minor, Currency: in.Currency}
+}
+func (x Money) ToMoney() entity.Money {
+	return entity.NewMoney(x.Amount, x.Currency)
+}
+
+func NewLeasePropertyReq(in entity.Lease) *LeasePropertyReq {
+	return &LeasePropertyReq{
+		Lease: MinLease{
+			PropertyID:   in.PropertyID,
+			UnitID:       toPointer(in.UnitID),
+			TenantIDs:    toStrings(in.TenantIDs),
+			StartDate:    ToDate(in.StartDate),
+			EndDate:      ToDate(in.EndDate),
+			Deposit:      ToMoney(in.Deposit),
+			RentAmount:   ToMoney(in.RentAmount),
+			RentInterval: MinLeaseRentInterval(in.RentInterval),
+		},
+	}
+}
+func (x *MinLease) ToLease() entity.Lease {
+	return entity.Lease{
+		PropertyID:   x.PropertyID,
+		UnitID:       removePointer(x.UnitID),
+		TenantIDs:    toStrings(x.TenantIDs),
+		StartDate:    FromDate(x.StartDate),
+		EndDate:      FromDate(x.EndDate),
+		Deposit:      x.Deposit.ToMoney(),
+		RentAmount:   x.RentAmount.ToMoney(),
+		RentInterval: string(x.RentInterval),
+	}
+}
+func (x *Lease) GetID() string { return x.Id }
+func (x *Lease) ToLease() *entity.Lease {
+	return &entity.Lease{
+		ID:           x.GetID(),
+		PropertyID:   x.PropertyID,
+		UnitID:       removePointer(x.UnitID),
+		TenantIDs:    toStrings(x.TenantIDs),
+		StartDate:    FromDate(x.StartDate),
+		EndDate:      FromDate(x.EndDate),
+		Deposit:      x.Deposit.ToMoney(),
+		RentAmount:   x.RentAmount.ToMoney(),
+		RentInterval: string(x.RentInterval),
+		RenewsID:     removePointer(x.RenewsID),
+	}
+}
+func ToLease(in entity.Lease) *Lease {
+	return &Lease{
+		Id:           in.GetID(),
+		PropertyID:   in.PropertyID,
+		UnitID:       toPointer(in.UnitID),
+		TenantIDs:    toStrings(in.TenantIDs),
+		StartDate:    ToDate(in.StartDate),
+		EndDate:      ToDate(in.EndDate),
+		Deposit:      ToMoney(in.Deposit),
+		RentAmount:   ToMoney(in.RentAmount),
+		RentInterval: LeaseRentInterval(in.RentInterval),
+		RenewsID:     toPointer(in.RenewsID),
+	}
+}
+func NewGetLeaseRes(in entity.Lease) GetLeaseRes {
+	return GetLeaseRes{Lease: *ToLease(in)}
+}
+
+// WithHistory adds the lease version chain to the response
+func (x GetLeaseRes) WithHistory(h entity.LeaseHistory) GetLeaseRes {
+	var list = make([]LeaseVersion, len(h.Versions))
+	for i, v := range h.Versions {
+		list[i] = ToLeaseVersion(v)
+	}
+	x.History = &list
+	return x
+}
+func (x GetLeaseRes) ToLeaseHistory() *entity.LeaseHistory {
+	var h entity.LeaseHistory
+	for _, v := range removePointer(x.History) {
+		h.Versions = append(h.Versions, v.ToLeaseVersion())
+	}
+	return &h
+}
+func ToLeaseVersion(in entity.LeaseVersion) LeaseVersion {
+	return LeaseVersion{
+		LeaseID:       in.LeaseID,
+		Version:       in.Version,
+		Change:        LeaseVersionChange(in.Change),
+		EffectiveDate: ToDate(in.Effective),
+		Reason:        toPointer(in.Reason),
+		Lease:         *ToLease(in.Terms),
+	}
+}
+func (x LeaseVersion) ToLeaseVersion() entity.LeaseVersion {
+	return entity.LeaseVersion{
+		LeaseID:   x.LeaseID,
+		Version:   x.Version,
+		Change:    string(x.Change),
+		Effective: FromDate(x.EffectiveDate),
+		Reason:    removePointer(x.Reason),
+		Terms:     *x.Lease.ToLease(),
+	}
+}
+func (x LeaseList) WithNextCursor(cursor string) LeaseList {
+	if cursor != "" {
+		x.NextCursor = &cursor
+	}
+	return x
+}
+func ToLeaseList(in ...entity.Lease) LeaseList {
+	var list = make([]Lease, len(in))
+	for i, e := range in {
+		list[i] = *ToLease(e)
+	}
+	return LeaseList{
+		Leases: list,
+	}
+}
+func (x LeaseList) ToLeases() []entity.Lease {
+	if len(x.Leases) == 0 {
+		return nil
+	}
+	var list = make([]entity.Lease, len(x.Leases))
+	for i, l := range x.Leases {
+		list[i] = *l.ToLease()
+	}
+	return list
+}
+func NewTerminateLeaseReq(endDate schedule.Date, reason string) *TerminateLeaseReq {
+	return &TerminateLeaseReq{
+		EndDate: ToDate(endDate),
+		Reason:  toPointer(reason),
+	}
+}
+func (x *TerminateLeaseReq) GetReason() string { return removePointer(x.Reason) }
+func NewRenewLeaseReq(in entity.LeaseRenewal) *RenewLeaseReq {
+	return &RenewLeaseReq{
+		EndDate:    ToDate(in.EndDate),
+		RentAmount: toPointer(ToMoney(in.RentAmount)),
+		Reason:     toPointer(in.Reason),
+	}
+}
+
+// ToLeaseRenewal leaves the new lease id empty so one is assigned
+func (x *RenewLeaseReq) ToLeaseRenewal() entity.LeaseRenewal {
+	return entity.LeaseRenewal{
+		EndDate:    FromDate(x.EndDate),
+		RentAmount: toEntityMoney(x.RentAmount),
+		Reason:     removePointer(x.Reason),
+	}
+}
+func NewAmendLeaseReq(in entity.LeaseAmendment) *AmendLeaseReq {
+	return &AmendLeaseReq{
+		EffectiveDate:   ToDate(in.Effective),
+		RentAmount:      toPointer(ToMoney(in.RentAmount)),
+		AddTenantIDs:    toStringsPointer(in.AddTenants),
+		RemoveTenantIDs: toStringsPointer(in.RemoveTenants),
+		Reason:          toPointer(in.Reason),
+	}
+}
+func (x *AmendLeaseReq) ToLeaseAmendment() entity.LeaseAmendment {
+	return entity.LeaseAmendment{
+		Effective:     FromDate(x.EffectiveDate),
+		RentAmount:    toEntityMoney(x.RentAmount),
+		AddTenants:    removePointer(x.AddTenantIDs),
+		RemoveTenants: removePointer(x.RemoveTenantIDs),
+		Reason:        removePointer(x.Reason),
+	}
+}
+func (x *ListLeasesParams) ToFilter() filters.LeaseFilter {
+	return filters.NewLeaseFilter().
+		WithPropertyID(removePointer(x.PropertyID)).
+		WithUnitID(removePointer(x.UnitID)).
+		WithPage(toPage(x.Sort, x.PageSize, x.Cursor))
+}
+
+func (x *GetRentScheduleParams) ToScheduleOptions() entity.ScheduleOptions {
+	return entity.NewScheduleOptions().
+		WithDueDay(removePointer(x.DueDay)).
+		WithUntil(FromDate(removePointer(x.Until)))
+}
+func NewGetRentScheduleParams(opts entity.ScheduleOptions) *GetRentScheduleParams {
+	var params GetRentScheduleParams
+	if opts.DueDay != 0 {
+		params.DueDay = &opts.DueDay
+	}
+	if !opts.Until.IsZero() {
+		until := ToDate(opts.Until)
+		params.Until = &until
+	}
+	return &params
+}
+func ToRentSchedule(in ...entity.RentDue) RentSchedule {
+	var list = make([]RentDue, len(in))
+	for i, e := range in {
+		list[i] = RentDue{
+			DueDate:     ToDate(e.DueDate),
+			PeriodStart: ToDate(e.Period.From),
+			PeriodEnd:   ToDate(*e.Period.Until),
+			Amount:      ToMoney(e.Amount),
+			Prorated:    e.Prorated,
+		}
+	}
+	return RentSchedule{Payments: list}
+}
+func (x RentSchedule) ToRentDues() []entity.RentDue {
+	var list = make([]entity.RentDue, len(x.Payments))
+	for i, p := range x.Payments {
+		until := FromDate(p.PeriodEnd)
+		list[i] = entity.RentDue{
+			DueDate:  FromDate(p.DueDate),
+			Period:   schedule.NewDateRangeUntil(FromDate(p.PeriodStart), &until),
+			Amount:   p.Amount.ToMoney(),
+			Prorated: p.Prorated,
+		}
+	}
+	return list
+}
+
+func NewPostLedgerEntryReq(in entity.LedgerEntry) *PostLedgerEntryReq {
+	return &PostLedgerEntryReq{
+		Entry: MinLedgerEntry{
+			Type:   MinLedgerEntryType(in.Type),
+			Amount: ToMoney(in.Amount),
+			Date:   ToDate(in.Date),
+			Memo:   toPointer(in.Memo),
+			Ref:    toPointer(in.Ref),
+		},
+	}
+}
+func (x *MinLedgerEntry) ToLedgerEntry(leaseID entity.ID) entity.LedgerEntry {
+	return entity.LedgerEntry{
+		LeaseID: leaseID,
+		Type:    string(x.Type),
+		Amount:  x.Amount.ToMoney(),
+		Date:    FromDate(x.Date),
+		Memo:    removePointer(x.Memo),
+		Ref:     removePointer(x.Ref),
+	}
+}
+func (x *LedgerEntry) GetID() string { return x.Id }
+func (x *LedgerEntry) ToLedgerEntry() *entity.LedgerEntry {
+	return &entity.LedgerEntry{
+		ID:         x.GetID(),
+		LeaseID:    x.LeaseID,
+		Type:       string(x.Type),
+		Amount:     x.Amount.ToMoney(),
+		Date:       FromDate(x.Date),
+		Memo:       removePointer(x.Memo),
+		ReversesID: removePointer(x.ReversesID),
+		Ref:        removePointer(x.Ref),
+	}
+}
+func ToLedgerEntry(in entity.LedgerEntry) *LedgerEntry {
+	return &LedgerEntry{
+		Id:         in.GetID(),
+		LeaseID:    in.LeaseID,
+		Type:       LedgerEntryType(in.Type),
+		Amount:     ToMoney(in.Amount),
+		Date:       ToDate(in.Date),
+		Memo:       toPointer(in.Memo),
+		ReversesID: toPointer(in.ReversesID),
+		Ref:        toPointer(in.Ref),
+	}
+}
+func NewLedgerEntryRes(in entity.LedgerEntry) LedgerEntryRes {
+	return LedgerEntryRes{Entry: *ToLedgerEntry(in)}
+}
+func NewReverseLedgerEntryReq(date schedule.Date, memo string) *ReverseLedgerEntryReq {
+	return &ReverseLedgerEntryReq{
+		Date: ToDate(date),
+		Memo: toPointer(memo),
+	}
+}
+func (x *ReverseLedgerEntryReq) ToReversal() (date schedule.Date, memo string) {
+	return FromDate(x.Date), removePointer(x.Memo)
+}
+func NewBalance(leaseID entity.ID, asOf schedule.Date, balance entity.Money) Balance {
+	return Balance{
+		LeaseID: leaseID,
+		AsOf:    toDatePointer(asOf),
+		Balance: ToMoney(balance),
+	}
+}
+func ToStatement(in entity.Statement) Statement {
+	var lines = make([]StatementLine, len(in.Lines))
+	for i, l := range in.Lines {
+		lines[i] = StatementLine{
+			Entry:   *ToLedgerEntry(l.Entry),
+			Balance: ToMoney(l.Balance),
+		}
+	}
+	return Statement{
+		LeaseID:        in.LeaseID,
+		From:           toDatePointer(in.From),
+		Until:          toDatePointer(in.Until),
+		OpeningBalance: ToMoney(in.OpeningBalance),
+		Lines:          lines,
+		ClosingBalance: ToMoney(in.ClosingBalance),
+	}
+}
+func (x Statement) ToStatement() *entity.Statement {
+	var lines = make([]entity.StatementLine, len(x.Lines))
+	for i, l := range x.Lines {
+		lines[i] = entity.StatementLine{
+			Entry:   *l.Entry.ToLedgerEntry(),
+			Balance: l.Balance.ToMoney(),
+		}
+	}
+	return &entity.Statement{
+		LeaseID:        x.LeaseID,
+		From:           FromDate(removePointer(x.From)),
+		Until:          FromDate(removePointer(x.Until)),
+		OpeningBalance: x.OpeningBalance.ToMoney(),
+		Lines:          lines,
+		ClosingBalance: x.ClosingBalance.ToMoney(),
+	}
+}
+func (x *GetStatementParams) ToDateRange() (from, until schedule.Date) {
+	return FromDate(removePointer(x.From)), FromDate(removePointer(x.Until))
+}
+func (x *GetBalanceParams) ToAsOf() schedule.Date {
+	return FromDate(removePointer(x.AsOf))
+}
+
+func NewStoreLateFeePolicyReq(in entity.LateFeePolicy) *StoreLateFeePolicyReq {
+	return &StoreLateFeePolicyReq{
+		Policy: MinLateFeePolicy{
+			GraceDays:   in.GraceDays,
+			FlatFee:     ToMoney(in.FlatFee),
+			RentPercent: in.RentPercent,
+			DailyFee:    ToMoney(in.DailyFee),
+			MaxFee:      ToMoney(in.MaxFee),
+		},
+	}
+}
+func (x *MinLateFeePolicy) ToLateFeePolicy() entity.LateFeePolicy {
+	return entity.LateFeePolicy{
+		GraceDays:   x.GraceDays,
+		FlatFee:     x.FlatFee.ToMoney(),
+		RentPercent: x.RentPercent,
+		DailyFee:    x.DailyFee.ToMoney(),
+		MaxFee:      x.MaxFee.ToMoney(),
+	}
+}
+func (x *LateFeePolicy) GetID() string { return x.Id }
+func (x *LateFeePolicy) ToLateFeePolicy() *entity.LateFeePolicy {
+	return &entity.LateFeePolicy{
+		ID:          x.GetID(),
+		PropertyID:  removePointer(x.PropertyID),
+		LeaseID:     removePointer(x.LeaseID),
+		GraceDays:   x.GraceDays,
+		FlatFee:     x.FlatFee.ToMoney(),
+		RentPercent: x.RentPercent,
+		DailyFee:    x.DailyFee.ToMoney(),
+		MaxFee:      x.MaxFee.ToMoney(),
+	}
+}
+func NewLateFeePolicyRes(in entity.LateFeePolicy) LateFeePolicyRes {
+	return LateFeePolicyRes{
+		Policy: LateFeePolicy{
+			Id:          in.GetID(),
+			PropertyID:  toPointer(in.PropertyID),
+			LeaseID:     toPointer(in.LeaseID),
+			GraceDays:   in.GraceDays,
+			FlatFee:     ToMoney(in.FlatFee),
+			RentPercent: in.RentPercent,
+			DailyFee:    ToMoney(in.DailyFee),
+			MaxFee:      ToMoney(in.MaxFee),
+		},
+	}
+}
+func ToLateFeeList(in ...entity.LateFee) LateFeeList {
+	var list = make([]LateFee, len(in))
+	for i, e := range in {
+		list[i] = LateFee{
+			DueDate:  ToDate(e.DueDate),
+			Unpaid:   ToMoney(e.Unpaid),
+			DaysLate: e.DaysLate,
+			Amount:   ToMoney(e.Amount),
+			Ref:      e.Ref(),
+		}
+	}
+	return LateFeeList{Fees: list}
+}
+func (x LateFeeList) ToLateFees() []entity.LateFee {
+	var list = make([]entity.LateFee, len(x.Fees))
+	for i, e := range x.Fees {
+		list[i] = entity.LateFee{
+			DueDate:  FromDate(e.DueDate),
+			Unpaid:   e.Unpaid.ToMoney(),
+			DaysLate: e.DaysLate,
+			Amount:   e.Amount.ToMoney(),
+		}
+	}
+	return list
+}
+func (x *AssessLateFeesParams) ToAsOf() schedule.Date {
+	return FromDate(removePointer(x.AsOf))
+}
+func NewApplyLateFeesReq(asOf schedule.Date) *ApplyLateFeesReq {
+	return &ApplyLateFeesReq{AsOf: toDatePointer(asOf)}
+}
+func (x *ApplyLateFeesReq) ToAsOf() schedule.Date {
+	return FromDate(removePointer(x.AsOf))
+}
+func ToLedgerEntryList(in ...entity.LedgerEntry) LedgerEntryList {
+	var list = make([]LedgerEntry, len(in))
+	for i, e := range in {
+		list[i] = *ToLedgerEntry(e)
+	}
+	return LedgerEntryList{Entries: list}
+}
+func (x LedgerEntryList) ToLedgerEntries() []entity.LedgerEntry {
+	var list = make([]entity.LedgerEntry, len(x.Entries))
+	for i, e := range x.Entries {
+		list[i] = *e.ToLedgerEntry()
+	}
+	return list
+}
+func NewRecordDepositReceiptReq(in entity.DepositReceipt) *RecordDepositReceiptReq {
+	return &RecordDepositReceiptReq{
+		Receipt: MinDepositReceipt{
+			Amount: ToMoney(in.Amount),
+			Date:   ToDate(in.Date),
+			Memo:   toPointer(in.Memo),
+		},
+	}
+}
+func (x *MinDepositReceipt) ToDepositReceipt(leaseID entity.ID) entity.DepositReceipt {
+	return entity.DepositReceipt{
+		LeaseID: leaseID,
+		Amount:  x.Amount.ToMoney(),
+		Date:    FromDate(x.Date),
+		Memo:    removePointer(x.Memo),
+	}
+}
+func (x *DepositReceipt) GetID() string { return x.Id }
+func (x *DepositReceipt) ToDepositReceipt() *entity.DepositReceipt {
+	return &entity.DepositReceipt{
+		ID:      x.GetID(),
+		LeaseID: x.LeaseID,
+		Amount:  x.Amount.ToMoney(),
+		Date:    FromDate(x.Date),
+		Memo:    removePointer(x.Memo),
+	}
+}
+func ToDepositReceipt(in entity.DepositReceipt) *DepositReceipt {
+	return &DepositReceipt{
+		Id:      in.GetID(),
+		LeaseID: in.LeaseID,
+		Amount:  ToMoney(in.Amount),
+		Date:    ToDate(in.Date),
+		Memo:    toPointer(in.Memo),
+	}
+}
+func NewDepositReceiptRes(in entity.DepositReceipt) DepositReceiptRes {
+	return DepositReceiptRes{Receipt: *ToDepositReceipt(in)}
+}
+func ToDeductions(in ...entity.Deduction) []Deduction {
+	var list = make([]Deduction, len(in))
+	for i, d := range in {
+		list[i] = Deduction{
+			Category:    DeductionCategory(d.Category),
+			Amount:      ToMoney(d.Amount),
+			Description: d.Description,
+		}
+	}
+	return list
+}
+func FromDeductions(in ...Deduction) []entity.Deduction {
+	if len(in) == 0 {
+		return nil
+	}
+	var list = make([]entity.Deduction, len(in))
+	for i, d := range in {
+		list[i] = entity.NewDeduction(string(d.Category), d.Amount.ToMoney(), d.Description)
+	}
+	return list
+}
+func NewDisposeDepositReq(in entity.DepositDisposition) *DisposeDepositReq {
+	return &DisposeDepositReq{
+		Disposition: MinDepositDisposition{
+			MoveOutDate: ToDate(in.MoveOutDate),
+			Deductions:  ToDeductions(in.Deductions...),
+		},
+	}
+}
+func (x *MinDepositDisposition) ToDepositDisposition(leaseID entity.ID) entity.DepositDisposition {
+	return entity.DepositDisposition{
+		LeaseID:     leaseID,
+		MoveOutDate: FromDate(x.MoveOutDate),
+		Deductions:  FromDeductions(x.Deductions...),
+	}
+}
+func (x *DepositDisposition) GetID() string { return x.Id }
+func (x *DepositDisposition) ToDepositDisposition() *entity.DepositDisposition {
+	return &entity.DepositDisposition{
+		ID:          x.GetID(),
+		LeaseID:     x.LeaseID,
+		MoveOutDate: FromDate(x.MoveOutDate),
+		Deductions:  FromDeductions(x.Deductions...),
+		Held:        x.Held.ToMoney(),
+		Refund:      x.Refund.ToMoney(),
+		Owed:        x.Owed.ToMoney(),
+	}
+}
+func ToDepositDisposition(in entity.DepositDisposition) *DepositDisposition {
+	return &DepositDisposition{
+		Id:          in.GetID(),
+		LeaseID:     in.LeaseID,
+		MoveOutDate: ToDate(in.MoveOutDate),
+		Deductions:  ToDeductions(in.Deductions...),
+		Held:        ToMoney(in.Held),
+		Refund:      ToMoney(in.Refund),
+		Owed:        ToMoney(in.Owed),
+	}
+}
+func NewDepositDispositionRes(in entity.DepositDisposition) DepositDispositionRes {
+	return DepositDispositionRes{Disposition: *ToDepositDisposition(in)}
+}
+func ToDepositAccount(in entity.DepositAccount) DepositAccount {
+	var out = DepositAccount{
+		LeaseID:  in.LeaseID,
+		Required: ToMoney(in.Required),
+		Held:     ToMoney(in.Held()),
+		Status:   DepositAccountStatus(in.Status()),
+		Receipts: make([]DepositReceipt, len(in.Receipts)),
+	}
+	for i, r := range in.Receipts {
+		out.Receipts[i] = *ToDepositReceipt(r)
+	}
+	if in.Disposition != nil {
+		out.Disposition = ToDepositDisposition(*in.Disposition)
+	}
+	return out
+}
+func (x DepositAccount) ToDepositAccount() *entity.DepositAccount {
+	var out = entity.DepositAccount{
+		LeaseID:  x.LeaseID,
+		Required: x.Required.ToMoney(),
+		Receipts: make([]entity.DepositReceipt, len(x.Receipts)),
+	}
+	for i, r := range x.Receipts {
+		out.Receipts[i] = *r.ToDepositReceipt()
+	}
+	if x.Disposition != nil {
+		out.Disposition = x.Disposition.ToDepositDisposition()
+	}
+	return &out
+}
+
+func ToApplicants(in ...entity.Applicant) []Applicant {
+	var list = make([]Applicant, len(in))
+	for i, a := range in {
+		list[i] = Applicant{
+			FullName:        a.FullName,
+			Dob:             ToDate(a.DateOfBirth),
+			Ssn:             toPointer(a.SSN),
+			DlNum:           toPointer(a.DLNum),
+			DlState:         toPointer(a.DLState),
+			HasPets:         toPointer(a.HasPets),
+			PetsDesc:        toPointer(a.PetsDesc),
+			VehicleCount:    toPointer(a.VehicleCount),
+			VehicleDesc:     toPointer(a.VehicleDesc),
+			CrimeConviction: toPointer(a.CrimeConviction),
+			CrimeDesc:       toPointer(a.CrimeDesc),
+			BankruptcyFiled: toPointer(a.BankruptcyFiled),
+			BankruptcyDesc:  toPointer(a.BankruptcyDesc),
+			MonthlyIncome:   toPointer(ToMoney(a.MonthlyIncome)),
+		}
+	}
+	return list
+}
+func FromApplicants(in ...Applicant) []entity.Applicant {
+	if len(in) == 0 {
+		return nil
+	}
+	var list = make([]entity.Applicant, len(in))
+	for i, a := range in {
+		list[i] = entity.Applicant{
+			FullName:        a.FullName,
+			DateOfBirth:     FromDate(a.Dob),
+			SSN:             removePointer(a.Ssn),
+			DLNum:           removePointer(a.DlNum),
+			DLState:         removePointer(a.DlState),
+			HasPets:         removePointer(a.HasPets),
+			PetsDesc:        removePointer(a.PetsDesc),
+			VehicleCount:    removePointer(a.VehicleCount),
+			VehicleDesc:     removePointer(a.VehicleDesc),
+			CrimeConviction: removePointer(a.CrimeConviction),
+			CrimeDesc:       removePointer(a.CrimeDesc),
+			BankruptcyFiled: removePointer(a.BankruptcyFiled),
+			BankruptcyDesc:  removePointer(a.BankruptcyDesc),
+			MonthlyIncome:   toEntityMoney(a.MonthlyIncome),
+		}
+	}
+	return list
+}
+func NewSubmitApplicationReq(in entity.RentalApplication) *SubmitApplicationReq {
+	return &SubmitApplicationReq{
+		Application: MinApplication{
+			PropertyID: in.PropertyID,
+			MoveInDate: ToDate(in.MoveInDate),
+			Applicants: ToApplicants(in.Applicants...),
+		},
+	}
+}
+func (x *MinApplication) ToRentalApplication() entity.RentalApplication {
+	return entity.RentalApplication{
+		PropertyID: x.PropertyID,
+		MoveInDate: FromDate(x.MoveInDate),
+		Applicants: FromApplicants(x.Applicants...),
+	}
+}
+func (x *Application) GetID() string { return x.Id }
+func (x *Application) ToRentalApplication() *entity.RentalApplication {
+	var out = entity.RentalApplication{
+		ID:          x.GetID(),
+		PropertyID:  x.PropertyID,
+		MoveInDate:  FromDate(x.MoveInDate),
+		Applicants:  FromApplicants(x.Applicants...),
+		Status:      string(x.Status),
+		TenantIDs:   removePointer(x.TenantIDs),
+		SubmittedAt: x.SubmittedAt,
+	}
+	for _, n := range x.Notes {
+		out.Notes = append(out.Notes, entity.ApplicationNote{
+			Status:    string(n.Status),
+			Note:      n.Note,
+			CreatedAt: n.CreatedAt,
+		})
+	}
+	return &out
+}
+func ToApplication(in entity.RentalApplication) *Application {
+	var out = Application{
+		Id:          in.GetID(),
+		PropertyID:  in.PropertyID,
+		MoveInDate:  ToDate(in.MoveInDate),
+		Applicants:  ToApplicants(in.Applicants...),
+		Status:      ApplicationStatus(in.Status),
+		Notes:       make([]ApplicationNote, len(in.Notes)),
+		TenantIDs:   toStringsPointer(in.TenantIDs),
+		SubmittedAt: in.SubmittedAt,
+	}
+	for i, n := range in.Notes {
+		out.Notes[i] = ApplicationNote{
+			Status:    ApplicationStatus(n.Status),
+			Note:      n.Note,
+			CreatedAt: n.CreatedAt,
+		}
+	}
+	return &out
+}
+func NewApplicationRes(in entity.RentalApplication) ApplicationRes {
+	return ApplicationRes{Application: *ToApplication(in)}
+}
+func (x ApplicationList) WithNextCursor(cursor string) ApplicationList {
+	if cursor != "" {
+		x.NextCursor = &cursor
+	}
+	return x
+}
+func ToApplicationList(in ...entity.RentalApplication) ApplicationList {
+	var list = ApplicationList{Applications: make([]Application, len(in))}
+	for i, a := range in {
+		list.Applications[i] = *ToApplication(a)
+	}
+	return list
+}
+func (x ApplicationList) ToRentalApplications() []entity.RentalApplication {
+	var list = make([]entity.RentalApplication, len(x.Applications))
+	for i, a := range x.Applications {
+		list[i] = *a.ToRentalApplication()
+	}
+	return list
+}
+func (x *ListApplicationsParams) ToFilter() filters.ApplicationFilter {
+	return filters.NewApplicationFilter().
+		WithPropertyID(removePointer(x.PropertyID)).
+		WithStatus(removePointer(x.Status)).
+		WithPage(toPage(x.Sort, x.PageSize, x.Cursor))
+}
+func NewListApplicationsParams(f filters.ApplicationFilter) *ListApplicationsParams {
+	return &ListApplicationsParams{
+		PropertyID: toPointer(f.PropertyID),
+		Status:     toPointer(f.Status),
+	}
+}
+func NewUpdateApplicationStatusReq(status entity.ApplicationStatus, note string) *UpdateApplicationStatusReq {
+	return &UpdateApplicationStatusReq{
+		Status: ApplicationStatus(status),
+		Note:   toPointer(note),
+	}
+}
+func (x *UpdateApplicationStatusReq) GetNote() string { return removePointer(x.Note) }
+func ToApplicationConversion(in usecase.ApplicationConversion) ApplicationConversion {
+	var out = ApplicationConversion{
+		Application: *ToApplication(in.Application),
+		Tenants:     make([]Tenant, len(in.Tenants)),
+		Lease:       *ToLease(in.Lease),
+	}
+	for i, t := range in.Tenants {
+		out.Tenants[i] = *ToTenant(t)
+	}
+	return out
+}
+func (x ApplicationConversion) ToApplicationConversion() *usecase.ApplicationConversion {
+	var out = usecase.ApplicationConversion{
+		Application: *x.Application.ToRentalApplication(),
+		Tenants:     make([]entity.Tenant, len(x.Tenants)),
+		Lease:       *x.Lease.ToLease(),
+	}
+	for i, t := range x.Tenants {
+		out.Tenants[i] = *t.ToTenant()
+	}
+	return &out
+}
+
+func NewStoreScreeningPolicyReq(in entity.ScreeningPolicy) *StoreScreeningPolicyReq {
+	return &StoreScreeningPolicyReq{
+		Policy: MinScreeningPolicy{
+			Rent:  toPointer(ToMoney(in.Rent)),
+			Rules: ToScreeningRules(in.Rules...),
+		},
+	}
+}
+func (x *MinScreeningPolicy) ToScreeningPolicy(propertyID entity.ID) entity.ScreeningPolicy {
+	return entity.ScreeningPolicy{
+		PropertyID: propertyID,
+		Rent:       toEntityMoney(x.Rent),
+		Rules:      FromScreeningRules(x.Rules...),
+	}
+}
+func (x *ScreeningPolicy) GetID() string { return x.Id }
+func (x *ScreeningPolicy) ToScreeningPolicy() *entity.ScreeningPolicy {
+	return &entity.ScreeningPolicy{
+		ID:         x.GetID(),
+		PropertyID: x.PropertyID,
+		Rent:       toEntityMoney(x.Rent),
+		Rules:      FromScreeningRules(x.Rules...),
+		UpdatedAt:  removePointer(x.UpdatedAt),
+	}
+}
+func NewScreeningPolicyRes(in entity.ScreeningPolicy) ScreeningPolicyRes {
+	return ScreeningPolicyRes{
+		Policy: ScreeningPolicy{
+			Id:         in.GetID(),
+			PropertyID: in.PropertyID,
+			Rent:       toPointer(ToMoney(in.Rent)),
+			Rules:      ToScreeningRules(in.Rules...),
+			UpdatedAt:  toPointer(in.UpdatedAt),
+		},
+	}
+}
+func ToScreeningRules(in ...entity.ScreeningRule) []ScreeningRule {
+	var list = make([]ScreeningRule, len(in))
+	for i, r := range in {
+		list[i] = ScreeningRule{
+			Criterion: ScreeningRuleCriterion(r.Criterion),
+			Limit:     toPointer(r.Limit),
+			Action:    ScreeningRuleAction(r.Action),
+		}
+	}
+	return list
+}
+func FromScreeningRules(in ...ScreeningRule) []entity.ScreeningRule {
+	var list = make([]entity.ScreeningRule, len(in))
+	for i, r := range in {
+		list[i] = entity.ScreeningRule{
+			Criterion: string(r.Criterion),
+			Limit:     removePointer(r.Limit),
+			Action:    string(r.Action),
+		}
+	}
+	return list
+}
+func ToScreeningReport(in entity.ScreeningReport) *ScreeningReport {
+	var out = ScreeningReport{
+		Id:            in.GetID(),
+		ApplicationID: in.ApplicationID,
+		PropertyID:    in.PropertyID,
+		Result:        ScreeningReportResult(in.Result),
+		Rent:          toPointer(ToMoney(in.Rent)),
+		Rules:         ToScreeningRules(in.Rules...),
+		Findings:      make([]ScreeningFinding, len(in.Findings)),
+		CreatedAt:     in.CreatedAt,
+	}
+	for i, f := range in.Findings {
+		out.Findings[i] = ScreeningFinding{
+			Rule:      ToScreeningRules(f.Rule)[0],
+			Applicant: toPointer(f.Applicant),
+			Reason:    f.Reason,
+		}
+	}
+	return &out
+}
+func (x *ScreeningReport) GetID() string { return x.Id }
+func (x *ScreeningReport) ToScreeningReport() *entity.ScreeningReport {
+	var out = entity.ScreeningReport{
+		ID:            x.GetID(),
+		ApplicationID: x.ApplicationID,
+		PropertyID:    x.PropertyID,
+		Result:        string(x.Result),
+		Rent:          toEntityMoney(x.Rent),
+		Rules:         FromScreeningRules(x.Rules...),
+		Findings:      make([]entity.ScreeningFinding, len(x.Findings)),
+		CreatedAt:     x.CreatedAt,
+	}
+	for i, f := range x.Findings {
+		out.Findings[i] = entity.ScreeningFinding{
+			Rule:      FromScreeningRules(f.Rule)[0],
+			Applicant: removePointer(f.Applicant),
+			Reason:    f.Reason,
+		}
+	}
+	return &out
+}
+func NewScreeningReportRes(in entity.ScreeningReport) ScreeningReportRes {
+	return ScreeningReportRes{Report: *ToScreeningReport(in)}
+}
+func (x ScreeningReportList) WithNextCursor(cursor string) ScreeningReportList {
+	if cursor != "" {
+		x.NextCursor = &cursor
+	}
+	return x
+}
+func ToScreeningReportList(in ...entity.ScreeningReport) ScreeningReportList {
+	var list = ScreeningReportList{Reports: make([]ScreeningReport, len(in))}
+	for i, r := range in {
+		list.Reports[i] = *ToScreeningReport(r)
+	}
+	return list
+}
+func (x ScreeningReportList) ToScreeningReports() []entity.ScreeningReport {
+	var list = make([]entity.ScreeningReport, len(x.Reports))
+	for i, r := range x.Reports {
+		list[i] = *r.ToScreeningReport()
+	}
+	return list
+}
+func (x ListScreeningReportsParams) ToFilter(applicationID entity.ID) filters.ScreeningReportFilter {
+	return filters.NewScreeningReportFilter().
+		WithApplicationID(applicationID).
+		WithPage(toPage(x.Sort, x.PageSize, x.Cursor))
+}
+
+// toDatePointer leaves the optional date out of the response when it is zero
+func toDatePointer(in schedule.Date) *Date {
+	if in.IsZero() {
+		return nil
+	}
+	d := ToDate(in)
+	return &d
+}
+
+// toStrings copies the list so the api and domain models never share a slice
+func toStrings(in []string) []string {
+	if len(in) == 0 {
+		return []string{}
+	}
+	return append(make([]string, 0, len(in)), in...)
+}
+
+// toStringsPointer leaves an empty optional list out of the request
+func toStringsPointer(in []string) *[]string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := toStrings(in)
+	return &out
+}
+
+func NewStoreUnitReq(in entity.Unit) *StoreUnitReq {
+	return &StoreUnitReq{
+		Unit: MinUnit{
+			Number:     toPointer(in.Number),
+			Beds:       toPointer(in.Beds),
+			Baths:      toPointer(in.Baths),
+			Sqft:       toPointer(in.SqFt),
+			RentTarget: toPointer(ToMoney(in.RentTarget)),
+		},
+	}
+}
+func (x *MinUnit) ToUnit(propertyID entity.ID) entity.Unit {
+	return entity.NewUnit(propertyID, removePointer(x.Number)).
+		WithRooms(removePointer(x.Beds), removePointer(x.Baths)).
+		WithSqFt(removePointer(x.Sqft)).
+		WithRentTarget(toEntityMoney(x.RentTarget))
+}
+func (x *Unit) GetID() string { return x.Id }
+func (x *Unit) ToUnit() *entity.Unit {
+	u := entity.NewUnit(x.PropertyID, removePointer(x.Number)).
+		WithID(x.GetID()).
+		WithRooms(removePointer(x.Beds), removePointer(x.Baths)).
+		WithSqFt(removePointer(x.Sqft)).
+		WithRentTarget(toEntityMoney(x.RentTarget))
+	u.LeaseID = removePointer(x.LeaseID)
+	return &u
+}
+func ToUnit(in entity.Unit) *Unit {
+	return &Unit{
+		Id:         in.GetID(),
+		PropertyID: in.PropertyID,
+		Number:     toPointer(in.Number),
+		Beds:       toPointer(in.Beds),
+		Baths:      toPointer(in.Baths),
+		Sqft:       toPointer(in.SqFt),
+		RentTarget: toPointer(ToMoney(in.RentTarget)),
+		LeaseID:    toPointer(in.LeaseID),
+	}
+}
+func NewUnitRes(in entity.Unit) UnitRes {
+	return UnitRes{Unit: *ToUnit(in)}
+}
+func ToUnitList(in ...entity.Unit) UnitList {
+	var list = make([]Unit, len(in))
+	for i, u := range in {
+		list[i] = *ToUnit(u)
+	}
+	return UnitList{Units: list}
+}
+func (x UnitList) ToUnits() []entity.Unit {
+	var list = make([]entity.Unit, len(x.Units))
+	for i, u := range x.Units {
+		list[i] = *u.ToUnit()
+	}
+	return list
+}
+
+func NewStoreListingReq(in entity.Listing) *StoreListingReq {
+	return &StoreListingReq{
+		Listing: MinListing{
+			Details:       ToRentalDetails(in.Details),
+			Rent:          toPointer(ToMoney(in.Rent)),
+			AvailableFrom: toPointer(ToDate(in.AvailableFrom)),
+			Description:   toPointer(in.Description),
+			Photos:        ToListingPhotos(in.Photos...),
+		},
+	}
+}
+func (x *MinListing) ToListing(propertyID entity.ID) entity.Listing {
+	return entity.NewListing(propertyID, toEntityMoney(x.Rent), FromDate(removePointer(x.AvailableFrom))).
+		WithDetails(FromRentalDetails(x.Details)).
+		WithDescription(removePointer(x.Description)).
+		WithPhoto(FromListingPhotos(x.Photos)...)
+}
+func (x *Listing) GetID() string { return x.Id }
+func (x *Listing) ToListing() *entity.Listing {
+	l := entity.NewListing(x.PropertyID, toEntityMoney(x.Rent), FromDate(removePointer(x.AvailableFrom))).
+		WithID(x.GetID()).
+		WithDetails(FromRentalDetails(x.Details)).
+		WithDescription(removePointer(x.Description)).
+		WithPhoto(FromListingPhotos(x.Photos)...)
+	l.Status = string(x.Status)
+	l.PublishedAt = removePointer(x.PublishedAt)
+	l.UpdatedAt = removePointer(x.UpdatedAt)
+	return &l
+}
+func ToListing(in entity.Listing) *Listing {
+	return &Listing{
+		Id:            in.GetID(),
+		PropertyID:    in.PropertyID,
+		Details:       ToRentalDetails(in.Details),
+		Rent:          toPointer(ToMoney(in.Rent)),
+		AvailableFrom: toPointer(ToDate(in.AvailableFrom)),
+		Description:   toPointer(in.Description),
+		Photos:        ToListingPhotos(in.Photos...),
+		Status:        ListingStatus(in.Status),
+		PublishedAt:   toPointer(in.PublishedAt),
+		UpdatedAt:     toPointer(in.UpdatedAt),
+	}
+}
+func NewListingRes(in entity.Listing) ListingRes {
+	return ListingRes{Listing: *ToListing(in)}
+}
+func ToRentalDetails(in entity.RentalDetails) *RentalDetails {
+	return &RentalDetails{
+		AllowSmoking:  toPointer(in.AllowSmoking),
+		AllowPets:     toPointer(in.AllowPets),
+		ParkingSpaces: toPointer(in.ParkingSpaces),
+		ParkingDesc:   toPointer(in.ParkingDesc),
+	}
+}
+func FromRentalDetails(in *RentalDetails) entity.RentalDetails {
+	if in == nil {
+		return entity.RentalDetails{}
+	}
+	return entity.RentalDetails{
+		AllowSmoking:  removePointer(in.AllowSmoking),
+		AllowPets:     removePointer(in.AllowPets),
+		ParkingSpaces: removePointer(in.ParkingSpaces),
+		ParkingDesc:   removePointer(in.ParkingDesc),
+	}
+}
+func ToListingPhotos(in ...entity.ListingPhoto) *[]ListingPhoto {
+	if len(in) == 0 {
+		return nil
+	}
+	var list = make([]ListingPhoto, len(in))
+	for i, p := range in {
+		list[i] = ListingPhoto{
+			Url:     p.URL,
+			Caption: toPointer(p.Caption),
+			Width:   toPointer(p.Width),
+			Height:  toPointer(p.Height),
+		}
+	}
+	return &list
+}
+func FromListingPhotos(in *[]ListingPhoto) []entity.ListingPhoto {
+	if in == nil {
+		return nil
+	}
+	var list = make([]entity.ListingPhoto, len(*in))
+	for i, p := range *in {
+		list[i] = entity.ListingPhoto{
+			URL:     p.Url,
+			Caption: removePointer(p.Caption),
+			Width:   removePointer(p.Width),
+			Height:  removePointer(p.Height),
+		}
+	}
+	return list
+}
+func (x PublicListingList) WithNextCursor(cursor string) PublicListingList {
+	if cursor != "" {
+		x.NextCursor = &cursor
+	}
+	return x
+}
+func ToPublicListingList(in ...usecase.PublicListing) PublicListingList {
+	var list = PublicListingList{Listings: make([]PublicListing, len(in))}
+	for i, pl := range in {
+		list.Listings[i] = PublicListing{
+			Listing:  *ToListing(pl.Listing),
+			Property: *ToProperty(pl.Property),
+		}
+	}
+	return list
+}
+func (x PublicListingList) ToPublicListings() []usecase.PublicListing {
+	var list = make([]usecase.PublicListing, len(x.Listings))
+	for i, pl := range x.Listings {
+		list[i] = usecase.PublicListing{
+			Listing:  *pl.Listing.ToListing(),
+			Property: pl.Property.ToProperty(),
 		}
 	}
 	return list
 }
 
+// ToFilter rent bounds are in the currency, USD when it is not given
+func (x *ListPublicListingsParams) ToFilter() filters.ListingFilter {
+	var (
+		currency = removePointer(x.Currency)
+		minRent  entity.Money
+		maxRent  entity.Money
+	)
+	if currency == "" {
+		currency = entity.CurrencyUSD
+	}
+	if x.MinRent != nil {
+		minRent = entity.NewMoney(*x.MinRent, currency)
+	}
+	if x.MaxRent != nil {
+		maxRent = entity.NewMoney(*x.MaxRent, currency)
+	}
+	f := filters.NewListingFilter().
+		WithCity(removePointer(x.City)).
+		WithRentRange(minRent, maxRent).
+		WithPage(toPage(x.Sort, x.PageSize, x.Cursor))
+	if removePointer(x.PetsAllowed) {
+		f = f.WithPetsAllowed()
+	}
+	return f
+}
+func NewListPublicListingsParams(f filters.ListingFilter) *ListPublicListingsParams {
+	var params = ListPublicListingsParams{
+		City:        toPointer(f.City),
+		PetsAllowed: toPointer(f.PetsAllowed),
+	}
+	for _, m := range []entity.Money{f.MinRent, f.MaxRent} {
+		if m.Currency != "" {
+			params.Currency = toPointer(m.Currency)
+		}
+	}
+	if f.MinRent != (entity.Money{}) {
+		params.MinRent = &f.MinRent.Minor
+	}
+	if f.MaxRent != (entity.Money{}) {
+		params.MaxRent = &f.MaxRent.Minor
+	}
+	return &params
+}
+
+func ToOutboxMessage(in event.Message) OutboxMessage {
+	var payload map[string]any
+	// the payload is always the json of the event the message was created with
+	_ = json.Unmarshal(in.Payload, &payload)
+	return OutboxMessage{
+		Id:            in.ID,
+		Name:          in.Name,
+		Payload:       payload,
+		Status:        OutboxStatus(in.Status),
+		Attempts:      in.Attempts,
+		LastError:     toPointer(in.LastError),
+		CreatedAt:     in.CreatedAt,
+		NextAttemptAt: toPointer(in.NextAttempt),
+		DeliveredAt:   toPointer(in.DeliveredAt),
+	}
+}
+func (x OutboxMessage) ToMessage() (*event.Message, error) {
+	payload, err := json.Marshal(x.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return &event.Message{
+		ID:          x.Id,
+		Name:        x.Name,
+		Payload:     payload,
+		Status:      string(x.Status),
+		Attempts:    x.Attempts,
+		LastError:   removePointer(x.LastError),
+		CreatedAt:   x.CreatedAt,
+		NextAttempt: removePointer(x.NextAttemptAt),
+		DeliveredAt: removePointer(x.DeliveredAt),
+	}, nil
+}
+func NewOutboxMessageRes(in event.Message) OutboxMessageRes {
+	return OutboxMessageRes{Message: ToOutboxMessage(in)}
+}
+func (x OutboxMessageList) WithNextCursor(cursor string) OutboxMessageList {
+	if cursor != "" {
+		x.NextCursor = &cursor
+	}
+	return x
+}
+func ToOutboxMessageList(in ...event.Message) OutboxMessageList {
+	var list = OutboxMessageList{Messages: make([]OutboxMessage, len(in))}
+	for i, m := range in {
+		list.Messages[i] = ToOutboxMessage(m)
+	}
+	return list
+}
+func (x ListOutboxParams) ToFilter() filters.OutboxFilter {
+	return filters.NewOutboxFilter().
+		WithStatus(string(removePointer(x.Status))).
+		WithName(removePointer(x.Name)).
+		WithPage(toPage(x.Sort, x.PageSize, x.Cursor))
+}
+func NewListOutboxParams(f filters.OutboxFilter) *ListOutboxParams {
+	return &ListOutboxParams{
+		Status: toPointer(OutboxStatus(f.Status)),
+		Name:   toPointer(f.Name),
+	}
+}
+
+func ToWebhook(in entity.Webhook) Webhook {
+	var events *[]string
+	if len(in.Events) > 0 {
+		events = &in.Events
+	}
+	return Webhook{
+		Id:        toPointer(in.ID),
+		Url:       in.URL,
+		Events:    events,
+		Secret:    toPointer(in.Secret),
+		CreatedAt: toPointer(in.CreatedAt),
+	}
+}
+func (x Webhook) ToWebhook() entity.Webhook {
+	var events []string
+	if x.Events != nil {
+		events = *x.Events
+	}
+	w := entity.NewWebhook(x.Url, removePointer(x.Secret), events...).WithID(removePointer(x.Id))
+	w.CreatedAt = removePointer(x.CreatedAt)
+	return w
+}
+func NewStoreWebhookReq(in entity.Webhook) StoreWebhookReq {
+	return StoreWebhookReq{Webhook: ToWebhook(in)}
+}
+func NewWebhookRes(in entity.Webhook) WebhookRes {
+	return WebhookRes{Webhook: ToWebhook(in)}
+}
+func ToWebhookList(in ...entity.Webhook) WebhookList {
+	var list = WebhookList{Webhooks: make([]Webhook, len(in))}
+	for i, w := range in {
+		list.Webhooks[i] = ToWebhook(w)
+	}
+	return list
+}
+func ToWebhookDelivery(in entity.WebhookDelivery) WebhookDelivery {
+	var payload map[string]any
+	// the payload is always the json object posted to the webhook
+	_ = json.Unmarshal(in.Payload, &payload)
+	return WebhookDelivery{
+		Id:            in.ID,
+		WebhookID:     in.WebhookID,
+		Event:         in.Event,
+		Payload:       payload,
+		Status:        WebhookDeliveryStatus(in.Status),
+		StatusCode:    in.StatusCode,
+		Attempts:      in.Attempts,
+		LastError:     toPointer(in.LastError),
+		RedeliveryOf:  toPointer(in.RedeliveryOf),
+		CreatedAt:     in.CreatedAt,
+		NextAttemptAt: toPointer(in.NextAttempt),
+		DeliveredAt:   toPointer(in.DeliveredAt),
+	}
+}
+func (x WebhookDelivery) ToWebhookDelivery() (*entity.WebhookDelivery, error) {
+	payload, err := json.Marshal(x.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.WebhookDelivery{
+		ID:           x.Id,
+		WebhookID:    x.WebhookID,
+		Event:        x.Event,
+		Payload:      payload,
+		Status:       string(x.Status),
+		StatusCode:   x.StatusCode,
+		Attempts:     x.Attempts,
+		LastError:    removePointer(x.LastError),
+		RedeliveryOf: removePointer(x.RedeliveryOf),
+		CreatedAt:    x.CreatedAt,
+		NextAttempt:  removePointer(x.NextAttemptAt),
+		DeliveredAt:  removePointer(x.DeliveredAt),
+	}, nil
+}
+func NewWebhookDeliveryRes(in entity.WebhookDelivery) WebhookDeliveryRes {
+	return WebhookDeliveryRes{Delivery: ToWebhookDelivery(in)}
+}
+func (x WebhookDeliveryList) WithNextCursor(cursor string) WebhookDeliveryList {
+	if cursor != "" {
+		x.NextCursor = &cursor
+	}
+	return x
+}
+func ToWebhookDeliveryList(in ...entity.WebhookDelivery) WebhookDeliveryList {
+	var list = WebhookDeliveryList{Deliveries: make([]WebhookDelivery, len(in))}
+	for i, d := range in {
+		list.Deliveries[i] = ToWebhookDelivery(d)
+	}
+	return list
+}
+func (x ListWebhookDeliveriesParams) ToFilter(webhookID entity.ID) filters.WebhookDeliveryFilter {
+	return filters.NewWebhookDeliveryFilter().
+		WithWebhookID(webhookID).
+		WithStatus(string(removePointer(x.Status))).
+		WithPage(toPage(x.Sort, x.PageSize, x.Cursor))
+}
+
+// toEntityMoney is the zero value when the optional amount is missing
+func toEntityMoney(in *Money) entity.Money {
+	if in == nil {
+		return entity.Money{}
+	}
+	return in.ToMoney()
+}
+
+// toPointer returns nil for the zero value so optional fields are omitted
+func ToAuditEntry(in audit.Entry) AuditEntry {
+	var diff map[string]any
+	// the diff is always a json object of the fields which changed
+	_ = json.Unmarshal(in.Diff, &diff)
+	return AuditEntry{
+		Id:         in.ID,
+		Actor:      toPointer(in.Actor),
+		Transport:  toPointer(AuditEntryTransport(in.Transport)),
+		EntityType: in.EntityType,
+		EntityID:   in.EntityID,
+		Action:     AuditEntryAction(in.Action),
+		Diff:       diff,
+		CreatedAt:  in.CreatedAt,
+	}
+}
+func (x AuditEntry) ToEntry() (*audit.Entry, error) {
+	diff, err := json.Marshal(x.Diff)
+	if err != nil {
+		return nil, err
+	}
+	return &audit.Entry{
+		ID:         x.Id,
+		Actor:      removePointer(x.Actor),
+		Transport:  string(removePointer(x.Transport)),
+		EntityType: x.EntityType,
+		EntityID:   x.EntityID,
+		Action:     string(x.Action),
+		Diff:       diff,
+		CreatedAt:  x.CreatedAt,
+	}, nil
+}
+func (x AuditList) WithNextCursor(cursor string) AuditList {
+	if cursor != "" {
+		x.NextCursor = &cursor
+	}
+	return x
+}
+func ToAuditList(in ...audit.Entry) AuditList {
+	var list = AuditList{Entries: make([]AuditEntry, len(in))}
+	for i, e := range in {
+		list.Entries[i] = ToAuditEntry(e)
+	}
+	return list
+}
+func (x ListAuditParams) ToFilter() filters.AuditFilter {
+	return filters.NewAuditFilter().
+		WithEntity(string(removePointer(x.Entity)), removePointer(x.EntityID)).
+		WithActor(removePointer(x.Actor)).
+		WithPage(toPage(x.Sort, x.PageSize, x.Cursor))
+}
+func NewListAuditParams(f filters.AuditFilter) *ListAuditParams {
+	return &ListAuditParams{
+		Entity:   toPointer(ListAuditParamsEntity(f.EntityType)),
+		EntityID: toPointer(f.EntityID),
+		Actor:    toPointer(f.Actor),
+	}
+}
+
+func toPointer[T comparable](in T) *T {
+	var zero T
+	if in == zero {
+		return nil
+	}
+	return &in
+}
 func removePointer[T any](in *T) T {
 	var out T
 	if in != nil {
@@ -1,17 +1,22 @@
 package rest
 
 import (
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
+	"net/url"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/tempcke/rpm/actions"
 	oapi "github.com/tempcke/rpm/api/rest/openapi"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/internal/lib/log"
+	"github.com/tempcke/rpm/usecase"
 )
 
 const (
@@ -26,23 +31,924 @@ type (
 		actions   actions.Actions
 		apiKey    string
 		apiSecret string
+		piiKey    string
+		piiSecret string
 	}
 	Header struct{ k, v string }
 )
 
 func (s *Server) LeaseProperty(w http.ResponseWriter, r *http.Request) {
-	// TODO implement me
-	panic("implement me")
+	var (
+		ctx  = r.Context()
+		data oapi.LeasePropertyReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	lease, err := s.actions.LeaseProperty(ctx, data.Lease.ToLease().WithID(entity.NewID()))
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+
+	jsonResponse(w, http.StatusCreated, oapi.NewGetLeaseRes(*lease),
+		Header{"Location", "/lease/" + lease.ID})
+}
+func (s *Server) GetLease(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	lease, err := s.actions.GetLease(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	history, err := s.actions.GetLeaseHistory(ctx, id)
+	if err != nil {
+		s.logError(err)
+		errorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewGetLeaseRes(*lease).WithHistory(*history))
+}
+func (s *Server) TerminateLease(w http.ResponseWriter, r *http.Request, id string) {
+	var (
+		ctx  = r.Context()
+		data oapi.TerminateLeaseReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	lease, err := s.actions.TerminateLease(ctx, id, oapi.FromDate(data.EndDate), data.GetReason())
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewGetLeaseRes(*lease))
+}
+func (s *Server) RenewLease(w http.ResponseWriter, r *http.Request, id string) {
+	var (
+		ctx  = r.Context()
+		data oapi.RenewLeaseReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	lease, err := s.actions.RenewLease(ctx, id, data.ToLeaseRenewal().WithLeaseID(entity.NewID()))
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusCreated, oapi.NewGetLeaseRes(*lease),
+		Header{"Location", "/lease/" + lease.ID})
+}
+func (s *Server) AmendLease(w http.ResponseWriter, r *http.Request, id string) {
+	var (
+		ctx  = r.Context()
+		data oapi.AmendLeaseReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	lease, err := s.actions.AmendLease(ctx, id, data.ToLeaseAmendment())
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewGetLeaseRes(*lease))
+}
+func (s *Server) GetRentSchedule(w http.ResponseWriter, r *http.Request, id string, params oapi.GetRentScheduleParams) {
+	ctx := r.Context()
+	list, err := s.actions.GetRentSchedule(ctx, id, params.ToScheduleOptions())
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.ToRentSchedule(list...))
+}
+func (s *Server) ListLeases(w http.ResponseWriter, r *http.Request, params oapi.ListLeasesParams) {
+	var (
+		ctx = r.Context()
+		f   = params.ToFilter()
+	)
+	list, err := s.actions.ListLeases(ctx, f)
+	if err != nil {
+		s.listErrorResponse(w, err)
+		return
+	}
+	cursor := filters.NextCursor(list, f.Paging(), filters.LeaseSortValue)
+	jsonResponse(w, http.StatusOK, oapi.ToLeaseList(list...).WithNextCursor(cursor), nextLink(r, cursor)...)
+}
+
+func (s *Server) PostLedgerEntry(w http.ResponseWriter, r *http.Request, leaseID string) {
+	var (
+		ctx  = r.Context()
+		data oapi.PostLedgerEntryReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	entry, err := s.actions.PostLedgerEntry(ctx, data.Entry.ToLedgerEntry(leaseID).WithID(entity.NewID()))
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusCreated, oapi.NewLedgerEntryRes(*entry))
+}
+func (s *Server) ReverseLedgerEntry(w http.ResponseWriter, r *http.Request, leaseID string, entryID string) {
+	var (
+		ctx  = r.Context()
+		data oapi.ReverseLedgerEntryReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	date, memo := data.ToReversal()
+	entry, err := s.actions.ReverseLedgerEntry(ctx, leaseID, entryID, date, memo)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusCreated, oapi.NewLedgerEntryRes(*entry))
+}
+func (s *Server) GetStatement(w http.ResponseWriter, r *http.Request, leaseID string, params oapi.GetStatementParams) {
+	var (
+		ctx         = r.Context()
+		from, until = params.ToDateRange()
+	)
+	statement, err := s.actions.GetStatement(ctx, leaseID, from, until)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.ToStatement(*statement))
+}
+func (s *Server) GetBalance(w http.ResponseWriter, r *http.Request, leaseID string, params oapi.GetBalanceParams) {
+	var (
+		ctx  = r.Context()
+		asOf = params.ToAsOf()
+	)
+	balance, err := s.actions.GetBalance(ctx, leaseID, asOf)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewBalance(leaseID, asOf, balance))
+}
+
+func (s *Server) StoreLeaseLateFeePolicy(w http.ResponseWriter, r *http.Request, leaseID string) {
+	s.storeLateFeePolicy(w, r, func(p entity.LateFeePolicy) entity.LateFeePolicy {
+		return p.ForLease(leaseID)
+	})
+}
+func (s *Server) StorePropertyLateFeePolicy(w http.ResponseWriter, r *http.Request, propertyID string) {
+	s.storeLateFeePolicy(w, r, func(p entity.LateFeePolicy) entity.LateFeePolicy {
+		return p.ForProperty(propertyID)
+	})
+}
+func (s *Server) storeLateFeePolicy(w http.ResponseWriter, r *http.Request, scope func(entity.LateFeePolicy) entity.LateFeePolicy) {
+	var (
+		ctx  = r.Context()
+		data oapi.StoreLateFeePolicyReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	policy, err := s.actions.StoreLateFeePolicy(ctx, scope(data.Policy.ToLateFeePolicy()))
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewLateFeePolicyRes(*policy))
+}
+func (s *Server) GetLateFeePolicy(w http.ResponseWriter, r *http.Request, leaseID string) {
+	ctx := r.Context()
+	policy, err := s.actions.GetLateFeePolicy(ctx, leaseID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewLateFeePolicyRes(*policy))
+}
+func (s *Server) AssessLateFees(w http.ResponseWriter, r *http.Request, leaseID string, params oapi.AssessLateFeesParams) {
+	ctx := r.Context()
+	fees, err := s.actions.AssessLateFees(ctx, leaseID, params.ToAsOf())
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.ToLateFeeList(fees...))
+}
+func (s *Server) ApplyLateFees(w http.ResponseWriter, r *http.Request, leaseID string) {
+	var (
+		ctx  = r.Context()
+		data oapi.ApplyLateFeesReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	entries, err := s.actions.ApplyLateFees(ctx, leaseID, data.ToAsOf())
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.ToLedgerEntryList(entries...))
+}
+
+func (s *Server) GetDeposit(w http.ResponseWriter, r *http.Request, leaseID string) {
+	ctx := r.Context()
+	account, err := s.actions.GetDeposit(ctx, leaseID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.ToDepositAccount(*account))
+}
+func (s *Server) RecordDepositReceipt(w http.ResponseWriter, r *http.Request, leaseID string) {
+	var (
+		ctx  = r.Context()
+		data oapi.RecordDepositReceiptReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	receipt, err := s.actions.RecordDepositReceipt(ctx, data.Receipt.ToDepositReceipt(leaseID))
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusCreated, oapi.NewDepositReceiptRes(*receipt))
+}
+func (s *Server) DisposeDeposit(w http.ResponseWriter, r *http.Request, leaseID string) {
+	var (
+		ctx  = r.Context()
+		data oapi.DisposeDepositReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	disposition, err := s.actions.DisposeDeposit(ctx, data.Disposition.ToDepositDisposition(leaseID))
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusCreated, oapi.NewDepositDispositionRes(*disposition))
+}
+func (s *Server) GetDepositStatement(w http.ResponseWriter, r *http.Request, leaseID string) {
+	ctx := r.Context()
+	statement, err := s.actions.GetDepositStatement(ctx, leaseID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	textResponse(w, http.StatusOK, statement)
+}
+
+func (s *Server) SubmitApplication(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx  = r.Context()
+		data oapi.SubmitApplicationReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	app, err := s.actions.SubmitApplication(ctx, data.Application.ToRentalApplication().WithID(entity.NewID()))
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusCreated, oapi.NewApplicationRes(s.maskApplication(r, *app)),
+		Header{"Location", "/application/" + app.ID})
+}
+func (s *Server) GetApplication(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	app, err := s.actions.GetApplication(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewApplicationRes(s.maskApplication(r, *app)))
+}
+func (s *Server) ListApplications(w http.ResponseWriter, r *http.Request, params oapi.ListApplicationsParams) {
+	var (
+		ctx = r.Context()
+		f   = params.ToFilter()
+	)
+	list, err := s.actions.ListApplications(ctx, f)
+	if err != nil {
+		s.listErrorResponse(w, err)
+		return
+	}
+	cursor := filters.NextCursor(list, f.Page, filters.ApplicationSortValue)
+	for i := range list {
+		list[i] = s.maskApplication(r, list[i])
+	}
+	jsonResponse(w, http.StatusOK, oapi.ToApplicationList(list...).WithNextCursor(cursor), nextLink(r, cursor)...)
+}
+func (s *Server) UpdateApplicationStatus(w http.ResponseWriter, r *http.Request, id string) {
+	var (
+		ctx  = r.Context()
+		data oapi.UpdateApplicationStatusReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	app, err := s.actions.UpdateApplicationStatus(ctx, id, string(data.Status), data.GetNote())
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewApplicationRes(s.maskApplication(r, *app)))
+}
+func (s *Server) ConvertApplication(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	conversion, err := s.actions.ConvertApplication(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	conversion.Application = s.maskApplication(r, conversion.Application)
+	for i := range conversion.Tenants {
+		conversion.Tenants[i] = s.maskTenant(r, conversion.Tenants[i])
+	}
+	jsonResponse(w, http.StatusCreated, oapi.ToApplicationConversion(*conversion))
+}
+func (s *Server) StoreScreeningPolicy(w http.ResponseWriter, r *http.Request, propertyID string) {
+	var (
+		ctx  = r.Context()
+		data oapi.StoreScreeningPolicyReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	policy, err := s.actions.StoreScreeningPolicy(ctx, data.Policy.ToScreeningPolicy(propertyID))
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewScreeningPolicyRes(*policy))
+}
+func (s *Server) GetScreeningPolicy(w http.ResponseWriter, r *http.Request, propertyID string) {
+	ctx := r.Context()
+	policy, err := s.actions.GetScreeningPolicy(ctx, propertyID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewScreeningPolicyRes(*policy))
+}
+func (s *Server) ScreenApplication(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	report, err := s.actions.ScreenApplication(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusCreated, oapi.NewScreeningReportRes(*report))
+}
+func (s *Server) ListScreeningReports(w http.ResponseWriter, r *http.Request, id string, params oapi.ListScreeningReportsParams) {
+	var (
+		ctx = r.Context()
+		f   = params.ToFilter(id)
+	)
+	reports, err := s.actions.ListScreeningReports(ctx, f)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		default:
+			s.listErrorResponse(w, err)
+		}
+		return
+	}
+	cursor := filters.NextCursor(reports, f.Page, filters.ScreeningReportSortValue)
+	jsonResponse(w, http.StatusOK, oapi.ToScreeningReportList(reports...).WithNextCursor(cursor), nextLink(r, cursor)...)
+}
+func (s *Server) StoreListing(w http.ResponseWriter, r *http.Request, propertyID string) {
+	var (
+		ctx  = r.Context()
+		data oapi.StoreListingReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	listing, err := s.actions.StoreListing(ctx, data.Listing.ToListing(propertyID))
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewListingRes(*listing))
+}
+func (s *Server) GetListing(w http.ResponseWriter, r *http.Request, propertyID string) {
+	ctx := r.Context()
+	listing, err := s.actions.GetListing(ctx, propertyID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewListingRes(*listing))
+}
+func (s *Server) PublishListing(w http.ResponseWriter, r *http.Request, propertyID string) {
+	ctx := r.Context()
+	listing, err := s.actions.PublishListing(ctx, propertyID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewListingRes(*listing))
+}
+func (s *Server) UnpublishListing(w http.ResponseWriter, r *http.Request, propertyID string) {
+	ctx := r.Context()
+	listing, err := s.actions.UnpublishListing(ctx, propertyID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewListingRes(*listing))
+}
+
+// ListPublicListings is public, it is called by the marketing site without credentials
+func (s *Server) ListPublicListings(w http.ResponseWriter, r *http.Request, params oapi.ListPublicListingsParams) {
+	var (
+		ctx = r.Context()
+		f   = params.ToFilter()
+	)
+	list, err := s.actions.ListPublicListings(ctx, f)
+	if err != nil {
+		s.listErrorResponse(w, err)
+		return
+	}
+	cursor := filters.NextCursor(list, f.Paging(), usecase.PublicListingSortValue)
+	jsonResponse(w, http.StatusOK, oapi.ToPublicListingList(list...).WithNextCursor(cursor), nextLink(r, cursor)...)
+}
+func (s *Server) ListOutbox(w http.ResponseWriter, r *http.Request, params oapi.ListOutboxParams) {
+	var (
+		ctx = r.Context()
+		f   = params.ToFilter()
+	)
+	list, err := s.actions.ListOutbox(ctx, f)
+	if err != nil {
+		s.listErrorResponse(w, err)
+		return
+	}
+	cursor := filters.NextCursor(list, f.Page, filters.OutboxSortValue)
+	jsonResponse(w, http.StatusOK, oapi.ToOutboxMessageList(list...).WithNextCursor(cursor), nextLink(r, cursor)...)
+}
+func (s *Server) GetOutboxMessage(w http.ResponseWriter, r *http.Request, messageID string) {
+	ctx := r.Context()
+	m, err := s.actions.GetOutboxMessage(ctx, messageID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewOutboxMessageRes(*m))
+}
+func (s *Server) ReplayOutboxMessage(w http.ResponseWriter, r *http.Request, messageID string) {
+	ctx := r.Context()
+	m, err := s.actions.ReplayOutboxMessage(ctx, messageID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewOutboxMessageRes(*m))
+}
+func (s *Server) ListAudit(w http.ResponseWriter, r *http.Request, params oapi.ListAuditParams) {
+	var (
+		ctx = r.Context()
+		f   = params.ToFilter()
+	)
+	list, err := s.actions.ListAudit(ctx, f)
+	if err != nil {
+		s.listErrorResponse(w, err)
+		return
+	}
+	cursor := filters.NextCursor(list, f.Page, filters.AuditSortValue)
+	jsonResponse(w, http.StatusOK, oapi.ToAuditList(list...).WithNextCursor(cursor), nextLink(r, cursor)...)
+}
+func (s *Server) AddWebhook(w http.ResponseWriter, r *http.Request) {
+	s.StoreWebhook(w, r, entity.NewID())
+}
+func (s *Server) StoreWebhook(w http.ResponseWriter, r *http.Request, webhookID string) {
+	var (
+		ctx     = r.Context()
+		resCode = http.StatusCreated
+		data    oapi.StoreWebhookReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	if cur, _ := s.actions.GetWebhook(ctx, webhookID); cur != nil {
+		resCode = http.StatusOK
+	}
+
+	hook, err := s.actions.StoreWebhook(ctx, data.Webhook.ToWebhook().WithID(webhookID))
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, resCode, oapi.NewWebhookRes(*hook),
+		Header{"Location", "/webhook/" + hook.ID})
 }
-func (s *Server) GetLease(w http.ResponseWriter, r *http.Request, id string) {
-	// TODO implement me
-	panic("implement me")
+func (s *Server) GetWebhook(w http.ResponseWriter, r *http.Request, webhookID string) {
+	ctx := r.Context()
+	hook, err := s.actions.GetWebhook(ctx, webhookID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewWebhookRes(*hook))
+}
+func (s *Server) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	var ctx = r.Context()
+	list, err := s.actions.ListWebhooks(ctx)
+	if err != nil {
+		s.logError(err)
+		errorResponse(w, http.StatusInternalServerError, "Error fetching list")
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.ToWebhookList(list...))
 }
-func (s *Server) ListLeases(w http.ResponseWriter, r *http.Request) {
-	// TODO implement me
-	panic("implement me")
+func (s *Server) DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookID string) {
+	ctx := r.Context()
+	if err := s.actions.RemoveWebhook(ctx, webhookID); err != nil {
+		switch {
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+func (s *Server) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookID string, params oapi.ListWebhookDeliveriesParams) {
+	var (
+		ctx = r.Context()
+		f   = params.ToFilter(webhookID)
+	)
+	list, err := s.actions.ListWebhookDeliveries(ctx, f)
+	if err != nil {
+		s.listErrorResponse(w, err)
+		return
+	}
+	cursor := filters.NextCursor(list, f.Paging(), filters.DeliverySortValue)
+	jsonResponse(w, http.StatusOK, oapi.ToWebhookDeliveryList(list...).WithNextCursor(cursor), nextLink(r, cursor)...)
+}
+func (s *Server) GetWebhookDelivery(w http.ResponseWriter, r *http.Request, deliveryID string) {
+	ctx := r.Context()
+	d, err := s.actions.GetWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewWebhookDeliveryRes(*d))
+}
+func (s *Server) RedeliverWebhook(w http.ResponseWriter, r *http.Request, deliveryID string) {
+	ctx := r.Context()
+	d, err := s.actions.RedeliverWebhook(ctx, deliveryID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewWebhookDeliveryRes(*d))
 }
-
 func (s *Server) AddTenant(w http.ResponseWriter, r *http.Request) {
 	s.StoreTenant(w, r, entity.NewID())
 }
@@ -63,11 +969,19 @@ func (s *Server) StoreTenant(w http.ResponseWriter, r *http.Request, id string)
 	tenant := data.Tenant.ToTenant().WithID(id)
 
 	if _, err := s.actions.StoreTenant(ctx, tenant); err != nil {
-		errorResponse(w, http.StatusBadRequest, err.Error())
+		switch {
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
 		return
 	}
 
-	jsonResponse(w, resCode, oapi.NewGetTenantRes(tenant),
+	jsonResponse(w, resCode, oapi.NewGetTenantRes(s.maskTenant(r, tenant)),
 		Header{"Location", "/tenant/" + tenant.ID})
 }
 func (s *Server) GetTenant(w http.ResponseWriter, r *http.Request, id string) {
@@ -84,17 +998,115 @@ func (s *Server) GetTenant(w http.ResponseWriter, r *http.Request, id string) {
 		}
 		return
 	}
-	jsonResponse(w, http.StatusOK, oapi.NewGetTenantRes(*tenant))
+	jsonResponse(w, http.StatusOK, oapi.NewGetTenantRes(s.maskTenant(r, *tenant)))
 }
-func (s *Server) ListTenants(w http.ResponseWriter, r *http.Request) {
-	var ctx = r.Context()
-	list, err := s.actions.ListTenants(ctx)
+func (s *Server) ListTenants(w http.ResponseWriter, r *http.Request, params oapi.ListTenantsParams) {
+	var (
+		ctx = r.Context()
+		f   = params.ToFilter()
+	)
+	list, err := s.actions.ListTenants(ctx, f)
 	if err != nil {
-		s.logError(err)
-		errorResponse(w, http.StatusInternalServerError, "Error fetching list")
+		s.listErrorResponse(w, err)
+		return
+	}
+	cursor := filters.NextCursor(list, f.Page, filters.TenantSortValue)
+	for i := range list {
+		list[i] = s.maskTenant(r, list[i])
+	}
+	jsonResponse(w, http.StatusOK, oapi.ToTenantList(list...).WithNextCursor(cursor), nextLink(r, cursor)...)
+}
+func (s *Server) PatchTenant(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, "Request body could not be read")
+		return
+	}
+	patch, err := oapi.ToTenantPatch(body)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, "Request body was not a valid json merge patch")
+		return
+	}
+	tenant, err := s.actions.PatchTenant(ctx, id, patch)
+	if err != nil {
+		s.tenantErrorResponse(w, err)
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewGetTenantRes(s.maskTenant(r, *tenant)))
+}
+func (s *Server) DeleteTenant(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	if err := s.actions.RemoveTenant(ctx, id); err != nil {
+		s.tenantErrorResponse(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+func (s *Server) RestoreTenant(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	tenant, err := s.actions.RestoreTenant(ctx, id)
+	if err != nil {
+		s.tenantErrorResponse(w, err)
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewGetTenantRes(s.maskTenant(r, *tenant)))
+}
+func (s *Server) AddTenantPhone(w http.ResponseWriter, r *http.Request, id string) {
+	var (
+		ctx  = r.Context()
+		data oapi.Phone
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+	tenant, err := s.actions.AddTenantPhone(ctx, id, data.ToPhone())
+	if err != nil {
+		s.tenantErrorResponse(w, err)
+		return
+	}
+	jsonResponse(w, http.StatusCreated, oapi.NewGetTenantRes(s.maskTenant(r, *tenant)),
+		Header{"Location", "/tenant/" + tenant.ID + "/phone/" + data.Number})
+}
+func (s *Server) UpdateTenantPhone(w http.ResponseWriter, r *http.Request, id string, number string) {
+	var (
+		ctx  = r.Context()
+		data oapi.Phone
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+	tenant, err := s.actions.UpdateTenantPhone(ctx, id, number, data.ToPhone())
+	if err != nil {
+		s.tenantErrorResponse(w, err)
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewGetTenantRes(s.maskTenant(r, *tenant)))
+}
+func (s *Server) RemoveTenantPhone(w http.ResponseWriter, r *http.Request, id string, number string) {
+	ctx := r.Context()
+	if err := s.actions.RemoveTenantPhone(ctx, id, number); err != nil {
+		s.tenantErrorResponse(w, err)
 		return
 	}
-	jsonResponse(w, http.StatusOK, oapi.ToTenantList(list...))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// tenantErrorResponse writes the response for an error from a tenant action
+func (s *Server) tenantErrorResponse(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, internal.ErrEntityInvalid):
+		validationResponse(w, err)
+	case errors.Is(err, internal.ErrEntityNotFound):
+		errorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, internal.ErrConflict):
+		errorResponse(w, http.StatusConflict, err.Error())
+	case errors.Is(err, internal.ErrInternal):
+		s.logError(err)
+		errorResponse(w, http.StatusInternalServerError, err.Error())
+	default:
+		errorResponse(w, http.StatusBadRequest, err.Error())
+	}
 }
 
 func (s *Server) AddProperty(w http.ResponseWriter, r *http.Request) {
@@ -118,7 +1130,22 @@ func (s *Server) StoreProperty(w http.ResponseWriter, r *http.Request, id string
 	property.ID = id
 
 	if _, err := s.actions.StoreProperty(ctx, property); err != nil {
-		errorResponse(w, http.StatusBadRequest, err.Error())
+		var dup usecase.DuplicateAddressError
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.As(err, &dup):
+			// the client can read or update the property which has the address
+			errorResponse(w, http.StatusConflict, err.Error(),
+				Header{"Location", "/property/" + dup.PropertyID})
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
 		return
 	}
 
@@ -148,11 +1175,34 @@ func (s *Server) ListProperties(w http.ResponseWriter, r *http.Request, params o
 	)
 	propList, err := s.actions.ListProperties(ctx, f)
 	if err != nil {
-		s.logError(err)
-		errorResponse(w, http.StatusInternalServerError, "Error fetching list")
+		s.listErrorResponse(w, err)
 		return
 	}
-	jsonResponse(w, http.StatusOK, oapi.NewListPropertiesRes(propList...))
+	cursor := filters.NextCursor(propList, f.Paging(), filters.PropertySortValue)
+	jsonResponse(w, http.StatusOK, oapi.NewListPropertiesRes(propList...).WithNextCursor(cursor), nextLink(r, cursor)...)
+}
+
+// listErrorResponse is the response of a list which failed, an invalid sort or
+// cursor is the fault of the client
+func (s *Server) listErrorResponse(w http.ResponseWriter, err error) {
+	if errors.Is(err, internal.ErrEntityInvalid) {
+		validationResponse(w, err)
+		return
+	}
+	s.logError(err)
+	errorResponse(w, http.StatusInternalServerError, "Error fetching list")
+}
+
+// nextLink is the Link header of the page after the one of the request, none
+// when there is no next cursor
+func nextLink(r *http.Request, cursor string) []Header {
+	if cursor == "" {
+		return nil
+	}
+	q := r.URL.Query()
+	q.Set("cursor", cursor)
+	next := url.URL{Path: r.URL.Path, RawQuery: q.Encode()}
+	return []Header{{"Link", "<" + next.String() + `>; rel="next"`}}
 }
 func (s *Server) DeleteProperty(w http.ResponseWriter, r *http.Request, propertyID string) {
 	ctx := r.Context()
@@ -161,6 +1211,9 @@ func (s *Server) DeleteProperty(w http.ResponseWriter, r *http.Request, property
 		case errors.Is(err, internal.ErrInternal):
 			errorResponse(w, http.StatusInternalServerError, err.Error())
 			return
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+			return
 		case errors.Is(err, internal.ErrEntityNotFound):
 		// what should a restful DELETE endpoint do
 		// when the resource does not exist?
@@ -173,6 +1226,113 @@ func (s *Server) DeleteProperty(w http.ResponseWriter, r *http.Request, property
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
+func (s *Server) RestoreProperty(w http.ResponseWriter, r *http.Request, propertyID string) {
+	ctx := r.Context()
+	property, err := s.actions.RestoreProperty(ctx, propertyID)
+	if err != nil {
+		var dup usecase.DuplicateAddressError
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.As(err, &dup):
+			errorResponse(w, http.StatusConflict, err.Error(),
+				Header{"Location", "/property/" + dup.PropertyID})
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewGetPropertyRes(*property))
+}
+
+func (s *Server) AddUnit(w http.ResponseWriter, r *http.Request, propertyID string) {
+	s.StoreUnit(w, r, propertyID, entity.NewID())
+}
+func (s *Server) StoreUnit(w http.ResponseWriter, r *http.Request, propertyID string, unitID string) {
+	var (
+		ctx     = r.Context()
+		resCode = http.StatusCreated
+		data    oapi.StoreUnitReq
+	)
+	if err := decodeRequestData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	if cur, _ := s.actions.GetUnit(ctx, propertyID, unitID); cur != nil {
+		resCode = http.StatusOK
+	}
+
+	unit, err := s.actions.StoreUnit(ctx, data.Unit.ToUnit(propertyID).WithID(unitID))
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityInvalid):
+			validationResponse(w, err)
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, resCode, oapi.NewUnitRes(*unit),
+		Header{"Location", "/property/" + propertyID + "/unit/" + unit.ID})
+}
+func (s *Server) GetUnit(w http.ResponseWriter, r *http.Request, propertyID string, unitID string) {
+	ctx := r.Context()
+	unit, err := s.actions.GetUnit(ctx, propertyID, unitID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.NewUnitRes(*unit))
+}
+func (s *Server) ListUnits(w http.ResponseWriter, r *http.Request, propertyID string) {
+	ctx := r.Context()
+	list, err := s.actions.ListUnits(ctx, propertyID)
+	if err != nil {
+		switch {
+		case errors.Is(err, internal.ErrEntityNotFound):
+			errorResponse(w, http.StatusNotFound, err.Error())
+		default:
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, "Error fetching list")
+		}
+		return
+	}
+	jsonResponse(w, http.StatusOK, oapi.ToUnitList(list...))
+}
+func (s *Server) DeleteUnit(w http.ResponseWriter, r *http.Request, propertyID string, unitID string) {
+	ctx := r.Context()
+	if err := s.actions.RemoveUnit(ctx, propertyID, unitID); err != nil {
+		switch {
+		case errors.Is(err, internal.ErrConflict):
+			errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, internal.ErrInternal):
+			s.logError(err)
+			errorResponse(w, http.StatusInternalServerError, err.Error())
+		default:
+			errorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
 
 func NewServer(acts actions.Actions) *Server {
 	server := Server{
@@ -186,6 +1346,15 @@ func (s *Server) WithCredentials(key, secret string) *Server {
 	s2.apiKey, s2.apiSecret = key, secret
 	return &s2
 }
+
+// WithPIICredentials which are accepted along with the regular credentials
+// and grant the pii scope, only requests made with them get the ssn, drivers
+// license number and date of birth of tenants and applicants unmasked
+func (s *Server) WithPIICredentials(key, secret string) *Server {
+	s2 := *s
+	s2.piiKey, s2.piiSecret = key, secret
+	return &s2
+}
 func (s *Server) Handler() http.Handler {
 	router := chi.NewRouter()
 	router.Group(func(r chi.Router) {
@@ -209,7 +1378,13 @@ func (s *Server) AuthMW(next http.Handler) http.Handler {
 			reqKey    = r.Header.Get(HeaderAPIKey)
 			reqSecret = r.Header.Get(HeaderAPISecret)
 		)
+		// changes are audited as made by the api key until callers have an identity of their own
+		r = r.WithContext(audit.WithActor(r.Context(), audit.Actor{ID: reqKey, Transport: audit.TransportREST}))
 
+		if !requiresAuth(r) || s.hasPIIScope(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
 		if key != "" && reqKey != key {
 			w.WriteHeader(http.StatusUnauthorized)
 			return
@@ -222,6 +1397,35 @@ func (s *Server) AuthMW(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// requiresAuth is false for operations the openapi spec declares without
+// security, the generated handlers only set the scopes of secured operations
+func requiresAuth(r *http.Request) bool {
+	return r.Context().Value(oapi.KeyScopes) != nil
+}
+
+// hasPIIScope is true when the request was made with the pii credentials,
+// never when the server has no pii key or no pii secret
+func (s *Server) hasPIIScope(r *http.Request) bool {
+	if s.piiKey == "" || s.piiSecret == "" {
+		return false
+	}
+	key := subtle.ConstantTimeCompare([]byte(r.Header.Get(HeaderAPIKey)), []byte(s.piiKey))
+	secret := subtle.ConstantTimeCompare([]byte(r.Header.Get(HeaderAPISecret)), []byte(s.piiSecret))
+	return key&secret == 1
+}
+func (s *Server) maskTenant(r *http.Request, t entity.Tenant) entity.Tenant {
+	if s.hasPIIScope(r) {
+		return t
+	}
+	return t.MaskPII()
+}
+func (s *Server) maskApplication(r *http.Request, a entity.RentalApplication) entity.RentalApplication {
+	if s.hasPIIScope(r) {
+		return a
+	}
+	return a.MaskPII()
+}
 func (s *Server) okHandler(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
@@ -229,13 +1433,24 @@ func (s *Server) logError(err error) {
 	log.Entry().Error(err.Error())
 }
 
-func errorResponse(w http.ResponseWriter, code int, msg string) {
+func errorResponse(w http.ResponseWriter, code int, msg string, headers ...Header) {
 	jsonResponse(w, code, oapi.ErrorResponse{
 		Error: oapi.Error{
 			Code:    0, // TODO
 			Message: msg,
 			Type:    "", // TODO
 		},
+	}, headers...)
+}
+
+// validationResponse lists every internal.FieldError in err so they can all be fixed at once
+func validationResponse(w http.ResponseWriter, err error) {
+	jsonResponse(w, http.StatusBadRequest, oapi.ErrorResponse{
+		Error: oapi.Error{
+			Message: err.Error(),
+			Type:    "validation",
+			Fields:  oapi.ToFieldErrors(internal.FieldErrors(err)...),
+		},
 	})
 }
 func jsonResponse(w http.ResponseWriter, resCode int, data interface{}, headers ...Header) {
@@ -257,6 +1472,13 @@ func jsonResponse(w http.ResponseWriter, resCode int, data interface{}, headers
 		return
 	}
 }
+func textResponse(w http.ResponseWriter, resCode int, text string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(resCode)
+	if _, err := io.WriteString(w, text); err != nil {
+		log.WithError(err).Error("w.Write failed")
+	}
+}
 func decodeRequestData(w http.ResponseWriter, body io.Reader, data interface{}) error {
 	err := json.NewDecoder(body).Decode(&data)
 	if err != nil {
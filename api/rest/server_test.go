@@ -4,7 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -17,6 +21,7 @@ import (
 	"github.com/tempcke/rpm/entity/fake"
 	"github.com/tempcke/rpm/internal"
 	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/schedule"
 )
 
 var ctx = context.Background()
@@ -188,6 +193,27 @@ func TestPutProperty(t *testing.T) {
 		assertEqual(t, p1.StateCode, updated.State)
 		assertEqual(t, p1.Zip, updated.Zip)
 	})
+	t.Run("409 address of another property", func(t *testing.T) {
+		var (
+			p1 = fake.Property()
+			p2 = fake.Property().WithAddress(p1.Address.WithUnit("Apt 3"))
+		)
+		for _, p := range []entity.Property{p1, p2} {
+			res := handleReq(t, s, putReq(t, "/property/"+p.ID, openapi.NewStorePropertyReq(p), headers))
+			require.Equal(t, http.StatusCreated, res.StatusCode)
+		}
+
+		// the unit is left out so it is the address of p1
+		body := openapi.NewStorePropertyReq(p2.WithAddress(p1.Address))
+		body.Property.Street = strings.ToLower(p1.Street)
+		res := handleReq(t, s, putReq(t, "/property/"+p2.ID, body, headers))
+		assertResCode(t, res, http.StatusConflict)
+		assert.Equal(t, "/property/"+p1.ID, res.Header.Get("Location"))
+
+		res = handleReq(t, s, postReq(t, "/property", body, headers))
+		assertResCode(t, res, http.StatusConflict)
+		assert.Equal(t, "/property/"+p1.ID, res.Header.Get("Location"))
+	})
 }
 func TestAddProperty_badRequest(t *testing.T) {
 	var (
@@ -203,6 +229,8 @@ func TestAddProperty_badRequest(t *testing.T) {
 		"no city":   {`{"street": "a", "state": "TX", "zip": "12345"}`},
 		"no state":  {`{"street": "a", "city": "b", "zip": "12345"}`},
 		"no zip":    {`{"street": "a", "city": "b", "state": "TX"}`},
+		"bad state": {`{"street": "a", "city": "b", "state": "XX", "zip": "12345"}`},
+		"bad zip":   {`{"street": "a", "city": "b", "state": "TX", "zip": "1234"}`},
 		"invalid json": {
 			`{
 			"street": "a", 
@@ -292,8 +320,54 @@ func TestListProperties(t *testing.T) {
 		require.NoError(t, json.NewDecoder(res.Body).Decode(&resModel))
 		assert.Len(t, resModel.Properties, 1)
 
+		p2.Rank = 1 // the zip is a whole word of the address
 		assert.Equal(t, p2, resModel.Properties[0].ToProperty())
 	})
+	t.Run("pages follow the Link header", func(t *testing.T) {
+		var (
+			repo = repository.NewInMemoryRepo()
+			s    = rest.NewServer(actions.NewActionsWithRepo(repo)).Handler()
+			want []string
+		)
+		for _, zip := range []string{"10001", "10002", "10003"} {
+			p := fake.Property().WithZip(zip)
+			require.NoError(t, repo.StoreProperty(ctx, p))
+			want = append(want, p.ID)
+		}
+
+		var (
+			got  []string
+			next = route + "?sort=zip&pageSize=2"
+		)
+		for next != "" {
+			res := handleReq(t, s, getReq(t, next, headers))
+			assertResCode(t, res, http.StatusOK)
+			var resModel openapi.ListPropertiesRes
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&resModel))
+			require.LessOrEqual(t, len(resModel.Properties), 2)
+			for _, p := range resModel.Properties {
+				got = append(got, p.GetID())
+			}
+
+			link := res.Header.Get("Link")
+			if resModel.NextCursor == nil {
+				assert.Empty(t, link)
+				break
+			}
+			require.True(t, strings.HasPrefix(link, "<") && strings.HasSuffix(link, `>; rel="next"`), link)
+			next = strings.TrimSuffix(strings.TrimPrefix(link, "<"), `>; rel="next"`)
+			assert.Contains(t, next, "cursor="+*resModel.NextCursor)
+			assert.Contains(t, next, "sort=zip")
+		}
+		assert.Equal(t, want, got)
+	})
+	t.Run("400 invalid sort or cursor", func(t *testing.T) {
+		var s = newServer(t).Handler()
+		for _, query := range []string{"?sort=name", "?cursor=nonsense"} {
+			res := handleReq(t, s, getReq(t, route+query, headers))
+			assertResCode(t, res, http.StatusBadRequest)
+		}
+	})
 }
 func TestGetProperty(t *testing.T) {
 	var (
@@ -379,12 +453,62 @@ func TestDeleteProperty(t *testing.T) {
 		res = handleReq(t, s, req)
 		require.Equal(t, http.StatusNoContent, res.StatusCode)
 	})
+	t.Run("409 property with an active lease", func(t *testing.T) {
+		var (
+			p1    = fake.Property()
+			route = routeBase + p1.ID
+		)
+		var tenant = fake.Tenant()
+		require.NoError(t, repo.StoreProperty(ctx, p1))
+		require.NoError(t, repo.StoreTenant(ctx, tenant))
+		require.NoError(t, repo.StoreLease(ctx, fake.Lease(p1.ID, tenant.ID)))
+
+		res := handleReq(t, s, delReq(t, route, headers))
+		assertResCode(t, res, http.StatusConflict)
+		_, err := repo.GetProperty(ctx, p1.ID)
+		require.NoError(t, err)
+	})
+	t.Run("200 restore removed property", func(t *testing.T) {
+		var (
+			p1    = fake.Property()
+			route = routeBase + p1.ID
+		)
+		require.NoError(t, repo.StoreProperty(ctx, p1))
+		res := handleReq(t, s, delReq(t, route, headers))
+		require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+		// the removed property is only listed when asked for
+		p := path.New("/property").WithQueryArgs(map[string]string{"includeDeleted": "true"})
+		res = handleReq(t, s, getReq(t, p.String(), headers))
+		assertResCode(t, res, http.StatusOK)
+		var list openapi.ListPropertiesRes
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&list))
+		m := make(map[entity.ID]entity.Property)
+		for _, p := range list.ToProperties() {
+			m[p.ID] = p
+		}
+		require.Contains(t, m, p1.ID)
+		assert.True(t, m[p1.ID].Deleted())
+
+		res = handleReq(t, s, postReq(t, route+"/restore", nil, headers))
+		assertResCode(t, res, http.StatusOK)
+		var resModel openapi.GetPropertyRes
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&resModel))
+		assert.Equal(t, p1.ID, resModel.Property.Id)
+		assert.Nil(t, resModel.Property.DeletedAt)
+		_, err := repo.GetProperty(ctx, p1.ID)
+		require.NoError(t, err)
+
+		// only a removed property can be restored
+		res = handleReq(t, s, postReq(t, route+"/restore", nil, headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
 }
 
 func TestOAPI_Tenant(t *testing.T) {
 	var (
-		s       = newServer(t).Handler()
-		headers map[string]string
+		s       = newServer(t).WithPIICredentials(piiKey, piiSecret).Handler()
+		headers = piiHeaders
 	)
 
 	t.Run("post", func(t *testing.T) {
@@ -480,6 +604,451 @@ func TestOAPI_Tenant(t *testing.T) {
 		assert.Equal(t, tenant1, tenantMap[tenant1.GetID()])
 		assert.Equal(t, tenant2, tenantMap[tenant2.GetID()])
 	})
+	t.Run("list filtered", func(t *testing.T) {
+		var (
+			tenant1 = fake.Tenant().WithName("Dana Filterson")
+			tenant2 = fake.Tenant().WithName("Eve Filterson")
+		)
+		tenant1.DLState, tenant2.DLState = "TX", "OK"
+		handleReq(t, s, putReq(t, "/tenant/"+tenant1.GetID(), openapi.NewStoreTenantReq(tenant1), headers))
+		handleReq(t, s, putReq(t, "/tenant/"+tenant2.GetID(), openapi.NewStoreTenantReq(tenant2), headers))
+
+		var (
+			fetched openapi.TenantList
+			route   = "/tenant?id=" + tenant1.GetID() + "&id=" + tenant2.GetID() + "&name=filterson&dlState=tx"
+		)
+		res := handleReq(t, s, getReq(t, route, headers))
+		assertResCode(t, res, http.StatusOK)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&fetched))
+		require.Len(t, fetched.Tenants, 1)
+		assert.Equal(t, tenant1.GetID(), fetched.Tenants[0].GetID())
+
+		res = handleReq(t, s, getReq(t, "/tenant?dobFrom=yesterday", headers))
+		assertResCode(t, res, http.StatusBadRequest)
+	})
+	t.Run("patch", func(t *testing.T) {
+		var (
+			in    = fake.Tenant().WithPhone(fake.Phone())
+			route = "/tenant/" + in.GetID()
+		)
+		in.DLNum, in.DLState = "646673153", "TX"
+		res := handleReq(t, s, putReq(t, route, openapi.NewStoreTenantReq(in), headers))
+		assertResCode(t, res, http.StatusCreated)
+
+		// fields not in the body are kept and null removes a field
+		var (
+			patched openapi.GetTenantRes
+			name    = fake.FullName()
+			body    = map[string]any{"fullName": name, "dlNum": nil}
+		)
+		res = handleReq(t, s, patchReq(t, route, body, headers))
+		assertResCode(t, res, http.StatusOK)
+		assertApplicationJson(t, res.Header)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&patched))
+		out := patched.Tenant.ToTenant()
+		assert.Equal(t, name, out.FullName)
+		assert.Equal(t, "", out.DLNum)
+		assert.Equal(t, in.DLState, out.DLState)
+		assert.Equal(t, in.DateOfBirth, out.DateOfBirth)
+		assert.Equal(t, in.Phones, out.Phones)
+
+		t.Run("400 name can not be removed", func(t *testing.T) {
+			res := handleReq(t, s, patchReq(t, route, map[string]any{"fullName": nil}, headers))
+			assertResCode(t, res, http.StatusBadRequest)
+		})
+		t.Run("400 unknown field", func(t *testing.T) {
+			res := handleReq(t, s, patchReq(t, route, map[string]any{"ssn": "123-45-6789"}, headers))
+			assertResCode(t, res, http.StatusBadRequest)
+		})
+		t.Run("404 unknown tenant", func(t *testing.T) {
+			res := handleReq(t, s, patchReq(t, "/tenant/"+entity.NewID(), body, headers))
+			assertResCode(t, res, http.StatusNotFound)
+		})
+	})
+	t.Run("phone", func(t *testing.T) {
+		var (
+			in    = fake.Tenant().WithPhone(fake.Phone())
+			p1    = in.Phones[len(in.Phones)-1]
+			p2    = entity.NewPhone("555-010-1234").WithNotes("work")
+			route = "/tenant/" + in.GetID() + "/phone"
+		)
+		res := handleReq(t, s, putReq(t, "/tenant/"+in.GetID(), openapi.NewStoreTenantReq(in), headers))
+		assertResCode(t, res, http.StatusCreated)
+
+		res = handleReq(t, s, postReq(t, route, openapi.ToPhone(p2), headers))
+		assertResCode(t, res, http.StatusCreated)
+		var added openapi.GetTenantRes
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&added))
+		assert.True(t, in.WithPhone(p2).Equal(*added.Tenant.ToTenant()))
+
+		res = handleReq(t, s, postReq(t, route, openapi.ToPhone(p1), headers))
+		assertResCode(t, res, http.StatusConflict)
+
+		res = handleReq(t, s, putReq(t, route+"/"+p2.Number, openapi.ToPhone(p2.WithNotes("home")), headers))
+		assertResCode(t, res, http.StatusOK)
+		res = handleReq(t, s, putReq(t, route+"/555-010-0000", openapi.ToPhone(p2), headers))
+		assertResCode(t, res, http.StatusNotFound)
+
+		res = handleReq(t, s, delReq(t, route+"/"+p2.Number, headers))
+		assertResCode(t, res, http.StatusNoContent)
+		res = handleReq(t, s, delReq(t, route+"/"+p2.Number, headers))
+		assertResCode(t, res, http.StatusNoContent)
+		res = handleReq(t, s, postReq(t, "/tenant/"+entity.NewID()+"/phone", openapi.ToPhone(p2), headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+	t.Run("delete", func(t *testing.T) {
+		var (
+			in    = fake.Tenant()
+			route = "/tenant/" + in.GetID()
+		)
+		res := handleReq(t, s, putReq(t, route, openapi.NewStoreTenantReq(in), headers))
+		assertResCode(t, res, http.StatusCreated)
+
+		res = handleReq(t, s, delReq(t, route, headers))
+		assertResCode(t, res, http.StatusNoContent)
+		res = handleReq(t, s, getReq(t, route, headers))
+		assertResCode(t, res, http.StatusNotFound)
+
+		res = handleReq(t, s, postReq(t, route+"/restore", nil, headers))
+		assertResCode(t, res, http.StatusOK)
+		res = handleReq(t, s, postReq(t, route+"/restore", nil, headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+}
+
+func TestOAPI_Lease(t *testing.T) {
+	var (
+		s        = newServer(t).Handler()
+		headers  map[string]string
+		property = fake.Property()
+		tenant   = fake.Tenant()
+	)
+
+	t.Run("post", func(t *testing.T) {
+		var (
+			route = "/lease"
+			in    = fake.Lease(property.ID, tenant.ID)
+			body  = openapi.NewLeasePropertyReq(in)
+		)
+		storeParties(t, s, headers, in)
+
+		// 201 created
+		res := handleReq(t, s, postReq(t, route, body, headers))
+		assertResCode(t, res, http.StatusCreated)
+		assertApplicationJson(t, res.Header)
+		var created openapi.LeasePropertyRes
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&created))
+		require.NotEmpty(t, created.Lease.GetID())
+		assert.True(t, created.Lease.ToLease().Equal(in.WithID("")))
+
+		// 200 get from location header
+		loc := res.Header.Get("Location")
+		require.Equal(t, "/lease/"+created.Lease.GetID(), loc)
+		var fetched openapi.GetLeaseRes
+		res = handleReq(t, s, getReq(t, loc, headers))
+		assertResCode(t, res, http.StatusOK)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&fetched))
+		assert.Equal(t, created.Lease, fetched.Lease)
+
+		// 409 overlapping lease on the same property
+		res = handleReq(t, s, postReq(t, route, body, headers))
+		assertResCode(t, res, http.StatusConflict)
+	})
+	t.Run("400 invalid json", func(t *testing.T) {
+		res := handleReq(t, s, postReq(t, "/lease", `{"lease": }`, headers))
+		assertResCode(t, res, http.StatusBadRequest)
+	})
+	t.Run("400 invalid lease lists every field", func(t *testing.T) {
+		in := fake.Lease(property.ID).WithRent(entity.NewMoney(-1, entity.CurrencyUSD)).WithRentInterval("yearly")
+		res := handleReq(t, s, postReq(t, "/lease", openapi.NewLeasePropertyReq(in), headers))
+		assertResCode(t, res, http.StatusBadRequest)
+		var errRes openapi.ErrorResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&errRes))
+		assert.Equal(t, "validation", errRes.Error.Type)
+		require.NotNil(t, errRes.Error.Fields)
+		var fields []string
+		for _, fe := range *errRes.Error.Fields {
+			fields = append(fields, fe.Field)
+		}
+		assert.Equal(t, []string{"tenantIDs", "rentAmount", "rentInterval"}, fields)
+	})
+	t.Run("400 unknown property and tenant", func(t *testing.T) {
+		in := fake.Lease(fake.Property().ID, fake.Tenant().ID)
+		res := handleReq(t, s, postReq(t, "/lease", openapi.NewLeasePropertyReq(in), headers))
+		assertResCode(t, res, http.StatusBadRequest)
+		var errRes openapi.ErrorResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&errRes))
+		require.NotNil(t, errRes.Error.Fields)
+		var fields []string
+		for _, fe := range *errRes.Error.Fields {
+			fields = append(fields, fe.Field)
+		}
+		assert.Equal(t, []string{"propertyID", "tenantIDs"}, fields)
+	})
+	t.Run("404 get unknown lease", func(t *testing.T) {
+		res := handleReq(t, s, getReq(t, "/lease/"+entity.NewID(), headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+	t.Run("list", func(t *testing.T) {
+		var (
+			lease1 = fake.Lease(fake.Property().ID, tenant.ID)
+			lease2 = fake.Lease(fake.Property().ID, tenant.ID)
+		)
+		storeParties(t, s, headers, lease1)
+		storeParties(t, s, headers, lease2)
+		handleReq(t, s, postReq(t, "/lease", openapi.NewLeasePropertyReq(lease1), headers))
+		handleReq(t, s, postReq(t, "/lease", openapi.NewLeasePropertyReq(lease2), headers))
+
+		// all
+		var fetched openapi.LeaseList
+		res := handleReq(t, s, getReq(t, "/lease", headers))
+		assertResCode(t, res, http.StatusOK)
+		assertApplicationJson(t, res.Header)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&fetched))
+		assert.GreaterOrEqual(t, len(fetched.Leases), 2)
+
+		// by property
+		p := path.New("/lease").WithQueryArgs(map[string]string{"propertyID": lease2.PropertyID})
+		res = handleReq(t, s, getReq(t, p.String(), headers))
+		assertResCode(t, res, http.StatusOK)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&fetched))
+		require.Len(t, fetched.Leases, 1)
+		assert.Equal(t, lease2.PropertyID, fetched.Leases[0].PropertyID)
+
+		// in pages by end date, each Link header points at the next one
+		var (
+			endDates []string
+			next     = "/lease?sort=-endDate&pageSize=1"
+		)
+		for next != "" {
+			var page openapi.LeaseList
+			res = handleReq(t, s, getReq(t, next, headers))
+			assertResCode(t, res, http.StatusOK)
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&page))
+			require.LessOrEqual(t, len(page.Leases), 1)
+			for _, l := range page.Leases {
+				endDates = append(endDates, l.EndDate.String())
+			}
+			next = strings.TrimSuffix(strings.TrimPrefix(res.Header.Get("Link"), "<"), `>; rel="next"`)
+			assert.Equal(t, page.NextCursor == nil, next == "")
+		}
+		assert.GreaterOrEqual(t, len(endDates), 2)
+		assert.IsNonIncreasing(t, endDates)
+
+		// 400 sort leases do not have
+		res = handleReq(t, s, getReq(t, "/lease?sort=name", headers))
+		assertResCode(t, res, http.StatusBadRequest)
+	})
+	t.Run("renew and amend", func(t *testing.T) {
+		in := fake.Lease(fake.Property().ID, tenant.ID)
+		storeParties(t, s, headers, in)
+		res := handleReq(t, s, postReq(t, "/lease", openapi.NewLeasePropertyReq(in), headers))
+		assertResCode(t, res, http.StatusCreated)
+		var created openapi.LeasePropertyRes
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&created))
+		route := "/lease/" + created.Lease.GetID()
+
+		// 200 amend
+		amendment := entity.NewLeaseAmendment(in.StartDate.AddDate(0, 2, 0)).
+			WithRent(in.RentAmount.Mul(2)).
+			WithReason("upgraded unit")
+		res = handleReq(t, s, postReq(t, route+"/amend", openapi.NewAmendLeaseReq(amendment), headers))
+		assertResCode(t, res, http.StatusOK)
+
+		// 201 renew with a location header for the new lease
+		renewal := entity.NewLeaseRenewal(in.EndDate.AddDate(1, 0, 0))
+		res = handleReq(t, s, postReq(t, route+"/renew", openapi.NewRenewLeaseReq(renewal), headers))
+		assertResCode(t, res, http.StatusCreated)
+		var renewed openapi.GetLeaseRes
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&renewed))
+		assert.Equal(t, "/lease/"+renewed.Lease.GetID(), res.Header.Get("Location"))
+		assert.Equal(t, created.Lease.GetID(), removePointer(renewed.Lease.RenewsID))
+		assert.Nil(t, renewed.History, "history is only returned by getLease")
+
+		// 200 get includes the version chain
+		res = handleReq(t, s, getReq(t, route, headers))
+		assertResCode(t, res, http.StatusOK)
+		var fetched openapi.GetLeaseRes
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&fetched))
+		require.NotNil(t, fetched.History)
+		var changes []openapi.LeaseVersionChange
+		for _, v := range *fetched.History {
+			changes = append(changes, v.Change)
+		}
+		assert.Equal(t, []openapi.LeaseVersionChange{openapi.Created, openapi.Amended, openapi.Renewed}, changes)
+		assert.Equal(t, "upgraded unit", removePointer((*fetched.History)[1].Reason))
+
+		// 409 renewed twice
+		res = handleReq(t, s, postReq(t, route+"/renew", openapi.NewRenewLeaseReq(renewal), headers))
+		assertResCode(t, res, http.StatusConflict)
+
+		// 400 amendment without a change
+		empty := entity.NewLeaseAmendment(in.StartDate.AddDate(0, 3, 0))
+		res = handleReq(t, s, postReq(t, route+"/amend", openapi.NewAmendLeaseReq(empty), headers))
+		assertResCode(t, res, http.StatusBadRequest)
+
+		// 404 unknown lease
+		res = handleReq(t, s, postReq(t, "/lease/"+entity.NewID()+"/renew", openapi.NewRenewLeaseReq(renewal), headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+}
+
+func TestOAPI_Ledger(t *testing.T) {
+	var (
+		s       = newServer(t).Handler()
+		headers map[string]string
+		lease   = fake.Lease(fake.Property().ID, fake.Tenant().ID)
+		day1    = lease.StartDate
+	)
+	storeParties(t, s, headers, lease)
+	res := handleReq(t, s, postReq(t, "/lease", openapi.NewLeasePropertyReq(lease), headers))
+	assertResCode(t, res, http.StatusCreated)
+	var created openapi.LeasePropertyRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&created))
+	lease = *created.Lease.ToLease()
+	route := "/lease/" + lease.ID + "/ledger"
+
+	// 201 charge rent
+	charge := entity.NewLedgerEntry(lease.ID, entity.EntryCharge, lease.RentAmount, day1).WithMemo("rent")
+	res = handleReq(t, s, postReq(t, route, openapi.NewPostLedgerEntryReq(charge), headers))
+	assertResCode(t, res, http.StatusCreated)
+	assertApplicationJson(t, res.Header)
+	var posted openapi.LedgerEntryRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&posted))
+	assert.True(t, posted.Entry.ToLedgerEntry().Equal(charge.WithID("")))
+
+	// 201 reverse it, then 409 reversing it again
+	reverseRoute := route + "/" + posted.Entry.GetID() + "/reverse"
+	body := openapi.NewReverseLedgerEntryReq(day1, "wrong amount")
+	res = handleReq(t, s, postReq(t, reverseRoute, body, headers))
+	assertResCode(t, res, http.StatusCreated)
+	var reversal openapi.LedgerEntryRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&reversal))
+	assert.Equal(t, posted.Entry.GetID(), removePointer(reversal.Entry.ReversesID))
+	res = handleReq(t, s, postReq(t, reverseRoute, body, headers))
+	assertResCode(t, res, http.StatusConflict)
+
+	// 200 balance and statement
+	res = handleReq(t, s, postReq(t, route, openapi.NewPostLedgerEntryReq(charge.WithID(entity.NewID())), headers))
+	assertResCode(t, res, http.StatusCreated)
+	var balance openapi.Balance
+	res = handleReq(t, s, getReq(t, "/lease/"+lease.ID+"/balance", headers))
+	assertResCode(t, res, http.StatusOK)
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&balance))
+	assert.Equal(t, lease.RentAmount, balance.Balance.ToMoney())
+
+	var statement openapi.Statement
+	p := path.New(route).WithQueryArgs(map[string]string{"from": day1.String(), "until": day1.String()})
+	res = handleReq(t, s, getReq(t, p.String(), headers))
+	assertResCode(t, res, http.StatusOK)
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&statement))
+	require.Len(t, statement.Lines, 3)
+	assert.Equal(t, lease.RentAmount, statement.ClosingBalance.ToMoney())
+
+	t.Run("400 invalid entry lists every field", func(t *testing.T) {
+		in := entity.NewLedgerEntry(lease.ID, "fee", entity.NewMoney(0, lease.Currency()), day1)
+		res := handleReq(t, s, postReq(t, route, openapi.NewPostLedgerEntryReq(in), headers))
+		assertResCode(t, res, http.StatusBadRequest)
+		var errRes openapi.ErrorResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&errRes))
+		assert.Equal(t, "validation", errRes.Error.Type)
+		require.NotNil(t, errRes.Error.Fields)
+		var fields []string
+		for _, fe := range *errRes.Error.Fields {
+			fields = append(fields, fe.Field)
+		}
+		assert.Equal(t, []string{"type", "amount"}, fields)
+	})
+	t.Run("400 reverse a reversal", func(t *testing.T) {
+		route := route + "/" + reversal.Entry.GetID() + "/reverse"
+		res := handleReq(t, s, postReq(t, route, body, headers))
+		assertResCode(t, res, http.StatusBadRequest)
+	})
+	t.Run("404 unknown lease", func(t *testing.T) {
+		route := "/lease/" + entity.NewID() + "/ledger"
+		res := handleReq(t, s, postReq(t, route, openapi.NewPostLedgerEntryReq(charge), headers))
+		assertResCode(t, res, http.StatusNotFound)
+		res = handleReq(t, s, getReq(t, route, headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+}
+
+func TestOAPI_LateFee(t *testing.T) {
+	var (
+		s       = newServer(t).Handler()
+		headers map[string]string
+		lease   = fake.Lease(fake.Property().ID, fake.Tenant().ID)
+	)
+	storeParties(t, s, headers, lease)
+	res := handleReq(t, s, postReq(t, "/lease", openapi.NewLeasePropertyReq(lease), headers))
+	assertResCode(t, res, http.StatusCreated)
+	var created openapi.LeasePropertyRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&created))
+	lease = *created.Lease.ToLease()
+	route := "/lease/" + lease.ID + "/latefee"
+
+	// 404 until the lease has a policy
+	res = handleReq(t, s, getReq(t, route+"/policy", headers))
+	assertResCode(t, res, http.StatusNotFound)
+
+	// 200 store then get the policy
+	policy := entity.NewLateFeePolicy().WithID("").ForLease(lease.ID).WithGraceDays(5).WithFlatFee(entity.NewMoney(50, lease.Currency()))
+	res = handleReq(t, s, putReq(t, route+"/policy", openapi.NewStoreLateFeePolicyReq(policy), headers))
+	assertResCode(t, res, http.StatusOK)
+	assertApplicationJson(t, res.Header)
+	var stored openapi.LateFeePolicyRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&stored))
+	assert.NotEmpty(t, stored.Policy.GetID())
+	assert.True(t, policy.Equal(*stored.Policy.ToLateFeePolicy()))
+
+	res = handleReq(t, s, getReq(t, route+"/policy", headers))
+	assertResCode(t, res, http.StatusOK)
+	var got openapi.LateFeePolicyRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+	assert.Equal(t, stored.Policy, got.Policy)
+
+	// 200 assess and apply, nothing is late on a lease with no rent due yet
+	res = handleReq(t, s, getReq(t, route, headers))
+	assertResCode(t, res, http.StatusOK)
+	var fees openapi.LateFeeList
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&fees))
+	assert.NotNil(t, fees.Fees)
+
+	res = handleReq(t, s, postReq(t, route, openapi.NewApplyLateFeesReq(schedule.Date{}), headers))
+	assertResCode(t, res, http.StatusOK)
+	var entries openapi.LedgerEntryList
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&entries))
+	assert.NotNil(t, entries.Entries)
+
+	t.Run("400 invalid policy lists every field", func(t *testing.T) {
+		in := policy.WithGraceDays(-1).WithFlatFee(entity.Money{})
+		res := handleReq(t, s, putReq(t, route+"/policy", openapi.NewStoreLateFeePolicyReq(in), headers))
+		assertResCode(t, res, http.StatusBadRequest)
+		var errRes openapi.ErrorResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&errRes))
+		assert.Equal(t, "validation", errRes.Error.Type)
+		require.NotNil(t, errRes.Error.Fields)
+		var fields []string
+		for _, fe := range *errRes.Error.Fields {
+			fields = append(fields, fe.Field)
+		}
+		assert.Equal(t, []string{"graceDays", "flatFee"}, fields)
+	})
+	t.Run("400 asOf in the future", func(t *testing.T) {
+		asOf := schedule.Today().AddDate(0, 0, 2)
+		p := path.New(route).WithQueryArgs(map[string]string{"asOf": asOf.String()})
+		res := handleReq(t, s, getReq(t, p.String(), headers))
+		assertResCode(t, res, http.StatusBadRequest)
+		res = handleReq(t, s, postReq(t, route, openapi.NewApplyLateFeesReq(asOf), headers))
+		assertResCode(t, res, http.StatusBadRequest)
+	})
+	t.Run("404 unknown lease or property", func(t *testing.T) {
+		route := "/property/" + entity.NewID() + "/latefee/policy"
+		res := handleReq(t, s, putReq(t, route, openapi.NewStoreLateFeePolicyReq(policy), headers))
+		assertResCode(t, res, http.StatusNotFound)
+		res = handleReq(t, s, getReq(t, "/lease/"+entity.NewID()+"/latefee", headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
 }
 
 func assertResCode(t testing.TB, res *http.Response, code int, msgAndArgs ...any) {
@@ -503,7 +1072,584 @@ func fmtMsgAndArgs(v ...any) string {
 		return fmt.Sprintf(msg, args...)
 	}
 }
+func removePointer[T any](in *T) T {
+	var out T
+	if in != nil {
+		out = *in
+	}
+	return out
+}
+func TestOAPI_Deposit(t *testing.T) {
+	var (
+		s       = newServer(t).Handler()
+		headers map[string]string
+		usd     = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		lease   = fake.Lease(fake.Property().ID, fake.Tenant().ID).WithDeposit(usd(100000))
+	)
+	storeParties(t, s, headers, lease)
+	res := handleReq(t, s, postReq(t, "/lease", openapi.NewLeasePropertyReq(lease), headers))
+	assertResCode(t, res, http.StatusCreated)
+	var created openapi.LeasePropertyRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&created))
+	lease = *created.Lease.ToLease()
+	route := "/lease/" + lease.ID + "/deposit"
+
+	// 200 nothing received yet
+	res = handleReq(t, s, getReq(t, route, headers))
+	assertResCode(t, res, http.StatusOK)
+	assertApplicationJson(t, res.Header)
+	var account openapi.DepositAccount
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&account))
+	assert.Equal(t, openapi.Due, account.Status)
+	assert.Equal(t, openapi.ToMoney(usd(100000)), account.Required)
+	assert.NotNil(t, account.Receipts)
+	assert.Nil(t, account.Disposition)
+
+	// 201 record receipt
+	receipt := entity.NewDepositReceipt(lease.ID, usd(100000), lease.StartDate).WithID("").WithMemo("check 1001")
+	res = handleReq(t, s, postReq(t, route+"/receipt", openapi.NewRecordDepositReceiptReq(receipt), headers))
+	assertResCode(t, res, http.StatusCreated)
+	var recorded openapi.DepositReceiptRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&recorded))
+	assert.NotEmpty(t, recorded.Receipt.GetID())
+	assert.True(t, receipt.Equal(*recorded.Receipt.ToDepositReceipt()))
+
+	// 201 dispose
+	disposition := entity.NewDepositDisposition(lease.ID, lease.EndDate).WithDeduction(
+		entity.NewDeduction(entity.DeductionCleaning, usd(12500), "carpet cleaning"))
+	res = handleReq(t, s, postReq(t, route+"/disposition", openapi.NewDisposeDepositReq(disposition), headers))
+	assertResCode(t, res, http.StatusCreated)
+	var disposed openapi.DepositDispositionRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&disposed))
+	assert.NotEmpty(t, disposed.Disposition.GetID())
+	assert.Equal(t, openapi.ToMoney(usd(87500)), disposed.Disposition.Refund)
+
+	res = handleReq(t, s, getReq(t, route, headers))
+	assertResCode(t, res, http.StatusOK)
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&account))
+	assert.Equal(t, openapi.Disposed, account.Status)
+	require.NotNil(t, account.Disposition)
+	assert.Equal(t, disposed.Disposition, *account.Disposition)
+
+	// 200 plain text statement
+	res = handleReq(t, s, getReq(t, route+"/statement", headers))
+	assertResCode(t, res, http.StatusOK)
+	assert.Contains(t, res.Header.Get("Content-Type"), "text/plain")
+	text, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(text), "Refund due to tenant")
+
+	t.Run("400 invalid deductions lists every field", func(t *testing.T) {
+		in := entity.NewDepositDisposition(lease.ID, lease.EndDate).WithDeduction(
+			entity.NewDeduction("paint", usd(0), ""))
+		res := handleReq(t, s, postReq(t, route+"/disposition", openapi.NewDisposeDepositReq(in), headers))
+		assertResCode(t, res, http.StatusBadRequest)
+		var errRes openapi.ErrorResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&errRes))
+		assert.Equal(t, "validation", errRes.Error.Type)
+		require.NotNil(t, errRes.Error.Fields)
+		var fields []string
+		for _, fe := range *errRes.Error.Fields {
+			fields = append(fields, fe.Field)
+		}
+		assert.Equal(t, []string{"deductions[0].category", "deductions[0].amount", "deductions[0].description"}, fields)
+	})
+	t.Run("409 already disposed", func(t *testing.T) {
+		res := handleReq(t, s, postReq(t, route+"/disposition", openapi.NewDisposeDepositReq(disposition), headers))
+		assertResCode(t, res, http.StatusConflict)
+		res = handleReq(t, s, postReq(t, route+"/receipt", openapi.NewRecordDepositReceiptReq(receipt), headers))
+		assertResCode(t, res, http.StatusConflict)
+	})
+	t.Run("404 unknown lease", func(t *testing.T) {
+		res := handleReq(t, s, getReq(t, "/lease/"+entity.NewID()+"/deposit", headers))
+		assertResCode(t, res, http.StatusNotFound)
+		res = handleReq(t, s, getReq(t, "/lease/"+entity.NewID()+"/deposit/statement", headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+}
+
 func newServer(_ testing.TB) *rest.Server {
 	var repo = repository.NewInMemoryRepo()
 	return rest.NewServer(actions.NewActionsWithRepo(repo))
 }
+func TestOAPI_Application(t *testing.T) {
+	var (
+		s        = newServer(t).WithPIICredentials(piiKey, piiSecret).Handler()
+		headers  = piiHeaders
+		property = fake.Property()
+	)
+	res := handleReq(t, s, putReq(t, "/property/"+property.ID, openapi.NewStorePropertyReq(property), headers))
+	assertResCode(t, res, http.StatusCreated)
+
+	// 201 submit
+	app := fake.RentalApplication(property.ID).WithID("").WithApplicant(fake.Applicant())
+	res = handleReq(t, s, postReq(t, "/application", openapi.NewSubmitApplicationReq(app), headers))
+	assertResCode(t, res, http.StatusCreated)
+	assertApplicationJson(t, res.Header)
+	var submitted openapi.ApplicationRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&submitted))
+	assert.Equal(t, "/application/"+submitted.Application.Id, res.Header.Get("Location"))
+	assert.Equal(t, openapi.Submitted, submitted.Application.Status)
+	assert.True(t, app.Equal(*submitted.Application.ToRentalApplication()))
+	route := "/application/" + submitted.Application.Id
+
+	// 200 get and list
+	res = handleReq(t, s, getReq(t, route, headers))
+	assertResCode(t, res, http.StatusOK)
+	res = handleReq(t, s, getReq(t, "/application?status=submitted&propertyID="+property.ID, headers))
+	assertResCode(t, res, http.StatusOK)
+	var list openapi.ApplicationList
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&list))
+	require.Len(t, list.Applications, 1)
+	assert.Equal(t, submitted.Application.Id, list.Applications[0].Id)
+
+	// 409 convert before approval
+	res = handleReq(t, s, postReq(t, route+"/convert", nil, headers))
+	assertResCode(t, res, http.StatusConflict)
+
+	// 200 approve
+	res = handleReq(t, s, postReq(t, route+"/status",
+		openapi.NewUpdateApplicationStatusReq(entity.ApplicationApproved, "income verified"), headers))
+	assertResCode(t, res, http.StatusOK)
+	var approved openapi.ApplicationRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&approved))
+	assert.Equal(t, openapi.Approved, approved.Application.Status)
+	require.Len(t, approved.Application.Notes, 1)
+	assert.Equal(t, "income verified", approved.Application.Notes[0].Note)
+
+	// 201 convert
+	res = handleReq(t, s, postReq(t, route+"/convert", nil, headers))
+	assertResCode(t, res, http.StatusCreated)
+	var conversion openapi.ApplicationConversion
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&conversion))
+	require.Len(t, conversion.Tenants, 2)
+	assert.Equal(t, app.Applicants[0].FullName, conversion.Tenants[0].FullName)
+	assert.Equal(t, property.ID, conversion.Lease.PropertyID)
+	assert.ElementsMatch(t, removePointer(conversion.Application.TenantIDs), conversion.Lease.TenantIDs)
+
+	t.Run("400 invalid application lists every field", func(t *testing.T) {
+		in := entity.NewRentalApplication(property.ID)
+		res := handleReq(t, s, postReq(t, "/application", openapi.NewSubmitApplicationReq(in), headers))
+		assertResCode(t, res, http.StatusBadRequest)
+		var errRes openapi.ErrorResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&errRes))
+		assert.Equal(t, "validation", errRes.Error.Type)
+		require.NotNil(t, errRes.Error.Fields)
+		var fields []string
+		for _, fe := range *errRes.Error.Fields {
+			fields = append(fields, fe.Field)
+		}
+		assert.Equal(t, []string{"moveInDate", "applicants"}, fields)
+	})
+	t.Run("409 status is final", func(t *testing.T) {
+		res := handleReq(t, s, postReq(t, route+"/status",
+			openapi.NewUpdateApplicationStatusReq(entity.ApplicationWithdrawn, ""), headers))
+		assertResCode(t, res, http.StatusConflict)
+	})
+	t.Run("409 already converted", func(t *testing.T) {
+		res := handleReq(t, s, postReq(t, route+"/convert", nil, headers))
+		assertResCode(t, res, http.StatusConflict)
+	})
+	t.Run("404 unknown application", func(t *testing.T) {
+		res := handleReq(t, s, getReq(t, "/application/"+entity.NewID(), headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+}
+func TestOAPI_Screening(t *testing.T) {
+	var (
+		s        = newServer(t).Handler()
+		headers  map[string]string
+		property = fake.Property()
+		route    = "/property/" + property.ID + "/screening/policy"
+		cars     = entity.NewScreeningRule(entity.CriterionMaxVehicles, entity.ScreeningActionFail).WithLimit(1)
+	)
+	res := handleReq(t, s, putReq(t, "/property/"+property.ID, openapi.NewStorePropertyReq(property), headers))
+	assertResCode(t, res, http.StatusCreated)
+
+	// 404 no policy yet
+	res = handleReq(t, s, getReq(t, route, headers))
+	assertResCode(t, res, http.StatusNotFound)
+
+	// 200 store
+	policy := entity.NewScreeningPolicy(property.ID, cars)
+	res = handleReq(t, s, putReq(t, route, openapi.NewStoreScreeningPolicyReq(policy), headers))
+	assertResCode(t, res, http.StatusOK)
+	assertApplicationJson(t, res.Header)
+	var stored openapi.ScreeningPolicyRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&stored))
+	assert.True(t, policy.WithID("").Equal(*stored.Policy.ToScreeningPolicy()))
+
+	// 200 get
+	res = handleReq(t, s, getReq(t, route, headers))
+	assertResCode(t, res, http.StatusOK)
+
+	// screened on submit
+	ap := fake.Applicant()
+	ap.VehicleCount = 2
+	app := entity.NewRentalApplication(property.ID, ap).WithMoveInDate(fake.RentalApplication(property.ID).MoveInDate)
+	res = handleReq(t, s, postReq(t, "/application", openapi.NewSubmitApplicationReq(app), headers))
+	assertResCode(t, res, http.StatusCreated)
+	var submitted openapi.ApplicationRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&submitted))
+	assert.Equal(t, ap.MonthlyIncome, removePointer(submitted.Application.Applicants[0].MonthlyIncome).ToMoney())
+	appRoute := "/application/" + submitted.Application.Id + "/screening"
+
+	res = handleReq(t, s, getReq(t, appRoute, headers))
+	assertResCode(t, res, http.StatusOK)
+	var list openapi.ScreeningReportList
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&list))
+	require.Len(t, list.Reports, 1)
+	assert.Equal(t, openapi.ScreeningReportResultFail, list.Reports[0].Result)
+	require.Len(t, list.Reports[0].Findings, 1)
+	assert.Equal(t, openapi.MaxVehicles, list.Reports[0].Findings[0].Rule.Criterion)
+
+	// 201 screen again
+	res = handleReq(t, s, postReq(t, appRoute, nil, headers))
+	assertResCode(t, res, http.StatusCreated)
+
+	// in pages, each Link header points at the next one
+	var (
+		reports []string
+		next    = appRoute + "?pageSize=1"
+	)
+	for next != "" {
+		var page openapi.ScreeningReportList
+		res = handleReq(t, s, getReq(t, next, headers))
+		assertResCode(t, res, http.StatusOK)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&page))
+		require.LessOrEqual(t, len(page.Reports), 1)
+		for _, r := range page.Reports {
+			reports = append(reports, r.Id)
+		}
+		next = strings.TrimSuffix(strings.TrimPrefix(res.Header.Get("Link"), "<"), `>; rel="next"`)
+		assert.Equal(t, page.NextCursor == nil, next == "")
+	}
+	assert.Len(t, reports, 2)
+
+	t.Run("400 invalid policy lists every field", func(t *testing.T) {
+		in := entity.NewScreeningPolicy(property.ID,
+			entity.NewScreeningRule("no_smoking", entity.ScreeningActionFail),
+			entity.NewScreeningRule(entity.CriterionNoPets, "deny"))
+		res := handleReq(t, s, putReq(t, route, openapi.NewStoreScreeningPolicyReq(in), headers))
+		assertResCode(t, res, http.StatusBadRequest)
+		var errRes openapi.ErrorResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&errRes))
+		require.NotNil(t, errRes.Error.Fields)
+		var fields []string
+		for _, fe := range *errRes.Error.Fields {
+			fields = append(fields, fe.Field)
+		}
+		assert.Equal(t, []string{"rules[0].criterion", "rules[1].action"}, fields)
+	})
+	t.Run("404 unknown property", func(t *testing.T) {
+		in := entity.NewScreeningPolicy(entity.NewID(), cars)
+		res := handleReq(t, s, putReq(t, "/property/"+in.PropertyID+"/screening/policy", openapi.NewStoreScreeningPolicyReq(in), headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+	t.Run("400 sort reports do not have", func(t *testing.T) {
+		res := handleReq(t, s, getReq(t, appRoute+"?sort=name", headers))
+		assertResCode(t, res, http.StatusBadRequest)
+	})
+	t.Run("404 unknown application", func(t *testing.T) {
+		res := handleReq(t, s, postReq(t, "/application/"+entity.NewID()+"/screening", nil, headers))
+		assertResCode(t, res, http.StatusNotFound)
+		res = handleReq(t, s, getReq(t, "/application/"+entity.NewID()+"/screening", headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+}
+func TestOAPI_Listing(t *testing.T) {
+	var (
+		s        = newServer(t).WithCredentials("key", "secret").Handler()
+		headers  = map[string]string{rest.HeaderAPIKey: "key", rest.HeaderAPISecret: "secret"}
+		property = fake.Property()
+		route    = "/property/" + property.ID + "/listing"
+		public   = "/listings?city=" + url.QueryEscape(strings.ToLower(property.City))
+	)
+	res := handleReq(t, s, putReq(t, "/property/"+property.ID, openapi.NewStorePropertyReq(property), headers))
+	assertResCode(t, res, http.StatusCreated)
+
+	// 404 no listing yet
+	res = handleReq(t, s, getReq(t, route, headers))
+	assertResCode(t, res, http.StatusNotFound)
+
+	// 400 a draft without rent can not be published
+	draft := entity.NewListing(property.ID, entity.Money{}, schedule.Date{})
+	res = handleReq(t, s, putReq(t, route, openapi.NewStoreListingReq(draft), headers))
+	assertResCode(t, res, http.StatusOK)
+	res = handleReq(t, s, postReq(t, route+"/publish", nil, headers))
+	assertResCode(t, res, http.StatusBadRequest)
+	var errRes openapi.ErrorResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&errRes))
+	require.NotNil(t, errRes.Error.Fields)
+	assert.Len(t, *errRes.Error.Fields, 2)
+
+	// 200 store and publish
+	listing := fake.Listing(property.ID).WithDetails(entity.RentalDetails{AllowPets: true})
+	res = handleReq(t, s, putReq(t, route, openapi.NewStoreListingReq(listing), headers))
+	assertResCode(t, res, http.StatusOK)
+	assertApplicationJson(t, res.Header)
+	var stored openapi.ListingRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&stored))
+	assert.True(t, listing.WithID("").Equal(*stored.Listing.ToListing()))
+	assert.Equal(t, openapi.Draft, stored.Listing.Status)
+
+	res = handleReq(t, s, postReq(t, route+"/publish", nil, headers))
+	assertResCode(t, res, http.StatusOK)
+
+	// 200 public without credentials
+	res = handleReq(t, s, getReq(t, public+"&petsAllowed=true", nil))
+	assertResCode(t, res, http.StatusOK)
+	var list openapi.PublicListingList
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&list))
+	require.Len(t, list.Listings, 1)
+	assert.Equal(t, stored.Listing.Id, list.Listings[0].Listing.Id)
+	assert.Equal(t, openapi.Published, list.Listings[0].Listing.Status)
+	assert.Equal(t, property.Street, list.Listings[0].Property.Street)
+
+	// a full page links to the next one
+	res = handleReq(t, s, getReq(t, public+"&pageSize=1", nil))
+	assertResCode(t, res, http.StatusOK)
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&list))
+	require.Len(t, list.Listings, 1)
+	require.NotNil(t, list.NextCursor)
+	next := strings.TrimSuffix(strings.TrimPrefix(res.Header.Get("Link"), "<"), `>; rel="next"`)
+	assert.Contains(t, next, "cursor="+*list.NextCursor)
+	res = handleReq(t, s, getReq(t, next, nil))
+	assertResCode(t, res, http.StatusOK)
+	list = openapi.PublicListingList{}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&list))
+	assert.Len(t, list.Listings, 0)
+	assert.Nil(t, list.NextCursor)
+	assert.Empty(t, res.Header.Get("Link"))
+
+	rent := strconv.Itoa(listing.Rent.Minor)
+	for query, want := range map[string]int{
+		"&minRent=" + rent + "&maxRent=" + rent:          1,
+		"&minRent=" + rent + "&currency=EUR":             0,
+		"&maxRent=" + strconv.Itoa(listing.Rent.Minor-1): 0,
+	} {
+		res = handleReq(t, s, getReq(t, public+query, nil))
+		assertResCode(t, res, http.StatusOK)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&list))
+		assert.Len(t, list.Listings, want, query)
+	}
+
+	// 200 unpublished listings are not public
+	res = handleReq(t, s, postReq(t, route+"/unpublish", nil, headers))
+	assertResCode(t, res, http.StatusOK)
+	res = handleReq(t, s, getReq(t, public, nil))
+	assertResCode(t, res, http.StatusOK)
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&list))
+	assert.Len(t, list.Listings, 0)
+
+	t.Run("401 managing a listing requires credentials", func(t *testing.T) {
+		res := handleReq(t, s, getReq(t, route, nil))
+		assertResCode(t, res, http.StatusUnauthorized)
+		res = handleReq(t, s, postReq(t, route+"/publish", nil, nil))
+		assertResCode(t, res, http.StatusUnauthorized)
+	})
+	t.Run("400 invalid filter", func(t *testing.T) {
+		res := handleReq(t, s, getReq(t, "/listings?minRent=abc", nil))
+		assertResCode(t, res, http.StatusBadRequest)
+		res = handleReq(t, s, getReq(t, "/listings?sort=name", nil))
+		assertResCode(t, res, http.StatusBadRequest)
+	})
+	t.Run("404 unknown property", func(t *testing.T) {
+		in := fake.Listing(entity.NewID())
+		res := handleReq(t, s, putReq(t, "/property/"+in.PropertyID+"/listing", openapi.NewStoreListingReq(in), headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+}
+func TestOAPI_Unit(t *testing.T) {
+	var (
+		s        = newServer(t).Handler()
+		headers  map[string]string
+		property = fake.Property()
+		tenant   = fake.Tenant()
+		route    = "/property/" + property.ID + "/unit"
+	)
+	res := handleReq(t, s, putReq(t, "/property/"+property.ID, openapi.NewStorePropertyReq(property), headers))
+	assertResCode(t, res, http.StatusCreated)
+	res = handleReq(t, s, putReq(t, "/tenant/"+tenant.ID, openapi.NewStoreTenantReq(tenant), headers))
+	assertResCode(t, res, http.StatusCreated)
+
+	// 201 added
+	in := fake.Unit(property.ID)
+	res = handleReq(t, s, postReq(t, route, openapi.NewStoreUnitReq(in), headers))
+	assertResCode(t, res, http.StatusCreated)
+	assertApplicationJson(t, res.Header)
+	var created openapi.UnitRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&created))
+	require.NotEmpty(t, created.Unit.GetID())
+	assert.True(t, in.WithID("").Equal(*created.Unit.ToUnit()))
+	loc := res.Header.Get("Location")
+	require.Equal(t, route+"/"+created.Unit.GetID(), loc)
+
+	// 200 replaced
+	res = handleReq(t, s, putReq(t, loc, openapi.NewStoreUnitReq(in.WithSqFt(in.SqFt+50)), headers))
+	assertResCode(t, res, http.StatusOK)
+
+	// 409 another unit has the number
+	res = handleReq(t, s, postReq(t, route, openapi.NewStoreUnitReq(in), headers))
+	assertResCode(t, res, http.StatusConflict)
+
+	// 200 the default unit and the one added
+	res = handleReq(t, s, getReq(t, route, headers))
+	assertResCode(t, res, http.StatusOK)
+	var list openapi.UnitList
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&list))
+	require.Len(t, list.Units, 2)
+
+	// 400 the lease must say which unit it is for, then it occupies the unit
+	lease := fake.Lease(property.ID, tenant.ID).WithTerm(schedule.Today(), schedule.Today().AddDate(1, 0, 0))
+	res = handleReq(t, s, postReq(t, "/lease", openapi.NewLeasePropertyReq(lease), headers))
+	assertResCode(t, res, http.StatusBadRequest)
+	res = handleReq(t, s, postReq(t, "/lease", openapi.NewLeasePropertyReq(lease.WithUnit(created.Unit.Id)), headers))
+	assertResCode(t, res, http.StatusCreated)
+	var leased openapi.GetLeaseRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&leased))
+	res = handleReq(t, s, getReq(t, loc, headers))
+	assertResCode(t, res, http.StatusOK)
+	var got openapi.UnitRes
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+	require.NotNil(t, got.Unit.LeaseID)
+	assert.Equal(t, leased.Lease.Id, *got.Unit.LeaseID)
+
+	res = handleReq(t, s, getReq(t, "/lease?unitID="+created.Unit.Id, headers))
+	assertResCode(t, res, http.StatusOK)
+	var leases openapi.LeaseList
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&leases))
+	require.Len(t, leases.Leases, 1)
+	assert.Equal(t, leased.Lease.Id, leases.Leases[0].Id)
+
+	// 409 a leased unit is kept
+	res = handleReq(t, s, delReq(t, loc, headers))
+	assertResCode(t, res, http.StatusConflict)
+
+	t.Run("204 deleted", func(t *testing.T) {
+		res := handleReq(t, s, postReq(t, route, openapi.NewStoreUnitReq(fake.Unit(property.ID)), headers))
+		assertResCode(t, res, http.StatusCreated)
+		loc := res.Header.Get("Location")
+		res = handleReq(t, s, delReq(t, loc, headers))
+		assertResCode(t, res, http.StatusNoContent)
+		res = handleReq(t, s, getReq(t, loc, headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+	t.Run("400 invalid unit", func(t *testing.T) {
+		res := handleReq(t, s, postReq(t, route, openapi.NewStoreUnitReq(fake.Unit(property.ID).WithRooms(1, 1.25)), headers))
+		assertResCode(t, res, http.StatusBadRequest)
+	})
+	t.Run("404 unit of another property", func(t *testing.T) {
+		res := handleReq(t, s, getReq(t, "/property/"+entity.NewID()+"/unit/"+created.Unit.Id, headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+	t.Run("404 unknown property", func(t *testing.T) {
+		res := handleReq(t, s, getReq(t, "/property/"+entity.NewID()+"/unit", headers))
+		assertResCode(t, res, http.StatusNotFound)
+	})
+}
+
+const (
+	piiKey    = "pii-key"
+	piiSecret = "pii-secret"
+)
+
+// piiHeaders are sent by tests which compare the personal information
+// returned with what was stored
+var piiHeaders = map[string]string{rest.HeaderAPIKey: piiKey, rest.HeaderAPISecret: piiSecret}
+
+func TestOAPI_PII(t *testing.T) {
+	var (
+		s = newServer(t).WithCredentials("key", "secret").
+			WithPIICredentials(piiKey, piiSecret).Handler()
+		headers  = map[string]string{rest.HeaderAPIKey: "key", rest.HeaderAPISecret: "secret"}
+		property = fake.Property()
+		tenant   = fake.Tenant()
+		ap       = fake.Applicant()
+	)
+	tenant.DLNum = "646673153"
+	ap.SSN = "123-45-6789"
+	ap.DLNum = "646673153"
+	res := handleReq(t, s, putReq(t, "/property/"+property.ID, openapi.NewStorePropertyReq(property), headers))
+	assertResCode(t, res, http.StatusCreated)
+
+	t.Run("tenant", func(t *testing.T) {
+		res := handleReq(t, s, putReq(t, "/tenant/"+tenant.ID, openapi.NewStoreTenantReq(tenant), headers))
+		assertResCode(t, res, http.StatusCreated)
+		var stored openapi.GetTenantRes
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&stored))
+		assert.Equal(t, "*****3153", stored.Tenant.DlNum)
+		assert.Equal(t, "0001-01-01", stored.Tenant.Dob.String())
+
+		res = handleReq(t, s, getReq(t, "/tenant/"+tenant.ID, piiHeaders))
+		assertResCode(t, res, http.StatusOK)
+		var got openapi.GetTenantRes
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+		assert.Equal(t, tenant.DLNum, got.Tenant.DlNum)
+		assert.True(t, tenant.Equal(*got.Tenant.ToTenant()))
+
+		res = handleReq(t, s, getReq(t, "/tenant", headers))
+		assertResCode(t, res, http.StatusOK)
+		var list openapi.TenantList
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&list))
+		for _, tt := range list.Tenants {
+			assert.Equal(t, "*****3153", tt.DlNum)
+		}
+	})
+	t.Run("application", func(t *testing.T) {
+		app := fake.RentalApplication(property.ID).WithID("").WithApplicant(ap)
+		res := handleReq(t, s, postReq(t, "/application", openapi.NewSubmitApplicationReq(app), headers))
+		assertResCode(t, res, http.StatusCreated)
+		var submitted openapi.ApplicationRes
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&submitted))
+		last := submitted.Application.Applicants[len(submitted.Application.Applicants)-1]
+		assert.Equal(t, "***-**-6789", removePointer(last.Ssn))
+		assert.Equal(t, "*****3153", removePointer(last.DlNum))
+
+		res = handleReq(t, s, getReq(t, "/application/"+submitted.Application.Id, piiHeaders))
+		assertResCode(t, res, http.StatusOK)
+		var got openapi.ApplicationRes
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+		last = got.Application.Applicants[len(got.Application.Applicants)-1]
+		assert.Equal(t, ap.SSN, removePointer(last.Ssn))
+		assert.True(t, app.Equal(*got.Application.ToRentalApplication()))
+	})
+	t.Run("pii credentials do not replace the regular ones", func(t *testing.T) {
+		res := handleReq(t, s, getReq(t, "/tenant/"+tenant.ID, map[string]string{
+			rest.HeaderAPIKey:    piiKey,
+			rest.HeaderAPISecret: "secret",
+		}))
+		assertResCode(t, res, http.StatusUnauthorized)
+	})
+	t.Run("pii key without a pii secret grants nothing", func(t *testing.T) {
+		s := newServer(t).WithCredentials("key", "secret").
+			WithPIICredentials(piiKey, "").Handler()
+		res := handleReq(t, s, getReq(t, "/tenant/"+tenant.ID, map[string]string{
+			rest.HeaderAPIKey: piiKey,
+		}))
+		assertResCode(t, res, http.StatusUnauthorized)
+	})
+}
+func TestOAPI_Audit(t *testing.T) {
+	var (
+		s        = newServer(t).WithCredentials("key", "secret").Handler()
+		headers  = map[string]string{rest.HeaderAPIKey: "key", rest.HeaderAPISecret: "secret"}
+		property = fake.Property()
+	)
+	res := handleReq(t, s, putReq(t, "/property/"+property.ID, openapi.NewStorePropertyReq(property), headers))
+	assertResCode(t, res, http.StatusCreated)
+
+	res = handleReq(t, s, getReq(t, "/audit?entity=property&entityID="+property.ID, headers))
+	assertResCode(t, res, http.StatusOK)
+	var list openapi.AuditList
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&list))
+	require.Len(t, list.Entries, 1)
+	e := list.Entries[0]
+	assert.Equal(t, "key", removePointer(e.Actor))
+	assert.Equal(t, openapi.TransportREST, removePointer(e.Transport))
+	assert.Equal(t, openapi.AuditCreate, e.Action)
+	assert.Equal(t, property.City, e.Diff["City"].(map[string]any)["new"])
+
+	res = handleReq(t, s, getReq(t, "/audit?entity=property&entityID="+property.ID, nil))
+	assertResCode(t, res, http.StatusUnauthorized)
+}
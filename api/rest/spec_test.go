@@ -14,11 +14,11 @@ func TestOpenAPI_specifications(t *testing.T) {
 		t.Skip()
 	}
 	driver := restDriver(t) // oapiClient()
-	specifications.RunAllTests(t, driver, driver)
+	specifications.RunAllTests(t, driver)
 }
 func restDriver(t testing.TB) rest.Driver {
 	var (
-		server = newServer(t)
+		server = newServer(t).WithPIICredentials(piiKey, piiSecret)
 		client = newClient(t, server)
 	)
 
@@ -26,6 +26,10 @@ func restDriver(t testing.TB) rest.Driver {
 		// no requests are actually sent so the BaseURL is irrelevant
 		BaseURL: "http://example.localhost",
 		Client:  client,
+		// the specifications compare what was stored with what is returned
+		// so they need to see it unmasked
+		APIKey:    piiKey,
+		APISecret: piiSecret,
 	}
 }
 
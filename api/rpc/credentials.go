@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal/audit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	MetadataAPIKey    = "x-api-key"
+	MetadataAPISecret = "x-api-secret"
+)
+
+var _ credentials.PerRPCCredentials = Credentials{}
+
+// Credentials sends the api key and secret as metadata with every call
+// use them with grpc.WithPerRPCCredentials
+type Credentials struct {
+	Key    string
+	Secret string
+
+	// Insecure allows sending the credentials without TLS, only for tests
+	Insecure bool
+}
+
+func (c Credentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{
+		MetadataAPIKey:    c.Key,
+		MetadataAPISecret: c.Secret,
+	}, nil
+}
+func (c Credentials) RequireTransportSecurity() bool {
+	return !c.Insecure
+}
+
+// ActorInterceptor sets the actor of the call from its api key so the changes
+// it makes are audited as made by it over grpc, use it with grpc.UnaryInterceptor
+func ActorInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	var key string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		key = first(md.Get(MetadataAPIKey))
+	}
+	return handler(audit.WithActor(ctx, audit.Actor{ID: key, Transport: audit.TransportGRPC}), req)
+}
+
+// WithPIICredentials grants the pii scope to calls made with them, only those
+// calls get the ssn, drivers license number and date of birth of tenants and
+// applicants unmasked
+func (s *Server) WithPIICredentials(key, secret string) *Server {
+	s2 := *s
+	s2.piiKey, s2.piiSecret = key, secret
+	return &s2
+}
+
+// hasPIIScope is true when the call was made with the pii credentials, never
+// when the server has no pii key or no pii secret
+func (s *Server) hasPIIScope(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || s.piiKey == "" || s.piiSecret == "" {
+		return false
+	}
+	key := subtle.ConstantTimeCompare([]byte(first(md.Get(MetadataAPIKey))), []byte(s.piiKey))
+	secret := subtle.ConstantTimeCompare([]byte(first(md.Get(MetadataAPISecret))), []byte(s.piiSecret))
+	return key&secret == 1
+}
+func (s *Server) maskTenant(ctx context.Context, t entity.Tenant) entity.Tenant {
+	if s.hasPIIScope(ctx) {
+		return t
+	}
+	return t.MaskPII()
+}
+func (s *Server) maskApplication(ctx context.Context, a entity.RentalApplication) entity.RentalApplication {
+	if s.hasPIIScope(ctx) {
+		return a
+	}
+	return a.MaskPII()
+}
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
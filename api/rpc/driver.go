@@ -7,8 +7,13 @@ import (
 
 	pb "github.com/tempcke/rpm/api/rpc/proto"
 	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/specifications"
 	"github.com/tempcke/rpm/usecase"
+	"github.com/tempcke/schedule"
+	"google.golang.org/grpc"
 )
 
 var _ specifications.Driver = Driver{}
@@ -49,13 +54,7 @@ func (d Driver) GetProperty(ctx context.Context, id entity.ID) (*entity.Property
 	if err != nil {
 		return nil, err
 	}
-	p := entity.Property{
-		ID:        res.Property.GetPropertyID(),
-		Street:    res.Property.GetStreet(),
-		City:      res.Property.GetCity(),
-		StateCode: res.Property.GetState(),
-		Zip:       res.Property.GetZip(),
-	}
+	p := res.GetProperty().ToProperty()
 	return &p, nil
 }
 func (d Driver) ListProperties(ctx context.Context, f usecase.PropertyFilter) ([]entity.Property, error) {
@@ -63,30 +62,29 @@ func (d Driver) ListProperties(ctx context.Context, f usecase.PropertyFilter) ([
 	if err != nil {
 		return nil, err
 	}
-	req := pb.FromPropertyFilter(f)
-	stream, err := client.ListProperties(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-
 	var properties = make([]entity.Property, 0)
 	for {
-		p, err := stream.Recv()
+		stream, err := client.ListProperties(ctx, pb.FromPropertyFilter(f))
 		if err != nil {
-			if err == io.EOF {
-				break
-			}
 			return nil, err
 		}
-		properties = append(properties, entity.Property{
-			ID:        p.GetPropertyID(),
-			Street:    p.GetStreet(),
-			City:      p.GetCity(),
-			StateCode: p.GetState(),
-			Zip:       p.GetZip(),
-		})
+		for {
+			p, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			properties = append(properties, p.ToProperty())
+		}
+		// without a page size every page is read so the whole list is returned
+		cursor := nextCursor(stream)
+		if f.Page.Size > 0 || cursor == "" {
+			return properties, nil
+		}
+		f.Page.Cursor = cursor
 	}
-	return properties, nil
 }
 func (d Driver) RemoveProperty(ctx context.Context, id entity.ID) error {
 	client, err := d.getClient()
@@ -99,6 +97,73 @@ func (d Driver) RemoveProperty(ctx context.Context, id entity.ID) error {
 	}
 	return nil
 }
+func (d Driver) RestoreProperty(ctx context.Context, id entity.ID) (*entity.Property, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.RestoreProperty(ctx, &pb.RestorePropertyReq{PropertyID: id})
+	if err != nil {
+		return nil, err
+	}
+	p := res.GetProperty().ToProperty()
+	return &p, nil
+}
+
+func (d Driver) StoreUnit(ctx context.Context, u entity.Unit) (*entity.Unit, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.StoreUnit(ctx, &pb.StoreUnitReq{Unit: pb.ToUnit(u)})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetUnit().ToUnit()
+	return &out, nil
+}
+func (d Driver) GetUnit(ctx context.Context, propertyID, id entity.ID) (*entity.Unit, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetUnit(ctx, &pb.GetUnitReq{PropertyID: propertyID, UnitID: id})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetUnit().ToUnit()
+	return &out, nil
+}
+func (d Driver) ListUnits(ctx context.Context, propertyID entity.ID) ([]entity.Unit, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	stream, err := client.ListUnits(ctx, &pb.ListUnitsReq{PropertyID: propertyID})
+	if err != nil {
+		return nil, err
+	}
+	var units []entity.Unit
+	for {
+		u, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		units = append(units, u.ToUnit())
+	}
+	return units, nil
+}
+func (d Driver) RemoveUnit(ctx context.Context, propertyID, id entity.ID) error {
+	client, err := d.getClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.RemoveUnit(ctx, &pb.RemoveUnitReq{PropertyID: propertyID, UnitID: id})
+	return err
+}
 
 func (d Driver) StoreTenant(ctx context.Context, tenant entity.Tenant) (*entity.Tenant, error) {
 	client, err := d.getClient()
@@ -127,29 +192,846 @@ func (d Driver) GetTenant(ctx context.Context, id entity.ID) (*entity.Tenant, er
 	}
 	return res.Tenant.ToTenant().Ptr(), nil
 }
-func (d Driver) ListTenants(ctx context.Context) ([]entity.Tenant, error) {
+func (d Driver) ListTenants(ctx context.Context, f ...filters.TenantFilter) ([]entity.Tenant, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		filter  = filters.MergeTenantFilters(f...)
+		tenants []entity.Tenant
+	)
+	for {
+		stream, err := client.ListTenants(ctx, pb.FromTenantFilters(filter))
+		if err != nil {
+			return nil, err
+		}
+		for {
+			pbTenant, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			tenants = append(tenants, pbTenant.ToTenant())
+		}
+		// without a page size every page is read so the whole list is returned
+		cursor := nextCursor(stream)
+		if filter.Page.Size > 0 || cursor == "" {
+			return tenants, nil
+		}
+		filter.Page.Cursor = cursor
+	}
+}
+
+// nextCursor is the cursor of the page after the one streamed, empty when
+// the server did not send one
+func nextCursor(stream grpc.ClientStream) string {
+	md, err := stream.Header()
+	if err != nil {
+		return ""
+	}
+	if v := md.Get(MetadataNextCursor); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+func (d Driver) PatchTenant(ctx context.Context, id entity.ID, patch entity.TenantPatch) (*entity.Tenant, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.PatchTenant(ctx, pb.NewPatchTenantReq(id, patch))
+	if err != nil {
+		return nil, err
+	}
+	return res.GetTenant().ToTenant().Ptr(), nil
+}
+func (d Driver) RemoveTenant(ctx context.Context, id entity.ID) error {
+	client, err := d.getClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.RemoveTenant(ctx, &pb.RemoveTenantReq{TenantID: id})
+	return err
+}
+func (d Driver) RestoreTenant(ctx context.Context, id entity.ID) (*entity.Tenant, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.RestoreTenant(ctx, &pb.RestoreTenantReq{TenantID: id})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetTenant().ToTenant().Ptr(), nil
+}
+func (d Driver) AddTenantPhone(ctx context.Context, id entity.ID, phone entity.Phone) (*entity.Tenant, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	req := pb.AddTenantPhoneReq{TenantID: id, Phone: pb.ToPhone(phone)}
+	res, err := client.AddTenantPhone(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	return res.GetTenant().ToTenant().Ptr(), nil
+}
+func (d Driver) UpdateTenantPhone(ctx context.Context, id entity.ID, number string, phone entity.Phone) (*entity.Tenant, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	req := pb.UpdateTenantPhoneReq{TenantID: id, Number: number, Phone: pb.ToPhone(phone)}
+	res, err := client.UpdateTenantPhone(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	return res.GetTenant().ToTenant().Ptr(), nil
+}
+func (d Driver) RemoveTenantPhone(ctx context.Context, id entity.ID, number string) error {
+	client, err := d.getClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.RemoveTenantPhone(ctx, &pb.RemoveTenantPhoneReq{TenantID: id, Number: number})
+	return err
+}
+
+func (d Driver) LeaseProperty(ctx context.Context, lease entity.Lease) (*entity.Lease, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	req := pb.LeasePropertyReq{
+		Lease: pb.ToLease(lease),
+	}
+	res, err := client.LeaseProperty(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetLease().ToLease()
+	return &out, nil
+}
+func (d Driver) GetLease(ctx context.Context, id entity.ID) (*entity.Lease, error) {
 	client, err := d.getClient()
 	if err != nil {
 		return nil, err
 	}
-	stream, err := client.ListTenants(ctx, &pb.ListTenantsReq{})
+	res, err := client.GetLease(ctx, &pb.GetLeaseReq{LeaseID: id})
 	if err != nil {
 		return nil, err
 	}
-	var tenants []entity.Tenant
+	out := res.GetLease().ToLease()
+	return &out, nil
+}
+func (d Driver) ListLeases(ctx context.Context, f ...filters.LeaseFilter) ([]entity.Lease, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		filter = filters.MergeLeaseFilters(f...)
+		leases []entity.Lease
+	)
+	for {
+		stream, err := client.ListLeases(ctx, pb.FromLeaseFilters(filter))
+		if err != nil {
+			return nil, err
+		}
+		for {
+			pbLease, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			leases = append(leases, pbLease.ToLease())
+		}
+		// without a page size every page is read so the whole list is returned
+		cursor := nextCursor(stream)
+		if filter.Page.Size > 0 || cursor == "" {
+			return leases, nil
+		}
+		filter.Page.Cursor = cursor
+	}
+}
+func (d Driver) TerminateLease(ctx context.Context, id entity.ID, endDate schedule.Date, reason string) (*entity.Lease, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	req := pb.TerminateLeaseReq{LeaseID: id, EndDate: endDate.String(), Reason: reason}
+	res, err := client.TerminateLease(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetLease().ToLease()
+	return &out, nil
+}
+func (d Driver) RenewLease(ctx context.Context, id entity.ID, r entity.LeaseRenewal) (*entity.Lease, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.RenewLease(ctx, pb.NewRenewLeaseReq(id, r))
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetLease().ToLease()
+	return &out, nil
+}
+func (d Driver) AmendLease(ctx context.Context, id entity.ID, a entity.LeaseAmendment) (*entity.Lease, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.AmendLease(ctx, pb.NewAmendLeaseReq(id, a))
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetLease().ToLease()
+	return &out, nil
+}
+
+// GetLeaseHistory is part of the GetLease response
+func (d Driver) GetLeaseHistory(ctx context.Context, id entity.ID) (*entity.LeaseHistory, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetLease(ctx, &pb.GetLeaseReq{LeaseID: id})
+	if err != nil {
+		return nil, err
+	}
+	h := res.ToLeaseHistory()
+	return &h, nil
+}
+func (d Driver) GetRentSchedule(ctx context.Context, id entity.ID, opts entity.ScheduleOptions) ([]entity.RentDue, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	stream, err := client.GetRentSchedule(ctx, pb.NewGetRentScheduleReq(id, opts))
+	if err != nil {
+		return nil, err
+	}
+	var list []entity.RentDue
 	for {
-		pbTenant, err := stream.Recv()
+		pbDue, err := stream.Recv()
 		if err != nil {
 			if err == io.EOF {
 				break
 			}
 			return nil, err
 		}
-		tenants = append(tenants, pbTenant.ToTenant())
+		list = append(list, pbDue.ToRentDue())
+	}
+	return list, nil
+}
+func (d Driver) PostLedgerEntry(ctx context.Context, e entity.LedgerEntry) (*entity.LedgerEntry, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.PostLedgerEntry(ctx, &pb.PostLedgerEntryReq{Entry: pb.ToLedgerEntry(e)})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetEntry().ToLedgerEntry()
+	return &out, nil
+}
+func (d Driver) ReverseLedgerEntry(ctx context.Context, leaseID, entryID entity.ID, date schedule.Date, memo string) (*entity.LedgerEntry, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	req := pb.ReverseLedgerEntryReq{
+		LeaseID: leaseID,
+		EntryID: entryID,
+		Date:    date.String(),
+		Memo:    memo,
+	}
+	res, err := client.ReverseLedgerEntry(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetEntry().ToLedgerEntry()
+	return &out, nil
+}
+func (d Driver) GetBalance(ctx context.Context, leaseID entity.ID, asOf schedule.Date) (entity.Money, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return entity.Money{}, err
+	}
+	req := pb.GetBalanceReq{LeaseID: leaseID}
+	if !asOf.IsZero() {
+		req.AsOf = asOf.String()
+	}
+	res, err := client.GetBalance(ctx, &req)
+	if err != nil {
+		return entity.Money{}, err
+	}
+	return res.GetBalance().ToMoney(), nil
+}
+func (d Driver) GetStatement(ctx context.Context, leaseID entity.ID, from, until schedule.Date) (*entity.Statement, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	req := pb.GetStatementReq{LeaseID: leaseID}
+	if !from.IsZero() {
+		req.From = from.String()
+	}
+	if !until.IsZero() {
+		req.Until = until.String()
+	}
+	res, err := client.GetStatement(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	out := res.ToStatement()
+	return &out, nil
+}
+func (d Driver) StoreLateFeePolicy(ctx context.Context, p entity.LateFeePolicy) (*entity.LateFeePolicy, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.StoreLateFeePolicy(ctx, &pb.StoreLateFeePolicyReq{Policy: pb.ToLateFeePolicy(p)})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetPolicy().ToLateFeePolicy()
+	return &out, nil
+}
+func (d Driver) GetLateFeePolicy(ctx context.Context, leaseID entity.ID) (*entity.LateFeePolicy, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetLateFeePolicy(ctx, &pb.GetLateFeePolicyReq{LeaseID: leaseID})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetPolicy().ToLateFeePolicy()
+	return &out, nil
+}
+func (d Driver) AssessLateFees(ctx context.Context, leaseID entity.ID, asOf schedule.Date) ([]entity.LateFee, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	req := pb.AssessLateFeesReq{LeaseID: leaseID}
+	if !asOf.IsZero() {
+		req.AsOf = asOf.String()
+	}
+	stream, err := client.AssessLateFees(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	var list []entity.LateFee
+	for {
+		pbFee, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		list = append(list, pbFee.ToLateFee())
+	}
+	return list, nil
+}
+func (d Driver) ApplyLateFees(ctx context.Context, leaseID entity.ID, asOf schedule.Date) ([]entity.LedgerEntry, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	req := pb.ApplyLateFeesReq{LeaseID: leaseID}
+	if !asOf.IsZero() {
+		req.AsOf = asOf.String()
+	}
+	stream, err := client.ApplyLateFees(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	var list []entity.LedgerEntry
+	for {
+		pbEntry, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		list = append(list, pbEntry.ToLedgerEntry())
+	}
+	return list, nil
+}
+
+func (d Driver) RecordDepositReceipt(ctx context.Context, r entity.DepositReceipt) (*entity.DepositReceipt, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.RecordDepositReceipt(ctx, &pb.RecordDepositReceiptReq{Receipt: pb.ToDepositReceipt(r)})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetReceipt().ToDepositReceipt()
+	return &out, nil
+}
+func (d Driver) GetDeposit(ctx context.Context, leaseID entity.ID) (*entity.DepositAccount, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetDeposit(ctx, &pb.GetDepositReq{LeaseID: leaseID})
+	if err != nil {
+		return nil, err
+	}
+	out := res.ToDepositAccount()
+	return &out, nil
+}
+func (d Driver) DisposeDeposit(ctx context.Context, disposition entity.DepositDisposition) (*entity.DepositDisposition, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.DisposeDeposit(ctx, &pb.DisposeDepositReq{Disposition: pb.ToDepositDisposition(disposition)})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetDisposition().ToDepositDisposition()
+	return &out, nil
+}
+func (d Driver) GetDepositStatement(ctx context.Context, leaseID entity.ID) (string, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return "", err
+	}
+	res, err := client.GetDepositStatement(ctx, &pb.GetDepositStatementReq{LeaseID: leaseID})
+	if err != nil {
+		return "", err
+	}
+	return res.GetText(), nil
+}
+func (d Driver) SubmitApplication(ctx context.Context, app entity.RentalApplication) (*entity.RentalApplication, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.SubmitApplication(ctx, &pb.SubmitApplicationReq{Application: pb.ToApplication(app)})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetApplication().ToRentalApplication()
+	return &out, nil
+}
+func (d Driver) GetApplication(ctx context.Context, id entity.ID) (*entity.RentalApplication, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetApplication(ctx, &pb.GetApplicationReq{ApplicationID: id})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetApplication().ToRentalApplication()
+	return &out, nil
+}
+func (d Driver) ListApplications(ctx context.Context, filter filters.ApplicationFilter) ([]entity.RentalApplication, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	var list []entity.RentalApplication
+	for {
+		stream, err := client.ListApplications(ctx, pb.FromApplicationFilter(filter))
+		if err != nil {
+			return nil, err
+		}
+		for {
+			pbApp, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			list = append(list, pbApp.ToRentalApplication())
+		}
+		// without a page size every page is read so the whole list is returned
+		cursor := nextCursor(stream)
+		if filter.Page.Size > 0 || cursor == "" {
+			return list, nil
+		}
+		filter.Page.Cursor = cursor
+	}
+}
+func (d Driver) UpdateApplicationStatus(ctx context.Context, id entity.ID, status entity.ApplicationStatus, note string) (*entity.RentalApplication, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.UpdateApplicationStatus(ctx, &pb.UpdateApplicationStatusReq{
+		ApplicationID: id,
+		Status:        status,
+		Note:          note,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetApplication().ToRentalApplication()
+	return &out, nil
+}
+func (d Driver) ConvertApplication(ctx context.Context, id entity.ID) (*usecase.ApplicationConversion, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.ConvertApplication(ctx, &pb.ConvertApplicationReq{ApplicationID: id})
+	if err != nil {
+		return nil, err
+	}
+	out := res.ToApplicationConversion()
+	return &out, nil
+}
+func (d Driver) StoreScreeningPolicy(ctx context.Context, p entity.ScreeningPolicy) (*entity.ScreeningPolicy, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.StoreScreeningPolicy(ctx, &pb.StoreScreeningPolicyReq{Policy: pb.ToScreeningPolicy(p)})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetPolicy().ToScreeningPolicy()
+	return &out, nil
+}
+func (d Driver) GetScreeningPolicy(ctx context.Context, propertyID entity.ID) (*entity.ScreeningPolicy, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetScreeningPolicy(ctx, &pb.GetScreeningPolicyReq{PropertyID: propertyID})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetPolicy().ToScreeningPolicy()
+	return &out, nil
+}
+func (d Driver) ScreenApplication(ctx context.Context, applicationID entity.ID) (*entity.ScreeningReport, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.ScreenApplication(ctx, &pb.ScreenApplicationReq{ApplicationID: applicationID})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetReport().ToScreeningReport()
+	return &out, nil
+}
+func (d Driver) ListScreeningReports(ctx context.Context, filter filters.ScreeningReportFilter) ([]entity.ScreeningReport, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	var list []entity.ScreeningReport
+	for {
+		stream, err := client.ListScreeningReports(ctx, pb.FromScreeningReportFilter(filter))
+		if err != nil {
+			return nil, err
+		}
+		for {
+			pbReport, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			list = append(list, pbReport.ToScreeningReport())
+		}
+		// without a page size every page is read so the whole list is returned
+		cursor := nextCursor(stream)
+		if filter.Page.Size > 0 || cursor == "" {
+			return list, nil
+		}
+		filter.Page.Cursor = cursor
+	}
+}
+func (d Driver) StoreListing(ctx context.Context, l entity.Listing) (*entity.Listing, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.StoreListing(ctx, &pb.StoreListingReq{Listing: pb.ToListing(l)})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetListing().ToListing()
+	return &out, nil
+}
+func (d Driver) GetListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetListing(ctx, &pb.GetListingReq{PropertyID: propertyID})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetListing().ToListing()
+	return &out, nil
+}
+func (d Driver) PublishListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.PublishListing(ctx, &pb.PublishListingReq{PropertyID: propertyID})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetListing().ToListing()
+	return &out, nil
+}
+func (d Driver) UnpublishListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.UnpublishListing(ctx, &pb.UnpublishListingReq{PropertyID: propertyID})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetListing().ToListing()
+	return &out, nil
+}
+func (d Driver) ListPublicListings(ctx context.Context, filter filters.ListingFilter) ([]usecase.PublicListing, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	var list []usecase.PublicListing
+	for {
+		stream, err := client.ListPublicListings(ctx, pb.FromListingFilter(filter))
+		if err != nil {
+			return nil, err
+		}
+		for {
+			pbListing, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			list = append(list, pbListing.ToPublicListing())
+		}
+		// without a page size every page is read so the whole list is returned
+		cursor := nextCursor(stream)
+		if filter.Page.Size > 0 || cursor == "" {
+			return list, nil
+		}
+		filter.Page.Cursor = cursor
+	}
+}
+func (d Driver) ListOutbox(ctx context.Context, filter filters.OutboxFilter) ([]event.Message, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	var list []event.Message
+	for {
+		stream, err := client.ListOutbox(ctx, pb.FromOutboxFilter(filter))
+		if err != nil {
+			return nil, err
+		}
+		for {
+			pbMessage, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			list = append(list, pbMessage.ToMessage())
+		}
+		// without a page size every page is read so the whole list is returned
+		cursor := nextCursor(stream)
+		if filter.Page.Size > 0 || cursor == "" {
+			return list, nil
+		}
+		filter.Page.Cursor = cursor
+	}
+}
+func (d Driver) GetOutboxMessage(ctx context.Context, id string) (*event.Message, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetOutboxMessage(ctx, &pb.GetOutboxMessageReq{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetMessage().ToMessage()
+	return &out, nil
+}
+func (d Driver) ReplayOutboxMessage(ctx context.Context, id string) (*event.Message, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.ReplayOutboxMessage(ctx, &pb.ReplayOutboxMessageReq{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetMessage().ToMessage()
+	return &out, nil
+}
+func (d Driver) StoreWebhook(ctx context.Context, w entity.Webhook) (*entity.Webhook, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.StoreWebhook(ctx, &pb.StoreWebhookReq{Webhook: pb.ToWebhook(w)})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetWebhook().ToWebhook()
+	return &out, nil
+}
+func (d Driver) GetWebhook(ctx context.Context, id entity.ID) (*entity.Webhook, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetWebhook(ctx, &pb.GetWebhookReq{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetWebhook().ToWebhook()
+	return &out, nil
+}
+func (d Driver) ListWebhooks(ctx context.Context) ([]entity.Webhook, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	stream, err := client.ListWebhooks(ctx, &pb.ListWebhooksReq{})
+	if err != nil {
+		return nil, err
+	}
+	var list []entity.Webhook
+	for {
+		pbWebhook, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		list = append(list, pbWebhook.ToWebhook())
+	}
+	return list, nil
+}
+func (d Driver) RemoveWebhook(ctx context.Context, id entity.ID) error {
+	client, err := d.getClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.RemoveWebhook(ctx, &pb.RemoveWebhookReq{Id: id})
+	return err
+}
+func (d Driver) ListWebhookDeliveries(ctx context.Context, filter filters.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	var list []entity.WebhookDelivery
+	for {
+		stream, err := client.ListWebhookDeliveries(ctx, pb.FromWebhookDeliveryFilter(filter))
+		if err != nil {
+			return nil, err
+		}
+		for {
+			pbDelivery, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			list = append(list, pbDelivery.ToWebhookDelivery())
+		}
+		// without a page size every page is read so the whole list is returned
+		cursor := nextCursor(stream)
+		if filter.Page.Size > 0 || cursor == "" {
+			return list, nil
+		}
+		filter.Page.Cursor = cursor
+	}
+}
+func (d Driver) GetWebhookDelivery(ctx context.Context, id entity.ID) (*entity.WebhookDelivery, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetWebhookDelivery(ctx, &pb.GetWebhookDeliveryReq{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetDelivery().ToWebhookDelivery()
+	return &out, nil
+}
+func (d Driver) RedeliverWebhook(ctx context.Context, deliveryID entity.ID) (*entity.WebhookDelivery, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.RedeliverWebhook(ctx, &pb.RedeliverWebhookReq{DeliveryID: deliveryID})
+	if err != nil {
+		return nil, err
+	}
+	out := res.GetDelivery().ToWebhookDelivery()
+	return &out, nil
+}
+func (d Driver) ListAudit(ctx context.Context, filter filters.AuditFilter) ([]audit.Entry, error) {
+	client, err := d.getClient()
+	if err != nil {
+		return nil, err
+	}
+	var list []audit.Entry
+	for {
+		stream, err := client.ListAudit(ctx, pb.FromAuditFilter(filter))
+		if err != nil {
+			return nil, err
+		}
+		for {
+			pbEntry, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			list = append(list, pbEntry.ToEntry())
+		}
+		// without a page size every page is read so the whole list is returned
+		cursor := nextCursor(stream)
+		if filter.Page.Size > 0 || cursor == "" {
+			return list, nil
+		}
+		filter.Page.Cursor = cursor
 	}
-	return tenants, nil
 }
-
 func (d Driver) getClient() (pb.RPMClient, error) {
 	if d.client == nil {
 		return nil, errors.New("client not initialized")
@@ -1,37 +1,79 @@
 package pb
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/usecase"
 	"github.com/tempcke/schedule"
 )
 
 func (x *Property) ToProperty() entity.Property {
 	return entity.Property{
-		ID:        x.GetPropertyID(),
-		Street:    x.GetStreet(),
-		City:      x.GetCity(),
-		StateCode: x.GetState(),
-		Zip:       x.GetZip(),
+		ID: x.GetPropertyID(),
+		Address: entity.Address{
+			Street:    x.GetStreet(),
+			Unit:      x.GetUnit(),
+			City:      x.GetCity(),
+			StateCode: x.GetState(),
+			Zip:       x.GetZip(),
+			Country:   x.GetCountry(),
+		},
+		DeletedAt: parseTime(x.GetDeletedAt()),
+		Rank:      x.GetRank(),
 	}
 }
 func ToProperty(e entity.Property) *Property {
 	return &Property{
 		PropertyID: e.GetID(),
 		Street:     e.Street,
+		Unit:       e.Unit,
 		City:       e.City,
 		State:      e.StateCode,
 		Zip:        e.Zip,
+		Country:    e.Country,
+		DeletedAt:  timeString(e.DeletedAt),
+		Rank:       e.Rank,
+	}
+}
+
+func (x *Unit) ToUnit() entity.Unit {
+	return entity.Unit{
+		ID:         x.GetUnitID(),
+		PropertyID: x.GetPropertyID(),
+		Number:     x.GetNumber(),
+		Beds:       int(x.GetBeds()),
+		Baths:      x.GetBaths(),
+		SqFt:       int(x.GetSqft()),
+		RentTarget: x.GetRentTarget().ToMoney(),
+		LeaseID:    x.GetLeaseID(),
+	}
+}
+func ToUnit(e entity.Unit) *Unit {
+	return &Unit{
+		UnitID:     e.GetID(),
+		PropertyID: e.PropertyID,
+		Number:     e.Number,
+		Beds:       int32(e.Beds),
+		Baths:      e.Baths,
+		Sqft:       int32(e.SqFt),
+		RentTarget: optionalMoney(e.RentTarget),
+		LeaseID:    e.LeaseID,
 	}
 }
 
 func (x *Tenant) ToTenant() entity.Tenant {
 	e := entity.Tenant{
-		ID:       x.GetTenantID(),
-		FullName: x.GetFullName(),
-		DLNum:    x.GetDlNum(),
-		DLState:  x.GetDlState(),
-		Phones:   FromPhones(x.GetPhones()),
+		ID:        x.GetTenantID(),
+		FullName:  x.GetFullName(),
+		DLNum:     x.GetDlNum(),
+		DLState:   x.GetDlState(),
+		Phones:    FromPhones(x.GetPhones()),
+		DeletedAt: parseTime(x.GetDeletedAt()),
 	}
 
 	if dob := schedule.ParseDate(x.GetDob()); dob != nil {
@@ -41,24 +83,48 @@ func (x *Tenant) ToTenant() entity.Tenant {
 }
 func ToTenant(e entity.Tenant) *Tenant {
 	return &Tenant{
-		TenantID: e.GetID(),
-		FullName: e.FullName,
-		DlNum:    e.DLNum,
-		DlState:  e.DLState,
-		Dob:      e.DateOfBirth.String(),
-		Phones:   ToPhones(e.Phones),
+		TenantID:  e.GetID(),
+		FullName:  e.FullName,
+		DlNum:     e.DLNum,
+		DlState:   e.DLState,
+		Dob:       dateString(e.DateOfBirth),
+		Phones:    ToPhones(e.Phones),
+		DeletedAt: timeString(e.DeletedAt),
+	}
+}
+func (x *PatchTenantReq) ToTenantPatch() entity.TenantPatch {
+	var (
+		t     = x.GetTenant().ToTenant()
+		patch = entity.NewTenantPatch()
+	)
+	for _, f := range x.GetFields() {
+		patch = patch.WithField(entity.TenantField(f), t)
 	}
+	return patch
+}
+func NewPatchTenantReq(id entity.ID, patch entity.TenantPatch) *PatchTenantReq {
+	req := PatchTenantReq{
+		TenantID: id,
+		Tenant:   ToTenant(patch.Tenant),
+	}
+	for _, f := range patch.Fields {
+		req.Fields = append(req.Fields, string(f))
+	}
+	return &req
 }
 func FromPhones(phones []*Phone) []entity.Phone {
 	var list []entity.Phone
 	for _, p := range phones {
-		list = append(list, entity.Phone{
-			Number: p.Number,
-			Note:   p.Note,
-		})
+		list = append(list, p.ToPhone())
 	}
 	return list
 }
+func (x *Phone) ToPhone() entity.Phone {
+	return entity.Phone{
+		Number: x.GetNumber(),
+		Note:   x.GetNote(),
+	}
+}
 func ToPhones(phones []entity.Phone) []*Phone {
 	var list []*Phone
 	for _, e := range phones {
@@ -73,13 +139,927 @@ func ToPhone(e entity.Phone) *Phone {
 	}
 }
 
+func (x *Money) ToMoney() entity.Money {
+	return entity.NewMoney(int(x.GetAmount()), x.GetCurrency())
+}
+func ToMoney(e entity.Money) *Money {
+	return &Money{
+		Amount:   int64(e.Minor),
+		Currency: e.Currency,
+	}
+}
+
+func (x *Lease) ToLease() entity.Lease {
+	e := entity.Lease{
+		ID:           x.GetLeaseID(),
+		PropertyID:   x.GetPropertyID(),
+		TenantIDs:    x.GetTenantIDs(),
+		Deposit:      x.GetDeposit().ToMoney(),
+		RentAmount:   x.GetRentAmount().ToMoney(),
+		RentInterval: x.GetRentInterval(),
+		RenewsID:     x.GetRenewsID(),
+		UnitID:       x.GetUnitID(),
+	}
+	if d := schedule.ParseDate(x.GetStartDate()); d != nil {
+		e.StartDate = *d
+	}
+	if d := schedule.ParseDate(x.GetEndDate()); d != nil {
+		e.EndDate = *d
+	}
+	return e
+}
+func ToLease(e entity.Lease) *Lease {
+	return &Lease{
+		LeaseID:      e.GetID(),
+		PropertyID:   e.PropertyID,
+		TenantIDs:    e.TenantIDs,
+		StartDate:    dateString(e.StartDate),
+		EndDate:      dateString(e.EndDate),
+		Deposit:      ToMoney(e.Deposit),
+		RentAmount:   ToMoney(e.RentAmount),
+		RentInterval: e.RentInterval,
+		RenewsID:     e.RenewsID,
+		UnitID:       e.UnitID,
+	}
+}
+func (x *GetLeaseRes) ToLeaseHistory() entity.LeaseHistory {
+	var h entity.LeaseHistory
+	for _, v := range x.GetHistory() {
+		h.Versions = append(h.Versions, v.ToLeaseVersion())
+	}
+	return h
+}
+func ToLeaseHistory(h entity.LeaseHistory) []*LeaseVersion {
+	list := make([]*LeaseVersion, 0, len(h.Versions))
+	for _, v := range h.Versions {
+		list = append(list, ToLeaseVersion(v))
+	}
+	return list
+}
+func (x *LeaseVersion) ToLeaseVersion() entity.LeaseVersion {
+	v := entity.LeaseVersion{
+		LeaseID: x.GetLeaseID(),
+		Version: int(x.GetVersion()),
+		Change:  x.GetChange(),
+		Reason:  x.GetReason(),
+		Terms:   x.GetLease().ToLease(),
+	}
+	if d := schedule.ParseDate(x.GetEffectiveDate()); d != nil {
+		v.Effective = *d
+	}
+	return v
+}
+func ToLeaseVersion(v entity.LeaseVersion) *LeaseVersion {
+	return &LeaseVersion{
+		LeaseID:       v.LeaseID,
+		Version:       int32(v.Version),
+		Change:        v.Change,
+		EffectiveDate: dateString(v.Effective),
+		Reason:        v.Reason,
+		Lease:         ToLease(v.Terms),
+	}
+}
+func (x *RenewLeaseReq) ToLeaseRenewal() entity.LeaseRenewal {
+	r := entity.LeaseRenewal{
+		LeaseID:    x.GetNewLeaseID(),
+		RentAmount: x.GetRentAmount().ToMoney(),
+		Reason:     x.GetReason(),
+	}
+	if d := schedule.ParseDate(x.GetEndDate()); d != nil {
+		r.EndDate = *d
+	}
+	return r
+}
+func NewRenewLeaseReq(id entity.ID, r entity.LeaseRenewal) *RenewLeaseReq {
+	return &RenewLeaseReq{
+		LeaseID:    id,
+		NewLeaseID: r.LeaseID,
+		EndDate:    dateString(r.EndDate),
+		RentAmount: optionalMoney(r.RentAmount),
+		Reason:     r.Reason,
+	}
+}
+func (x *AmendLeaseReq) ToLeaseAmendment() entity.LeaseAmendment {
+	a := entity.LeaseAmendment{
+		RentAmount:    x.GetRentAmount().ToMoney(),
+		AddTenants:    x.GetAddTenantIDs(),
+		RemoveTenants: x.GetRemoveTenantIDs(),
+		Reason:        x.GetReason(),
+	}
+	if d := schedule.ParseDate(x.GetEffectiveDate()); d != nil {
+		a.Effective = *d
+	}
+	return a
+}
+func NewAmendLeaseReq(id entity.ID, a entity.LeaseAmendment) *AmendLeaseReq {
+	return &AmendLeaseReq{
+		LeaseID:         id,
+		EffectiveDate:   dateString(a.Effective),
+		RentAmount:      optionalMoney(a.RentAmount),
+		AddTenantIDs:    a.AddTenants,
+		RemoveTenantIDs: a.RemoveTenants,
+		Reason:          a.Reason,
+	}
+}
+
+func (x *RentDue) ToRentDue() entity.RentDue {
+	e := entity.RentDue{
+		Amount:   x.GetAmount().ToMoney(),
+		Prorated: x.GetProrated(),
+	}
+	if d := schedule.ParseDate(x.GetDueDate()); d != nil {
+		e.DueDate = *d
+	}
+	if d := schedule.ParseDate(x.GetPeriodStart()); d != nil {
+		e.Period.From = *d
+	}
+	e.Period.Until = schedule.ParseDate(x.GetPeriodEnd())
+	return e
+}
+func ToRentDue(e entity.RentDue) *RentDue {
+	x := &RentDue{
+		DueDate:     dateString(e.DueDate),
+		PeriodStart: dateString(e.Period.From),
+		Amount:      ToMoney(e.Amount),
+		Prorated:    e.Prorated,
+	}
+	if e.Period.Until != nil {
+		x.PeriodEnd = dateString(*e.Period.Until)
+	}
+	return x
+}
+func (x *GetRentScheduleReq) ToScheduleOptions() entity.ScheduleOptions {
+	opts := entity.NewScheduleOptions().WithDueDay(int(x.GetDueDay()))
+	if d := schedule.ParseDate(x.GetUntil()); d != nil {
+		opts = opts.WithUntil(*d)
+	}
+	return opts
+}
+func NewGetRentScheduleReq(id entity.ID, opts entity.ScheduleOptions) *GetRentScheduleReq {
+	return &GetRentScheduleReq{
+		LeaseID: id,
+		DueDay:  int32(opts.DueDay),
+		Until:   dateString(opts.Until),
+	}
+}
+
+func (x *LedgerEntry) ToLedgerEntry() entity.LedgerEntry {
+	e := entity.LedgerEntry{
+		ID:         x.GetEntryID(),
+		LeaseID:    x.GetLeaseID(),
+		Type:       x.GetType(),
+		Amount:     x.GetAmount().ToMoney(),
+		Memo:       x.GetMemo(),
+		ReversesID: x.GetReversesID(),
+		Ref:        x.GetRef(),
+	}
+	if d := schedule.ParseDate(x.GetDate()); d != nil {
+		e.Date = *d
+	}
+	return e
+}
+func ToLedgerEntry(e entity.LedgerEntry) *LedgerEntry {
+	return &LedgerEntry{
+		EntryID:    e.GetID(),
+		LeaseID:    e.LeaseID,
+		Type:       e.Type,
+		Amount:     ToMoney(e.Amount),
+		Date:       dateString(e.Date),
+		Memo:       e.Memo,
+		ReversesID: e.ReversesID,
+		Ref:        e.Ref,
+	}
+}
+func (x *Statement) ToStatement() entity.Statement {
+	s := entity.Statement{
+		LeaseID:        x.GetLeaseID(),
+		OpeningBalance: x.GetOpeningBalance().ToMoney(),
+		Lines:          make([]entity.StatementLine, 0, len(x.GetLines())),
+		ClosingBalance: x.GetClosingBalance().ToMoney(),
+	}
+	if d := schedule.ParseDate(x.GetFrom()); d != nil {
+		s.From = *d
+	}
+	if d := schedule.ParseDate(x.GetUntil()); d != nil {
+		s.Until = *d
+	}
+	for _, l := range x.GetLines() {
+		s.Lines = append(s.Lines, entity.StatementLine{
+			Entry:   l.GetEntry().ToLedgerEntry(),
+			Balance: l.GetBalance().ToMoney(),
+		})
+	}
+	return s
+}
+func ToStatement(s entity.Statement) *Statement {
+	x := &Statement{
+		LeaseID:        s.LeaseID,
+		From:           dateString(s.From),
+		Until:          dateString(s.Until),
+		OpeningBalance: ToMoney(s.OpeningBalance),
+		Lines:          make([]*StatementLine, 0, len(s.Lines)),
+		ClosingBalance: ToMoney(s.ClosingBalance),
+	}
+	for _, l := range s.Lines {
+		x.Lines = append(x.Lines, &StatementLine{
+			Entry:   ToLedgerEntry(l.Entry),
+			Balance: ToMoney(l.Balance),
+		})
+	}
+	return x
+}
+func (x *LateFeePolicy) ToLateFeePolicy() entity.LateFeePolicy {
+	return entity.LateFeePolicy{
+		ID:          x.GetPolicyID(),
+		PropertyID:  x.GetPropertyID(),
+		LeaseID:     x.GetLeaseID(),
+		GraceDays:   int(x.GetGraceDays()),
+		FlatFee:     x.GetFlatFee().ToMoney(),
+		RentPercent: int(x.GetRentPercent()),
+		DailyFee:    x.GetDailyFee().ToMoney(),
+		MaxFee:      x.GetMaxFee().ToMoney(),
+	}
+}
+func ToLateFeePolicy(p entity.LateFeePolicy) *LateFeePolicy {
+	return &LateFeePolicy{
+		PolicyID:    p.GetID(),
+		PropertyID:  p.PropertyID,
+		LeaseID:     p.LeaseID,
+		GraceDays:   int64(p.GraceDays),
+		FlatFee:     ToMoney(p.FlatFee),
+		RentPercent: int64(p.RentPercent),
+		DailyFee:    ToMoney(p.DailyFee),
+		MaxFee:      ToMoney(p.MaxFee),
+	}
+}
+func (x *LateFee) ToLateFee() entity.LateFee {
+	f := entity.LateFee{
+		Unpaid:   x.GetUnpaid().ToMoney(),
+		DaysLate: int(x.GetDaysLate()),
+		Amount:   x.GetAmount().ToMoney(),
+	}
+	if d := schedule.ParseDate(x.GetDueDate()); d != nil {
+		f.DueDate = *d
+	}
+	return f
+}
+func ToLateFee(f entity.LateFee) *LateFee {
+	return &LateFee{
+		DueDate:  dateString(f.DueDate),
+		Unpaid:   ToMoney(f.Unpaid),
+		DaysLate: int64(f.DaysLate),
+		Amount:   ToMoney(f.Amount),
+		Ref:      f.Ref(),
+	}
+}
+func (x *DepositReceipt) ToDepositReceipt() entity.DepositReceipt {
+	r := entity.DepositReceipt{
+		ID:      x.GetReceiptID(),
+		LeaseID: x.GetLeaseID(),
+		Amount:  x.GetAmount().ToMoney(),
+		Memo:    x.GetMemo(),
+	}
+	if d := schedule.ParseDate(x.GetDate()); d != nil {
+		r.Date = *d
+	}
+	return r
+}
+func ToDepositReceipt(r entity.DepositReceipt) *DepositReceipt {
+	return &DepositReceipt{
+		ReceiptID: r.GetID(),
+		LeaseID:   r.LeaseID,
+		Amount:    ToMoney(r.Amount),
+		Date:      dateString(r.Date),
+		Memo:      r.Memo,
+	}
+}
+func (x *DepositDisposition) ToDepositDisposition() entity.DepositDisposition {
+	d := entity.DepositDisposition{
+		ID:      x.GetDispositionID(),
+		LeaseID: x.GetLeaseID(),
+		Held:    x.GetHeld().ToMoney(),
+		Refund:  x.GetRefund().ToMoney(),
+		Owed:    x.GetOwed().ToMoney(),
+	}
+	if date := schedule.ParseDate(x.GetMoveOutDate()); date != nil {
+		d.MoveOutDate = *date
+	}
+	for _, ded := range x.GetDeductions() {
+		d.Deductions = append(d.Deductions, entity.NewDeduction(
+			ded.GetCategory(), ded.GetAmount().ToMoney(), ded.GetDescription()))
+	}
+	return d
+}
+func ToDepositDisposition(d entity.DepositDisposition) *DepositDisposition {
+	x := &DepositDisposition{
+		DispositionID: d.GetID(),
+		LeaseID:       d.LeaseID,
+		MoveOutDate:   dateString(d.MoveOutDate),
+		Deductions:    make([]*Deduction, 0, len(d.Deductions)),
+		Held:          ToMoney(d.Held),
+		Refund:        ToMoney(d.Refund),
+		Owed:          ToMoney(d.Owed),
+	}
+	for _, ded := range d.Deductions {
+		x.Deductions = append(x.Deductions, &Deduction{
+			Category:    ded.Category,
+			Amount:      ToMoney(ded.Amount),
+			Description: ded.Description,
+		})
+	}
+	return x
+}
+func (x *DepositAccount) ToDepositAccount() entity.DepositAccount {
+	a := entity.DepositAccount{
+		LeaseID:  x.GetLeaseID(),
+		Required: x.GetRequired().ToMoney(),
+		Receipts: make([]entity.DepositReceipt, 0, len(x.GetReceipts())),
+	}
+	for _, r := range x.GetReceipts() {
+		a.Receipts = append(a.Receipts, r.ToDepositReceipt())
+	}
+	if x.GetDisposition() != nil {
+		d := x.GetDisposition().ToDepositDisposition()
+		a.Disposition = &d
+	}
+	return a
+}
+func ToDepositAccount(a entity.DepositAccount) *DepositAccount {
+	x := &DepositAccount{
+		LeaseID:  a.LeaseID,
+		Required: ToMoney(a.Required),
+		Held:     ToMoney(a.Held()),
+		Status:   a.Status(),
+		Receipts: make([]*DepositReceipt, 0, len(a.Receipts)),
+	}
+	for _, r := range a.Receipts {
+		x.Receipts = append(x.Receipts, ToDepositReceipt(r))
+	}
+	if a.Disposition != nil {
+		x.Disposition = ToDepositDisposition(*a.Disposition)
+	}
+	return x
+}
+
+func (x *Applicant) ToApplicant() entity.Applicant {
+	a := entity.Applicant{
+		FullName:        x.GetFullName(),
+		SSN:             x.GetSsn(),
+		DLNum:           x.GetDlNum(),
+		DLState:         x.GetDlState(),
+		HasPets:         x.GetHasPets(),
+		PetsDesc:        x.GetPetsDesc(),
+		VehicleCount:    int(x.GetVehicleCount()),
+		VehicleDesc:     x.GetVehicleDesc(),
+		CrimeConviction: x.GetCrimeConviction(),
+		CrimeDesc:       x.GetCrimeDesc(),
+		BankruptcyFiled: x.GetBankruptcyFiled(),
+		BankruptcyDesc:  x.GetBankruptcyDesc(),
+		MonthlyIncome:   x.GetMonthlyIncome().ToMoney(),
+	}
+	if dob := schedule.ParseDate(x.GetDob()); dob != nil {
+		a.DateOfBirth = *dob
+	}
+	return a
+}
+func ToApplicant(a entity.Applicant) *Applicant {
+	return &Applicant{
+		FullName:        a.FullName,
+		Dob:             dateString(a.DateOfBirth),
+		Ssn:             a.SSN,
+		DlNum:           a.DLNum,
+		DlState:         a.DLState,
+		HasPets:         a.HasPets,
+		PetsDesc:        a.PetsDesc,
+		VehicleCount:    int32(a.VehicleCount),
+		VehicleDesc:     a.VehicleDesc,
+		CrimeConviction: a.CrimeConviction,
+		CrimeDesc:       a.CrimeDesc,
+		BankruptcyFiled: a.BankruptcyFiled,
+		BankruptcyDesc:  a.BankruptcyDesc,
+		MonthlyIncome:   optionalMoney(a.MonthlyIncome),
+	}
+}
+func (x *Application) ToRentalApplication() entity.RentalApplication {
+	a := entity.RentalApplication{
+		ID:          x.GetApplicationID(),
+		PropertyID:  x.GetPropertyID(),
+		Status:      x.GetStatus(),
+		TenantIDs:   x.GetTenantIDs(),
+		SubmittedAt: parseTime(x.GetSubmittedAt()),
+	}
+	if d := schedule.ParseDate(x.GetMoveInDate()); d != nil {
+		a.MoveInDate = *d
+	}
+	for _, ap := range x.GetApplicants() {
+		a.Applicants = append(a.Applicants, ap.ToApplicant())
+	}
+	for _, n := range x.GetNotes() {
+		a.Notes = append(a.Notes, entity.ApplicationNote{
+			Status:    n.GetStatus(),
+			Note:      n.GetNote(),
+			CreatedAt: parseTime(n.GetCreatedAt()),
+		})
+	}
+	return a
+}
+func ToApplication(a entity.RentalApplication) *Application {
+	x := &Application{
+		ApplicationID: a.GetID(),
+		PropertyID:    a.PropertyID,
+		MoveInDate:    dateString(a.MoveInDate),
+		Applicants:    make([]*Applicant, 0, len(a.Applicants)),
+		Status:        a.Status,
+		Notes:         make([]*ApplicationNote, 0, len(a.Notes)),
+		TenantIDs:     a.TenantIDs,
+		SubmittedAt:   timeString(a.SubmittedAt),
+	}
+	for _, ap := range a.Applicants {
+		x.Applicants = append(x.Applicants, ToApplicant(ap))
+	}
+	for _, n := range a.Notes {
+		x.Notes = append(x.Notes, &ApplicationNote{
+			Status:    n.Status,
+			Note:      n.Note,
+			CreatedAt: timeString(n.CreatedAt),
+		})
+	}
+	return x
+}
+func (x *ConvertApplicationRes) ToApplicationConversion() usecase.ApplicationConversion {
+	c := usecase.ApplicationConversion{
+		Application: x.GetApplication().ToRentalApplication(),
+		Tenants:     make([]entity.Tenant, 0, len(x.GetTenants())),
+		Lease:       x.GetLease().ToLease(),
+	}
+	for _, t := range x.GetTenants() {
+		c.Tenants = append(c.Tenants, t.ToTenant())
+	}
+	return c
+}
+func ToConvertApplicationRes(c usecase.ApplicationConversion) *ConvertApplicationRes {
+	x := &ConvertApplicationRes{
+		Application: ToApplication(c.Application),
+		Tenants:     make([]*Tenant, 0, len(c.Tenants)),
+		Lease:       ToLease(c.Lease),
+	}
+	for _, t := range c.Tenants {
+		x.Tenants = append(x.Tenants, ToTenant(t))
+	}
+	return x
+}
+
+func (x *ScreeningRule) ToScreeningRule() entity.ScreeningRule {
+	return entity.ScreeningRule{
+		Criterion: x.GetCriterion(),
+		Limit:     int(x.GetLimit()),
+		Action:    x.GetAction(),
+	}
+}
+func ToScreeningRule(r entity.ScreeningRule) *ScreeningRule {
+	return &ScreeningRule{
+		Criterion: r.Criterion,
+		Limit:     int64(r.Limit),
+		Action:    r.Action,
+	}
+}
+func (x *ScreeningPolicy) ToScreeningPolicy() entity.ScreeningPolicy {
+	p := entity.ScreeningPolicy{
+		ID:         x.GetPolicyID(),
+		PropertyID: x.GetPropertyID(),
+		Rent:       x.GetRent().ToMoney(),
+		Rules:      make([]entity.ScreeningRule, 0, len(x.GetRules())),
+	}
+	for _, r := range x.GetRules() {
+		p.Rules = append(p.Rules, r.ToScreeningRule())
+	}
+	return p
+}
+func ToScreeningPolicy(p entity.ScreeningPolicy) *ScreeningPolicy {
+	x := &ScreeningPolicy{
+		PolicyID:   p.GetID(),
+		PropertyID: p.PropertyID,
+		Rent:       optionalMoney(p.Rent),
+		Rules:      make([]*ScreeningRule, 0, len(p.Rules)),
+	}
+	for _, r := range p.Rules {
+		x.Rules = append(x.Rules, ToScreeningRule(r))
+	}
+	return x
+}
+func (x *ScreeningReport) ToScreeningReport() entity.ScreeningReport {
+	r := entity.ScreeningReport{
+		ID:            x.GetReportID(),
+		ApplicationID: x.GetApplicationID(),
+		PropertyID:    x.GetPropertyID(),
+		Result:        x.GetResult(),
+		Rent:          x.GetRent().ToMoney(),
+		Rules:         make([]entity.ScreeningRule, 0, len(x.GetRules())),
+		Findings:      make([]entity.ScreeningFinding, 0, len(x.GetFindings())),
+		CreatedAt:     parseTime(x.GetCreatedAt()),
+	}
+	for _, rule := range x.GetRules() {
+		r.Rules = append(r.Rules, rule.ToScreeningRule())
+	}
+	for _, f := range x.GetFindings() {
+		r.Findings = append(r.Findings, entity.ScreeningFinding{
+			Rule:      f.GetRule().ToScreeningRule(),
+			Applicant: f.GetApplicant(),
+			Reason:    f.GetReason(),
+		})
+	}
+	return r
+}
+func ToScreeningReport(r entity.ScreeningReport) *ScreeningReport {
+	x := &ScreeningReport{
+		ReportID:      r.GetID(),
+		ApplicationID: r.ApplicationID,
+		PropertyID:    r.PropertyID,
+		Result:        r.Result,
+		Rent:          optionalMoney(r.Rent),
+		Rules:         make([]*ScreeningRule, 0, len(r.Rules)),
+		Findings:      make([]*ScreeningFinding, 0, len(r.Findings)),
+		CreatedAt:     timeString(r.CreatedAt),
+	}
+	for _, rule := range r.Rules {
+		x.Rules = append(x.Rules, ToScreeningRule(rule))
+	}
+	for _, f := range r.Findings {
+		x.Findings = append(x.Findings, &ScreeningFinding{
+			Rule:      ToScreeningRule(f.Rule),
+			Applicant: f.Applicant,
+			Reason:    f.Reason,
+		})
+	}
+	return x
+}
+
+func (x *Listing) ToListing() entity.Listing {
+	l := entity.Listing{
+		ID:          x.GetListingID(),
+		PropertyID:  x.GetPropertyID(),
+		Details:     x.GetDetails().ToRentalDetails(),
+		Rent:        x.GetRent().ToMoney(),
+		Description: x.GetDescription(),
+		Status:      x.GetStatus(),
+		PublishedAt: parseTime(x.GetPublishedAt()),
+	}
+	l.Details.PropertyID = l.PropertyID
+	if d := schedule.ParseDate(x.GetAvailableFrom()); d != nil {
+		l.AvailableFrom = *d
+	}
+	for _, p := range x.GetPhotos() {
+		l.Photos = append(l.Photos, entity.ListingPhoto{
+			URL:     p.GetUrl(),
+			Caption: p.GetCaption(),
+			Width:   int(p.GetWidth()),
+			Height:  int(p.GetHeight()),
+		})
+	}
+	return l
+}
+func ToListing(l entity.Listing) *Listing {
+	x := &Listing{
+		ListingID:     l.GetID(),
+		PropertyID:    l.PropertyID,
+		Details:       ToRentalDetails(l.Details),
+		Rent:          optionalMoney(l.Rent),
+		AvailableFrom: dateString(l.AvailableFrom),
+		Description:   l.Description,
+		Photos:        make([]*ListingPhoto, 0, len(l.Photos)),
+		Status:        l.Status,
+		PublishedAt:   timeString(l.PublishedAt),
+	}
+	for _, p := range l.Photos {
+		x.Photos = append(x.Photos, &ListingPhoto{
+			Url:     p.URL,
+			Caption: p.Caption,
+			Width:   int64(p.Width),
+			Height:  int64(p.Height),
+		})
+	}
+	return x
+}
+func (x *RentalDetails) ToRentalDetails() entity.RentalDetails {
+	return entity.RentalDetails{
+		AllowSmoking:  x.GetAllowSmoking(),
+		AllowPets:     x.GetAllowPets(),
+		ParkingSpaces: int(x.GetParkingSpaces()),
+		ParkingDesc:   x.GetParkingDesc(),
+	}
+}
+func ToRentalDetails(d entity.RentalDetails) *RentalDetails {
+	return &RentalDetails{
+		AllowSmoking:  d.AllowSmoking,
+		AllowPets:     d.AllowPets,
+		ParkingSpaces: int64(d.ParkingSpaces),
+		ParkingDesc:   d.ParkingDesc,
+	}
+}
+func (x *PublicListing) ToPublicListing() usecase.PublicListing {
+	return usecase.PublicListing{
+		Listing:  x.GetListing().ToListing(),
+		Property: x.GetProperty().ToProperty(),
+	}
+}
+func ToPublicListing(pl usecase.PublicListing) *PublicListing {
+	return &PublicListing{
+		Listing:  ToListing(pl.Listing),
+		Property: ToProperty(pl.Property),
+	}
+}
+func (x *OutboxMessage) ToMessage() event.Message {
+	return event.Message{
+		ID:          x.GetId(),
+		Name:        x.GetName(),
+		Payload:     json.RawMessage(x.GetPayload()),
+		Status:      x.GetStatus(),
+		Attempts:    int(x.GetAttempts()),
+		LastError:   x.GetLastError(),
+		CreatedAt:   parseTime(x.GetCreatedAt()),
+		NextAttempt: parseTime(x.GetNextAttempt()),
+		DeliveredAt: parseTime(x.GetDeliveredAt()),
+	}
+}
+func ToOutboxMessage(m event.Message) *OutboxMessage {
+	return &OutboxMessage{
+		Id:          m.ID,
+		Name:        m.Name,
+		Payload:     string(m.Payload),
+		Status:      m.Status,
+		Attempts:    int64(m.Attempts),
+		LastError:   m.LastError,
+		CreatedAt:   timeString(m.CreatedAt),
+		NextAttempt: timeString(m.NextAttempt),
+		DeliveredAt: timeString(m.DeliveredAt),
+	}
+}
+func (x *Webhook) ToWebhook() entity.Webhook {
+	w := entity.NewWebhook(x.GetUrl(), x.GetSecret(), x.GetEvents()...).WithID(x.GetId())
+	w.CreatedAt = parseTime(x.GetCreatedAt())
+	return w
+}
+func ToWebhook(w entity.Webhook) *Webhook {
+	return &Webhook{
+		Id:        w.ID,
+		Url:       w.URL,
+		Events:    w.Events,
+		Secret:    w.Secret,
+		CreatedAt: timeString(w.CreatedAt),
+	}
+}
+func (x *WebhookDelivery) ToWebhookDelivery() entity.WebhookDelivery {
+	return entity.WebhookDelivery{
+		ID:           x.GetId(),
+		WebhookID:    x.GetWebhookID(),
+		Event:        x.GetEvent(),
+		Payload:      []byte(x.GetPayload()),
+		Status:       x.GetStatus(),
+		StatusCode:   int(x.GetStatusCode()),
+		Attempts:     int(x.GetAttempts()),
+		LastError:    x.GetLastError(),
+		RedeliveryOf: x.GetRedeliveryOf(),
+		CreatedAt:    parseTime(x.GetCreatedAt()),
+		NextAttempt:  parseTime(x.GetNextAttempt()),
+		DeliveredAt:  parseTime(x.GetDeliveredAt()),
+	}
+}
+func ToWebhookDelivery(d entity.WebhookDelivery) *WebhookDelivery {
+	return &WebhookDelivery{
+		Id:           d.ID,
+		WebhookID:    d.WebhookID,
+		Event:        d.Event,
+		Payload:      string(d.Payload),
+		Status:       d.Status,
+		StatusCode:   int64(d.StatusCode),
+		Attempts:     int64(d.Attempts),
+		LastError:    d.LastError,
+		RedeliveryOf: d.RedeliveryOf,
+		CreatedAt:    timeString(d.CreatedAt),
+		NextAttempt:  timeString(d.NextAttempt),
+		DeliveredAt:  timeString(d.DeliveredAt),
+	}
+}
+func (x *AuditEntry) ToEntry() audit.Entry {
+	return audit.Entry{
+		ID:         x.GetId(),
+		Actor:      x.GetActor(),
+		Transport:  x.GetTransport(),
+		EntityType: x.GetEntityType(),
+		EntityID:   x.GetEntityID(),
+		Action:     x.GetAction(),
+		Diff:       json.RawMessage(x.GetDiff()),
+		CreatedAt:  parseTime(x.GetCreatedAt()),
+	}
+}
+func ToAuditEntry(e audit.Entry) *AuditEntry {
+	return &AuditEntry{
+		Id:         e.ID,
+		Actor:      e.Actor,
+		Transport:  e.Transport,
+		EntityType: e.EntityType,
+		EntityID:   e.EntityID,
+		Action:     e.Action,
+		Diff:       string(e.Diff),
+		CreatedAt:  timeString(e.CreatedAt),
+	}
+}
+
+// optionalMoney leaves the zero value out of the request
+func optionalMoney(m entity.Money) *Money {
+	if m == (entity.Money{}) {
+		return nil
+	}
+	return ToMoney(m)
+}
+
+// dateString leaves a zero date empty rather than "0000-00-00"
+func dateString(d schedule.Date) string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.String()
+}
+
+// timeString leaves a zero time empty
+func timeString(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+func parseTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, s)
+	return t
+}
+
 func (x *ListPropertiesReq) ToPropertyFilter() usecase.PropertyFilter {
 	return usecase.PropertyFilter{
-		Search: x.GetSearch(),
+		Search:         x.GetSearch(),
+		IncludeDeleted: x.GetIncludeDeleted(),
+		Page:           x.GetPage().ToPage(),
 	}
 }
 func FromPropertyFilter(f usecase.PropertyFilter) *ListPropertiesReq {
 	return &ListPropertiesReq{
-		Search: f.Search,
+		Search:         f.Search,
+		IncludeDeleted: f.IncludeDeleted,
+		Page:           FromPage(f.Page),
+	}
+}
+
+// ToPage is the page of a list the request asks for, without a page size it
+// has the default one
+func (x *Page) ToPage() filters.Page {
+	field, desc := filters.ParseSort(x.GetSort())
+	return filters.NewPage().
+		WithSort(field, desc).
+		WithSize(int(x.GetPageSize())).
+		WithCursor(x.GetCursor()).
+		Limited()
+}
+func FromPage(p filters.Page) *Page {
+	return &Page{
+		Sort:     p.SortString(),
+		PageSize: int32(p.Size),
+		Cursor:   p.Cursor,
+	}
+}
+
+func (x *ListTenantsReq) ToTenantFilter() filters.TenantFilter {
+	var f = filters.NewTenantFilter().
+		WithIDs(x.GetTenantIDs()...).
+		WithName(x.GetName()).
+		WithPhone(x.GetPhone()).
+		WithDLState(x.GetDlState()).
+		WithLeasingProperty(x.GetLeasingPropertyID()).
+		WithPage(x.GetPage().ToPage())
+	if d := schedule.ParseDate(x.GetDobFrom()); d != nil {
+		f.DOBFrom = *d
+	}
+	if d := schedule.ParseDate(x.GetDobUntil()); d != nil {
+		f.DOBUntil = *d
+	}
+	if x.GetIncludeDeleted() {
+		f = f.WithDeleted()
+	}
+	return f
+}
+func FromTenantFilters(filter ...filters.TenantFilter) *ListTenantsReq {
+	f := filters.MergeTenantFilters(filter...)
+	return &ListTenantsReq{
+		IncludeDeleted:    f.IncludeDeleted,
+		TenantIDs:         f.IDs,
+		Name:              f.Name,
+		Phone:             f.Phone,
+		DlState:           f.DLState,
+		DobFrom:           dateString(f.DOBFrom),
+		DobUntil:          dateString(f.DOBUntil),
+		LeasingPropertyID: f.LeasingPropertyID,
+		Page:              FromPage(f.Page),
+	}
+}
+
+func (x *ListLeasesReq) ToLeaseFilter() filters.LeaseFilter {
+	return filters.NewLeaseFilter().
+		WithPropertyID(x.GetPropertyID()).
+		WithUnitID(x.GetUnitID()).
+		WithPage(x.GetPage().ToPage())
+}
+func FromLeaseFilters(f ...filters.LeaseFilter) *ListLeasesReq {
+	m := filters.MergeLeaseFilters(f...)
+	return &ListLeasesReq{
+		PropertyID: m.PropertyID,
+		UnitID:     m.UnitID,
+		Page:       FromPage(m.Page),
+	}
+}
+
+func (x *ListApplicationsReq) ToApplicationFilter() filters.ApplicationFilter {
+	return filters.NewApplicationFilter().
+		WithPropertyID(x.GetPropertyID()).
+		WithStatus(x.GetStatus()).
+		WithPage(x.GetPage().ToPage())
+}
+func FromApplicationFilter(f filters.ApplicationFilter) *ListApplicationsReq {
+	return &ListApplicationsReq{
+		PropertyID: f.PropertyID,
+		Status:     f.Status,
+		Page:       FromPage(f.Page),
+	}
+}
+
+func (x *ListPublicListingsReq) ToListingFilter() filters.ListingFilter {
+	f := filters.NewListingFilter().
+		WithCity(x.GetCity()).
+		WithRentRange(x.GetMinRent().ToMoney(), x.GetMaxRent().ToMoney()).
+		WithPage(x.GetPage().ToPage())
+	if x.GetPetsAllowed() {
+		f = f.WithPetsAllowed()
+	}
+	return f
+}
+func FromListingFilter(f filters.ListingFilter) *ListPublicListingsReq {
+	return &ListPublicListingsReq{
+		City:        f.City,
+		MinRent:     optionalMoney(f.MinRent),
+		MaxRent:     optionalMoney(f.MaxRent),
+		PetsAllowed: f.PetsAllowed,
+		Page:        FromPage(f.Page),
+	}
+}
+
+func (x *ListScreeningReportsReq) ToScreeningReportFilter() filters.ScreeningReportFilter {
+	return filters.NewScreeningReportFilter().
+		WithApplicationID(x.GetApplicationID()).
+		WithPage(x.GetPage().ToPage())
+}
+func FromScreeningReportFilter(f filters.ScreeningReportFilter) *ListScreeningReportsReq {
+	return &ListScreeningReportsReq{
+		ApplicationID: f.ApplicationID,
+		Page:          FromPage(f.Page),
+	}
+}
+
+func (x *ListOutboxReq) ToOutboxFilter() filters.OutboxFilter {
+	return filters.NewOutboxFilter().
+		WithStatus(x.GetStatus()).
+		WithName(x.GetName()).
+		WithPage(x.GetPage().ToPage())
+}
+func FromOutboxFilter(f filters.OutboxFilter) *ListOutboxReq {
+	return &ListOutboxReq{
+		Status: f.Status,
+		Name:   f.Name,
+		Page:   FromPage(f.Page),
+	}
+}
+
+func (x *ListWebhookDeliveriesReq) ToWebhookDeliveryFilter() filters.WebhookDeliveryFilter {
+	return filters.NewWebhookDeliveryFilter().
+		WithWebhookID(x.GetWebhookID()).
+		WithStatus(x.GetStatus()).
+		WithPage(x.GetPage().ToPage())
+}
+func FromWebhookDeliveryFilter(f filters.WebhookDeliveryFilter) *ListWebhookDeliveriesReq {
+	return &ListWebhookDeliveriesReq{
+		WebhookID: f.WebhookID,
+		Status:    f.Status,
+		Page:      FromPage(f.Page),
+	}
+}
+
+func (x *ListAuditReq) ToAuditFilter() filters.AuditFilter {
+	return filters.NewAuditFilter().
+		WithEntity(x.GetEntityType(), x.GetEntityID()).
+		WithActor(x.GetActor()).
+		WithPage(x.GetPage().ToPage())
+}
+func FromAuditFilter(f filters.AuditFilter) *ListAuditReq {
+	return &ListAuditReq{
+		EntityType: f.EntityType,
+		EntityID:   f.EntityID,
+		Actor:      f.Actor,
+		Page:       FromPage(f.Page),
 	}
 }
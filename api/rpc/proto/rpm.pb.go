@@ -25,11 +25,15 @@ type Property struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PropertyID string `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
-	Street     string `protobuf:"bytes,2,opt,name=street,proto3" json:"street,omitempty"`
-	City       string `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	State      string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	Zip        string `protobuf:"bytes,5,opt,name=zip,proto3" json:"zip,omitempty"`
+	PropertyID string  `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+	Street     string  `protobuf:"bytes,2,opt,name=street,proto3" json:"street,omitempty"`
+	City       string  `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	State      string  `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Zip        string  `protobuf:"bytes,5,opt,name=zip,proto3" json:"zip,omitempty"`
+	DeletedAt  string  `protobuf:"bytes,6,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"` // RFC 3339, empty unless the property was removed
+	Rank       float64 `protobuf:"fixed64,7,opt,name=rank,proto3" json:"rank,omitempty"`         // relevance to the search of a list from 0 to 1
+	Unit       string  `protobuf:"bytes,8,opt,name=unit,proto3" json:"unit,omitempty"`           // apartment, suite or other unit line, empty for the whole building
+	Country    string  `protobuf:"bytes,9,opt,name=country,proto3" json:"country,omitempty"`     // ISO 3166-1 alpha-2 code, US when empty
 }
 
 func (x *Property) Reset() {
@@ -99,6 +103,34 @@ func (x *Property) GetZip() string {
 	return ""
 }
 
+func (x *Property) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Property) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Property) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Property) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
 type StorePropertyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -372,16 +404,16 @@ func (*RemovePropertyRes) Descriptor() ([]byte, []int) {
 	return file_rpm_proto_rawDescGZIP(), []int{6}
 }
 
-type ListPropertiesReq struct {
+type RestorePropertyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Search string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	PropertyID string `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
 }
 
-func (x *ListPropertiesReq) Reset() {
-	*x = ListPropertiesReq{}
+func (x *RestorePropertyReq) Reset() {
+	*x = RestorePropertyReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -389,13 +421,13 @@ func (x *ListPropertiesReq) Reset() {
 	}
 }
 
-func (x *ListPropertiesReq) String() string {
+func (x *RestorePropertyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPropertiesReq) ProtoMessage() {}
+func (*RestorePropertyReq) ProtoMessage() {}
 
-func (x *ListPropertiesReq) ProtoReflect() protoreflect.Message {
+func (x *RestorePropertyReq) ProtoReflect() protoreflect.Message {
 	mi := &file_rpm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,33 +439,28 @@ func (x *ListPropertiesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPropertiesReq.ProtoReflect.Descriptor instead.
-func (*ListPropertiesReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestorePropertyReq.ProtoReflect.Descriptor instead.
+func (*RestorePropertyReq) Descriptor() ([]byte, []int) {
 	return file_rpm_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListPropertiesReq) GetSearch() string {
+func (x *RestorePropertyReq) GetPropertyID() string {
 	if x != nil {
-		return x.Search
+		return x.PropertyID
 	}
 	return ""
 }
 
-type Tenant struct {
+type RestorePropertyRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantID string   `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
-	FullName string   `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
-	DlNum    string   `protobuf:"bytes,3,opt,name=dlNum,proto3" json:"dlNum,omitempty"`
-	DlState  string   `protobuf:"bytes,4,opt,name=dlState,proto3" json:"dlState,omitempty"`
-	Dob      string   `protobuf:"bytes,5,opt,name=dob,proto3" json:"dob,omitempty"` // date of birth, ex: "2006-01-02"
-	Phones   []*Phone `protobuf:"bytes,6,rep,name=phones,proto3" json:"phones,omitempty"`
+	Property *Property `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
 }
 
-func (x *Tenant) Reset() {
-	*x = Tenant{}
+func (x *RestorePropertyRes) Reset() {
+	*x = RestorePropertyRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -441,13 +468,13 @@ func (x *Tenant) Reset() {
 	}
 }
 
-func (x *Tenant) String() string {
+func (x *RestorePropertyRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tenant) ProtoMessage() {}
+func (*RestorePropertyRes) ProtoMessage() {}
 
-func (x *Tenant) ProtoReflect() protoreflect.Message {
+func (x *RestorePropertyRes) ProtoReflect() protoreflect.Message {
 	mi := &file_rpm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -459,64 +486,30 @@ func (x *Tenant) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
-func (*Tenant) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestorePropertyRes.ProtoReflect.Descriptor instead.
+func (*RestorePropertyRes) Descriptor() ([]byte, []int) {
 	return file_rpm_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Tenant) GetTenantID() string {
-	if x != nil {
-		return x.TenantID
-	}
-	return ""
-}
-
-func (x *Tenant) GetFullName() string {
-	if x != nil {
-		return x.FullName
-	}
-	return ""
-}
-
-func (x *Tenant) GetDlNum() string {
-	if x != nil {
-		return x.DlNum
-	}
-	return ""
-}
-
-func (x *Tenant) GetDlState() string {
-	if x != nil {
-		return x.DlState
-	}
-	return ""
-}
-
-func (x *Tenant) GetDob() string {
-	if x != nil {
-		return x.Dob
-	}
-	return ""
-}
-
-func (x *Tenant) GetPhones() []*Phone {
+func (x *RestorePropertyRes) GetProperty() *Property {
 	if x != nil {
-		return x.Phones
+		return x.Property
 	}
 	return nil
 }
 
-type Phone struct {
+type ListPropertiesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Number string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
-	Note   string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	Search         string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	IncludeDeleted bool   `protobuf:"varint,2,opt,name=includeDeleted,proto3" json:"includeDeleted,omitempty"` // include removed properties which were not purged yet
+	Page           *Page  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`                      // sort by createdAt, city, zip or rank, a search is sorted by -rank by default
 }
 
-func (x *Phone) Reset() {
-	*x = Phone{}
+func (x *ListPropertiesReq) Reset() {
+	*x = ListPropertiesReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -524,13 +517,13 @@ func (x *Phone) Reset() {
 	}
 }
 
-func (x *Phone) String() string {
+func (x *ListPropertiesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Phone) ProtoMessage() {}
+func (*ListPropertiesReq) ProtoMessage() {}
 
-func (x *Phone) ProtoReflect() protoreflect.Message {
+func (x *ListPropertiesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_rpm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,35 +535,46 @@ func (x *Phone) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Phone.ProtoReflect.Descriptor instead.
-func (*Phone) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPropertiesReq.ProtoReflect.Descriptor instead.
+func (*ListPropertiesReq) Descriptor() ([]byte, []int) {
 	return file_rpm_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Phone) GetNumber() string {
+func (x *ListPropertiesReq) GetSearch() string {
 	if x != nil {
-		return x.Number
+		return x.Search
 	}
 	return ""
 }
 
-func (x *Phone) GetNote() string {
+func (x *ListPropertiesReq) GetIncludeDeleted() bool {
 	if x != nil {
-		return x.Note
+		return x.IncludeDeleted
 	}
-	return ""
+	return false
 }
 
-type StoreTenantReq struct {
+func (x *ListPropertiesReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// Page selects the order of a list and the part of it to stream, the cursor of
+// the next page is sent in the next-cursor header when there may be one
+type Page struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"` // uuid generated when omitted
+	Sort     string `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`          // field to order by, descending when preceded by a minus, ex: "-createdAt"
+	PageSize int32  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 100 when empty, at most 1000
+	Cursor   string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`      // next-cursor of the previous page, empty for the first page
 }
 
-func (x *StoreTenantReq) Reset() {
-	*x = StoreTenantReq{}
+func (x *Page) Reset() {
+	*x = Page{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -578,13 +582,13 @@ func (x *StoreTenantReq) Reset() {
 	}
 }
 
-func (x *StoreTenantReq) String() string {
+func (x *Page) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreTenantReq) ProtoMessage() {}
+func (*Page) ProtoMessage() {}
 
-func (x *StoreTenantReq) ProtoReflect() protoreflect.Message {
+func (x *Page) ProtoReflect() protoreflect.Message {
 	mi := &file_rpm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,28 +600,49 @@ func (x *StoreTenantReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreTenantReq.ProtoReflect.Descriptor instead.
-func (*StoreTenantReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
 	return file_rpm_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *StoreTenantReq) GetTenant() *Tenant {
+func (x *Page) GetSort() string {
 	if x != nil {
-		return x.Tenant
+		return x.Sort
 	}
-	return nil
+	return ""
 }
 
-type StoreTenantRes struct {
+func (x *Page) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *Page) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type Unit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantID string `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+	UnitID     string  `protobuf:"bytes,1,opt,name=unitID,proto3" json:"unitID,omitempty"` // set by the server when empty
+	PropertyID string  `protobuf:"bytes,2,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+	Number     string  `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"` // ex: "A" or "101", empty for the only unit of a house
+	Beds       int32   `protobuf:"varint,4,opt,name=beds,proto3" json:"beds,omitempty"`
+	Baths      float64 `protobuf:"fixed64,5,opt,name=baths,proto3" json:"baths,omitempty"` // a half bath counts as 0.5
+	Sqft       int32   `protobuf:"varint,6,opt,name=sqft,proto3" json:"sqft,omitempty"`
+	RentTarget *Money  `protobuf:"bytes,7,opt,name=rentTarget,proto3" json:"rentTarget,omitempty"` // currency defaults to USD when omitted
+	LeaseID    string  `protobuf:"bytes,8,opt,name=leaseID,proto3" json:"leaseID,omitempty"`       // the lease occupying the unit today, empty when it is vacant
 }
 
-func (x *StoreTenantRes) Reset() {
-	*x = StoreTenantRes{}
+func (x *Unit) Reset() {
+	*x = Unit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -625,13 +650,13 @@ func (x *StoreTenantRes) Reset() {
 	}
 }
 
-func (x *StoreTenantRes) String() string {
+func (x *Unit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreTenantRes) ProtoMessage() {}
+func (*Unit) ProtoMessage() {}
 
-func (x *StoreTenantRes) ProtoReflect() protoreflect.Message {
+func (x *Unit) ProtoReflect() protoreflect.Message {
 	mi := &file_rpm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -643,28 +668,77 @@ func (x *StoreTenantRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreTenantRes.ProtoReflect.Descriptor instead.
-func (*StoreTenantRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use Unit.ProtoReflect.Descriptor instead.
+func (*Unit) Descriptor() ([]byte, []int) {
 	return file_rpm_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *StoreTenantRes) GetTenantID() string {
+func (x *Unit) GetUnitID() string {
 	if x != nil {
-		return x.TenantID
+		return x.UnitID
 	}
 	return ""
 }
 
-type GetTenantReq struct {
+func (x *Unit) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+func (x *Unit) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Unit) GetBeds() int32 {
+	if x != nil {
+		return x.Beds
+	}
+	return 0
+}
+
+func (x *Unit) GetBaths() float64 {
+	if x != nil {
+		return x.Baths
+	}
+	return 0
+}
+
+func (x *Unit) GetSqft() int32 {
+	if x != nil {
+		return x.Sqft
+	}
+	return 0
+}
+
+func (x *Unit) GetRentTarget() *Money {
+	if x != nil {
+		return x.RentTarget
+	}
+	return nil
+}
+
+func (x *Unit) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+type StoreUnitReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantID string `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+	Unit *Unit `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
 }
 
-func (x *GetTenantReq) Reset() {
-	*x = GetTenantReq{}
+func (x *StoreUnitReq) Reset() {
+	*x = StoreUnitReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpm_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -672,13 +746,13 @@ func (x *GetTenantReq) Reset() {
 	}
 }
 
-func (x *GetTenantReq) String() string {
+func (x *StoreUnitReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTenantReq) ProtoMessage() {}
+func (*StoreUnitReq) ProtoMessage() {}
 
-func (x *GetTenantReq) ProtoReflect() protoreflect.Message {
+func (x *StoreUnitReq) ProtoReflect() protoreflect.Message {
 	mi := &file_rpm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -690,28 +764,28 @@ func (x *GetTenantReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTenantReq.ProtoReflect.Descriptor instead.
-func (*GetTenantReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use StoreUnitReq.ProtoReflect.Descriptor instead.
+func (*StoreUnitReq) Descriptor() ([]byte, []int) {
 	return file_rpm_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetTenantReq) GetTenantID() string {
+func (x *StoreUnitReq) GetUnit() *Unit {
 	if x != nil {
-		return x.TenantID
+		return x.Unit
 	}
-	return ""
+	return nil
 }
 
-type GetTenantRes struct {
+type StoreUnitRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Unit *Unit `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
 }
 
-func (x *GetTenantRes) Reset() {
-	*x = GetTenantRes{}
+func (x *StoreUnitRes) Reset() {
+	*x = StoreUnitRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpm_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -719,13 +793,13 @@ func (x *GetTenantRes) Reset() {
 	}
 }
 
-func (x *GetTenantRes) String() string {
+func (x *StoreUnitRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTenantRes) ProtoMessage() {}
+func (*StoreUnitRes) ProtoMessage() {}
 
-func (x *GetTenantRes) ProtoReflect() protoreflect.Message {
+func (x *StoreUnitRes) ProtoReflect() protoreflect.Message {
 	mi := &file_rpm_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -737,26 +811,29 @@ func (x *GetTenantRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTenantRes.ProtoReflect.Descriptor instead.
-func (*GetTenantRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use StoreUnitRes.ProtoReflect.Descriptor instead.
+func (*StoreUnitRes) Descriptor() ([]byte, []int) {
 	return file_rpm_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetTenantRes) GetTenant() *Tenant {
+func (x *StoreUnitRes) GetUnit() *Unit {
 	if x != nil {
-		return x.Tenant
+		return x.Unit
 	}
 	return nil
 }
 
-type ListTenantsReq struct {
+type GetUnitReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PropertyID string `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+	UnitID     string `protobuf:"bytes,2,opt,name=unitID,proto3" json:"unitID,omitempty"`
 }
 
-func (x *ListTenantsReq) Reset() {
-	*x = ListTenantsReq{}
+func (x *GetUnitReq) Reset() {
+	*x = GetUnitReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpm_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -764,13 +841,13 @@ func (x *ListTenantsReq) Reset() {
 	}
 }
 
-func (x *ListTenantsReq) String() string {
+func (x *GetUnitReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTenantsReq) ProtoMessage() {}
+func (*GetUnitReq) ProtoMessage() {}
 
-func (x *ListTenantsReq) ProtoReflect() protoreflect.Message {
+func (x *GetUnitReq) ProtoReflect() protoreflect.Message {
 	mi := &file_rpm_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -782,169 +859,9138 @@ func (x *ListTenantsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTenantsReq.ProtoReflect.Descriptor instead.
-func (*ListTenantsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUnitReq.ProtoReflect.Descriptor instead.
+func (*GetUnitReq) Descriptor() ([]byte, []int) {
 	return file_rpm_proto_rawDescGZIP(), []int{14}
 }
 
-var File_rpm_proto protoreflect.FileDescriptor
+func (x *GetUnitReq) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
 
-var file_rpm_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x72, 0x70, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x6d,
-	0x70, 0x62, 0x22, 0x7e, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1e,
-	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x7a, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x7a,
-	0x69, 0x70, 0x22, 0x3f, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0x22, 0x32, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x30, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x3d, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x22, 0x33, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x13, 0x0a,
-	0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52,
-	0x65, 0x73, 0x22, 0x2b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22,
-	0xa8, 0x01, 0x0a, 0x06, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6c, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x64, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x64, 0x6f, 0x62, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x68, 0x6f,
-	0x6e, 0x65, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x05, 0x50, 0x68,
-	0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22,
-	0x37, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x2a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x49, 0x44, 0x22, 0x35, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
-	0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x32, 0xb5, 0x03, 0x0a, 0x03,
-	0x52, 0x50, 0x4d, 0x12, 0x41, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x79, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e,
-	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x72,
-	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a,
-	0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x70,
-	0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15,
-	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0b,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x72, 0x70,
-	0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x30, 0x01, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x63, 0x6b, 0x65, 0x2f, 0x72, 0x70, 0x6d, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *GetUnitReq) GetUnitID() string {
+	if x != nil {
+		return x.UnitID
+	}
+	return ""
 }
 
-var (
-	file_rpm_proto_rawDescOnce sync.Once
-	file_rpm_proto_rawDescData = file_rpm_proto_rawDesc
-)
+type GetUnitRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_rpm_proto_rawDescGZIP() []byte {
-	file_rpm_proto_rawDescOnce.Do(func() {
-		file_rpm_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpm_proto_rawDescData)
-	})
-	return file_rpm_proto_rawDescData
+	Unit *Unit `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
 }
 
-var file_rpm_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_rpm_proto_goTypes = []interface{}{
-	(*Property)(nil),          // 0: rpmpb.Property
-	(*StorePropertyReq)(nil),  // 1: rpmpb.StorePropertyReq
-	(*StorePropertyRes)(nil),  // 2: rpmpb.StorePropertyRes
-	(*GetPropertyReq)(nil),    // 3: rpmpb.GetPropertyReq
-	(*GetPropertyRes)(nil),    // 4: rpmpb.GetPropertyRes
-	(*RemovePropertyReq)(nil), // 5: rpmpb.RemovePropertyReq
-	(*RemovePropertyRes)(nil), // 6: rpmpb.RemovePropertyRes
-	(*ListPropertiesReq)(nil), // 7: rpmpb.ListPropertiesReq
-	(*Tenant)(nil),            // 8: rpmpb.Tenant
-	(*Phone)(nil),             // 9: rpmpb.Phone
-	(*StoreTenantReq)(nil),    // 10: rpmpb.StoreTenantReq
-	(*StoreTenantRes)(nil),    // 11: rpmpb.StoreTenantRes
-	(*GetTenantReq)(nil),      // 12: rpmpb.GetTenantReq
-	(*GetTenantRes)(nil),      // 13: rpmpb.GetTenantRes
-	(*ListTenantsReq)(nil),    // 14: rpmpb.ListTenantsReq
+func (x *GetUnitRes) Reset() {
+	*x = GetUnitRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_rpm_proto_depIdxs = []int32{
-	0,  // 0: rpmpb.StorePropertyReq.property:type_name -> rpmpb.Property
-	0,  // 1: rpmpb.GetPropertyRes.property:type_name -> rpmpb.Property
-	9,  // 2: rpmpb.Tenant.phones:type_name -> rpmpb.Phone
-	8,  // 3: rpmpb.StoreTenantReq.tenant:type_name -> rpmpb.Tenant
-	8,  // 4: rpmpb.GetTenantRes.tenant:type_name -> rpmpb.Tenant
-	1,  // 5: rpmpb.RPM.StoreProperty:input_type -> rpmpb.StorePropertyReq
-	3,  // 6: rpmpb.RPM.GetProperty:input_type -> rpmpb.GetPropertyReq
-	5,  // 7: rpmpb.RPM.RemoveProperty:input_type -> rpmpb.RemovePropertyReq
-	7,  // 8: rpmpb.RPM.ListProperties:input_type -> rpmpb.ListPropertiesReq
-	10, // 9: rpmpb.RPM.StoreTenant:input_type -> rpmpb.StoreTenantReq
-	12, // 10: rpmpb.RPM.GetTenant:input_type -> rpmpb.GetTenantReq
-	14, // 11: rpmpb.RPM.ListTenants:input_type -> rpmpb.ListTenantsReq
-	2,  // 12: rpmpb.RPM.StoreProperty:output_type -> rpmpb.StorePropertyRes
-	4,  // 13: rpmpb.RPM.GetProperty:output_type -> rpmpb.GetPropertyRes
-	6,  // 14: rpmpb.RPM.RemoveProperty:output_type -> rpmpb.RemovePropertyRes
-	0,  // 15: rpmpb.RPM.ListProperties:output_type -> rpmpb.Property
-	11, // 16: rpmpb.RPM.StoreTenant:output_type -> rpmpb.StoreTenantRes
-	13, // 17: rpmpb.RPM.GetTenant:output_type -> rpmpb.GetTenantRes
-	8,  // 18: rpmpb.RPM.ListTenants:output_type -> rpmpb.Tenant
-	12, // [12:19] is the sub-list for method output_type
-	5,  // [5:12] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+
+func (x *GetUnitRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_rpm_proto_init() }
-func file_rpm_proto_init() {
-	if File_rpm_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_rpm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+func (*GetUnitRes) ProtoMessage() {}
+
+func (x *GetUnitRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnitRes.ProtoReflect.Descriptor instead.
+func (*GetUnitRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUnitRes) GetUnit() *Unit {
+	if x != nil {
+		return x.Unit
+	}
+	return nil
+}
+
+type ListUnitsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropertyID string `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+}
+
+func (x *ListUnitsReq) Reset() {
+	*x = ListUnitsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUnitsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnitsReq) ProtoMessage() {}
+
+func (x *ListUnitsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnitsReq.ProtoReflect.Descriptor instead.
+func (*ListUnitsReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListUnitsReq) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+type RemoveUnitReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropertyID string `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+	UnitID     string `protobuf:"bytes,2,opt,name=unitID,proto3" json:"unitID,omitempty"`
+}
+
+func (x *RemoveUnitReq) Reset() {
+	*x = RemoveUnitReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveUnitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUnitReq) ProtoMessage() {}
+
+func (x *RemoveUnitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUnitReq.ProtoReflect.Descriptor instead.
+func (*RemoveUnitReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveUnitReq) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+func (x *RemoveUnitReq) GetUnitID() string {
+	if x != nil {
+		return x.UnitID
+	}
+	return ""
+}
+
+type RemoveUnitRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveUnitRes) Reset() {
+	*x = RemoveUnitRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveUnitRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUnitRes) ProtoMessage() {}
+
+func (x *RemoveUnitRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUnitRes.ProtoReflect.Descriptor instead.
+func (*RemoveUnitRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{18}
+}
+
+type Tenant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantID  string   `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+	FullName  string   `protobuf:"bytes,2,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	DlNum     string   `protobuf:"bytes,3,opt,name=dlNum,proto3" json:"dlNum,omitempty"`
+	DlState   string   `protobuf:"bytes,4,opt,name=dlState,proto3" json:"dlState,omitempty"`
+	Dob       string   `protobuf:"bytes,5,opt,name=dob,proto3" json:"dob,omitempty"` // date of birth, ex: "2006-01-02"
+	Phones    []*Phone `protobuf:"bytes,6,rep,name=phones,proto3" json:"phones,omitempty"`
+	DeletedAt string   `protobuf:"bytes,7,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"` // RFC 3339, empty unless the tenant was removed
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Tenant) GetTenantID() string {
+	if x != nil {
+		return x.TenantID
+	}
+	return ""
+}
+
+func (x *Tenant) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Tenant) GetDlNum() string {
+	if x != nil {
+		return x.DlNum
+	}
+	return ""
+}
+
+func (x *Tenant) GetDlState() string {
+	if x != nil {
+		return x.DlState
+	}
+	return ""
+}
+
+func (x *Tenant) GetDob() string {
+	if x != nil {
+		return x.Dob
+	}
+	return ""
+}
+
+func (x *Tenant) GetPhones() []*Phone {
+	if x != nil {
+		return x.Phones
+	}
+	return nil
+}
+
+func (x *Tenant) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+type Phone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Note   string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *Phone) Reset() {
+	*x = Phone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Phone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Phone) ProtoMessage() {}
+
+func (x *Phone) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Phone.ProtoReflect.Descriptor instead.
+func (*Phone) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Phone) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Phone) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type StoreTenantReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"` // uuid generated when omitted
+}
+
+func (x *StoreTenantReq) Reset() {
+	*x = StoreTenantReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreTenantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreTenantReq) ProtoMessage() {}
+
+func (x *StoreTenantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreTenantReq.ProtoReflect.Descriptor instead.
+func (*StoreTenantReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StoreTenantReq) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type StoreTenantRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantID string `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+}
+
+func (x *StoreTenantRes) Reset() {
+	*x = StoreTenantRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreTenantRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreTenantRes) ProtoMessage() {}
+
+func (x *StoreTenantRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreTenantRes.ProtoReflect.Descriptor instead.
+func (*StoreTenantRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StoreTenantRes) GetTenantID() string {
+	if x != nil {
+		return x.TenantID
+	}
+	return ""
+}
+
+type GetTenantReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantID string `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+}
+
+func (x *GetTenantReq) Reset() {
+	*x = GetTenantReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantReq) ProtoMessage() {}
+
+func (x *GetTenantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantReq.ProtoReflect.Descriptor instead.
+func (*GetTenantReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTenantReq) GetTenantID() string {
+	if x != nil {
+		return x.TenantID
+	}
+	return ""
+}
+
+type GetTenantRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *GetTenantRes) Reset() {
+	*x = GetTenantRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRes) ProtoMessage() {}
+
+func (x *GetTenantRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRes.ProtoReflect.Descriptor instead.
+func (*GetTenantRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTenantRes) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type ListTenantsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeDeleted    bool     `protobuf:"varint,1,opt,name=includeDeleted,proto3" json:"includeDeleted,omitempty"` // include removed tenants which were not purged yet
+	TenantIDs         []string `protobuf:"bytes,2,rep,name=tenantIDs,proto3" json:"tenantIDs,omitempty"`
+	Name              string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                           // part of the full name, case is ignored
+	Phone             string   `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`                         // only the digits are compared, 555-555-1234 matches 5555551234
+	DlState           string   `protobuf:"bytes,5,opt,name=dlState,proto3" json:"dlState,omitempty"`                     // drivers license state, case is ignored
+	DobFrom           string   `protobuf:"bytes,6,opt,name=dobFrom,proto3" json:"dobFrom,omitempty"`                     // ex: "2006-01-02", born on or after
+	DobUntil          string   `protobuf:"bytes,7,opt,name=dobUntil,proto3" json:"dobUntil,omitempty"`                   // ex: "2006-01-02", born on or before
+	LeasingPropertyID string   `protobuf:"bytes,8,opt,name=leasingPropertyID,proto3" json:"leasingPropertyID,omitempty"` // on a lease of the property whose term includes today
+	Page              *Page    `protobuf:"bytes,9,opt,name=page,proto3" json:"page,omitempty"`                           // sort by createdAt or name
+}
+
+func (x *ListTenantsReq) Reset() {
+	*x = ListTenantsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTenantsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsReq) ProtoMessage() {}
+
+func (x *ListTenantsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsReq.ProtoReflect.Descriptor instead.
+func (*ListTenantsReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTenantsReq) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ListTenantsReq) GetTenantIDs() []string {
+	if x != nil {
+		return x.TenantIDs
+	}
+	return nil
+}
+
+func (x *ListTenantsReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListTenantsReq) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ListTenantsReq) GetDlState() string {
+	if x != nil {
+		return x.DlState
+	}
+	return ""
+}
+
+func (x *ListTenantsReq) GetDobFrom() string {
+	if x != nil {
+		return x.DobFrom
+	}
+	return ""
+}
+
+func (x *ListTenantsReq) GetDobUntil() string {
+	if x != nil {
+		return x.DobUntil
+	}
+	return ""
+}
+
+func (x *ListTenantsReq) GetLeasingPropertyID() string {
+	if x != nil {
+		return x.LeasingPropertyID
+	}
+	return ""
+}
+
+func (x *ListTenantsReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// PatchTenantReq changes only the fields listed, a listed field left empty
+// is removed, fullName and dob can not be removed
+type PatchTenantReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantID string   `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+	Tenant   *Tenant  `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"` // the new value of every field listed, its tenantID is ignored
+	Fields   []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"` // ex: "fullName", "dob", "dlNum", "dlState", "phones"
+}
+
+func (x *PatchTenantReq) Reset() {
+	*x = PatchTenantReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchTenantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchTenantReq) ProtoMessage() {}
+
+func (x *PatchTenantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchTenantReq.ProtoReflect.Descriptor instead.
+func (*PatchTenantReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PatchTenantReq) GetTenantID() string {
+	if x != nil {
+		return x.TenantID
+	}
+	return ""
+}
+
+func (x *PatchTenantReq) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *PatchTenantReq) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type PatchTenantRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *PatchTenantRes) Reset() {
+	*x = PatchTenantRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchTenantRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchTenantRes) ProtoMessage() {}
+
+func (x *PatchTenantRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchTenantRes.ProtoReflect.Descriptor instead.
+func (*PatchTenantRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PatchTenantRes) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type RemoveTenantReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantID string `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+}
+
+func (x *RemoveTenantReq) Reset() {
+	*x = RemoveTenantReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTenantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantReq) ProtoMessage() {}
+
+func (x *RemoveTenantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantReq.ProtoReflect.Descriptor instead.
+func (*RemoveTenantReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RemoveTenantReq) GetTenantID() string {
+	if x != nil {
+		return x.TenantID
+	}
+	return ""
+}
+
+type RemoveTenantRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveTenantRes) Reset() {
+	*x = RemoveTenantRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTenantRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantRes) ProtoMessage() {}
+
+func (x *RemoveTenantRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantRes.ProtoReflect.Descriptor instead.
+func (*RemoveTenantRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{29}
+}
+
+type RestoreTenantReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantID string `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+}
+
+func (x *RestoreTenantReq) Reset() {
+	*x = RestoreTenantReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreTenantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTenantReq) ProtoMessage() {}
+
+func (x *RestoreTenantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTenantReq.ProtoReflect.Descriptor instead.
+func (*RestoreTenantReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RestoreTenantReq) GetTenantID() string {
+	if x != nil {
+		return x.TenantID
+	}
+	return ""
+}
+
+type RestoreTenantRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *RestoreTenantRes) Reset() {
+	*x = RestoreTenantRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreTenantRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTenantRes) ProtoMessage() {}
+
+func (x *RestoreTenantRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTenantRes.ProtoReflect.Descriptor instead.
+func (*RestoreTenantRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RestoreTenantRes) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type AddTenantPhoneReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantID string `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+	Phone    *Phone `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *AddTenantPhoneReq) Reset() {
+	*x = AddTenantPhoneReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTenantPhoneReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTenantPhoneReq) ProtoMessage() {}
+
+func (x *AddTenantPhoneReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTenantPhoneReq.ProtoReflect.Descriptor instead.
+func (*AddTenantPhoneReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddTenantPhoneReq) GetTenantID() string {
+	if x != nil {
+		return x.TenantID
+	}
+	return ""
+}
+
+func (x *AddTenantPhoneReq) GetPhone() *Phone {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
+}
+
+type AddTenantPhoneRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *AddTenantPhoneRes) Reset() {
+	*x = AddTenantPhoneRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTenantPhoneRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTenantPhoneRes) ProtoMessage() {}
+
+func (x *AddTenantPhoneRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTenantPhoneRes.ProtoReflect.Descriptor instead.
+func (*AddTenantPhoneRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddTenantPhoneRes) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type UpdateTenantPhoneReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantID string `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+	Number   string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"` // number of the phone to update
+	Phone    *Phone `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`   // may have a new number
+}
+
+func (x *UpdateTenantPhoneReq) Reset() {
+	*x = UpdateTenantPhoneReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTenantPhoneReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantPhoneReq) ProtoMessage() {}
+
+func (x *UpdateTenantPhoneReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantPhoneReq.ProtoReflect.Descriptor instead.
+func (*UpdateTenantPhoneReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateTenantPhoneReq) GetTenantID() string {
+	if x != nil {
+		return x.TenantID
+	}
+	return ""
+}
+
+func (x *UpdateTenantPhoneReq) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *UpdateTenantPhoneReq) GetPhone() *Phone {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
+}
+
+type UpdateTenantPhoneRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *UpdateTenantPhoneRes) Reset() {
+	*x = UpdateTenantPhoneRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTenantPhoneRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantPhoneRes) ProtoMessage() {}
+
+func (x *UpdateTenantPhoneRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantPhoneRes.ProtoReflect.Descriptor instead.
+func (*UpdateTenantPhoneRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateTenantPhoneRes) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type RemoveTenantPhoneReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantID string `protobuf:"bytes,1,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+	Number   string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *RemoveTenantPhoneReq) Reset() {
+	*x = RemoveTenantPhoneReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTenantPhoneReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantPhoneReq) ProtoMessage() {}
+
+func (x *RemoveTenantPhoneReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantPhoneReq.ProtoReflect.Descriptor instead.
+func (*RemoveTenantPhoneReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RemoveTenantPhoneReq) GetTenantID() string {
+	if x != nil {
+		return x.TenantID
+	}
+	return ""
+}
+
+func (x *RemoveTenantPhoneReq) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type RemoveTenantPhoneRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveTenantPhoneRes) Reset() {
+	*x = RemoveTenantPhoneRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTenantPhoneRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantPhoneRes) ProtoMessage() {}
+
+func (x *RemoveTenantPhoneRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantPhoneRes.ProtoReflect.Descriptor instead.
+func (*RemoveTenantPhoneRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{37}
+}
+
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount   int64  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`    // minor units of the currency, 125050 is 1,250.50 USD
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 currency code
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Lease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID      string   `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	PropertyID   string   `protobuf:"bytes,2,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+	TenantIDs    []string `protobuf:"bytes,3,rep,name=tenantIDs,proto3" json:"tenantIDs,omitempty"`
+	StartDate    string   `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`       // ex: "2006-01-02"
+	EndDate      string   `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`           // ex: "2006-01-02", empty when the lease has no end
+	RentInterval string   `protobuf:"bytes,9,opt,name=rentInterval,proto3" json:"rentInterval,omitempty"` // daily, weekly, monthly
+	Deposit      *Money   `protobuf:"bytes,10,opt,name=deposit,proto3" json:"deposit,omitempty"`          // same currency as rentAmount
+	RentAmount   *Money   `protobuf:"bytes,11,opt,name=rentAmount,proto3" json:"rentAmount,omitempty"`    // currency defaults to USD when omitted
+	RenewsID     string   `protobuf:"bytes,12,opt,name=renewsID,proto3" json:"renewsID,omitempty"`        // the lease this one renews, set by RenewLease
+	UnitID       string   `protobuf:"bytes,13,opt,name=unitID,proto3" json:"unitID,omitempty"`            // the unit of the property leased, required when the property has more than one
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lease) ProtoMessage() {}
+
+func (x *Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Lease) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *Lease) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+func (x *Lease) GetTenantIDs() []string {
+	if x != nil {
+		return x.TenantIDs
+	}
+	return nil
+}
+
+func (x *Lease) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Lease) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Lease) GetRentInterval() string {
+	if x != nil {
+		return x.RentInterval
+	}
+	return ""
+}
+
+func (x *Lease) GetDeposit() *Money {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *Lease) GetRentAmount() *Money {
+	if x != nil {
+		return x.RentAmount
+	}
+	return nil
+}
+
+func (x *Lease) GetRenewsID() string {
+	if x != nil {
+		return x.RenewsID
+	}
+	return ""
+}
+
+func (x *Lease) GetUnitID() string {
+	if x != nil {
+		return x.UnitID
+	}
+	return ""
+}
+
+type LeasePropertyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lease *Lease `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"` // uuid generated when omitted
+}
+
+func (x *LeasePropertyReq) Reset() {
+	*x = LeasePropertyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeasePropertyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeasePropertyReq) ProtoMessage() {}
+
+func (x *LeasePropertyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeasePropertyReq.ProtoReflect.Descriptor instead.
+func (*LeasePropertyReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LeasePropertyReq) GetLease() *Lease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type LeasePropertyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lease *Lease `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+}
+
+func (x *LeasePropertyRes) Reset() {
+	*x = LeasePropertyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeasePropertyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeasePropertyRes) ProtoMessage() {}
+
+func (x *LeasePropertyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeasePropertyRes.ProtoReflect.Descriptor instead.
+func (*LeasePropertyRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LeasePropertyRes) GetLease() *Lease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type GetLeaseReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+}
+
+func (x *GetLeaseReq) Reset() {
+	*x = GetLeaseReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaseReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaseReq) ProtoMessage() {}
+
+func (x *GetLeaseReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaseReq.ProtoReflect.Descriptor instead.
+func (*GetLeaseReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetLeaseReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+type GetLeaseRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lease   *Lease          `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+	History []*LeaseVersion `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"` // every version of the lease and the leases linked to it by renewal, oldest first
+}
+
+func (x *GetLeaseRes) Reset() {
+	*x = GetLeaseRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaseRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaseRes) ProtoMessage() {}
+
+func (x *GetLeaseRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaseRes.ProtoReflect.Descriptor instead.
+func (*GetLeaseRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetLeaseRes) GetLease() *Lease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+func (x *GetLeaseRes) GetHistory() []*LeaseVersion {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type LeaseVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID       string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	Version       int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Change        string `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty"`               // created, renewed, amended, terminated
+	EffectiveDate string `protobuf:"bytes,4,opt,name=effectiveDate,proto3" json:"effectiveDate,omitempty"` // first day the terms apply, or the last day of a terminated lease
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Lease         *Lease `protobuf:"bytes,6,opt,name=lease,proto3" json:"lease,omitempty"` // the terms as of this version
+}
+
+func (x *LeaseVersion) Reset() {
+	*x = LeaseVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseVersion) ProtoMessage() {}
+
+func (x *LeaseVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseVersion.ProtoReflect.Descriptor instead.
+func (*LeaseVersion) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LeaseVersion) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *LeaseVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LeaseVersion) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *LeaseVersion) GetEffectiveDate() string {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return ""
+}
+
+func (x *LeaseVersion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LeaseVersion) GetLease() *Lease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type ListLeasesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropertyID string `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+	UnitID     string `protobuf:"bytes,2,opt,name=unitID,proto3" json:"unitID,omitempty"`
+	Page       *Page  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"` // sort by startDate or endDate, startDate when empty
+}
+
+func (x *ListLeasesReq) Reset() {
+	*x = ListLeasesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLeasesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeasesReq) ProtoMessage() {}
+
+func (x *ListLeasesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeasesReq.ProtoReflect.Descriptor instead.
+func (*ListLeasesReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListLeasesReq) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+func (x *ListLeasesReq) GetUnitID() string {
+	if x != nil {
+		return x.UnitID
+	}
+	return ""
+}
+
+func (x *ListLeasesReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type TerminateLeaseReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	EndDate string `protobuf:"bytes,2,opt,name=endDate,proto3" json:"endDate,omitempty"` // ex: "2006-01-02", must be within the lease term
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *TerminateLeaseReq) Reset() {
+	*x = TerminateLeaseReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminateLeaseReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateLeaseReq) ProtoMessage() {}
+
+func (x *TerminateLeaseReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateLeaseReq.ProtoReflect.Descriptor instead.
+func (*TerminateLeaseReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TerminateLeaseReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *TerminateLeaseReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *TerminateLeaseReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TerminateLeaseRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lease *Lease `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+}
+
+func (x *TerminateLeaseRes) Reset() {
+	*x = TerminateLeaseRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminateLeaseRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateLeaseRes) ProtoMessage() {}
+
+func (x *TerminateLeaseRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateLeaseRes.ProtoReflect.Descriptor instead.
+func (*TerminateLeaseRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TerminateLeaseRes) GetLease() *Lease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type RenewLeaseReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID    string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`       // the lease to renew
+	NewLeaseID string `protobuf:"bytes,2,opt,name=newLeaseID,proto3" json:"newLeaseID,omitempty"` // uuid generated when omitted
+	EndDate    string `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`       // last day of the new term, it starts the day after the lease ends
+	RentAmount *Money `protobuf:"bytes,4,opt,name=rentAmount,proto3" json:"rentAmount,omitempty"` // the current rent is kept when omitted
+	Reason     string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RenewLeaseReq) Reset() {
+	*x = RenewLeaseReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewLeaseReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLeaseReq) ProtoMessage() {}
+
+func (x *RenewLeaseReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLeaseReq.ProtoReflect.Descriptor instead.
+func (*RenewLeaseReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RenewLeaseReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *RenewLeaseReq) GetNewLeaseID() string {
+	if x != nil {
+		return x.NewLeaseID
+	}
+	return ""
+}
+
+func (x *RenewLeaseReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *RenewLeaseReq) GetRentAmount() *Money {
+	if x != nil {
+		return x.RentAmount
+	}
+	return nil
+}
+
+func (x *RenewLeaseReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RenewLeaseRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lease *Lease `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"` // the new lease
+}
+
+func (x *RenewLeaseRes) Reset() {
+	*x = RenewLeaseRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewLeaseRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLeaseRes) ProtoMessage() {}
+
+func (x *RenewLeaseRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLeaseRes.ProtoReflect.Descriptor instead.
+func (*RenewLeaseRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RenewLeaseRes) GetLease() *Lease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type AmendLeaseReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID         string   `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	EffectiveDate   string   `protobuf:"bytes,2,opt,name=effectiveDate,proto3" json:"effectiveDate,omitempty"` // first day the amended terms apply
+	RentAmount      *Money   `protobuf:"bytes,3,opt,name=rentAmount,proto3" json:"rentAmount,omitempty"`       // the current rent is kept when omitted
+	AddTenantIDs    []string `protobuf:"bytes,4,rep,name=addTenantIDs,proto3" json:"addTenantIDs,omitempty"`
+	RemoveTenantIDs []string `protobuf:"bytes,5,rep,name=removeTenantIDs,proto3" json:"removeTenantIDs,omitempty"`
+	Reason          string   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AmendLeaseReq) Reset() {
+	*x = AmendLeaseReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmendLeaseReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendLeaseReq) ProtoMessage() {}
+
+func (x *AmendLeaseReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendLeaseReq.ProtoReflect.Descriptor instead.
+func (*AmendLeaseReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AmendLeaseReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *AmendLeaseReq) GetEffectiveDate() string {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return ""
+}
+
+func (x *AmendLeaseReq) GetRentAmount() *Money {
+	if x != nil {
+		return x.RentAmount
+	}
+	return nil
+}
+
+func (x *AmendLeaseReq) GetAddTenantIDs() []string {
+	if x != nil {
+		return x.AddTenantIDs
+	}
+	return nil
+}
+
+func (x *AmendLeaseReq) GetRemoveTenantIDs() []string {
+	if x != nil {
+		return x.RemoveTenantIDs
+	}
+	return nil
+}
+
+func (x *AmendLeaseReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AmendLeaseRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lease *Lease `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+}
+
+func (x *AmendLeaseRes) Reset() {
+	*x = AmendLeaseRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmendLeaseRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendLeaseRes) ProtoMessage() {}
+
+func (x *AmendLeaseRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendLeaseRes.ProtoReflect.Descriptor instead.
+func (*AmendLeaseRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AmendLeaseRes) GetLease() *Lease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type RentDue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DueDate     string `protobuf:"bytes,1,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
+	PeriodStart string `protobuf:"bytes,2,opt,name=periodStart,proto3" json:"periodStart,omitempty"` // first day this payment covers
+	PeriodEnd   string `protobuf:"bytes,3,opt,name=periodEnd,proto3" json:"periodEnd,omitempty"`     // last day this payment covers
+	Prorated    bool   `protobuf:"varint,5,opt,name=prorated,proto3" json:"prorated,omitempty"`      // period is shorter than the full rent interval
+	Amount      *Money `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RentDue) Reset() {
+	*x = RentDue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RentDue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RentDue) ProtoMessage() {}
+
+func (x *RentDue) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RentDue.ProtoReflect.Descriptor instead.
+func (*RentDue) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RentDue) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *RentDue) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *RentDue) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *RentDue) GetProrated() bool {
+	if x != nil {
+		return x.Prorated
+	}
+	return false
+}
+
+func (x *RentDue) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type GetRentScheduleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	DueDay  int32  `protobuf:"varint,2,opt,name=dueDay,proto3" json:"dueDay,omitempty"` // 1-31 for monthly rent, 1-7 (Monday-Sunday) for weekly rent
+	Until   string `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`    // ex: "2006-01-02", required when the lease has no end date
+}
+
+func (x *GetRentScheduleReq) Reset() {
+	*x = GetRentScheduleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRentScheduleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRentScheduleReq) ProtoMessage() {}
+
+func (x *GetRentScheduleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRentScheduleReq.ProtoReflect.Descriptor instead.
+func (*GetRentScheduleReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetRentScheduleReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *GetRentScheduleReq) GetDueDay() int32 {
+	if x != nil {
+		return x.DueDay
+	}
+	return 0
+}
+
+func (x *GetRentScheduleReq) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntryID    string `protobuf:"bytes,1,opt,name=entryID,proto3" json:"entryID,omitempty"`
+	LeaseID    string `protobuf:"bytes,2,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	Type       string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // charge, payment, credit, refund
+	Date       string `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"` // ex: "2006-01-02"
+	Memo       string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	ReversesID string `protobuf:"bytes,7,opt,name=reversesID,proto3" json:"reversesID,omitempty"` // set when this entry cancels an earlier one
+	Ref        string `protobuf:"bytes,8,opt,name=ref,proto3" json:"ref,omitempty"`               // links the entry to what produced it, ex: "latefee:2006-01-02"
+	Amount     *Money `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`         // in the lease currency, always positive, type decides if it raises or lowers the balance
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *LedgerEntry) GetEntryID() string {
+	if x != nil {
+		return x.EntryID
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetReversesID() string {
+	if x != nil {
+		return x.ReversesID
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type PostLedgerEntryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *LedgerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"` // uuid generated when omitted
+}
+
+func (x *PostLedgerEntryReq) Reset() {
+	*x = PostLedgerEntryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostLedgerEntryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostLedgerEntryReq) ProtoMessage() {}
+
+func (x *PostLedgerEntryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostLedgerEntryReq.ProtoReflect.Descriptor instead.
+func (*PostLedgerEntryReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PostLedgerEntryReq) GetEntry() *LedgerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type PostLedgerEntryRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *LedgerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *PostLedgerEntryRes) Reset() {
+	*x = PostLedgerEntryRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostLedgerEntryRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostLedgerEntryRes) ProtoMessage() {}
+
+func (x *PostLedgerEntryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostLedgerEntryRes.ProtoReflect.Descriptor instead.
+func (*PostLedgerEntryRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PostLedgerEntryRes) GetEntry() *LedgerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ReverseLedgerEntryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	EntryID string `protobuf:"bytes,2,opt,name=entryID,proto3" json:"entryID,omitempty"`
+	Date    string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"` // ex: "2006-01-02"
+	Memo    string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *ReverseLedgerEntryReq) Reset() {
+	*x = ReverseLedgerEntryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseLedgerEntryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseLedgerEntryReq) ProtoMessage() {}
+
+func (x *ReverseLedgerEntryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseLedgerEntryReq.ProtoReflect.Descriptor instead.
+func (*ReverseLedgerEntryReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReverseLedgerEntryReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *ReverseLedgerEntryReq) GetEntryID() string {
+	if x != nil {
+		return x.EntryID
+	}
+	return ""
+}
+
+func (x *ReverseLedgerEntryReq) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ReverseLedgerEntryReq) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type ReverseLedgerEntryRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *LedgerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *ReverseLedgerEntryRes) Reset() {
+	*x = ReverseLedgerEntryRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseLedgerEntryRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseLedgerEntryRes) ProtoMessage() {}
+
+func (x *ReverseLedgerEntryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseLedgerEntryRes.ProtoReflect.Descriptor instead.
+func (*ReverseLedgerEntryRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReverseLedgerEntryRes) GetEntry() *LedgerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetBalanceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	AsOf    string `protobuf:"bytes,2,opt,name=asOf,proto3" json:"asOf,omitempty"` // ex: "2006-01-02", every entry when omitted
+}
+
+func (x *GetBalanceReq) Reset() {
+	*x = GetBalanceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceReq) ProtoMessage() {}
+
+func (x *GetBalanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceReq.ProtoReflect.Descriptor instead.
+func (*GetBalanceReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetBalanceReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *GetBalanceReq) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type GetBalanceRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	AsOf    string `protobuf:"bytes,2,opt,name=asOf,proto3" json:"asOf,omitempty"`
+	Balance *Money `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"` // lease currency, negative when the tenant has a credit
+}
+
+func (x *GetBalanceRes) Reset() {
+	*x = GetBalanceRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRes) ProtoMessage() {}
+
+func (x *GetBalanceRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRes.ProtoReflect.Descriptor instead.
+func (*GetBalanceRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetBalanceRes) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *GetBalanceRes) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+func (x *GetBalanceRes) GetBalance() *Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type GetStatementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	From    string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`   // ex: "2006-01-02", first entry when omitted
+	Until   string `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"` // ex: "2006-01-02", last entry when omitted
+}
+
+func (x *GetStatementReq) Reset() {
+	*x = GetStatementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementReq) ProtoMessage() {}
+
+func (x *GetStatementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementReq.ProtoReflect.Descriptor instead.
+func (*GetStatementReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetStatementReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *GetStatementReq) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetStatementReq) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+type StatementLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry   *LedgerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Balance *Money       `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"` // balance after the entry
+}
+
+func (x *StatementLine) Reset() {
+	*x = StatementLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatementLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementLine) ProtoMessage() {}
+
+func (x *StatementLine) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementLine.ProtoReflect.Descriptor instead.
+func (*StatementLine) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *StatementLine) GetEntry() *LedgerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *StatementLine) GetBalance() *Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type Statement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID        string           `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	From           string           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Until          string           `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	Lines          []*StatementLine `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
+	OpeningBalance *Money           `protobuf:"bytes,7,opt,name=openingBalance,proto3" json:"openingBalance,omitempty"` // lease currency
+	ClosingBalance *Money           `protobuf:"bytes,8,opt,name=closingBalance,proto3" json:"closingBalance,omitempty"`
+}
+
+func (x *Statement) Reset() {
+	*x = Statement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Statement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Statement) ProtoMessage() {}
+
+func (x *Statement) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Statement.ProtoReflect.Descriptor instead.
+func (*Statement) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Statement) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *Statement) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Statement) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *Statement) GetLines() []*StatementLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Statement) GetOpeningBalance() *Money {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return nil
+}
+
+func (x *Statement) GetClosingBalance() *Money {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return nil
+}
+
+type LateFeePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PolicyID    string `protobuf:"bytes,1,opt,name=policyID,proto3" json:"policyID,omitempty"`
+	PropertyID  string `protobuf:"bytes,2,opt,name=propertyID,proto3" json:"propertyID,omitempty"` // applies to every lease on the property
+	LeaseID     string `protobuf:"bytes,3,opt,name=leaseID,proto3" json:"leaseID,omitempty"`       // applies to this lease only, takes precedence over a property policy
+	GraceDays   int64  `protobuf:"varint,4,opt,name=graceDays,proto3" json:"graceDays,omitempty"`
+	RentPercent int64  `protobuf:"varint,6,opt,name=rentPercent,proto3" json:"rentPercent,omitempty"` // basis points of the late payment, 500 is 5%
+	FlatFee     *Money `protobuf:"bytes,9,opt,name=flatFee,proto3" json:"flatFee,omitempty"`          // lease currency
+	DailyFee    *Money `protobuf:"bytes,10,opt,name=dailyFee,proto3" json:"dailyFee,omitempty"`
+	MaxFee      *Money `protobuf:"bytes,11,opt,name=maxFee,proto3" json:"maxFee,omitempty"` // per late payment, zero is no cap
+}
+
+func (x *LateFeePolicy) Reset() {
+	*x = LateFeePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LateFeePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LateFeePolicy) ProtoMessage() {}
+
+func (x *LateFeePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LateFeePolicy.ProtoReflect.Descriptor instead.
+func (*LateFeePolicy) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *LateFeePolicy) GetPolicyID() string {
+	if x != nil {
+		return x.PolicyID
+	}
+	return ""
+}
+
+func (x *LateFeePolicy) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+func (x *LateFeePolicy) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *LateFeePolicy) GetGraceDays() int64 {
+	if x != nil {
+		return x.GraceDays
+	}
+	return 0
+}
+
+func (x *LateFeePolicy) GetRentPercent() int64 {
+	if x != nil {
+		return x.RentPercent
+	}
+	return 0
+}
+
+func (x *LateFeePolicy) GetFlatFee() *Money {
+	if x != nil {
+		return x.FlatFee
+	}
+	return nil
+}
+
+func (x *LateFeePolicy) GetDailyFee() *Money {
+	if x != nil {
+		return x.DailyFee
+	}
+	return nil
+}
+
+func (x *LateFeePolicy) GetMaxFee() *Money {
+	if x != nil {
+		return x.MaxFee
+	}
+	return nil
+}
+
+type StoreLateFeePolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *LateFeePolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // replaces the policy stored for the same lease or property
+}
+
+func (x *StoreLateFeePolicyReq) Reset() {
+	*x = StoreLateFeePolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreLateFeePolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreLateFeePolicyReq) ProtoMessage() {}
+
+func (x *StoreLateFeePolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreLateFeePolicyReq.ProtoReflect.Descriptor instead.
+func (*StoreLateFeePolicyReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *StoreLateFeePolicyReq) GetPolicy() *LateFeePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type StoreLateFeePolicyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *LateFeePolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *StoreLateFeePolicyRes) Reset() {
+	*x = StoreLateFeePolicyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreLateFeePolicyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreLateFeePolicyRes) ProtoMessage() {}
+
+func (x *StoreLateFeePolicyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreLateFeePolicyRes.ProtoReflect.Descriptor instead.
+func (*StoreLateFeePolicyRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *StoreLateFeePolicyRes) GetPolicy() *LateFeePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type GetLateFeePolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+}
+
+func (x *GetLateFeePolicyReq) Reset() {
+	*x = GetLateFeePolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLateFeePolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLateFeePolicyReq) ProtoMessage() {}
+
+func (x *GetLateFeePolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLateFeePolicyReq.ProtoReflect.Descriptor instead.
+func (*GetLateFeePolicyReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetLateFeePolicyReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+type GetLateFeePolicyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *LateFeePolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *GetLateFeePolicyRes) Reset() {
+	*x = GetLateFeePolicyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLateFeePolicyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLateFeePolicyRes) ProtoMessage() {}
+
+func (x *GetLateFeePolicyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLateFeePolicyRes.ProtoReflect.Descriptor instead.
+func (*GetLateFeePolicyRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetLateFeePolicyRes) GetPolicy() *LateFeePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type LateFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DueDate  string `protobuf:"bytes,1,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
+	DaysLate int64  `protobuf:"varint,3,opt,name=daysLate,proto3" json:"daysLate,omitempty"`
+	Ref      string `protobuf:"bytes,5,opt,name=ref,proto3" json:"ref,omitempty"`
+	Unpaid   *Money `protobuf:"bytes,6,opt,name=unpaid,proto3" json:"unpaid,omitempty"` // rent still owed when the grace period ended
+	Amount   *Money `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"` // total fee owed for this payment
+}
+
+func (x *LateFee) Reset() {
+	*x = LateFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LateFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LateFee) ProtoMessage() {}
+
+func (x *LateFee) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LateFee.ProtoReflect.Descriptor instead.
+func (*LateFee) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *LateFee) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *LateFee) GetDaysLate() int64 {
+	if x != nil {
+		return x.DaysLate
+	}
+	return 0
+}
+
+func (x *LateFee) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *LateFee) GetUnpaid() *Money {
+	if x != nil {
+		return x.Unpaid
+	}
+	return nil
+}
+
+func (x *LateFee) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type AssessLateFeesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	AsOf    string `protobuf:"bytes,2,opt,name=asOf,proto3" json:"asOf,omitempty"` // ex: "2006-01-02", today when omitted
+}
+
+func (x *AssessLateFeesReq) Reset() {
+	*x = AssessLateFeesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssessLateFeesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessLateFeesReq) ProtoMessage() {}
+
+func (x *AssessLateFeesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessLateFeesReq.ProtoReflect.Descriptor instead.
+func (*AssessLateFeesReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AssessLateFeesReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *AssessLateFeesReq) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type ApplyLateFeesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	AsOf    string `protobuf:"bytes,2,opt,name=asOf,proto3" json:"asOf,omitempty"` // ex: "2006-01-02", today when omitted
+}
+
+func (x *ApplyLateFeesReq) Reset() {
+	*x = ApplyLateFeesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyLateFeesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyLateFeesReq) ProtoMessage() {}
+
+func (x *ApplyLateFeesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyLateFeesReq.ProtoReflect.Descriptor instead.
+func (*ApplyLateFeesReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ApplyLateFeesReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *ApplyLateFeesReq) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type DepositReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceiptID string `protobuf:"bytes,1,opt,name=receiptID,proto3" json:"receiptID,omitempty"`
+	LeaseID   string `protobuf:"bytes,2,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	Amount    *Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // must be in the lease currency
+	Date      string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`     // ex: "2006-01-02"
+	Memo      string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *DepositReceipt) Reset() {
+	*x = DepositReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositReceipt) ProtoMessage() {}
+
+func (x *DepositReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositReceipt.ProtoReflect.Descriptor instead.
+func (*DepositReceipt) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DepositReceipt) GetReceiptID() string {
+	if x != nil {
+		return x.ReceiptID
+	}
+	return ""
+}
+
+func (x *DepositReceipt) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *DepositReceipt) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *DepositReceipt) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DepositReceipt) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type RecordDepositReceiptReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt *DepositReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *RecordDepositReceiptReq) Reset() {
+	*x = RecordDepositReceiptReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordDepositReceiptReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordDepositReceiptReq) ProtoMessage() {}
+
+func (x *RecordDepositReceiptReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordDepositReceiptReq.ProtoReflect.Descriptor instead.
+func (*RecordDepositReceiptReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RecordDepositReceiptReq) GetReceipt() *DepositReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type RecordDepositReceiptRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt *DepositReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *RecordDepositReceiptRes) Reset() {
+	*x = RecordDepositReceiptRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordDepositReceiptRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordDepositReceiptRes) ProtoMessage() {}
+
+func (x *RecordDepositReceiptRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordDepositReceiptRes.ProtoReflect.Descriptor instead.
+func (*RecordDepositReceiptRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RecordDepositReceiptRes) GetReceipt() *DepositReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type Deduction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category    string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // damage, unpaid_rent, cleaning or other
+	Amount      *Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Deduction) Reset() {
+	*x = Deduction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deduction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deduction) ProtoMessage() {}
+
+func (x *Deduction) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deduction.ProtoReflect.Descriptor instead.
+func (*Deduction) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Deduction) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Deduction) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Deduction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DepositDisposition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DispositionID string       `protobuf:"bytes,1,opt,name=dispositionID,proto3" json:"dispositionID,omitempty"`
+	LeaseID       string       `protobuf:"bytes,2,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	MoveOutDate   string       `protobuf:"bytes,3,opt,name=moveOutDate,proto3" json:"moveOutDate,omitempty"` // ex: "2006-01-02"
+	Deductions    []*Deduction `protobuf:"bytes,4,rep,name=deductions,proto3" json:"deductions,omitempty"`
+	Held          *Money       `protobuf:"bytes,5,opt,name=held,proto3" json:"held,omitempty"`     // set by the server
+	Refund        *Money       `protobuf:"bytes,6,opt,name=refund,proto3" json:"refund,omitempty"` // set by the server, due back to the tenant
+	Owed          *Money       `protobuf:"bytes,7,opt,name=owed,proto3" json:"owed,omitempty"`     // set by the server, deductions beyond what was held
+}
+
+func (x *DepositDisposition) Reset() {
+	*x = DepositDisposition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositDisposition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositDisposition) ProtoMessage() {}
+
+func (x *DepositDisposition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositDisposition.ProtoReflect.Descriptor instead.
+func (*DepositDisposition) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DepositDisposition) GetDispositionID() string {
+	if x != nil {
+		return x.DispositionID
+	}
+	return ""
+}
+
+func (x *DepositDisposition) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *DepositDisposition) GetMoveOutDate() string {
+	if x != nil {
+		return x.MoveOutDate
+	}
+	return ""
+}
+
+func (x *DepositDisposition) GetDeductions() []*Deduction {
+	if x != nil {
+		return x.Deductions
+	}
+	return nil
+}
+
+func (x *DepositDisposition) GetHeld() *Money {
+	if x != nil {
+		return x.Held
+	}
+	return nil
+}
+
+func (x *DepositDisposition) GetRefund() *Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *DepositDisposition) GetOwed() *Money {
+	if x != nil {
+		return x.Owed
+	}
+	return nil
+}
+
+type DisposeDepositReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disposition *DepositDisposition `protobuf:"bytes,1,opt,name=disposition,proto3" json:"disposition,omitempty"` // a deposit can only be disposed once
+}
+
+func (x *DisposeDepositReq) Reset() {
+	*x = DisposeDepositReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisposeDepositReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisposeDepositReq) ProtoMessage() {}
+
+func (x *DisposeDepositReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisposeDepositReq.ProtoReflect.Descriptor instead.
+func (*DisposeDepositReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DisposeDepositReq) GetDisposition() *DepositDisposition {
+	if x != nil {
+		return x.Disposition
+	}
+	return nil
+}
+
+type DisposeDepositRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disposition *DepositDisposition `protobuf:"bytes,1,opt,name=disposition,proto3" json:"disposition,omitempty"`
+}
+
+func (x *DisposeDepositRes) Reset() {
+	*x = DisposeDepositRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisposeDepositRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisposeDepositRes) ProtoMessage() {}
+
+func (x *DisposeDepositRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisposeDepositRes.ProtoReflect.Descriptor instead.
+func (*DisposeDepositRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DisposeDepositRes) GetDisposition() *DepositDisposition {
+	if x != nil {
+		return x.Disposition
+	}
+	return nil
+}
+
+type GetDepositReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+}
+
+func (x *GetDepositReq) Reset() {
+	*x = GetDepositReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDepositReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepositReq) ProtoMessage() {}
+
+func (x *GetDepositReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepositReq.ProtoReflect.Descriptor instead.
+func (*GetDepositReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetDepositReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+type DepositAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID     string              `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+	Required    *Money              `protobuf:"bytes,2,opt,name=required,proto3" json:"required,omitempty"`
+	Held        *Money              `protobuf:"bytes,3,opt,name=held,proto3" json:"held,omitempty"`
+	Status      string              `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // due, held or disposed
+	Receipts    []*DepositReceipt   `protobuf:"bytes,5,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	Disposition *DepositDisposition `protobuf:"bytes,6,opt,name=disposition,proto3" json:"disposition,omitempty"` // unset until the deposit is disposed
+}
+
+func (x *DepositAccount) Reset() {
+	*x = DepositAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAccount) ProtoMessage() {}
+
+func (x *DepositAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAccount.ProtoReflect.Descriptor instead.
+func (*DepositAccount) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DepositAccount) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+func (x *DepositAccount) GetRequired() *Money {
+	if x != nil {
+		return x.Required
+	}
+	return nil
+}
+
+func (x *DepositAccount) GetHeld() *Money {
+	if x != nil {
+		return x.Held
+	}
+	return nil
+}
+
+func (x *DepositAccount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DepositAccount) GetReceipts() []*DepositReceipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+func (x *DepositAccount) GetDisposition() *DepositDisposition {
+	if x != nil {
+		return x.Disposition
+	}
+	return nil
+}
+
+type GetDepositStatementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseID string `protobuf:"bytes,1,opt,name=leaseID,proto3" json:"leaseID,omitempty"`
+}
+
+func (x *GetDepositStatementReq) Reset() {
+	*x = GetDepositStatementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDepositStatementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepositStatementReq) ProtoMessage() {}
+
+func (x *GetDepositStatementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepositStatementReq.ProtoReflect.Descriptor instead.
+func (*GetDepositStatementReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetDepositStatementReq) GetLeaseID() string {
+	if x != nil {
+		return x.LeaseID
+	}
+	return ""
+}
+
+type GetDepositStatementRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *GetDepositStatementRes) Reset() {
+	*x = GetDepositStatementRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDepositStatementRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepositStatementRes) ProtoMessage() {}
+
+func (x *GetDepositStatementRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepositStatementRes.ProtoReflect.Descriptor instead.
+func (*GetDepositStatementRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetDepositStatementRes) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type Applicant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FullName        string `protobuf:"bytes,1,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	Dob             string `protobuf:"bytes,2,opt,name=dob,proto3" json:"dob,omitempty"` // date of birth, ex: "2006-01-02"
+	Ssn             string `protobuf:"bytes,3,opt,name=ssn,proto3" json:"ssn,omitempty"`
+	DlNum           string `protobuf:"bytes,4,opt,name=dlNum,proto3" json:"dlNum,omitempty"`
+	DlState         string `protobuf:"bytes,5,opt,name=dlState,proto3" json:"dlState,omitempty"`
+	HasPets         bool   `protobuf:"varint,6,opt,name=hasPets,proto3" json:"hasPets,omitempty"`
+	PetsDesc        string `protobuf:"bytes,7,opt,name=petsDesc,proto3" json:"petsDesc,omitempty"`
+	VehicleCount    int32  `protobuf:"varint,8,opt,name=vehicleCount,proto3" json:"vehicleCount,omitempty"`
+	VehicleDesc     string `protobuf:"bytes,9,opt,name=vehicleDesc,proto3" json:"vehicleDesc,omitempty"`
+	CrimeConviction bool   `protobuf:"varint,10,opt,name=crimeConviction,proto3" json:"crimeConviction,omitempty"`
+	CrimeDesc       string `protobuf:"bytes,11,opt,name=crimeDesc,proto3" json:"crimeDesc,omitempty"`
+	BankruptcyFiled bool   `protobuf:"varint,12,opt,name=bankruptcyFiled,proto3" json:"bankruptcyFiled,omitempty"`
+	BankruptcyDesc  string `protobuf:"bytes,13,opt,name=bankruptcyDesc,proto3" json:"bankruptcyDesc,omitempty"`
+	MonthlyIncome   *Money `protobuf:"bytes,14,opt,name=monthlyIncome,proto3" json:"monthlyIncome,omitempty"` // used by min_income screening rules
+}
+
+func (x *Applicant) Reset() {
+	*x = Applicant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Applicant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Applicant) ProtoMessage() {}
+
+func (x *Applicant) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Applicant.ProtoReflect.Descriptor instead.
+func (*Applicant) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Applicant) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Applicant) GetDob() string {
+	if x != nil {
+		return x.Dob
+	}
+	return ""
+}
+
+func (x *Applicant) GetSsn() string {
+	if x != nil {
+		return x.Ssn
+	}
+	return ""
+}
+
+func (x *Applicant) GetDlNum() string {
+	if x != nil {
+		return x.DlNum
+	}
+	return ""
+}
+
+func (x *Applicant) GetDlState() string {
+	if x != nil {
+		return x.DlState
+	}
+	return ""
+}
+
+func (x *Applicant) GetHasPets() bool {
+	if x != nil {
+		return x.HasPets
+	}
+	return false
+}
+
+func (x *Applicant) GetPetsDesc() string {
+	if x != nil {
+		return x.PetsDesc
+	}
+	return ""
+}
+
+func (x *Applicant) GetVehicleCount() int32 {
+	if x != nil {
+		return x.VehicleCount
+	}
+	return 0
+}
+
+func (x *Applicant) GetVehicleDesc() string {
+	if x != nil {
+		return x.VehicleDesc
+	}
+	return ""
+}
+
+func (x *Applicant) GetCrimeConviction() bool {
+	if x != nil {
+		return x.CrimeConviction
+	}
+	return false
+}
+
+func (x *Applicant) GetCrimeDesc() string {
+	if x != nil {
+		return x.CrimeDesc
+	}
+	return ""
+}
+
+func (x *Applicant) GetBankruptcyFiled() bool {
+	if x != nil {
+		return x.BankruptcyFiled
+	}
+	return false
+}
+
+func (x *Applicant) GetBankruptcyDesc() string {
+	if x != nil {
+		return x.BankruptcyDesc
+	}
+	return ""
+}
+
+func (x *Applicant) GetMonthlyIncome() *Money {
+	if x != nil {
+		return x.MonthlyIncome
+	}
+	return nil
+}
+
+type ApplicationNote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Note      string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt string `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // RFC 3339
+}
+
+func (x *ApplicationNote) Reset() {
+	*x = ApplicationNote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationNote) ProtoMessage() {}
+
+func (x *ApplicationNote) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationNote.ProtoReflect.Descriptor instead.
+func (*ApplicationNote) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ApplicationNote) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApplicationNote) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ApplicationNote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type Application struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationID string             `protobuf:"bytes,1,opt,name=applicationID,proto3" json:"applicationID,omitempty"`
+	PropertyID    string             `protobuf:"bytes,2,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+	MoveInDate    string             `protobuf:"bytes,3,opt,name=moveInDate,proto3" json:"moveInDate,omitempty"` // ex: "2006-01-02"
+	Applicants    []*Applicant       `protobuf:"bytes,4,rep,name=applicants,proto3" json:"applicants,omitempty"`
+	Status        string             `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`           // submitted, under_review, approved, denied, withdrawn - set by the server
+	Notes         []*ApplicationNote `protobuf:"bytes,6,rep,name=notes,proto3" json:"notes,omitempty"`             // set by the server, one for every status change
+	TenantIDs     []string           `protobuf:"bytes,7,rep,name=tenantIDs,proto3" json:"tenantIDs,omitempty"`     // set by the server once converted
+	SubmittedAt   string             `protobuf:"bytes,8,opt,name=submittedAt,proto3" json:"submittedAt,omitempty"` // RFC 3339, set by the server
+}
+
+func (x *Application) Reset() {
+	*x = Application{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Application) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Application) ProtoMessage() {}
+
+func (x *Application) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Application.ProtoReflect.Descriptor instead.
+func (*Application) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Application) GetApplicationID() string {
+	if x != nil {
+		return x.ApplicationID
+	}
+	return ""
+}
+
+func (x *Application) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+func (x *Application) GetMoveInDate() string {
+	if x != nil {
+		return x.MoveInDate
+	}
+	return ""
+}
+
+func (x *Application) GetApplicants() []*Applicant {
+	if x != nil {
+		return x.Applicants
+	}
+	return nil
+}
+
+func (x *Application) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Application) GetNotes() []*ApplicationNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Application) GetTenantIDs() []string {
+	if x != nil {
+		return x.TenantIDs
+	}
+	return nil
+}
+
+func (x *Application) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+type SubmitApplicationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Application *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"` // uuid generated when omitted
+}
+
+func (x *SubmitApplicationReq) Reset() {
+	*x = SubmitApplicationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitApplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitApplicationReq) ProtoMessage() {}
+
+func (x *SubmitApplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitApplicationReq.ProtoReflect.Descriptor instead.
+func (*SubmitApplicationReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SubmitApplicationReq) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type SubmitApplicationRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Application *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+}
+
+func (x *SubmitApplicationRes) Reset() {
+	*x = SubmitApplicationRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitApplicationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitApplicationRes) ProtoMessage() {}
+
+func (x *SubmitApplicationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitApplicationRes.ProtoReflect.Descriptor instead.
+func (*SubmitApplicationRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SubmitApplicationRes) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type GetApplicationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationID string `protobuf:"bytes,1,opt,name=applicationID,proto3" json:"applicationID,omitempty"`
+}
+
+func (x *GetApplicationReq) Reset() {
+	*x = GetApplicationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationReq) ProtoMessage() {}
+
+func (x *GetApplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationReq.ProtoReflect.Descriptor instead.
+func (*GetApplicationReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetApplicationReq) GetApplicationID() string {
+	if x != nil {
+		return x.ApplicationID
+	}
+	return ""
+}
+
+type GetApplicationRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Application *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+}
+
+func (x *GetApplicationRes) Reset() {
+	*x = GetApplicationRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApplicationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationRes) ProtoMessage() {}
+
+func (x *GetApplicationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationRes.ProtoReflect.Descriptor instead.
+func (*GetApplicationRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetApplicationRes) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type ListApplicationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropertyID string `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+	Status     string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page       *Page  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"` // sort by createdAt
+}
+
+func (x *ListApplicationsReq) Reset() {
+	*x = ListApplicationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListApplicationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationsReq) ProtoMessage() {}
+
+func (x *ListApplicationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationsReq.ProtoReflect.Descriptor instead.
+func (*ListApplicationsReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListApplicationsReq) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+func (x *ListApplicationsReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListApplicationsReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type UpdateApplicationStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationID string `protobuf:"bytes,1,opt,name=applicationID,proto3" json:"applicationID,omitempty"`
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // under_review, approved, denied or withdrawn
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`     // required to deny
+}
+
+func (x *UpdateApplicationStatusReq) Reset() {
+	*x = UpdateApplicationStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateApplicationStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationStatusReq) ProtoMessage() {}
+
+func (x *UpdateApplicationStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationStatusReq.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationStatusReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UpdateApplicationStatusReq) GetApplicationID() string {
+	if x != nil {
+		return x.ApplicationID
+	}
+	return ""
+}
+
+func (x *UpdateApplicationStatusReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateApplicationStatusReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type UpdateApplicationStatusRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Application *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+}
+
+func (x *UpdateApplicationStatusRes) Reset() {
+	*x = UpdateApplicationStatusRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateApplicationStatusRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationStatusRes) ProtoMessage() {}
+
+func (x *UpdateApplicationStatusRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationStatusRes.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationStatusRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UpdateApplicationStatusRes) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type ConvertApplicationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationID string `protobuf:"bytes,1,opt,name=applicationID,proto3" json:"applicationID,omitempty"` // must be approved
+}
+
+func (x *ConvertApplicationReq) Reset() {
+	*x = ConvertApplicationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertApplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertApplicationReq) ProtoMessage() {}
+
+func (x *ConvertApplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertApplicationReq.ProtoReflect.Descriptor instead.
+func (*ConvertApplicationReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ConvertApplicationReq) GetApplicationID() string {
+	if x != nil {
+		return x.ApplicationID
+	}
+	return ""
+}
+
+type ConvertApplicationRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Application *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	Tenants     []*Tenant    `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"` // one for every applicant
+	Lease       *Lease       `protobuf:"bytes,3,opt,name=lease,proto3" json:"lease,omitempty"`     // draft, not stored, set the rent and deposit and then LeaseProperty
+}
+
+func (x *ConvertApplicationRes) Reset() {
+	*x = ConvertApplicationRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertApplicationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertApplicationRes) ProtoMessage() {}
+
+func (x *ConvertApplicationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertApplicationRes.ProtoReflect.Descriptor instead.
+func (*ConvertApplicationRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ConvertApplicationRes) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *ConvertApplicationRes) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *ConvertApplicationRes) GetLease() *Lease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type ScreeningRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Criterion string `protobuf:"bytes,1,opt,name=criterion,proto3" json:"criterion,omitempty"` // no_pets, max_vehicles, no_crime_conviction, no_bankruptcy or min_income
+	Limit     int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`        // vehicles allowed for max_vehicles, percent of rent for min_income, 300 is 3x
+	Action    string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`       // fail or review, what happens when the rule is not met
+}
+
+func (x *ScreeningRule) Reset() {
+	*x = ScreeningRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreeningRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreeningRule) ProtoMessage() {}
+
+func (x *ScreeningRule) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreeningRule.ProtoReflect.Descriptor instead.
+func (*ScreeningRule) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ScreeningRule) GetCriterion() string {
+	if x != nil {
+		return x.Criterion
+	}
+	return ""
+}
+
+func (x *ScreeningRule) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ScreeningRule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type ScreeningPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PolicyID   string           `protobuf:"bytes,1,opt,name=policyID,proto3" json:"policyID,omitempty"` // set by the server
+	PropertyID string           `protobuf:"bytes,2,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+	Rent       *Money           `protobuf:"bytes,3,opt,name=rent,proto3" json:"rent,omitempty"` // required by min_income rules
+	Rules      []*ScreeningRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *ScreeningPolicy) Reset() {
+	*x = ScreeningPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreeningPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreeningPolicy) ProtoMessage() {}
+
+func (x *ScreeningPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreeningPolicy.ProtoReflect.Descriptor instead.
+func (*ScreeningPolicy) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ScreeningPolicy) GetPolicyID() string {
+	if x != nil {
+		return x.PolicyID
+	}
+	return ""
+}
+
+func (x *ScreeningPolicy) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+func (x *ScreeningPolicy) GetRent() *Money {
+	if x != nil {
+		return x.Rent
+	}
+	return nil
+}
+
+func (x *ScreeningPolicy) GetRules() []*ScreeningRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type StoreScreeningPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *ScreeningPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // replaces the policy stored for the property
+}
+
+func (x *StoreScreeningPolicyReq) Reset() {
+	*x = StoreScreeningPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreScreeningPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreScreeningPolicyReq) ProtoMessage() {}
+
+func (x *StoreScreeningPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreScreeningPolicyReq.ProtoReflect.Descriptor instead.
+func (*StoreScreeningPolicyReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *StoreScreeningPolicyReq) GetPolicy() *ScreeningPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type StoreScreeningPolicyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *ScreeningPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *StoreScreeningPolicyRes) Reset() {
+	*x = StoreScreeningPolicyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreScreeningPolicyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreScreeningPolicyRes) ProtoMessage() {}
+
+func (x *StoreScreeningPolicyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreScreeningPolicyRes.ProtoReflect.Descriptor instead.
+func (*StoreScreeningPolicyRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *StoreScreeningPolicyRes) GetPolicy() *ScreeningPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type GetScreeningPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropertyID string `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+}
+
+func (x *GetScreeningPolicyReq) Reset() {
+	*x = GetScreeningPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScreeningPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScreeningPolicyReq) ProtoMessage() {}
+
+func (x *GetScreeningPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScreeningPolicyReq.ProtoReflect.Descriptor instead.
+func (*GetScreeningPolicyReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetScreeningPolicyReq) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+type GetScreeningPolicyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *ScreeningPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *GetScreeningPolicyRes) Reset() {
+	*x = GetScreeningPolicyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScreeningPolicyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScreeningPolicyRes) ProtoMessage() {}
+
+func (x *GetScreeningPolicyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScreeningPolicyRes.ProtoReflect.Descriptor instead.
+func (*GetScreeningPolicyRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetScreeningPolicyRes) GetPolicy() *ScreeningPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type ScreeningFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule      *ScreeningRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`           // the rule which fired
+	Applicant string         `protobuf:"bytes,2,opt,name=applicant,proto3" json:"applicant,omitempty"` // full name, empty when the rule applies to all applicants together
+	Reason    string         `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ScreeningFinding) Reset() {
+	*x = ScreeningFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreeningFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreeningFinding) ProtoMessage() {}
+
+func (x *ScreeningFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreeningFinding.ProtoReflect.Descriptor instead.
+func (*ScreeningFinding) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ScreeningFinding) GetRule() *ScreeningRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *ScreeningFinding) GetApplicant() string {
+	if x != nil {
+		return x.Applicant
+	}
+	return ""
+}
+
+func (x *ScreeningFinding) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ScreeningReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportID      string              `protobuf:"bytes,1,opt,name=reportID,proto3" json:"reportID,omitempty"`
+	ApplicationID string              `protobuf:"bytes,2,opt,name=applicationID,proto3" json:"applicationID,omitempty"`
+	PropertyID    string              `protobuf:"bytes,3,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+	Result        string              `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"` // pass, fail or needs_review
+	Rent          *Money              `protobuf:"bytes,5,opt,name=rent,proto3" json:"rent,omitempty"`
+	Rules         []*ScreeningRule    `protobuf:"bytes,6,rep,name=rules,proto3" json:"rules,omitempty"` // the rules which were applied
+	Findings      []*ScreeningFinding `protobuf:"bytes,7,rep,name=findings,proto3" json:"findings,omitempty"`
+	CreatedAt     string              `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // RFC 3339
+}
+
+func (x *ScreeningReport) Reset() {
+	*x = ScreeningReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreeningReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreeningReport) ProtoMessage() {}
+
+func (x *ScreeningReport) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreeningReport.ProtoReflect.Descriptor instead.
+func (*ScreeningReport) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ScreeningReport) GetReportID() string {
+	if x != nil {
+		return x.ReportID
+	}
+	return ""
+}
+
+func (x *ScreeningReport) GetApplicationID() string {
+	if x != nil {
+		return x.ApplicationID
+	}
+	return ""
+}
+
+func (x *ScreeningReport) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+func (x *ScreeningReport) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ScreeningReport) GetRent() *Money {
+	if x != nil {
+		return x.Rent
+	}
+	return nil
+}
+
+func (x *ScreeningReport) GetRules() []*ScreeningRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ScreeningReport) GetFindings() []*ScreeningFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *ScreeningReport) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ScreenApplicationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationID string `protobuf:"bytes,1,opt,name=applicationID,proto3" json:"applicationID,omitempty"`
+}
+
+func (x *ScreenApplicationReq) Reset() {
+	*x = ScreenApplicationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreenApplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenApplicationReq) ProtoMessage() {}
+
+func (x *ScreenApplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenApplicationReq.ProtoReflect.Descriptor instead.
+func (*ScreenApplicationReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ScreenApplicationReq) GetApplicationID() string {
+	if x != nil {
+		return x.ApplicationID
+	}
+	return ""
+}
+
+type ScreenApplicationRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *ScreeningReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *ScreenApplicationRes) Reset() {
+	*x = ScreenApplicationRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreenApplicationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenApplicationRes) ProtoMessage() {}
+
+func (x *ScreenApplicationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenApplicationRes.ProtoReflect.Descriptor instead.
+func (*ScreenApplicationRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ScreenApplicationRes) GetReport() *ScreeningReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type ListScreeningReportsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationID string `protobuf:"bytes,1,opt,name=applicationID,proto3" json:"applicationID,omitempty"`
+	Page          *Page  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"` // sort by createdAt
+}
+
+func (x *ListScreeningReportsReq) Reset() {
+	*x = ListScreeningReportsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScreeningReportsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScreeningReportsReq) ProtoMessage() {}
+
+func (x *ListScreeningReportsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScreeningReportsReq.ProtoReflect.Descriptor instead.
+func (*ListScreeningReportsReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListScreeningReportsReq) GetApplicationID() string {
+	if x != nil {
+		return x.ApplicationID
+	}
+	return ""
+}
+
+func (x *ListScreeningReportsReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type RentalDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllowSmoking  bool   `protobuf:"varint,1,opt,name=allowSmoking,proto3" json:"allowSmoking,omitempty"`
+	AllowPets     bool   `protobuf:"varint,2,opt,name=allowPets,proto3" json:"allowPets,omitempty"`
+	ParkingSpaces int64  `protobuf:"varint,3,opt,name=parkingSpaces,proto3" json:"parkingSpaces,omitempty"`
+	ParkingDesc   string `protobuf:"bytes,4,opt,name=parkingDesc,proto3" json:"parkingDesc,omitempty"`
+}
+
+func (x *RentalDetails) Reset() {
+	*x = RentalDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RentalDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RentalDetails) ProtoMessage() {}
+
+func (x *RentalDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RentalDetails.ProtoReflect.Descriptor instead.
+func (*RentalDetails) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *RentalDetails) GetAllowSmoking() bool {
+	if x != nil {
+		return x.AllowSmoking
+	}
+	return false
+}
+
+func (x *RentalDetails) GetAllowPets() bool {
+	if x != nil {
+		return x.AllowPets
+	}
+	return false
+}
+
+func (x *RentalDetails) GetParkingSpaces() int64 {
+	if x != nil {
+		return x.ParkingSpaces
+	}
+	return 0
+}
+
+func (x *RentalDetails) GetParkingDesc() string {
+	if x != nil {
+		return x.ParkingDesc
+	}
+	return ""
+}
+
+type ListingPhoto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url     string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Caption string `protobuf:"bytes,2,opt,name=caption,proto3" json:"caption,omitempty"`
+	Width   int64  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`   // pixels
+	Height  int64  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"` // pixels
+}
+
+func (x *ListingPhoto) Reset() {
+	*x = ListingPhoto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListingPhoto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingPhoto) ProtoMessage() {}
+
+func (x *ListingPhoto) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingPhoto.ProtoReflect.Descriptor instead.
+func (*ListingPhoto) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListingPhoto) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ListingPhoto) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *ListingPhoto) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ListingPhoto) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type Listing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListingID     string          `protobuf:"bytes,1,opt,name=listingID,proto3" json:"listingID,omitempty"` // set by the server
+	PropertyID    string          `protobuf:"bytes,2,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+	Details       *RentalDetails  `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	Rent          *Money          `protobuf:"bytes,4,opt,name=rent,proto3" json:"rent,omitempty"`                   // asking rent per month, required to publish
+	AvailableFrom string          `protobuf:"bytes,5,opt,name=availableFrom,proto3" json:"availableFrom,omitempty"` // ex: "2006-01-02", required to publish
+	Description   string          `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Photos        []*ListingPhoto `protobuf:"bytes,7,rep,name=photos,proto3" json:"photos,omitempty"`           // in the order they are shown, the first is the cover
+	Status        string          `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`           // draft or published, set by the server
+	PublishedAt   string          `protobuf:"bytes,9,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"` // RFC 3339, empty while a draft
+}
+
+func (x *Listing) Reset() {
+	*x = Listing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Listing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listing) ProtoMessage() {}
+
+func (x *Listing) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listing.ProtoReflect.Descriptor instead.
+func (*Listing) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *Listing) GetListingID() string {
+	if x != nil {
+		return x.ListingID
+	}
+	return ""
+}
+
+func (x *Listing) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+func (x *Listing) GetDetails() *RentalDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Listing) GetRent() *Money {
+	if x != nil {
+		return x.Rent
+	}
+	return nil
+}
+
+func (x *Listing) GetAvailableFrom() string {
+	if x != nil {
+		return x.AvailableFrom
+	}
+	return ""
+}
+
+func (x *Listing) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Listing) GetPhotos() []*ListingPhoto {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+func (x *Listing) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Listing) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+type StoreListingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listing *Listing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"` // replaces the listing stored for the property, whether it is published does not change
+}
+
+func (x *StoreListingReq) Reset() {
+	*x = StoreListingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreListingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreListingReq) ProtoMessage() {}
+
+func (x *StoreListingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreListingReq.ProtoReflect.Descriptor instead.
+func (*StoreListingReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *StoreListingReq) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type StoreListingRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listing *Listing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+}
+
+func (x *StoreListingRes) Reset() {
+	*x = StoreListingRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreListingRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreListingRes) ProtoMessage() {}
+
+func (x *StoreListingRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreListingRes.ProtoReflect.Descriptor instead.
+func (*StoreListingRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *StoreListingRes) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type GetListingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropertyID string `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+}
+
+func (x *GetListingReq) Reset() {
+	*x = GetListingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListingReq) ProtoMessage() {}
+
+func (x *GetListingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListingReq.ProtoReflect.Descriptor instead.
+func (*GetListingReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetListingReq) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+type GetListingRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listing *Listing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+}
+
+func (x *GetListingRes) Reset() {
+	*x = GetListingRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListingRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListingRes) ProtoMessage() {}
+
+func (x *GetListingRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListingRes.ProtoReflect.Descriptor instead.
+func (*GetListingRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetListingRes) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type PublishListingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropertyID string `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+}
+
+func (x *PublishListingReq) Reset() {
+	*x = PublishListingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishListingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishListingReq) ProtoMessage() {}
+
+func (x *PublishListingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishListingReq.ProtoReflect.Descriptor instead.
+func (*PublishListingReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *PublishListingReq) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+type PublishListingRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listing *Listing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+}
+
+func (x *PublishListingRes) Reset() {
+	*x = PublishListingRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishListingRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishListingRes) ProtoMessage() {}
+
+func (x *PublishListingRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishListingRes.ProtoReflect.Descriptor instead.
+func (*PublishListingRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *PublishListingRes) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type UnpublishListingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropertyID string `protobuf:"bytes,1,opt,name=propertyID,proto3" json:"propertyID,omitempty"`
+}
+
+func (x *UnpublishListingReq) Reset() {
+	*x = UnpublishListingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpublishListingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishListingReq) ProtoMessage() {}
+
+func (x *UnpublishListingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishListingReq.ProtoReflect.Descriptor instead.
+func (*UnpublishListingReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *UnpublishListingReq) GetPropertyID() string {
+	if x != nil {
+		return x.PropertyID
+	}
+	return ""
+}
+
+type UnpublishListingRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listing *Listing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+}
+
+func (x *UnpublishListingRes) Reset() {
+	*x = UnpublishListingRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpublishListingRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishListingRes) ProtoMessage() {}
+
+func (x *UnpublishListingRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishListingRes.ProtoReflect.Descriptor instead.
+func (*UnpublishListingRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *UnpublishListingRes) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type PublicListing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listing  *Listing  `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	Property *Property `protobuf:"bytes,2,opt,name=property,proto3" json:"property,omitempty"`
+}
+
+func (x *PublicListing) Reset() {
+	*x = PublicListing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicListing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicListing) ProtoMessage() {}
+
+func (x *PublicListing) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicListing.ProtoReflect.Descriptor instead.
+func (*PublicListing) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *PublicListing) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+func (x *PublicListing) GetProperty() *Property {
+	if x != nil {
+		return x.Property
+	}
+	return nil
+}
+
+type ListPublicListingsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City        string `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`       // case insensitive
+	MinRent     *Money `protobuf:"bytes,2,opt,name=minRent,proto3" json:"minRent,omitempty"` // listings asking rent in another currency are not listed
+	MaxRent     *Money `protobuf:"bytes,3,opt,name=maxRent,proto3" json:"maxRent,omitempty"`
+	PetsAllowed bool   `protobuf:"varint,4,opt,name=petsAllowed,proto3" json:"petsAllowed,omitempty"`
+	Page        *Page  `protobuf:"bytes,5,opt,name=page,proto3" json:"page,omitempty"` // sort by publishedAt, -publishedAt when empty
+}
+
+func (x *ListPublicListingsReq) Reset() {
+	*x = ListPublicListingsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPublicListingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicListingsReq) ProtoMessage() {}
+
+func (x *ListPublicListingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicListingsReq.ProtoReflect.Descriptor instead.
+func (*ListPublicListingsReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListPublicListingsReq) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ListPublicListingsReq) GetMinRent() *Money {
+	if x != nil {
+		return x.MinRent
+	}
+	return nil
+}
+
+func (x *ListPublicListingsReq) GetMaxRent() *Money {
+	if x != nil {
+		return x.MaxRent
+	}
+	return nil
+}
+
+func (x *ListPublicListingsReq) GetPetsAllowed() bool {
+	if x != nil {
+		return x.PetsAllowed
+	}
+	return false
+}
+
+func (x *ListPublicListingsReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type OutboxMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`       // event name, ex: "rental.added"
+	Payload     string `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"` // the event as json
+	Status      string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`   // pending, delivered or dead
+	Attempts    int64  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError   string `protobuf:"bytes,6,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedAt   string `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`     // RFC 3339
+	NextAttempt string `protobuf:"bytes,8,opt,name=nextAttempt,proto3" json:"nextAttempt,omitempty"` // RFC 3339
+	DeliveredAt string `protobuf:"bytes,9,opt,name=deliveredAt,proto3" json:"deliveredAt,omitempty"` // RFC 3339, empty until delivered
+}
+
+func (x *OutboxMessage) Reset() {
+	*x = OutboxMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboxMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxMessage) ProtoMessage() {}
+
+func (x *OutboxMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxMessage.ProtoReflect.Descriptor instead.
+func (*OutboxMessage) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *OutboxMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OutboxMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OutboxMessage) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *OutboxMessage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OutboxMessage) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *OutboxMessage) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *OutboxMessage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *OutboxMessage) GetNextAttempt() string {
+	if x != nil {
+		return x.NextAttempt
+	}
+	return ""
+}
+
+func (x *OutboxMessage) GetDeliveredAt() string {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return ""
+}
+
+type ListOutboxReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Page   *Page  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"` // sort by createdAt
+}
+
+func (x *ListOutboxReq) Reset() {
+	*x = ListOutboxReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutboxReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutboxReq) ProtoMessage() {}
+
+func (x *ListOutboxReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutboxReq.ProtoReflect.Descriptor instead.
+func (*ListOutboxReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListOutboxReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListOutboxReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListOutboxReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetOutboxMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetOutboxMessageReq) Reset() {
+	*x = GetOutboxMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOutboxMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutboxMessageReq) ProtoMessage() {}
+
+func (x *GetOutboxMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutboxMessageReq.ProtoReflect.Descriptor instead.
+func (*GetOutboxMessageReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetOutboxMessageReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetOutboxMessageRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *OutboxMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GetOutboxMessageRes) Reset() {
+	*x = GetOutboxMessageRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOutboxMessageRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutboxMessageRes) ProtoMessage() {}
+
+func (x *GetOutboxMessageRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutboxMessageRes.ProtoReflect.Descriptor instead.
+func (*GetOutboxMessageRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetOutboxMessageRes) GetMessage() *OutboxMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ReplayOutboxMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReplayOutboxMessageReq) Reset() {
+	*x = ReplayOutboxMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayOutboxMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayOutboxMessageReq) ProtoMessage() {}
+
+func (x *ReplayOutboxMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayOutboxMessageReq.ProtoReflect.Descriptor instead.
+func (*ReplayOutboxMessageReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ReplayOutboxMessageReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReplayOutboxMessageRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *OutboxMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ReplayOutboxMessageRes) Reset() {
+	*x = ReplayOutboxMessageRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayOutboxMessageRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayOutboxMessageRes) ProtoMessage() {}
+
+func (x *ReplayOutboxMessageRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayOutboxMessageRes.ProtoReflect.Descriptor instead.
+func (*ReplayOutboxMessageRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ReplayOutboxMessageRes) GetMessage() *OutboxMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // set by the server when empty
+	Url       string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events    []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`       // event names, every event when empty
+	Secret    string   `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`       // at least 16 characters, made up for a new webhook when empty, a replaced one keeps its secret unless another is given, only sent back by StoreWebhook when the webhook is added
+	CreatedAt string   `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // RFC 3339, set by the server
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookID    string `protobuf:"bytes,2,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
+	Event        string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`            // event name, ex: "rental.added"
+	Payload      string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`        // the json body which is posted
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`          // pending, delivered or dead
+	StatusCode   int64  `protobuf:"varint,6,opt,name=statusCode,proto3" json:"statusCode,omitempty"` // of the last attempt, 0 when the receiver did not answer
+	Attempts     int64  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError    string `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	RedeliveryOf string `protobuf:"bytes,9,opt,name=redeliveryOf,proto3" json:"redeliveryOf,omitempty"` // the delivery this is a redelivery of
+	CreatedAt    string `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`      // RFC 3339
+	NextAttempt  string `protobuf:"bytes,11,opt,name=nextAttempt,proto3" json:"nextAttempt,omitempty"`  // RFC 3339
+	DeliveredAt  string `protobuf:"bytes,12,opt,name=deliveredAt,proto3" json:"deliveredAt,omitempty"`  // RFC 3339, empty until delivered
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookID() string {
+	if x != nil {
+		return x.WebhookID
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatusCode() int64 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetRedeliveryOf() string {
+	if x != nil {
+		return x.RedeliveryOf
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttempt() string {
+	if x != nil {
+		return x.NextAttempt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() string {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return ""
+}
+
+type StoreWebhookReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+}
+
+func (x *StoreWebhookReq) Reset() {
+	*x = StoreWebhookReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreWebhookReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreWebhookReq) ProtoMessage() {}
+
+func (x *StoreWebhookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreWebhookReq.ProtoReflect.Descriptor instead.
+func (*StoreWebhookReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *StoreWebhookReq) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type StoreWebhookRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+}
+
+func (x *StoreWebhookRes) Reset() {
+	*x = StoreWebhookRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreWebhookRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreWebhookRes) ProtoMessage() {}
+
+func (x *StoreWebhookRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreWebhookRes.ProtoReflect.Descriptor instead.
+func (*StoreWebhookRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *StoreWebhookRes) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type GetWebhookReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetWebhookReq) Reset() {
+	*x = GetWebhookReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookReq) ProtoMessage() {}
+
+func (x *GetWebhookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookReq.ProtoReflect.Descriptor instead.
+func (*GetWebhookReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetWebhookReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWebhookRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+}
+
+func (x *GetWebhookRes) Reset() {
+	*x = GetWebhookRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRes) ProtoMessage() {}
+
+func (x *GetWebhookRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRes.ProtoReflect.Descriptor instead.
+func (*GetWebhookRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetWebhookRes) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListWebhooksReq) Reset() {
+	*x = ListWebhooksReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksReq) ProtoMessage() {}
+
+func (x *ListWebhooksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksReq.ProtoReflect.Descriptor instead.
+func (*ListWebhooksReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{131}
+}
+
+type RemoveWebhookReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveWebhookReq) Reset() {
+	*x = RemoveWebhookReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWebhookReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWebhookReq) ProtoMessage() {}
+
+func (x *RemoveWebhookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWebhookReq.ProtoReflect.Descriptor instead.
+func (*RemoveWebhookReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RemoveWebhookReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveWebhookRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveWebhookRes) Reset() {
+	*x = RemoveWebhookRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWebhookRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWebhookRes) ProtoMessage() {}
+
+func (x *RemoveWebhookRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWebhookRes.ProtoReflect.Descriptor instead.
+func (*RemoveWebhookRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{133}
+}
+
+type ListWebhookDeliveriesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WebhookID string `protobuf:"bytes,1,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
+	Status    string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page      *Page  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"` // sort by createdAt, -createdAt when empty
+}
+
+func (x *ListWebhookDeliveriesReq) Reset() {
+	*x = ListWebhookDeliveriesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesReq) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesReq.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListWebhookDeliveriesReq) GetWebhookID() string {
+	if x != nil {
+		return x.WebhookID
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetWebhookDeliveryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetWebhookDeliveryReq) Reset() {
+	*x = GetWebhookDeliveryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookDeliveryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookDeliveryReq) ProtoMessage() {}
+
+func (x *GetWebhookDeliveryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookDeliveryReq.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveryReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetWebhookDeliveryReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWebhookDeliveryRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delivery *WebhookDelivery `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+}
+
+func (x *GetWebhookDeliveryRes) Reset() {
+	*x = GetWebhookDeliveryRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookDeliveryRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookDeliveryRes) ProtoMessage() {}
+
+func (x *GetWebhookDeliveryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookDeliveryRes.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveryRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetWebhookDeliveryRes) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+type RedeliverWebhookReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryID string `protobuf:"bytes,1,opt,name=deliveryID,proto3" json:"deliveryID,omitempty"`
+}
+
+func (x *RedeliverWebhookReq) Reset() {
+	*x = RedeliverWebhookReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeliverWebhookReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookReq) ProtoMessage() {}
+
+func (x *RedeliverWebhookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookReq.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *RedeliverWebhookReq) GetDeliveryID() string {
+	if x != nil {
+		return x.DeliveryID
+	}
+	return ""
+}
+
+type RedeliverWebhookRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delivery *WebhookDelivery `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"` // the new delivery
+}
+
+func (x *RedeliverWebhookRes) Reset() {
+	*x = RedeliverWebhookRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeliverWebhookRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRes) ProtoMessage() {}
+
+func (x *RedeliverWebhookRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRes.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRes) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *RedeliverWebhookRes) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor      string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`           // the api key the change was made with
+	Transport  string `protobuf:"bytes,3,opt,name=transport,proto3" json:"transport,omitempty"`   // rest or grpc
+	EntityType string `protobuf:"bytes,4,opt,name=entityType,proto3" json:"entityType,omitempty"` // property, tenant or lease
+	EntityID   string `protobuf:"bytes,5,opt,name=entityID,proto3" json:"entityID,omitempty"`
+	Action     string `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`       // create, update or delete
+	Diff       string `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`           // json, the old and new value of every field which changed
+	CreatedAt  string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // RFC 3339
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *AuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetEntityID() string {
+	if x != nil {
+		return x.EntityID
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListAuditReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityType string `protobuf:"bytes,1,opt,name=entityType,proto3" json:"entityType,omitempty"`
+	EntityID   string `protobuf:"bytes,2,opt,name=entityID,proto3" json:"entityID,omitempty"`
+	Actor      string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Page       *Page  `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"` // sort by createdAt
+}
+
+func (x *ListAuditReq) Reset() {
+	*x = ListAuditReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpm_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditReq) ProtoMessage() {}
+
+func (x *ListAuditReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpm_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditReq.ProtoReflect.Descriptor instead.
+func (*ListAuditReq) Descriptor() ([]byte, []int) {
+	return file_rpm_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListAuditReq) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ListAuditReq) GetEntityID() string {
+	if x != nil {
+		return x.EntityID
+	}
+	return ""
+}
+
+func (x *ListAuditReq) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+var File_rpm_proto protoreflect.FileDescriptor
+
+var file_rpm_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x72, 0x70, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x22, 0xde, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x7a, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x7a, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x22, 0x3f, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x22, 0x32, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x30, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x3d, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x22, 0x33, 0x0a, 0x11, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x13,
+	0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x41, 0x0a, 0x12, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x22, 0x74, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x22, 0x4e, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0xdc, 0x01, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x6e, 0x69, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x6e, 0x69,
+	0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62,
+	0x65, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x65, 0x64, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x62, 0x61, 0x74, 0x68, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x71, 0x66, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x71, 0x66, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x65, 0x6e,
+	0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x6e,
+	0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49,
+	0x44, 0x22, 0x2f, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x22, 0x2f, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x75,
+	0x6e, 0x69, 0x74, 0x22, 0x44, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x55, 0x6e,
+	0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x2e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x47, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49,
+	0x44, 0x22, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x06, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6c, 0x4e, 0x75, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x64, 0x6f, 0x62, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e,
+	0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x33, 0x0a, 0x05, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x22, 0x37, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x0e, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x2a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x44, 0x22, 0x35, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x9f, 0x02, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x49, 0x44, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x64, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f, 0x62, 0x46, 0x72,
+	0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f, 0x62, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x62, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x62, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x2c, 0x0a,
+	0x11, 0x6c, 0x65, 0x61, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6c, 0x65, 0x61, 0x73, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x6b, 0x0a, 0x0e,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x37, 0x0a, 0x0e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x22, 0x2d, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x44, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x49, 0x44, 0x22, 0x39, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22,
+	0x53, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44,
+	0x12, 0x22, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x22, 0x3a, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x22, 0x6e, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x22, 0x3d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22,
+	0x4a, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0xd7, 0x02, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x26,
+	0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x73, 0x49, 0x44,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x73, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x44, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x44, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04,
+	0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x22, 0x36, 0x0a, 0x10, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x22,
+	0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x22, 0x36, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x49, 0x44, 0x22, 0x60, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x05, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x44, 0x12, 0x1f, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x5f,
+	0x0a, 0x11, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x37, 0x0a, 0x11, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x0a, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0a, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0xe3, 0x01, 0x0a, 0x0d, 0x41, 0x6d,
+	0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x72,
+	0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x72,
+	0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x64, 0x64,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x64, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x33, 0x0a, 0x0d, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x12, 0x22, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x05, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x04,
+	0x10, 0x05, 0x22, 0x5c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x75, 0x65, 0x44, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x64, 0x75, 0x65, 0x44, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x22, 0xdb, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x49, 0x44, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x49, 0x44,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72,
+	0x65, 0x66, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x3e,
+	0x0a, 0x12, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x3e,
+	0x0a, 0x12, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x73,
+	0x0a, 0x15, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x22, 0x41, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x6b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x73, 0x4f, 0x66, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x03,
+	0x10, 0x04, 0x22, 0x55, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x67, 0x0a, 0x0d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x02,
+	0x10, 0x03, 0x22, 0xf3, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x12, 0x34, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e,
+	0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x63, 0x6c,
+	0x6f, 0x73, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x04,
+	0x10, 0x05, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0xaf, 0x02, 0x0a, 0x0d, 0x4c, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x61, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x26, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x46, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x07, 0x66, 0x6c, 0x61, 0x74, 0x46, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x46, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x46,
+	0x65, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04,
+	0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x22, 0x45, 0x0a, 0x15, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x74, 0x65,
+	0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0x45, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x2f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x12, 0x2c, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xa9,
+	0x01, 0x0a, 0x07, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x75,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x75, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x79, 0x73, 0x4c, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x61, 0x79, 0x73, 0x4c, 0x61, 0x74, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72,
+	0x65, 0x66, 0x12, 0x24, 0x0a, 0x06, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x06, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04,
+	0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x41, 0x0a, 0x11, 0x41, 0x73,
+	0x73, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x40, 0x0a,
+	0x10, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x73, 0x4f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22,
+	0x96, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x4a, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x22, 0x4a, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x22, 0x6f, 0x0a, 0x09, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x92, 0x02, 0x0a, 0x12, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x44, 0x69, 0x73,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x64, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x76, 0x65,
+	0x4f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
+	0x6f, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x64, 0x65,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x04,
+	0x68, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x12, 0x24,
+	0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x04, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x73,
+	0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x3b, 0x0a, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x44, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x70,
+	0x6f, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x49, 0x44, 0x22, 0xfe, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x49, 0x44, 0x12, 0x28, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x04,
+	0x68, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x44, 0x69,
+	0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0xc5, 0x03, 0x0a, 0x09, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x64, 0x6f, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x73, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x73, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6c, 0x4e, 0x75, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x50, 0x65, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x50, 0x65, 0x74, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x65, 0x74, 0x73, 0x44, 0x65, 0x73, 0x63, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x74, 0x73, 0x44, 0x65, 0x73, 0x63, 0x12, 0x22, 0x0a, 0x0c,
+	0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x72, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x72, 0x69,
+	0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x69, 0x6d, 0x65, 0x44, 0x65, 0x73, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x69, 0x6d, 0x65, 0x44, 0x65, 0x73, 0x63, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x61,
+	0x6e, 0x6b, 0x72, 0x75, 0x70, 0x74, 0x63, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x62, 0x61, 0x6e, 0x6b, 0x72, 0x75, 0x70, 0x74, 0x63, 0x79, 0x46,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x61, 0x6e, 0x6b, 0x72, 0x75, 0x70, 0x74,
+	0x63, 0x79, 0x44, 0x65, 0x73, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61,
+	0x6e, 0x6b, 0x72, 0x75, 0x70, 0x74, 0x63, 0x79, 0x44, 0x65, 0x73, 0x63, 0x12, 0x32, 0x0a, 0x0d,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0d, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65,
+	0x22, 0x5b, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xab, 0x02,
+	0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a,
+	0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4c, 0x0a, 0x14, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x14, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x22, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6e, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x6e, 0x0a,
+	0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x52, 0x0a,
+	0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x3d, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x22, 0x9a, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x5b, 0x0a,
+	0x0d, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x01, 0x0a, 0x0f, 0x53,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0x49, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x12, 0x2e,
+	0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x37,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x47, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x72, 0x0a, 0x10, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0xac, 0x02, 0x0a, 0x0f, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x3c, 0x0a, 0x14, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x22, 0x46, 0x0a, 0x14, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x60, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x0d,
+	0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x6d, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x6d, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x65, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x65, 0x74, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x44, 0x65, 0x73, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x44, 0x65, 0x73, 0x63, 0x22, 0x68, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xc8, 0x02, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3b, 0x0a, 0x0f,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12,
+	0x28, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x3b, 0x0a, 0x0f, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x2f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x22, 0x33, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x3d, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x35, 0x0a, 0x13, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x44, 0x22, 0x3f, 0x0a,
+	0x13, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x66,
+	0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x28, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x22, 0xbe, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x52, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x74, 0x73, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x65, 0x74, 0x73, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x62,
+	0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5c, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x45, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x48, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f,
+	0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x79, 0x0a, 0x07, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe7, 0x02, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4f, 0x66, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x3b, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x3b, 0x0a,
+	0x0f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73,
+	0x12, 0x28, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x22, 0x22, 0x0a, 0x10, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x12, 0x0a,
+	0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x73, 0x22, 0x71, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4b, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x22, 0x35, 0x0a, 0x13, 0x52, 0x65,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49,
+	0x44, 0x22, 0x49, 0x0a, 0x13, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x22, 0xd6, 0x01, 0x0a,
+	0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x32, 0xa1, 0x21, 0x0a, 0x03, 0x52, 0x50,
+	0x4d, 0x12, 0x41, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x12, 0x44, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
+	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x12, 0x3d, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x30, 0x01, 0x12,
+	0x35, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55,
+	0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x12, 0x11, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12,
+	0x35, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x13, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3b, 0x0a,
+	0x0b, 0x50, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x44, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12,
+	0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52,
+	0x65, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x12, 0x41, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x12, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0e,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a,
+	0x41, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e,
+	0x74, 0x44, 0x75, 0x65, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12,
+	0x50, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x12, 0x38, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61,
+	0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x61,
+	0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x0e, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x30,
+	0x01, 0x12, 0x3e, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65,
+	0x65, 0x73, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x30,
+	0x01, 0x12, 0x56, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
+	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x44,
+	0x69, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x65,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12,
+	0x4d, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x44,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0x5f, 0x0a, 0x17, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x12, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x1c, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x56, 0x0a,
+	0x14, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x6d, 0x70,
+	0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1e,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x18,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x55, 0x6e, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x30, 0x01,
+	0x12, 0x3a, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x12, 0x14,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f,
+	0x78, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1a, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x62,
+	0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1d, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x3e, 0x0a,
+	0x0c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x16, 0x2e,
+	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x0e, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x30,
+	0x01, 0x12, 0x41, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x72, 0x70,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e,
+	0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x1c,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x72,
+	0x70, 0x6d, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x52, 0x65,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1a,
+	0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x6d,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x72, 0x70, 0x6d, 0x70, 0x62,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x30, 0x01, 0x42, 0x23, 0x5a,
+	0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x6d, 0x70,
+	0x63, 0x6b, 0x65, 0x2f, 0x72, 0x70, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpm_proto_rawDescOnce sync.Once
+	file_rpm_proto_rawDescData = file_rpm_proto_rawDesc
+)
+
+func file_rpm_proto_rawDescGZIP() []byte {
+	file_rpm_proto_rawDescOnce.Do(func() {
+		file_rpm_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpm_proto_rawDescData)
+	})
+	return file_rpm_proto_rawDescData
+}
+
+var file_rpm_proto_msgTypes = make([]protoimpl.MessageInfo, 141)
+var file_rpm_proto_goTypes = []interface{}{
+	(*Property)(nil),                   // 0: rpmpb.Property
+	(*StorePropertyReq)(nil),           // 1: rpmpb.StorePropertyReq
+	(*StorePropertyRes)(nil),           // 2: rpmpb.StorePropertyRes
+	(*GetPropertyReq)(nil),             // 3: rpmpb.GetPropertyReq
+	(*GetPropertyRes)(nil),             // 4: rpmpb.GetPropertyRes
+	(*RemovePropertyReq)(nil),          // 5: rpmpb.RemovePropertyReq
+	(*RemovePropertyRes)(nil),          // 6: rpmpb.RemovePropertyRes
+	(*RestorePropertyReq)(nil),         // 7: rpmpb.RestorePropertyReq
+	(*RestorePropertyRes)(nil),         // 8: rpmpb.RestorePropertyRes
+	(*ListPropertiesReq)(nil),          // 9: rpmpb.ListPropertiesReq
+	(*Page)(nil),                       // 10: rpmpb.Page
+	(*Unit)(nil),                       // 11: rpmpb.Unit
+	(*StoreUnitReq)(nil),               // 12: rpmpb.StoreUnitReq
+	(*StoreUnitRes)(nil),               // 13: rpmpb.StoreUnitRes
+	(*GetUnitReq)(nil),                 // 14: rpmpb.GetUnitReq
+	(*GetUnitRes)(nil),                 // 15: rpmpb.GetUnitRes
+	(*ListUnitsReq)(nil),               // 16: rpmpb.ListUnitsReq
+	(*RemoveUnitReq)(nil),              // 17: rpmpb.RemoveUnitReq
+	(*RemoveUnitRes)(nil),              // 18: rpmpb.RemoveUnitRes
+	(*Tenant)(nil),                     // 19: rpmpb.Tenant
+	(*Phone)(nil),                      // 20: rpmpb.Phone
+	(*StoreTenantReq)(nil),             // 21: rpmpb.StoreTenantReq
+	(*StoreTenantRes)(nil),             // 22: rpmpb.StoreTenantRes
+	(*GetTenantReq)(nil),               // 23: rpmpb.GetTenantReq
+	(*GetTenantRes)(nil),               // 24: rpmpb.GetTenantRes
+	(*ListTenantsReq)(nil),             // 25: rpmpb.ListTenantsReq
+	(*PatchTenantReq)(nil),             // 26: rpmpb.PatchTenantReq
+	(*PatchTenantRes)(nil),             // 27: rpmpb.PatchTenantRes
+	(*RemoveTenantReq)(nil),            // 28: rpmpb.RemoveTenantReq
+	(*RemoveTenantRes)(nil),            // 29: rpmpb.RemoveTenantRes
+	(*RestoreTenantReq)(nil),           // 30: rpmpb.RestoreTenantReq
+	(*RestoreTenantRes)(nil),           // 31: rpmpb.RestoreTenantRes
+	(*AddTenantPhoneReq)(nil),          // 32: rpmpb.AddTenantPhoneReq
+	(*AddTenantPhoneRes)(nil),          // 33: rpmpb.AddTenantPhoneRes
+	(*UpdateTenantPhoneReq)(nil),       // 34: rpmpb.UpdateTenantPhoneReq
+	(*UpdateTenantPhoneRes)(nil),       // 35: rpmpb.UpdateTenantPhoneRes
+	(*RemoveTenantPhoneReq)(nil),       // 36: rpmpb.RemoveTenantPhoneReq
+	(*RemoveTenantPhoneRes)(nil),       // 37: rpmpb.RemoveTenantPhoneRes
+	(*Money)(nil),                      // 38: rpmpb.Money
+	(*Lease)(nil),                      // 39: rpmpb.Lease
+	(*LeasePropertyReq)(nil),           // 40: rpmpb.LeasePropertyReq
+	(*LeasePropertyRes)(nil),           // 41: rpmpb.LeasePropertyRes
+	(*GetLeaseReq)(nil),                // 42: rpmpb.GetLeaseReq
+	(*GetLeaseRes)(nil),                // 43: rpmpb.GetLeaseRes
+	(*LeaseVersion)(nil),               // 44: rpmpb.LeaseVersion
+	(*ListLeasesReq)(nil),              // 45: rpmpb.ListLeasesReq
+	(*TerminateLeaseReq)(nil),          // 46: rpmpb.TerminateLeaseReq
+	(*TerminateLeaseRes)(nil),          // 47: rpmpb.TerminateLeaseRes
+	(*RenewLeaseReq)(nil),              // 48: rpmpb.RenewLeaseReq
+	(*RenewLeaseRes)(nil),              // 49: rpmpb.RenewLeaseRes
+	(*AmendLeaseReq)(nil),              // 50: rpmpb.AmendLeaseReq
+	(*AmendLeaseRes)(nil),              // 51: rpmpb.AmendLeaseRes
+	(*RentDue)(nil),                    // 52: rpmpb.RentDue
+	(*GetRentScheduleReq)(nil),         // 53: rpmpb.GetRentScheduleReq
+	(*LedgerEntry)(nil),                // 54: rpmpb.LedgerEntry
+	(*PostLedgerEntryReq)(nil),         // 55: rpmpb.PostLedgerEntryReq
+	(*PostLedgerEntryRes)(nil),         // 56: rpmpb.PostLedgerEntryRes
+	(*ReverseLedgerEntryReq)(nil),      // 57: rpmpb.ReverseLedgerEntryReq
+	(*ReverseLedgerEntryRes)(nil),      // 58: rpmpb.ReverseLedgerEntryRes
+	(*GetBalanceReq)(nil),              // 59: rpmpb.GetBalanceReq
+	(*GetBalanceRes)(nil),              // 60: rpmpb.GetBalanceRes
+	(*GetStatementReq)(nil),            // 61: rpmpb.GetStatementReq
+	(*StatementLine)(nil),              // 62: rpmpb.StatementLine
+	(*Statement)(nil),                  // 63: rpmpb.Statement
+	(*LateFeePolicy)(nil),              // 64: rpmpb.LateFeePolicy
+	(*StoreLateFeePolicyReq)(nil),      // 65: rpmpb.StoreLateFeePolicyReq
+	(*StoreLateFeePolicyRes)(nil),      // 66: rpmpb.StoreLateFeePolicyRes
+	(*GetLateFeePolicyReq)(nil),        // 67: rpmpb.GetLateFeePolicyReq
+	(*GetLateFeePolicyRes)(nil),        // 68: rpmpb.GetLateFeePolicyRes
+	(*LateFee)(nil),                    // 69: rpmpb.LateFee
+	(*AssessLateFeesReq)(nil),          // 70: rpmpb.AssessLateFeesReq
+	(*ApplyLateFeesReq)(nil),           // 71: rpmpb.ApplyLateFeesReq
+	(*DepositReceipt)(nil),             // 72: rpmpb.DepositReceipt
+	(*RecordDepositReceiptReq)(nil),    // 73: rpmpb.RecordDepositReceiptReq
+	(*RecordDepositReceiptRes)(nil),    // 74: rpmpb.RecordDepositReceiptRes
+	(*Deduction)(nil),                  // 75: rpmpb.Deduction
+	(*DepositDisposition)(nil),         // 76: rpmpb.DepositDisposition
+	(*DisposeDepositReq)(nil),          // 77: rpmpb.DisposeDepositReq
+	(*DisposeDepositRes)(nil),          // 78: rpmpb.DisposeDepositRes
+	(*GetDepositReq)(nil),              // 79: rpmpb.GetDepositReq
+	(*DepositAccount)(nil),             // 80: rpmpb.DepositAccount
+	(*GetDepositStatementReq)(nil),     // 81: rpmpb.GetDepositStatementReq
+	(*GetDepositStatementRes)(nil),     // 82: rpmpb.GetDepositStatementRes
+	(*Applicant)(nil),                  // 83: rpmpb.Applicant
+	(*ApplicationNote)(nil),            // 84: rpmpb.ApplicationNote
+	(*Application)(nil),                // 85: rpmpb.Application
+	(*SubmitApplicationReq)(nil),       // 86: rpmpb.SubmitApplicationReq
+	(*SubmitApplicationRes)(nil),       // 87: rpmpb.SubmitApplicationRes
+	(*GetApplicationReq)(nil),          // 88: rpmpb.GetApplicationReq
+	(*GetApplicationRes)(nil),          // 89: rpmpb.GetApplicationRes
+	(*ListApplicationsReq)(nil),        // 90: rpmpb.ListApplicationsReq
+	(*UpdateApplicationStatusReq)(nil), // 91: rpmpb.UpdateApplicationStatusReq
+	(*UpdateApplicationStatusRes)(nil), // 92: rpmpb.UpdateApplicationStatusRes
+	(*ConvertApplicationReq)(nil),      // 93: rpmpb.ConvertApplicationReq
+	(*ConvertApplicationRes)(nil),      // 94: rpmpb.ConvertApplicationRes
+	(*ScreeningRule)(nil),              // 95: rpmpb.ScreeningRule
+	(*ScreeningPolicy)(nil),            // 96: rpmpb.ScreeningPolicy
+	(*StoreScreeningPolicyReq)(nil),    // 97: rpmpb.StoreScreeningPolicyReq
+	(*StoreScreeningPolicyRes)(nil),    // 98: rpmpb.StoreScreeningPolicyRes
+	(*GetScreeningPolicyReq)(nil),      // 99: rpmpb.GetScreeningPolicyReq
+	(*GetScreeningPolicyRes)(nil),      // 100: rpmpb.GetScreeningPolicyRes
+	(*ScreeningFinding)(nil),           // 101: rpmpb.ScreeningFinding
+	(*ScreeningReport)(nil),            // 102: rpmpb.ScreeningReport
+	(*ScreenApplicationReq)(nil),       // 103: rpmpb.ScreenApplicationReq
+	(*ScreenApplicationRes)(nil),       // 104: rpmpb.ScreenApplicationRes
+	(*ListScreeningReportsReq)(nil),    // 105: rpmpb.ListScreeningReportsReq
+	(*RentalDetails)(nil),              // 106: rpmpb.RentalDetails
+	(*ListingPhoto)(nil),               // 107: rpmpb.ListingPhoto
+	(*Listing)(nil),                    // 108: rpmpb.Listing
+	(*StoreListingReq)(nil),            // 109: rpmpb.StoreListingReq
+	(*StoreListingRes)(nil),            // 110: rpmpb.StoreListingRes
+	(*GetListingReq)(nil),              // 111: rpmpb.GetListingReq
+	(*GetListingRes)(nil),              // 112: rpmpb.GetListingRes
+	(*PublishListingReq)(nil),          // 113: rpmpb.PublishListingReq
+	(*PublishListingRes)(nil),          // 114: rpmpb.PublishListingRes
+	(*UnpublishListingReq)(nil),        // 115: rpmpb.UnpublishListingReq
+	(*UnpublishListingRes)(nil),        // 116: rpmpb.UnpublishListingRes
+	(*PublicListing)(nil),              // 117: rpmpb.PublicListing
+	(*ListPublicListingsReq)(nil),      // 118: rpmpb.ListPublicListingsReq
+	(*OutboxMessage)(nil),              // 119: rpmpb.OutboxMessage
+	(*ListOutboxReq)(nil),              // 120: rpmpb.ListOutboxReq
+	(*GetOutboxMessageReq)(nil),        // 121: rpmpb.GetOutboxMessageReq
+	(*GetOutboxMessageRes)(nil),        // 122: rpmpb.GetOutboxMessageRes
+	(*ReplayOutboxMessageReq)(nil),     // 123: rpmpb.ReplayOutboxMessageReq
+	(*ReplayOutboxMessageRes)(nil),     // 124: rpmpb.ReplayOutboxMessageRes
+	(*Webhook)(nil),                    // 125: rpmpb.Webhook
+	(*WebhookDelivery)(nil),            // 126: rpmpb.WebhookDelivery
+	(*StoreWebhookReq)(nil),            // 127: rpmpb.StoreWebhookReq
+	(*StoreWebhookRes)(nil),            // 128: rpmpb.StoreWebhookRes
+	(*GetWebhookReq)(nil),              // 129: rpmpb.GetWebhookReq
+	(*GetWebhookRes)(nil),              // 130: rpmpb.GetWebhookRes
+	(*ListWebhooksReq)(nil),            // 131: rpmpb.ListWebhooksReq
+	(*RemoveWebhookReq)(nil),           // 132: rpmpb.RemoveWebhookReq
+	(*RemoveWebhookRes)(nil),           // 133: rpmpb.RemoveWebhookRes
+	(*ListWebhookDeliveriesReq)(nil),   // 134: rpmpb.ListWebhookDeliveriesReq
+	(*GetWebhookDeliveryReq)(nil),      // 135: rpmpb.GetWebhookDeliveryReq
+	(*GetWebhookDeliveryRes)(nil),      // 136: rpmpb.GetWebhookDeliveryRes
+	(*RedeliverWebhookReq)(nil),        // 137: rpmpb.RedeliverWebhookReq
+	(*RedeliverWebhookRes)(nil),        // 138: rpmpb.RedeliverWebhookRes
+	(*AuditEntry)(nil),                 // 139: rpmpb.AuditEntry
+	(*ListAuditReq)(nil),               // 140: rpmpb.ListAuditReq
+}
+var file_rpm_proto_depIdxs = []int32{
+	0,   // 0: rpmpb.StorePropertyReq.property:type_name -> rpmpb.Property
+	0,   // 1: rpmpb.GetPropertyRes.property:type_name -> rpmpb.Property
+	0,   // 2: rpmpb.RestorePropertyRes.property:type_name -> rpmpb.Property
+	10,  // 3: rpmpb.ListPropertiesReq.page:type_name -> rpmpb.Page
+	38,  // 4: rpmpb.Unit.rentTarget:type_name -> rpmpb.Money
+	11,  // 5: rpmpb.StoreUnitReq.unit:type_name -> rpmpb.Unit
+	11,  // 6: rpmpb.StoreUnitRes.unit:type_name -> rpmpb.Unit
+	11,  // 7: rpmpb.GetUnitRes.unit:type_name -> rpmpb.Unit
+	20,  // 8: rpmpb.Tenant.phones:type_name -> rpmpb.Phone
+	19,  // 9: rpmpb.StoreTenantReq.tenant:type_name -> rpmpb.Tenant
+	19,  // 10: rpmpb.GetTenantRes.tenant:type_name -> rpmpb.Tenant
+	10,  // 11: rpmpb.ListTenantsReq.page:type_name -> rpmpb.Page
+	19,  // 12: rpmpb.PatchTenantReq.tenant:type_name -> rpmpb.Tenant
+	19,  // 13: rpmpb.PatchTenantRes.tenant:type_name -> rpmpb.Tenant
+	19,  // 14: rpmpb.RestoreTenantRes.tenant:type_name -> rpmpb.Tenant
+	20,  // 15: rpmpb.AddTenantPhoneReq.phone:type_name -> rpmpb.Phone
+	19,  // 16: rpmpb.AddTenantPhoneRes.tenant:type_name -> rpmpb.Tenant
+	20,  // 17: rpmpb.UpdateTenantPhoneReq.phone:type_name -> rpmpb.Phone
+	19,  // 18: rpmpb.UpdateTenantPhoneRes.tenant:type_name -> rpmpb.Tenant
+	38,  // 19: rpmpb.Lease.deposit:type_name -> rpmpb.Money
+	38,  // 20: rpmpb.Lease.rentAmount:type_name -> rpmpb.Money
+	39,  // 21: rpmpb.LeasePropertyReq.lease:type_name -> rpmpb.Lease
+	39,  // 22: rpmpb.LeasePropertyRes.lease:type_name -> rpmpb.Lease
+	39,  // 23: rpmpb.GetLeaseRes.lease:type_name -> rpmpb.Lease
+	44,  // 24: rpmpb.GetLeaseRes.history:type_name -> rpmpb.LeaseVersion
+	39,  // 25: rpmpb.LeaseVersion.lease:type_name -> rpmpb.Lease
+	10,  // 26: rpmpb.ListLeasesReq.page:type_name -> rpmpb.Page
+	39,  // 27: rpmpb.TerminateLeaseRes.lease:type_name -> rpmpb.Lease
+	38,  // 28: rpmpb.RenewLeaseReq.rentAmount:type_name -> rpmpb.Money
+	39,  // 29: rpmpb.RenewLeaseRes.lease:type_name -> rpmpb.Lease
+	38,  // 30: rpmpb.AmendLeaseReq.rentAmount:type_name -> rpmpb.Money
+	39,  // 31: rpmpb.AmendLeaseRes.lease:type_name -> rpmpb.Lease
+	38,  // 32: rpmpb.RentDue.amount:type_name -> rpmpb.Money
+	38,  // 33: rpmpb.LedgerEntry.amount:type_name -> rpmpb.Money
+	54,  // 34: rpmpb.PostLedgerEntryReq.entry:type_name -> rpmpb.LedgerEntry
+	54,  // 35: rpmpb.PostLedgerEntryRes.entry:type_name -> rpmpb.LedgerEntry
+	54,  // 36: rpmpb.ReverseLedgerEntryRes.entry:type_name -> rpmpb.LedgerEntry
+	38,  // 37: rpmpb.GetBalanceRes.balance:type_name -> rpmpb.Money
+	54,  // 38: rpmpb.StatementLine.entry:type_name -> rpmpb.LedgerEntry
+	38,  // 39: rpmpb.StatementLine.balance:type_name -> rpmpb.Money
+	62,  // 40: rpmpb.Statement.lines:type_name -> rpmpb.StatementLine
+	38,  // 41: rpmpb.Statement.openingBalance:type_name -> rpmpb.Money
+	38,  // 42: rpmpb.Statement.closingBalance:type_name -> rpmpb.Money
+	38,  // 43: rpmpb.LateFeePolicy.flatFee:type_name -> rpmpb.Money
+	38,  // 44: rpmpb.LateFeePolicy.dailyFee:type_name -> rpmpb.Money
+	38,  // 45: rpmpb.LateFeePolicy.maxFee:type_name -> rpmpb.Money
+	64,  // 46: rpmpb.StoreLateFeePolicyReq.policy:type_name -> rpmpb.LateFeePolicy
+	64,  // 47: rpmpb.StoreLateFeePolicyRes.policy:type_name -> rpmpb.LateFeePolicy
+	64,  // 48: rpmpb.GetLateFeePolicyRes.policy:type_name -> rpmpb.LateFeePolicy
+	38,  // 49: rpmpb.LateFee.unpaid:type_name -> rpmpb.Money
+	38,  // 50: rpmpb.LateFee.amount:type_name -> rpmpb.Money
+	38,  // 51: rpmpb.DepositReceipt.amount:type_name -> rpmpb.Money
+	72,  // 52: rpmpb.RecordDepositReceiptReq.receipt:type_name -> rpmpb.DepositReceipt
+	72,  // 53: rpmpb.RecordDepositReceiptRes.receipt:type_name -> rpmpb.DepositReceipt
+	38,  // 54: rpmpb.Deduction.amount:type_name -> rpmpb.Money
+	75,  // 55: rpmpb.DepositDisposition.deductions:type_name -> rpmpb.Deduction
+	38,  // 56: rpmpb.DepositDisposition.held:type_name -> rpmpb.Money
+	38,  // 57: rpmpb.DepositDisposition.refund:type_name -> rpmpb.Money
+	38,  // 58: rpmpb.DepositDisposition.owed:type_name -> rpmpb.Money
+	76,  // 59: rpmpb.DisposeDepositReq.disposition:type_name -> rpmpb.DepositDisposition
+	76,  // 60: rpmpb.DisposeDepositRes.disposition:type_name -> rpmpb.DepositDisposition
+	38,  // 61: rpmpb.DepositAccount.required:type_name -> rpmpb.Money
+	38,  // 62: rpmpb.DepositAccount.held:type_name -> rpmpb.Money
+	72,  // 63: rpmpb.DepositAccount.receipts:type_name -> rpmpb.DepositReceipt
+	76,  // 64: rpmpb.DepositAccount.disposition:type_name -> rpmpb.DepositDisposition
+	38,  // 65: rpmpb.Applicant.monthlyIncome:type_name -> rpmpb.Money
+	83,  // 66: rpmpb.Application.applicants:type_name -> rpmpb.Applicant
+	84,  // 67: rpmpb.Application.notes:type_name -> rpmpb.ApplicationNote
+	85,  // 68: rpmpb.SubmitApplicationReq.application:type_name -> rpmpb.Application
+	85,  // 69: rpmpb.SubmitApplicationRes.application:type_name -> rpmpb.Application
+	85,  // 70: rpmpb.GetApplicationRes.application:type_name -> rpmpb.Application
+	10,  // 71: rpmpb.ListApplicationsReq.page:type_name -> rpmpb.Page
+	85,  // 72: rpmpb.UpdateApplicationStatusRes.application:type_name -> rpmpb.Application
+	85,  // 73: rpmpb.ConvertApplicationRes.application:type_name -> rpmpb.Application
+	19,  // 74: rpmpb.ConvertApplicationRes.tenants:type_name -> rpmpb.Tenant
+	39,  // 75: rpmpb.ConvertApplicationRes.lease:type_name -> rpmpb.Lease
+	38,  // 76: rpmpb.ScreeningPolicy.rent:type_name -> rpmpb.Money
+	95,  // 77: rpmpb.ScreeningPolicy.rules:type_name -> rpmpb.ScreeningRule
+	96,  // 78: rpmpb.StoreScreeningPolicyReq.policy:type_name -> rpmpb.ScreeningPolicy
+	96,  // 79: rpmpb.StoreScreeningPolicyRes.policy:type_name -> rpmpb.ScreeningPolicy
+	96,  // 80: rpmpb.GetScreeningPolicyRes.policy:type_name -> rpmpb.ScreeningPolicy
+	95,  // 81: rpmpb.ScreeningFinding.rule:type_name -> rpmpb.ScreeningRule
+	38,  // 82: rpmpb.ScreeningReport.rent:type_name -> rpmpb.Money
+	95,  // 83: rpmpb.ScreeningReport.rules:type_name -> rpmpb.ScreeningRule
+	101, // 84: rpmpb.ScreeningReport.findings:type_name -> rpmpb.ScreeningFinding
+	102, // 85: rpmpb.ScreenApplicationRes.report:type_name -> rpmpb.ScreeningReport
+	10,  // 86: rpmpb.ListScreeningReportsReq.page:type_name -> rpmpb.Page
+	106, // 87: rpmpb.Listing.details:type_name -> rpmpb.RentalDetails
+	38,  // 88: rpmpb.Listing.rent:type_name -> rpmpb.Money
+	107, // 89: rpmpb.Listing.photos:type_name -> rpmpb.ListingPhoto
+	108, // 90: rpmpb.StoreListingReq.listing:type_name -> rpmpb.Listing
+	108, // 91: rpmpb.StoreListingRes.listing:type_name -> rpmpb.Listing
+	108, // 92: rpmpb.GetListingRes.listing:type_name -> rpmpb.Listing
+	108, // 93: rpmpb.PublishListingRes.listing:type_name -> rpmpb.Listing
+	108, // 94: rpmpb.UnpublishListingRes.listing:type_name -> rpmpb.Listing
+	108, // 95: rpmpb.PublicListing.listing:type_name -> rpmpb.Listing
+	0,   // 96: rpmpb.PublicListing.property:type_name -> rpmpb.Property
+	38,  // 97: rpmpb.ListPublicListingsReq.minRent:type_name -> rpmpb.Money
+	38,  // 98: rpmpb.ListPublicListingsReq.maxRent:type_name -> rpmpb.Money
+	10,  // 99: rpmpb.ListPublicListingsReq.page:type_name -> rpmpb.Page
+	10,  // 100: rpmpb.ListOutboxReq.page:type_name -> rpmpb.Page
+	119, // 101: rpmpb.GetOutboxMessageRes.message:type_name -> rpmpb.OutboxMessage
+	119, // 102: rpmpb.ReplayOutboxMessageRes.message:type_name -> rpmpb.OutboxMessage
+	125, // 103: rpmpb.StoreWebhookReq.webhook:type_name -> rpmpb.Webhook
+	125, // 104: rpmpb.StoreWebhookRes.webhook:type_name -> rpmpb.Webhook
+	125, // 105: rpmpb.GetWebhookRes.webhook:type_name -> rpmpb.Webhook
+	10,  // 106: rpmpb.ListWebhookDeliveriesReq.page:type_name -> rpmpb.Page
+	126, // 107: rpmpb.GetWebhookDeliveryRes.delivery:type_name -> rpmpb.WebhookDelivery
+	126, // 108: rpmpb.RedeliverWebhookRes.delivery:type_name -> rpmpb.WebhookDelivery
+	10,  // 109: rpmpb.ListAuditReq.page:type_name -> rpmpb.Page
+	1,   // 110: rpmpb.RPM.StoreProperty:input_type -> rpmpb.StorePropertyReq
+	3,   // 111: rpmpb.RPM.GetProperty:input_type -> rpmpb.GetPropertyReq
+	5,   // 112: rpmpb.RPM.RemoveProperty:input_type -> rpmpb.RemovePropertyReq
+	7,   // 113: rpmpb.RPM.RestoreProperty:input_type -> rpmpb.RestorePropertyReq
+	9,   // 114: rpmpb.RPM.ListProperties:input_type -> rpmpb.ListPropertiesReq
+	12,  // 115: rpmpb.RPM.StoreUnit:input_type -> rpmpb.StoreUnitReq
+	14,  // 116: rpmpb.RPM.GetUnit:input_type -> rpmpb.GetUnitReq
+	16,  // 117: rpmpb.RPM.ListUnits:input_type -> rpmpb.ListUnitsReq
+	17,  // 118: rpmpb.RPM.RemoveUnit:input_type -> rpmpb.RemoveUnitReq
+	21,  // 119: rpmpb.RPM.StoreTenant:input_type -> rpmpb.StoreTenantReq
+	23,  // 120: rpmpb.RPM.GetTenant:input_type -> rpmpb.GetTenantReq
+	25,  // 121: rpmpb.RPM.ListTenants:input_type -> rpmpb.ListTenantsReq
+	26,  // 122: rpmpb.RPM.PatchTenant:input_type -> rpmpb.PatchTenantReq
+	28,  // 123: rpmpb.RPM.RemoveTenant:input_type -> rpmpb.RemoveTenantReq
+	30,  // 124: rpmpb.RPM.RestoreTenant:input_type -> rpmpb.RestoreTenantReq
+	32,  // 125: rpmpb.RPM.AddTenantPhone:input_type -> rpmpb.AddTenantPhoneReq
+	34,  // 126: rpmpb.RPM.UpdateTenantPhone:input_type -> rpmpb.UpdateTenantPhoneReq
+	36,  // 127: rpmpb.RPM.RemoveTenantPhone:input_type -> rpmpb.RemoveTenantPhoneReq
+	40,  // 128: rpmpb.RPM.LeaseProperty:input_type -> rpmpb.LeasePropertyReq
+	42,  // 129: rpmpb.RPM.GetLease:input_type -> rpmpb.GetLeaseReq
+	45,  // 130: rpmpb.RPM.ListLeases:input_type -> rpmpb.ListLeasesReq
+	46,  // 131: rpmpb.RPM.TerminateLease:input_type -> rpmpb.TerminateLeaseReq
+	48,  // 132: rpmpb.RPM.RenewLease:input_type -> rpmpb.RenewLeaseReq
+	50,  // 133: rpmpb.RPM.AmendLease:input_type -> rpmpb.AmendLeaseReq
+	53,  // 134: rpmpb.RPM.GetRentSchedule:input_type -> rpmpb.GetRentScheduleReq
+	55,  // 135: rpmpb.RPM.PostLedgerEntry:input_type -> rpmpb.PostLedgerEntryReq
+	57,  // 136: rpmpb.RPM.ReverseLedgerEntry:input_type -> rpmpb.ReverseLedgerEntryReq
+	59,  // 137: rpmpb.RPM.GetBalance:input_type -> rpmpb.GetBalanceReq
+	61,  // 138: rpmpb.RPM.GetStatement:input_type -> rpmpb.GetStatementReq
+	65,  // 139: rpmpb.RPM.StoreLateFeePolicy:input_type -> rpmpb.StoreLateFeePolicyReq
+	67,  // 140: rpmpb.RPM.GetLateFeePolicy:input_type -> rpmpb.GetLateFeePolicyReq
+	70,  // 141: rpmpb.RPM.AssessLateFees:input_type -> rpmpb.AssessLateFeesReq
+	71,  // 142: rpmpb.RPM.ApplyLateFees:input_type -> rpmpb.ApplyLateFeesReq
+	73,  // 143: rpmpb.RPM.RecordDepositReceipt:input_type -> rpmpb.RecordDepositReceiptReq
+	79,  // 144: rpmpb.RPM.GetDeposit:input_type -> rpmpb.GetDepositReq
+	77,  // 145: rpmpb.RPM.DisposeDeposit:input_type -> rpmpb.DisposeDepositReq
+	81,  // 146: rpmpb.RPM.GetDepositStatement:input_type -> rpmpb.GetDepositStatementReq
+	86,  // 147: rpmpb.RPM.SubmitApplication:input_type -> rpmpb.SubmitApplicationReq
+	88,  // 148: rpmpb.RPM.GetApplication:input_type -> rpmpb.GetApplicationReq
+	90,  // 149: rpmpb.RPM.ListApplications:input_type -> rpmpb.ListApplicationsReq
+	91,  // 150: rpmpb.RPM.UpdateApplicationStatus:input_type -> rpmpb.UpdateApplicationStatusReq
+	93,  // 151: rpmpb.RPM.ConvertApplication:input_type -> rpmpb.ConvertApplicationReq
+	97,  // 152: rpmpb.RPM.StoreScreeningPolicy:input_type -> rpmpb.StoreScreeningPolicyReq
+	99,  // 153: rpmpb.RPM.GetScreeningPolicy:input_type -> rpmpb.GetScreeningPolicyReq
+	103, // 154: rpmpb.RPM.ScreenApplication:input_type -> rpmpb.ScreenApplicationReq
+	105, // 155: rpmpb.RPM.ListScreeningReports:input_type -> rpmpb.ListScreeningReportsReq
+	109, // 156: rpmpb.RPM.StoreListing:input_type -> rpmpb.StoreListingReq
+	111, // 157: rpmpb.RPM.GetListing:input_type -> rpmpb.GetListingReq
+	113, // 158: rpmpb.RPM.PublishListing:input_type -> rpmpb.PublishListingReq
+	115, // 159: rpmpb.RPM.UnpublishListing:input_type -> rpmpb.UnpublishListingReq
+	118, // 160: rpmpb.RPM.ListPublicListings:input_type -> rpmpb.ListPublicListingsReq
+	120, // 161: rpmpb.RPM.ListOutbox:input_type -> rpmpb.ListOutboxReq
+	121, // 162: rpmpb.RPM.GetOutboxMessage:input_type -> rpmpb.GetOutboxMessageReq
+	123, // 163: rpmpb.RPM.ReplayOutboxMessage:input_type -> rpmpb.ReplayOutboxMessageReq
+	127, // 164: rpmpb.RPM.StoreWebhook:input_type -> rpmpb.StoreWebhookReq
+	129, // 165: rpmpb.RPM.GetWebhook:input_type -> rpmpb.GetWebhookReq
+	131, // 166: rpmpb.RPM.ListWebhooks:input_type -> rpmpb.ListWebhooksReq
+	132, // 167: rpmpb.RPM.RemoveWebhook:input_type -> rpmpb.RemoveWebhookReq
+	134, // 168: rpmpb.RPM.ListWebhookDeliveries:input_type -> rpmpb.ListWebhookDeliveriesReq
+	135, // 169: rpmpb.RPM.GetWebhookDelivery:input_type -> rpmpb.GetWebhookDeliveryReq
+	137, // 170: rpmpb.RPM.RedeliverWebhook:input_type -> rpmpb.RedeliverWebhookReq
+	140, // 171: rpmpb.RPM.ListAudit:input_type -> rpmpb.ListAuditReq
+	2,   // 172: rpmpb.RPM.StoreProperty:output_type -> rpmpb.StorePropertyRes
+	4,   // 173: rpmpb.RPM.GetProperty:output_type -> rpmpb.GetPropertyRes
+	6,   // 174: rpmpb.RPM.RemoveProperty:output_type -> rpmpb.RemovePropertyRes
+	8,   // 175: rpmpb.RPM.RestoreProperty:output_type -> rpmpb.RestorePropertyRes
+	0,   // 176: rpmpb.RPM.ListProperties:output_type -> rpmpb.Property
+	13,  // 177: rpmpb.RPM.StoreUnit:output_type -> rpmpb.StoreUnitRes
+	15,  // 178: rpmpb.RPM.GetUnit:output_type -> rpmpb.GetUnitRes
+	11,  // 179: rpmpb.RPM.ListUnits:output_type -> rpmpb.Unit
+	18,  // 180: rpmpb.RPM.RemoveUnit:output_type -> rpmpb.RemoveUnitRes
+	22,  // 181: rpmpb.RPM.StoreTenant:output_type -> rpmpb.StoreTenantRes
+	24,  // 182: rpmpb.RPM.GetTenant:output_type -> rpmpb.GetTenantRes
+	19,  // 183: rpmpb.RPM.ListTenants:output_type -> rpmpb.Tenant
+	27,  // 184: rpmpb.RPM.PatchTenant:output_type -> rpmpb.PatchTenantRes
+	29,  // 185: rpmpb.RPM.RemoveTenant:output_type -> rpmpb.RemoveTenantRes
+	31,  // 186: rpmpb.RPM.RestoreTenant:output_type -> rpmpb.RestoreTenantRes
+	33,  // 187: rpmpb.RPM.AddTenantPhone:output_type -> rpmpb.AddTenantPhoneRes
+	35,  // 188: rpmpb.RPM.UpdateTenantPhone:output_type -> rpmpb.UpdateTenantPhoneRes
+	37,  // 189: rpmpb.RPM.RemoveTenantPhone:output_type -> rpmpb.RemoveTenantPhoneRes
+	41,  // 190: rpmpb.RPM.LeaseProperty:output_type -> rpmpb.LeasePropertyRes
+	43,  // 191: rpmpb.RPM.GetLease:output_type -> rpmpb.GetLeaseRes
+	39,  // 192: rpmpb.RPM.ListLeases:output_type -> rpmpb.Lease
+	47,  // 193: rpmpb.RPM.TerminateLease:output_type -> rpmpb.TerminateLeaseRes
+	49,  // 194: rpmpb.RPM.RenewLease:output_type -> rpmpb.RenewLeaseRes
+	51,  // 195: rpmpb.RPM.AmendLease:output_type -> rpmpb.AmendLeaseRes
+	52,  // 196: rpmpb.RPM.GetRentSchedule:output_type -> rpmpb.RentDue
+	56,  // 197: rpmpb.RPM.PostLedgerEntry:output_type -> rpmpb.PostLedgerEntryRes
+	58,  // 198: rpmpb.RPM.ReverseLedgerEntry:output_type -> rpmpb.ReverseLedgerEntryRes
+	60,  // 199: rpmpb.RPM.GetBalance:output_type -> rpmpb.GetBalanceRes
+	63,  // 200: rpmpb.RPM.GetStatement:output_type -> rpmpb.Statement
+	66,  // 201: rpmpb.RPM.StoreLateFeePolicy:output_type -> rpmpb.StoreLateFeePolicyRes
+	68,  // 202: rpmpb.RPM.GetLateFeePolicy:output_type -> rpmpb.GetLateFeePolicyRes
+	69,  // 203: rpmpb.RPM.AssessLateFees:output_type -> rpmpb.LateFee
+	54,  // 204: rpmpb.RPM.ApplyLateFees:output_type -> rpmpb.LedgerEntry
+	74,  // 205: rpmpb.RPM.RecordDepositReceipt:output_type -> rpmpb.RecordDepositReceiptRes
+	80,  // 206: rpmpb.RPM.GetDeposit:output_type -> rpmpb.DepositAccount
+	78,  // 207: rpmpb.RPM.DisposeDeposit:output_type -> rpmpb.DisposeDepositRes
+	82,  // 208: rpmpb.RPM.GetDepositStatement:output_type -> rpmpb.GetDepositStatementRes
+	87,  // 209: rpmpb.RPM.SubmitApplication:output_type -> rpmpb.SubmitApplicationRes
+	89,  // 210: rpmpb.RPM.GetApplication:output_type -> rpmpb.GetApplicationRes
+	85,  // 211: rpmpb.RPM.ListApplications:output_type -> rpmpb.Application
+	92,  // 212: rpmpb.RPM.UpdateApplicationStatus:output_type -> rpmpb.UpdateApplicationStatusRes
+	94,  // 213: rpmpb.RPM.ConvertApplication:output_type -> rpmpb.ConvertApplicationRes
+	98,  // 214: rpmpb.RPM.StoreScreeningPolicy:output_type -> rpmpb.StoreScreeningPolicyRes
+	100, // 215: rpmpb.RPM.GetScreeningPolicy:output_type -> rpmpb.GetScreeningPolicyRes
+	104, // 216: rpmpb.RPM.ScreenApplication:output_type -> rpmpb.ScreenApplicationRes
+	102, // 217: rpmpb.RPM.ListScreeningReports:output_type -> rpmpb.ScreeningReport
+	110, // 218: rpmpb.RPM.StoreListing:output_type -> rpmpb.StoreListingRes
+	112, // 219: rpmpb.RPM.GetListing:output_type -> rpmpb.GetListingRes
+	114, // 220: rpmpb.RPM.PublishListing:output_type -> rpmpb.PublishListingRes
+	116, // 221: rpmpb.RPM.UnpublishListing:output_type -> rpmpb.UnpublishListingRes
+	117, // 222: rpmpb.RPM.ListPublicListings:output_type -> rpmpb.PublicListing
+	119, // 223: rpmpb.RPM.ListOutbox:output_type -> rpmpb.OutboxMessage
+	122, // 224: rpmpb.RPM.GetOutboxMessage:output_type -> rpmpb.GetOutboxMessageRes
+	124, // 225: rpmpb.RPM.ReplayOutboxMessage:output_type -> rpmpb.ReplayOutboxMessageRes
+	128, // 226: rpmpb.RPM.StoreWebhook:output_type -> rpmpb.StoreWebhookRes
+	130, // 227: rpmpb.RPM.GetWebhook:output_type -> rpmpb.GetWebhookRes
+	125, // 228: rpmpb.RPM.ListWebhooks:output_type -> rpmpb.Webhook
+	133, // 229: rpmpb.RPM.RemoveWebhook:output_type -> rpmpb.RemoveWebhookRes
+	126, // 230: rpmpb.RPM.ListWebhookDeliveries:output_type -> rpmpb.WebhookDelivery
+	136, // 231: rpmpb.RPM.GetWebhookDelivery:output_type -> rpmpb.GetWebhookDeliveryRes
+	138, // 232: rpmpb.RPM.RedeliverWebhook:output_type -> rpmpb.RedeliverWebhookRes
+	139, // 233: rpmpb.RPM.ListAudit:output_type -> rpmpb.AuditEntry
+	172, // [172:234] is the sub-list for method output_type
+	110, // [110:172] is the sub-list for method input_type
+	110, // [110:110] is the sub-list for extension type_name
+	110, // [110:110] is the sub-list for extension extendee
+	0,   // [0:110] is the sub-list for field type_name
+}
+
+func init() { file_rpm_proto_init() }
+func file_rpm_proto_init() {
+	if File_rpm_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Property); i {
 			case 0:
 				return &v.state
@@ -956,8 +10002,1256 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorePropertyReq); i {
+		file_rpm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorePropertyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorePropertyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPropertyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPropertyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePropertyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePropertyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePropertyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePropertyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPropertiesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Page); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Unit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreUnitReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreUnitRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnitReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnitRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUnitsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveUnitReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveUnitRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tenant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Phone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreTenantReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreTenantRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchTenantReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchTenantRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTenantReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTenantRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreTenantReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreTenantRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTenantPhoneReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTenantPhoneRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTenantPhoneReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTenantPhoneRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTenantPhoneReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTenantPhoneRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeasePropertyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeasePropertyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaseReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaseRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLeasesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminateLeaseReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminateLeaseRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewLeaseReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewLeaseRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmendLeaseReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmendLeaseRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RentDue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRentScheduleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostLedgerEntryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostLedgerEntryRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseLedgerEntryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseLedgerEntryRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatementReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Statement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LateFeePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreLateFeePolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreLateFeePolicyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLateFeePolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLateFeePolicyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LateFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssessLateFeesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyLateFeesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordDepositReceiptReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordDepositReceiptRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deduction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositDisposition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisposeDepositReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisposeDepositRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDepositReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDepositStatementReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDepositStatementRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Applicant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplicationNote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Application); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitApplicationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitApplicationRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApplicationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateApplicationStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateApplicationStatusRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertApplicationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertApplicationRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreeningRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreeningPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreScreeningPolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreScreeningPolicyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScreeningPolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScreeningPolicyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreeningFinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreeningReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreenApplicationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreenApplicationRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScreeningReportsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -968,8 +11262,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorePropertyRes); i {
+		file_rpm_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RentalDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -980,8 +11274,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPropertyReq); i {
+		file_rpm_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingPhoto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -992,8 +11286,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPropertyRes); i {
+		file_rpm_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Listing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1004,8 +11298,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemovePropertyReq); i {
+		file_rpm_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreListingReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1016,8 +11310,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemovePropertyRes); i {
+		file_rpm_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreListingRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1028,8 +11322,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPropertiesReq); i {
+		file_rpm_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListingReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1040,8 +11334,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tenant); i {
+		file_rpm_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListingRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1052,8 +11346,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Phone); i {
+		file_rpm_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishListingReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1064,8 +11358,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreTenantReq); i {
+		file_rpm_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishListingRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1076,8 +11370,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreTenantRes); i {
+		file_rpm_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpublishListingReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1088,8 +11382,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTenantReq); i {
+		file_rpm_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpublishListingRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1100,8 +11394,8 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTenantRes); i {
+		file_rpm_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublicListing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1112,8 +11406,272 @@ func file_rpm_proto_init() {
 				return nil
 			}
 		}
-		file_rpm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTenantsReq); i {
+		file_rpm_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPublicListingsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutboxMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOutboxReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOutboxMessageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOutboxMessageRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayOutboxMessageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayOutboxMessageRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreWebhookReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreWebhookRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveWebhookReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveWebhookRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookDeliveryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookDeliveryRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeliverWebhookReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeliverWebhookRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpm_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1131,7 +11689,7 @@ func file_rpm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpm_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   141,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,10 +25,65 @@ type RPMClient interface {
 	StoreProperty(ctx context.Context, in *StorePropertyReq, opts ...grpc.CallOption) (*StorePropertyRes, error)
 	GetProperty(ctx context.Context, in *GetPropertyReq, opts ...grpc.CallOption) (*GetPropertyRes, error)
 	RemoveProperty(ctx context.Context, in *RemovePropertyReq, opts ...grpc.CallOption) (*RemovePropertyRes, error)
+	RestoreProperty(ctx context.Context, in *RestorePropertyReq, opts ...grpc.CallOption) (*RestorePropertyRes, error)
 	ListProperties(ctx context.Context, in *ListPropertiesReq, opts ...grpc.CallOption) (RPM_ListPropertiesClient, error)
+	StoreUnit(ctx context.Context, in *StoreUnitReq, opts ...grpc.CallOption) (*StoreUnitRes, error)
+	GetUnit(ctx context.Context, in *GetUnitReq, opts ...grpc.CallOption) (*GetUnitRes, error)
+	ListUnits(ctx context.Context, in *ListUnitsReq, opts ...grpc.CallOption) (RPM_ListUnitsClient, error)
+	RemoveUnit(ctx context.Context, in *RemoveUnitReq, opts ...grpc.CallOption) (*RemoveUnitRes, error)
 	StoreTenant(ctx context.Context, in *StoreTenantReq, opts ...grpc.CallOption) (*StoreTenantRes, error)
 	GetTenant(ctx context.Context, in *GetTenantReq, opts ...grpc.CallOption) (*GetTenantRes, error)
 	ListTenants(ctx context.Context, in *ListTenantsReq, opts ...grpc.CallOption) (RPM_ListTenantsClient, error)
+	PatchTenant(ctx context.Context, in *PatchTenantReq, opts ...grpc.CallOption) (*PatchTenantRes, error)
+	RemoveTenant(ctx context.Context, in *RemoveTenantReq, opts ...grpc.CallOption) (*RemoveTenantRes, error)
+	RestoreTenant(ctx context.Context, in *RestoreTenantReq, opts ...grpc.CallOption) (*RestoreTenantRes, error)
+	AddTenantPhone(ctx context.Context, in *AddTenantPhoneReq, opts ...grpc.CallOption) (*AddTenantPhoneRes, error)
+	UpdateTenantPhone(ctx context.Context, in *UpdateTenantPhoneReq, opts ...grpc.CallOption) (*UpdateTenantPhoneRes, error)
+	RemoveTenantPhone(ctx context.Context, in *RemoveTenantPhoneReq, opts ...grpc.CallOption) (*RemoveTenantPhoneRes, error)
+	LeaseProperty(ctx context.Context, in *LeasePropertyReq, opts ...grpc.CallOption) (*LeasePropertyRes, error)
+	GetLease(ctx context.Context, in *GetLeaseReq, opts ...grpc.CallOption) (*GetLeaseRes, error)
+	ListLeases(ctx context.Context, in *ListLeasesReq, opts ...grpc.CallOption) (RPM_ListLeasesClient, error)
+	TerminateLease(ctx context.Context, in *TerminateLeaseReq, opts ...grpc.CallOption) (*TerminateLeaseRes, error)
+	RenewLease(ctx context.Context, in *RenewLeaseReq, opts ...grpc.CallOption) (*RenewLeaseRes, error)
+	AmendLease(ctx context.Context, in *AmendLeaseReq, opts ...grpc.CallOption) (*AmendLeaseRes, error)
+	GetRentSchedule(ctx context.Context, in *GetRentScheduleReq, opts ...grpc.CallOption) (RPM_GetRentScheduleClient, error)
+	PostLedgerEntry(ctx context.Context, in *PostLedgerEntryReq, opts ...grpc.CallOption) (*PostLedgerEntryRes, error)
+	ReverseLedgerEntry(ctx context.Context, in *ReverseLedgerEntryReq, opts ...grpc.CallOption) (*ReverseLedgerEntryRes, error)
+	GetBalance(ctx context.Context, in *GetBalanceReq, opts ...grpc.CallOption) (*GetBalanceRes, error)
+	GetStatement(ctx context.Context, in *GetStatementReq, opts ...grpc.CallOption) (*Statement, error)
+	StoreLateFeePolicy(ctx context.Context, in *StoreLateFeePolicyReq, opts ...grpc.CallOption) (*StoreLateFeePolicyRes, error)
+	GetLateFeePolicy(ctx context.Context, in *GetLateFeePolicyReq, opts ...grpc.CallOption) (*GetLateFeePolicyRes, error)
+	AssessLateFees(ctx context.Context, in *AssessLateFeesReq, opts ...grpc.CallOption) (RPM_AssessLateFeesClient, error)
+	ApplyLateFees(ctx context.Context, in *ApplyLateFeesReq, opts ...grpc.CallOption) (RPM_ApplyLateFeesClient, error)
+	RecordDepositReceipt(ctx context.Context, in *RecordDepositReceiptReq, opts ...grpc.CallOption) (*RecordDepositReceiptRes, error)
+	GetDeposit(ctx context.Context, in *GetDepositReq, opts ...grpc.CallOption) (*DepositAccount, error)
+	DisposeDeposit(ctx context.Context, in *DisposeDepositReq, opts ...grpc.CallOption) (*DisposeDepositRes, error)
+	GetDepositStatement(ctx context.Context, in *GetDepositStatementReq, opts ...grpc.CallOption) (*GetDepositStatementRes, error)
+	SubmitApplication(ctx context.Context, in *SubmitApplicationReq, opts ...grpc.CallOption) (*SubmitApplicationRes, error)
+	GetApplication(ctx context.Context, in *GetApplicationReq, opts ...grpc.CallOption) (*GetApplicationRes, error)
+	ListApplications(ctx context.Context, in *ListApplicationsReq, opts ...grpc.CallOption) (RPM_ListApplicationsClient, error)
+	UpdateApplicationStatus(ctx context.Context, in *UpdateApplicationStatusReq, opts ...grpc.CallOption) (*UpdateApplicationStatusRes, error)
+	ConvertApplication(ctx context.Context, in *ConvertApplicationReq, opts ...grpc.CallOption) (*ConvertApplicationRes, error)
+	StoreScreeningPolicy(ctx context.Context, in *StoreScreeningPolicyReq, opts ...grpc.CallOption) (*StoreScreeningPolicyRes, error)
+	GetScreeningPolicy(ctx context.Context, in *GetScreeningPolicyReq, opts ...grpc.CallOption) (*GetScreeningPolicyRes, error)
+	ScreenApplication(ctx context.Context, in *ScreenApplicationReq, opts ...grpc.CallOption) (*ScreenApplicationRes, error)
+	ListScreeningReports(ctx context.Context, in *ListScreeningReportsReq, opts ...grpc.CallOption) (RPM_ListScreeningReportsClient, error)
+	StoreListing(ctx context.Context, in *StoreListingReq, opts ...grpc.CallOption) (*StoreListingRes, error)
+	GetListing(ctx context.Context, in *GetListingReq, opts ...grpc.CallOption) (*GetListingRes, error)
+	PublishListing(ctx context.Context, in *PublishListingReq, opts ...grpc.CallOption) (*PublishListingRes, error)
+	UnpublishListing(ctx context.Context, in *UnpublishListingReq, opts ...grpc.CallOption) (*UnpublishListingRes, error)
+	ListPublicListings(ctx context.Context, in *ListPublicListingsReq, opts ...grpc.CallOption) (RPM_ListPublicListingsClient, error)
+	ListOutbox(ctx context.Context, in *ListOutboxReq, opts ...grpc.CallOption) (RPM_ListOutboxClient, error)
+	GetOutboxMessage(ctx context.Context, in *GetOutboxMessageReq, opts ...grpc.CallOption) (*GetOutboxMessageRes, error)
+	ReplayOutboxMessage(ctx context.Context, in *ReplayOutboxMessageReq, opts ...grpc.CallOption) (*ReplayOutboxMessageRes, error)
+	StoreWebhook(ctx context.Context, in *StoreWebhookReq, opts ...grpc.CallOption) (*StoreWebhookRes, error)
+	GetWebhook(ctx context.Context, in *GetWebhookReq, opts ...grpc.CallOption) (*GetWebhookRes, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksReq, opts ...grpc.CallOption) (RPM_ListWebhooksClient, error)
+	RemoveWebhook(ctx context.Context, in *RemoveWebhookReq, opts ...grpc.CallOption) (*RemoveWebhookRes, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (RPM_ListWebhookDeliveriesClient, error)
+	GetWebhookDelivery(ctx context.Context, in *GetWebhookDeliveryReq, opts ...grpc.CallOption) (*GetWebhookDeliveryRes, error)
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookReq, opts ...grpc.CallOption) (*RedeliverWebhookRes, error)
+	ListAudit(ctx context.Context, in *ListAuditReq, opts ...grpc.CallOption) (RPM_ListAuditClient, error)
 }
 
 type rPMClient struct {
@@ -66,6 +121,15 @@ func (c *rPMClient) RemoveProperty(ctx context.Context, in *RemovePropertyReq, o
 	return out, nil
 }
 
+func (c *rPMClient) RestoreProperty(ctx context.Context, in *RestorePropertyReq, opts ...grpc.CallOption) (*RestorePropertyRes, error) {
+	out := new(RestorePropertyRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/RestoreProperty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rPMClient) ListProperties(ctx context.Context, in *ListPropertiesReq, opts ...grpc.CallOption) (RPM_ListPropertiesClient, error) {
 	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[0], "/rpmpb.RPM/ListProperties", opts...)
 	if err != nil {
@@ -98,6 +162,65 @@ func (x *rPMListPropertiesClient) Recv() (*Property, error) {
 	return m, nil
 }
 
+func (c *rPMClient) StoreUnit(ctx context.Context, in *StoreUnitReq, opts ...grpc.CallOption) (*StoreUnitRes, error) {
+	out := new(StoreUnitRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/StoreUnit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetUnit(ctx context.Context, in *GetUnitReq, opts ...grpc.CallOption) (*GetUnitRes, error) {
+	out := new(GetUnitRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetUnit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ListUnits(ctx context.Context, in *ListUnitsReq, opts ...grpc.CallOption) (RPM_ListUnitsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[1], "/rpmpb.RPM/ListUnits", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMListUnitsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_ListUnitsClient interface {
+	Recv() (*Unit, error)
+	grpc.ClientStream
+}
+
+type rPMListUnitsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMListUnitsClient) Recv() (*Unit, error) {
+	m := new(Unit)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) RemoveUnit(ctx context.Context, in *RemoveUnitReq, opts ...grpc.CallOption) (*RemoveUnitRes, error) {
+	out := new(RemoveUnitRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/RemoveUnit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rPMClient) StoreTenant(ctx context.Context, in *StoreTenantReq, opts ...grpc.CallOption) (*StoreTenantRes, error) {
 	out := new(StoreTenantRes)
 	err := c.cc.Invoke(ctx, "/rpmpb.RPM/StoreTenant", in, out, opts...)
@@ -117,7 +240,7 @@ func (c *rPMClient) GetTenant(ctx context.Context, in *GetTenantReq, opts ...grp
 }
 
 func (c *rPMClient) ListTenants(ctx context.Context, in *ListTenantsReq, opts ...grpc.CallOption) (RPM_ListTenantsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[1], "/rpmpb.RPM/ListTenants", opts...)
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[2], "/rpmpb.RPM/ListTenants", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -140,195 +263,2144 @@ type rPMListTenantsClient struct {
 	grpc.ClientStream
 }
 
-func (x *rPMListTenantsClient) Recv() (*Tenant, error) {
-	m := new(Tenant)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (x *rPMListTenantsClient) Recv() (*Tenant, error) {
+	m := new(Tenant)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) PatchTenant(ctx context.Context, in *PatchTenantReq, opts ...grpc.CallOption) (*PatchTenantRes, error) {
+	out := new(PatchTenantRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/PatchTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) RemoveTenant(ctx context.Context, in *RemoveTenantReq, opts ...grpc.CallOption) (*RemoveTenantRes, error) {
+	out := new(RemoveTenantRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/RemoveTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) RestoreTenant(ctx context.Context, in *RestoreTenantReq, opts ...grpc.CallOption) (*RestoreTenantRes, error) {
+	out := new(RestoreTenantRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/RestoreTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) AddTenantPhone(ctx context.Context, in *AddTenantPhoneReq, opts ...grpc.CallOption) (*AddTenantPhoneRes, error) {
+	out := new(AddTenantPhoneRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/AddTenantPhone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) UpdateTenantPhone(ctx context.Context, in *UpdateTenantPhoneReq, opts ...grpc.CallOption) (*UpdateTenantPhoneRes, error) {
+	out := new(UpdateTenantPhoneRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/UpdateTenantPhone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) RemoveTenantPhone(ctx context.Context, in *RemoveTenantPhoneReq, opts ...grpc.CallOption) (*RemoveTenantPhoneRes, error) {
+	out := new(RemoveTenantPhoneRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/RemoveTenantPhone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) LeaseProperty(ctx context.Context, in *LeasePropertyReq, opts ...grpc.CallOption) (*LeasePropertyRes, error) {
+	out := new(LeasePropertyRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/LeaseProperty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetLease(ctx context.Context, in *GetLeaseReq, opts ...grpc.CallOption) (*GetLeaseRes, error) {
+	out := new(GetLeaseRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ListLeases(ctx context.Context, in *ListLeasesReq, opts ...grpc.CallOption) (RPM_ListLeasesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[3], "/rpmpb.RPM/ListLeases", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMListLeasesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_ListLeasesClient interface {
+	Recv() (*Lease, error)
+	grpc.ClientStream
+}
+
+type rPMListLeasesClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMListLeasesClient) Recv() (*Lease, error) {
+	m := new(Lease)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) TerminateLease(ctx context.Context, in *TerminateLeaseReq, opts ...grpc.CallOption) (*TerminateLeaseRes, error) {
+	out := new(TerminateLeaseRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/TerminateLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) RenewLease(ctx context.Context, in *RenewLeaseReq, opts ...grpc.CallOption) (*RenewLeaseRes, error) {
+	out := new(RenewLeaseRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/RenewLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) AmendLease(ctx context.Context, in *AmendLeaseReq, opts ...grpc.CallOption) (*AmendLeaseRes, error) {
+	out := new(AmendLeaseRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/AmendLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetRentSchedule(ctx context.Context, in *GetRentScheduleReq, opts ...grpc.CallOption) (RPM_GetRentScheduleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[4], "/rpmpb.RPM/GetRentSchedule", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMGetRentScheduleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_GetRentScheduleClient interface {
+	Recv() (*RentDue, error)
+	grpc.ClientStream
+}
+
+type rPMGetRentScheduleClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMGetRentScheduleClient) Recv() (*RentDue, error) {
+	m := new(RentDue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) PostLedgerEntry(ctx context.Context, in *PostLedgerEntryReq, opts ...grpc.CallOption) (*PostLedgerEntryRes, error) {
+	out := new(PostLedgerEntryRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/PostLedgerEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ReverseLedgerEntry(ctx context.Context, in *ReverseLedgerEntryReq, opts ...grpc.CallOption) (*ReverseLedgerEntryRes, error) {
+	out := new(ReverseLedgerEntryRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/ReverseLedgerEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetBalance(ctx context.Context, in *GetBalanceReq, opts ...grpc.CallOption) (*GetBalanceRes, error) {
+	out := new(GetBalanceRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetStatement(ctx context.Context, in *GetStatementReq, opts ...grpc.CallOption) (*Statement, error) {
+	out := new(Statement)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) StoreLateFeePolicy(ctx context.Context, in *StoreLateFeePolicyReq, opts ...grpc.CallOption) (*StoreLateFeePolicyRes, error) {
+	out := new(StoreLateFeePolicyRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/StoreLateFeePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetLateFeePolicy(ctx context.Context, in *GetLateFeePolicyReq, opts ...grpc.CallOption) (*GetLateFeePolicyRes, error) {
+	out := new(GetLateFeePolicyRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetLateFeePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) AssessLateFees(ctx context.Context, in *AssessLateFeesReq, opts ...grpc.CallOption) (RPM_AssessLateFeesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[5], "/rpmpb.RPM/AssessLateFees", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMAssessLateFeesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_AssessLateFeesClient interface {
+	Recv() (*LateFee, error)
+	grpc.ClientStream
+}
+
+type rPMAssessLateFeesClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMAssessLateFeesClient) Recv() (*LateFee, error) {
+	m := new(LateFee)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) ApplyLateFees(ctx context.Context, in *ApplyLateFeesReq, opts ...grpc.CallOption) (RPM_ApplyLateFeesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[6], "/rpmpb.RPM/ApplyLateFees", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMApplyLateFeesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_ApplyLateFeesClient interface {
+	Recv() (*LedgerEntry, error)
+	grpc.ClientStream
+}
+
+type rPMApplyLateFeesClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMApplyLateFeesClient) Recv() (*LedgerEntry, error) {
+	m := new(LedgerEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) RecordDepositReceipt(ctx context.Context, in *RecordDepositReceiptReq, opts ...grpc.CallOption) (*RecordDepositReceiptRes, error) {
+	out := new(RecordDepositReceiptRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/RecordDepositReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetDeposit(ctx context.Context, in *GetDepositReq, opts ...grpc.CallOption) (*DepositAccount, error) {
+	out := new(DepositAccount)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) DisposeDeposit(ctx context.Context, in *DisposeDepositReq, opts ...grpc.CallOption) (*DisposeDepositRes, error) {
+	out := new(DisposeDepositRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/DisposeDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetDepositStatement(ctx context.Context, in *GetDepositStatementReq, opts ...grpc.CallOption) (*GetDepositStatementRes, error) {
+	out := new(GetDepositStatementRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetDepositStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) SubmitApplication(ctx context.Context, in *SubmitApplicationReq, opts ...grpc.CallOption) (*SubmitApplicationRes, error) {
+	out := new(SubmitApplicationRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/SubmitApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetApplication(ctx context.Context, in *GetApplicationReq, opts ...grpc.CallOption) (*GetApplicationRes, error) {
+	out := new(GetApplicationRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ListApplications(ctx context.Context, in *ListApplicationsReq, opts ...grpc.CallOption) (RPM_ListApplicationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[7], "/rpmpb.RPM/ListApplications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMListApplicationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_ListApplicationsClient interface {
+	Recv() (*Application, error)
+	grpc.ClientStream
+}
+
+type rPMListApplicationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMListApplicationsClient) Recv() (*Application, error) {
+	m := new(Application)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) UpdateApplicationStatus(ctx context.Context, in *UpdateApplicationStatusReq, opts ...grpc.CallOption) (*UpdateApplicationStatusRes, error) {
+	out := new(UpdateApplicationStatusRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/UpdateApplicationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ConvertApplication(ctx context.Context, in *ConvertApplicationReq, opts ...grpc.CallOption) (*ConvertApplicationRes, error) {
+	out := new(ConvertApplicationRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/ConvertApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) StoreScreeningPolicy(ctx context.Context, in *StoreScreeningPolicyReq, opts ...grpc.CallOption) (*StoreScreeningPolicyRes, error) {
+	out := new(StoreScreeningPolicyRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/StoreScreeningPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetScreeningPolicy(ctx context.Context, in *GetScreeningPolicyReq, opts ...grpc.CallOption) (*GetScreeningPolicyRes, error) {
+	out := new(GetScreeningPolicyRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetScreeningPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ScreenApplication(ctx context.Context, in *ScreenApplicationReq, opts ...grpc.CallOption) (*ScreenApplicationRes, error) {
+	out := new(ScreenApplicationRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/ScreenApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ListScreeningReports(ctx context.Context, in *ListScreeningReportsReq, opts ...grpc.CallOption) (RPM_ListScreeningReportsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[8], "/rpmpb.RPM/ListScreeningReports", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMListScreeningReportsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_ListScreeningReportsClient interface {
+	Recv() (*ScreeningReport, error)
+	grpc.ClientStream
+}
+
+type rPMListScreeningReportsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMListScreeningReportsClient) Recv() (*ScreeningReport, error) {
+	m := new(ScreeningReport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) StoreListing(ctx context.Context, in *StoreListingReq, opts ...grpc.CallOption) (*StoreListingRes, error) {
+	out := new(StoreListingRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/StoreListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetListing(ctx context.Context, in *GetListingReq, opts ...grpc.CallOption) (*GetListingRes, error) {
+	out := new(GetListingRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) PublishListing(ctx context.Context, in *PublishListingReq, opts ...grpc.CallOption) (*PublishListingRes, error) {
+	out := new(PublishListingRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/PublishListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) UnpublishListing(ctx context.Context, in *UnpublishListingReq, opts ...grpc.CallOption) (*UnpublishListingRes, error) {
+	out := new(UnpublishListingRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/UnpublishListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ListPublicListings(ctx context.Context, in *ListPublicListingsReq, opts ...grpc.CallOption) (RPM_ListPublicListingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[9], "/rpmpb.RPM/ListPublicListings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMListPublicListingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_ListPublicListingsClient interface {
+	Recv() (*PublicListing, error)
+	grpc.ClientStream
+}
+
+type rPMListPublicListingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMListPublicListingsClient) Recv() (*PublicListing, error) {
+	m := new(PublicListing)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) ListOutbox(ctx context.Context, in *ListOutboxReq, opts ...grpc.CallOption) (RPM_ListOutboxClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[10], "/rpmpb.RPM/ListOutbox", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMListOutboxClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_ListOutboxClient interface {
+	Recv() (*OutboxMessage, error)
+	grpc.ClientStream
+}
+
+type rPMListOutboxClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMListOutboxClient) Recv() (*OutboxMessage, error) {
+	m := new(OutboxMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) GetOutboxMessage(ctx context.Context, in *GetOutboxMessageReq, opts ...grpc.CallOption) (*GetOutboxMessageRes, error) {
+	out := new(GetOutboxMessageRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetOutboxMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ReplayOutboxMessage(ctx context.Context, in *ReplayOutboxMessageReq, opts ...grpc.CallOption) (*ReplayOutboxMessageRes, error) {
+	out := new(ReplayOutboxMessageRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/ReplayOutboxMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) StoreWebhook(ctx context.Context, in *StoreWebhookReq, opts ...grpc.CallOption) (*StoreWebhookRes, error) {
+	out := new(StoreWebhookRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/StoreWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) GetWebhook(ctx context.Context, in *GetWebhookReq, opts ...grpc.CallOption) (*GetWebhookRes, error) {
+	out := new(GetWebhookRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ListWebhooks(ctx context.Context, in *ListWebhooksReq, opts ...grpc.CallOption) (RPM_ListWebhooksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[11], "/rpmpb.RPM/ListWebhooks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMListWebhooksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_ListWebhooksClient interface {
+	Recv() (*Webhook, error)
+	grpc.ClientStream
+}
+
+type rPMListWebhooksClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMListWebhooksClient) Recv() (*Webhook, error) {
+	m := new(Webhook)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) RemoveWebhook(ctx context.Context, in *RemoveWebhookReq, opts ...grpc.CallOption) (*RemoveWebhookRes, error) {
+	out := new(RemoveWebhookRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/RemoveWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (RPM_ListWebhookDeliveriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[12], "/rpmpb.RPM/ListWebhookDeliveries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMListWebhookDeliveriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_ListWebhookDeliveriesClient interface {
+	Recv() (*WebhookDelivery, error)
+	grpc.ClientStream
+}
+
+type rPMListWebhookDeliveriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMListWebhookDeliveriesClient) Recv() (*WebhookDelivery, error) {
+	m := new(WebhookDelivery)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rPMClient) GetWebhookDelivery(ctx context.Context, in *GetWebhookDeliveryReq, opts ...grpc.CallOption) (*GetWebhookDeliveryRes, error) {
+	out := new(GetWebhookDeliveryRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/GetWebhookDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookReq, opts ...grpc.CallOption) (*RedeliverWebhookRes, error) {
+	out := new(RedeliverWebhookRes)
+	err := c.cc.Invoke(ctx, "/rpmpb.RPM/RedeliverWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPMClient) ListAudit(ctx context.Context, in *ListAuditReq, opts ...grpc.CallOption) (RPM_ListAuditClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPM_ServiceDesc.Streams[13], "/rpmpb.RPM/ListAudit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPMListAuditClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RPM_ListAuditClient interface {
+	Recv() (*AuditEntry, error)
+	grpc.ClientStream
+}
+
+type rPMListAuditClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPMListAuditClient) Recv() (*AuditEntry, error) {
+	m := new(AuditEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RPMServer is the server API for RPM service.
+// All implementations must embed UnimplementedRPMServer
+// for forward compatibility
+type RPMServer interface {
+	StoreProperty(context.Context, *StorePropertyReq) (*StorePropertyRes, error)
+	GetProperty(context.Context, *GetPropertyReq) (*GetPropertyRes, error)
+	RemoveProperty(context.Context, *RemovePropertyReq) (*RemovePropertyRes, error)
+	RestoreProperty(context.Context, *RestorePropertyReq) (*RestorePropertyRes, error)
+	ListProperties(*ListPropertiesReq, RPM_ListPropertiesServer) error
+	StoreUnit(context.Context, *StoreUnitReq) (*StoreUnitRes, error)
+	GetUnit(context.Context, *GetUnitReq) (*GetUnitRes, error)
+	ListUnits(*ListUnitsReq, RPM_ListUnitsServer) error
+	RemoveUnit(context.Context, *RemoveUnitReq) (*RemoveUnitRes, error)
+	StoreTenant(context.Context, *StoreTenantReq) (*StoreTenantRes, error)
+	GetTenant(context.Context, *GetTenantReq) (*GetTenantRes, error)
+	ListTenants(*ListTenantsReq, RPM_ListTenantsServer) error
+	PatchTenant(context.Context, *PatchTenantReq) (*PatchTenantRes, error)
+	RemoveTenant(context.Context, *RemoveTenantReq) (*RemoveTenantRes, error)
+	RestoreTenant(context.Context, *RestoreTenantReq) (*RestoreTenantRes, error)
+	AddTenantPhone(context.Context, *AddTenantPhoneReq) (*AddTenantPhoneRes, error)
+	UpdateTenantPhone(context.Context, *UpdateTenantPhoneReq) (*UpdateTenantPhoneRes, error)
+	RemoveTenantPhone(context.Context, *RemoveTenantPhoneReq) (*RemoveTenantPhoneRes, error)
+	LeaseProperty(context.Context, *LeasePropertyReq) (*LeasePropertyRes, error)
+	GetLease(context.Context, *GetLeaseReq) (*GetLeaseRes, error)
+	ListLeases(*ListLeasesReq, RPM_ListLeasesServer) error
+	TerminateLease(context.Context, *TerminateLeaseReq) (*TerminateLeaseRes, error)
+	RenewLease(context.Context, *RenewLeaseReq) (*RenewLeaseRes, error)
+	AmendLease(context.Context, *AmendLeaseReq) (*AmendLeaseRes, error)
+	GetRentSchedule(*GetRentScheduleReq, RPM_GetRentScheduleServer) error
+	PostLedgerEntry(context.Context, *PostLedgerEntryReq) (*PostLedgerEntryRes, error)
+	ReverseLedgerEntry(context.Context, *ReverseLedgerEntryReq) (*ReverseLedgerEntryRes, error)
+	GetBalance(context.Context, *GetBalanceReq) (*GetBalanceRes, error)
+	GetStatement(context.Context, *GetStatementReq) (*Statement, error)
+	StoreLateFeePolicy(context.Context, *StoreLateFeePolicyReq) (*StoreLateFeePolicyRes, error)
+	GetLateFeePolicy(context.Context, *GetLateFeePolicyReq) (*GetLateFeePolicyRes, error)
+	AssessLateFees(*AssessLateFeesReq, RPM_AssessLateFeesServer) error
+	ApplyLateFees(*ApplyLateFeesReq, RPM_ApplyLateFeesServer) error
+	RecordDepositReceipt(context.Context, *RecordDepositReceiptReq) (*RecordDepositReceiptRes, error)
+	GetDeposit(context.Context, *GetDepositReq) (*DepositAccount, error)
+	DisposeDeposit(context.Context, *DisposeDepositReq) (*DisposeDepositRes, error)
+	GetDepositStatement(context.Context, *GetDepositStatementReq) (*GetDepositStatementRes, error)
+	SubmitApplication(context.Context, *SubmitApplicationReq) (*SubmitApplicationRes, error)
+	GetApplication(context.Context, *GetApplicationReq) (*GetApplicationRes, error)
+	ListApplications(*ListApplicationsReq, RPM_ListApplicationsServer) error
+	UpdateApplicationStatus(context.Context, *UpdateApplicationStatusReq) (*UpdateApplicationStatusRes, error)
+	ConvertApplication(context.Context, *ConvertApplicationReq) (*ConvertApplicationRes, error)
+	StoreScreeningPolicy(context.Context, *StoreScreeningPolicyReq) (*StoreScreeningPolicyRes, error)
+	GetScreeningPolicy(context.Context, *GetScreeningPolicyReq) (*GetScreeningPolicyRes, error)
+	ScreenApplication(context.Context, *ScreenApplicationReq) (*ScreenApplicationRes, error)
+	ListScreeningReports(*ListScreeningReportsReq, RPM_ListScreeningReportsServer) error
+	StoreListing(context.Context, *StoreListingReq) (*StoreListingRes, error)
+	GetListing(context.Context, *GetListingReq) (*GetListingRes, error)
+	PublishListing(context.Context, *PublishListingReq) (*PublishListingRes, error)
+	UnpublishListing(context.Context, *UnpublishListingReq) (*UnpublishListingRes, error)
+	ListPublicListings(*ListPublicListingsReq, RPM_ListPublicListingsServer) error
+	ListOutbox(*ListOutboxReq, RPM_ListOutboxServer) error
+	GetOutboxMessage(context.Context, *GetOutboxMessageReq) (*GetOutboxMessageRes, error)
+	ReplayOutboxMessage(context.Context, *ReplayOutboxMessageReq) (*ReplayOutboxMessageRes, error)
+	StoreWebhook(context.Context, *StoreWebhookReq) (*StoreWebhookRes, error)
+	GetWebhook(context.Context, *GetWebhookReq) (*GetWebhookRes, error)
+	ListWebhooks(*ListWebhooksReq, RPM_ListWebhooksServer) error
+	RemoveWebhook(context.Context, *RemoveWebhookReq) (*RemoveWebhookRes, error)
+	ListWebhookDeliveries(*ListWebhookDeliveriesReq, RPM_ListWebhookDeliveriesServer) error
+	GetWebhookDelivery(context.Context, *GetWebhookDeliveryReq) (*GetWebhookDeliveryRes, error)
+	RedeliverWebhook(context.Context, *RedeliverWebhookReq) (*RedeliverWebhookRes, error)
+	ListAudit(*ListAuditReq, RPM_ListAuditServer) error
+	mustEmbedUnimplementedRPMServer()
+}
+
+// UnimplementedRPMServer must be embedded to have forward compatible implementations.
+type UnimplementedRPMServer struct {
+}
+
+func (UnimplementedRPMServer) StoreProperty(context.Context, *StorePropertyReq) (*StorePropertyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreProperty not implemented")
+}
+func (UnimplementedRPMServer) GetProperty(context.Context, *GetPropertyReq) (*GetPropertyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProperty not implemented")
+}
+func (UnimplementedRPMServer) RemoveProperty(context.Context, *RemovePropertyReq) (*RemovePropertyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProperty not implemented")
+}
+func (UnimplementedRPMServer) RestoreProperty(context.Context, *RestorePropertyReq) (*RestorePropertyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProperty not implemented")
+}
+func (UnimplementedRPMServer) ListProperties(*ListPropertiesReq, RPM_ListPropertiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListProperties not implemented")
+}
+func (UnimplementedRPMServer) StoreUnit(context.Context, *StoreUnitReq) (*StoreUnitRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreUnit not implemented")
+}
+func (UnimplementedRPMServer) GetUnit(context.Context, *GetUnitReq) (*GetUnitRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnit not implemented")
+}
+func (UnimplementedRPMServer) ListUnits(*ListUnitsReq, RPM_ListUnitsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListUnits not implemented")
+}
+func (UnimplementedRPMServer) RemoveUnit(context.Context, *RemoveUnitReq) (*RemoveUnitRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUnit not implemented")
+}
+func (UnimplementedRPMServer) StoreTenant(context.Context, *StoreTenantReq) (*StoreTenantRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreTenant not implemented")
+}
+func (UnimplementedRPMServer) GetTenant(context.Context, *GetTenantReq) (*GetTenantRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
+}
+func (UnimplementedRPMServer) ListTenants(*ListTenantsReq, RPM_ListTenantsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTenants not implemented")
+}
+func (UnimplementedRPMServer) PatchTenant(context.Context, *PatchTenantReq) (*PatchTenantRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchTenant not implemented")
+}
+func (UnimplementedRPMServer) RemoveTenant(context.Context, *RemoveTenantReq) (*RemoveTenantRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTenant not implemented")
+}
+func (UnimplementedRPMServer) RestoreTenant(context.Context, *RestoreTenantReq) (*RestoreTenantRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTenant not implemented")
+}
+func (UnimplementedRPMServer) AddTenantPhone(context.Context, *AddTenantPhoneReq) (*AddTenantPhoneRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTenantPhone not implemented")
+}
+func (UnimplementedRPMServer) UpdateTenantPhone(context.Context, *UpdateTenantPhoneReq) (*UpdateTenantPhoneRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenantPhone not implemented")
+}
+func (UnimplementedRPMServer) RemoveTenantPhone(context.Context, *RemoveTenantPhoneReq) (*RemoveTenantPhoneRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTenantPhone not implemented")
+}
+func (UnimplementedRPMServer) LeaseProperty(context.Context, *LeasePropertyReq) (*LeasePropertyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseProperty not implemented")
+}
+func (UnimplementedRPMServer) GetLease(context.Context, *GetLeaseReq) (*GetLeaseRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLease not implemented")
+}
+func (UnimplementedRPMServer) ListLeases(*ListLeasesReq, RPM_ListLeasesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListLeases not implemented")
+}
+func (UnimplementedRPMServer) TerminateLease(context.Context, *TerminateLeaseReq) (*TerminateLeaseRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateLease not implemented")
+}
+func (UnimplementedRPMServer) RenewLease(context.Context, *RenewLeaseReq) (*RenewLeaseRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLease not implemented")
+}
+func (UnimplementedRPMServer) AmendLease(context.Context, *AmendLeaseReq) (*AmendLeaseRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendLease not implemented")
+}
+func (UnimplementedRPMServer) GetRentSchedule(*GetRentScheduleReq, RPM_GetRentScheduleServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetRentSchedule not implemented")
+}
+func (UnimplementedRPMServer) PostLedgerEntry(context.Context, *PostLedgerEntryReq) (*PostLedgerEntryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostLedgerEntry not implemented")
+}
+func (UnimplementedRPMServer) ReverseLedgerEntry(context.Context, *ReverseLedgerEntryReq) (*ReverseLedgerEntryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseLedgerEntry not implemented")
+}
+func (UnimplementedRPMServer) GetBalance(context.Context, *GetBalanceReq) (*GetBalanceRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedRPMServer) GetStatement(context.Context, *GetStatementReq) (*Statement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatement not implemented")
+}
+func (UnimplementedRPMServer) StoreLateFeePolicy(context.Context, *StoreLateFeePolicyReq) (*StoreLateFeePolicyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreLateFeePolicy not implemented")
+}
+func (UnimplementedRPMServer) GetLateFeePolicy(context.Context, *GetLateFeePolicyReq) (*GetLateFeePolicyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLateFeePolicy not implemented")
+}
+func (UnimplementedRPMServer) AssessLateFees(*AssessLateFeesReq, RPM_AssessLateFeesServer) error {
+	return status.Errorf(codes.Unimplemented, "method AssessLateFees not implemented")
+}
+func (UnimplementedRPMServer) ApplyLateFees(*ApplyLateFeesReq, RPM_ApplyLateFeesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ApplyLateFees not implemented")
+}
+func (UnimplementedRPMServer) RecordDepositReceipt(context.Context, *RecordDepositReceiptReq) (*RecordDepositReceiptRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordDepositReceipt not implemented")
+}
+func (UnimplementedRPMServer) GetDeposit(context.Context, *GetDepositReq) (*DepositAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeposit not implemented")
+}
+func (UnimplementedRPMServer) DisposeDeposit(context.Context, *DisposeDepositReq) (*DisposeDepositRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisposeDeposit not implemented")
+}
+func (UnimplementedRPMServer) GetDepositStatement(context.Context, *GetDepositStatementReq) (*GetDepositStatementRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositStatement not implemented")
+}
+func (UnimplementedRPMServer) SubmitApplication(context.Context, *SubmitApplicationReq) (*SubmitApplicationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitApplication not implemented")
+}
+func (UnimplementedRPMServer) GetApplication(context.Context, *GetApplicationReq) (*GetApplicationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplication not implemented")
+}
+func (UnimplementedRPMServer) ListApplications(*ListApplicationsReq, RPM_ListApplicationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListApplications not implemented")
+}
+func (UnimplementedRPMServer) UpdateApplicationStatus(context.Context, *UpdateApplicationStatusReq) (*UpdateApplicationStatusRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplicationStatus not implemented")
+}
+func (UnimplementedRPMServer) ConvertApplication(context.Context, *ConvertApplicationReq) (*ConvertApplicationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertApplication not implemented")
+}
+func (UnimplementedRPMServer) StoreScreeningPolicy(context.Context, *StoreScreeningPolicyReq) (*StoreScreeningPolicyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreScreeningPolicy not implemented")
+}
+func (UnimplementedRPMServer) GetScreeningPolicy(context.Context, *GetScreeningPolicyReq) (*GetScreeningPolicyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScreeningPolicy not implemented")
+}
+func (UnimplementedRPMServer) ScreenApplication(context.Context, *ScreenApplicationReq) (*ScreenApplicationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScreenApplication not implemented")
+}
+func (UnimplementedRPMServer) ListScreeningReports(*ListScreeningReportsReq, RPM_ListScreeningReportsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListScreeningReports not implemented")
+}
+func (UnimplementedRPMServer) StoreListing(context.Context, *StoreListingReq) (*StoreListingRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreListing not implemented")
+}
+func (UnimplementedRPMServer) GetListing(context.Context, *GetListingReq) (*GetListingRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListing not implemented")
+}
+func (UnimplementedRPMServer) PublishListing(context.Context, *PublishListingReq) (*PublishListingRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishListing not implemented")
+}
+func (UnimplementedRPMServer) UnpublishListing(context.Context, *UnpublishListingReq) (*UnpublishListingRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishListing not implemented")
+}
+func (UnimplementedRPMServer) ListPublicListings(*ListPublicListingsReq, RPM_ListPublicListingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPublicListings not implemented")
+}
+func (UnimplementedRPMServer) ListOutbox(*ListOutboxReq, RPM_ListOutboxServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListOutbox not implemented")
+}
+func (UnimplementedRPMServer) GetOutboxMessage(context.Context, *GetOutboxMessageReq) (*GetOutboxMessageRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutboxMessage not implemented")
+}
+func (UnimplementedRPMServer) ReplayOutboxMessage(context.Context, *ReplayOutboxMessageReq) (*ReplayOutboxMessageRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayOutboxMessage not implemented")
+}
+func (UnimplementedRPMServer) StoreWebhook(context.Context, *StoreWebhookReq) (*StoreWebhookRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreWebhook not implemented")
+}
+func (UnimplementedRPMServer) GetWebhook(context.Context, *GetWebhookReq) (*GetWebhookRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedRPMServer) ListWebhooks(*ListWebhooksReq, RPM_ListWebhooksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedRPMServer) RemoveWebhook(context.Context, *RemoveWebhookReq) (*RemoveWebhookRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebhook not implemented")
+}
+func (UnimplementedRPMServer) ListWebhookDeliveries(*ListWebhookDeliveriesReq, RPM_ListWebhookDeliveriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedRPMServer) GetWebhookDelivery(context.Context, *GetWebhookDeliveryReq) (*GetWebhookDeliveryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDelivery not implemented")
+}
+func (UnimplementedRPMServer) RedeliverWebhook(context.Context, *RedeliverWebhookReq) (*RedeliverWebhookRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedRPMServer) ListAudit(*ListAuditReq, RPM_ListAuditServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAudit not implemented")
+}
+func (UnimplementedRPMServer) mustEmbedUnimplementedRPMServer() {}
+
+// UnsafeRPMServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RPMServer will
+// result in compilation errors.
+type UnsafeRPMServer interface {
+	mustEmbedUnimplementedRPMServer()
+}
+
+func RegisterRPMServer(s grpc.ServiceRegistrar, srv RPMServer) {
+	s.RegisterService(&RPM_ServiceDesc, srv)
+}
+
+func _RPM_StoreProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorePropertyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).StoreProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/StoreProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).StoreProperty(ctx, req.(*StorePropertyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetProperty(ctx, req.(*GetPropertyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_RemoveProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePropertyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).RemoveProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/RemoveProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).RemoveProperty(ctx, req.(*RemovePropertyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_RestoreProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePropertyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).RestoreProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/RestoreProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).RestoreProperty(ctx, req.(*RestorePropertyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_ListProperties_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPropertiesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RPMServer).ListProperties(m, &rPMListPropertiesServer{stream})
+}
+
+type RPM_ListPropertiesServer interface {
+	Send(*Property) error
+	grpc.ServerStream
+}
+
+type rPMListPropertiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPMListPropertiesServer) Send(m *Property) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RPM_StoreUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreUnitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).StoreUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/StoreUnit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).StoreUnit(ctx, req.(*StoreUnitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetUnit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetUnit(ctx, req.(*GetUnitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_ListUnits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListUnitsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RPMServer).ListUnits(m, &rPMListUnitsServer{stream})
+}
+
+type RPM_ListUnitsServer interface {
+	Send(*Unit) error
+	grpc.ServerStream
+}
+
+type rPMListUnitsServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPMListUnitsServer) Send(m *Unit) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RPM_RemoveUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUnitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).RemoveUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/RemoveUnit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).RemoveUnit(ctx, req.(*RemoveUnitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_StoreTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreTenantReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).StoreTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/StoreTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).StoreTenant(ctx, req.(*StoreTenantReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetTenant(ctx, req.(*GetTenantReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_ListTenants_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTenantsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RPMServer).ListTenants(m, &rPMListTenantsServer{stream})
+}
+
+type RPM_ListTenantsServer interface {
+	Send(*Tenant) error
+	grpc.ServerStream
+}
+
+type rPMListTenantsServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPMListTenantsServer) Send(m *Tenant) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RPM_PatchTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchTenantReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).PatchTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/PatchTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).PatchTenant(ctx, req.(*PatchTenantReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_RemoveTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTenantReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).RemoveTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/RemoveTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).RemoveTenant(ctx, req.(*RemoveTenantReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_RestoreTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTenantReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).RestoreTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/RestoreTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).RestoreTenant(ctx, req.(*RestoreTenantReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_AddTenantPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTenantPhoneReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).AddTenantPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/AddTenantPhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).AddTenantPhone(ctx, req.(*AddTenantPhoneReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_UpdateTenantPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantPhoneReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).UpdateTenantPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/UpdateTenantPhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).UpdateTenantPhone(ctx, req.(*UpdateTenantPhoneReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_RemoveTenantPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTenantPhoneReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).RemoveTenantPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/RemoveTenantPhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).RemoveTenantPhone(ctx, req.(*RemoveTenantPhoneReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_LeaseProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeasePropertyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).LeaseProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/LeaseProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).LeaseProperty(ctx, req.(*LeasePropertyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetLease(ctx, req.(*GetLeaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_ListLeases_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListLeasesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RPMServer).ListLeases(m, &rPMListLeasesServer{stream})
+}
+
+type RPM_ListLeasesServer interface {
+	Send(*Lease) error
+	grpc.ServerStream
+}
+
+type rPMListLeasesServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPMListLeasesServer) Send(m *Lease) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RPM_TerminateLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateLeaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).TerminateLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/TerminateLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).TerminateLease(ctx, req.(*TerminateLeaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_RenewLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLeaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).RenewLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/RenewLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).RenewLease(ctx, req.(*RenewLeaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_AmendLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendLeaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).AmendLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/AmendLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).AmendLease(ctx, req.(*AmendLeaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetRentSchedule_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRentScheduleReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RPMServer).GetRentSchedule(m, &rPMGetRentScheduleServer{stream})
+}
+
+type RPM_GetRentScheduleServer interface {
+	Send(*RentDue) error
+	grpc.ServerStream
+}
+
+type rPMGetRentScheduleServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPMGetRentScheduleServer) Send(m *RentDue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RPM_PostLedgerEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostLedgerEntryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).PostLedgerEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/PostLedgerEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).PostLedgerEntry(ctx, req.(*PostLedgerEntryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_ReverseLedgerEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseLedgerEntryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).ReverseLedgerEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/ReverseLedgerEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).ReverseLedgerEntry(ctx, req.(*ReverseLedgerEntryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetBalance(ctx, req.(*GetBalanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetStatement(ctx, req.(*GetStatementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_StoreLateFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreLateFeePolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).StoreLateFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/StoreLateFeePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).StoreLateFeePolicy(ctx, req.(*StoreLateFeePolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetLateFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLateFeePolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetLateFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetLateFeePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetLateFeePolicy(ctx, req.(*GetLateFeePolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_AssessLateFees_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AssessLateFeesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RPMServer).AssessLateFees(m, &rPMAssessLateFeesServer{stream})
+}
+
+type RPM_AssessLateFeesServer interface {
+	Send(*LateFee) error
+	grpc.ServerStream
+}
+
+type rPMAssessLateFeesServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPMAssessLateFeesServer) Send(m *LateFee) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RPM_ApplyLateFees_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApplyLateFeesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RPMServer).ApplyLateFees(m, &rPMApplyLateFeesServer{stream})
+}
+
+type RPM_ApplyLateFeesServer interface {
+	Send(*LedgerEntry) error
+	grpc.ServerStream
+}
+
+type rPMApplyLateFeesServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPMApplyLateFeesServer) Send(m *LedgerEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RPM_RecordDepositReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordDepositReceiptReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).RecordDepositReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/RecordDepositReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).RecordDepositReceipt(ctx, req.(*RecordDepositReceiptReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetDeposit(ctx, req.(*GetDepositReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_DisposeDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisposeDepositReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).DisposeDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/DisposeDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).DisposeDeposit(ctx, req.(*DisposeDepositReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetDepositStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositStatementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetDepositStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetDepositStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetDepositStatement(ctx, req.(*GetDepositStatementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_SubmitApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitApplicationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).SubmitApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/SubmitApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).SubmitApplication(ctx, req.(*SubmitApplicationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetApplication(ctx, req.(*GetApplicationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_ListApplications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListApplicationsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RPMServer).ListApplications(m, &rPMListApplicationsServer{stream})
+}
+
+type RPM_ListApplicationsServer interface {
+	Send(*Application) error
+	grpc.ServerStream
+}
+
+type rPMListApplicationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPMListApplicationsServer) Send(m *Application) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RPM_UpdateApplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).UpdateApplicationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/UpdateApplicationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).UpdateApplicationStatus(ctx, req.(*UpdateApplicationStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_ConvertApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertApplicationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).ConvertApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/ConvertApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).ConvertApplication(ctx, req.(*ConvertApplicationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_StoreScreeningPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreScreeningPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).StoreScreeningPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/StoreScreeningPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).StoreScreeningPolicy(ctx, req.(*StoreScreeningPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetScreeningPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScreeningPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetScreeningPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetScreeningPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetScreeningPolicy(ctx, req.(*GetScreeningPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_ScreenApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreenApplicationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).ScreenApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/ScreenApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).ScreenApplication(ctx, req.(*ScreenApplicationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_ListScreeningReports_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListScreeningReportsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RPMServer).ListScreeningReports(m, &rPMListScreeningReportsServer{stream})
+}
+
+type RPM_ListScreeningReportsServer interface {
+	Send(*ScreeningReport) error
+	grpc.ServerStream
+}
+
+type rPMListScreeningReportsServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPMListScreeningReportsServer) Send(m *ScreeningReport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RPM_StoreListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreListingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).StoreListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/StoreListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).StoreListing(ctx, req.(*StoreListingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_GetListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetListing(ctx, req.(*GetListingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_PublishListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishListingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).PublishListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/PublishListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).PublishListing(ctx, req.(*PublishListingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_UnpublishListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishListingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).UnpublishListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/UnpublishListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).UnpublishListing(ctx, req.(*UnpublishListingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPM_ListPublicListings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPublicListingsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return m, nil
+	return srv.(RPMServer).ListPublicListings(m, &rPMListPublicListingsServer{stream})
 }
 
-// RPMServer is the server API for RPM service.
-// All implementations must embed UnimplementedRPMServer
-// for forward compatibility
-type RPMServer interface {
-	StoreProperty(context.Context, *StorePropertyReq) (*StorePropertyRes, error)
-	GetProperty(context.Context, *GetPropertyReq) (*GetPropertyRes, error)
-	RemoveProperty(context.Context, *RemovePropertyReq) (*RemovePropertyRes, error)
-	ListProperties(*ListPropertiesReq, RPM_ListPropertiesServer) error
-	StoreTenant(context.Context, *StoreTenantReq) (*StoreTenantRes, error)
-	GetTenant(context.Context, *GetTenantReq) (*GetTenantRes, error)
-	ListTenants(*ListTenantsReq, RPM_ListTenantsServer) error
-	mustEmbedUnimplementedRPMServer()
+type RPM_ListPublicListingsServer interface {
+	Send(*PublicListing) error
+	grpc.ServerStream
 }
 
-// UnimplementedRPMServer must be embedded to have forward compatible implementations.
-type UnimplementedRPMServer struct {
+type rPMListPublicListingsServer struct {
+	grpc.ServerStream
 }
 
-func (UnimplementedRPMServer) StoreProperty(context.Context, *StorePropertyReq) (*StorePropertyRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoreProperty not implemented")
-}
-func (UnimplementedRPMServer) GetProperty(context.Context, *GetPropertyReq) (*GetPropertyRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProperty not implemented")
-}
-func (UnimplementedRPMServer) RemoveProperty(context.Context, *RemovePropertyReq) (*RemovePropertyRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveProperty not implemented")
+func (x *rPMListPublicListingsServer) Send(m *PublicListing) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedRPMServer) ListProperties(*ListPropertiesReq, RPM_ListPropertiesServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListProperties not implemented")
+
+func _RPM_ListOutbox_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListOutboxReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RPMServer).ListOutbox(m, &rPMListOutboxServer{stream})
 }
-func (UnimplementedRPMServer) StoreTenant(context.Context, *StoreTenantReq) (*StoreTenantRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoreTenant not implemented")
+
+type RPM_ListOutboxServer interface {
+	Send(*OutboxMessage) error
+	grpc.ServerStream
 }
-func (UnimplementedRPMServer) GetTenant(context.Context, *GetTenantReq) (*GetTenantRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
+
+type rPMListOutboxServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedRPMServer) ListTenants(*ListTenantsReq, RPM_ListTenantsServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListTenants not implemented")
+
+func (x *rPMListOutboxServer) Send(m *OutboxMessage) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedRPMServer) mustEmbedUnimplementedRPMServer() {}
 
-// UnsafeRPMServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RPMServer will
-// result in compilation errors.
-type UnsafeRPMServer interface {
-	mustEmbedUnimplementedRPMServer()
+func _RPM_GetOutboxMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutboxMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).GetOutboxMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/GetOutboxMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).GetOutboxMessage(ctx, req.(*GetOutboxMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRPMServer(s grpc.ServiceRegistrar, srv RPMServer) {
-	s.RegisterService(&RPM_ServiceDesc, srv)
+func _RPM_ReplayOutboxMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayOutboxMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPMServer).ReplayOutboxMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpmpb.RPM/ReplayOutboxMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPMServer).ReplayOutboxMessage(ctx, req.(*ReplayOutboxMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RPM_StoreProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StorePropertyReq)
+func _RPM_StoreWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RPMServer).StoreProperty(ctx, in)
+		return srv.(RPMServer).StoreWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpmpb.RPM/StoreProperty",
+		FullMethod: "/rpmpb.RPM/StoreWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPMServer).StoreProperty(ctx, req.(*StorePropertyReq))
+		return srv.(RPMServer).StoreWebhook(ctx, req.(*StoreWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RPM_GetProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPropertyReq)
+func _RPM_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RPMServer).GetProperty(ctx, in)
+		return srv.(RPMServer).GetWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpmpb.RPM/GetProperty",
+		FullMethod: "/rpmpb.RPM/GetWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPMServer).GetProperty(ctx, req.(*GetPropertyReq))
+		return srv.(RPMServer).GetWebhook(ctx, req.(*GetWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RPM_RemoveProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemovePropertyReq)
+func _RPM_ListWebhooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListWebhooksReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RPMServer).ListWebhooks(m, &rPMListWebhooksServer{stream})
+}
+
+type RPM_ListWebhooksServer interface {
+	Send(*Webhook) error
+	grpc.ServerStream
+}
+
+type rPMListWebhooksServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPMListWebhooksServer) Send(m *Webhook) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RPM_RemoveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RPMServer).RemoveProperty(ctx, in)
+		return srv.(RPMServer).RemoveWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpmpb.RPM/RemoveProperty",
+		FullMethod: "/rpmpb.RPM/RemoveWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPMServer).RemoveProperty(ctx, req.(*RemovePropertyReq))
+		return srv.(RPMServer).RemoveWebhook(ctx, req.(*RemoveWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RPM_ListProperties_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListPropertiesReq)
+func _RPM_ListWebhookDeliveries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListWebhookDeliveriesReq)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(RPMServer).ListProperties(m, &rPMListPropertiesServer{stream})
+	return srv.(RPMServer).ListWebhookDeliveries(m, &rPMListWebhookDeliveriesServer{stream})
 }
 
-type RPM_ListPropertiesServer interface {
-	Send(*Property) error
+type RPM_ListWebhookDeliveriesServer interface {
+	Send(*WebhookDelivery) error
 	grpc.ServerStream
 }
 
-type rPMListPropertiesServer struct {
+type rPMListWebhookDeliveriesServer struct {
 	grpc.ServerStream
 }
 
-func (x *rPMListPropertiesServer) Send(m *Property) error {
+func (x *rPMListWebhookDeliveriesServer) Send(m *WebhookDelivery) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _RPM_StoreTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StoreTenantReq)
+func _RPM_GetWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RPMServer).StoreTenant(ctx, in)
+		return srv.(RPMServer).GetWebhookDelivery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpmpb.RPM/StoreTenant",
+		FullMethod: "/rpmpb.RPM/GetWebhookDelivery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPMServer).StoreTenant(ctx, req.(*StoreTenantReq))
+		return srv.(RPMServer).GetWebhookDelivery(ctx, req.(*GetWebhookDeliveryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RPM_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTenantReq)
+func _RPM_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RPMServer).GetTenant(ctx, in)
+		return srv.(RPMServer).RedeliverWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpmpb.RPM/GetTenant",
+		FullMethod: "/rpmpb.RPM/RedeliverWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPMServer).GetTenant(ctx, req.(*GetTenantReq))
+		return srv.(RPMServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RPM_ListTenants_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListTenantsReq)
+func _RPM_ListAudit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAuditReq)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(RPMServer).ListTenants(m, &rPMListTenantsServer{stream})
+	return srv.(RPMServer).ListAudit(m, &rPMListAuditServer{stream})
 }
 
-type RPM_ListTenantsServer interface {
-	Send(*Tenant) error
+type RPM_ListAuditServer interface {
+	Send(*AuditEntry) error
 	grpc.ServerStream
 }
 
-type rPMListTenantsServer struct {
+type rPMListAuditServer struct {
 	grpc.ServerStream
 }
 
-func (x *rPMListTenantsServer) Send(m *Tenant) error {
+func (x *rPMListAuditServer) Send(m *AuditEntry) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -351,6 +2423,22 @@ var RPM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveProperty",
 			Handler:    _RPM_RemoveProperty_Handler,
 		},
+		{
+			MethodName: "RestoreProperty",
+			Handler:    _RPM_RestoreProperty_Handler,
+		},
+		{
+			MethodName: "StoreUnit",
+			Handler:    _RPM_StoreUnit_Handler,
+		},
+		{
+			MethodName: "GetUnit",
+			Handler:    _RPM_GetUnit_Handler,
+		},
+		{
+			MethodName: "RemoveUnit",
+			Handler:    _RPM_RemoveUnit_Handler,
+		},
 		{
 			MethodName: "StoreTenant",
 			Handler:    _RPM_StoreTenant_Handler,
@@ -359,6 +2447,162 @@ var RPM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTenant",
 			Handler:    _RPM_GetTenant_Handler,
 		},
+		{
+			MethodName: "PatchTenant",
+			Handler:    _RPM_PatchTenant_Handler,
+		},
+		{
+			MethodName: "RemoveTenant",
+			Handler:    _RPM_RemoveTenant_Handler,
+		},
+		{
+			MethodName: "RestoreTenant",
+			Handler:    _RPM_RestoreTenant_Handler,
+		},
+		{
+			MethodName: "AddTenantPhone",
+			Handler:    _RPM_AddTenantPhone_Handler,
+		},
+		{
+			MethodName: "UpdateTenantPhone",
+			Handler:    _RPM_UpdateTenantPhone_Handler,
+		},
+		{
+			MethodName: "RemoveTenantPhone",
+			Handler:    _RPM_RemoveTenantPhone_Handler,
+		},
+		{
+			MethodName: "LeaseProperty",
+			Handler:    _RPM_LeaseProperty_Handler,
+		},
+		{
+			MethodName: "GetLease",
+			Handler:    _RPM_GetLease_Handler,
+		},
+		{
+			MethodName: "TerminateLease",
+			Handler:    _RPM_TerminateLease_Handler,
+		},
+		{
+			MethodName: "RenewLease",
+			Handler:    _RPM_RenewLease_Handler,
+		},
+		{
+			MethodName: "AmendLease",
+			Handler:    _RPM_AmendLease_Handler,
+		},
+		{
+			MethodName: "PostLedgerEntry",
+			Handler:    _RPM_PostLedgerEntry_Handler,
+		},
+		{
+			MethodName: "ReverseLedgerEntry",
+			Handler:    _RPM_ReverseLedgerEntry_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _RPM_GetBalance_Handler,
+		},
+		{
+			MethodName: "GetStatement",
+			Handler:    _RPM_GetStatement_Handler,
+		},
+		{
+			MethodName: "StoreLateFeePolicy",
+			Handler:    _RPM_StoreLateFeePolicy_Handler,
+		},
+		{
+			MethodName: "GetLateFeePolicy",
+			Handler:    _RPM_GetLateFeePolicy_Handler,
+		},
+		{
+			MethodName: "RecordDepositReceipt",
+			Handler:    _RPM_RecordDepositReceipt_Handler,
+		},
+		{
+			MethodName: "GetDeposit",
+			Handler:    _RPM_GetDeposit_Handler,
+		},
+		{
+			MethodName: "DisposeDeposit",
+			Handler:    _RPM_DisposeDeposit_Handler,
+		},
+		{
+			MethodName: "GetDepositStatement",
+			Handler:    _RPM_GetDepositStatement_Handler,
+		},
+		{
+			MethodName: "SubmitApplication",
+			Handler:    _RPM_SubmitApplication_Handler,
+		},
+		{
+			MethodName: "GetApplication",
+			Handler:    _RPM_GetApplication_Handler,
+		},
+		{
+			MethodName: "UpdateApplicationStatus",
+			Handler:    _RPM_UpdateApplicationStatus_Handler,
+		},
+		{
+			MethodName: "ConvertApplication",
+			Handler:    _RPM_ConvertApplication_Handler,
+		},
+		{
+			MethodName: "StoreScreeningPolicy",
+			Handler:    _RPM_StoreScreeningPolicy_Handler,
+		},
+		{
+			MethodName: "GetScreeningPolicy",
+			Handler:    _RPM_GetScreeningPolicy_Handler,
+		},
+		{
+			MethodName: "ScreenApplication",
+			Handler:    _RPM_ScreenApplication_Handler,
+		},
+		{
+			MethodName: "StoreListing",
+			Handler:    _RPM_StoreListing_Handler,
+		},
+		{
+			MethodName: "GetListing",
+			Handler:    _RPM_GetListing_Handler,
+		},
+		{
+			MethodName: "PublishListing",
+			Handler:    _RPM_PublishListing_Handler,
+		},
+		{
+			MethodName: "UnpublishListing",
+			Handler:    _RPM_UnpublishListing_Handler,
+		},
+		{
+			MethodName: "GetOutboxMessage",
+			Handler:    _RPM_GetOutboxMessage_Handler,
+		},
+		{
+			MethodName: "ReplayOutboxMessage",
+			Handler:    _RPM_ReplayOutboxMessage_Handler,
+		},
+		{
+			MethodName: "StoreWebhook",
+			Handler:    _RPM_StoreWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _RPM_GetWebhook_Handler,
+		},
+		{
+			MethodName: "RemoveWebhook",
+			Handler:    _RPM_RemoveWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDelivery",
+			Handler:    _RPM_GetWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _RPM_RedeliverWebhook_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -366,11 +2610,71 @@ var RPM_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _RPM_ListProperties_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListUnits",
+			Handler:       _RPM_ListUnits_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListTenants",
 			Handler:       _RPM_ListTenants_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListLeases",
+			Handler:       _RPM_ListLeases_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetRentSchedule",
+			Handler:       _RPM_GetRentSchedule_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AssessLateFees",
+			Handler:       _RPM_AssessLateFees_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ApplyLateFees",
+			Handler:       _RPM_ApplyLateFees_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListApplications",
+			Handler:       _RPM_ListApplications_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListScreeningReports",
+			Handler:       _RPM_ListScreeningReports_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListPublicListings",
+			Handler:       _RPM_ListPublicListings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListOutbox",
+			Handler:       _RPM_ListOutbox_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListWebhooks",
+			Handler:       _RPM_ListWebhooks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListWebhookDeliveries",
+			Handler:       _RPM_ListWebhookDeliveries_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListAudit",
+			Handler:       _RPM_ListAudit_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpm.proto",
 }
@@ -6,16 +6,27 @@ import (
 
 	"github.com/tempcke/rpm/actions"
 	pb "github.com/tempcke/rpm/api/rpc/proto"
+	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/usecase"
+	"github.com/tempcke/schedule"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// MetadataNextCursor is the header list streams send the cursor of their next page in
+const MetadataNextCursor = "next-cursor"
+
 var _ pb.RPMServer = (*Server)(nil)
 
 type Server struct {
 	pb.UnimplementedRPMServer
-	actions actions.Actions
+	actions   actions.Actions
+	piiKey    string
+	piiSecret string
 }
 
 func NewServer(actions actions.Actions) *Server {
@@ -29,8 +40,7 @@ func (s *Server) StoreProperty(ctx context.Context, req *pb.StorePropertyReq) (*
 	pIn := req.GetProperty().ToProperty()
 	id, err := s.actions.StoreProperty(ctx, pIn)
 	if err != nil {
-		// FIXME: determine and return correct error code
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, statusError(err)
 	}
 
 	res := pb.StorePropertyRes{PropertyID: id}
@@ -38,11 +48,18 @@ func (s *Server) StoreProperty(ctx context.Context, req *pb.StorePropertyReq) (*
 }
 func (s *Server) RemoveProperty(ctx context.Context, req *pb.RemovePropertyReq) (*pb.RemovePropertyRes, error) {
 	if err := s.actions.RemoveProperty(ctx, req.GetPropertyID()); err != nil {
-		return nil, err // FIXME: use proper status error
+		return nil, statusError(err)
 	}
 	res := &pb.RemovePropertyRes{}
 	return res, nil
 }
+func (s *Server) RestoreProperty(ctx context.Context, req *pb.RestorePropertyReq) (*pb.RestorePropertyRes, error) {
+	p, err := s.actions.RestoreProperty(ctx, req.GetPropertyID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.RestorePropertyRes{Property: pb.ToProperty(*p)}, nil
+}
 func (s *Server) GetProperty(ctx context.Context, req *pb.GetPropertyReq) (*pb.GetPropertyRes, error) {
 	var propertyID = req.GetPropertyID()
 	p, err := s.actions.GetProperty(ctx, propertyID)
@@ -53,13 +70,7 @@ func (s *Server) GetProperty(ctx context.Context, req *pb.GetPropertyReq) (*pb.G
 		return nil, err
 	}
 	res := pb.GetPropertyRes{
-		Property: &pb.Property{
-			PropertyID: p.ID,
-			Street:     p.Street,
-			City:       p.City,
-			State:      p.StateCode,
-			Zip:        p.Zip,
-		},
+		Property: pb.ToProperty(*p),
 	}
 	return &res, nil
 }
@@ -68,29 +79,57 @@ func (s *Server) ListProperties(req *pb.ListPropertiesReq, stream pb.RPM_ListPro
 	filter := req.ToPropertyFilter()
 	properties, err := s.actions.ListProperties(ctx, filter)
 	if err != nil {
-		return err // FIXME: use proper status error
+		return statusError(err)
+	}
+	if err := sendNextCursor(stream, filters.NextCursor(properties, filter.Paging(), filters.PropertySortValue)); err != nil {
+		return err
 	}
 	for _, p := range properties {
-		property := pb.Property{
-			PropertyID: p.ID,
-			Street:     p.Street,
-			City:       p.City,
-			State:      p.StateCode,
-			Zip:        p.Zip,
-		}
-		if err := stream.Send(&property); err != nil {
+		if err := stream.Send(pb.ToProperty(p)); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+func (s *Server) StoreUnit(ctx context.Context, req *pb.StoreUnitReq) (*pb.StoreUnitRes, error) {
+	out, err := s.actions.StoreUnit(ctx, req.GetUnit().ToUnit())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.StoreUnitRes{Unit: pb.ToUnit(*out)}, nil
+}
+func (s *Server) GetUnit(ctx context.Context, req *pb.GetUnitReq) (*pb.GetUnitRes, error) {
+	out, err := s.actions.GetUnit(ctx, req.GetPropertyID(), req.GetUnitID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.GetUnitRes{Unit: pb.ToUnit(*out)}, nil
+}
+func (s *Server) ListUnits(req *pb.ListUnitsReq, stream pb.RPM_ListUnitsServer) error {
+	list, err := s.actions.ListUnits(stream.Context(), req.GetPropertyID())
+	if err != nil {
+		return statusError(err)
+	}
+	for _, u := range list {
+		if err := stream.Send(pb.ToUnit(u)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (s *Server) RemoveUnit(ctx context.Context, req *pb.RemoveUnitReq) (*pb.RemoveUnitRes, error) {
+	if err := s.actions.RemoveUnit(ctx, req.GetPropertyID(), req.GetUnitID()); err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.RemoveUnitRes{}, nil
+}
+
 func (s *Server) StoreTenant(ctx context.Context, req *pb.StoreTenantReq) (*pb.StoreTenantRes, error) {
 	in := req.GetTenant().ToTenant()
 	out, err := s.actions.StoreTenant(ctx, in)
 	if err != nil {
-		// FIXME: determine and return correct error code
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, statusError(err)
 	}
 	res := pb.StoreTenantRes{TenantID: out.ID}
 	return &res, nil
@@ -104,21 +143,610 @@ func (s *Server) GetTenant(ctx context.Context, req *pb.GetTenantReq) (*pb.GetTe
 		// FIXME: determine and return correct error code
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
-	res := pb.GetTenantRes{Tenant: pb.ToTenant(*out)}
+	res := pb.GetTenantRes{Tenant: pb.ToTenant(s.maskTenant(ctx, *out))}
 	return &res, nil
 }
 func (s *Server) ListTenants(filter *pb.ListTenantsReq, stream pb.RPM_ListTenantsServer) error {
 	var ctx = context.Background() // TODO: is there a better context to use?
-	list, err := s.actions.ListTenants(ctx)
+	for name, value := range map[string]string{"dobFrom": filter.GetDobFrom(), "dobUntil": filter.GetDobUntil()} {
+		if _, err := optionalDate(name, value); err != nil {
+			return err
+		}
+	}
+	f := filter.ToTenantFilter()
+	list, err := s.actions.ListTenants(ctx, f)
 	if err != nil {
-		// FIXME: determine and return correct error code
-		return status.Error(codes.Unknown, err.Error())
+		return statusError(err)
+	}
+	if err := sendNextCursor(stream, filters.NextCursor(list, f.Page, filters.TenantSortValue)); err != nil {
+		return err
 	}
 	for _, e := range list {
-		if err := stream.Send(pb.ToTenant(e)); err != nil {
+		if err := stream.Send(pb.ToTenant(s.maskTenant(stream.Context(), e))); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+func (s *Server) PatchTenant(ctx context.Context, req *pb.PatchTenantReq) (*pb.PatchTenantRes, error) {
+	out, err := s.actions.PatchTenant(ctx, req.GetTenantID(), req.ToTenantPatch())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.PatchTenantRes{Tenant: pb.ToTenant(s.maskTenant(ctx, *out))}, nil
+}
+func (s *Server) RemoveTenant(ctx context.Context, req *pb.RemoveTenantReq) (*pb.RemoveTenantRes, error) {
+	if err := s.actions.RemoveTenant(ctx, req.GetTenantID()); err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.RemoveTenantRes{}, nil
+}
+func (s *Server) RestoreTenant(ctx context.Context, req *pb.RestoreTenantReq) (*pb.RestoreTenantRes, error) {
+	out, err := s.actions.RestoreTenant(ctx, req.GetTenantID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.RestoreTenantRes{Tenant: pb.ToTenant(s.maskTenant(ctx, *out))}, nil
+}
+func (s *Server) AddTenantPhone(ctx context.Context, req *pb.AddTenantPhoneReq) (*pb.AddTenantPhoneRes, error) {
+	out, err := s.actions.AddTenantPhone(ctx, req.GetTenantID(), req.GetPhone().ToPhone())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.AddTenantPhoneRes{Tenant: pb.ToTenant(s.maskTenant(ctx, *out))}, nil
+}
+func (s *Server) UpdateTenantPhone(ctx context.Context, req *pb.UpdateTenantPhoneReq) (*pb.UpdateTenantPhoneRes, error) {
+	out, err := s.actions.UpdateTenantPhone(ctx, req.GetTenantID(), req.GetNumber(), req.GetPhone().ToPhone())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.UpdateTenantPhoneRes{Tenant: pb.ToTenant(s.maskTenant(ctx, *out))}, nil
+}
+func (s *Server) RemoveTenantPhone(ctx context.Context, req *pb.RemoveTenantPhoneReq) (*pb.RemoveTenantPhoneRes, error) {
+	if err := s.actions.RemoveTenantPhone(ctx, req.GetTenantID(), req.GetNumber()); err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.RemoveTenantPhoneRes{}, nil
+}
+
+func (s *Server) LeaseProperty(ctx context.Context, req *pb.LeasePropertyReq) (*pb.LeasePropertyRes, error) {
+	in := req.GetLease().ToLease()
+	out, err := s.actions.LeaseProperty(ctx, in)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.LeasePropertyRes{Lease: pb.ToLease(*out)}
+	return &res, nil
+}
+func (s *Server) GetLease(ctx context.Context, req *pb.GetLeaseReq) (*pb.GetLeaseRes, error) {
+	out, err := s.actions.GetLease(ctx, req.GetLeaseID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	history, err := s.actions.GetLeaseHistory(ctx, req.GetLeaseID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.GetLeaseRes{
+		Lease:   pb.ToLease(*out),
+		History: pb.ToLeaseHistory(*history),
+	}
+	return &res, nil
+}
+func (s *Server) ListLeases(req *pb.ListLeasesReq, stream pb.RPM_ListLeasesServer) error {
+	f := req.ToLeaseFilter()
+	list, err := s.actions.ListLeases(stream.Context(), f)
+	if err != nil {
+		return statusError(err)
+	}
+	if err := sendNextCursor(stream, filters.NextCursor(list, f.Paging(), filters.LeaseSortValue)); err != nil {
+		return err
+	}
+	for _, e := range list {
+		if err := stream.Send(pb.ToLease(e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (s *Server) TerminateLease(ctx context.Context, req *pb.TerminateLeaseReq) (*pb.TerminateLeaseRes, error) {
+	endDate := schedule.ParseDate(req.GetEndDate())
+	if endDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid endDate: "+req.GetEndDate())
+	}
+	out, err := s.actions.TerminateLease(ctx, req.GetLeaseID(), *endDate, req.GetReason())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.TerminateLeaseRes{Lease: pb.ToLease(*out)}
+	return &res, nil
+}
+func (s *Server) RenewLease(ctx context.Context, req *pb.RenewLeaseReq) (*pb.RenewLeaseRes, error) {
+	if req.GetEndDate() != "" && schedule.ParseDate(req.GetEndDate()) == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid endDate: "+req.GetEndDate())
+	}
+	out, err := s.actions.RenewLease(ctx, req.GetLeaseID(), req.ToLeaseRenewal())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.RenewLeaseRes{Lease: pb.ToLease(*out)}
+	return &res, nil
+}
+func (s *Server) AmendLease(ctx context.Context, req *pb.AmendLeaseReq) (*pb.AmendLeaseRes, error) {
+	if req.GetEffectiveDate() != "" && schedule.ParseDate(req.GetEffectiveDate()) == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid effectiveDate: "+req.GetEffectiveDate())
+	}
+	out, err := s.actions.AmendLease(ctx, req.GetLeaseID(), req.ToLeaseAmendment())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.AmendLeaseRes{Lease: pb.ToLease(*out)}
+	return &res, nil
+}
+func (s *Server) GetRentSchedule(req *pb.GetRentScheduleReq, stream pb.RPM_GetRentScheduleServer) error {
+	if req.GetUntil() != "" && schedule.ParseDate(req.GetUntil()) == nil {
+		return status.Error(codes.InvalidArgument, "invalid until: "+req.GetUntil())
+	}
+	list, err := s.actions.GetRentSchedule(stream.Context(), req.GetLeaseID(), req.ToScheduleOptions())
+	if err != nil {
+		return statusError(err)
+	}
+	for _, e := range list {
+		if err := stream.Send(pb.ToRentDue(e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) PostLedgerEntry(ctx context.Context, req *pb.PostLedgerEntryReq) (*pb.PostLedgerEntryRes, error) {
+	in := req.GetEntry().ToLedgerEntry()
+	out, err := s.actions.PostLedgerEntry(ctx, in)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.PostLedgerEntryRes{Entry: pb.ToLedgerEntry(*out)}
+	return &res, nil
+}
+func (s *Server) ReverseLedgerEntry(ctx context.Context, req *pb.ReverseLedgerEntryReq) (*pb.ReverseLedgerEntryRes, error) {
+	date := schedule.ParseDate(req.GetDate())
+	if date == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid date: "+req.GetDate())
+	}
+	out, err := s.actions.ReverseLedgerEntry(ctx, req.GetLeaseID(), req.GetEntryID(), *date, req.GetMemo())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.ReverseLedgerEntryRes{Entry: pb.ToLedgerEntry(*out)}
+	return &res, nil
+}
+func (s *Server) GetBalance(ctx context.Context, req *pb.GetBalanceReq) (*pb.GetBalanceRes, error) {
+	asOf, err := optionalDate("asOf", req.GetAsOf())
+	if err != nil {
+		return nil, err
+	}
+	balance, err := s.actions.GetBalance(ctx, req.GetLeaseID(), asOf)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.GetBalanceRes{
+		LeaseID: req.GetLeaseID(),
+		AsOf:    req.GetAsOf(),
+		Balance: pb.ToMoney(balance),
+	}
+	return &res, nil
+}
+func (s *Server) GetStatement(ctx context.Context, req *pb.GetStatementReq) (*pb.Statement, error) {
+	from, err := optionalDate("from", req.GetFrom())
+	if err != nil {
+		return nil, err
+	}
+	until, err := optionalDate("until", req.GetUntil())
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.actions.GetStatement(ctx, req.GetLeaseID(), from, until)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return pb.ToStatement(*out), nil
+}
+
+func (s *Server) StoreLateFeePolicy(ctx context.Context, req *pb.StoreLateFeePolicyReq) (*pb.StoreLateFeePolicyRes, error) {
+	in := req.GetPolicy().ToLateFeePolicy()
+	out, err := s.actions.StoreLateFeePolicy(ctx, in)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.StoreLateFeePolicyRes{Policy: pb.ToLateFeePolicy(*out)}
+	return &res, nil
+}
+func (s *Server) GetLateFeePolicy(ctx context.Context, req *pb.GetLateFeePolicyReq) (*pb.GetLateFeePolicyRes, error) {
+	out, err := s.actions.GetLateFeePolicy(ctx, req.GetLeaseID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.GetLateFeePolicyRes{Policy: pb.ToLateFeePolicy(*out)}
+	return &res, nil
+}
+func (s *Server) AssessLateFees(req *pb.AssessLateFeesReq, stream pb.RPM_AssessLateFeesServer) error {
+	asOf, err := optionalDate("asOf", req.GetAsOf())
+	if err != nil {
+		return err
+	}
+	list, err := s.actions.AssessLateFees(stream.Context(), req.GetLeaseID(), asOf)
+	if err != nil {
+		return statusError(err)
+	}
+	for _, e := range list {
+		if err := stream.Send(pb.ToLateFee(e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (s *Server) ApplyLateFees(req *pb.ApplyLateFeesReq, stream pb.RPM_ApplyLateFeesServer) error {
+	asOf, err := optionalDate("asOf", req.GetAsOf())
+	if err != nil {
+		return err
+	}
+	list, err := s.actions.ApplyLateFees(stream.Context(), req.GetLeaseID(), asOf)
+	if err != nil {
+		return statusError(err)
+	}
+	for _, e := range list {
+		if err := stream.Send(pb.ToLedgerEntry(e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) RecordDepositReceipt(ctx context.Context, req *pb.RecordDepositReceiptReq) (*pb.RecordDepositReceiptRes, error) {
+	in := req.GetReceipt().ToDepositReceipt()
+	out, err := s.actions.RecordDepositReceipt(ctx, in)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.RecordDepositReceiptRes{Receipt: pb.ToDepositReceipt(*out)}
+	return &res, nil
+}
+func (s *Server) GetDeposit(ctx context.Context, req *pb.GetDepositReq) (*pb.DepositAccount, error) {
+	out, err := s.actions.GetDeposit(ctx, req.GetLeaseID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return pb.ToDepositAccount(*out), nil
+}
+func (s *Server) DisposeDeposit(ctx context.Context, req *pb.DisposeDepositReq) (*pb.DisposeDepositRes, error) {
+	in := req.GetDisposition().ToDepositDisposition()
+	out, err := s.actions.DisposeDeposit(ctx, in)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.DisposeDepositRes{Disposition: pb.ToDepositDisposition(*out)}
+	return &res, nil
+}
+func (s *Server) GetDepositStatement(ctx context.Context, req *pb.GetDepositStatementReq) (*pb.GetDepositStatementRes, error) {
+	text, err := s.actions.GetDepositStatement(ctx, req.GetLeaseID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.GetDepositStatementRes{Text: text}
+	return &res, nil
+}
+
+func (s *Server) SubmitApplication(ctx context.Context, req *pb.SubmitApplicationReq) (*pb.SubmitApplicationRes, error) {
+	in := req.GetApplication().ToRentalApplication()
+	out, err := s.actions.SubmitApplication(ctx, in)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.SubmitApplicationRes{Application: pb.ToApplication(s.maskApplication(ctx, *out))}
+	return &res, nil
+}
+func (s *Server) GetApplication(ctx context.Context, req *pb.GetApplicationReq) (*pb.GetApplicationRes, error) {
+	out, err := s.actions.GetApplication(ctx, req.GetApplicationID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.GetApplicationRes{Application: pb.ToApplication(s.maskApplication(ctx, *out))}
+	return &res, nil
+}
+func (s *Server) ListApplications(req *pb.ListApplicationsReq, stream pb.RPM_ListApplicationsServer) error {
+	f := req.ToApplicationFilter()
+	list, err := s.actions.ListApplications(stream.Context(), f)
+	if err != nil {
+		return statusError(err)
+	}
+	if err := sendNextCursor(stream, filters.NextCursor(list, f.Page, filters.ApplicationSortValue)); err != nil {
+		return err
+	}
+	for _, e := range list {
+		if err := stream.Send(pb.ToApplication(s.maskApplication(stream.Context(), e))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (s *Server) UpdateApplicationStatus(ctx context.Context, req *pb.UpdateApplicationStatusReq) (*pb.UpdateApplicationStatusRes, error) {
+	out, err := s.actions.UpdateApplicationStatus(ctx, req.GetApplicationID(), req.GetStatus(), req.GetNote())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.UpdateApplicationStatusRes{Application: pb.ToApplication(s.maskApplication(ctx, *out))}
+	return &res, nil
+}
+func (s *Server) ConvertApplication(ctx context.Context, req *pb.ConvertApplicationReq) (*pb.ConvertApplicationRes, error) {
+	out, err := s.actions.ConvertApplication(ctx, req.GetApplicationID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	out.Application = s.maskApplication(ctx, out.Application)
+	for i := range out.Tenants {
+		out.Tenants[i] = s.maskTenant(ctx, out.Tenants[i])
+	}
+	return pb.ToConvertApplicationRes(*out), nil
+}
+func (s *Server) StoreScreeningPolicy(ctx context.Context, req *pb.StoreScreeningPolicyReq) (*pb.StoreScreeningPolicyRes, error) {
+	in := req.GetPolicy().ToScreeningPolicy()
+	out, err := s.actions.StoreScreeningPolicy(ctx, in)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.StoreScreeningPolicyRes{Policy: pb.ToScreeningPolicy(*out)}
+	return &res, nil
+}
+func (s *Server) GetScreeningPolicy(ctx context.Context, req *pb.GetScreeningPolicyReq) (*pb.GetScreeningPolicyRes, error) {
+	out, err := s.actions.GetScreeningPolicy(ctx, req.GetPropertyID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.GetScreeningPolicyRes{Policy: pb.ToScreeningPolicy(*out)}
+	return &res, nil
+}
+func (s *Server) ScreenApplication(ctx context.Context, req *pb.ScreenApplicationReq) (*pb.ScreenApplicationRes, error) {
+	out, err := s.actions.ScreenApplication(ctx, req.GetApplicationID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.ScreenApplicationRes{Report: pb.ToScreeningReport(*out)}
+	return &res, nil
+}
+func (s *Server) ListScreeningReports(req *pb.ListScreeningReportsReq, stream pb.RPM_ListScreeningReportsServer) error {
+	f := req.ToScreeningReportFilter()
+	list, err := s.actions.ListScreeningReports(stream.Context(), f)
+	if err != nil {
+		return statusError(err)
+	}
+	if err := sendNextCursor(stream, filters.NextCursor(list, f.Page, filters.ScreeningReportSortValue)); err != nil {
+		return err
+	}
+	for _, e := range list {
+		if err := stream.Send(pb.ToScreeningReport(e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (s *Server) StoreListing(ctx context.Context, req *pb.StoreListingReq) (*pb.StoreListingRes, error) {
+	if _, err := optionalDate("availableFrom", req.GetListing().GetAvailableFrom()); err != nil {
+		return nil, err
+	}
+	out, err := s.actions.StoreListing(ctx, req.GetListing().ToListing())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.StoreListingRes{Listing: pb.ToListing(*out)}
+	return &res, nil
+}
+func (s *Server) GetListing(ctx context.Context, req *pb.GetListingReq) (*pb.GetListingRes, error) {
+	out, err := s.actions.GetListing(ctx, req.GetPropertyID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.GetListingRes{Listing: pb.ToListing(*out)}
+	return &res, nil
+}
+func (s *Server) PublishListing(ctx context.Context, req *pb.PublishListingReq) (*pb.PublishListingRes, error) {
+	out, err := s.actions.PublishListing(ctx, req.GetPropertyID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.PublishListingRes{Listing: pb.ToListing(*out)}
+	return &res, nil
+}
+func (s *Server) UnpublishListing(ctx context.Context, req *pb.UnpublishListingReq) (*pb.UnpublishListingRes, error) {
+	out, err := s.actions.UnpublishListing(ctx, req.GetPropertyID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.UnpublishListingRes{Listing: pb.ToListing(*out)}
+	return &res, nil
+}
+func (s *Server) ListPublicListings(req *pb.ListPublicListingsReq, stream pb.RPM_ListPublicListingsServer) error {
+	f := req.ToListingFilter()
+	list, err := s.actions.ListPublicListings(stream.Context(), f)
+	if err != nil {
+		return statusError(err)
+	}
+	if err := sendNextCursor(stream, filters.NextCursor(list, f.Paging(), usecase.PublicListingSortValue)); err != nil {
+		return err
+	}
+	for _, e := range list {
+		if err := stream.Send(pb.ToPublicListing(e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (s *Server) ListOutbox(req *pb.ListOutboxReq, stream pb.RPM_ListOutboxServer) error {
+	f := req.ToOutboxFilter()
+	list, err := s.actions.ListOutbox(stream.Context(), f)
+	if err != nil {
+		return statusError(err)
+	}
+	if err := sendNextCursor(stream, filters.NextCursor(list, f.Page, filters.OutboxSortValue)); err != nil {
+		return err
+	}
+	for _, m := range list {
+		if err := stream.Send(pb.ToOutboxMessage(m)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (s *Server) GetOutboxMessage(ctx context.Context, req *pb.GetOutboxMessageReq) (*pb.GetOutboxMessageRes, error) {
+	out, err := s.actions.GetOutboxMessage(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.GetOutboxMessageRes{Message: pb.ToOutboxMessage(*out)}
+	return &res, nil
+}
+func (s *Server) ReplayOutboxMessage(ctx context.Context, req *pb.ReplayOutboxMessageReq) (*pb.ReplayOutboxMessageRes, error) {
+	out, err := s.actions.ReplayOutboxMessage(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.ReplayOutboxMessageRes{Message: pb.ToOutboxMessage(*out)}
+	return &res, nil
+}
+func (s *Server) StoreWebhook(ctx context.Context, req *pb.StoreWebhookReq) (*pb.StoreWebhookRes, error) {
+	out, err := s.actions.StoreWebhook(ctx, req.GetWebhook().ToWebhook())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.StoreWebhookRes{Webhook: pb.ToWebhook(*out)}
+	return &res, nil
+}
+func (s *Server) GetWebhook(ctx context.Context, req *pb.GetWebhookReq) (*pb.GetWebhookRes, error) {
+	out, err := s.actions.GetWebhook(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.GetWebhookRes{Webhook: pb.ToWebhook(*out)}
+	return &res, nil
+}
+func (s *Server) ListWebhooks(_ *pb.ListWebhooksReq, stream pb.RPM_ListWebhooksServer) error {
+	list, err := s.actions.ListWebhooks(stream.Context())
+	if err != nil {
+		return statusError(err)
+	}
+	for _, w := range list {
+		if err := stream.Send(pb.ToWebhook(w)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (s *Server) RemoveWebhook(ctx context.Context, req *pb.RemoveWebhookReq) (*pb.RemoveWebhookRes, error) {
+	if err := s.actions.RemoveWebhook(ctx, req.GetId()); err != nil {
+		return nil, statusError(err)
+	}
+	return &pb.RemoveWebhookRes{}, nil
+}
+func (s *Server) ListWebhookDeliveries(req *pb.ListWebhookDeliveriesReq, stream pb.RPM_ListWebhookDeliveriesServer) error {
+	f := req.ToWebhookDeliveryFilter()
+	list, err := s.actions.ListWebhookDeliveries(stream.Context(), f)
+	if err != nil {
+		return statusError(err)
+	}
+	if err := sendNextCursor(stream, filters.NextCursor(list, f.Paging(), filters.DeliverySortValue)); err != nil {
+		return err
+	}
+	for _, d := range list {
+		if err := stream.Send(pb.ToWebhookDelivery(d)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (s *Server) GetWebhookDelivery(ctx context.Context, req *pb.GetWebhookDeliveryReq) (*pb.GetWebhookDeliveryRes, error) {
+	out, err := s.actions.GetWebhookDelivery(ctx, req.GetId())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.GetWebhookDeliveryRes{Delivery: pb.ToWebhookDelivery(*out)}
+	return &res, nil
+}
+func (s *Server) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookReq) (*pb.RedeliverWebhookRes, error) {
+	out, err := s.actions.RedeliverWebhook(ctx, req.GetDeliveryID())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	res := pb.RedeliverWebhookRes{Delivery: pb.ToWebhookDelivery(*out)}
+	return &res, nil
+}
+func (s *Server) ListAudit(req *pb.ListAuditReq, stream pb.RPM_ListAuditServer) error {
+	f := req.ToAuditFilter()
+	list, err := s.actions.ListAudit(stream.Context(), f)
+	if err != nil {
+		return statusError(err)
+	}
+	if err := sendNextCursor(stream, filters.NextCursor(list, f.Page, filters.AuditSortValue)); err != nil {
+		return err
+	}
+	for _, e := range list {
+		if err := stream.Send(pb.ToAuditEntry(e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// optionalDate parses the date when it is not empty
+func optionalDate(name, value string) (schedule.Date, error) {
+	if value == "" {
+		return schedule.Date{}, nil
+	}
+	d := schedule.ParseDate(value)
+	if d == nil {
+		return schedule.Date{}, status.Error(codes.InvalidArgument, "invalid "+name+": "+value)
+	}
+	return *d, nil
+}
+
+// sendNextCursor sends the cursor of the next page of a list in the
+// MetadataNextCursor header, it is left out when there is no next page
+func sendNextCursor(stream grpc.ServerStream, cursor string) error {
+	if cursor == "" {
+		return nil
+	}
+	return stream.SendHeader(metadata.Pairs(MetadataNextCursor, cursor))
+}
+
+// statusError converts known errors into a grpc status error with a matching code
+func statusError(err error) error {
+	var leaseConflict usecase.LeaseConflictError
+	switch {
+	case errors.As(err, &leaseConflict):
+		// the request is valid but the property is not free for the requested term
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrApplicationStatus), errors.Is(err, entity.ErrApplicationNotReady):
+		// the application is not in a status which allows the request
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, usecase.ErrRemoveLeasedProperty), errors.Is(err, usecase.ErrRemoveLeasedTenant):
+		// it can be removed once its leases ended
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, usecase.ErrRemoveLeasedUnit):
+		// a unit keeps its leases, it can not be removed once it was leased
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, usecase.ErrStoreRemoved):
+		// it has to be restored before it can be stored again
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrListingNotPublishable):
+		// the listing is valid but is missing what the public needs to see
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, internal.ErrEntityNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, internal.ErrConflict):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, internal.ErrInternal):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, internal.ErrBadRequest), errors.Is(err, internal.ErrEntityInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
@@ -14,8 +14,11 @@ import (
 	pb "github.com/tempcke/rpm/api/rpc/proto"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/internal/repository"
 	"github.com/tempcke/rpm/specifications"
+	"github.com/tempcke/schedule"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
@@ -29,10 +32,10 @@ func TestRPC_Specifications(t *testing.T) {
 	var (
 		repo      = repository.NewInMemoryRepo()
 		server    = rpc.NewServer(actions.NewActionsWithRepo(repo))
-		rpmClient = newClient(t, server)
+		rpmClient = newPIIClient(t, server)
 		driver    = rpc.NewDriver(rpmClient)
 	)
-	specifications.RunAllTests(t, driver, driver)
+	specifications.RunAllTests(t, driver)
 }
 
 func TestRPC_Property(t *testing.T) {
@@ -52,6 +55,17 @@ func TestRPC_Property(t *testing.T) {
 	require.NotNil(t, storeRes)
 	require.Equal(t, p1.ID, storeRes.PropertyID)
 
+	// another property can not have the same address
+	_, err = rpmClient.StoreProperty(ctx, &pb.StorePropertyReq{
+		Property: pb.ToProperty(fake.Property().WithAddress(p1.Address)),
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), p1.ID)
+	_, err = rpmClient.StoreProperty(ctx, &pb.StorePropertyReq{
+		Property: pb.ToProperty(fake.Property().WithZip("1234")),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
 	// GetProperty
 	getRes, err := rpmClient.GetProperty(ctx, &pb.GetPropertyReq{PropertyID: p1.GetID()})
 	require.NoError(t, err)
@@ -103,12 +117,81 @@ func TestRPC_Property(t *testing.T) {
 	// t.Log(s.String())      // rpc error: code = NotFound desc = entity not found: property[some-id]
 	// t.Log(s.Message())     // entity not found: property[some-id]
 	// t.Log(s.Err().Error()) // rpc error: code = NotFound desc = entity not found: property[some-id]
+
+	// ListProperties including removed
+	stream, err = rpmClient.ListProperties(ctx, &pb.ListPropertiesReq{IncludeDeleted: true})
+	require.NoError(t, err)
+	properties = make(map[string]*pb.Property, 0)
+	for {
+		p, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			t.Fatal(err)
+		}
+		properties[p.PropertyID] = p
+	}
+	require.Contains(t, properties, p1.ID)
+	assert.NotEmpty(t, properties[p1.ID].GetDeletedAt())
+
+	// RestoreProperty
+	restoreRes, err := rpmClient.RestoreProperty(ctx, &pb.RestorePropertyReq{PropertyID: p1.ID})
+	require.NoError(t, err)
+	assertPropertyMatch(t, p1, restoreRes.GetProperty())
+	assert.Empty(t, restoreRes.GetProperty().GetDeletedAt())
+	_, err = rpmClient.RestoreProperty(ctx, &pb.RestorePropertyReq{PropertyID: p1.ID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// RemoveProperty with an active lease
+	tenant := fake.Tenant()
+	require.NoError(t, repo.StoreTenant(ctx, tenant))
+	require.NoError(t, repo.StoreLease(ctx, fake.Lease(p1.ID, tenant.ID)))
+	_, err = rpmClient.RemoveProperty(ctx, &pb.RemovePropertyReq{PropertyID: p1.ID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// ListProperties in pages, the next cursor is in the header
+	p2, p3 := fake.Property(), fake.Property()
+	require.NoError(t, repo.StoreProperty(ctx, p2))
+	require.NoError(t, repo.StoreProperty(ctx, p3))
+	page := &pb.Page{PageSize: 2}
+	var ids []string
+	for {
+		stream, err = rpmClient.ListProperties(ctx, &pb.ListPropertiesReq{Page: page})
+		require.NoError(t, err)
+		var n int
+		for {
+			p, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				t.Fatal(err)
+			}
+			ids = append(ids, p.PropertyID)
+			n++
+		}
+		require.LessOrEqual(t, n, 2)
+		md, err := stream.Header()
+		require.NoError(t, err)
+		if len(md.Get(rpc.MetadataNextCursor)) == 0 {
+			break
+		}
+		page.Cursor = md.Get(rpc.MetadataNextCursor)[0]
+	}
+	assert.Equal(t, []string{p1.ID, p2.ID, p3.ID}, ids)
+
+	// ListProperties with a sort it does not have
+	stream, err = rpmClient.ListProperties(ctx, &pb.ListPropertiesReq{Page: &pb.Page{Sort: "name"}})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 func TestRPC_Tenant(t *testing.T) {
 	var (
 		repo      = repository.NewInMemoryRepo()
 		server    = rpc.NewServer(actions.NewActionsWithRepo(repo))
-		rpmClient = newClient(t, server)
+		rpmClient = newPIIClient(t, server)
 	)
 
 	t.Run("success", func(t *testing.T) {
@@ -163,6 +246,530 @@ func TestRPC_Tenant(t *testing.T) {
 		require.True(t, ok, "err was not a grpc status")
 		assert.Equal(t, codes.NotFound.String(), s.Code().String(), err)
 	})
+	t.Run("patch", func(t *testing.T) {
+		in := fake.Tenant()
+		in.DLState = "TX"
+		_, err := rpmClient.StoreTenant(ctx, &pb.StoreTenantReq{Tenant: pb.ToTenant(in)})
+		require.NoError(t, err)
+
+		// only the listed fields change, a listed field left empty is removed
+		req := pb.PatchTenantReq{
+			TenantID: in.GetID(),
+			Tenant:   &pb.Tenant{FullName: "Jane Doe"},
+			Fields:   []string{"fullName", "dlState"},
+		}
+		res, err := rpmClient.PatchTenant(ctx, &req)
+		require.NoError(t, err)
+		out := res.GetTenant().ToTenant()
+		assert.Equal(t, "Jane Doe", out.FullName)
+		assert.Equal(t, "", out.DLState)
+		assert.Equal(t, in.DateOfBirth, out.DateOfBirth)
+
+		req.Fields = []string{"ssn"}
+		_, err = rpmClient.PatchTenant(ctx, &req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("phone", func(t *testing.T) {
+		in := fake.Tenant().WithPhone(fake.Phone())
+		_, err := rpmClient.StoreTenant(ctx, &pb.StoreTenantReq{Tenant: pb.ToTenant(in)})
+		require.NoError(t, err)
+
+		req := pb.AddTenantPhoneReq{TenantID: in.GetID(), Phone: pb.ToPhone(in.Phones[0])}
+		_, err = rpmClient.AddTenantPhone(ctx, &req)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		_, err = rpmClient.UpdateTenantPhone(ctx, &pb.UpdateTenantPhoneReq{
+			TenantID: in.GetID(), Number: "555-010-0000", Phone: req.Phone,
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("list with an invalid date of birth", func(t *testing.T) {
+		stream, err := rpmClient.ListTenants(ctx, &pb.ListTenantsReq{DobFrom: "yesterday"})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("remove tenant on an active lease", func(t *testing.T) {
+		var (
+			tenant = fake.Tenant()
+			lease  = fake.Lease(fake.Property().ID, tenant.ID)
+		)
+		storeParties(t, repo, lease)
+		require.NoError(t, repo.StoreLease(ctx, lease))
+
+		_, err := rpmClient.RemoveTenant(ctx, &pb.RemoveTenantReq{TenantID: tenant.ID})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestRPC_Lease(t *testing.T) {
+	var (
+		repo      = repository.NewInMemoryRepo()
+		server    = rpc.NewServer(actions.NewActionsWithRepo(repo))
+		rpmClient = newClient(t, server)
+		lease     = fake.Lease(entity.NewID(), entity.NewID())
+	)
+	storeParties(t, repo, lease)
+
+	// LeaseProperty
+	storeRes, err := rpmClient.LeaseProperty(ctx, &pb.LeasePropertyReq{Lease: pb.ToLease(lease)})
+	require.NoError(t, err)
+	require.True(t, lease.Equal(storeRes.GetLease().ToLease()))
+
+	// GetLease
+	getRes, err := rpmClient.GetLease(ctx, &pb.GetLeaseReq{LeaseID: lease.ID})
+	require.NoError(t, err)
+	require.True(t, lease.Equal(getRes.GetLease().ToLease()))
+
+	// TerminateLease
+	endDate := lease.StartDate.AddDate(0, 1, 0)
+	termRes, err := rpmClient.TerminateLease(ctx, &pb.TerminateLeaseReq{
+		LeaseID: lease.ID,
+		EndDate: endDate.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, endDate.String(), termRes.GetLease().GetEndDate())
+
+	// RenewLease
+	renewal := entity.NewLeaseRenewal(endDate.AddDate(1, 0, 0)).WithReason("stay another year")
+	renewRes, err := rpmClient.RenewLease(ctx, pb.NewRenewLeaseReq(lease.ID, renewal))
+	require.NoError(t, err)
+	renewed := renewRes.GetLease().ToLease()
+	assert.Equal(t, renewal.LeaseID, renewed.ID)
+	assert.Equal(t, lease.ID, renewed.RenewsID)
+	assert.Equal(t, endDate.Next().String(), renewed.StartDate.String())
+
+	// AmendLease
+	amendment := entity.NewLeaseAmendment(renewed.StartDate.AddDate(0, 1, 0)).WithRent(renewed.RentAmount.Mul(2))
+	amendRes, err := rpmClient.AmendLease(ctx, pb.NewAmendLeaseReq(renewed.ID, amendment))
+	require.NoError(t, err)
+	assert.Equal(t, amendment.RentAmount, amendRes.GetLease().ToLease().RentAmount)
+
+	// GetLease history
+	getRes, err = rpmClient.GetLease(ctx, &pb.GetLeaseReq{LeaseID: lease.ID})
+	require.NoError(t, err)
+	var changes []entity.LeaseChange
+	for _, v := range getRes.GetHistory() {
+		changes = append(changes, v.GetChange())
+	}
+	assert.Equal(t, []entity.LeaseChange{
+		entity.LeaseCreated, entity.LeaseTerminated, entity.LeaseRenewed, entity.LeaseAmended,
+	}, changes)
+	assert.Equal(t, "stay another year", getRes.GetHistory()[2].GetReason())
+
+	// ListLeases in pages by start date, the next cursor is in the header
+	page := &pb.Page{PageSize: 1}
+	var ids []string
+	for {
+		stream, err := rpmClient.ListLeases(ctx, &pb.ListLeasesReq{PropertyID: lease.PropertyID, Page: page})
+		require.NoError(t, err)
+		for {
+			l, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			ids = append(ids, l.GetLeaseID())
+		}
+		md, err := stream.Header()
+		require.NoError(t, err)
+		if len(md.Get(rpc.MetadataNextCursor)) == 0 {
+			break
+		}
+		page.Cursor = md.Get(rpc.MetadataNextCursor)[0]
+	}
+	assert.Equal(t, []string{lease.ID, renewed.ID}, ids)
+
+	t.Run("error codes", func(t *testing.T) {
+		tests := map[string]struct {
+			call func() error
+			code codes.Code
+		}{
+			"get unknown lease": {
+				call: func() error {
+					_, err := rpmClient.GetLease(ctx, &pb.GetLeaseReq{LeaseID: entity.NewID()})
+					return err
+				},
+				code: codes.NotFound,
+			},
+			"unknown property": {
+				call: func() error {
+					in := fake.Lease(entity.NewID(), lease.TenantIDs...).WithID("")
+					_, err := rpmClient.LeaseProperty(ctx, &pb.LeasePropertyReq{Lease: pb.ToLease(in)})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"overlapping lease": {
+				call: func() error {
+					in := fake.Lease(lease.PropertyID, lease.TenantIDs...).WithID("")
+					_, err := rpmClient.LeaseProperty(ctx, &pb.LeasePropertyReq{Lease: pb.ToLease(in)})
+					return err
+				},
+				code: codes.FailedPrecondition,
+			},
+			"list with a sort leases do not have": {
+				call: func() error {
+					stream, err := rpmClient.ListLeases(ctx, &pb.ListLeasesReq{Page: &pb.Page{Sort: "name"}})
+					if err != nil {
+						return err
+					}
+					_, err = stream.Recv()
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"terminate without date": {
+				call: func() error {
+					_, err := rpmClient.TerminateLease(ctx, &pb.TerminateLeaseReq{LeaseID: lease.ID})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"terminate after end": {
+				call: func() error {
+					_, err := rpmClient.TerminateLease(ctx, &pb.TerminateLeaseReq{
+						LeaseID: lease.ID,
+						EndDate: endDate.AddDate(0, 0, 1).String(),
+					})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"renew twice": {
+				call: func() error {
+					_, err := rpmClient.RenewLease(ctx, pb.NewRenewLeaseReq(lease.ID, entity.NewLeaseRenewal(renewal.EndDate)))
+					return err
+				},
+				code: codes.AlreadyExists,
+			},
+			"renew with invalid endDate": {
+				call: func() error {
+					_, err := rpmClient.RenewLease(ctx, &pb.RenewLeaseReq{LeaseID: renewed.ID, EndDate: "next year"})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"amend without a change": {
+				call: func() error {
+					_, err := rpmClient.AmendLease(ctx, pb.NewAmendLeaseReq(renewed.ID, entity.NewLeaseAmendment(renewed.EndDate)))
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+		}
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				err := tc.call()
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err), err)
+			})
+		}
+	})
+}
+
+func TestRPC_Unit(t *testing.T) {
+	var (
+		repo      = repository.NewInMemoryRepo()
+		server    = rpc.NewServer(actions.NewActionsWithRepo(repo))
+		rpmClient = newClient(t, server)
+		p1        = fake.Property()
+	)
+	_, err := rpmClient.StoreProperty(ctx, &pb.StorePropertyReq{Property: pb.ToProperty(p1)})
+	require.NoError(t, err)
+
+	// StoreUnit
+	in := fake.Unit(p1.ID).WithID("")
+	storeRes, err := rpmClient.StoreUnit(ctx, &pb.StoreUnitReq{Unit: pb.ToUnit(in)})
+	require.NoError(t, err)
+	unit := storeRes.GetUnit().ToUnit()
+	require.NotEmpty(t, unit.ID)
+	assert.True(t, in.Equal(unit))
+
+	// LeaseProperty of the unit
+	lease := fake.Lease(p1.ID, entity.NewID()).WithUnit(unit.ID).WithTerm(schedule.Today(), schedule.Date{})
+	storeParties(t, repo, lease)
+	_, err = rpmClient.LeaseProperty(ctx, &pb.LeasePropertyReq{Lease: pb.ToLease(lease)})
+	require.NoError(t, err)
+
+	// GetUnit
+	getRes, err := rpmClient.GetUnit(ctx, &pb.GetUnitReq{PropertyID: p1.ID, UnitID: unit.ID})
+	require.NoError(t, err)
+	assert.True(t, unit.Equal(getRes.GetUnit().ToUnit()))
+	assert.Equal(t, lease.ID, getRes.GetUnit().GetLeaseID())
+
+	// ListUnits along with the default unit of the property
+	stream, err := rpmClient.ListUnits(ctx, &pb.ListUnitsReq{PropertyID: p1.ID})
+	require.NoError(t, err)
+	var units []entity.Unit
+	for {
+		u, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		units = append(units, u.ToUnit())
+	}
+	require.Len(t, units, 2)
+	vacant := units[0]
+	if vacant.ID == unit.ID {
+		vacant = units[1]
+	}
+	assert.False(t, vacant.Occupied())
+
+	// ListLeases of the unit
+	leaseStream, err := rpmClient.ListLeases(ctx, &pb.ListLeasesReq{PropertyID: p1.ID, UnitID: vacant.ID})
+	require.NoError(t, err)
+	_, err = leaseStream.Recv()
+	require.ErrorIs(t, err, io.EOF, "the default unit was not leased")
+
+	// RemoveUnit
+	_, err = rpmClient.RemoveUnit(ctx, &pb.RemoveUnitReq{PropertyID: p1.ID, UnitID: vacant.ID})
+	require.NoError(t, err)
+
+	t.Run("error codes", func(t *testing.T) {
+		tests := map[string]struct {
+			call func() error
+			code codes.Code
+		}{
+			"get removed unit": {
+				call: func() error {
+					_, err := rpmClient.GetUnit(ctx, &pb.GetUnitReq{PropertyID: p1.ID, UnitID: vacant.ID})
+					return err
+				},
+				code: codes.NotFound,
+			},
+			"unit of unknown property": {
+				call: func() error {
+					_, err := rpmClient.StoreUnit(ctx, &pb.StoreUnitReq{Unit: pb.ToUnit(fake.Unit(entity.NewID()))})
+					return err
+				},
+				code: codes.NotFound,
+			},
+			"duplicate number": {
+				call: func() error {
+					dup := entity.NewUnit(p1.ID, unit.Number)
+					_, err := rpmClient.StoreUnit(ctx, &pb.StoreUnitReq{Unit: pb.ToUnit(dup)})
+					return err
+				},
+				code: codes.AlreadyExists,
+			},
+			"invalid unit": {
+				call: func() error {
+					_, err := rpmClient.StoreUnit(ctx, &pb.StoreUnitReq{Unit: pb.ToUnit(unit.WithSqFt(-1))})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"remove leased unit": {
+				call: func() error {
+					_, err := rpmClient.RemoveUnit(ctx, &pb.RemoveUnitReq{PropertyID: p1.ID, UnitID: unit.ID})
+					return err
+				},
+				code: codes.FailedPrecondition,
+			},
+		}
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				err := tc.call()
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err), err)
+			})
+		}
+	})
+}
+
+func TestRPC_Ledger(t *testing.T) {
+	var (
+		repo      = repository.NewInMemoryRepo()
+		server    = rpc.NewServer(actions.NewActionsWithRepo(repo))
+		rpmClient = newClient(t, server)
+		lease     = fake.Lease(entity.NewID(), entity.NewID())
+		charge    = entity.NewLedgerEntry(lease.ID, entity.EntryCharge, lease.RentAmount, lease.StartDate)
+	)
+	storeParties(t, repo, lease)
+	_, err := rpmClient.LeaseProperty(ctx, &pb.LeasePropertyReq{Lease: pb.ToLease(lease)})
+	require.NoError(t, err)
+
+	// PostLedgerEntry
+	postRes, err := rpmClient.PostLedgerEntry(ctx, &pb.PostLedgerEntryReq{Entry: pb.ToLedgerEntry(charge)})
+	require.NoError(t, err)
+	require.True(t, charge.Equal(postRes.GetEntry().ToLedgerEntry()))
+
+	// ReverseLedgerEntry
+	revRes, err := rpmClient.ReverseLedgerEntry(ctx, &pb.ReverseLedgerEntryReq{
+		LeaseID: lease.ID,
+		EntryID: charge.ID,
+		Date:    lease.StartDate.String(),
+		Memo:    "posted twice",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, charge.ID, revRes.GetEntry().GetReversesID())
+
+	// GetBalance
+	balRes, err := rpmClient.GetBalance(ctx, &pb.GetBalanceReq{LeaseID: lease.ID})
+	require.NoError(t, err)
+	assert.Equal(t, lease.RentAmount.Zero(), balRes.GetBalance().ToMoney())
+
+	// GetStatement
+	statement, err := rpmClient.GetStatement(ctx, &pb.GetStatementReq{LeaseID: lease.ID})
+	require.NoError(t, err)
+	require.Len(t, statement.GetLines(), 2)
+	assert.Equal(t, lease.RentAmount, statement.GetLines()[0].GetBalance().ToMoney())
+
+	t.Run("error codes", func(t *testing.T) {
+		tests := map[string]struct {
+			call func() error
+			code codes.Code
+		}{
+			"post to unknown lease": {
+				call: func() error {
+					in := entity.NewLedgerEntry(entity.NewID(), entity.EntryPayment, lease.RentAmount, lease.StartDate)
+					_, err := rpmClient.PostLedgerEntry(ctx, &pb.PostLedgerEntryReq{Entry: pb.ToLedgerEntry(in)})
+					return err
+				},
+				code: codes.NotFound,
+			},
+			"post invalid entry": {
+				call: func() error {
+					in := entity.NewLedgerEntry(lease.ID, entity.EntryPayment, entity.NewMoney(-1, lease.Currency()), lease.StartDate)
+					_, err := rpmClient.PostLedgerEntry(ctx, &pb.PostLedgerEntryReq{Entry: pb.ToLedgerEntry(in)})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"reverse twice": {
+				call: func() error {
+					_, err := rpmClient.ReverseLedgerEntry(ctx, &pb.ReverseLedgerEntryReq{
+						LeaseID: lease.ID,
+						EntryID: charge.ID,
+						Date:    lease.StartDate.String(),
+					})
+					return err
+				},
+				code: codes.AlreadyExists,
+			},
+			"reverse without date": {
+				call: func() error {
+					_, err := rpmClient.ReverseLedgerEntry(ctx, &pb.ReverseLedgerEntryReq{
+						LeaseID: lease.ID,
+						EntryID: charge.ID,
+					})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"statement with invalid date": {
+				call: func() error {
+					_, err := rpmClient.GetStatement(ctx, &pb.GetStatementReq{LeaseID: lease.ID, From: "jan 1"})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+		}
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				err := tc.call()
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err), err)
+			})
+		}
+	})
+}
+
+func TestRPC_LateFee(t *testing.T) {
+	var (
+		repo      = repository.NewInMemoryRepo()
+		server    = rpc.NewServer(actions.NewActionsWithRepo(repo))
+		rpmClient = newClient(t, server)
+		lease     = fake.Lease(entity.NewID(), entity.NewID())
+		policy    = entity.NewLateFeePolicy().ForLease(lease.ID).WithGraceDays(5).WithFlatFee(entity.NewMoney(50, lease.Currency()))
+	)
+	storeParties(t, repo, lease)
+	_, err := rpmClient.LeaseProperty(ctx, &pb.LeasePropertyReq{Lease: pb.ToLease(lease)})
+	require.NoError(t, err)
+
+	// StoreLateFeePolicy
+	storeRes, err := rpmClient.StoreLateFeePolicy(ctx, &pb.StoreLateFeePolicyReq{Policy: pb.ToLateFeePolicy(policy)})
+	require.NoError(t, err)
+	require.True(t, policy.Equal(storeRes.GetPolicy().ToLateFeePolicy()))
+
+	// GetLateFeePolicy
+	getRes, err := rpmClient.GetLateFeePolicy(ctx, &pb.GetLateFeePolicyReq{LeaseID: lease.ID})
+	require.NoError(t, err)
+	assert.True(t, policy.Equal(getRes.GetPolicy().ToLateFeePolicy()))
+
+	// AssessLateFees and ApplyLateFees stream nothing while no rent is late
+	assessStream, err := rpmClient.AssessLateFees(ctx, &pb.AssessLateFeesReq{LeaseID: lease.ID})
+	require.NoError(t, err)
+	_, err = assessStream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	applyStream, err := rpmClient.ApplyLateFees(ctx, &pb.ApplyLateFeesReq{LeaseID: lease.ID})
+	require.NoError(t, err)
+	_, err = applyStream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	t.Run("error codes", func(t *testing.T) {
+		tests := map[string]struct {
+			call func() error
+			code codes.Code
+		}{
+			"store invalid policy": {
+				call: func() error {
+					in := pb.ToLateFeePolicy(policy.WithFlatFee(entity.Money{}))
+					_, err := rpmClient.StoreLateFeePolicy(ctx, &pb.StoreLateFeePolicyReq{Policy: in})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"store for unknown property": {
+				call: func() error {
+					in := pb.ToLateFeePolicy(policy.ForProperty(entity.NewID()))
+					_, err := rpmClient.StoreLateFeePolicy(ctx, &pb.StoreLateFeePolicyReq{Policy: in})
+					return err
+				},
+				code: codes.NotFound,
+			},
+			"get for unknown lease": {
+				call: func() error {
+					_, err := rpmClient.GetLateFeePolicy(ctx, &pb.GetLateFeePolicyReq{LeaseID: entity.NewID()})
+					return err
+				},
+				code: codes.NotFound,
+			},
+			"assess with invalid date": {
+				call: func() error {
+					stream, err := rpmClient.AssessLateFees(ctx, &pb.AssessLateFeesReq{LeaseID: lease.ID, AsOf: "jan 1"})
+					if err != nil {
+						return err
+					}
+					_, err = stream.Recv()
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"apply in the future": {
+				call: func() error {
+					asOf := schedule.Today().AddDate(0, 0, 2).String()
+					stream, err := rpmClient.ApplyLateFees(ctx, &pb.ApplyLateFeesReq{LeaseID: lease.ID, AsOf: asOf})
+					if err != nil {
+						return err
+					}
+					_, err = stream.Recv()
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+		}
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				err := tc.call()
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err), err)
+			})
+		}
+	})
 }
 
 func assertPropertyMatch(t *testing.T, expect entity.Property, actual *pb.Property) {
@@ -175,18 +782,36 @@ func assertPropertyMatch(t *testing.T, expect entity.Property, actual *pb.Proper
 	assert.Equal(t, expect.Zip, actual.Zip)
 
 }
+
+// storeParties stores a property and tenants with the ids of the lease
+// unless they are already stored, a lease is only stored with them
+func storeParties(t testing.TB, repo repository.InMemory, lease entity.Lease) {
+	t.Helper()
+	if _, err := repo.GetProperty(ctx, lease.PropertyID); err != nil {
+		p := fake.Property()
+		p.ID = lease.PropertyID
+		require.NoError(t, repo.StoreProperty(ctx, p))
+	}
+	for _, id := range lease.TenantIDs {
+		if _, err := repo.GetTenant(ctx, id); err != nil {
+			tenant := fake.Tenant()
+			tenant.ID = id
+			require.NoError(t, repo.StoreTenant(ctx, tenant))
+		}
+	}
+}
 func assertTenantMatch(t testing.TB, expect entity.Tenant, actual entity.Tenant) {
 	t.Helper()
 	if !actual.Equal(expect) {
 		t.Fatalf("tenants not equal\ngot  %+v\nwant %+v", actual, expect)
 	}
 }
-func newClient(t testing.TB, server *rpc.Server) pb.RPMClient {
+func newClient(t testing.TB, server *rpc.Server, opts ...grpc.DialOption) pb.RPMClient {
 	// start server
 	const bufSize = 1024 * 1024
 	var (
 		lis = bufconn.Listen(bufSize)
-		s   = grpc.NewServer()
+		s   = grpc.NewServer(grpc.UnaryInterceptor(rpc.ActorInterceptor))
 	)
 
 	pb.RegisterRPMServer(s, server)
@@ -202,7 +827,7 @@ func newClient(t testing.TB, server *rpc.Server) pb.RPMClient {
 		}),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	}
-	conn, err := grpc.DialContext(ctx, "bufnet", dialOpts...)
+	conn, err := grpc.DialContext(ctx, "bufnet", append(dialOpts, opts...)...)
 	if err != nil {
 		t.Fatalf("Failed to dial bufnet: %v", err)
 	}
@@ -210,3 +835,499 @@ func newClient(t testing.TB, server *rpc.Server) pb.RPMClient {
 
 	return pb.NewRPMClient(conn)
 }
+
+// newPIIClient calls the server with credentials granting the pii scope, for
+// tests which compare the personal information returned with what was stored
+func newPIIClient(t testing.TB, server *rpc.Server) pb.RPMClient {
+	creds := rpc.Credentials{Key: piiKey, Secret: piiSecret, Insecure: true}
+	return newClient(t, server.WithPIICredentials(piiKey, piiSecret), grpc.WithPerRPCCredentials(creds))
+}
+
+func TestRPC_Deposit(t *testing.T) {
+	var (
+		repo      = repository.NewInMemoryRepo()
+		server    = rpc.NewServer(actions.NewActionsWithRepo(repo))
+		rpmClient = newClient(t, server)
+		usd       = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		lease     = fake.Lease(entity.NewID(), entity.NewID()).WithDeposit(usd(100000))
+		receipt   = entity.NewDepositReceipt(lease.ID, usd(100000), lease.StartDate).WithMemo("check 1001")
+		disposal  = entity.NewDepositDisposition(lease.ID, lease.EndDate).WithDeduction(
+			entity.NewDeduction(entity.DeductionDamage, usd(110000), "replace carpet"))
+	)
+	storeParties(t, repo, lease)
+	_, err := rpmClient.LeaseProperty(ctx, &pb.LeasePropertyReq{Lease: pb.ToLease(lease)})
+	require.NoError(t, err)
+
+	// RecordDepositReceipt
+	recordRes, err := rpmClient.RecordDepositReceipt(ctx, &pb.RecordDepositReceiptReq{Receipt: pb.ToDepositReceipt(receipt)})
+	require.NoError(t, err)
+	assert.True(t, receipt.Equal(recordRes.GetReceipt().ToDepositReceipt()))
+
+	// DisposeDeposit, deductions beyond the deposit are owed
+	disposeRes, err := rpmClient.DisposeDeposit(ctx, &pb.DisposeDepositReq{Disposition: pb.ToDepositDisposition(disposal)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), disposeRes.GetDisposition().GetRefund().GetAmount())
+	assert.Equal(t, int64(10000), disposeRes.GetDisposition().GetOwed().GetAmount())
+
+	// GetDeposit
+	account, err := rpmClient.GetDeposit(ctx, &pb.GetDepositReq{LeaseID: lease.ID})
+	require.NoError(t, err)
+	assert.Equal(t, entity.DepositDisposed, account.GetStatus())
+	assert.Equal(t, int64(100000), account.GetHeld().GetAmount())
+	require.Len(t, account.GetReceipts(), 1)
+	require.NotNil(t, account.GetDisposition())
+	assert.Equal(t, disposal.ID, account.GetDisposition().GetDispositionID())
+
+	// GetDepositStatement
+	statementRes, err := rpmClient.GetDepositStatement(ctx, &pb.GetDepositStatementReq{LeaseID: lease.ID})
+	require.NoError(t, err)
+	assert.Contains(t, statementRes.GetText(), "Balance owed by tenant")
+
+	t.Run("error codes", func(t *testing.T) {
+		tests := map[string]struct {
+			call func() error
+			code codes.Code
+		}{
+			"record invalid receipt": {
+				call: func() error {
+					in := pb.ToDepositReceipt(receipt.WithID(entity.NewID()).WithAmount(usd(0)))
+					_, err := rpmClient.RecordDepositReceipt(ctx, &pb.RecordDepositReceiptReq{Receipt: in})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"record after disposed": {
+				call: func() error {
+					in := pb.ToDepositReceipt(receipt.WithID(entity.NewID()))
+					_, err := rpmClient.RecordDepositReceipt(ctx, &pb.RecordDepositReceiptReq{Receipt: in})
+					return err
+				},
+				code: codes.AlreadyExists,
+			},
+			"dispose twice": {
+				call: func() error {
+					in := pb.ToDepositDisposition(disposal.WithID(entity.NewID()))
+					_, err := rpmClient.DisposeDeposit(ctx, &pb.DisposeDepositReq{Disposition: in})
+					return err
+				},
+				code: codes.AlreadyExists,
+			},
+			"get for unknown lease": {
+				call: func() error {
+					_, err := rpmClient.GetDeposit(ctx, &pb.GetDepositReq{LeaseID: entity.NewID()})
+					return err
+				},
+				code: codes.NotFound,
+			},
+		}
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				err := tc.call()
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err), err)
+			})
+		}
+	})
+}
+func TestRPC_Application(t *testing.T) {
+	var (
+		repo      = repository.NewInMemoryRepo()
+		server    = rpc.NewServer(actions.NewActionsWithRepo(repo))
+		rpmClient = newPIIClient(t, server)
+		property  = fake.Property()
+		app       = fake.RentalApplication(property.ID).WithApplicant(fake.Applicant())
+	)
+	_, err := rpmClient.StoreProperty(ctx, &pb.StorePropertyReq{Property: pb.ToProperty(property)})
+	require.NoError(t, err)
+
+	// SubmitApplication
+	submitRes, err := rpmClient.SubmitApplication(ctx, &pb.SubmitApplicationReq{Application: pb.ToApplication(app)})
+	require.NoError(t, err)
+	assert.True(t, app.Equal(submitRes.GetApplication().ToRentalApplication()))
+	assert.Equal(t, entity.ApplicationSubmitted, submitRes.GetApplication().GetStatus())
+	assert.NotEmpty(t, submitRes.GetApplication().GetSubmittedAt())
+
+	// UpdateApplicationStatus
+	updateRes, err := rpmClient.UpdateApplicationStatus(ctx, &pb.UpdateApplicationStatusReq{
+		ApplicationID: app.ID,
+		Status:        entity.ApplicationApproved,
+		Note:          "income verified",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, entity.ApplicationApproved, updateRes.GetApplication().GetStatus())
+	require.Len(t, updateRes.GetApplication().GetNotes(), 1)
+
+	// ListApplications
+	stream, err := rpmClient.ListApplications(ctx, &pb.ListApplicationsReq{PropertyID: property.ID, Status: entity.ApplicationApproved})
+	require.NoError(t, err)
+	listed, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, app.ID, listed.GetApplicationID())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	// ConvertApplication
+	convertRes, err := rpmClient.ConvertApplication(ctx, &pb.ConvertApplicationReq{ApplicationID: app.ID})
+	require.NoError(t, err)
+	require.Len(t, convertRes.GetTenants(), 2)
+	assert.Equal(t, app.Applicants[1].FullName, convertRes.GetTenants()[1].GetFullName())
+	assert.Equal(t, convertRes.GetTenants()[0].GetTenantID(), convertRes.GetApplication().GetTenantIDs()[0])
+	assert.Equal(t, property.ID, convertRes.GetLease().GetPropertyID())
+
+	// GetApplication
+	getRes, err := rpmClient.GetApplication(ctx, &pb.GetApplicationReq{ApplicationID: app.ID})
+	require.NoError(t, err)
+	assert.Len(t, getRes.GetApplication().GetTenantIDs(), 2)
+
+	t.Run("error codes", func(t *testing.T) {
+		tests := map[string]struct {
+			call func() error
+			code codes.Code
+		}{
+			"submit for unknown property": {
+				call: func() error {
+					in := pb.ToApplication(fake.RentalApplication(entity.NewID()))
+					_, err := rpmClient.SubmitApplication(ctx, &pb.SubmitApplicationReq{Application: in})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"status after final": {
+				call: func() error {
+					_, err := rpmClient.UpdateApplicationStatus(ctx, &pb.UpdateApplicationStatusReq{
+						ApplicationID: app.ID, Status: entity.ApplicationWithdrawn})
+					return err
+				},
+				code: codes.FailedPrecondition,
+			},
+			"convert twice": {
+				call: func() error {
+					_, err := rpmClient.ConvertApplication(ctx, &pb.ConvertApplicationReq{ApplicationID: app.ID})
+					return err
+				},
+				code: codes.AlreadyExists,
+			},
+			"get unknown": {
+				call: func() error {
+					_, err := rpmClient.GetApplication(ctx, &pb.GetApplicationReq{ApplicationID: entity.NewID()})
+					return err
+				},
+				code: codes.NotFound,
+			},
+		}
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				err := tc.call()
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err), err)
+			})
+		}
+	})
+}
+func TestRPC_Screening(t *testing.T) {
+	var (
+		repo      = repository.NewInMemoryRepo()
+		server    = rpc.NewServer(actions.NewActionsWithRepo(repo))
+		rpmClient = newPIIClient(t, server)
+		property  = fake.Property()
+		income    = entity.NewScreeningRule(entity.CriterionMinIncome, entity.ScreeningActionReview).WithLimit(300)
+		policy    = entity.NewScreeningPolicy(property.ID, income).WithRent(entity.NewMoney(200000, entity.CurrencyUSD))
+	)
+	_, err := rpmClient.StoreProperty(ctx, &pb.StorePropertyReq{Property: pb.ToProperty(property)})
+	require.NoError(t, err)
+
+	// StoreScreeningPolicy
+	storeRes, err := rpmClient.StoreScreeningPolicy(ctx, &pb.StoreScreeningPolicyReq{Policy: pb.ToScreeningPolicy(policy)})
+	require.NoError(t, err)
+	assert.True(t, policy.Equal(storeRes.GetPolicy().ToScreeningPolicy()))
+
+	// GetScreeningPolicy
+	getRes, err := rpmClient.GetScreeningPolicy(ctx, &pb.GetScreeningPolicyReq{PropertyID: property.ID})
+	require.NoError(t, err)
+	assert.True(t, policy.Equal(getRes.GetPolicy().ToScreeningPolicy()))
+
+	// screened on submit, 5,000.00 is less than 3x rent
+	ap := fake.Applicant()
+	ap.MonthlyIncome = entity.NewMoney(500000, entity.CurrencyUSD)
+	app := entity.NewRentalApplication(property.ID, ap).WithMoveInDate(fake.RentalApplication(property.ID).MoveInDate)
+	submitRes, err := rpmClient.SubmitApplication(ctx, &pb.SubmitApplicationReq{Application: pb.ToApplication(app)})
+	require.NoError(t, err)
+	assert.True(t, app.Equal(submitRes.GetApplication().ToRentalApplication()))
+
+	// ListScreeningReports
+	stream, err := rpmClient.ListScreeningReports(ctx, &pb.ListScreeningReportsReq{ApplicationID: app.ID})
+	require.NoError(t, err)
+	listed, err := stream.Recv()
+	require.NoError(t, err)
+	report := listed.ToScreeningReport()
+	assert.Equal(t, entity.ScreeningNeedsReview, report.Result)
+	require.Len(t, report.Findings, 1)
+	assert.Equal(t, income, report.Findings[0].Rule)
+	assert.False(t, report.CreatedAt.IsZero())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	// ScreenApplication
+	screenRes, err := rpmClient.ScreenApplication(ctx, &pb.ScreenApplicationReq{ApplicationID: app.ID})
+	require.NoError(t, err)
+	assert.Equal(t, entity.ScreeningNeedsReview, screenRes.GetReport().GetResult())
+
+	// ListScreeningReports a page at a time, oldest first
+	byApp := filters.NewScreeningReportFilter().WithApplicationID(app.ID)
+	stream, err = rpmClient.ListScreeningReports(ctx, pb.FromScreeningReportFilter(byApp.WithPage(filters.NewPage().WithSize(1))))
+	require.NoError(t, err)
+	listed, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, report.ID, listed.GetReportID())
+	md, err := stream.Header()
+	require.NoError(t, err)
+	require.Len(t, md.Get(rpc.MetadataNextCursor), 1)
+	next := byApp.WithPage(filters.NewPage().WithSize(1).WithCursor(md.Get(rpc.MetadataNextCursor)[0]))
+	stream, err = rpmClient.ListScreeningReports(ctx, pb.FromScreeningReportFilter(next))
+	require.NoError(t, err)
+	listed, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, screenRes.GetReport().GetReportID(), listed.GetReportID())
+
+	t.Run("error codes", func(t *testing.T) {
+		tests := map[string]struct {
+			call func() error
+			code codes.Code
+		}{
+			"invalid policy": {
+				call: func() error {
+					in := pb.ToScreeningPolicy(entity.NewScreeningPolicy(property.ID, income))
+					_, err := rpmClient.StoreScreeningPolicy(ctx, &pb.StoreScreeningPolicyReq{Policy: in})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"no policy": {
+				call: func() error {
+					_, err := rpmClient.GetScreeningPolicy(ctx, &pb.GetScreeningPolicyReq{PropertyID: entity.NewID()})
+					return err
+				},
+				code: codes.NotFound,
+			},
+			"screen unknown": {
+				call: func() error {
+					_, err := rpmClient.ScreenApplication(ctx, &pb.ScreenApplicationReq{ApplicationID: entity.NewID()})
+					return err
+				},
+				code: codes.NotFound,
+			},
+		}
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				err := tc.call()
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err), err)
+			})
+		}
+	})
+}
+func TestRPC_Listing(t *testing.T) {
+	var (
+		repo      = repository.NewInMemoryRepo()
+		server    = rpc.NewServer(actions.NewActionsWithRepo(repo))
+		rpmClient = newClient(t, server)
+		property  = fake.Property()
+		listing   = fake.Listing(property.ID).WithDetails(entity.RentalDetails{AllowPets: true})
+	)
+	_, err := rpmClient.StoreProperty(ctx, &pb.StorePropertyReq{Property: pb.ToProperty(property)})
+	require.NoError(t, err)
+
+	// StoreListing
+	storeRes, err := rpmClient.StoreListing(ctx, &pb.StoreListingReq{Listing: pb.ToListing(listing)})
+	require.NoError(t, err)
+	assert.True(t, listing.Equal(storeRes.GetListing().ToListing()))
+	assert.Equal(t, entity.ListingDraft, storeRes.GetListing().GetStatus())
+
+	// PublishListing
+	publishRes, err := rpmClient.PublishListing(ctx, &pb.PublishListingReq{PropertyID: property.ID})
+	require.NoError(t, err)
+	assert.Equal(t, entity.ListingPublished, publishRes.GetListing().GetStatus())
+	assert.NotEmpty(t, publishRes.GetListing().GetPublishedAt())
+
+	// GetListing
+	getRes, err := rpmClient.GetListing(ctx, &pb.GetListingReq{PropertyID: property.ID})
+	require.NoError(t, err)
+	assert.True(t, listing.Equal(getRes.GetListing().ToListing()))
+
+	// ListPublicListings
+	filter := filters.NewListingFilter().WithCity(property.City).WithPetsAllowed()
+	stream, err := rpmClient.ListPublicListings(ctx, pb.FromListingFilter(filter))
+	require.NoError(t, err)
+	listed, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, listing.ID, listed.ToPublicListing().Listing.ID)
+	assert.Equal(t, property, listed.ToPublicListing().Property)
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	// ListPublicListings a page at a time, a full page has a next cursor
+	stream, err = rpmClient.ListPublicListings(ctx, pb.FromListingFilter(filter.WithPage(filters.NewPage().WithSize(1))))
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	md, err := stream.Header()
+	require.NoError(t, err)
+	require.Len(t, md.Get(rpc.MetadataNextCursor), 1)
+	next := filter.WithPage(filters.NewPage().WithSize(1).WithCursor(md.Get(rpc.MetadataNextCursor)[0]))
+	stream, err = rpmClient.ListPublicListings(ctx, pb.FromListingFilter(next))
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	// UnpublishListing
+	unpublishRes, err := rpmClient.UnpublishListing(ctx, &pb.UnpublishListingReq{PropertyID: property.ID})
+	require.NoError(t, err)
+	assert.Equal(t, entity.ListingDraft, unpublishRes.GetListing().GetStatus())
+	assert.Empty(t, unpublishRes.GetListing().GetPublishedAt())
+
+	t.Run("error codes", func(t *testing.T) {
+		tests := map[string]struct {
+			call func() error
+			code codes.Code
+		}{
+			"invalid listing": {
+				call: func() error {
+					in := pb.ToListing(listing.WithPhoto(entity.ListingPhoto{}))
+					_, err := rpmClient.StoreListing(ctx, &pb.StoreListingReq{Listing: in})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"invalid available from": {
+				call: func() error {
+					in := pb.ToListing(listing)
+					in.AvailableFrom = "next month"
+					_, err := rpmClient.StoreListing(ctx, &pb.StoreListingReq{Listing: in})
+					return err
+				},
+				code: codes.InvalidArgument,
+			},
+			"not publishable": {
+				call: func() error {
+					p := fake.Property()
+					_, err := rpmClient.StoreProperty(ctx, &pb.StorePropertyReq{Property: pb.ToProperty(p)})
+					require.NoError(t, err)
+					in := pb.ToListing(entity.NewListing(p.ID, entity.Money{}, schedule.Date{}))
+					_, err = rpmClient.StoreListing(ctx, &pb.StoreListingReq{Listing: in})
+					require.NoError(t, err)
+					_, err = rpmClient.PublishListing(ctx, &pb.PublishListingReq{PropertyID: p.ID})
+					return err
+				},
+				code: codes.FailedPrecondition,
+			},
+			"no listing": {
+				call: func() error {
+					_, err := rpmClient.GetListing(ctx, &pb.GetListingReq{PropertyID: entity.NewID()})
+					return err
+				},
+				code: codes.NotFound,
+			},
+		}
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				err := tc.call()
+				require.Error(t, err)
+				assert.Equal(t, tc.code, status.Code(err), err)
+			})
+		}
+	})
+}
+
+const (
+	piiKey    = "pii-key"
+	piiSecret = "pii-secret"
+)
+
+func TestRPC_PII(t *testing.T) {
+	var (
+		repo      = repository.NewInMemoryRepo()
+		server    = rpc.NewServer(actions.NewActionsWithRepo(repo)).WithPIICredentials(piiKey, piiSecret)
+		rpmClient = newClient(t, server)
+		piiClient = newPIIClient(t, server)
+		property  = fake.Property()
+		tenant    = fake.Tenant()
+		ap        = fake.Applicant()
+	)
+	tenant.DLNum = "646673153"
+	ap.SSN = "123-45-6789"
+	_, err := rpmClient.StoreProperty(ctx, &pb.StorePropertyReq{Property: pb.ToProperty(property)})
+	require.NoError(t, err)
+	_, err = rpmClient.StoreTenant(ctx, &pb.StoreTenantReq{Tenant: pb.ToTenant(tenant)})
+	require.NoError(t, err)
+
+	t.Run("tenant", func(t *testing.T) {
+		masked, err := rpmClient.GetTenant(ctx, &pb.GetTenantReq{TenantID: tenant.ID})
+		require.NoError(t, err)
+		assert.Equal(t, "*****3153", masked.GetTenant().GetDlNum())
+		assert.Equal(t, "", masked.GetTenant().GetDob())
+
+		got, err := piiClient.GetTenant(ctx, &pb.GetTenantReq{TenantID: tenant.ID})
+		require.NoError(t, err)
+		assertTenantMatch(t, tenant, got.GetTenant().ToTenant())
+
+		stream, err := rpmClient.ListTenants(ctx, &pb.ListTenantsReq{})
+		require.NoError(t, err)
+		for {
+			pbTenant, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "*****3153", pbTenant.GetDlNum())
+		}
+	})
+	t.Run("application", func(t *testing.T) {
+		app := fake.RentalApplication(property.ID).WithApplicant(ap)
+		res, err := rpmClient.SubmitApplication(ctx, &pb.SubmitApplicationReq{Application: pb.ToApplication(app)})
+		require.NoError(t, err)
+		applicants := res.GetApplication().GetApplicants()
+		assert.Equal(t, "***-**-6789", applicants[len(applicants)-1].GetSsn())
+		assert.Equal(t, "", applicants[len(applicants)-1].GetDob())
+
+		got, err := piiClient.GetApplication(ctx, &pb.GetApplicationReq{ApplicationID: app.ID})
+		require.NoError(t, err)
+		applicants = got.GetApplication().GetApplicants()
+		assert.Equal(t, ap.SSN, applicants[len(applicants)-1].GetSsn())
+	})
+	t.Run("pii key without a pii secret grants nothing", func(t *testing.T) {
+		creds := rpc.Credentials{Key: piiKey, Insecure: true}
+		client := newClient(t, rpc.NewServer(actions.NewActionsWithRepo(repo)).WithPIICredentials(piiKey, ""),
+			grpc.WithPerRPCCredentials(creds))
+		got, err := client.GetTenant(ctx, &pb.GetTenantReq{TenantID: tenant.ID})
+		require.NoError(t, err)
+		assert.Equal(t, "*****3153", got.GetTenant().GetDlNum())
+	})
+}
+func TestRPC_Audit(t *testing.T) {
+	var (
+		repo      = repository.NewInMemoryRepo()
+		server    = rpc.NewServer(actions.NewActionsWithRepo(repo))
+		creds     = rpc.Credentials{Key: "key", Secret: "secret", Insecure: true}
+		rpmClient = newClient(t, server, grpc.WithPerRPCCredentials(creds))
+		property  = fake.Property()
+	)
+	_, err := rpmClient.StoreProperty(ctx, &pb.StorePropertyReq{Property: pb.ToProperty(property)})
+	require.NoError(t, err)
+
+	stream, err := rpmClient.ListAudit(ctx, &pb.ListAuditReq{EntityType: audit.EntityProperty, EntityID: property.ID})
+	require.NoError(t, err)
+	var list []*pb.AuditEntry
+	for {
+		e, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		list = append(list, e)
+	}
+	require.Len(t, list, 1)
+	assert.Equal(t, "key", list[0].GetActor())
+	assert.Equal(t, audit.TransportGRPC, list[0].GetTransport())
+	assert.Equal(t, audit.ActionCreate, list[0].GetAction())
+	assert.Contains(t, list[0].GetDiff(), property.Street)
+}
@@ -0,0 +1,85 @@
+// rpmpurge permanently deletes the properties and tenants which were removed
+// longer ago than PURGE_RETENTION, ex: 2160h for 90 days
+//
+// until then a removed property or tenant can still be restored, the ones a
+// lease or rental application refers to are kept so its history stays intact
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	_ "github.com/lib/pq" // db driver
+	"github.com/tempcke/rpm/actions"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/configs"
+	"github.com/tempcke/rpm/internal/db/postgres"
+	"github.com/tempcke/rpm/internal/lib/log"
+	"github.com/tempcke/rpm/internal/repository"
+)
+
+func main() {
+	if err := run(os.Getenv, os.Args[1:]...); err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(
+	envFunc func(string) string,
+	args ...string,
+) error {
+	conf := buildConfig(envFunc, args...)
+	retention, err := time.ParseDuration(conf.GetString(internal.EnvPurgeRetention))
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", internal.EnvPurgeRetention, err)
+	}
+	if retention <= 0 {
+		return errors.New(internal.EnvPurgeRetention + " must be positive")
+	}
+
+	db, err := postgres.NewDB(conf)
+	if err != nil {
+		return fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	var (
+		ctx = context.Background()
+		a   = actions.NewActionsWithRepo(repository.NewPostgresRepo(db))
+	)
+	tenants, err := a.PurgeTenants(ctx, retention)
+	if err != nil {
+		return fmt.Errorf("purge tenants failed: %w", err)
+	}
+	properties, err := a.PurgeProperties(ctx, retention)
+	if err != nil {
+		return fmt.Errorf("purge properties failed: %w", err)
+	}
+	log.Entry().Info("purged removed records", "retention", retention.String(),
+		"tenants", tenants, "properties", properties)
+	return nil
+}
+
+func buildConfig(envFunc func(string) string, args ...string) configs.Config {
+	return configs.New(
+		configs.WithFlagSet(getFlagSet()),
+		configs.WithEnvFunc(envFunc),
+		configs.WithArgs(args), // os.Args[1:] from main()
+	)
+}
+func getFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.String(internal.EnvPurgeRetention, "2160h", "how long removed properties and tenants are kept before they are purged")
+	fs.String(internal.EnvPostgresHost, "localhost", "postgres host")
+	fs.String(internal.EnvPostgresPort, "5432", "postgres port")
+	fs.String(internal.EnvPostgresUser, "postgres", "postgres user")
+	fs.String(internal.EnvPostgresPass, "password", "postgres password")
+	fs.String(internal.EnvPostgresDB, "rpm", "postgres database")
+	fs.String(internal.EnvPostgresSSLMode, "disable", "postgres sslmode")
+	return fs
+}
@@ -0,0 +1,81 @@
+// rpmreencrypt seals the personal information of every tenant and applicant
+// with the current key of PII_KEY_FILE
+//
+// to rotate keys append a new key to the key file, restart the servers so new
+// values are sealed with it, then run this so existing values are too, once it
+// is done the older keys can be removed from the file
+// it also encrypts values stored before encryption was turned on
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	_ "github.com/lib/pq" // db driver
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/configs"
+	"github.com/tempcke/rpm/internal/db/postgres"
+	"github.com/tempcke/rpm/internal/lib/crypt"
+	"github.com/tempcke/rpm/internal/lib/log"
+	"github.com/tempcke/rpm/internal/repository"
+)
+
+func main() {
+	if err := run(os.Getenv, os.Args[1:]...); err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(
+	envFunc func(string) string,
+	args ...string,
+) error {
+	var (
+		conf    = buildConfig(envFunc, args...)
+		keyFile = conf.GetString(internal.EnvPIIKeyFile)
+	)
+	if keyFile == "" {
+		return errors.New(internal.EnvPIIKeyFile + " not configured")
+	}
+	keys, err := crypt.LoadKeyFile(keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", internal.EnvPIIKeyFile, err)
+	}
+
+	db, err := postgres.NewDB(conf)
+	if err != nil {
+		return fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	r := repository.NewPostgresRepo(db).WithPIIEnvelope(crypt.NewEnvelope(keys))
+	n, err := r.ReencryptPII(context.Background())
+	if err != nil {
+		return fmt.Errorf("re-encrypt failed: %w", err)
+	}
+	log.Entry().Info("re-encrypted personal information", "rows", n)
+	return nil
+}
+
+func buildConfig(envFunc func(string) string, args ...string) configs.Config {
+	return configs.New(
+		configs.WithFlagSet(getFlagSet()),
+		configs.WithEnvFunc(envFunc),
+		configs.WithArgs(args), // os.Args[1:] from main()
+	)
+}
+func getFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.String(internal.EnvPIIKeyFile, "", "key file, one <id>:<base64 key> per line, the last is current")
+	fs.String(internal.EnvPostgresHost, "localhost", "postgres host")
+	fs.String(internal.EnvPostgresPort, "5432", "postgres port")
+	fs.String(internal.EnvPostgresUser, "postgres", "postgres user")
+	fs.String(internal.EnvPostgresPass, "password", "postgres password")
+	fs.String(internal.EnvPostgresDB, "rpm", "postgres database")
+	fs.String(internal.EnvPostgresSSLMode, "disable", "postgres sslmode")
+	return fs
+}
@@ -29,7 +29,7 @@ func TestAcceptanceGRPC_specifications(t *testing.T) {
 		t.Skip()
 	}
 	driver := rpcDriver(t)
-	specifications.RunAllTests(t, driver, driver)
+	specifications.RunAllTests(t, driver)
 }
 func rpcDriver(t testing.TB) rpc.Driver {
 	var (
@@ -49,15 +49,23 @@ func newClient(t testing.TB, addr string) (pb.RPMClient, error) {
 		credentialOpt = grpc.WithTransportCredentials(insecure.NewCredentials())
 	)
 
+	piiCreds := rpc.Credentials{
+		Key:      conf.GetString(internal.EnvAPIPIIKey),
+		Secret:   conf.GetString(internal.EnvAPIPIISecret),
+		Insecure: true,
+	}
 	if file := findCertFile(certFile); file != "" {
 		creds, err := credentials.NewClientTLSFromFile(file, "")
 		if err != nil {
 			return nil, err
 		}
 		credentialOpt = grpc.WithTransportCredentials(creds)
+		piiCreds.Insecure = false
 	}
 
-	dialOpts := []grpc.DialOption{credentialOpt}
+	// the specifications compare what was stored with what is returned so
+	// they need to see personal information unmasked
+	dialOpts := []grpc.DialOption{credentialOpt, grpc.WithPerRPCCredentials(piiCreds)}
 	conn, connErr = grpc.Dial(addr, dialOpts...)
 	t.Cleanup(func() {
 		if conn != nil {
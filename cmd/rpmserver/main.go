@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"flag"
@@ -10,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"sync"
+	"time"
 
 	_ "github.com/lib/pq" // db driver
 	"github.com/tempcke/rpm/actions"
@@ -19,6 +21,8 @@ import (
 	"github.com/tempcke/rpm/internal"
 	"github.com/tempcke/rpm/internal/configs"
 	"github.com/tempcke/rpm/internal/db/postgres"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/lib/crypt"
 	"github.com/tempcke/rpm/internal/lib/log"
 	"github.com/tempcke/rpm/internal/repository"
 	"google.golang.org/grpc"
@@ -40,6 +44,7 @@ func run(
 		errChan = make(chan error)
 		conf    = buildConfig(envFunc, args...)
 		logger  = initLogger(conf)
+		events  = eventBus(logger)
 	)
 
 	db, err := postgres.NewDB(conf)
@@ -48,11 +53,18 @@ func run(
 	}
 	defer func() { _ = db.Close() }()
 
+	// refuse to start rather than store personal information unencrypted
+	if _, err := repo(conf, db); err != nil {
+		return err
+	}
+
 	// TODO: graceful shut down
 	go func() { errChan <- openapiServer(conf, db, logger) }()
 
 	go func() { errChan <- grpcServer(conf, db, logger) }()
 
+	go func() { errChan <- relayEvents(context.Background(), conf, db, events, logger) }()
+
 	return <-errChan
 }
 
@@ -63,20 +75,33 @@ func initLogger(conf configs.Config) *slog.Logger {
 	return slog.Default()
 }
 
+// eventBus the outbox is relayed to, subscribers to rental, tenant and lease
+// events are registered here
+func eventBus(log log.SLogger) event.Dispatcher {
+	bus := event.NewDispatcher()
+	bus.Subscribe(func(_ context.Context, e event.Event) error {
+		log.Info("event published", "event", e.Name(), "payload", e)
+		return nil
+	})
+	return bus
+}
+
 func openapiServer(conf Config, db *sql.DB, log log.SLogger) error {
 	var (
-		r    = repo(db)
-		acts = actions.NewActions().
-			WithPropertyRepo(r).WithTenantRepo(r)
 		port      = ":" + conf.GetString(internal.EnvAppPort)
 		apiKey    = conf.GetString(internal.EnvAPIKey)
 		apiSecret = conf.GetString(internal.EnvAPISecret)
+		piiKey    = conf.GetString(internal.EnvAPIPIIKey)
+		piiSecret = conf.GetString(internal.EnvAPIPIISecret)
 	)
 	if port == ":" {
 		return errors.New(internal.EnvAppPort + " not configured")
 	}
-
-	server := rest.NewServer(acts).WithCredentials(apiKey, apiSecret)
+	r, err := repo(conf, db)
+	if err != nil {
+		return err
+	}
+	server := rest.NewServer(actions.NewActionsWithRepo(r)).WithCredentials(apiKey, apiSecret).WithPIICredentials(piiKey, piiSecret)
 
 	log.Info("Listening on " + port)
 	return http.ListenAndServe(port, server.Handler())
@@ -96,16 +121,49 @@ func grpcServer(conf Config, db *sql.DB, log *slog.Logger) error {
 	if err != nil {
 		return err
 	}
+	r, err := repo(conf, db)
+	if err != nil {
+		return err
+	}
 	s := grpc.NewServer(options...)
-	r := repo(db)
-	rpcServer := rpc.NewServer(actions.NewActions().
-		WithPropertyRepo(r).WithTenantRepo(r))
+	rpcServer := rpc.NewServer(actions.NewActionsWithRepo(r)).
+		WithPIICredentials(conf.GetString(internal.EnvAPIPIIKey), conf.GetString(internal.EnvAPIPIISecret))
 	pb.RegisterRPMServer(s, rpcServer)
 
 	log.Info("Listening on " + port)
 	fmt.Println("Listening on " + port)
 	return s.Serve(lis)
 }
+
+// relayEvents delivers the events the servers stored in the outbox to the
+// subscribers of the bus and posts them to the webhooks subscribed to them,
+// a failed delivery is retried on a later tick
+func relayEvents(ctx context.Context, conf Config, db *sql.DB, bus event.Dispatcher, log log.SLogger) error {
+	r, err := repo(conf, db)
+	if err != nil {
+		return err
+	}
+	acts := actions.NewActions().WithOutboxRepo(r).WithWebhookRepo(r)
+	bus.Subscribe(acts.EnqueueWebhooks)
+	ticker := time.NewTicker(relayInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := acts.RelayOutbox(ctx, bus); err != nil {
+				log.Error("failed to relay outbox", "error", err)
+			}
+			if _, err := acts.DeliverWebhooks(ctx); err != nil {
+				log.Error("failed to deliver webhooks", "error", err)
+			}
+		}
+	}
+}
+
+const relayInterval = time.Second
+
 func grpcOptions(conf Config) ([]grpc.ServerOption, error) {
 	var (
 		certFile = conf.GetString(internal.EnvServiceCertFile)
@@ -118,23 +176,47 @@ func grpcOptions(conf Config) ([]grpc.ServerOption, error) {
 	if err != nil {
 		return nil, fmt.Errorf("grpcOptions: credentials.NewServerTLSFromFile failed: %w", err)
 	}
-	return []grpc.ServerOption{grpc.Creds(creds)}, nil
+	return []grpc.ServerOption{grpc.Creds(creds), grpc.UnaryInterceptor(rpc.ActorInterceptor)}, nil
 }
 
 var (
 	repoOnce sync.Once
 	_repo    repository.Postgres
+	_repoErr error
 )
 
-func repo(db *sql.DB) repository.Postgres {
+// appEnvLocal is the only APP_ENV which may store personal information in
+// plaintext, and only when PII_PLAINTEXT is set
+const appEnvLocal = "local"
+
+// repo encrypts personal information with the keys of PII_KEY_FILE, it fails
+// without one unless plaintext was opted into for the local app env
+func repo(conf Config, db *sql.DB) (repository.Postgres, error) {
 	repoOnce.Do(func() {
 		_repo = repository.NewPostgresRepo(db)
+		keyFile := conf.GetString(internal.EnvPIIKeyFile)
+		if keyFile == "" {
+			if !conf.GetBool(internal.EnvPIIPlaintext) || conf.GetString(internal.EnvAppEnv) != appEnvLocal {
+				_repoErr = fmt.Errorf("%s not configured, personal information can only be stored in plaintext with %s=true and %s=%s",
+					internal.EnvPIIKeyFile, internal.EnvPIIPlaintext, internal.EnvAppEnv, appEnvLocal)
+				return
+			}
+			slog.Default().Warn("personal information is stored in plaintext, " + internal.EnvPIIKeyFile + " is not configured")
+			return
+		}
+		keys, err := crypt.LoadKeyFile(keyFile)
+		if err != nil {
+			_repoErr = fmt.Errorf("failed to load %s: %w", internal.EnvPIIKeyFile, err)
+			return
+		}
+		_repo = _repo.WithPIIEnvelope(crypt.NewEnvelope(keys))
 	})
-	return _repo
+	return _repo, _repoErr
 }
 
 type Config interface {
 	GetString(string) string
+	GetBool(string) bool
 }
 
 func buildConfig(envFunc func(string) string, args ...string) configs.Config {
@@ -152,6 +234,10 @@ func getFlagSet() *flag.FlagSet {
 	fs.String(internal.EnvGrpcPort, "8443", "grpc service port")
 	fs.String(internal.EnvAPIKey, "", "api key")
 	fs.String(internal.EnvAPISecret, "", "api secret")
+	fs.String(internal.EnvAPIPIIKey, "", "api key granting the pii scope, personal information is masked for every other key")
+	fs.String(internal.EnvAPIPIISecret, "", "api secret granting the pii scope")
+	fs.String(internal.EnvPIIKeyFile, "", "key file to encrypt personal information with, one <id>:<base64 key> per line, the last is current")
+	fs.String(internal.EnvPIIPlaintext, "false", "store personal information in plaintext when there is no key file, only allowed with APP_ENV=local")
 	fs.String(internal.EnvServiceCertFile, "", "service cert file")
 	fs.String(internal.EnvServiceKeyFile, "", "service key file")
 	fs.String(internal.EnvPostgresHost, "localhost", "postgres host")
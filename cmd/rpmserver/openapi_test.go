@@ -4,11 +4,15 @@
 package main_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tempcke/rpm/api/rest"
+	"github.com/tempcke/rpm/entity/fake"
 	"github.com/tempcke/rpm/internal"
 	"github.com/tempcke/rpm/internal/test"
 	"github.com/tempcke/rpm/specifications"
@@ -27,7 +31,38 @@ func TestAcceptanceOpenAPI_specifications(t *testing.T) {
 		t.Skip()
 	}
 	driver := restDriver() // oapiClient()
-	specifications.RunAllTests(t, driver, driver)
+	specifications.RunAllTests(t, driver)
+}
+
+// TestAcceptanceOpenAPI_units makes sure main() gives the server the unit
+// repo, without it a property gets no default unit and leases no unit
+func TestAcceptanceOpenAPI_units(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	var (
+		ctx    = context.Background()
+		driver = restDriver()
+		p      = fake.Property()
+	)
+	_, err := driver.StoreProperty(ctx, p)
+	require.NoError(t, err)
+	units, err := driver.ListUnits(ctx, p.ID)
+	require.NoError(t, err)
+	require.Len(t, units, 1, "GET /property/{id}/unit lists the default unit")
+
+	tenant, err := driver.StoreTenant(ctx, fake.Tenant())
+	require.NoError(t, err)
+	lease, err := driver.LeaseProperty(ctx, fake.Lease(p.ID, tenant.ID))
+	require.NoError(t, err)
+	assert.Equal(t, units[0].ID, lease.UnitID, "the only unit was leased")
+
+	unit, err := driver.StoreUnit(ctx, fake.Unit(p.ID).WithID(""))
+	require.NoError(t, err)
+	got, err := driver.GetUnit(ctx, p.ID, unit.ID)
+	require.NoError(t, err)
+	assert.True(t, unit.Equal(*got))
+	require.NoError(t, driver.RemoveUnit(ctx, p.ID, unit.ID))
 }
 func restDriver() rest.Driver {
 	return rest.Driver{
@@ -35,5 +70,7 @@ func restDriver() rest.Driver {
 		Client: &http.Client{
 			Timeout: 1 * time.Second,
 		},
+		APIKey:    conf.GetString(internal.EnvAPIPIIKey),
+		APISecret: conf.GetString(internal.EnvAPIPIISecret),
 	}
 }
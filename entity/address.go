@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/search"
+)
+
+// CountryUS is the country of an address which has none
+const CountryUS = "US"
+
+var (
+	zipUS       = regexp.MustCompile(`^[0-9]{5}(-?[0-9]{4})?$`) // 75401, 75401-1234 or 754011234
+	countryCode = regexp.MustCompile(`^[A-Z]{2}$`)              // ISO 3166-1 alpha-2
+)
+
+// Address of a property, it is kept the way it was entered, Normalized is
+// the form used to compare addresses
+type Address struct {
+	Street    string // 1234 N Main st
+	Unit      string // Apt 5, empty when the address is the whole building
+	City      string // Dallas
+	StateCode string // TX
+	Zip       string // 75401 or the ZIP+4 75401-1234
+	Country   string // ISO 3166-1 alpha-2 code, CountryUS when empty
+}
+
+func NewAddress(street, city, state, zip string) Address {
+	return Address{
+		Street:    street,
+		City:      city,
+		StateCode: state,
+		Zip:       zip,
+	}
+}
+func (a Address) WithUnit(unit string) Address       { a.Unit = unit; return a }
+func (a Address) WithCountry(country string) Address { a.Country = country; return a }
+
+// CountryCode is the upper case country of the address, CountryUS when it has none
+func (a Address) CountryCode() string {
+	if c := strings.ToUpper(strings.TrimSpace(a.Country)); c != "" {
+		return c
+	}
+	return CountryUS
+}
+
+// Zip5 is the zip without the +4 of a ZIP+4, other countries get their
+// postal code as it is
+func (a Address) Zip5() string {
+	zip := strings.TrimSpace(a.Zip)
+	if a.CountryCode() == CountryUS && zipUS.MatchString(zip) {
+		return zip[:5]
+	}
+	return zip
+}
+
+// Normalized is the address in the upper case USPS style, street suffixes,
+// directionals and unit designators are abbreviated and punctuation is
+// dropped, ex: "1234 North Main Street." is "1234 N MAIN ST"
+func (a Address) Normalized() Address {
+	zip := strings.ToUpper(strings.Join(strings.Fields(a.Zip), " "))
+	if a.CountryCode() == CountryUS && zipUS.MatchString(zip) && len(zip) > 5 {
+		zip = zip[:5] + "-" + zip[len(zip)-4:]
+	}
+	return Address{
+		Street:    strings.ToUpper(search.Normalize(a.Street)),
+		Unit:      strings.ToUpper(search.Normalize(a.Unit)),
+		City:      strings.ToUpper(strings.Join(search.Tokens(a.City), " ")),
+		StateCode: strings.ToUpper(strings.TrimSpace(a.StateCode)),
+		Zip:       zip,
+		Country:   a.CountryCode(),
+	}
+}
+
+// Key is the same for two addresses of the same place however they were
+// written, the +4 of a ZIP+4 is left out since it is often omitted
+func (a Address) Key() string {
+	n := a.Normalized()
+	return strings.Join([]string{n.Street, n.Unit, n.City, n.StateCode, n.Zip5(), n.Country}, "|")
+}
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field, a US address needs a state code and a 5 digit zip
+// or a ZIP+4
+func (a Address) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if strings.TrimSpace(a.Street) == "" {
+		invalid("street", "is required")
+	}
+	if strings.TrimSpace(a.City) == "" {
+		invalid("city", "is required")
+	}
+	var (
+		country = a.CountryCode()
+		state   = strings.ToUpper(strings.TrimSpace(a.StateCode))
+		zip     = strings.TrimSpace(a.Zip)
+	)
+	if !countryCode.MatchString(country) {
+		invalid("country", "must be a 2 letter ISO 3166 code")
+	}
+	switch {
+	case state == "":
+		invalid("state", "is required")
+	case country == CountryUS && !ValidStateCode(state):
+		invalid("state", "must be a USPS state code, ex: TX")
+	}
+	switch {
+	case zip == "":
+		invalid("zip", "is required")
+	case country == CountryUS && !zipUS.MatchString(zip):
+		invalid("zip", "must be 5 digits or a ZIP+4, ex: 75401-1234")
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+
+func (a Address) Equal(a2 Address) bool {
+	return a.Street == a2.Street &&
+		a.Unit == a2.Unit &&
+		a.City == a2.City &&
+		a.StateCode == a2.StateCode &&
+		a.Zip == a2.Zip &&
+		a.CountryCode() == a2.CountryCode()
+}
+
+// String is the address on one line, the unit follows the street and the
+// country is only added when it is not the US
+func (a Address) String() string {
+	street := a.Street
+	if a.Unit != "" {
+		street += " " + a.Unit
+	}
+	s := fmt.Sprintf("%s, %s, %s %s", street, a.City, a.StateCode, a.Zip)
+	if c := a.CountryCode(); c != CountryUS {
+		s += ", " + c
+	}
+	return s
+}
+
+// ValidStateCode is true for the USPS codes of states, DC, territories and
+// armed forces, the code must be upper case
+func ValidStateCode(code string) bool {
+	return stateCodes[code]
+}
+
+var stateCodes = func() map[string]bool {
+	m := make(map[string]bool)
+	for _, code := range []string{
+		"AL", "AK", "AZ", "AR", "CA", "CO", "CT", "DE", "FL", "GA",
+		"HI", "ID", "IL", "IN", "IA", "KS", "KY", "LA", "ME", "MD",
+		"MA", "MI", "MN", "MS", "MO", "MT", "NE", "NV", "NH", "NJ",
+		"NM", "NY", "NC", "ND", "OH", "OK", "OR", "PA", "RI", "SC",
+		"SD", "TN", "TX", "UT", "VT", "VA", "WA", "WV", "WI", "WY",
+		"DC",                               // district
+		"AS", "GU", "MP", "PR", "VI", "UM", // territories
+		"FM", "MH", "PW", // freely associated states
+		"AA", "AE", "AP", // armed forces
+	} {
+		m[code] = true
+	}
+	return m
+}()
@@ -0,0 +1,97 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+)
+
+func TestAddress_Validate(t *testing.T) {
+	valid := entity.NewAddress("1234 N Main st.", "Dallas", "TX", "75401")
+	require.NoError(t, valid.Validate())
+	for _, a := range []entity.Address{
+		valid.WithUnit("Apt 5"),
+		entity.NewAddress("1234 N Main st.", "Dallas", "tx", "75401-1234"),
+		entity.NewAddress("1234 N Main st.", "Dallas", "TX", "754011234"),
+		entity.NewAddress("10 Queen St W", "Toronto", "ON", "M5H 2N2").WithCountry("ca"),
+	} {
+		assert.NoError(t, a.Validate(), a.String())
+	}
+
+	tests := map[string]struct {
+		address entity.Address
+		fields  []string
+	}{
+		"empty": {
+			address: entity.Address{},
+			fields:  []string{"street", "city", "state", "zip"},
+		},
+		"unknown state": {
+			address: entity.NewAddress("1234 N Main st.", "Dallas", "XX", "75401"),
+			fields:  []string{"state"},
+		},
+		"short zip": {
+			address: entity.NewAddress("1234 N Main st.", "Dallas", "TX", "7540"),
+			fields:  []string{"zip"},
+		},
+		"bad zip+4": {
+			address: entity.NewAddress("1234 N Main st.", "Dallas", "TX", "75401-12"),
+			fields:  []string{"zip"},
+		},
+		"country": {
+			address: valid.WithCountry("USA"),
+			fields:  []string{"country"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.address.Validate()
+			require.ErrorIs(t, err, internal.ErrEntityInvalid)
+			var fields []string
+			for _, fe := range internal.FieldErrors(err) {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
+func TestAddress_Normalized(t *testing.T) {
+	a := entity.NewAddress(" 1234 North  Main Street.", "dallas", "tx", "754011234").WithUnit("Apartment #5")
+	assert.Equal(t, entity.Address{
+		Street:    "1234 N MAIN ST",
+		Unit:      "APT 5",
+		City:      "DALLAS",
+		StateCode: "TX",
+		Zip:       "75401-1234",
+		Country:   entity.CountryUS,
+	}, a.Normalized())
+	assert.Equal(t, "75401", a.Zip5())
+}
+func TestAddress_Key(t *testing.T) {
+	a := entity.NewAddress("123 Main St.", "Dallas", "TX", "75401")
+	for _, same := range []entity.Address{
+		entity.NewAddress("123 main street", "DALLAS", "tx", "75401"),
+		entity.NewAddress("123 Main St", "Dallas", "TX", "75401-1234"),
+		a.WithCountry("us"),
+	} {
+		assert.Equal(t, a.Key(), same.Key(), same.String())
+	}
+	for _, other := range []entity.Address{
+		entity.NewAddress("123 Main Ave", "Dallas", "TX", "75401"),
+		entity.NewAddress("123 N Main St", "Dallas", "TX", "75401"),
+		a.WithUnit("Apt 5"),
+		a.WithCountry("CA"),
+	} {
+		assert.NotEqual(t, a.Key(), other.Key(), other.String())
+	}
+}
+func TestAddress_String(t *testing.T) {
+	a := entity.NewAddress("1234 N Main st.", "Dallas", "TX", "75401")
+	assert.Equal(t, "1234 N Main st., Dallas, TX 75401", a.String())
+	assert.Equal(t, "1234 N Main st. Apt 5, Dallas, TX 75401", a.WithUnit("Apt 5").String())
+	assert.Equal(t, "10 Queen St W, Toronto, ON M5H 2N2, CA",
+		entity.NewAddress("10 Queen St W", "Toronto", "ON", "M5H 2N2").WithCountry("ca").String())
+}
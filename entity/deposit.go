@@ -0,0 +1,300 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+type DepositStatus = string
+type DeductionCategory = string
+
+const (
+	DepositDue      DepositStatus = "due"      // less than the lease deposit has been received
+	DepositHeld     DepositStatus = "held"     // received in full and held until move out
+	DepositDisposed DepositStatus = "disposed" // deductions itemized and the refund decided at move out
+
+	DeductionDamage     DeductionCategory = "damage"
+	DeductionUnpaidRent DeductionCategory = "unpaid_rent"
+	DeductionCleaning   DeductionCategory = "cleaning"
+	DeductionOther      DeductionCategory = "other"
+)
+
+// DepositReceipt records money received toward the lease security deposit
+// the deposit is held apart from the rent ledger until it is disposed at move out
+type DepositReceipt struct {
+	ID        ID
+	LeaseID   ID
+	Amount    Money
+	Date      schedule.Date
+	Memo      string
+	CreatedAt time.Time
+}
+
+func NewDepositReceipt(leaseID ID, amount Money, date schedule.Date) DepositReceipt {
+	return DepositReceipt{
+		ID:      NewID(),
+		LeaseID: leaseID,
+		Amount:  amount,
+		Date:    date,
+	}
+}
+func (r DepositReceipt) WithID(id ID) DepositReceipt {
+	r.ID = id
+	return r
+}
+func (r DepositReceipt) WithAmount(amount Money) DepositReceipt {
+	r.Amount = amount
+	return r
+}
+func (r DepositReceipt) WithMemo(memo string) DepositReceipt {
+	r.Memo = memo
+	return r
+}
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (r DepositReceipt) GetID() ID { return r.ID }
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field
+func (r DepositReceipt) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if r.ID == "" {
+		invalid("id", "is required")
+	}
+	if r.LeaseID == "" {
+		invalid("leaseID", "is required")
+	}
+	if r.Amount.Minor <= 0 {
+		invalid("amount", "must be positive")
+	} else if !IsCurrency(r.Amount.Currency) {
+		invalid("amount", "currency must be an ISO 4217 currency code")
+	}
+	if r.Date.IsZero() {
+		invalid("date", "is required")
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+func (r DepositReceipt) Equal(r2 DepositReceipt) bool {
+	return idEqualOrEmpty(r.ID, r2.ID) &&
+		r.LeaseID == r2.LeaseID &&
+		r.Amount.Equal(r2.Amount) &&
+		r.Date.Equal(r2.Date) &&
+		r.Memo == r2.Memo
+}
+
+// Deduction is one itemized charge taken out of the deposit at move out
+type Deduction struct {
+	Category    DeductionCategory
+	Amount      Money
+	Description string // what the deduction is for, ex: "replace broken window in kitchen"
+}
+
+func NewDeduction(category DeductionCategory, amount Money, description string) Deduction {
+	return Deduction{
+		Category:    category,
+		Amount:      amount,
+		Description: description,
+	}
+}
+
+// DepositDisposition settles the deposit at move out, the deductions are taken
+// from what was held and the rest is refunded, deductions beyond what was held
+// are still owed by the tenant
+type DepositDisposition struct {
+	ID          ID
+	LeaseID     ID
+	MoveOutDate schedule.Date
+	Deductions  []Deduction
+	Held        Money // total received, set by Settle
+	Refund      Money // due back to the tenant, set by Settle
+	Owed        Money // deductions beyond what was held, set by Settle
+	CreatedAt   time.Time
+}
+
+func NewDepositDisposition(leaseID ID, moveOut schedule.Date) DepositDisposition {
+	return DepositDisposition{
+		ID:          NewID(),
+		LeaseID:     leaseID,
+		MoveOutDate: moveOut,
+	}
+}
+func (d DepositDisposition) WithID(id ID) DepositDisposition {
+	d.ID = id
+	return d
+}
+func (d DepositDisposition) WithDeduction(deductions ...Deduction) DepositDisposition {
+	d.Deductions = append(append([]Deduction{}, d.Deductions...), deductions...)
+	return d
+}
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (d DepositDisposition) GetID() ID { return d.ID }
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field, deductions are reported by index ex: deductions[1].amount
+func (d DepositDisposition) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if d.ID == "" {
+		invalid("id", "is required")
+	}
+	if d.LeaseID == "" {
+		invalid("leaseID", "is required")
+	}
+	if d.MoveOutDate.IsZero() {
+		invalid("moveOutDate", "is required")
+	}
+	for i, ded := range d.Deductions {
+		field := "deductions[" + strconv.Itoa(i) + "]."
+		switch ded.Category {
+		case DeductionDamage, DeductionUnpaidRent, DeductionCleaning, DeductionOther:
+		default:
+			invalid(field+"category", "must be one of damage, unpaid_rent, cleaning, other")
+		}
+		if ded.Amount.Minor <= 0 {
+			invalid(field+"amount", "must be positive")
+		}
+		if strings.TrimSpace(ded.Description) == "" {
+			invalid(field+"description", "is required")
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+
+// Settle computes the refund and anything still owed from the amount held,
+// every deduction must be in the same currency as held
+func (d DepositDisposition) Settle(held Money) (DepositDisposition, error) {
+	var errs []error
+	total := held.Zero()
+	for i, ded := range d.Deductions {
+		sum, err := total.Add(ded.Amount)
+		if err != nil {
+			field := "deductions[" + strconv.Itoa(i) + "].amount"
+			errs = append(errs, internal.NewFieldError(field, "must be in the lease currency"))
+			continue
+		}
+		total = sum
+	}
+	if len(errs) > 0 {
+		return d, internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+	}
+	d.Held = held
+	d.Refund = held.Zero()
+	d.Owed = held.Zero()
+	if total.Minor > held.Minor {
+		d.Owed.Minor = total.Minor - held.Minor
+	} else {
+		d.Refund.Minor = held.Minor - total.Minor
+	}
+	return d, nil
+}
+
+// TotalDeductions in the currency of the deductions
+func (d DepositDisposition) TotalDeductions() Money {
+	total := d.Held.Zero()
+	for _, ded := range d.Deductions {
+		total.Minor += ded.Amount.Minor
+		total.Currency = ded.Amount.Currency
+	}
+	return total
+}
+func (d DepositDisposition) Equal(d2 DepositDisposition) bool {
+	if len(d.Deductions) != len(d2.Deductions) {
+		return false
+	}
+	for i := range d.Deductions {
+		if d.Deductions[i].Category != d2.Deductions[i].Category ||
+			!d.Deductions[i].Amount.Equal(d2.Deductions[i].Amount) ||
+			d.Deductions[i].Description != d2.Deductions[i].Description {
+			return false
+		}
+	}
+	return idEqualOrEmpty(d.ID, d2.ID) &&
+		d.LeaseID == d2.LeaseID &&
+		d.MoveOutDate.Equal(d2.MoveOutDate) &&
+		d.Held.Equal(d2.Held) &&
+		d.Refund.Equal(d2.Refund) &&
+		d.Owed.Equal(d2.Owed)
+}
+
+// DepositAccount is everything known about the security deposit of a lease
+type DepositAccount struct {
+	LeaseID     ID
+	Required    Money // the lease deposit
+	Receipts    []DepositReceipt
+	Disposition *DepositDisposition // nil until the deposit is disposed at move out
+}
+
+// Held is the total received toward the deposit
+func (a DepositAccount) Held() Money {
+	held := a.Required.Zero()
+	for _, r := range a.Receipts {
+		held.Minor += r.Amount.Minor
+	}
+	return held
+}
+func (a DepositAccount) Status() DepositStatus {
+	switch {
+	case a.Disposition != nil:
+		return DepositDisposed
+	case a.Held().Minor < a.Required.Minor:
+		return DepositDue
+	}
+	return DepositHeld
+}
+
+// Statement is the itemized deposit statement as plain text, suitable to send
+// to the tenant once the deposit has been disposed
+func (a DepositAccount) Statement() string {
+	var (
+		b    strings.Builder
+		line = func(label string, m Money) {
+			fmt.Fprintf(&b, "  %-44s %20s\n", label, m.String())
+		}
+	)
+	b.WriteString("SECURITY DEPOSIT STATEMENT\n")
+	fmt.Fprintf(&b, "Lease: %s\n", a.LeaseID)
+	if a.Disposition != nil {
+		fmt.Fprintf(&b, "Move out: %s\n", a.Disposition.MoveOutDate)
+	}
+	b.WriteString("\nDeposit received\n")
+	for _, r := range a.Receipts {
+		line(strings.TrimSpace(r.Date.String()+" "+r.Memo), r.Amount)
+	}
+	line("Total held", a.Held())
+	if a.Disposition == nil {
+		fmt.Fprintf(&b, "\nStatus: %s\n", a.Status())
+		return b.String()
+	}
+	d := *a.Disposition
+	b.WriteString("\nDeductions\n")
+	for _, ded := range d.Deductions {
+		line(ded.Category+": "+ded.Description, ded.Amount)
+	}
+	line("Total deductions", d.TotalDeductions())
+	b.WriteString("\n")
+	if d.Owed.Minor > 0 {
+		line("Balance owed by tenant", d.Owed)
+	} else {
+		line("Refund due to tenant", d.Refund)
+	}
+	return b.String()
+}
@@ -0,0 +1,151 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+func TestDepositDisposition_Settle(t *testing.T) {
+	var (
+		usd     = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		moveOut = schedule.NewDate(2024, time.June, 30)
+		base    = entity.NewDepositDisposition(entity.NewID(), moveOut)
+	)
+	tests := map[string]struct {
+		deductions []entity.Deduction
+		refund     int
+		owed       int
+	}{
+		"full refund": {
+			refund: 100000,
+		},
+		"partial refund": {
+			deductions: []entity.Deduction{
+				entity.NewDeduction(entity.DeductionCleaning, usd(15000), "carpet cleaning"),
+				entity.NewDeduction(entity.DeductionDamage, usd(4050), "broken blinds"),
+			},
+			refund: 80950,
+		},
+		"deductions exceed deposit": {
+			deductions: []entity.Deduction{
+				entity.NewDeduction(entity.DeductionUnpaidRent, usd(100000), "june rent"),
+				entity.NewDeduction(entity.DeductionDamage, usd(2500), "hole in wall"),
+			},
+			owed: 2500,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, err := base.WithDeduction(tc.deductions...).Settle(usd(100000))
+			require.NoError(t, err)
+			assert.Equal(t, usd(100000), d.Held)
+			assert.Equal(t, usd(tc.refund), d.Refund)
+			assert.Equal(t, usd(tc.owed), d.Owed)
+		})
+	}
+
+	t.Run("deduction in another currency", func(t *testing.T) {
+		_, err := base.WithDeduction(
+			entity.NewDeduction(entity.DeductionOther, entity.NewMoney(100, "EUR"), "keys"),
+		).Settle(usd(100000))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+		require.Len(t, internal.FieldErrors(err), 1)
+		assert.Equal(t, "deductions[0].amount", internal.FieldErrors(err)[0].Field)
+	})
+}
+func TestDepositDisposition_Validate(t *testing.T) {
+	var (
+		usd   = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		valid = entity.NewDepositDisposition(entity.NewID(), schedule.NewDate(2024, time.June, 30)).
+			WithDeduction(entity.NewDeduction(entity.DeductionCleaning, usd(100), "oven"))
+	)
+	require.NoError(t, valid.Validate())
+
+	tests := map[string]struct {
+		disposition entity.DepositDisposition
+		fields      []string
+	}{
+		"no lease or move out": {
+			disposition: entity.DepositDisposition{ID: valid.ID},
+			fields:      []string{"leaseID", "moveOutDate"},
+		},
+		"invalid deduction": {
+			disposition: valid.WithDeduction(entity.NewDeduction("paint", usd(0), " ")),
+			fields:      []string{"deductions[1].category", "deductions[1].amount", "deductions[1].description"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.disposition.Validate()
+			require.ErrorIs(t, err, internal.ErrEntityInvalid)
+			var fields []string
+			for _, fe := range internal.FieldErrors(err) {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
+func TestDepositReceipt_Validate(t *testing.T) {
+	valid := entity.NewDepositReceipt(entity.NewID(), entity.NewMoney(100, entity.CurrencyUSD), schedule.NewDate(2024, time.June, 1))
+	require.NoError(t, valid.Validate())
+
+	err := entity.DepositReceipt{ID: valid.ID, Amount: entity.NewMoney(-1, entity.CurrencyUSD)}.Validate()
+	require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	var fields []string
+	for _, fe := range internal.FieldErrors(err) {
+		fields = append(fields, fe.Field)
+	}
+	assert.Equal(t, []string{"leaseID", "amount", "date"}, fields)
+}
+func TestDepositAccount(t *testing.T) {
+	var (
+		usd     = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		leaseID = entity.NewID()
+		account = entity.DepositAccount{LeaseID: leaseID, Required: usd(150000)}
+		receipt = func(minor int, day int) entity.DepositReceipt {
+			return entity.NewDepositReceipt(leaseID, usd(minor), schedule.NewDate(2024, time.January, day))
+		}
+	)
+	assert.Equal(t, entity.DepositDue, account.Status())
+
+	account.Receipts = append(account.Receipts, receipt(100000, 1).WithMemo("check 1001"))
+	assert.Equal(t, usd(100000), account.Held())
+	assert.Equal(t, entity.DepositDue, account.Status())
+
+	account.Receipts = append(account.Receipts, receipt(50000, 15))
+	assert.Equal(t, usd(150000), account.Held())
+	assert.Equal(t, entity.DepositHeld, account.Status())
+	assert.Contains(t, account.Statement(), "Status: held")
+
+	d, err := entity.NewDepositDisposition(leaseID, schedule.NewDate(2024, time.December, 31)).
+		WithDeduction(entity.NewDeduction(entity.DeductionCleaning, usd(12500), "carpet cleaning")).
+		Settle(account.Held())
+	require.NoError(t, err)
+	account.Disposition = &d
+	assert.Equal(t, entity.DepositDisposed, account.Status())
+
+	statement := account.Statement()
+	for _, want := range []string{
+		"SECURITY DEPOSIT STATEMENT",
+		"Lease: " + leaseID,
+		"Move out: 2024-12-31",
+		"2024-01-01 check 1001",
+		"1,000.00 USD",
+		"Total held",
+		"1,500.00 USD",
+		"cleaning: carpet cleaning",
+		"125.00 USD",
+		"Refund due to tenant",
+		"1,375.00 USD",
+	} {
+		assert.Contains(t, statement, want)
+	}
+	assert.NotContains(t, statement, "Balance owed")
+}
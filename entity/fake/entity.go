@@ -8,19 +8,24 @@ import (
 
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/internal/test"
+	"github.com/tempcke/schedule"
 )
 
+var states = []string{"TX", "OH", "CA", "NY", "FL", "WA", "IL", "GA"}
+
 func Property() entity.Property {
 	var (
 		scope  = test.RandString(5)
 		number = strconv.Itoa(rand.Intn(80000) + 10000)
 	)
 	return entity.Property{
-		ID:        entity.NewID(),
-		Street:    fmt.Sprintf("%s N %s st.", number[0:3], ucFirst(scope)),
-		City:      ucFirst(scope[1:5]) + " City",
-		StateCode: strings.ToUpper(scope[2:4]),
-		Zip:       number[0:5],
+		ID: entity.NewID(),
+		Address: entity.NewAddress(
+			fmt.Sprintf("%s N %s st.", number[0:3], ucFirst(scope)),
+			ucFirst(scope[1:5])+" City",
+			states[rand.Intn(len(states))],
+			number[0:5],
+		),
 	}
 }
 func Tenant() entity.Tenant {
@@ -38,6 +43,66 @@ func Tenant() entity.Tenant {
 		DLState:     "TX",
 	}
 }
+func Lease(propertyID entity.ID, tenantIDs ...entity.ID) entity.Lease {
+	var (
+		rent      = entity.NewMoney(rand.Intn(100000)+100000, entity.CurrencyUSD)
+		nextMonth = schedule.Today().AddDate(0, 1, 0)
+		start     = schedule.NewDate(nextMonth.Year(), nextMonth.Month(), 1)
+		end       = start.AddDate(1, 0, -1)
+	)
+	return entity.NewLease(propertyID).
+		WithTenant(tenantIDs...).
+		WithTerm(start, end).
+		WithRent(rent).
+		WithDeposit(rent).
+		WithRentInterval(entity.IntervalMonthly)
+}
+func Unit(propertyID entity.ID) entity.Unit {
+	var (
+		beds = rand.Intn(4) + 1
+		rent = entity.NewMoney((rand.Intn(100)+50)*beds*1000, entity.CurrencyUSD)
+	)
+	return entity.NewUnit(propertyID, strconv.Itoa(rand.Intn(900)+100)+strings.ToUpper(LowerString(1))).
+		WithRooms(beds, float64(rand.Intn(beds*2)+2)/2).
+		WithSqFt(beds*400 + rand.Intn(200)).
+		WithRentTarget(rent)
+}
+func Applicant() entity.Applicant {
+	return entity.Applicant{
+		FullName:      FullName(),
+		DateOfBirth:   DateOfBirth(),
+		SSN:           fmt.Sprintf("%03d-%02d-%04d", rand.Intn(899)+100, rand.Intn(89)+10, rand.Intn(8999)+1000),
+		DLNum:         LowerString(8),
+		DLState:       "TX",
+		VehicleCount:  rand.Intn(3),
+		MonthlyIncome: entity.NewMoney(rand.Intn(500000)+300000, entity.CurrencyUSD),
+	}
+}
+func RentalApplication(propertyID entity.ID) entity.RentalApplication {
+	nextMonth := schedule.Today().AddDate(0, 1, 0)
+	return entity.NewRentalApplication(propertyID, Applicant()).
+		WithMoveInDate(schedule.NewDate(nextMonth.Year(), nextMonth.Month(), 1))
+}
+func Listing(propertyID entity.ID) entity.Listing {
+	var (
+		rent      = entity.NewMoney(rand.Intn(100000)+100000, entity.CurrencyUSD)
+		nextMonth = schedule.Today().AddDate(0, 1, 0)
+	)
+	return entity.NewListing(propertyID, rent, schedule.NewDate(nextMonth.Year(), nextMonth.Month(), 1)).
+		WithDetails(entity.RentalDetails{
+			AllowPets:     rand.Intn(2) == 1,
+			ParkingSpaces: rand.Intn(3),
+		}).
+		WithDescription(LowerString(20)).
+		WithPhoto(entity.ListingPhoto{
+			URL:    "https://example.com/" + LowerString(8) + ".jpg",
+			Width:  1024,
+			Height: 768,
+		})
+}
+func Webhook(events ...string) entity.Webhook {
+	return entity.NewWebhook("https://example.com/hooks/"+LowerString(8), LowerString(24), events...)
+}
 func Phone() entity.Phone {
 	n := rand.Intn(8000) + 1000
 	return entity.Phone{
@@ -1,5 +1,7 @@
 package entity
 
+import "github.com/tempcke/rpm/internal"
+
 type Phone struct {
 	Number string // format determined by user input
 	Note   string // any user entered notes
@@ -23,3 +25,13 @@ func (p Phone) AppendNote(note string) Phone {
 func (p Phone) Equal(p2 Phone) bool {
 	return p.Number == p2.Number && p.Note == p2.Note
 }
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// when the phone has no number
+func (p Phone) Validate() error {
+	if p.Number == "" {
+		return internal.NewErrors(internal.ErrEntityInvalid,
+			internal.NewFieldError("number", "is required"))
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+// LateFeeRefPrefix marks ledger entries charged by a LateFeePolicy, the rest
+// of the Ref is the due date of the rent payment which was late
+const LateFeeRefPrefix = "latefee:"
+
+// LateFeePolicy decides what to charge when rent is not paid on time
+// a policy applies to a single lease when LeaseID is set, otherwise to every
+// lease on PropertyID, a lease policy takes precedence over a property policy
+//
+// each late payment is charged FlatFee plus RentPercent of the payment once
+// GraceDays have passed, then DailyFee for every day it remains unpaid,
+// the total for a single payment never exceeds MaxFee when MaxFee is set
+//
+// the fees are in a single currency, the one of the leases the policy applies to
+type LateFeePolicy struct {
+	ID          ID
+	PropertyID  ID
+	LeaseID     ID
+	GraceDays   int   // days after the due date before rent is late
+	FlatFee     Money // per late payment
+	RentPercent int   // basis points of the late payment, 500 is 5%
+	DailyFee    Money // per day after the grace period
+	MaxFee      Money // per late payment, zero is no cap
+	CreatedAt   time.Time
+}
+
+func NewLateFeePolicy() LateFeePolicy {
+	return LateFeePolicy{ID: NewID()}
+}
+func (p LateFeePolicy) WithID(id ID) LateFeePolicy {
+	p.ID = id
+	return p
+}
+func (p LateFeePolicy) ForLease(leaseID ID) LateFeePolicy {
+	p.LeaseID = leaseID
+	p.PropertyID = ""
+	return p
+}
+func (p LateFeePolicy) ForProperty(propertyID ID) LateFeePolicy {
+	p.PropertyID = propertyID
+	p.LeaseID = ""
+	return p
+}
+func (p LateFeePolicy) WithGraceDays(days int) LateFeePolicy {
+	p.GraceDays = days
+	return p
+}
+func (p LateFeePolicy) WithFlatFee(amount Money) LateFeePolicy {
+	p.FlatFee = amount
+	return p
+}
+func (p LateFeePolicy) WithRentPercent(basisPoints int) LateFeePolicy {
+	p.RentPercent = basisPoints
+	return p
+}
+func (p LateFeePolicy) WithDailyFee(amount, maxFee Money) LateFeePolicy {
+	p.DailyFee = amount
+	p.MaxFee = maxFee
+	return p
+}
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (p LateFeePolicy) GetID() ID { return p.ID }
+
+// Currency of the fees, empty when the policy only charges RentPercent which
+// is in the currency of the rent
+func (p LateFeePolicy) Currency() string {
+	for _, fee := range []Money{p.FlatFee, p.DailyFee, p.MaxFee} {
+		if fee.Currency != "" {
+			return fee.Currency
+		}
+	}
+	return ""
+}
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field
+func (p LateFeePolicy) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if p.ID == "" {
+		invalid("id", "is required")
+	}
+	switch {
+	case p.LeaseID == "" && p.PropertyID == "":
+		invalid("leaseID", "leaseID or propertyID is required")
+	case p.LeaseID != "" && p.PropertyID != "":
+		invalid("leaseID", "can not be combined with propertyID")
+	}
+	if p.GraceDays < 0 {
+		invalid("graceDays", "can not be negative")
+	}
+	fee := func(field string, m Money) {
+		switch {
+		case m.IsNegative():
+			invalid(field, "can not be negative")
+		case m.IsZero() && m.Currency == "":
+		case !IsCurrency(m.Currency):
+			invalid(field, "currency must be an ISO 4217 currency code")
+		case m.Currency != p.Currency():
+			invalid(field, "must be in the same currency as the other fees")
+		}
+	}
+	fee("flatFee", p.FlatFee)
+	if p.RentPercent < 0 || p.RentPercent > 10000 {
+		invalid("rentPercent", "must be 0-10000 basis points")
+	}
+	fee("dailyFee", p.DailyFee)
+	fee("maxFee", p.MaxFee)
+	if p.FlatFee.IsZero() && p.RentPercent == 0 && p.DailyFee.IsZero() {
+		invalid("flatFee", "one of flatFee, rentPercent or dailyFee is required")
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+func (p LateFeePolicy) Equal(p2 LateFeePolicy) bool {
+	return idEqualOrEmpty(p.ID, p2.ID) &&
+		p.PropertyID == p2.PropertyID &&
+		p.LeaseID == p2.LeaseID &&
+		p.GraceDays == p2.GraceDays &&
+		p.Currency() == p2.Currency() &&
+		p.FlatFee.Minor == p2.FlatFee.Minor &&
+		p.RentPercent == p2.RentPercent &&
+		p.DailyFee.Minor == p2.DailyFee.Minor &&
+		p.MaxFee.Minor == p2.MaxFee.Minor
+}
+
+// LateFee owed for a single rent payment
+type LateFee struct {
+	DueDate  schedule.Date
+	Unpaid   Money // rent still owed when the grace period ended
+	DaysLate int   // days unpaid after the grace period, through asOf
+	Amount   Money // total fee owed for this payment as of asOf, in the rent currency
+}
+
+// Ref links ledger entries charged for this fee back to the rent payment
+func (f LateFee) Ref() string {
+	return LateFeeRefPrefix + f.DueDate.String()
+}
+
+// Assess the late fees owed as of the end of asOf
+//
+// payments and credits are applied to the oldest rent first, rent is late when
+// the total paid by the end of the grace period is less than the total due
+// through that payment, the fees of the policy must be in the currency of the rent
+func (p LateFeePolicy) Assess(rent []RentDue, ledger Ledger, asOf schedule.Date) []LateFee {
+	var (
+		payments = ledger.payments()
+		totDue   int
+		fees     = make([]LateFee, 0)
+	)
+	for _, due := range rent {
+		if due.DueDate.After(asOf) {
+			break
+		}
+		totDue += due.Amount.Minor
+		graceEnd := due.DueDate.AddDate(0, 0, p.GraceDays)
+		if !asOf.After(graceEnd) {
+			continue
+		}
+		unpaid := totDue + payments.balance(graceEnd)
+		if unpaid <= 0 {
+			continue
+		}
+		fee := LateFee{
+			DueDate:  due.DueDate,
+			Unpaid:   NewMoney(min(unpaid, due.Amount.Minor), due.Amount.Currency),
+			DaysLate: asOf.Sub(graceEnd),
+		}
+		if paidOn := payments.paidOn(totDue, graceEnd, asOf); !paidOn.IsZero() {
+			// the day it was paid in full does not accrue a fee
+			fee.DaysLate = paidOn.Sub(graceEnd) - 1
+		}
+		amount := p.FlatFee.Minor + prorate(due.Amount.Minor, p.RentPercent, 10000) + p.DailyFee.Minor*fee.DaysLate
+		if p.MaxFee.Minor > 0 && amount > p.MaxFee.Minor {
+			amount = p.MaxFee.Minor
+		}
+		fee.Amount = NewMoney(amount, due.Amount.Currency)
+		if amount > 0 {
+			fees = append(fees, fee)
+		}
+	}
+	return fees
+}
+
+// Assessed is the total in minor units charged so far for ref, reversed
+// charges are still counted so a waived fee is never charged again
+func (l Ledger) Assessed(ref string) int {
+	var total int
+	for _, e := range l {
+		if e.Type == EntryCharge && e.Ref == ref && !e.IsReversal() {
+			total += e.Amount.Minor
+		}
+	}
+	return total
+}
+
+// payments is every entry which is not a charge, the negative balance is the
+// total paid toward rent: payments and credits add to it, refunds and
+// reversed payments take away from it
+func (l Ledger) payments() Ledger {
+	var list = make(Ledger, 0, len(l))
+	for _, e := range l.Sorted() {
+		if e.Type != EntryCharge {
+			list = append(list, e)
+		}
+	}
+	return list
+}
+
+// paidOn is the first day after from, through until, when the total paid
+// reached amount, zero when it was not paid in full
+func (l Ledger) paidOn(amount int, from, until schedule.Date) schedule.Date {
+	for _, e := range l {
+		if !e.Date.After(from) || e.Date.After(until) {
+			continue
+		}
+		if -l.balance(e.Date) >= amount {
+			return e.Date
+		}
+	}
+	return schedule.Date{}
+}
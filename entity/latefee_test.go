@@ -0,0 +1,154 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+func TestLateFeePolicy_Assess(t *testing.T) {
+	var (
+		jan1  = schedule.NewDate(2024, time.January, 1)
+		jun30 = schedule.NewDate(2024, time.June, 30)
+		usd   = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		lease = entity.NewLease(entity.NewID()).WithTenant(entity.NewID()).
+			WithRent(entity.NewMoney(1000, entity.CurrencyUSD)).WithRentInterval(entity.IntervalMonthly).
+			WithTerm(jan1, jun30)
+		policy = entity.NewLateFeePolicy().ForLease(lease.ID).
+			WithGraceDays(5).WithFlatFee(usd(50)).WithRentPercent(500).WithDailyFee(usd(10), usd(200))
+		date = func(m time.Month, d int) schedule.Date { return schedule.NewDate(2024, m, d) }
+		pay  = func(amount int, d schedule.Date) entity.LedgerEntry {
+			return entity.NewLedgerEntry(lease.ID, entity.EntryPayment, usd(amount), d)
+		}
+	)
+	rent, err := lease.RentSchedule(entity.NewScheduleOptions())
+	require.NoError(t, err)
+
+	ledger := entity.Ledger{
+		pay(1000, date(time.January, 3)), // on time
+		pay(1000, date(time.February, 10)),
+		// march never paid
+	}
+	tests := map[string]struct {
+		asOf   schedule.Date
+		expect map[string]entity.LateFee // by due date
+	}{
+		"within grace period": {
+			asOf:   date(time.February, 6),
+			expect: map[string]entity.LateFee{},
+		},
+		"first day late": {
+			asOf: date(time.February, 7),
+			expect: map[string]entity.LateFee{
+				"2024-02-01": {Unpaid: usd(1000), DaysLate: 1, Amount: usd(110)},
+			},
+		},
+		"accrual stops once paid": {
+			asOf: date(time.February, 29),
+			expect: map[string]entity.LateFee{
+				"2024-02-01": {Unpaid: usd(1000), DaysLate: 3, Amount: usd(130)},
+			},
+		},
+		"capped": {
+			asOf: date(time.March, 31),
+			expect: map[string]entity.LateFee{
+				"2024-02-01": {Unpaid: usd(1000), DaysLate: 3, Amount: usd(130)},
+				"2024-03-01": {Unpaid: usd(1000), DaysLate: 25, Amount: usd(200)},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fees := policy.Assess(rent, ledger, tc.asOf)
+			require.Len(t, fees, len(tc.expect))
+			for _, fee := range fees {
+				expect, ok := tc.expect[fee.DueDate.String()]
+				require.True(t, ok, "unexpected fee for "+fee.DueDate.String())
+				assert.Equal(t, expect.Unpaid, fee.Unpaid)
+				assert.Equal(t, expect.DaysLate, fee.DaysLate)
+				assert.Equal(t, expect.Amount, fee.Amount)
+				assert.Equal(t, entity.LateFeeRefPrefix+fee.DueDate.String(), fee.Ref())
+			}
+		})
+	}
+	t.Run("overpayment is applied to the next payment", func(t *testing.T) {
+		ledger := entity.Ledger{pay(2000, date(time.January, 2))}
+		fees := policy.Assess(rent, ledger, date(time.February, 29))
+		assert.Len(t, fees, 0)
+	})
+	t.Run("partial payment", func(t *testing.T) {
+		ledger := entity.Ledger{pay(600, date(time.January, 2))}
+		fees := policy.Assess(rent, ledger, date(time.January, 6))
+		assert.Len(t, fees, 0)
+		fees = policy.Assess(rent, ledger, date(time.January, 7))
+		require.Len(t, fees, 1)
+		assert.Equal(t, usd(400), fees[0].Unpaid)
+	})
+	t.Run("assessed counts reversed charges", func(t *testing.T) {
+		fee := entity.NewLedgerEntry(lease.ID, entity.EntryCharge, usd(110), date(time.February, 7)).
+			WithRef(entity.LateFeeRefPrefix + "2024-02-01")
+		ledger := entity.Ledger{fee, fee.Reversal(date(time.February, 8), "waived")}
+		assert.Equal(t, 110, ledger.Assessed(fee.Ref))
+		assert.Equal(t, 0, ledger.Assessed(entity.LateFeeRefPrefix+"2024-03-01"))
+	})
+}
+func TestLateFeePolicy_Validate(t *testing.T) {
+	var (
+		usd   = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		valid = entity.NewLateFeePolicy().ForProperty(entity.NewID()).WithFlatFee(usd(50))
+	)
+	require.NoError(t, valid.Validate())
+
+	tests := map[string]struct {
+		policy entity.LateFeePolicy
+		fields []string
+	}{
+		"no scope": {
+			policy: valid.ForProperty(""),
+			fields: []string{"leaseID"},
+		},
+		"lease and property": {
+			policy: entity.LateFeePolicy{ID: valid.ID, LeaseID: entity.NewID(), PropertyID: entity.NewID(), FlatFee: usd(50)},
+			fields: []string{"leaseID"},
+		},
+		"no fee": {
+			policy: valid.WithFlatFee(entity.Money{}),
+			fields: []string{"flatFee"},
+		},
+		"rent percent only": {
+			policy: valid.WithFlatFee(entity.Money{}).WithRentPercent(500),
+		},
+		"fees in two currencies": {
+			policy: valid.WithDailyFee(entity.NewMoney(10, "EUR"), entity.Money{}),
+			fields: []string{"dailyFee"},
+		},
+		"unknown currency": {
+			policy: valid.WithFlatFee(entity.NewMoney(50, "XYZ")),
+			fields: []string{"flatFee"},
+		},
+		"negative values": {
+			policy: valid.WithGraceDays(-1).WithRentPercent(10001).WithDailyFee(usd(-1), usd(-1)),
+			fields: []string{"graceDays", "rentPercent", "dailyFee", "maxFee"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.fields == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, internal.ErrEntityInvalid)
+			var fields []string
+			for _, fe := range internal.FieldErrors(err) {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
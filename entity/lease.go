@@ -1,19 +1,21 @@
 package entity
 
 import (
+	"github.com/tempcke/rpm/internal"
 	"github.com/tempcke/schedule"
 )
 
 type Lease struct {
 	ID           ID
 	PropertyID   ID
+	UnitID       ID // the unit of the property leased, empty when the whole property is
 	TenantIDs    []ID
 	StartDate    schedule.Date
 	EndDate      schedule.Date
-	Deposit      int    // dollars
-	RentAmount   int    // dollars
-	Currency     string // empty will be considered USD
+	Deposit      Money
+	RentAmount   Money // the lease currency, deposit must be in the same currency
 	RentInterval Interval
+	RenewsID     ID // the lease this one renews, empty for the first lease in a chain
 }
 type Interval = string
 
@@ -30,6 +32,14 @@ func NewLease(propertyID ID) Lease {
 		PropertyID: propertyID,
 	}
 }
+func (l Lease) WithID(id ID) Lease {
+	l.ID = id
+	return l
+}
+func (l Lease) WithUnit(id ID) Lease {
+	l.UnitID = id
+	return l
+}
 func (l Lease) WithTenant(tenantIDs ...ID) Lease {
 	for _, id := range tenantIDs {
 		if !l.HasTenant(id) {
@@ -38,11 +48,21 @@ func (l Lease) WithTenant(tenantIDs ...ID) Lease {
 	}
 	return l
 }
-func (l Lease) WithRent(v int) Lease {
+func (l Lease) WithoutTenant(tenantIDs ...ID) Lease {
+	var kept = make([]ID, 0, len(l.TenantIDs))
+	for _, id := range l.TenantIDs {
+		if !containsID(tenantIDs, id) {
+			kept = append(kept, id)
+		}
+	}
+	l.TenantIDs = kept
+	return l
+}
+func (l Lease) WithRent(v Money) Lease {
 	l.RentAmount = v
 	return l
 }
-func (l Lease) WithDeposit(v int) Lease {
+func (l Lease) WithDeposit(v Money) Lease {
 	l.Deposit = v
 	return l
 }
@@ -52,11 +72,131 @@ func (l Lease) WithTerm(start, end schedule.Date) Lease {
 	return l
 }
 
+// WithCurrency sets the currency of both the rent and the deposit
+func (l Lease) WithCurrency(v string) Lease {
+	l.RentAmount.Currency = v
+	l.Deposit.Currency = v
+	return l
+}
+func (l Lease) WithRentInterval(v Interval) Lease {
+	l.RentInterval = v
+	return l
+}
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (l Lease) GetID() ID { return l.ID }
+
+// Currency of the lease, every amount owed on it is in this currency
+func (l Lease) Currency() string { return l.RentAmount.Currency }
 func (l Lease) HasTenant(id ID) bool {
-	for i := range l.TenantIDs {
-		if l.TenantIDs[i] == id {
+	return containsID(l.TenantIDs, id)
+}
+
+// Overlaps is true when both leases are for the same unit and share at least one day
+// the StartDate and EndDate are both included in the term, an empty EndDate never ends
+// a lease without a unit is for the whole property so it overlaps the leases of every unit
+func (l Lease) Overlaps(l2 Lease) bool {
+	if l.PropertyID != l2.PropertyID {
+		return false
+	}
+	if l.UnitID != "" && l2.UnitID != "" && l.UnitID != l2.UnitID {
+		return false
+	}
+	return dateOnOrBefore(l.StartDate, l2.EndDate) &&
+		dateOnOrBefore(l2.StartDate, l.EndDate)
+}
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field, so they can all be reported at once
+func (l Lease) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if l.ID == "" {
+		invalid("id", "is required")
+	}
+	if l.PropertyID == "" {
+		invalid("propertyID", "is required")
+	}
+	if len(l.TenantIDs) == 0 {
+		invalid("tenantIDs", "must have at least one tenant")
+	}
+	if l.StartDate.IsZero() {
+		invalid("startDate", "is required")
+	}
+	if !l.EndDate.IsZero() && !l.EndDate.After(l.StartDate) {
+		invalid("endDate", "must be after startDate")
+	}
+	if l.Deposit.IsNegative() {
+		invalid("deposit", "must not be negative")
+	} else if l.Deposit != (Money{}) && l.Deposit.Currency != l.Currency() {
+		invalid("deposit", "must be in the same currency as rentAmount")
+	}
+	if l.RentAmount.IsNegative() {
+		invalid("rentAmount", "must not be negative")
+	}
+	if !IsCurrency(l.Currency()) {
+		invalid("currency", "must be an ISO 4217 currency code")
+	}
+	switch l.RentInterval {
+	case IntervalDaily, IntervalWeekly, IntervalMonthly:
+	default:
+		invalid("rentInterval", "must be one of daily, weekly, monthly")
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+
+// InTerm is true when d falls between the StartDate and EndDate inclusive
+func (l Lease) InTerm(d schedule.Date) bool {
+	return !d.Before(l.StartDate) && dateOnOrBefore(d, l.EndDate)
+}
+
+// Ended is true when the term ended before d, a lease without an EndDate never ends
+func (l Lease) Ended(d schedule.Date) bool {
+	return !dateOnOrBefore(d, l.EndDate)
+}
+func (l Lease) Equal(l2 Lease) bool {
+	return idEqualOrEmpty(l.ID, l2.ID) &&
+		l.PropertyID == l2.PropertyID &&
+		idEqualOrEmpty(l.UnitID, l2.UnitID) &&
+		idListEqual(l.TenantIDs, l2.TenantIDs) &&
+		l.StartDate.Equal(l2.StartDate) &&
+		l.EndDate.Equal(l2.EndDate) &&
+		l.Deposit.Equal(l2.Deposit) &&
+		l.RentAmount.Equal(l2.RentAmount) &&
+		l.RentInterval == l2.RentInterval &&
+		l.RenewsID == l2.RenewsID
+}
+
+// dateOnOrBefore treats a zero end date as having no end
+func dateOnOrBefore(d, end schedule.Date) bool {
+	return end.IsZero() || !d.After(end)
+}
+func containsID(ids []ID, id ID) bool {
+	for i := range ids {
+		if ids[i] == id {
 			return true
 		}
 	}
 	return false
 }
+func idListEqual(a, b []ID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[ID]bool, len(a))
+	for _, id := range a {
+		seen[id] = true
+	}
+	for _, id := range b {
+		if !seen[id] {
+			return false
+		}
+	}
+	return true
+}
@@ -3,11 +3,13 @@ package entity_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
 	"github.com/tempcke/schedule"
 )
 
@@ -17,8 +19,8 @@ func TestLease(t *testing.T) {
 		tenant1   = fake.Tenant()
 		tenant2   = fake.Tenant()
 		tenant3   = fake.Tenant()
-		rent      = rand.Intn(1000) + 1000
-		deposit   = rent*3 + rand.Intn(1000)
+		rent      = entity.NewMoney(rand.Intn(100000)+100000, entity.CurrencyUSD)
+		deposit   = rent.Mul(3)
 		nextMonth = schedule.Today().AddDate(0, 1, 0)
 		start     = schedule.NewDate(nextMonth.Year(), nextMonth.Month(), 1)
 		end       = start.AddDate(0, 12, -1)
@@ -45,3 +47,96 @@ func TestLease(t *testing.T) {
 	assert.Equal(t, start, lease.StartDate)
 	assert.Equal(t, end, lease.EndDate)
 }
+
+func TestLease_Overlaps(t *testing.T) {
+	var (
+		propertyID = entity.NewID()
+		jan1       = schedule.NewDate(2024, time.January, 1)
+		jan31      = schedule.NewDate(2024, time.January, 31)
+		feb1       = schedule.NewDate(2024, time.February, 1)
+		feb29      = schedule.NewDate(2024, time.February, 29)
+		noEnd      schedule.Date
+	)
+	tests := map[string]struct {
+		start1, end1, start2, end2 schedule.Date
+		overlaps                   bool
+	}{
+		"same term":           {jan1, jan31, jan1, jan31, true},
+		"back to back":        {jan1, jan31, feb1, feb29, false},
+		"back to back rev":    {feb1, feb29, jan1, jan31, false},
+		"end on start day":    {jan1, feb1, feb1, feb29, true},
+		"inside":              {jan1, feb29, feb1, feb1, true},
+		"open ended before":   {jan1, noEnd, feb1, feb29, true},
+		"open ended after":    {feb1, noEnd, jan1, jan31, false},
+		"both open ended":     {jan1, noEnd, feb1, noEnd, true},
+		"open ended same day": {feb1, noEnd, jan1, feb1, true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			l1 := entity.NewLease(propertyID).WithTerm(tc.start1, tc.end1)
+			l2 := entity.NewLease(propertyID).WithTerm(tc.start2, tc.end2)
+			assert.Equal(t, tc.overlaps, l1.Overlaps(l2))
+			assert.Equal(t, tc.overlaps, l2.Overlaps(l1))
+		})
+	}
+	t.Run("different property", func(t *testing.T) {
+		l1 := entity.NewLease(propertyID).WithTerm(jan1, jan31)
+		l2 := entity.NewLease(entity.NewID()).WithTerm(jan1, jan31)
+		assert.False(t, l1.Overlaps(l2))
+	})
+	t.Run("units", func(t *testing.T) {
+		var (
+			unitA = entity.NewLease(propertyID).WithUnit(entity.NewID()).WithTerm(jan1, jan31)
+			unitB = entity.NewLease(propertyID).WithUnit(entity.NewID()).WithTerm(jan1, jan31)
+			whole = entity.NewLease(propertyID).WithTerm(jan1, jan31)
+		)
+		assert.False(t, unitA.Overlaps(unitB), "units are leased on their own")
+		assert.True(t, unitA.Overlaps(unitA.WithID(entity.NewID())))
+		assert.True(t, whole.Overlaps(unitA), "the whole property includes every unit")
+		assert.True(t, unitB.Overlaps(whole))
+	})
+}
+
+func TestLease_Validate(t *testing.T) {
+	var (
+		valid = fake.Lease(entity.NewID(), entity.NewID())
+		noEnd schedule.Date
+	)
+	require.NoError(t, valid.Validate())
+	require.NoError(t, valid.WithTerm(valid.StartDate, noEnd).Validate())
+
+	noProperty := valid
+	noProperty.PropertyID = ""
+	noTenants := valid
+	noTenants.TenantIDs = nil
+	tests := map[string]struct {
+		lease  entity.Lease
+		fields []string
+	}{
+		"no id":            {valid.WithID(""), []string{"id"}},
+		"no property":      {noProperty, []string{"propertyID"}},
+		"no tenants":       {noTenants, []string{"tenantIDs"}},
+		"no start":         {valid.WithTerm(schedule.Date{}, noEnd), []string{"startDate"}},
+		"end before start": {valid.WithTerm(valid.StartDate, valid.StartDate.AddDate(0, 0, -1)), []string{"endDate"}},
+		"end on start":     {valid.WithTerm(valid.StartDate, valid.StartDate), []string{"endDate"}},
+		"negative amounts": {valid.WithRent(valid.RentAmount.Neg()).WithDeposit(valid.Deposit.Neg()), []string{"deposit", "rentAmount"}},
+		"unknown currency": {valid.WithCurrency("XYZ"), []string{"currency"}},
+		"bad interval":     {valid.WithRentInterval("yearly"), []string{"rentInterval"}},
+		"deposit currency": {valid.WithDeposit(entity.NewMoney(100, "EUR")), []string{"deposit"}},
+		"everything": {
+			entity.Lease{Deposit: entity.NewMoney(-1, ""), RentAmount: entity.NewMoney(-1, "")},
+			[]string{"id", "propertyID", "tenantIDs", "startDate", "deposit", "rentAmount", "currency", "rentInterval"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.lease.Validate()
+			require.ErrorIs(t, err, internal.ErrEntityInvalid)
+			var fields []string
+			for _, fe := range internal.FieldErrors(err) {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package entity
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+type LeaseChange = string
+
+const (
+	LeaseCreated    LeaseChange = "created"    // version 1 of a new lease
+	LeaseRenewed    LeaseChange = "renewed"    // version 1 of a lease which continues an earlier one
+	LeaseAmended    LeaseChange = "amended"    // rent or tenants changed part way through the term
+	LeaseTerminated LeaseChange = "terminated" // ended early
+)
+
+var ErrLeaseNoEndDate = errors.New("lease has no end date to renew from")
+
+// LeaseVersion is the lease as it stood after one change, versions are never
+// edited so the full history of a lease can always be shown
+type LeaseVersion struct {
+	LeaseID   ID
+	Version   int // 1 for the lease as first stored
+	Change    LeaseChange
+	Effective schedule.Date // first day the terms apply, or the last day of a terminated lease
+	Reason    string
+	Terms     Lease
+	CreatedAt time.Time
+}
+
+// NewLeaseVersion is version 1 of the lease, a created or renewed lease
+// takes effect on its StartDate
+func NewLeaseVersion(l Lease) LeaseVersion {
+	change := LeaseCreated
+	if l.RenewsID != "" {
+		change = LeaseRenewed
+	}
+	return LeaseVersion{
+		LeaseID:   l.ID,
+		Version:   1,
+		Change:    change,
+		Effective: l.StartDate,
+		Terms:     l,
+	}
+}
+
+// Next version of the same lease with the changed terms
+func (v LeaseVersion) Next(change LeaseChange, effective schedule.Date, reason string, terms Lease) LeaseVersion {
+	return LeaseVersion{
+		LeaseID:   v.LeaseID,
+		Version:   v.Version + 1,
+		Change:    change,
+		Effective: effective,
+		Reason:    reason,
+		Terms:     terms,
+	}
+}
+
+// GetID of entity, a lease and version number pair is unique
+// method needed to implement entity.Entity
+func (v LeaseVersion) GetID() ID { return v.LeaseID + "#" + strconv.Itoa(v.Version) }
+func (v LeaseVersion) Equal(v2 LeaseVersion) bool {
+	return v.LeaseID == v2.LeaseID &&
+		v.Version == v2.Version &&
+		v.Change == v2.Change &&
+		v.Effective.Equal(v2.Effective) &&
+		v.Reason == v2.Reason &&
+		v.Terms.Equal(v2.Terms)
+}
+
+// LeaseHistory is the version chain of a lease, every version of every lease
+// linked to it by renewal, oldest first
+type LeaseHistory struct {
+	Versions []LeaseVersion
+}
+
+// Current terms of the lease, the latest version of it
+func (h LeaseHistory) Current(leaseID ID) (LeaseVersion, bool) {
+	var (
+		cur   LeaseVersion
+		found bool
+	)
+	for _, v := range h.Versions {
+		if v.LeaseID == leaseID && v.Version > cur.Version {
+			cur, found = v, true
+		}
+	}
+	return cur, found
+}
+
+// RenewedBy is the lease which renews leaseID, empty when it has not been renewed
+func (h LeaseHistory) RenewedBy(leaseID ID) ID {
+	for _, v := range h.Versions {
+		if v.Change == LeaseRenewed && v.Terms.RenewsID == leaseID {
+			return v.LeaseID
+		}
+	}
+	return ""
+}
+
+// RentOn is the rent of leaseID in effect on d, from the latest version
+// which took effect on or before d
+func (h LeaseHistory) RentOn(leaseID ID, d schedule.Date) (Money, bool) {
+	var (
+		cur   LeaseVersion
+		found bool
+	)
+	for _, v := range h.Versions {
+		if v.LeaseID == leaseID && !v.Effective.After(d) && v.Version > cur.Version {
+			cur, found = v, true
+		}
+	}
+	return cur.Terms.RentAmount, found
+}
+
+// LeaseAmendment changes the rent or tenants of a lease from Effective on,
+// a zero RentAmount keeps the current rent
+type LeaseAmendment struct {
+	Effective     schedule.Date
+	RentAmount    Money
+	AddTenants    []ID
+	RemoveTenants []ID
+	Reason        string
+}
+
+func NewLeaseAmendment(effective schedule.Date) LeaseAmendment {
+	return LeaseAmendment{Effective: effective}
+}
+func (a LeaseAmendment) WithRent(v Money) LeaseAmendment {
+	a.RentAmount = v
+	return a
+}
+func (a LeaseAmendment) WithTenantsAdded(ids ...ID) LeaseAmendment {
+	a.AddTenants = append(append([]ID{}, a.AddTenants...), ids...)
+	return a
+}
+func (a LeaseAmendment) WithTenantsRemoved(ids ...ID) LeaseAmendment {
+	a.RemoveTenants = append(append([]ID{}, a.RemoveTenants...), ids...)
+	return a
+}
+func (a LeaseAmendment) WithReason(reason string) LeaseAmendment {
+	a.Reason = reason
+	return a
+}
+
+// Apply the amendment to the current terms of the lease, it returns
+// internal.ErrEntityInvalid with a FieldError for every invalid field
+func (a LeaseAmendment) Apply(l Lease) (Lease, error) {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if a.Effective.IsZero() {
+		invalid("effectiveDate", "is required")
+	} else if !l.InTerm(a.Effective) {
+		invalid("effectiveDate", "must be within the lease term")
+	}
+	if a.RentAmount == (Money{}) && len(a.AddTenants) == 0 && len(a.RemoveTenants) == 0 {
+		invalid("rentAmount", "rent or tenants must change")
+	}
+	if a.RentAmount != (Money{}) {
+		if a.RentAmount.IsNegative() {
+			invalid("rentAmount", "must not be negative")
+		} else if a.RentAmount.Currency != l.Currency() {
+			invalid("rentAmount", "must be in the lease currency")
+		}
+	}
+	for _, id := range a.AddTenants {
+		if l.HasTenant(id) {
+			invalid("addTenantIDs", "tenant["+id+"] is already on the lease")
+		}
+	}
+	for _, id := range a.RemoveTenants {
+		if !l.HasTenant(id) {
+			invalid("removeTenantIDs", "tenant["+id+"] is not on the lease")
+		}
+	}
+	amended := l.WithTenant(a.AddTenants...).WithoutTenant(a.RemoveTenants...)
+	if len(amended.TenantIDs) == 0 && len(l.TenantIDs) > 0 {
+		invalid("removeTenantIDs", "must leave at least one tenant")
+	}
+	if a.RentAmount != (Money{}) {
+		amended = amended.WithRent(a.RentAmount)
+	}
+	if len(errs) > 0 {
+		return l, internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+	}
+	return amended, nil
+}
+
+// LeaseRenewal continues a lease with a new term starting the day after it
+// ends, a zero RentAmount keeps the current rent
+type LeaseRenewal struct {
+	LeaseID    ID // of the new lease
+	EndDate    schedule.Date
+	RentAmount Money
+	Reason     string
+}
+
+func NewLeaseRenewal(endDate schedule.Date) LeaseRenewal {
+	return LeaseRenewal{
+		LeaseID: NewID(),
+		EndDate: endDate,
+	}
+}
+func (r LeaseRenewal) WithLeaseID(id ID) LeaseRenewal {
+	r.LeaseID = id
+	return r
+}
+func (r LeaseRenewal) WithRent(v Money) LeaseRenewal {
+	r.RentAmount = v
+	return r
+}
+func (r LeaseRenewal) WithReason(reason string) LeaseRenewal {
+	r.Reason = reason
+	return r
+}
+
+// Apply the renewal to the lease being renewed, the new lease keeps the
+// property, tenants, deposit and rent interval
+func (r LeaseRenewal) Apply(prev Lease) (Lease, error) {
+	if prev.EndDate.IsZero() {
+		return prev, internal.NewErrors(internal.ErrBadRequest, ErrLeaseNoEndDate)
+	}
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if r.EndDate.IsZero() {
+		invalid("endDate", "is required")
+	} else if !r.EndDate.After(prev.EndDate.Next()) {
+		invalid("endDate", "must be after the renewal starts on "+prev.EndDate.Next().String())
+	}
+	if r.RentAmount != (Money{}) {
+		if r.RentAmount.IsNegative() {
+			invalid("rentAmount", "must not be negative")
+		} else if r.RentAmount.Currency != prev.Currency() {
+			invalid("rentAmount", "must be in the lease currency")
+		}
+	}
+	if len(errs) > 0 {
+		return prev, internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+	}
+	next := prev.WithID(r.LeaseID).WithTerm(prev.EndDate.Next(), r.EndDate)
+	next.TenantIDs = append([]ID{}, prev.TenantIDs...)
+	next.RenewsID = prev.ID
+	if r.RentAmount != (Money{}) {
+		next = next.WithRent(r.RentAmount)
+	}
+	return next, nil
+}
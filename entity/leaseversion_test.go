@@ -0,0 +1,151 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+func TestLeaseAmendment_Apply(t *testing.T) {
+	var (
+		usd     = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		tenant1 = entity.NewID()
+		tenant2 = entity.NewID()
+		start   = schedule.NewDate(2024, time.January, 1)
+		lease   = entity.NewLease(entity.NewID()).
+			WithTenant(tenant1).
+			WithTerm(start, start.AddDate(1, 0, -1)).
+			WithRent(usd(100000)).
+			WithRentInterval(entity.IntervalMonthly)
+		effective = start.AddDate(0, 6, 0)
+	)
+	out, err := entity.NewLeaseAmendment(effective).
+		WithRent(usd(110000)).
+		WithTenantsAdded(tenant2).
+		WithTenantsRemoved(tenant1).
+		Apply(lease)
+	require.NoError(t, err)
+	assert.Equal(t, usd(110000), out.RentAmount)
+	assert.Equal(t, []entity.ID{tenant2}, out.TenantIDs)
+	assert.Equal(t, lease.ID, out.ID)
+	assert.Equal(t, []entity.ID{tenant1}, lease.TenantIDs, "lease unchanged")
+
+	t.Run("rent kept when omitted", func(t *testing.T) {
+		out, err := entity.NewLeaseAmendment(effective).WithTenantsAdded(tenant2).Apply(lease)
+		require.NoError(t, err)
+		assert.Equal(t, lease.RentAmount, out.RentAmount)
+		assert.ElementsMatch(t, []entity.ID{tenant1, tenant2}, out.TenantIDs)
+	})
+
+	tests := map[string]struct {
+		amendment entity.LeaseAmendment
+		fields    []string
+	}{
+		"nothing changes": {
+			amendment: entity.NewLeaseAmendment(effective),
+			fields:    []string{"rentAmount"},
+		},
+		"effective outside term": {
+			amendment: entity.NewLeaseAmendment(lease.EndDate.Next()).WithRent(usd(1)),
+			fields:    []string{"effectiveDate"},
+		},
+		"other currency": {
+			amendment: entity.NewLeaseAmendment(effective).WithRent(entity.NewMoney(1, "EUR")),
+			fields:    []string{"rentAmount"},
+		},
+		"tenants": {
+			amendment: entity.NewLeaseAmendment(effective).
+				WithTenantsAdded(tenant1).
+				WithTenantsRemoved(tenant2),
+			fields: []string{"addTenantIDs", "removeTenantIDs"},
+		},
+		"last tenant removed": {
+			amendment: entity.NewLeaseAmendment(effective).WithTenantsRemoved(tenant1),
+			fields:    []string{"removeTenantIDs"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.amendment.Apply(lease)
+			require.ErrorIs(t, err, internal.ErrEntityInvalid)
+			var fields []string
+			for _, fe := range internal.FieldErrors(err) {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
+func TestLeaseRenewal_Apply(t *testing.T) {
+	var (
+		usd   = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		start = schedule.NewDate(2024, time.January, 1)
+		prev  = entity.NewLease(entity.NewID()).
+			WithTenant(entity.NewID()).
+			WithTerm(start, start.AddDate(1, 0, -1)).
+			WithRent(usd(100000)).
+			WithDeposit(usd(100000)).
+			WithRentInterval(entity.IntervalMonthly)
+		endDate = start.AddDate(2, 0, -1)
+	)
+	next, err := entity.NewLeaseRenewal(endDate).WithRent(usd(105000)).Apply(prev)
+	require.NoError(t, err)
+	assert.NotEqual(t, prev.ID, next.ID)
+	assert.Equal(t, prev.ID, next.RenewsID)
+	assert.Equal(t, schedule.NewDate(2025, time.January, 1), next.StartDate)
+	assert.Equal(t, endDate, next.EndDate)
+	assert.Equal(t, usd(105000), next.RentAmount)
+	assert.Equal(t, prev.Deposit, next.Deposit)
+	assert.Equal(t, prev.TenantIDs, next.TenantIDs)
+	assert.False(t, next.Overlaps(prev))
+	assert.Equal(t, entity.LeaseRenewed, entity.NewLeaseVersion(next).Change)
+
+	t.Run("rent kept when omitted", func(t *testing.T) {
+		next, err := entity.NewLeaseRenewal(endDate).Apply(prev)
+		require.NoError(t, err)
+		assert.Equal(t, prev.RentAmount, next.RentAmount)
+	})
+	t.Run("end before start", func(t *testing.T) {
+		_, err := entity.NewLeaseRenewal(prev.EndDate).Apply(prev)
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+		require.Len(t, internal.FieldErrors(err), 1)
+		assert.Equal(t, "endDate", internal.FieldErrors(err)[0].Field)
+	})
+	t.Run("lease without end", func(t *testing.T) {
+		_, err := entity.NewLeaseRenewal(endDate).Apply(prev.WithTerm(start, schedule.Date{}))
+		require.ErrorIs(t, err, internal.ErrBadRequest)
+		require.ErrorIs(t, err, entity.ErrLeaseNoEndDate)
+	})
+}
+func TestLease_AmendedRentSchedule(t *testing.T) {
+	var (
+		usd   = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		start = schedule.NewDate(2024, time.January, 1)
+		lease = entity.NewLease(entity.NewID()).
+			WithTenant(entity.NewID()).
+			WithTerm(start, start.AddDate(0, 4, -1)).
+			WithRent(usd(100000)).
+			WithRentInterval(entity.IntervalMonthly)
+		v1      = entity.NewLeaseVersion(lease)
+		amended = lease.WithRent(usd(120000))
+		v2      = v1.Next(entity.LeaseAmended, schedule.NewDate(2024, time.March, 1), "", amended)
+		h       = entity.LeaseHistory{Versions: []entity.LeaseVersion{v1, v2}}
+	)
+	list, err := amended.AmendedRentSchedule(entity.NewScheduleOptions(), h)
+	require.NoError(t, err)
+	require.Len(t, list, 4)
+	assert.Equal(t, usd(100000), list[0].Amount)
+	assert.Equal(t, usd(100000), list[1].Amount)
+	assert.Equal(t, usd(120000), list[2].Amount)
+	assert.Equal(t, usd(120000), list[3].Amount)
+
+	cur, ok := h.Current(lease.ID)
+	require.True(t, ok)
+	assert.Equal(t, 2, cur.Version)
+	assert.Empty(t, h.RenewedBy(lease.ID))
+}
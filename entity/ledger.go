@@ -0,0 +1,214 @@
+package entity
+
+import (
+	"sort"
+	"time"
+
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+type EntryType = string
+
+const (
+	EntryCharge  EntryType = "charge"  // rent or fees owed by the tenant
+	EntryPayment EntryType = "payment" // money received from the tenant
+	EntryCredit  EntryType = "credit"  // reduces what the tenant owes without money changing hands
+	EntryRefund  EntryType = "refund"  // money returned to the tenant
+)
+
+// LedgerEntry is one line of a lease ledger, entries are never edited
+// a mistake is corrected by appending a reversing entry
+// amounts and balances are in the lease currency
+type LedgerEntry struct {
+	ID         ID
+	LeaseID    ID
+	Type       EntryType
+	Amount     Money // always positive, Type decides if it raises or lowers the balance
+	Date       schedule.Date
+	Memo       string
+	ReversesID ID     // set when this entry cancels an earlier one
+	Ref        string // what the entry is for, ex: the rent due date a late fee was charged for
+	CreatedAt  time.Time
+}
+
+func NewLedgerEntry(leaseID ID, entryType EntryType, amount Money, date schedule.Date) LedgerEntry {
+	return LedgerEntry{
+		ID:      NewID(),
+		LeaseID: leaseID,
+		Type:    entryType,
+		Amount:  amount,
+		Date:    date,
+	}
+}
+func (e LedgerEntry) WithID(id ID) LedgerEntry {
+	e.ID = id
+	return e
+}
+func (e LedgerEntry) WithMemo(memo string) LedgerEntry {
+	e.Memo = memo
+	return e
+}
+func (e LedgerEntry) WithRef(ref string) LedgerEntry {
+	e.Ref = ref
+	return e
+}
+
+// Reversal returns a new entry which cancels this one on the given date
+func (e LedgerEntry) Reversal(date schedule.Date, memo string) LedgerEntry {
+	return LedgerEntry{
+		ID:         NewID(),
+		LeaseID:    e.LeaseID,
+		Type:       e.Type,
+		Amount:     e.Amount,
+		Date:       date,
+		Memo:       memo,
+		ReversesID: e.ID,
+		Ref:        e.Ref,
+	}
+}
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (e LedgerEntry) GetID() ID { return e.ID }
+func (e LedgerEntry) IsReversal() bool {
+	return e.ReversesID != ""
+}
+
+// SignedAmount is how much the entry changes the balance owed by the tenant
+func (e LedgerEntry) SignedAmount() Money {
+	amount := e.Amount
+	if e.Type == EntryPayment || e.Type == EntryCredit {
+		amount = amount.Neg()
+	}
+	if e.IsReversal() {
+		amount = amount.Neg()
+	}
+	return amount
+}
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field
+func (e LedgerEntry) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if e.ID == "" {
+		invalid("id", "is required")
+	}
+	if e.LeaseID == "" {
+		invalid("leaseID", "is required")
+	}
+	switch e.Type {
+	case EntryCharge, EntryPayment, EntryCredit, EntryRefund:
+	default:
+		invalid("type", "must be one of charge, payment, credit, refund")
+	}
+	if e.Amount.Minor <= 0 {
+		invalid("amount", "must be positive")
+	} else if !IsCurrency(e.Amount.Currency) {
+		invalid("amount", "currency must be an ISO 4217 currency code")
+	}
+	if e.Date.IsZero() {
+		invalid("date", "is required")
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+func (e LedgerEntry) Equal(e2 LedgerEntry) bool {
+	return idEqualOrEmpty(e.ID, e2.ID) &&
+		e.LeaseID == e2.LeaseID &&
+		e.Type == e2.Type &&
+		e.Amount.Equal(e2.Amount) &&
+		e.Date.Equal(e2.Date) &&
+		e.Memo == e2.Memo &&
+		e.ReversesID == e2.ReversesID &&
+		e.Ref == e2.Ref
+}
+
+// Ledger is every entry for a single lease
+type Ledger []LedgerEntry
+
+// Sorted copy of the ledger by Date, then the order the entries were created
+func (l Ledger) Sorted() Ledger {
+	sorted := append(Ledger{}, l...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+	return sorted
+}
+
+// Balance owed as of the end of the given day, a zero date includes every entry
+// the ledger of a lease only has entries in its currency, which is the one given
+func (l Ledger) Balance(currency string, asOf schedule.Date) Money {
+	return NewMoney(l.balance(asOf), currency)
+}
+
+// balance in minor units as of the end of the given day
+func (l Ledger) balance(asOf schedule.Date) int {
+	var balance int
+	for _, e := range l {
+		if asOf.IsZero() || !e.Date.After(asOf) {
+			balance += e.SignedAmount().Minor
+		}
+	}
+	return balance
+}
+
+// Reversed is true when another entry in the ledger reverses id
+func (l Ledger) Reversed(id ID) bool {
+	for _, e := range l {
+		if e.ReversesID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// StatementLine is an entry along with the balance after it was applied
+type StatementLine struct {
+	Entry   LedgerEntry
+	Balance Money
+}
+
+// Statement lists ledger activity between From and Until inclusive
+// a zero From starts at the first entry, a zero Until ends at the last
+type Statement struct {
+	LeaseID        ID
+	From           schedule.Date
+	Until          schedule.Date
+	OpeningBalance Money
+	Lines          []StatementLine
+	ClosingBalance Money
+}
+
+// Statement of the ledger, balances are in the given currency like Balance
+func (l Ledger) Statement(leaseID ID, currency string, from, until schedule.Date) Statement {
+	s := Statement{
+		LeaseID:        leaseID,
+		From:           from,
+		Until:          until,
+		OpeningBalance: NewMoney(0, currency),
+		Lines:          make([]StatementLine, 0),
+	}
+	if !from.IsZero() {
+		s.OpeningBalance = l.Balance(currency, from.AddDate(0, 0, -1))
+	}
+	balance := s.OpeningBalance.Minor
+	for _, e := range l.Sorted() {
+		if (!from.IsZero() && e.Date.Before(from)) || (!until.IsZero() && e.Date.After(until)) {
+			continue
+		}
+		balance += e.SignedAmount().Minor
+		s.Lines = append(s.Lines, StatementLine{Entry: e, Balance: NewMoney(balance, currency)})
+	}
+	s.ClosingBalance = NewMoney(balance, currency)
+	return s
+}
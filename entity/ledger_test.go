@@ -0,0 +1,92 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+func TestLedger(t *testing.T) {
+	var (
+		leaseID = entity.NewID()
+		jan1    = schedule.NewDate(2024, time.January, 1)
+		jan5    = schedule.NewDate(2024, time.January, 5)
+		feb1    = schedule.NewDate(2024, time.February, 1)
+		feb3    = schedule.NewDate(2024, time.February, 3)
+		usd     = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+
+		rentJan  = entity.NewLedgerEntry(leaseID, entity.EntryCharge, usd(1000), jan1)
+		payJan   = entity.NewLedgerEntry(leaseID, entity.EntryPayment, usd(900), jan5)
+		credit   = entity.NewLedgerEntry(leaseID, entity.EntryCredit, usd(50), jan5)
+		rentFeb  = entity.NewLedgerEntry(leaseID, entity.EntryCharge, usd(1000), feb1)
+		badPay   = entity.NewLedgerEntry(leaseID, entity.EntryPayment, usd(1000), feb1)
+		reversal = badPay.Reversal(feb3, "check bounced")
+		refund   = entity.NewLedgerEntry(leaseID, entity.EntryRefund, usd(25), feb3)
+	)
+	// entered out of order
+	ledger := entity.Ledger{reversal, rentFeb, badPay, payJan, rentJan, credit, refund}
+
+	t.Run("signed amounts", func(t *testing.T) {
+		assert.Equal(t, usd(1000), rentJan.SignedAmount())
+		assert.Equal(t, usd(-900), payJan.SignedAmount())
+		assert.Equal(t, usd(-50), credit.SignedAmount())
+		assert.Equal(t, usd(25), refund.SignedAmount())
+		assert.Equal(t, usd(1000), reversal.SignedAmount())
+	})
+	t.Run("reversal", func(t *testing.T) {
+		assert.True(t, reversal.IsReversal())
+		assert.Equal(t, badPay.ID, reversal.ReversesID)
+		assert.Equal(t, badPay.Type, reversal.Type)
+		assert.Equal(t, badPay.Amount, reversal.Amount)
+		assert.NotEqual(t, badPay.ID, reversal.ID)
+		assert.True(t, ledger.Reversed(badPay.ID))
+		assert.False(t, ledger.Reversed(payJan.ID))
+	})
+	t.Run("balance", func(t *testing.T) {
+		assert.Equal(t, usd(1000), ledger.Balance(entity.CurrencyUSD, jan1))
+		assert.Equal(t, usd(50), ledger.Balance(entity.CurrencyUSD, jan5))
+		assert.Equal(t, usd(50), ledger.Balance(entity.CurrencyUSD, feb1))
+		assert.Equal(t, usd(1075), ledger.Balance(entity.CurrencyUSD, feb3))
+		assert.Equal(t, usd(1075), ledger.Balance(entity.CurrencyUSD, schedule.Date{}))
+	})
+	t.Run("statement", func(t *testing.T) {
+		s := ledger.Statement(leaseID, entity.CurrencyUSD, feb1, feb1)
+		assert.Equal(t, usd(50), s.OpeningBalance)
+		require.Len(t, s.Lines, 2)
+		assert.Equal(t, usd(50+s.Lines[0].Entry.SignedAmount().Minor), s.Lines[0].Balance)
+		assert.Equal(t, usd(50), s.Lines[1].Balance)
+		assert.Equal(t, usd(50), s.ClosingBalance)
+
+		all := ledger.Statement(leaseID, entity.CurrencyUSD, schedule.Date{}, schedule.Date{})
+		assert.Equal(t, usd(0), all.OpeningBalance)
+		require.Len(t, all.Lines, len(ledger))
+		assert.Equal(t, rentJan.ID, all.Lines[0].Entry.ID)
+		assert.Equal(t, usd(1000), all.Lines[0].Balance)
+		assert.Equal(t, usd(1075), all.ClosingBalance)
+	})
+}
+
+func TestLedgerEntry_Validate(t *testing.T) {
+	valid := entity.NewLedgerEntry(entity.NewID(), entity.EntryPayment, entity.NewMoney(100, entity.CurrencyUSD), schedule.Today())
+	require.NoError(t, valid.Validate())
+
+	err := entity.LedgerEntry{Type: "fee", Amount: entity.NewMoney(-1, entity.CurrencyUSD)}.Validate()
+	require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	var fields []string
+	for _, fe := range internal.FieldErrors(err) {
+		fields = append(fields, fe.Field)
+	}
+	assert.Equal(t, []string{"id", "leaseID", "type", "amount", "date"}, fields)
+
+	unknown := valid
+	unknown.Amount = entity.NewMoney(100, "XYZ")
+	err = unknown.Validate()
+	require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	require.Len(t, internal.FieldErrors(err), 1)
+	assert.Equal(t, "amount", internal.FieldErrors(err)[0].Field)
+}
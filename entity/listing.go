@@ -0,0 +1,193 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+type ListingStatus = string
+
+const (
+	ListingDraft     ListingStatus = "draft"     // being written, not shown to the public
+	ListingPublished ListingStatus = "published" // shown on the public listings
+)
+
+var ErrListingNotPublishable = errors.New("listing is missing details required to publish it")
+
+// Listing advertises a vacant property, only published listings are shown to
+// the public, Details is the RentalDetails of the property
+type Listing struct {
+	ID            ID
+	PropertyID    ID
+	Details       RentalDetails
+	Rent          Money // asking rent per month
+	AvailableFrom schedule.Date
+	Description   string
+	Photos        []ListingPhoto // in the order they are shown, the first is the cover
+	Status        ListingStatus
+	PublishedAt   time.Time // zero while a draft
+	UpdatedAt     time.Time
+}
+
+// RentalDetails are the house rules and amenities of a property
+type RentalDetails struct {
+	PropertyID    string
+	AllowSmoking  bool
+	AllowPets     bool
+	ParkingSpaces int
+	ParkingDesc   string
+}
+
+// ListingPhoto is the metadata of a photo, the photo itself is hosted elsewhere
+type ListingPhoto struct {
+	URL     string
+	Caption string
+	Width   int // pixels
+	Height  int // pixels
+}
+
+func NewListing(propertyID ID, rent Money, availableFrom schedule.Date) Listing {
+	return Listing{
+		ID:            NewID(),
+		PropertyID:    propertyID,
+		Details:       RentalDetails{PropertyID: propertyID},
+		Rent:          rent,
+		AvailableFrom: availableFrom,
+		Status:        ListingDraft,
+	}
+}
+func (l Listing) WithID(id ID) Listing {
+	l.ID = id
+	return l
+}
+func (l Listing) WithDetails(d RentalDetails) Listing {
+	d.PropertyID = l.PropertyID
+	l.Details = d
+	return l
+}
+func (l Listing) WithDescription(desc string) Listing {
+	l.Description = desc
+	return l
+}
+func (l Listing) WithPhoto(photos ...ListingPhoto) Listing {
+	l.Photos = append(append([]ListingPhoto{}, l.Photos...), photos...)
+	return l
+}
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (l Listing) GetID() ID { return l.ID }
+
+func (l Listing) IsPublished() bool { return l.Status == ListingPublished }
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field, a draft may leave out the rent and available from
+// date, see CanPublish
+func (l Listing) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if l.ID == "" {
+		invalid("id", "is required")
+	}
+	if l.PropertyID == "" {
+		invalid("propertyID", "is required")
+	}
+	switch l.Status {
+	case ListingDraft, ListingPublished:
+	default:
+		invalid("status", "must be one of draft, published")
+	}
+	if !l.Rent.IsZero() || l.Rent.Currency != "" {
+		if err := l.Rent.Validate(); err != nil {
+			invalid("rent", err.Error())
+		} else if l.Rent.IsNegative() {
+			invalid("rent", "can not be negative")
+		}
+	}
+	if l.Details.ParkingSpaces < 0 {
+		invalid("details.parkingSpaces", "can not be negative")
+	}
+	for i, p := range l.Photos {
+		field := fmt.Sprintf("photos[%d].", i)
+		if p.URL == "" {
+			invalid(field+"url", "is required")
+		}
+		if p.Width < 0 {
+			invalid(field+"width", "can not be negative")
+		}
+		if p.Height < 0 {
+			invalid(field+"height", "can not be negative")
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+
+// CanPublish returns internal.ErrEntityInvalid along with a field error for
+// everything which must be set before the listing can be shown to the public
+func (l Listing) CanPublish() error {
+	if err := l.Validate(); err != nil {
+		return err
+	}
+	var errs []error
+	if l.Rent.Minor <= 0 {
+		errs = append(errs, internal.NewFieldError("rent", "is required to publish"))
+	}
+	if l.AvailableFrom.IsZero() {
+		errs = append(errs, internal.NewFieldError("availableFrom", "is required to publish"))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid, ErrListingNotPublishable).Append(errs...)
+}
+
+// Publish the listing as of now, publishing a published listing keeps the
+// time it was first published
+func (l Listing) Publish(now time.Time) (Listing, error) {
+	if err := l.CanPublish(); err != nil {
+		return l, err
+	}
+	if !l.IsPublished() {
+		l.Status = ListingPublished
+		l.PublishedAt = now
+	}
+	return l, nil
+}
+
+// Unpublish turns the listing back into a draft
+func (l Listing) Unpublish() Listing {
+	l.Status = ListingDraft
+	l.PublishedAt = time.Time{}
+	return l
+}
+
+func (l Listing) Equal(l2 Listing) bool {
+	return idEqualOrEmpty(l.ID, l2.ID) &&
+		l.PropertyID == l2.PropertyID &&
+		l.Details == l2.Details &&
+		l.Rent.Equal(l2.Rent) &&
+		l.AvailableFrom.Equal(l2.AvailableFrom) &&
+		l.Description == l2.Description &&
+		photosEqual(l.Photos, l2.Photos)
+}
+
+func photosEqual(p1, p2 []ListingPhoto) bool {
+	if len(p1) != len(p2) {
+		return false
+	}
+	for i := range p1 {
+		if p1[i] != p2[i] {
+			return false
+		}
+	}
+	return true
+}
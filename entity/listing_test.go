@@ -0,0 +1,90 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+func TestListing_Validate(t *testing.T) {
+	valid := fake.Listing(entity.NewID())
+	require.NoError(t, valid.Validate())
+	require.NoError(t, entity.NewListing(valid.PropertyID, entity.Money{}, schedule.Date{}).Validate(),
+		"a draft may leave out the rent and available from date")
+
+	tests := map[string]struct {
+		listing entity.Listing
+		fields  []string
+	}{
+		"no property": {
+			listing: entity.NewListing("", valid.Rent, valid.AvailableFrom),
+			fields:  []string{"propertyID"},
+		},
+		"unknown status": {
+			listing: func() entity.Listing { l := valid; l.Status = "rented"; return l }(),
+			fields:  []string{"status"},
+		},
+		"negative rent": {
+			listing: entity.NewListing(valid.PropertyID, entity.NewMoney(-1, entity.CurrencyUSD), valid.AvailableFrom),
+			fields:  []string{"rent"},
+		},
+		"bad currency": {
+			listing: entity.NewListing(valid.PropertyID, entity.NewMoney(100, "XX"), valid.AvailableFrom),
+			fields:  []string{"rent"},
+		},
+		"negative parking": {
+			listing: valid.WithDetails(entity.RentalDetails{ParkingSpaces: -1}),
+			fields:  []string{"details.parkingSpaces"},
+		},
+		"photo": {
+			listing: valid.WithPhoto(entity.ListingPhoto{Width: -1, Height: -1}),
+			fields:  []string{"photos[1].url", "photos[1].width", "photos[1].height"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.listing.Validate()
+			require.ErrorIs(t, err, internal.ErrEntityInvalid)
+			var fields []string
+			for _, fe := range internal.FieldErrors(err) {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
+
+func TestListing_Publish(t *testing.T) {
+	var (
+		now   = time.Now()
+		draft = fake.Listing(entity.NewID())
+	)
+	published, err := draft.Publish(now)
+	require.NoError(t, err)
+	assert.True(t, published.IsPublished())
+	assert.Equal(t, now, published.PublishedAt)
+	assert.False(t, draft.IsPublished(), "original must not change")
+
+	again, err := published.Publish(now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, now, again.PublishedAt, "keeps the time it was first published")
+
+	unpublished := published.Unpublish()
+	assert.Equal(t, entity.ListingDraft, unpublished.Status)
+	assert.True(t, unpublished.PublishedAt.IsZero())
+
+	_, err = entity.NewListing(draft.PropertyID, entity.Money{}, schedule.Date{}).Publish(now)
+	require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	require.ErrorIs(t, err, entity.ErrListingNotPublishable)
+	var fields []string
+	for _, fe := range internal.FieldErrors(err) {
+		fields = append(fields, fe.Field)
+	}
+	assert.Equal(t, []string{"rent", "availableFrom"}, fields)
+}
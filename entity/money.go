@@ -0,0 +1,228 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	ErrInvalidMoney     = errors.New("invalid money amount")
+	ErrInvalidRatios    = errors.New("ratios must not be negative and must not all be zero")
+)
+
+// Money is an amount in the minor unit of its currency, cents for USD, so
+// there is never any rounding when amounts are stored or added together
+//
+// the zero value has no currency, it can be added to any amount which makes
+// it a convenient starting point for a total
+type Money struct {
+	Minor    int    // minor units of Currency, 125050 is 1,250.50 USD
+	Currency string // ISO 4217 currency code
+}
+
+func NewMoney(minor int, currency string) Money {
+	return Money{Minor: minor, Currency: currency}
+}
+
+// ParseMoney reads a decimal amount such as "1250.5" or "-3" in currency,
+// it fails when the amount has more decimal places than the currency allows
+func ParseMoney(amount, currency string) (Money, error) {
+	var (
+		exp            = CurrencyExponent(currency)
+		neg            = strings.HasPrefix(amount, "-")
+		whole, frac, _ = strings.Cut(strings.TrimPrefix(amount, "-"), ".")
+	)
+	if whole == "" || len(frac) > exp || strings.ContainsAny(whole+frac, "+-") {
+		return Money{}, fmt.Errorf("%w: %q %s", ErrInvalidMoney, amount, currency)
+	}
+	minor, err := strconv.Atoi(whole + frac + strings.Repeat("0", exp-len(frac)))
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q %s", ErrInvalidMoney, amount, currency)
+	}
+	if neg {
+		minor = -minor
+	}
+	return NewMoney(minor, currency), nil
+}
+
+// Zero amount in the same currency
+func (m Money) Zero() Money {
+	return Money{Currency: m.Currency}
+}
+func (m Money) IsZero() bool     { return m.Minor == 0 }
+func (m Money) IsNegative() bool { return m.Minor < 0 }
+func (m Money) Neg() Money {
+	m.Minor = -m.Minor
+	return m
+}
+func (m Money) Mul(n int) Money {
+	m.Minor *= n
+	return m
+}
+func (m Money) Add(m2 Money) (Money, error) {
+	currency, err := m.sameCurrency(m2)
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(m.Minor+m2.Minor, currency), nil
+}
+func (m Money) Sub(m2 Money) (Money, error) {
+	return m.Add(m2.Neg())
+}
+
+// Cmp returns -1, 0 or +1 when m is less than, equal to or greater than m2
+func (m Money) Cmp(m2 Money) (int, error) {
+	if _, err := m.sameCurrency(m2); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.Minor < m2.Minor:
+		return -1, nil
+	case m.Minor > m2.Minor:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Prorate is the part/whole share of m rounded half away from zero to the minor unit
+func (m Money) Prorate(part, whole int) Money {
+	if m.Minor < 0 {
+		return m.Neg().Prorate(part, whole).Neg()
+	}
+	m.Minor = prorate(m.Minor, part, whole)
+	return m
+}
+
+// Allocate splits m into one amount per ratio without losing a minor unit,
+// the remainder is handed out one minor unit at a time from the first amount
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	var total int
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, ErrInvalidRatios
+		}
+		total += r
+	}
+	if total == 0 {
+		return nil, ErrInvalidRatios
+	}
+	var (
+		parts  = make([]Money, len(ratios))
+		remain = m.Minor
+		unit   = 1
+	)
+	if m.Minor < 0 {
+		unit = -1
+	}
+	for i, r := range ratios {
+		parts[i] = NewMoney(m.Minor*r/total, m.Currency)
+		remain -= parts[i].Minor
+	}
+	for i := 0; remain != 0; i = (i + 1) % len(parts) {
+		if ratios[i] == 0 {
+			continue
+		}
+		parts[i].Minor += unit
+		remain -= unit
+	}
+	return parts, nil
+}
+
+// Split m into n amounts which differ by at most one minor unit
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, ErrInvalidRatios
+	}
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// Validate returns an error when the currency is not an ISO 4217 code
+func (m Money) Validate() error {
+	if !IsCurrency(m.Currency) {
+		return fmt.Errorf("%w: unknown currency %q", ErrInvalidMoney, m.Currency)
+	}
+	return nil
+}
+func (m Money) Equal(m2 Money) bool {
+	return m.Minor == m2.Minor && m.Currency == m2.Currency
+}
+
+// Decimal is the amount in major units without grouping, ex: "-1250.50"
+func (m Money) Decimal() string {
+	return m.format("")
+}
+
+// String is the amount with thousands grouped followed by the currency, ex: "1,250.50 USD"
+func (m Money) String() string {
+	return strings.TrimSpace(m.format(",") + " " + m.Currency)
+}
+
+func (m Money) format(sep string) string {
+	var (
+		exp    = CurrencyExponent(m.Currency)
+		digits = strconv.Itoa(abs(m.Minor))
+	)
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	whole, frac := digits[:len(digits)-exp], digits[len(digits)-exp:]
+	if sep != "" {
+		for i := len(whole) - 3; i > 0; i -= 3 {
+			whole = whole[:i] + sep + whole[i:]
+		}
+	}
+	if m.Minor < 0 {
+		whole = "-" + whole
+	}
+	if frac == "" {
+		return whole
+	}
+	return whole + "." + frac
+}
+
+// sameCurrency returns the currency both amounts share, a zero amount
+// without a currency takes on the currency of the other
+func (m Money) sameCurrency(m2 Money) (string, error) {
+	switch {
+	case m.Currency == m2.Currency:
+		return m.Currency, nil
+	case m.Currency == "" && m.Minor == 0:
+		return m2.Currency, nil
+	case m2.Currency == "" && m2.Minor == 0:
+		return m.Currency, nil
+	}
+	return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, m2.Currency)
+}
+
+type moneyJSON struct {
+	Amount   int    `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// MarshalJSON encodes the amount in minor units, ex: {"amount":125050,"currency":"USD"}
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: m.Minor, Currency: m.Currency})
+}
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = NewMoney(v.Amount, v.Currency)
+	return nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,139 @@
+package entity_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+)
+
+func TestMoney_arithmetic(t *testing.T) {
+	var (
+		usd = func(minor int) entity.Money { return entity.NewMoney(minor, "USD") }
+		a   = usd(125050)
+	)
+	sum, err := a.Add(usd(50))
+	require.NoError(t, err)
+	assert.Equal(t, usd(125100), sum)
+
+	diff, err := a.Sub(usd(125100))
+	require.NoError(t, err)
+	assert.Equal(t, usd(-50), diff)
+	assert.True(t, diff.IsNegative())
+
+	assert.Equal(t, usd(375150), a.Mul(3))
+	assert.Equal(t, usd(-125050), a.Neg())
+	assert.Equal(t, usd(60508), a.Prorate(15, 31), "rounds half up")
+	assert.Equal(t, usd(-60508), a.Neg().Prorate(15, 31), "rounds half away from zero")
+
+	// the zero value takes on the currency of the other amount
+	var total entity.Money
+	total, err = total.Add(a)
+	require.NoError(t, err)
+	assert.Equal(t, a, total)
+
+	cmp, err := a.Cmp(usd(1))
+	require.NoError(t, err)
+	assert.Equal(t, 1, cmp)
+
+	t.Run("currency mismatch", func(t *testing.T) {
+		_, err := a.Add(entity.NewMoney(1, "EUR"))
+		assert.ErrorIs(t, err, entity.ErrCurrencyMismatch)
+		_, err = a.Cmp(entity.NewMoney(1, "EUR"))
+		assert.ErrorIs(t, err, entity.ErrCurrencyMismatch)
+	})
+}
+func TestMoney_Allocate(t *testing.T) {
+	tests := map[string]struct {
+		money  entity.Money
+		ratios []int
+		expect []int
+	}{
+		"even":               {entity.NewMoney(100, "USD"), []int{1, 1}, []int{50, 50}},
+		"remainder first":    {entity.NewMoney(100, "USD"), []int{1, 1, 1}, []int{34, 33, 33}},
+		"weighted":           {entity.NewMoney(5, "USD"), []int{3, 7}, []int{2, 3}},
+		"zero ratio skipped": {entity.NewMoney(101, "USD"), []int{0, 1, 1}, []int{0, 51, 50}},
+		"negative":           {entity.NewMoney(-100, "USD"), []int{1, 1, 1}, []int{-34, -33, -33}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			parts, err := tc.money.Allocate(tc.ratios...)
+			require.NoError(t, err)
+			require.Len(t, parts, len(tc.expect))
+			var total entity.Money
+			for i, p := range parts {
+				assert.Equal(t, tc.expect[i], p.Minor)
+				assert.Equal(t, tc.money.Currency, p.Currency)
+				total, err = total.Add(p)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.money, total, "nothing lost")
+		})
+	}
+
+	split, err := entity.NewMoney(1000, "USD").Split(3)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.Money{
+		entity.NewMoney(334, "USD"), entity.NewMoney(333, "USD"), entity.NewMoney(333, "USD"),
+	}, split)
+
+	t.Run("invalid ratios", func(t *testing.T) {
+		_, err := entity.NewMoney(1, "USD").Allocate(0, 0)
+		assert.ErrorIs(t, err, entity.ErrInvalidRatios)
+		_, err = entity.NewMoney(1, "USD").Allocate(2, -1)
+		assert.ErrorIs(t, err, entity.ErrInvalidRatios)
+		_, err = entity.NewMoney(1, "USD").Split(0)
+		assert.ErrorIs(t, err, entity.ErrInvalidRatios)
+	})
+}
+func TestMoney_format(t *testing.T) {
+	tests := map[string]struct {
+		money   entity.Money
+		decimal string
+		str     string
+	}{
+		"usd":          {entity.NewMoney(125050, "USD"), "1250.50", "1,250.50 USD"},
+		"usd cents":    {entity.NewMoney(5, "USD"), "0.05", "0.05 USD"},
+		"negative":     {entity.NewMoney(-123456789, "USD"), "-1234567.89", "-1,234,567.89 USD"},
+		"no decimals":  {entity.NewMoney(1250, "JPY"), "1250", "1,250 JPY"},
+		"three places": {entity.NewMoney(1250, "KWD"), "1.250", "1.250 KWD"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.decimal, tc.money.Decimal())
+			assert.Equal(t, tc.str, tc.money.String())
+
+			parsed, err := entity.ParseMoney(tc.decimal, tc.money.Currency)
+			require.NoError(t, err)
+			assert.Equal(t, tc.money, parsed)
+		})
+	}
+
+	t.Run("parse", func(t *testing.T) {
+		m, err := entity.ParseMoney("1250.5", "USD")
+		require.NoError(t, err)
+		assert.Equal(t, entity.NewMoney(125050, "USD"), m)
+
+		for _, in := range []string{"", "1.234", "1.5.0", "abc", "1,250", "--1", ".5"} {
+			_, err := entity.ParseMoney(in, "USD")
+			assert.ErrorIs(t, err, entity.ErrInvalidMoney, in)
+		}
+		_, err = entity.ParseMoney("1.5", "JPY")
+		assert.ErrorIs(t, err, entity.ErrInvalidMoney)
+	})
+}
+func TestMoney_JSON(t *testing.T) {
+	in := entity.NewMoney(125050, "USD")
+	data, err := json.Marshal(in)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":125050,"currency":"USD"}`, string(data))
+
+	var out entity.Money
+	require.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+
+	assert.NoError(t, in.Validate())
+	assert.ErrorIs(t, entity.NewMoney(1, "XYZ").Validate(), entity.ErrInvalidMoney)
+}
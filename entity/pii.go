@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/tempcke/schedule"
+)
+
+// MaskSSN keeps only the last 4 digits, 123-45-6789 becomes ***-**-6789
+func MaskSSN(ssn string) string {
+	if ssn == "" {
+		return ""
+	}
+	var digits []rune
+	for _, c := range ssn {
+		if c >= '0' && c <= '9' {
+			digits = append(digits, c)
+		}
+	}
+	last4 := "****"
+	if len(digits) >= 4 {
+		last4 = string(digits[len(digits)-4:])
+	}
+	return "***-**-" + last4
+}
+
+// MaskLast4 replaces every character but the last 4 with *
+// a value of 4 characters or fewer is masked completely
+func MaskLast4(s string) string {
+	r := []rune(s)
+	if len(r) <= 4 {
+		return strings.Repeat("*", len(r))
+	}
+	return strings.Repeat("*", len(r)-4) + string(r[len(r)-4:])
+}
+
+// MaskPII returns a copy safe to show to callers who may not see personal
+// information, the drivers license number is masked and the date of birth
+// is removed
+func (t Tenant) MaskPII() Tenant {
+	t.DLNum = MaskLast4(t.DLNum)
+	t.DateOfBirth = schedule.Date{}
+	return t
+}
+
+// MaskPII returns a copy with the ssn and drivers license number masked and
+// the date of birth removed
+func (a Applicant) MaskPII() Applicant {
+	a.SSN = MaskSSN(a.SSN)
+	a.DLNum = MaskLast4(a.DLNum)
+	a.DateOfBirth = schedule.Date{}
+	return a
+}
+
+// MaskPII returns a copy with the personal information of every applicant masked
+func (a RentalApplication) MaskPII() RentalApplication {
+	applicants := make([]Applicant, len(a.Applicants))
+	for i, ap := range a.Applicants {
+		applicants[i] = ap.MaskPII()
+	}
+	a.Applicants = applicants
+	return a
+}
@@ -0,0 +1,47 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+)
+
+func TestMaskSSN(t *testing.T) {
+	tests := map[string]string{
+		"123-45-6789": "***-**-6789",
+		"123456789":   "***-**-6789",
+		"12":          "***-**-****",
+		"":            "",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, entity.MaskSSN(in), in)
+	}
+}
+
+func TestMaskLast4(t *testing.T) {
+	tests := map[string]string{
+		"646673153": "*****3153",
+		"1234":      "****",
+		"":          "",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, entity.MaskLast4(in), in)
+	}
+}
+
+func TestRentalApplication_MaskPII(t *testing.T) {
+	ap := fake.Applicant()
+	ap.SSN = "123-45-6789"
+	ap.DLNum = "646673153"
+	app := fake.RentalApplication(entity.NewID()).WithApplicant(ap)
+
+	masked := app.MaskPII()
+	last := masked.Applicants[len(masked.Applicants)-1]
+	assert.Equal(t, "***-**-6789", last.SSN)
+	assert.Equal(t, "*****3153", last.DLNum)
+	assert.True(t, last.DateOfBirth.IsZero())
+	assert.Equal(t, ap.FullName, last.FullName)
+	assert.Equal(t, "123-45-6789", app.Applicants[len(app.Applicants)-1].SSN, "original must not change")
+}
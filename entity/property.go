@@ -1,7 +1,6 @@
 package entity
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/tempcke/rpm/internal"
@@ -9,27 +8,27 @@ import (
 
 // Property entity
 type Property struct {
-	ID        ID
-	Street    string // 1234 N Main st
-	City      string // Dallas
-	StateCode string // TX
-	Zip       string // 75401
+	ID ID
+	Address
 	CreatedAt time.Time
+	DeletedAt time.Time // zero unless the property was removed
+
+	// Rank is the relevance of the property to the search it was listed by,
+	// from 0 to 1, it is zero when it was not found by a search
+	Rank float64
 }
 
 // NewProperty returns a new entity.Property
 func NewProperty(street, city, state, zip string) Property {
 	return Property{
 		ID:        NewID(),
-		Street:    street,
-		City:      city,
-		StateCode: state,
-		Zip:       zip,
+		Address:   NewAddress(street, city, state, zip),
 		CreatedAt: time.Now(),
 	}
 }
-func (p Property) WithID(id ID) Property     { p.ID = id; return p }
-func (p Property) WithZip(v string) Property { p.Zip = v; return p }
+func (p Property) WithID(id ID) Property          { p.ID = id; return p }
+func (p Property) WithZip(v string) Property      { p.Zip = v; return p }
+func (p Property) WithAddress(a Address) Property { p.Address = a; return p }
 
 // GetID of entity
 // method needed to implement entity.Entity
@@ -37,23 +36,25 @@ func (p Property) GetID() ID {
 	return p.ID
 }
 
-// Validate is used to validate the entity
+// Deleted is true once the property was removed, until it is restored
+func (p Property) Deleted() bool { return !p.DeletedAt.IsZero() }
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field, see Address.Validate
 func (p Property) Validate() error {
-	if p.ID == "" || p.Street == "" || p.City == "" || p.StateCode == "" || p.Zip == "" {
-		return internal.ErrEntityInvalid
+	var errs []error
+	if p.ID == "" {
+		errs = append(errs, internal.NewFieldError("id", "is required"))
+	}
+	for _, fe := range internal.FieldErrors(p.Address.Validate()) {
+		errs = append(errs, fe)
 	}
-	return nil
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
 }
 
 func (p Property) Equal(p2 Property) bool {
-	return idEqualOrEmpty(p.ID, p2.ID) &&
-		p.Street == p2.Street &&
-		p.City == p2.City &&
-		p.StateCode == p2.StateCode &&
-		p.Zip == p2.Zip
-}
-
-func (p Property) String() string {
-	return fmt.Sprintf("%s, %s, %s %s",
-		p.Street, p.City, p.StateCode, p.Zip)
+	return idEqualOrEmpty(p.ID, p2.ID) && p.Address.Equal(p2.Address)
 }
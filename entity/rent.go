@@ -0,0 +1,182 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tempcke/schedule"
+)
+
+var (
+	ErrScheduleUnbounded = errors.New("lease has no end date, until is required")
+	ErrInvalidDueDay     = errors.New("dueDay must be 1-31 for monthly or 1-7 for weekly rent")
+)
+
+// RentDue is a single rent payment owed on a lease
+type RentDue struct {
+	DueDate  schedule.Date
+	Period   schedule.DateRange // days this payment covers
+	Amount   Money
+	Prorated bool // Period is shorter than a full RentInterval
+}
+
+// ScheduleOptions control how RentSchedule lays out the due dates
+//
+//	DueDay for monthly rent is the day of the month, clamped to the last day
+//	of shorter months, zero is the 1st
+//	DueDay for weekly rent is the ISO weekday, 1 Monday through 7 Sunday,
+//	zero is the weekday of the lease StartDate
+//	DueDay is ignored for daily rent
+//
+// Until limits the schedule to payments due on or before it, it is required
+// when the lease has no EndDate
+type ScheduleOptions struct {
+	DueDay int
+	Until  schedule.Date
+}
+
+func NewScheduleOptions() ScheduleOptions {
+	return ScheduleOptions{}
+}
+func (o ScheduleOptions) WithDueDay(day int) ScheduleOptions {
+	o.DueDay = day
+	return o
+}
+func (o ScheduleOptions) WithUntil(d schedule.Date) ScheduleOptions {
+	o.Until = d
+	return o
+}
+
+// RentSchedule lists every rent payment from StartDate through EndDate
+// a partial first or last period is prorated by day and rounded to the minor unit
+func (l Lease) RentSchedule(opts ScheduleOptions) ([]RentDue, error) {
+	return l.rentSchedule(opts, func(schedule.Date) Money { return l.RentAmount })
+}
+
+// AmendedRentSchedule is the RentSchedule of the current terms of the lease
+// where each payment is the rent of the version in effect on its due date
+func (l Lease) AmendedRentSchedule(opts ScheduleOptions, h LeaseHistory) ([]RentDue, error) {
+	return l.rentSchedule(opts, func(d schedule.Date) Money {
+		if rent, ok := h.RentOn(l.ID, d); ok {
+			return rent
+		}
+		return l.RentAmount
+	})
+}
+
+func (l Lease) rentSchedule(opts ScheduleOptions, rentOn func(schedule.Date) Money) ([]RentDue, error) {
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+	until := l.EndDate
+	if !opts.Until.IsZero() && (until.IsZero() || opts.Until.Before(until)) {
+		until = opts.Until
+	}
+	if until.IsZero() {
+		return nil, ErrScheduleUnbounded
+	}
+	if !l.validDueDay(opts.DueDay) {
+		return nil, ErrInvalidDueDay
+	}
+
+	var (
+		next = l.nextDueFunc(opts.DueDay)
+		list = make([]RentDue, 0)
+	)
+	for start := l.StartDate; !start.After(until); {
+		// the full period which contains start, used for proration
+		fullEnd := next(start).AddDate(0, 0, -1)
+		fullStart := l.prevDue(next, start)
+		full := schedule.NewDateRangeUntil(fullStart, &fullEnd)
+
+		end := fullEnd
+		if !l.EndDate.IsZero() && l.EndDate.Before(end) {
+			end = l.EndDate
+		}
+		period := schedule.NewDateRangeUntil(start, end.Pointer())
+		rent := rentOn(start)
+		due := RentDue{
+			DueDate: start,
+			Period:  period,
+			Amount:  rent,
+		}
+		if days, fullDays := period.DayCount(), full.DayCount(); days < fullDays {
+			due.Amount = rent.Prorate(days, fullDays)
+			due.Prorated = true
+		}
+		list = append(list, due)
+		start = fullEnd.Next()
+	}
+	return list, nil
+}
+
+func (l Lease) validDueDay(day int) bool {
+	switch l.RentInterval {
+	case IntervalMonthly:
+		return day >= 0 && day <= 31
+	case IntervalWeekly:
+		return day >= 0 && day <= 7
+	}
+	return true
+}
+
+// nextDueFunc returns a func which finds the first due date after d
+func (l Lease) nextDueFunc(dueDay int) func(d schedule.Date) schedule.Date {
+	switch l.RentInterval {
+	case IntervalDaily:
+		return func(d schedule.Date) schedule.Date { return d.Next() }
+	case IntervalWeekly:
+		weekday := time.Weekday(dueDay % 7)
+		if dueDay == 0 {
+			weekday = l.StartDate.ToTime().Weekday()
+		}
+		return func(d schedule.Date) schedule.Date {
+			days := (int(weekday) - int(d.ToTime().Weekday()) + 7) % 7
+			if days == 0 {
+				days = 7
+			}
+			return d.AddDate(0, 0, days)
+		}
+	default: // IntervalMonthly
+		if dueDay == 0 {
+			dueDay = 1
+		}
+		return func(d schedule.Date) schedule.Date {
+			due := dueInMonth(d.Year(), d.Month(), dueDay)
+			if due.After(d) {
+				return due
+			}
+			return dueInMonth(d.Year(), d.Month()+1, dueDay)
+		}
+	}
+}
+
+// prevDue is the last due date on or before d
+func (l Lease) prevDue(next func(schedule.Date) schedule.Date, d schedule.Date) schedule.Date {
+	// step back far enough to land before the previous due date
+	back := d.AddDate(0, -2, 0)
+	switch l.RentInterval {
+	case IntervalDaily:
+		return d
+	case IntervalWeekly:
+		back = d.AddDate(0, 0, -14)
+	}
+	for due := next(back); !due.After(d); due = next(due) {
+		back = due
+	}
+	return back
+}
+
+// dueInMonth clamps day to the last day of the month
+func dueInMonth(year int, month time.Month, day int) schedule.Date {
+	first := schedule.NewDate(year, month, 1)
+	if last := first.AddDate(0, 1, -1); day > last.Day() {
+		return last
+	}
+	return schedule.NewDate(year, month, day)
+}
+
+// prorate rounds amount*days/fullDays half up
+func prorate(amount, days, fullDays int) int {
+	return (2*amount*days + fullDays) / (2 * fullDays)
+}
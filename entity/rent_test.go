@@ -0,0 +1,126 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/schedule"
+)
+
+func TestLease_RentSchedule(t *testing.T) {
+	type due struct {
+		date, from, until string
+		amount            int
+	}
+	var (
+		date  = func(s string) schedule.Date { return *schedule.ParseDate(s) }
+		lease = func(interval entity.Interval, start, end string) entity.Lease {
+			l := entity.NewLease(entity.NewID()).WithTenant(entity.NewID()).
+				WithRent(entity.NewMoney(1000, entity.CurrencyUSD)).WithRentInterval(interval)
+			var endDate schedule.Date
+			if end != "" {
+				endDate = date(end)
+			}
+			return l.WithTerm(date(start), endDate)
+		}
+	)
+	tests := map[string]struct {
+		lease  entity.Lease
+		opts   entity.ScheduleOptions
+		expect []due
+	}{
+		"monthly full months": {
+			lease: lease(entity.IntervalMonthly, "2024-01-01", "2024-03-31"),
+			expect: []due{
+				{"2024-01-01", "2024-01-01", "2024-01-31", 1000},
+				{"2024-02-01", "2024-02-01", "2024-02-29", 1000},
+				{"2024-03-01", "2024-03-01", "2024-03-31", 1000},
+			},
+		},
+		"monthly prorated first and last": {
+			lease: lease(entity.IntervalMonthly, "2024-01-17", "2024-03-10"),
+			expect: []due{
+				{"2024-01-17", "2024-01-17", "2024-01-31", 484}, // 15/31
+				{"2024-02-01", "2024-02-01", "2024-02-29", 1000},
+				{"2024-03-01", "2024-03-01", "2024-03-10", 323}, // 10/31
+			},
+		},
+		"monthly due on the 15th": {
+			lease: lease(entity.IntervalMonthly, "2024-01-01", "2024-02-29"),
+			opts:  entity.NewScheduleOptions().WithDueDay(15),
+			expect: []due{
+				{"2024-01-01", "2024-01-01", "2024-01-14", 452}, // 14/31 of Dec15-Jan14
+				{"2024-01-15", "2024-01-15", "2024-02-14", 1000},
+				{"2024-02-15", "2024-02-15", "2024-02-29", 517}, // 15/29 of Feb15-Mar14
+			},
+		},
+		"monthly due on the 31st is clamped": {
+			lease: lease(entity.IntervalMonthly, "2024-01-31", "2024-03-30"),
+			opts:  entity.NewScheduleOptions().WithDueDay(31),
+			expect: []due{
+				{"2024-01-31", "2024-01-31", "2024-02-28", 1000},
+				{"2024-02-29", "2024-02-29", "2024-03-30", 1000},
+			},
+		},
+		"weekly due on start weekday": {
+			lease: lease(entity.IntervalWeekly, "2024-01-03", "2024-01-19"),
+			expect: []due{
+				{"2024-01-03", "2024-01-03", "2024-01-09", 1000},
+				{"2024-01-10", "2024-01-10", "2024-01-16", 1000},
+				{"2024-01-17", "2024-01-17", "2024-01-19", 429}, // 3/7
+			},
+		},
+		"weekly due monday": {
+			lease: lease(entity.IntervalWeekly, "2024-01-03", "2024-01-14"),
+			opts:  entity.NewScheduleOptions().WithDueDay(1),
+			expect: []due{
+				{"2024-01-03", "2024-01-03", "2024-01-07", 714}, // 5/7
+				{"2024-01-08", "2024-01-08", "2024-01-14", 1000},
+			},
+		},
+		"daily": {
+			lease: lease(entity.IntervalDaily, "2024-01-01", "2024-01-02"),
+			expect: []due{
+				{"2024-01-01", "2024-01-01", "2024-01-01", 1000},
+				{"2024-01-02", "2024-01-02", "2024-01-02", 1000},
+			},
+		},
+		"no end date until": {
+			lease: lease(entity.IntervalMonthly, "2024-01-01", ""),
+			opts:  entity.NewScheduleOptions().WithUntil(date("2024-02-15")),
+			expect: []due{
+				{"2024-01-01", "2024-01-01", "2024-01-31", 1000},
+				{"2024-02-01", "2024-02-01", "2024-02-29", 1000},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			list, err := tc.lease.RentSchedule(tc.opts)
+			require.NoError(t, err)
+			var got []due
+			for _, d := range list {
+				assert.Equal(t, !d.Amount.Equal(tc.lease.RentAmount), d.Prorated, d.DueDate.String())
+				got = append(got, due{d.DueDate.String(), d.Period.From.String(), d.Period.Until.String(), d.Amount.Minor})
+			}
+			assert.Equal(t, tc.expect, got)
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		monthly := lease(entity.IntervalMonthly, "2024-01-01", "")
+		_, err := monthly.RentSchedule(entity.NewScheduleOptions())
+		assert.ErrorIs(t, err, entity.ErrScheduleUnbounded)
+
+		until := schedule.NewDate(2024, time.June, 1)
+		_, err = monthly.RentSchedule(entity.NewScheduleOptions().WithUntil(until).WithDueDay(32))
+		assert.ErrorIs(t, err, entity.ErrInvalidDueDay)
+
+		weekly := lease(entity.IntervalWeekly, "2024-01-01", "2024-06-01")
+		_, err = weekly.RentSchedule(entity.NewScheduleOptions().WithDueDay(8))
+		assert.ErrorIs(t, err, entity.ErrInvalidDueDay)
+	})
+}
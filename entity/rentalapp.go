@@ -1,19 +1,57 @@
 package entity
 
 import (
+	"errors"
+	"time"
+
+	"github.com/tempcke/rpm/internal"
 	"github.com/tempcke/schedule"
 )
 
-type RentalDetails struct {
-	PropertyID    string
-	AllowSmoking  bool
-	AllowPets     bool
-	ParkingSpaces int
-	ParkingDesc   string
+type ApplicationStatus = string
+
+const (
+	ApplicationSubmitted   ApplicationStatus = "submitted"    // received, nobody has looked at it yet
+	ApplicationUnderReview ApplicationStatus = "under_review" // being screened
+	ApplicationApproved    ApplicationStatus = "approved"     // may be converted into tenants and a lease
+	ApplicationDenied      ApplicationStatus = "denied"       // final, a note must say why
+	ApplicationWithdrawn   ApplicationStatus = "withdrawn"    // final, the applicants backed out
+)
+
+var (
+	ErrApplicationStatus    = errors.New("application status can not change from its current status to the one requested")
+	ErrApplicationNotReady  = errors.New("only an approved application can be converted")
+	ErrApplicationConverted = errors.New("application has already been converted")
+)
+
+// applicationTransitions lists the statuses each status may move to
+// approved, denied and withdrawn are final
+var applicationTransitions = map[ApplicationStatus][]ApplicationStatus{
+	ApplicationSubmitted:   {ApplicationUnderReview, ApplicationApproved, ApplicationDenied, ApplicationWithdrawn},
+	ApplicationUnderReview: {ApplicationApproved, ApplicationDenied, ApplicationWithdrawn},
 }
+
+// RentalApplication is a request by one or more applicants to rent a property
+// once approved it is converted into tenants and a draft lease
 type RentalApplication struct {
-	PropertyID string
+	ID          ID
+	PropertyID  ID
+	Applicants  []Applicant
+	MoveInDate  schedule.Date
+	Status      ApplicationStatus
+	Notes       []ApplicationNote // oldest first, one for every status change
+	TenantIDs   []ID              // set once converted, in the same order as Applicants
+	SubmittedAt time.Time
+	UpdatedAt   time.Time
 }
+
+// ApplicationNote records a status change along with why it was made
+type ApplicationNote struct {
+	Status    ApplicationStatus
+	Note      string
+	CreatedAt time.Time
+}
+
 type Applicant struct {
 	FullName        string
 	DateOfBirth     schedule.Date
@@ -28,4 +66,142 @@ type Applicant struct {
 	CrimeDesc       string
 	BankruptcyFiled bool
 	BankruptcyDesc  string
+	MonthlyIncome   Money // gross, used by min_income screening rules
+}
+
+func NewRentalApplication(propertyID ID, applicants ...Applicant) RentalApplication {
+	return RentalApplication{
+		ID:         NewID(),
+		PropertyID: propertyID,
+		Applicants: applicants,
+		Status:     ApplicationSubmitted,
+	}
+}
+func (a RentalApplication) WithID(id ID) RentalApplication {
+	a.ID = id
+	return a
+}
+func (a RentalApplication) WithApplicant(applicants ...Applicant) RentalApplication {
+	a.Applicants = append(append([]Applicant{}, a.Applicants...), applicants...)
+	return a
+}
+func (a RentalApplication) WithMoveInDate(d schedule.Date) RentalApplication {
+	a.MoveInDate = d
+	return a
+}
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (a RentalApplication) GetID() ID { return a.ID }
+
+// Converted is true once tenants have been created from the applicants
+func (a RentalApplication) Converted() bool { return len(a.TenantIDs) > 0 }
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field
+func (a RentalApplication) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if a.ID == "" {
+		invalid("id", "is required")
+	}
+	if a.PropertyID == "" {
+		invalid("propertyID", "is required")
+	}
+	if a.MoveInDate.IsZero() {
+		invalid("moveInDate", "is required")
+	}
+	if len(a.Applicants) == 0 {
+		invalid("applicants", "must have at least one applicant")
+	}
+	for _, ap := range a.Applicants {
+		if ap.FullName == "" {
+			invalid("applicants.fullName", "is required")
+		}
+		if ap.DateOfBirth.IsZero() {
+			invalid("applicants.dateOfBirth", "is required")
+		}
+		if !ap.MonthlyIncome.IsZero() || ap.MonthlyIncome.Currency != "" {
+			if err := ap.MonthlyIncome.Validate(); err != nil {
+				invalid("applicants.monthlyIncome", err.Error())
+			} else if ap.MonthlyIncome.IsNegative() {
+				invalid("applicants.monthlyIncome", "can not be negative")
+			}
+		}
+	}
+	switch a.Status {
+	case ApplicationSubmitted, ApplicationUnderReview, ApplicationApproved, ApplicationDenied, ApplicationWithdrawn:
+	default:
+		invalid("status", "must be one of submitted, under_review, approved, denied, withdrawn")
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+
+// Transition moves the application to status and records the note
+// an invalid status is internal.ErrEntityInvalid, a status which can not be
+// reached from the current one is internal.ErrConflict
+func (a RentalApplication) Transition(status ApplicationStatus, note string) (RentalApplication, error) {
+	switch status {
+	case ApplicationSubmitted, ApplicationUnderReview, ApplicationApproved, ApplicationDenied, ApplicationWithdrawn:
+	default:
+		return a, internal.NewErrors(internal.ErrEntityInvalid).Append(
+			internal.NewFieldError("status", "must be one of under_review, approved, denied, withdrawn"))
+	}
+	if status == ApplicationDenied && note == "" {
+		return a, internal.NewErrors(internal.ErrEntityInvalid).Append(
+			internal.NewFieldError("note", "is required to deny an application"))
+	}
+	if !a.CanTransition(status) {
+		return a, internal.NewErrors(internal.ErrConflict, ErrApplicationStatus)
+	}
+	a.Status = status
+	a.Notes = append(append([]ApplicationNote{}, a.Notes...), ApplicationNote{
+		Status: status,
+		Note:   note,
+	})
+	return a, nil
+}
+
+// CanTransition is true when status may follow the current status
+func (a RentalApplication) CanTransition(status ApplicationStatus) bool {
+	for _, s := range applicationTransitions[a.Status] {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (a RentalApplication) Equal(a2 RentalApplication) bool {
+	if len(a.Applicants) != len(a2.Applicants) || len(a.Notes) != len(a2.Notes) {
+		return false
+	}
+	for i := range a.Applicants {
+		if a.Applicants[i] != a2.Applicants[i] {
+			return false
+		}
+	}
+	for i := range a.Notes {
+		if a.Notes[i].Status != a2.Notes[i].Status || a.Notes[i].Note != a2.Notes[i].Note {
+			return false
+		}
+	}
+	return idEqualOrEmpty(a.ID, a2.ID) &&
+		a.PropertyID == a2.PropertyID &&
+		a.MoveInDate.Equal(a2.MoveInDate) &&
+		a.Status == a2.Status &&
+		idListEqual(a.TenantIDs, a2.TenantIDs)
+}
+
+// ToTenant is a new tenant with the identity of the applicant
+func (ap Applicant) ToTenant() Tenant {
+	t := NewTenant(ap.FullName, ap.DateOfBirth)
+	t.DLNum = ap.DLNum
+	t.DLState = ap.DLState
+	return t
 }
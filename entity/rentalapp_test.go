@@ -0,0 +1,84 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+)
+
+func TestRentalApplication_Validate(t *testing.T) {
+	valid := fake.RentalApplication(entity.NewID())
+	require.NoError(t, valid.Validate())
+
+	noName := fake.Applicant()
+	noName.FullName = ""
+	tests := map[string]struct {
+		app    entity.RentalApplication
+		fields []string
+	}{
+		"no property":   {app: entity.NewRentalApplication("", fake.Applicant()).WithMoveInDate(valid.MoveInDate), fields: []string{"propertyID"}},
+		"no applicants": {app: entity.NewRentalApplication(valid.PropertyID).WithMoveInDate(valid.MoveInDate), fields: []string{"applicants"}},
+		"no move in":    {app: entity.NewRentalApplication(valid.PropertyID, fake.Applicant()), fields: []string{"moveInDate"}},
+		"no name":       {app: valid.WithApplicant(noName), fields: []string{"applicants.fullName"}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.app.Validate()
+			require.ErrorIs(t, err, internal.ErrEntityInvalid)
+			var fields []string
+			for _, fe := range internal.FieldErrors(err) {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
+
+func TestRentalApplication_Transition(t *testing.T) {
+	app := fake.RentalApplication(entity.NewID())
+	require.Equal(t, entity.ApplicationSubmitted, app.Status)
+
+	t.Run("review then approve", func(t *testing.T) {
+		a, err := app.Transition(entity.ApplicationUnderReview, "")
+		require.NoError(t, err)
+		a, err = a.Transition(entity.ApplicationApproved, "income verified")
+		require.NoError(t, err)
+		assert.Equal(t, entity.ApplicationApproved, a.Status)
+		require.Len(t, a.Notes, 2)
+		assert.Equal(t, "income verified", a.Notes[1].Note)
+		assert.Len(t, app.Notes, 0, "original must not change")
+	})
+	t.Run("deny requires a note", func(t *testing.T) {
+		_, err := app.Transition(entity.ApplicationDenied, "")
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+		assert.Equal(t, "note", internal.FieldErrors(err)[0].Field)
+	})
+	t.Run("final status", func(t *testing.T) {
+		a, err := app.Transition(entity.ApplicationWithdrawn, "found another place")
+		require.NoError(t, err)
+		_, err = a.Transition(entity.ApplicationApproved, "")
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, entity.ErrApplicationStatus)
+	})
+	t.Run("back to submitted", func(t *testing.T) {
+		_, err := app.Transition(entity.ApplicationSubmitted, "")
+		require.ErrorIs(t, err, internal.ErrConflict)
+	})
+	t.Run("unknown status", func(t *testing.T) {
+		_, err := app.Transition("lost", "")
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+}
+
+func TestApplicant_ToTenant(t *testing.T) {
+	ap := fake.Applicant()
+	tenant := ap.ToTenant()
+	assert.NotEmpty(t, tenant.ID)
+	assert.Equal(t, ap.FullName, tenant.FullName)
+	assert.True(t, ap.DateOfBirth.Equal(tenant.DateOfBirth))
+	assert.Equal(t, ap.DLNum, tenant.DLNum)
+}
@@ -0,0 +1,257 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tempcke/rpm/internal"
+)
+
+type (
+	ScreeningCriterion = string
+	ScreeningAction    = string
+	ScreeningResult    = string
+)
+
+const (
+	CriterionNoPets            ScreeningCriterion = "no_pets"             // no applicant may have pets
+	CriterionMaxVehicles       ScreeningCriterion = "max_vehicles"        // Limit is the total vehicles allowed
+	CriterionNoCrimeConviction ScreeningCriterion = "no_crime_conviction" // no applicant may have a conviction
+	CriterionNoBankruptcy      ScreeningCriterion = "no_bankruptcy"       // no applicant may have filed bankruptcy
+	CriterionMinIncome         ScreeningCriterion = "min_income"          // Limit is combined monthly income as a percent of rent, 300 is 3x
+
+	ScreeningActionFail   ScreeningAction = "fail"   // the application fails screening
+	ScreeningActionReview ScreeningAction = "review" // a person must review the application
+
+	ScreeningPass        ScreeningResult = "pass"
+	ScreeningFail        ScreeningResult = "fail"
+	ScreeningNeedsReview ScreeningResult = "needs_review"
+)
+
+// ScreeningRule is a single criterion applications to a property are held to
+// along with what happens when an application does not meet it
+type ScreeningRule struct {
+	Criterion ScreeningCriterion
+	Limit     int
+	Action    ScreeningAction
+}
+
+// ScreeningPolicy is the screening criteria a landlord set for a property
+// Rent is what min_income rules are measured against
+type ScreeningPolicy struct {
+	ID         ID
+	PropertyID ID
+	Rent       Money
+	Rules      []ScreeningRule
+	UpdatedAt  time.Time
+}
+
+// ScreeningReport is the outcome of screening an application, it keeps a copy
+// of the rules and rent which were applied so the decision can be reviewed
+// even after the policy has changed
+type ScreeningReport struct {
+	ID            ID
+	ApplicationID ID
+	PropertyID    ID
+	Result        ScreeningResult
+	Rent          Money
+	Rules         []ScreeningRule
+	Findings      []ScreeningFinding // one for every time a rule fired
+	CreatedAt     time.Time
+}
+
+// ScreeningFinding is a rule which the application did not meet
+// Applicant is the full name of the applicant it applies to, it is empty when
+// the rule applies to all applicants together
+type ScreeningFinding struct {
+	Rule      ScreeningRule
+	Applicant string
+	Reason    string
+}
+
+func NewScreeningRule(criterion ScreeningCriterion, action ScreeningAction) ScreeningRule {
+	return ScreeningRule{Criterion: criterion, Action: action}
+}
+func (r ScreeningRule) WithLimit(limit int) ScreeningRule {
+	r.Limit = limit
+	return r
+}
+
+func NewScreeningPolicy(propertyID ID, rules ...ScreeningRule) ScreeningPolicy {
+	return ScreeningPolicy{
+		ID:         NewID(),
+		PropertyID: propertyID,
+		Rules:      rules,
+	}
+}
+func (p ScreeningPolicy) WithID(id ID) ScreeningPolicy {
+	p.ID = id
+	return p
+}
+func (p ScreeningPolicy) WithRent(rent Money) ScreeningPolicy {
+	p.Rent = rent
+	return p
+}
+func (p ScreeningPolicy) WithRule(rules ...ScreeningRule) ScreeningPolicy {
+	p.Rules = append(append([]ScreeningRule{}, p.Rules...), rules...)
+	return p
+}
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (p ScreeningPolicy) GetID() ID { return p.ID }
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field
+func (p ScreeningPolicy) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if p.ID == "" {
+		invalid("id", "is required")
+	}
+	if p.PropertyID == "" {
+		invalid("propertyID", "is required")
+	}
+	if !p.Rent.IsZero() || p.Rent.Currency != "" {
+		if err := p.Rent.Validate(); err != nil {
+			invalid("rent", err.Error())
+		} else if p.Rent.IsNegative() {
+			invalid("rent", "can not be negative")
+		}
+	}
+	for i, r := range p.Rules {
+		field := fmt.Sprintf("rules[%d].", i)
+		switch r.Criterion {
+		case CriterionNoPets, CriterionNoCrimeConviction, CriterionNoBankruptcy:
+		case CriterionMaxVehicles:
+			if r.Limit < 0 {
+				invalid(field+"limit", "can not be negative")
+			}
+		case CriterionMinIncome:
+			if r.Limit <= 0 {
+				invalid(field+"limit", "must be a percent of rent greater than zero")
+			}
+			if p.Rent.Minor <= 0 {
+				invalid("rent", "is required for a min_income rule")
+			}
+		default:
+			invalid(field+"criterion", "must be one of no_pets, max_vehicles, no_crime_conviction, no_bankruptcy, min_income")
+		}
+		switch r.Action {
+		case ScreeningActionFail, ScreeningActionReview:
+		default:
+			invalid(field+"action", "must be one of fail, review")
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+func (p ScreeningPolicy) Equal(p2 ScreeningPolicy) bool {
+	return idEqualOrEmpty(p.ID, p2.ID) &&
+		p.PropertyID == p2.PropertyID &&
+		p.Rent.Equal(p2.Rent) &&
+		rulesEqual(p.Rules, p2.Rules)
+}
+
+// Evaluate the application against every rule of the policy
+// the result is fail when any fail rule fired, needs_review when only review
+// rules fired and pass otherwise, the report is not stored
+func (p ScreeningPolicy) Evaluate(a RentalApplication) ScreeningReport {
+	report := ScreeningReport{
+		ID:            NewID(),
+		ApplicationID: a.ID,
+		PropertyID:    p.PropertyID,
+		Result:        ScreeningPass,
+		Rent:          p.Rent,
+		Rules:         append([]ScreeningRule{}, p.Rules...),
+		Findings:      make([]ScreeningFinding, 0),
+	}
+	for _, r := range p.Rules {
+		for _, f := range r.check(a, p.Rent) {
+			report.Findings = append(report.Findings, f)
+			switch {
+			case r.Action == ScreeningActionFail:
+				report.Result = ScreeningFail
+			case report.Result == ScreeningPass:
+				report.Result = ScreeningNeedsReview
+			}
+		}
+	}
+	return report
+}
+
+// check returns a finding for every way the application does not meet the rule
+func (r ScreeningRule) check(a RentalApplication, rent Money) []ScreeningFinding {
+	var findings []ScreeningFinding
+	fired := func(applicant, reason string) {
+		findings = append(findings, ScreeningFinding{Rule: r, Applicant: applicant, Reason: reason})
+	}
+	switch r.Criterion {
+	case CriterionNoPets:
+		for _, ap := range a.Applicants {
+			if ap.HasPets {
+				fired(ap.FullName, withDesc("has pets", ap.PetsDesc))
+			}
+		}
+	case CriterionNoCrimeConviction:
+		for _, ap := range a.Applicants {
+			if ap.CrimeConviction {
+				fired(ap.FullName, withDesc("has a criminal conviction", ap.CrimeDesc))
+			}
+		}
+	case CriterionNoBankruptcy:
+		for _, ap := range a.Applicants {
+			if ap.BankruptcyFiled {
+				fired(ap.FullName, withDesc("has filed bankruptcy", ap.BankruptcyDesc))
+			}
+		}
+	case CriterionMaxVehicles:
+		var vehicles int
+		for _, ap := range a.Applicants {
+			vehicles += ap.VehicleCount
+		}
+		if vehicles > r.Limit {
+			fired("", fmt.Sprintf("%d vehicles, at most %d allowed", vehicles, r.Limit))
+		}
+	case CriterionMinIncome:
+		// income in another currency than the rent can not be compared so it does not count
+		income := rent.Zero()
+		for _, ap := range a.Applicants {
+			if ap.MonthlyIncome.Currency == rent.Currency {
+				income.Minor += ap.MonthlyIncome.Minor
+			}
+		}
+		required := rent.Prorate(r.Limit, 100)
+		if income.Minor < required.Minor {
+			fired("", fmt.Sprintf("combined monthly income %s is less than %s, %d%% of rent %s",
+				income, required, r.Limit, rent))
+		}
+	}
+	return findings
+}
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (r ScreeningReport) GetID() ID { return r.ID }
+
+func withDesc(reason, desc string) string {
+	if desc == "" {
+		return reason
+	}
+	return reason + ": " + desc
+}
+func rulesEqual(r1, r2 []ScreeningRule) bool {
+	if len(r1) != len(r2) {
+		return false
+	}
+	for i := range r1 {
+		if r1[i] != r2[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,121 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+)
+
+func TestScreeningPolicy_Validate(t *testing.T) {
+	var (
+		propertyID = entity.NewID()
+		rent       = entity.NewMoney(150000, entity.CurrencyUSD)
+	)
+	valid := entity.NewScreeningPolicy(propertyID,
+		entity.NewScreeningRule(entity.CriterionNoPets, entity.ScreeningActionFail),
+		entity.NewScreeningRule(entity.CriterionMinIncome, entity.ScreeningActionReview).WithLimit(300),
+	).WithRent(rent)
+	require.NoError(t, valid.Validate())
+	require.NoError(t, entity.NewScreeningPolicy(propertyID).Validate(), "a policy without rules is valid")
+
+	tests := map[string]struct {
+		policy entity.ScreeningPolicy
+		fields []string
+	}{
+		"no property": {
+			policy: entity.NewScreeningPolicy(""),
+			fields: []string{"propertyID"},
+		},
+		"unknown criterion": {
+			policy: valid.WithRule(entity.NewScreeningRule("no_smoking", entity.ScreeningActionFail)),
+			fields: []string{"rules[2].criterion"},
+		},
+		"unknown action": {
+			policy: valid.WithRule(entity.NewScreeningRule(entity.CriterionNoBankruptcy, "deny")),
+			fields: []string{"rules[2].action"},
+		},
+		"negative vehicles": {
+			policy: valid.WithRule(entity.NewScreeningRule(entity.CriterionMaxVehicles, entity.ScreeningActionFail).WithLimit(-1)),
+			fields: []string{"rules[2].limit"},
+		},
+		"min income without rent": {
+			policy: valid.WithRent(entity.Money{}),
+			fields: []string{"rent"},
+		},
+		"bad currency": {
+			policy: valid.WithRent(entity.NewMoney(100, "XX")),
+			fields: []string{"rent"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			require.ErrorIs(t, err, internal.ErrEntityInvalid)
+			var fields []string
+			for _, fe := range internal.FieldErrors(err) {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
+
+func TestScreeningPolicy_Evaluate(t *testing.T) {
+	var (
+		usd    = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		noPets = entity.NewScreeningRule(entity.CriterionNoPets, entity.ScreeningActionFail)
+		cars   = entity.NewScreeningRule(entity.CriterionMaxVehicles, entity.ScreeningActionReview).WithLimit(2)
+		income = entity.NewScreeningRule(entity.CriterionMinIncome, entity.ScreeningActionReview).WithLimit(300)
+		policy = entity.NewScreeningPolicy(entity.NewID(), noPets, cars, income).WithRent(usd(100000))
+	)
+	applicant := func(vehicles, income int, hasPets bool) entity.Applicant {
+		ap := fake.Applicant()
+		ap.VehicleCount = vehicles
+		ap.MonthlyIncome = usd(income)
+		ap.HasPets = hasPets
+		return ap
+	}
+
+	t.Run("pass", func(t *testing.T) {
+		app := entity.NewRentalApplication(policy.PropertyID, applicant(1, 200000, false), applicant(1, 100000, false))
+		report := policy.Evaluate(app)
+		assert.Equal(t, entity.ScreeningPass, report.Result)
+		assert.Empty(t, report.Findings)
+		assert.Equal(t, app.ID, report.ApplicationID)
+		assert.Equal(t, policy.Rules, report.Rules)
+		assert.Equal(t, policy.Rent, report.Rent)
+	})
+	t.Run("needs review", func(t *testing.T) {
+		app := entity.NewRentalApplication(policy.PropertyID, applicant(2, 200000, false), applicant(1, 99999, false))
+		report := policy.Evaluate(app)
+		assert.Equal(t, entity.ScreeningNeedsReview, report.Result)
+		require.Len(t, report.Findings, 2)
+		assert.Equal(t, cars, report.Findings[0].Rule)
+		assert.Equal(t, "3 vehicles, at most 2 allowed", report.Findings[0].Reason)
+		assert.Equal(t, income, report.Findings[1].Rule)
+		assert.Contains(t, report.Findings[1].Reason, "2,999.99 USD")
+	})
+	t.Run("fail", func(t *testing.T) {
+		pets := applicant(0, 400000, true)
+		pets.PetsDesc = "two cats"
+		app := entity.NewRentalApplication(policy.PropertyID, pets)
+		report := policy.Evaluate(app)
+		assert.Equal(t, entity.ScreeningFail, report.Result)
+		require.Len(t, report.Findings, 1)
+		assert.Equal(t, noPets, report.Findings[0].Rule)
+		assert.Equal(t, pets.FullName, report.Findings[0].Applicant)
+		assert.Equal(t, "has pets: two cats", report.Findings[0].Reason)
+	})
+	t.Run("income in another currency", func(t *testing.T) {
+		ap := applicant(0, 0, false)
+		ap.MonthlyIncome = entity.NewMoney(900000, "EUR")
+		report := policy.Evaluate(entity.NewRentalApplication(policy.PropertyID, ap))
+		assert.Equal(t, entity.ScreeningNeedsReview, report.Result)
+		require.Len(t, report.Findings, 1)
+		assert.Equal(t, income, report.Findings[0].Rule)
+	})
+}
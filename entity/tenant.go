@@ -1,6 +1,9 @@
 package entity
 
 import (
+	"time"
+
+	"github.com/tempcke/rpm/internal"
 	"github.com/tempcke/schedule"
 )
 
@@ -9,8 +12,10 @@ type Tenant struct {
 	FullName    string
 	DateOfBirth schedule.Date
 	Phones      []Phone
-	DLNum       string // drivers license number
-	DLState     string // drivers license state
+	DLNum       string    // drivers license number
+	DLState     string    // drivers license state
+	CreatedAt   time.Time // set by the repository when the tenant is first stored
+	DeletedAt   time.Time // zero unless the tenant was removed
 }
 
 func NewTenant(name string, dob schedule.Date) Tenant {
@@ -38,6 +43,46 @@ func (t Tenant) WithDOB(date schedule.Date) Tenant {
 }
 
 func (t Tenant) GetID() ID { return t.ID }
+
+// HasPhone is true when one of the phones of the tenant has the number
+func (t Tenant) HasPhone(number string) bool {
+	return t.phoneIndex(number) >= 0
+}
+
+// WithPhoneReplaced replaces the phone with the number, when the tenant has
+// no phone with the number the tenant is returned unchanged
+func (t Tenant) WithPhoneReplaced(number string, p Phone) Tenant {
+	i := t.phoneIndex(number)
+	if i < 0 {
+		return t
+	}
+	t.Phones = append([]Phone{}, t.Phones...)
+	t.Phones[i] = p
+	return t
+}
+
+// WithoutPhone removes the phone with the number
+func (t Tenant) WithoutPhone(number string) Tenant {
+	var phones []Phone
+	for _, p := range t.Phones {
+		if p.Number != number {
+			phones = append(phones, p)
+		}
+	}
+	t.Phones = phones
+	return t
+}
+func (t Tenant) phoneIndex(number string) int {
+	for i, p := range t.Phones {
+		if p.Number == number {
+			return i
+		}
+	}
+	return -1
+}
+
+// Deleted is true once the tenant was removed, until it is restored
+func (t Tenant) Deleted() bool { return !t.DeletedAt.IsZero() }
 func (t Tenant) Equal(t2 Tenant) bool {
 	return idEqualOrEmpty(t.ID, t2.ID) &&
 		t.FullName == t2.FullName &&
@@ -47,6 +92,143 @@ func (t Tenant) Equal(t2 Tenant) bool {
 
 func (t Tenant) Ptr() *Tenant { return &t }
 
+// TenantField names a field of a tenant which can be patched, the names are
+// the same as the json and protobuf field names
+type TenantField string
+
+const (
+	TenantFullName    TenantField = "fullName"
+	TenantDateOfBirth TenantField = "dob"
+	TenantDLNum       TenantField = "dlNum"
+	TenantDLState     TenantField = "dlState"
+	TenantPhones      TenantField = "phones"
+)
+
+// TenantFields are all the fields of a tenant which can be patched
+var TenantFields = []TenantField{TenantFullName, TenantDateOfBirth, TenantDLNum, TenantDLState, TenantPhones}
+
+func (f TenantField) Valid() bool {
+	for _, v := range TenantFields {
+		if f == v {
+			return true
+		}
+	}
+	return false
+}
+
+// TenantPatch changes only the fields it has, the rest of the tenant is kept
+// a field it has with a zero value is cleared
+type TenantPatch struct {
+	Tenant Tenant // the new value of every field in Fields
+	Fields []TenantField
+}
+
+func NewTenantPatch() TenantPatch {
+	return TenantPatch{}
+}
+func (p TenantPatch) WithName(name string) TenantPatch {
+	p.Tenant.FullName = name
+	return p.with(TenantFullName)
+}
+func (p TenantPatch) WithDOB(date schedule.Date) TenantPatch {
+	p.Tenant.DateOfBirth = date
+	return p.with(TenantDateOfBirth)
+}
+func (p TenantPatch) WithDL(num, state string) TenantPatch {
+	p.Tenant.DLNum, p.Tenant.DLState = num, state
+	return p.with(TenantDLNum).with(TenantDLState)
+}
+func (p TenantPatch) WithPhones(phones ...Phone) TenantPatch {
+	p.Tenant.Phones = append([]Phone{}, phones...)
+	return p.with(TenantPhones)
+}
+
+// WithField sets the field to the value it has in t
+func (p TenantPatch) WithField(f TenantField, t Tenant) TenantPatch {
+	switch f {
+	case TenantFullName:
+		p.Tenant.FullName = t.FullName
+	case TenantDateOfBirth:
+		p.Tenant.DateOfBirth = t.DateOfBirth
+	case TenantDLNum:
+		p.Tenant.DLNum = t.DLNum
+	case TenantDLState:
+		p.Tenant.DLState = t.DLState
+	case TenantPhones:
+		p.Tenant.Phones = append([]Phone{}, t.Phones...)
+	}
+	return p.with(f)
+}
+func (p TenantPatch) Has(f TenantField) bool {
+	for _, v := range p.Fields {
+		if v == f {
+			return true
+		}
+	}
+	return false
+}
+func (p TenantPatch) with(f TenantField) TenantPatch {
+	if !p.Has(f) {
+		p.Fields = append(append([]TenantField{}, p.Fields...), f)
+	}
+	return p
+}
+
+// Apply the patch to t, the id of t is kept
+func (p TenantPatch) Apply(t Tenant) Tenant {
+	for _, f := range p.Fields {
+		switch f {
+		case TenantFullName:
+			t.FullName = p.Tenant.FullName
+		case TenantDateOfBirth:
+			t.DateOfBirth = p.Tenant.DateOfBirth
+		case TenantDLNum:
+			t.DLNum = p.Tenant.DLNum
+		case TenantDLState:
+			t.DLState = p.Tenant.DLState
+		case TenantPhones:
+			t.Phones = append([]Phone(nil), p.Tenant.Phones...)
+		}
+	}
+	return t
+}
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every field which can not be patched as is
+func (p TenantPatch) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	for _, f := range p.Fields {
+		switch f {
+		case TenantFullName:
+			if p.Tenant.FullName == "" {
+				invalid(string(f), "can not be removed")
+			}
+		case TenantDateOfBirth:
+			if p.Tenant.DateOfBirth.IsZero() {
+				invalid(string(f), "can not be removed")
+			}
+		case TenantPhones:
+			for _, phone := range p.Tenant.Phones {
+				if phone.Validate() != nil {
+					invalid(string(f), "must each have a number")
+					break
+				}
+			}
+		default:
+			if !f.Valid() {
+				invalid(string(f), "is not a field of a tenant")
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+
 func phoneListEqual(a, b []Phone) bool {
 	if len(a) != len(b) {
 		return false
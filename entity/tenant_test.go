@@ -3,9 +3,11 @@ package entity_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
 	"github.com/tempcke/schedule"
 )
 
@@ -24,3 +26,71 @@ func TestTenant(t *testing.T) {
 	require.True(t, tnt.Equal(tnt.WithID("")))
 	require.Equal(t, tnt.ID, tnt.GetID())
 }
+func TestTenantPatch(t *testing.T) {
+	var (
+		p1 = entity.NewPhone("555-010-0001")
+		p2 = entity.NewPhone("555-010-0002")
+		in = fake.Tenant().WithID(entity.NewID())
+	)
+	in.DLNum, in.DLState = "646673153", "TX"
+
+	t.Run("only the fields of the patch change", func(t *testing.T) {
+		patch := entity.NewTenantPatch().WithName("bob").WithPhones(p1)
+		require.NoError(t, patch.Validate())
+		assert.True(t, patch.Has(entity.TenantFullName))
+		assert.False(t, patch.Has(entity.TenantDLNum))
+
+		out := patch.Apply(in)
+		want := in.WithName("bob")
+		want.Phones = []entity.Phone{p1}
+		assert.Equal(t, want, out)
+	})
+	t.Run("a zero value clears the field", func(t *testing.T) {
+		out := entity.NewTenantPatch().WithDL("", "").WithPhones().Apply(in)
+		assert.Equal(t, "", out.DLNum)
+		assert.Equal(t, "", out.DLState)
+		assert.Empty(t, out.Phones)
+		assert.Equal(t, in.FullName, out.FullName)
+	})
+	t.Run("with field", func(t *testing.T) {
+		var (
+			src   = fake.Tenant()
+			patch = entity.NewTenantPatch()
+		)
+		for _, f := range entity.TenantFields {
+			patch = patch.WithField(f, src)
+		}
+		assert.Equal(t, src.WithID(in.ID), patch.Apply(in))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for name, patch := range map[string]entity.TenantPatch{
+			"no name":    entity.NewTenantPatch().WithName(""),
+			"no dob":     entity.NewTenantPatch().WithDOB(schedule.Date{}),
+			"no number":  entity.NewTenantPatch().WithPhones(p2, entity.Phone{}),
+			"not fields": entity.NewTenantPatch().WithField("ssn", in),
+		} {
+			t.Run(name, func(t *testing.T) {
+				assert.ErrorIs(t, patch.Validate(), internal.ErrEntityInvalid)
+			})
+		}
+	})
+}
+func TestTenant_phones(t *testing.T) {
+	var (
+		p1 = entity.NewPhone("555-010-0001")
+		p2 = entity.NewPhone("555-010-0002")
+		p3 = entity.NewPhone("555-010-0003")
+		in = fake.Tenant()
+	)
+	in.Phones = []entity.Phone{p1, p2}
+	assert.True(t, in.HasPhone(p1.Number))
+	assert.False(t, in.HasPhone(p3.Number))
+
+	out := in.WithPhoneReplaced(p2.Number, p3)
+	assert.Equal(t, []entity.Phone{p1, p3}, out.Phones)
+	assert.Equal(t, []entity.Phone{p1, p2}, in.Phones, "should not change the original")
+	assert.Equal(t, in, in.WithPhoneReplaced(p3.Number, p1))
+
+	assert.Equal(t, []entity.Phone{p2}, in.WithoutPhone(p1.Number).Phones)
+	assert.Equal(t, in.Phones, in.WithoutPhone(p3.Number).Phones)
+}
@@ -0,0 +1,115 @@
+package entity
+
+import (
+	"math"
+	"strings"
+
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+// Unit of a property which is leased on its own, a house has one unit while
+// a duplex or an apartment building has one for each home in it
+type Unit struct {
+	ID         ID
+	PropertyID ID
+	Number     string  // ex: "A" or "101", empty for the only unit of a property
+	Beds       int     // bedrooms
+	Baths      float64 // bathrooms, a half bath counts as 0.5
+	SqFt       int     // square feet
+	RentTarget Money   // the monthly rent the unit should lease for
+
+	// LeaseID is the lease whose term included the day the unit was read, it
+	// is empty when the unit was vacant that day
+	LeaseID ID
+}
+
+func NewUnit(propertyID ID, number string) Unit {
+	return Unit{
+		ID:         NewID(),
+		PropertyID: propertyID,
+		Number:     number,
+	}
+}
+
+// DefaultUnit of a property is the unit every property gets when it is added,
+// its number is the unit line of the address
+func DefaultUnit(p Property) Unit {
+	return NewUnit(p.ID, strings.TrimSpace(p.Unit))
+}
+func (u Unit) WithID(id ID) Unit { u.ID = id; return u }
+func (u Unit) WithRooms(beds int, baths float64) Unit {
+	u.Beds = beds
+	u.Baths = baths
+	return u
+}
+func (u Unit) WithSqFt(v int) Unit         { u.SqFt = v; return u }
+func (u Unit) WithRentTarget(v Money) Unit { u.RentTarget = v; return u }
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (u Unit) GetID() ID { return u.ID }
+
+// Occupied is true when a lease of the unit was in term the day it was read
+func (u Unit) Occupied() bool { return u.LeaseID != "" }
+
+// Occupy sets the LeaseID to the first lease of the unit in term on the day,
+// a lease without a unit is of the whole property so it occupies every unit
+func (u Unit) Occupy(day schedule.Date, leases ...Lease) Unit {
+	u.LeaseID = ""
+	for _, l := range leases {
+		if l.PropertyID == u.PropertyID && (l.UnitID == "" || l.UnitID == u.ID) && l.InTerm(day) {
+			u.LeaseID = l.ID
+			break
+		}
+	}
+	return u
+}
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field, so they can all be reported at once
+func (u Unit) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if u.ID == "" {
+		invalid("id", "is required")
+	}
+	if u.PropertyID == "" {
+		invalid("propertyID", "is required")
+	}
+	if u.Beds < 0 {
+		invalid("beds", "can not be negative")
+	}
+	if u.Baths < 0 {
+		invalid("baths", "can not be negative")
+	} else if u.Baths*2 != math.Trunc(u.Baths*2) {
+		invalid("baths", "must be a whole or half number, ex: 1.5")
+	}
+	if u.SqFt < 0 {
+		invalid("sqft", "can not be negative")
+	}
+	if u.RentTarget != (Money{}) {
+		if err := u.RentTarget.Validate(); err != nil {
+			invalid("rentTarget", err.Error())
+		} else if u.RentTarget.IsNegative() {
+			invalid("rentTarget", "can not be negative")
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+
+// Equal compares what is stored of the units, the LeaseID is left out
+func (u Unit) Equal(u2 Unit) bool {
+	return idEqualOrEmpty(u.ID, u2.ID) &&
+		u.PropertyID == u2.PropertyID &&
+		u.Number == u2.Number &&
+		u.Beds == u2.Beds &&
+		u.Baths == u2.Baths &&
+		u.SqFt == u2.SqFt &&
+		u.RentTarget.Equal(u2.RentTarget)
+}
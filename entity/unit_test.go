@@ -0,0 +1,69 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+func TestUnit_Validate(t *testing.T) {
+	valid := entity.NewUnit(entity.NewID(), "101").
+		WithRooms(2, 1.5).
+		WithSqFt(850).
+		WithRentTarget(entity.NewMoney(125000, entity.CurrencyUSD))
+	require.NoError(t, valid.Validate())
+	require.NoError(t, entity.NewUnit(entity.NewID(), "").Validate(), "only the property is required")
+
+	tests := map[string]struct {
+		unit   entity.Unit
+		fields []string
+	}{
+		"empty":          {entity.Unit{}, []string{"id", "propertyID"}},
+		"negative rooms": {valid.WithRooms(-1, -1), []string{"beds", "baths"}},
+		"quarter bath":   {valid.WithRooms(2, 1.25), []string{"baths"}},
+		"negative sqft":  {valid.WithSqFt(-850), []string{"sqft"}},
+		"rent currency":  {valid.WithRentTarget(entity.NewMoney(125000, "XX")), []string{"rentTarget"}},
+		"negative rent":  {valid.WithRentTarget(entity.NewMoney(-1, entity.CurrencyUSD)), []string{"rentTarget"}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.unit.Validate()
+			require.ErrorIs(t, err, internal.ErrEntityInvalid)
+			var fields []string
+			for _, fe := range internal.FieldErrors(err) {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
+func TestUnit_Occupy(t *testing.T) {
+	var (
+		p     = entity.NewProperty("1234 N Main st.", "Dallas", "TX", "75401")
+		unitA = entity.NewUnit(p.ID, "A")
+		unitB = entity.NewUnit(p.ID, "B")
+		jan1  = schedule.NewDate(2024, time.January, 1)
+		dec31 = schedule.NewDate(2024, time.December, 31)
+		lease = entity.NewLease(p.ID).WithUnit(unitA.ID).WithTerm(jan1, dec31)
+	)
+	assert.Equal(t, lease.ID, unitA.Occupy(jan1, lease).LeaseID)
+	assert.True(t, unitA.Occupy(dec31, lease).Occupied())
+	assert.False(t, unitA.Occupy(dec31.Next(), lease).Occupied(), "the lease ended")
+	assert.False(t, unitB.Occupy(jan1, lease).Occupied(), "another unit was leased")
+
+	whole := entity.NewLease(p.ID).WithTerm(jan1, dec31)
+	assert.Equal(t, whole.ID, unitB.Occupy(jan1, whole).LeaseID, "the whole property was leased")
+}
+func TestDefaultUnit(t *testing.T) {
+	p := entity.NewProperty("1234 N Main st.", "Dallas", "TX", "75401")
+	u := entity.DefaultUnit(p)
+	assert.Equal(t, p.ID, u.PropertyID)
+	assert.Empty(t, u.Number)
+	assert.NotEmpty(t, u.ID)
+	assert.Equal(t, "Apt 5", entity.DefaultUnit(p.WithAddress(p.Address.WithUnit(" Apt 5"))).Number)
+}
@@ -0,0 +1,150 @@
+package entity
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/tempcke/rpm/internal"
+)
+
+type DeliveryStatus = string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"   // not delivered yet, retried at NextAttempt
+	DeliveryDelivered DeliveryStatus = "delivered" // the receiver answered with a 2xx status
+	DeliveryDead      DeliveryStatus = "dead"      // gave up after too many attempts, only redelivered by hand
+)
+
+// Webhook subscribes a URL to events, every delivery is signed with the secret
+// so the receiver can tell it came from us
+type Webhook struct {
+	ID        ID
+	URL       string
+	Events    []string // event names, ex: "rental.added", every event when empty
+	Secret    string
+	CreatedAt time.Time
+}
+
+// WebhookDelivery of an event to a webhook, Payload is the request body
+// which is posted as is on every attempt
+type WebhookDelivery struct {
+	ID           ID
+	WebhookID    ID
+	Event        string // event name
+	Payload      []byte
+	Status       DeliveryStatus
+	StatusCode   int // of the last attempt, zero when the receiver did not answer
+	Attempts     int
+	LastError    string
+	RedeliveryOf ID // the delivery this is a redelivery of, empty for the first
+	CreatedAt    time.Time
+	NextAttempt  time.Time
+	DeliveredAt  time.Time
+}
+
+func NewWebhook(url, secret string, events ...string) Webhook {
+	return Webhook{
+		ID:     NewID(),
+		URL:    url,
+		Events: events,
+		Secret: secret,
+	}
+}
+func (w Webhook) WithID(id ID) Webhook {
+	w.ID = id
+	return w
+}
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (w Webhook) GetID() ID { return w.ID }
+
+// Subscribed to the event with the name
+func (w Webhook) Subscribed(name string) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns internal.ErrEntityInvalid along with an internal.FieldError
+// for every invalid field, whether the event names exist is up to the caller
+func (w Webhook) Validate() error {
+	var errs []error
+	invalid := func(field, reason string) {
+		errs = append(errs, internal.NewFieldError(field, reason))
+	}
+	if w.ID == "" {
+		invalid("id", "is required")
+	}
+	if u, err := url.Parse(w.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		invalid("url", "must be an absolute http or https url")
+	}
+	if len(w.Secret) < 16 {
+		invalid("secret", "must be at least 16 characters")
+	}
+	for i, e := range w.Events {
+		if e == "" {
+			invalid(fmt.Sprintf("events[%d]", i), "is required")
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+
+// NewWebhookDelivery of the payload to the webhook, pending from now
+func NewWebhookDelivery(webhookID ID, event string, payload []byte, now time.Time) WebhookDelivery {
+	return WebhookDelivery{
+		ID:          NewID(),
+		WebhookID:   webhookID,
+		Event:       event,
+		Payload:     payload,
+		Status:      DeliveryPending,
+		CreatedAt:   now,
+		NextAttempt: now,
+	}
+}
+
+// GetID of entity
+// method needed to implement entity.Entity
+func (d WebhookDelivery) GetID() ID { return d.ID }
+
+// Delivered by the attempt which got the statusCode
+func (d WebhookDelivery) Delivered(statusCode int, now time.Time) WebhookDelivery {
+	d.Status = DeliveryDelivered
+	d.StatusCode = statusCode
+	d.Attempts++
+	d.LastError = ""
+	d.DeliveredAt = now
+	return d
+}
+
+// Failed attempt, retried at next or dead when next is zero, statusCode is
+// zero when the receiver did not answer
+func (d WebhookDelivery) Failed(statusCode int, err error, next time.Time) WebhookDelivery {
+	d.StatusCode = statusCode
+	d.Attempts++
+	d.LastError = err.Error()
+	if next.IsZero() {
+		d.Status = DeliveryDead
+		return d
+	}
+	d.NextAttempt = next
+	return d
+}
+
+// Redeliver the same payload as a new delivery pending from now, the
+// delivery itself is kept as it was
+func (d WebhookDelivery) Redeliver(now time.Time) WebhookDelivery {
+	r := NewWebhookDelivery(d.WebhookID, d.Event, d.Payload, now)
+	r.RedeliveryOf = d.ID
+	return r
+}
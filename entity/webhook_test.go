@@ -0,0 +1,102 @@
+package entity_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+)
+
+func TestWebhook_Validate(t *testing.T) {
+	valid := fake.Webhook("rental.added")
+	require.NoError(t, valid.Validate())
+
+	tests := map[string]struct {
+		webhook entity.Webhook
+		fields  []string
+	}{
+		"no id": {
+			webhook: valid.WithID(""),
+			fields:  []string{"id"},
+		},
+		"relative url": {
+			webhook: entity.NewWebhook("/hooks", valid.Secret),
+			fields:  []string{"url"},
+		},
+		"not http": {
+			webhook: entity.NewWebhook("ftp://example.com/hooks", valid.Secret),
+			fields:  []string{"url"},
+		},
+		"short secret": {
+			webhook: entity.NewWebhook(valid.URL, "secret"),
+			fields:  []string{"secret"},
+		},
+		"empty event": {
+			webhook: entity.NewWebhook(valid.URL, valid.Secret, "rental.added", ""),
+			fields:  []string{"events[1]"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.webhook.Validate()
+			require.ErrorIs(t, err, internal.ErrEntityInvalid)
+			var fields []string
+			for _, fe := range internal.FieldErrors(err) {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
+
+func TestWebhook_Subscribed(t *testing.T) {
+	assert.True(t, fake.Webhook().Subscribed("rental.added"), "every event when none are given")
+	w := fake.Webhook("rental.added", "lease.renewed")
+	assert.True(t, w.Subscribed("lease.renewed"))
+	assert.False(t, w.Subscribed("tenant.added"))
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	var (
+		now     = time.Now()
+		next    = now.Add(time.Minute)
+		failure = errors.New("bad gateway")
+		d       = entity.NewWebhookDelivery(entity.NewID(), "rental.added", []byte(`{}`), now)
+	)
+	assert.Equal(t, entity.DeliveryPending, d.Status)
+	assert.Equal(t, now, d.NextAttempt)
+
+	failed := d.Failed(http.StatusBadGateway, failure, next)
+	assert.Equal(t, entity.DeliveryPending, failed.Status)
+	assert.Equal(t, http.StatusBadGateway, failed.StatusCode)
+	assert.Equal(t, 1, failed.Attempts)
+	assert.Equal(t, failure.Error(), failed.LastError)
+	assert.Equal(t, next, failed.NextAttempt)
+
+	dead := failed.Failed(0, failure, time.Time{})
+	assert.Equal(t, entity.DeliveryDead, dead.Status)
+	assert.Zero(t, dead.StatusCode)
+	assert.Equal(t, 2, dead.Attempts)
+
+	delivered := failed.Delivered(http.StatusNoContent, next)
+	assert.Equal(t, entity.DeliveryDelivered, delivered.Status)
+	assert.Equal(t, http.StatusNoContent, delivered.StatusCode)
+	assert.Equal(t, 2, delivered.Attempts)
+	assert.Empty(t, delivered.LastError)
+	assert.Equal(t, next, delivered.DeliveredAt)
+
+	again := dead.Redeliver(next)
+	assert.NotEqual(t, dead.ID, again.ID)
+	assert.Equal(t, dead.ID, again.RedeliveryOf)
+	assert.Equal(t, dead.WebhookID, again.WebhookID)
+	assert.Equal(t, dead.Payload, again.Payload)
+	assert.Equal(t, entity.DeliveryPending, again.Status)
+	assert.Zero(t, again.Attempts)
+	assert.Equal(t, next, again.NextAttempt)
+}
@@ -0,0 +1,174 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	Transport = string
+	Action    = string
+)
+
+const (
+	TransportREST Transport = "rest"
+	TransportGRPC Transport = "grpc"
+
+	ActionCreate  Action = "create"
+	ActionUpdate  Action = "update"
+	ActionDelete  Action = "delete"
+	ActionRestore Action = "restore"
+
+	EntityProperty = "property"
+	EntityTenant   = "tenant"
+	EntityLease    = "lease"
+)
+
+// Actor who made a change and how they reached us, the ID is the api key
+// until callers have an identity of their own
+type Actor struct {
+	ID        string
+	Transport Transport
+}
+
+// Entry in the audit log, Diff holds the old and new value of every field
+// which changed, ex: {"City": {"old": "Dallas", "new": "Austin"}}
+type Entry struct {
+	ID         string
+	Actor      string
+	Transport  Transport
+	EntityType string
+	EntityID   string
+	Action     Action
+	Diff       json.RawMessage
+	CreatedAt  time.Time
+}
+
+// Change made to an entity, Old is nil when it is created and New is nil
+// when it is deleted, personal information must be masked by the caller
+type Change struct {
+	EntityType string
+	EntityID   string
+	Action     Action // only needed for actions other than create, update and delete
+	Old        any
+	New        any
+}
+
+// NewEntry for the change made by the actor of the context
+func NewEntry(ctx context.Context, c Change, now time.Time) (Entry, error) {
+	diff, err := Diff(c.Old, c.New)
+	if err != nil {
+		return Entry{}, err
+	}
+	actor := ActorFrom(ctx)
+	return Entry{
+		ID:         uuid.NewString(),
+		Actor:      actor.ID,
+		Transport:  actor.Transport,
+		EntityType: c.EntityType,
+		EntityID:   c.EntityID,
+		Action:     c.action(),
+		Diff:       diff,
+		CreatedAt:  now,
+	}, nil
+}
+
+func (e Entry) GetID() string { return e.ID }
+
+// action the change is when it is not set, an update unless it has no old
+// or no new value
+func (c Change) action() Action {
+	switch {
+	case c.Action != "":
+		return c.Action
+	case c.Old == nil:
+		return ActionCreate
+	case c.New == nil:
+		return ActionDelete
+	default:
+		return ActionUpdate
+	}
+}
+
+type fieldDiff struct {
+	Old json.RawMessage `json:"old"`
+	New json.RawMessage `json:"new"`
+}
+
+// Diff of the json encoding of two values, the old and new value of every
+// top level field which differs, a nil value has no fields
+func Diff(old, new any) (json.RawMessage, error) {
+	oldFields, err := fields(old)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := fields(new)
+	if err != nil {
+		return nil, err
+	}
+	diff := make(map[string]fieldDiff)
+	for k, o := range oldFields {
+		if n := newFields[k]; !bytes.Equal(o, n) {
+			diff[k] = fieldDiff{Old: orNull(o), New: orNull(n)}
+		}
+	}
+	for k, n := range newFields {
+		if _, ok := oldFields[k]; !ok {
+			diff[k] = fieldDiff{Old: orNull(nil), New: n}
+		}
+	}
+	return json.Marshal(diff)
+}
+func fields(v any) (map[string]json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+func orNull(v json.RawMessage) json.RawMessage {
+	if v == nil {
+		return json.RawMessage("null")
+	}
+	return v
+}
+
+type actorKey struct{}
+
+// WithActor making the changes of the context, set by the transport once the
+// caller is authenticated
+func WithActor(ctx context.Context, a Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, a)
+}
+
+// ActorFrom the context, zero when no transport set one
+func ActorFrom(ctx context.Context) Actor {
+	a, _ := ctx.Value(actorKey{}).(Actor)
+	return a
+}
+
+type stagedKey struct{}
+
+// Stage changes in the context of a write, a repository with an audit log
+// records them in the same transaction as the write itself
+func Stage(ctx context.Context, changes ...Change) context.Context {
+	staged := append(Staged(ctx), changes...)
+	return context.WithValue(ctx, stagedKey{}, staged)
+}
+
+// Staged changes of the context, nil when none are
+func Staged(ctx context.Context) []Change {
+	staged, _ := ctx.Value(stagedKey{}).([]Change)
+	return staged[:len(staged):len(staged)]
+}
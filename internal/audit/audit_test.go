@@ -0,0 +1,96 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/internal/audit"
+)
+
+var ctx = context.Background()
+
+type thing struct {
+	Name  string
+	Color string
+	Size  int
+}
+
+func TestDiff(t *testing.T) {
+	var (
+		old = thing{Name: "box", Color: "red", Size: 2}
+		new = thing{Name: "box", Color: "blue", Size: 2}
+	)
+	diff, err := audit.Diff(old, new)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Color": {"old": "red", "new": "blue"}}`, string(diff))
+
+	diff, err = audit.Diff(nil, old)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"Name": {"old": null, "new": "box"},
+		"Color": {"old": null, "new": "red"},
+		"Size": {"old": null, "new": 2}
+	}`, string(diff))
+
+	diff, err = audit.Diff(old, nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"Name": {"old": "box", "new": null},
+		"Color": {"old": "red", "new": null},
+		"Size": {"old": 2, "new": null}
+	}`, string(diff))
+
+	diff, err = audit.Diff(old, old)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(diff))
+}
+
+func TestNewEntry(t *testing.T) {
+	var (
+		now   = time.Now()
+		actor = audit.Actor{ID: "key1", Transport: audit.TransportREST}
+		c     = audit.Change{EntityType: "thing", EntityID: "t1", Old: thing{Size: 1}, New: thing{Size: 2}}
+	)
+	e, err := audit.NewEntry(audit.WithActor(ctx, actor), c, now)
+	require.NoError(t, err)
+	assert.NotEmpty(t, e.ID)
+	assert.Equal(t, "key1", e.Actor)
+	assert.Equal(t, audit.TransportREST, e.Transport)
+	assert.Equal(t, "thing", e.EntityType)
+	assert.Equal(t, "t1", e.EntityID)
+	assert.Equal(t, audit.ActionUpdate, e.Action)
+	assert.JSONEq(t, `{"Size": {"old": 1, "new": 2}}`, string(e.Diff))
+	assert.Equal(t, now, e.CreatedAt)
+
+	e, err = audit.NewEntry(ctx, audit.Change{New: thing{}}, now)
+	require.NoError(t, err)
+	assert.Equal(t, audit.ActionCreate, e.Action)
+	assert.Empty(t, e.Actor)
+
+	e, err = audit.NewEntry(ctx, audit.Change{Old: thing{}}, now)
+	require.NoError(t, err)
+	assert.Equal(t, audit.ActionDelete, e.Action)
+
+	e, err = audit.NewEntry(ctx, audit.Change{Action: audit.ActionRestore}, now)
+	require.NoError(t, err)
+	assert.Equal(t, audit.ActionRestore, e.Action)
+	assert.JSONEq(t, `{}`, string(e.Diff))
+}
+
+func TestStage(t *testing.T) {
+	var (
+		c1 = audit.Change{EntityType: "thing", EntityID: "t1"}
+		c2 = audit.Change{EntityType: "thing", EntityID: "t2"}
+		c3 = audit.Change{EntityType: "thing", EntityID: "t3"}
+	)
+	assert.Empty(t, audit.Staged(ctx))
+
+	staged := audit.Stage(ctx, c1)
+	a, b := audit.Stage(staged, c2), audit.Stage(staged, c3)
+	assert.Equal(t, []audit.Change{c1}, audit.Staged(staged))
+	assert.Equal(t, []audit.Change{c1, c2}, audit.Staged(a))
+	assert.Equal(t, []audit.Change{c1, c3}, audit.Staged(b))
+}
@@ -9,6 +9,11 @@ const (
 	EnvAPISecret       = "API_SECRET"
 	EnvServiceCertFile = "SERVICE_CERT_FILE"
 	EnvServiceKeyFile  = "SERVICE_KEY_FILE"
+	EnvPIIKeyFile      = "PII_KEY_FILE"
+	EnvPIIPlaintext    = "PII_PLAINTEXT"
+	EnvAPIPIIKey       = "API_PII_KEY"
+	EnvAPIPIISecret    = "API_PII_SECRET"
+	EnvPurgeRetention  = "PURGE_RETENTION"
 
 	EnvPostgresDSN     = "POSTGRES_DSN"
 	EnvPostgresHost    = "POSTGRES_HOST"
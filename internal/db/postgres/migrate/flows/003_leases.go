@@ -0,0 +1,34 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+var Flow003Leases = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 3, 1),
+		Up: `
+			CREATE TABLE IF NOT EXISTS leases (
+				id            VARCHAR(36) PRIMARY KEY,
+				property_id   VARCHAR(36) NOT NULL REFERENCES properties (id),
+				start_date    date        NOT NULL,
+				end_date      date,
+				deposit       INTEGER     NOT NULL DEFAULT 0,
+				rent_amount   INTEGER     NOT NULL DEFAULT 0,
+				currency      VARCHAR(3)  NOT NULL DEFAULT 'USD',
+				rent_interval VARCHAR(16) NOT NULL DEFAULT '',
+
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+			);
+			CREATE INDEX lease_property_id ON leases(property_id);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 3, 2),
+		Up: `
+			CREATE TABLE IF NOT EXISTS lease_tenants (
+				lease_id    VARCHAR(36) NOT NULL REFERENCES leases (id) ON DELETE CASCADE,
+				tenant_id   VARCHAR(36) NOT NULL REFERENCES tenants (id),
+				PRIMARY KEY (lease_id, tenant_id)
+			);
+			CREATE INDEX lease_tenant_id ON lease_tenants(tenant_id);`,
+	},
+}
@@ -0,0 +1,20 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow004LeaseOverlap prevents two leases on one property from sharing a day,
+// a NULL end_date gives an unbounded range which overlaps every later lease
+var Flow004LeaseOverlap = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 4, 1),
+		Up: `CREATE EXTENSION IF NOT EXISTS btree_gist;`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 4, 2),
+		Up: `
+			ALTER TABLE leases ADD CONSTRAINT lease_term_no_overlap EXCLUDE USING gist (
+				property_id WITH =,
+				daterange(start_date, end_date, '[]') WITH &&
+			);`,
+	},
+}
@@ -0,0 +1,36 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow005Ledger entries are append only, the trigger rejects any UPDATE or DELETE
+// and the unique reverses_id allows each entry to be reversed only once
+var Flow005Ledger = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 5, 1),
+		Up: `
+			CREATE TABLE IF NOT EXISTS ledger_entries (
+				id          VARCHAR(36) PRIMARY KEY,
+				lease_id    VARCHAR(36) NOT NULL REFERENCES leases (id),
+				entry_type  VARCHAR(16) NOT NULL,
+				amount      INTEGER     NOT NULL CHECK (amount > 0),
+				entry_date  date        NOT NULL,
+				memo        TEXT        NOT NULL DEFAULT '',
+				reverses_id VARCHAR(36) UNIQUE REFERENCES ledger_entries (id),
+
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+			);
+			CREATE INDEX ledger_entry_lease_id ON ledger_entries(lease_id, entry_date);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 5, 2),
+		Up: `
+			CREATE OR REPLACE FUNCTION ledger_entries_append_only() RETURNS trigger AS $$
+			BEGIN
+				RAISE EXCEPTION 'ledger_entries is append only, post a reversing entry instead';
+			END;
+			$$ LANGUAGE plpgsql;
+			CREATE TRIGGER ledger_entries_append_only
+				BEFORE UPDATE OR DELETE ON ledger_entries
+				FOR EACH ROW EXECUTE FUNCTION ledger_entries_append_only();`,
+	},
+}
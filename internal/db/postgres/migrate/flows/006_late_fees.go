@@ -0,0 +1,31 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow006LateFees stores one late fee policy per lease or property, and adds
+// ref to the ledger so late fee charges can be traced to the rent they were for
+var Flow006LateFees = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 6, 1),
+		Up: `
+			ALTER TABLE ledger_entries ADD COLUMN IF NOT EXISTS ref TEXT NOT NULL DEFAULT '';
+			CREATE INDEX ledger_entry_ref ON ledger_entries(lease_id, ref) WHERE ref <> '';`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 6, 2),
+		Up: `
+			CREATE TABLE IF NOT EXISTS late_fee_policies (
+				id           VARCHAR(36) PRIMARY KEY,
+				property_id  VARCHAR(36) UNIQUE REFERENCES properties (id),
+				lease_id     VARCHAR(36) UNIQUE REFERENCES leases (id),
+				grace_days   INTEGER NOT NULL DEFAULT 0 CHECK (grace_days >= 0),
+				flat_fee     INTEGER NOT NULL DEFAULT 0 CHECK (flat_fee >= 0),
+				rent_percent INTEGER NOT NULL DEFAULT 0 CHECK (rent_percent BETWEEN 0 AND 10000),
+				daily_fee    INTEGER NOT NULL DEFAULT 0 CHECK (daily_fee >= 0),
+				max_fee      INTEGER NOT NULL DEFAULT 0 CHECK (max_fee >= 0),
+
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				CHECK ((property_id IS NULL) <> (lease_id IS NULL))
+			);`,
+	},
+}
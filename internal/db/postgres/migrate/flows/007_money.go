@@ -0,0 +1,63 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow007Money stores amounts in the minor unit of the lease currency, cents
+// for USD, instead of whole dollars
+//
+// existing amounts are scaled by the number of decimal places the currency
+// has, property late fee policies are not tied to a currency so they assume 2
+var Flow007Money = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 7, 1),
+		Up: `
+			CREATE OR REPLACE FUNCTION currency_minor_factor(code VARCHAR) RETURNS INTEGER AS $$
+				SELECT CASE
+					WHEN code IN ('BIF', 'CLP', 'DJF', 'GNF', 'ISK', 'JPY', 'KMF', 'KRW',
+						'PYG', 'RWF', 'UGX', 'VND', 'VUV', 'XAF', 'XOF', 'XPF') THEN 1
+					WHEN code IN ('BHD', 'IQD', 'JOD', 'KWD', 'LYD', 'OMR', 'TND') THEN 1000
+					ELSE 100
+				END;
+			$$ LANGUAGE sql IMMUTABLE;`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 7, 2),
+		Up: `
+			ALTER TABLE leases RENAME COLUMN deposit TO deposit_minor;
+			ALTER TABLE leases RENAME COLUMN rent_amount TO rent_minor;
+			ALTER TABLE leases
+				ALTER COLUMN deposit_minor TYPE BIGINT,
+				ALTER COLUMN rent_minor TYPE BIGINT;
+			UPDATE leases SET
+				deposit_minor = deposit_minor * currency_minor_factor(currency),
+				rent_minor = rent_minor * currency_minor_factor(currency);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 7, 3),
+		Up: `
+			ALTER TABLE ledger_entries ALTER COLUMN amount TYPE BIGINT;
+			ALTER TABLE ledger_entries DISABLE TRIGGER ledger_entries_append_only;
+			UPDATE ledger_entries e SET amount = e.amount * currency_minor_factor(l.currency)
+				FROM leases l WHERE l.id = e.lease_id;
+			ALTER TABLE ledger_entries ENABLE TRIGGER ledger_entries_append_only;`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 7, 4),
+		Up: `
+			ALTER TABLE late_fee_policies
+				ALTER COLUMN flat_fee TYPE BIGINT,
+				ALTER COLUMN daily_fee TYPE BIGINT,
+				ALTER COLUMN max_fee TYPE BIGINT;
+			UPDATE late_fee_policies p SET
+				flat_fee = p.flat_fee * f.factor,
+				daily_fee = p.daily_fee * f.factor,
+				max_fee = p.max_fee * f.factor
+			FROM (
+				SELECT p2.id, COALESCE(currency_minor_factor(l.currency), 100) AS factor
+				FROM late_fee_policies p2
+				LEFT JOIN leases l ON l.id = p2.lease_id
+			) f
+			WHERE f.id = p.id;
+			DROP FUNCTION currency_minor_factor(VARCHAR);`,
+	},
+}
@@ -0,0 +1,46 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow008Deposits stores security deposit receipts and the move out
+// disposition of a deposit with its itemized deductions
+var Flow008Deposits = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 8, 1),
+		Up: `
+			CREATE TABLE IF NOT EXISTS deposit_receipts (
+				id           VARCHAR(36) PRIMARY KEY,
+				lease_id     VARCHAR(36) NOT NULL REFERENCES leases (id),
+				amount_minor BIGINT NOT NULL CHECK (amount_minor > 0),
+				currency     VARCHAR(3) NOT NULL,
+				received_on  DATE NOT NULL,
+				memo         TEXT NOT NULL DEFAULT '',
+
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+			);
+			CREATE INDEX deposit_receipt_lease ON deposit_receipts(lease_id);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 8, 2),
+		Up: `
+			CREATE TABLE IF NOT EXISTS deposit_dispositions (
+				id            VARCHAR(36) PRIMARY KEY,
+				lease_id      VARCHAR(36) NOT NULL UNIQUE REFERENCES leases (id),
+				move_out_date DATE NOT NULL,
+				held_minor    BIGINT NOT NULL CHECK (held_minor >= 0),
+				refund_minor  BIGINT NOT NULL CHECK (refund_minor >= 0),
+				owed_minor    BIGINT NOT NULL CHECK (owed_minor >= 0),
+				currency      VARCHAR(3) NOT NULL,
+
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+			);
+			CREATE TABLE IF NOT EXISTS deposit_deductions (
+				disposition_id VARCHAR(36) NOT NULL REFERENCES deposit_dispositions (id) ON DELETE CASCADE,
+				position       INTEGER NOT NULL,
+				category       VARCHAR(20) NOT NULL,
+				amount_minor   BIGINT NOT NULL CHECK (amount_minor > 0),
+				description    TEXT NOT NULL,
+				PRIMARY KEY (disposition_id, position)
+			);`,
+	},
+}
@@ -0,0 +1,49 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow009LeaseVersions links a renewal to the lease it renews and keeps every
+// version of a lease, existing leases get a created version with their
+// current terms since nothing older is known about them
+var Flow009LeaseVersions = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 9, 1),
+		Up: `
+			ALTER TABLE leases ADD COLUMN renews_id VARCHAR(36) UNIQUE REFERENCES leases (id);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 9, 2),
+		Up: `
+			CREATE TABLE IF NOT EXISTS lease_versions (
+				lease_id       VARCHAR(36) NOT NULL REFERENCES leases (id) ON DELETE CASCADE,
+				version        INTEGER     NOT NULL CHECK (version > 0),
+				change         VARCHAR(16) NOT NULL,
+				effective_date DATE        NOT NULL,
+				reason         TEXT        NOT NULL DEFAULT '',
+				start_date     DATE        NOT NULL,
+				end_date       DATE,
+				deposit_minor  BIGINT      NOT NULL,
+				rent_minor     BIGINT      NOT NULL,
+				currency       VARCHAR(3)  NOT NULL,
+				rent_interval  VARCHAR(16) NOT NULL,
+				tenant_ids     VARCHAR(36)[] NOT NULL DEFAULT '{}',
+
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				PRIMARY KEY (lease_id, version)
+			);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 9, 3),
+		Up: `
+			INSERT INTO lease_versions (
+				lease_id, version, change, effective_date, start_date, end_date,
+				deposit_minor, rent_minor, currency, rent_interval, tenant_ids, created_at
+			)
+			SELECT l.id, 1, 'created', l.start_date, l.start_date, l.end_date,
+				l.deposit_minor, l.rent_minor, l.currency, l.rent_interval,
+				ARRAY_REMOVE(ARRAY_AGG(lt.tenant_id), NULL), l.created_at
+			FROM leases l
+			LEFT JOIN lease_tenants lt ON lt.lease_id = l.id
+			GROUP BY l.id;`,
+	},
+}
@@ -0,0 +1,55 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow010RentalApplications stores rental applications, their applicants in
+// the order given and the notes recorded with every status change
+// rental_applicants.tenant_id is set when the application is converted
+var Flow010RentalApplications = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 10, 1),
+		Up: `
+			CREATE TABLE IF NOT EXISTS rental_applications (
+				id           VARCHAR(36) PRIMARY KEY,
+				property_id  VARCHAR(36) NOT NULL REFERENCES properties (id),
+				move_in_date DATE        NOT NULL,
+				status       VARCHAR(16) NOT NULL,
+
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+			);
+			CREATE INDEX rental_application_property ON rental_applications(property_id, status);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 10, 2),
+		Up: `
+			CREATE TABLE IF NOT EXISTS rental_applicants (
+				application_id   VARCHAR(36)  NOT NULL REFERENCES rental_applications (id) ON DELETE CASCADE,
+				position         INTEGER      NOT NULL,
+				full_name        VARCHAR(128) NOT NULL,
+				dob              DATE         NOT NULL,
+				ssn              VARCHAR(16)  NOT NULL DEFAULT '',
+				dl_num           VARCHAR(32)  NOT NULL DEFAULT '',
+				dl_state         VARCHAR(32)  NOT NULL DEFAULT '',
+				has_pets         BOOLEAN      NOT NULL DEFAULT false,
+				pets_desc        TEXT         NOT NULL DEFAULT '',
+				vehicle_count    INTEGER      NOT NULL DEFAULT 0,
+				vehicle_desc     TEXT         NOT NULL DEFAULT '',
+				crime_conviction BOOLEAN      NOT NULL DEFAULT false,
+				crime_desc       TEXT         NOT NULL DEFAULT '',
+				bankruptcy_filed BOOLEAN      NOT NULL DEFAULT false,
+				bankruptcy_desc  TEXT         NOT NULL DEFAULT '',
+				tenant_id        VARCHAR(36)  UNIQUE REFERENCES tenants (id),
+				PRIMARY KEY (application_id, position)
+			);
+			CREATE TABLE IF NOT EXISTS application_notes (
+				application_id VARCHAR(36) NOT NULL REFERENCES rental_applications (id) ON DELETE CASCADE,
+				position       INTEGER     NOT NULL,
+				status         VARCHAR(16) NOT NULL,
+				note           TEXT        NOT NULL DEFAULT '',
+
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				PRIMARY KEY (application_id, position)
+			);`,
+	},
+}
@@ -0,0 +1,70 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow011Screening stores the screening policy of each property with its rules
+// in order, and a report for every time an application was screened
+// a report keeps its own copy of the rules and rent which were applied,
+// findings point at the rule which fired by its position in that copy
+var Flow011Screening = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 11, 1),
+		Up: `
+			ALTER TABLE rental_applicants
+				ADD COLUMN IF NOT EXISTS income_minor    BIGINT     NOT NULL DEFAULT 0,
+				ADD COLUMN IF NOT EXISTS income_currency VARCHAR(3) NOT NULL DEFAULT '';`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 11, 2),
+		Up: `
+			CREATE TABLE IF NOT EXISTS screening_policies (
+				id          VARCHAR(36) PRIMARY KEY,
+				property_id VARCHAR(36) NOT NULL UNIQUE REFERENCES properties (id),
+				rent_minor  BIGINT      NOT NULL DEFAULT 0,
+				currency    VARCHAR(3)  NOT NULL DEFAULT '',
+
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+			);
+			CREATE TABLE IF NOT EXISTS screening_rules (
+				policy_id VARCHAR(36) NOT NULL REFERENCES screening_policies (id) ON DELETE CASCADE,
+				position  INTEGER     NOT NULL,
+				criterion VARCHAR(32) NOT NULL,
+				"limit"   INTEGER     NOT NULL DEFAULT 0,
+				action    VARCHAR(16) NOT NULL,
+				PRIMARY KEY (policy_id, position)
+			);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 11, 3),
+		Up: `
+			CREATE TABLE IF NOT EXISTS screening_reports (
+				id             VARCHAR(36) PRIMARY KEY,
+				application_id VARCHAR(36) NOT NULL REFERENCES rental_applications (id) ON DELETE CASCADE,
+				property_id    VARCHAR(36) NOT NULL REFERENCES properties (id),
+				result         VARCHAR(16) NOT NULL,
+				rent_minor     BIGINT      NOT NULL DEFAULT 0,
+				currency       VARCHAR(3)  NOT NULL DEFAULT '',
+
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+			);
+			CREATE INDEX screening_report_application ON screening_reports(application_id);
+			CREATE TABLE IF NOT EXISTS screening_report_rules (
+				report_id VARCHAR(36) NOT NULL REFERENCES screening_reports (id) ON DELETE CASCADE,
+				position  INTEGER     NOT NULL,
+				criterion VARCHAR(32) NOT NULL,
+				"limit"   INTEGER     NOT NULL DEFAULT 0,
+				action    VARCHAR(16) NOT NULL,
+				PRIMARY KEY (report_id, position)
+			);
+			CREATE TABLE IF NOT EXISTS screening_findings (
+				report_id     VARCHAR(36)  NOT NULL,
+				position      INTEGER      NOT NULL,
+				rule_position INTEGER      NOT NULL,
+				applicant     VARCHAR(128) NOT NULL DEFAULT '',
+				reason        TEXT         NOT NULL,
+				PRIMARY KEY (report_id, position),
+				FOREIGN KEY (report_id, rule_position) REFERENCES screening_report_rules (report_id, position) ON DELETE CASCADE
+			);`,
+	},
+}
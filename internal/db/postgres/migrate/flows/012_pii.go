@@ -0,0 +1,26 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow012PII makes room for sealed (encrypted) personal information
+// a sealed value is far longer than the plaintext so the columns become TEXT,
+// a sealed date of birth can not be stored in a DATE column so it is kept in
+// dob_enc and dob is left NULL
+var Flow012PII = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 12, 1),
+		Up: `
+			ALTER TABLE tenants
+				ALTER COLUMN dl_num TYPE TEXT,
+				ADD COLUMN IF NOT EXISTS dob_enc TEXT NOT NULL DEFAULT '';`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 12, 2),
+		Up: `
+			ALTER TABLE rental_applicants
+				ALTER COLUMN ssn TYPE TEXT,
+				ALTER COLUMN dl_num TYPE TEXT,
+				ALTER COLUMN dob DROP NOT NULL,
+				ADD COLUMN IF NOT EXISTS dob_enc TEXT NOT NULL DEFAULT '';`,
+	},
+}
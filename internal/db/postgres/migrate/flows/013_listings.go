@@ -0,0 +1,43 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow013Listings stores the listing of each property with its photos in the
+// order they are shown, published_at is NULL while the listing is a draft
+var Flow013Listings = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 13, 1),
+		Up: `
+			CREATE TABLE IF NOT EXISTS listings (
+				id             VARCHAR(36) PRIMARY KEY,
+				property_id    VARCHAR(36) NOT NULL UNIQUE REFERENCES properties (id),
+				rent_minor     BIGINT      NOT NULL DEFAULT 0,
+				currency       VARCHAR(3)  NOT NULL DEFAULT '',
+				available_from DATE,
+				description    TEXT        NOT NULL DEFAULT '',
+				allow_smoking  BOOLEAN     NOT NULL DEFAULT false,
+				allow_pets     BOOLEAN     NOT NULL DEFAULT false,
+				parking_spaces INTEGER     NOT NULL DEFAULT 0,
+				parking_desc   TEXT        NOT NULL DEFAULT '',
+				status         VARCHAR(16) NOT NULL,
+				published_at   TIMESTAMP WITH TIME ZONE,
+
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+			);
+			CREATE INDEX listing_status ON listings(status);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 13, 2),
+		Up: `
+			CREATE TABLE IF NOT EXISTS listing_photos (
+				listing_id VARCHAR(36) NOT NULL REFERENCES listings (id) ON DELETE CASCADE,
+				position   INTEGER     NOT NULL,
+				url        TEXT        NOT NULL,
+				caption    TEXT        NOT NULL DEFAULT '',
+				width      INTEGER     NOT NULL DEFAULT 0,
+				height     INTEGER     NOT NULL DEFAULT 0,
+				PRIMARY KEY (listing_id, position)
+			);`,
+	},
+}
@@ -0,0 +1,25 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow014Outbox stores events in the same transaction as the change they
+// describe, a relay delivers the pending ones once they are due
+var Flow014Outbox = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 14, 1),
+		Up: `
+			CREATE TABLE IF NOT EXISTS outbox (
+				id              VARCHAR(36) PRIMARY KEY,
+				name            VARCHAR(64) NOT NULL,
+				payload         JSONB       NOT NULL,
+				status          VARCHAR(16) NOT NULL,
+				attempts        INTEGER     NOT NULL DEFAULT 0,
+				last_error      TEXT        NOT NULL DEFAULT '',
+				created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				delivered_at    TIMESTAMP WITH TIME ZONE
+			);
+			CREATE INDEX outbox_due ON outbox(next_attempt_at) WHERE status = 'pending';
+			CREATE INDEX outbox_status ON outbox(status, created_at);`,
+	},
+}
@@ -0,0 +1,39 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow015Webhooks subscribes urls to events, every delivery to a webhook is
+// recorded along with the status code of its last attempt
+var Flow015Webhooks = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 15, 1),
+		Up: `
+			CREATE TABLE IF NOT EXISTS webhooks (
+				id         VARCHAR(36)   PRIMARY KEY,
+				url        VARCHAR(2048) NOT NULL,
+				events     VARCHAR(64)[] NOT NULL DEFAULT '{}',
+				secret     VARCHAR(256)  NOT NULL,
+				created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+			);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 15, 2),
+		Up: `
+			CREATE TABLE IF NOT EXISTS webhook_deliveries (
+				id              VARCHAR(36) PRIMARY KEY,
+				webhook_id      VARCHAR(36) NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+				event           VARCHAR(64) NOT NULL,
+				payload         JSONB       NOT NULL,
+				status          VARCHAR(16) NOT NULL,
+				status_code     INTEGER     NOT NULL DEFAULT 0,
+				attempts        INTEGER     NOT NULL DEFAULT 0,
+				last_error      TEXT        NOT NULL DEFAULT '',
+				redelivery_of   VARCHAR(36) NOT NULL DEFAULT '',
+				created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				delivered_at    TIMESTAMP WITH TIME ZONE
+			);
+			CREATE INDEX webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+			CREATE INDEX webhook_deliveries_webhook ON webhook_deliveries(webhook_id, created_at);`,
+	},
+}
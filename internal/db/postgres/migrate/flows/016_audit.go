@@ -0,0 +1,24 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow016Audit records every change made to an entity, who made it and the
+// old and new value of each field which changed
+var Flow016Audit = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 16, 1),
+		Up: `
+			CREATE TABLE IF NOT EXISTS audit_log (
+				id          VARCHAR(36)  PRIMARY KEY,
+				actor       VARCHAR(255) NOT NULL DEFAULT '',
+				transport   VARCHAR(16)  NOT NULL DEFAULT '',
+				entity_type VARCHAR(32)  NOT NULL,
+				entity_id   VARCHAR(36)  NOT NULL,
+				action      VARCHAR(16)  NOT NULL,
+				diff        JSONB        NOT NULL,
+				created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+			);
+			CREATE INDEX audit_log_entity ON audit_log(entity_type, entity_id, created_at);
+			CREATE INDEX audit_log_actor ON audit_log(actor, created_at);`,
+	},
+}
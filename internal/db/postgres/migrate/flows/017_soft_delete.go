@@ -0,0 +1,16 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow017SoftDelete keeps removed properties and tenants with the time they
+// were removed until they are purged, deleted_at is NULL while they are not
+var Flow017SoftDelete = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 17, 1),
+		Up: `
+			ALTER TABLE properties ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+			ALTER TABLE tenants ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+			CREATE INDEX property_deleted ON properties(deleted_at) WHERE deleted_at IS NOT NULL;
+			CREATE INDEX tenant_deleted ON tenants(deleted_at) WHERE deleted_at IS NOT NULL;`,
+	},
+}
@@ -0,0 +1,17 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow018ListOrder indexes the orders properties and tenants are listed in, a
+// page of a list is read from an index instead of sorting the whole table
+var Flow018ListOrder = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 18, 1),
+		Up: `
+			CREATE INDEX IF NOT EXISTS property_created ON properties(created_at, id COLLATE "C");
+			CREATE INDEX IF NOT EXISTS property_city ON properties((LOWER(city) COLLATE "C"), id COLLATE "C");
+			CREATE INDEX IF NOT EXISTS property_zip ON properties((zip COLLATE "C"), id COLLATE "C");
+			CREATE INDEX IF NOT EXISTS tenant_created ON tenants(created_at, id COLLATE "C");
+			CREATE INDEX IF NOT EXISTS tenant_name ON tenants((LOWER(full_name) COLLATE "C"), id COLLATE "C");`,
+	},
+}
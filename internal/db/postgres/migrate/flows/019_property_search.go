@@ -0,0 +1,101 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow019PropertySearch indexes the address of properties for search,
+// search_text is the address as search.Normalize writes it and search_vector
+// is generated from it, pg_trgm ranks results and finds them despite typos
+var Flow019PropertySearch = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 19, 1),
+		Up: `CREATE EXTENSION IF NOT EXISTS pg_trgm;`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 19, 2),
+		Up: `
+			ALTER TABLE properties ADD COLUMN IF NOT EXISTS search_text TEXT NOT NULL DEFAULT '';
+			ALTER TABLE properties ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+				GENERATED ALWAYS AS (to_tsvector('simple', search_text)) STORED;
+			CREATE INDEX IF NOT EXISTS property_search ON properties USING GIN (search_vector);
+			CREATE INDEX IF NOT EXISTS property_search_trgm ON properties USING GIN (search_text gin_trgm_ops);`,
+	},
+	{
+		// the addresses stored before are normalized in sql the way
+		// search.Normalize did it, with a copy of its abbreviations as they
+		// were then, a migration must not change when search.Abbreviations does
+		ID: mig.MakeID(idPrefix, 19, 3),
+		Up: `
+			UPDATE properties p SET search_text = COALESCE((
+				SELECT STRING_AGG(COALESCE(a.canonical, w.token), ' ' ORDER BY w.n)
+				FROM UNNEST(REGEXP_SPLIT_TO_ARRAY(
+					LOWER(p.street || ' ' || p.city || ' ' || p.state || ' ' || p.zip), '[^[:alnum:]]+'
+				)) WITH ORDINALITY AS w(token, n)
+				LEFT JOIN (VALUES
+					('allee', 'aly'), ('alley', 'aly'), ('ally', 'aly'), ('aly', 'aly'), ('apartment', 'apt'),
+					('apt', 'apt'), ('av', 'ave'), ('ave', 'ave'), ('aven', 'ave'), ('avenu', 'ave'),
+					('avenue', 'ave'), ('avn', 'ave'), ('avnue', 'ave'), ('bend', 'bnd'), ('bldg', 'bldg'),
+					('blvd', 'blvd'), ('bnd', 'bnd'), ('boul', 'blvd'), ('boulevard', 'blvd'), ('boulv', 'blvd'),
+					('brdge', 'brg'), ('brg', 'brg'), ('bridge', 'brg'), ('brk', 'brk'), ('brook', 'brk'),
+					('building', 'bldg'), ('byp', 'byp'), ('bypa', 'byp'), ('bypas', 'byp'), ('bypass', 'byp'),
+					('byps', 'byp'), ('canyn', 'cyn'), ('canyon', 'cyn'), ('causeway', 'cswy'),
+					('causwa', 'cswy'), ('cen', 'ctr'), ('cent', 'ctr'), ('center', 'ctr'), ('centr', 'ctr'),
+					('centre', 'ctr'), ('cir', 'cir'), ('circ', 'cir'), ('circl', 'cir'), ('circle', 'cir'),
+					('clf', 'clf'), ('cliff', 'clf'), ('cnter', 'ctr'), ('cntr', 'ctr'), ('cnyn', 'cyn'),
+					('court', 'ct'), ('courts', 'cts'), ('cove', 'cv'), ('crcl', 'cir'), ('crcle', 'cir'),
+					('creek', 'crk'), ('cres', 'cres'), ('crescent', 'cres'), ('crk', 'crk'),
+					('crossing', 'xing'), ('crsent', 'cres'), ('crsnt', 'cres'), ('crssng', 'xing'),
+					('cswy', 'cswy'), ('ct', 'ct'), ('ctr', 'ctr'), ('cts', 'cts'), ('cv', 'cv'), ('cyn', 'cyn'),
+					('department', 'dept'), ('dept', 'dept'), ('dr', 'dr'), ('driv', 'dr'), ('drive', 'dr'),
+					('drv', 'dr'), ('e', 'e'), ('east', 'e'), ('est', 'est'), ('estate', 'est'),
+					('estates', 'ests'), ('ests', 'ests'), ('exp', 'expy'), ('expr', 'expy'), ('express', 'expy'),
+					('expressway', 'expy'), ('expw', 'expy'), ('expy', 'expy'), ('ext', 'ext'),
+					('extension', 'ext'), ('extn', 'ext'), ('extnsn', 'ext'), ('field', 'fld'),
+					('fields', 'flds'), ('fl', 'fl'), ('fld', 'fld'), ('flds', 'flds'), ('floor', 'fl'),
+					('forest', 'frst'), ('forests', 'frst'), ('fork', 'frk'), ('freeway', 'fwy'), ('frk', 'frk'),
+					('frst', 'frst'), ('frway', 'fwy'), ('frwy', 'fwy'), ('fwy', 'fwy'), ('garden', 'gdn'),
+					('gardens', 'gdns'), ('gardn', 'gdn'), ('gateway', 'gtwy'), ('gatewy', 'gtwy'),
+					('gatway', 'gtwy'), ('gdn', 'gdn'), ('gdns', 'gdns'), ('glen', 'gln'), ('gln', 'gln'),
+					('grden', 'gdn'), ('grdn', 'gdn'), ('grdns', 'gdns'), ('green', 'grn'), ('grn', 'grn'),
+					('grov', 'grv'), ('grove', 'grv'), ('grv', 'grv'), ('gtway', 'gtwy'), ('gtwy', 'gtwy'),
+					('harb', 'hbr'), ('harbor', 'hbr'), ('harbr', 'hbr'), ('hbr', 'hbr'), ('heights', 'hts'),
+					('highway', 'hwy'), ('highwy', 'hwy'), ('hill', 'hl'), ('hills', 'hls'), ('hiway', 'hwy'),
+					('hiwy', 'hwy'), ('hl', 'hl'), ('hls', 'hls'), ('hrbor', 'hbr'), ('ht', 'hts'),
+					('hts', 'hts'), ('hway', 'hwy'), ('hwy', 'hwy'), ('is', 'is'), ('island', 'is'),
+					('islnd', 'is'), ('jct', 'jct'), ('jction', 'jct'), ('jctn', 'jct'), ('junction', 'jct'),
+					('junctn', 'jct'), ('juncton', 'jct'), ('lake', 'lk'), ('lakes', 'lks'), ('landing', 'lndg'),
+					('lane', 'ln'), ('lk', 'lk'), ('lks', 'lks'), ('ln', 'ln'), ('lndg', 'lndg'),
+					('lndng', 'lndg'), ('loop', 'loop'), ('loops', 'loop'), ('manor', 'mnr'), ('mdw', 'mdws'),
+					('mdws', 'mdws'), ('meadows', 'mdws'), ('medows', 'mdws'), ('mill', 'ml'), ('ml', 'ml'),
+					('mnr', 'mnr'), ('mnt', 'mt'), ('mntain', 'mtn'), ('mntn', 'mtn'), ('mount', 'mt'),
+					('mountain', 'mtn'), ('mountin', 'mtn'), ('mt', 'mt'), ('mtin', 'mtn'), ('mtn', 'mtn'),
+					('n', 'n'), ('ne', 'ne'), ('north', 'n'), ('northeast', 'ne'), ('northwest', 'nw'),
+					('nw', 'nw'), ('orch', 'orch'), ('orchard', 'orch'), ('orchrd', 'orch'), ('parkway', 'pkwy'),
+					('parkways', 'pkwy'), ('parkwy', 'pkwy'), ('pine', 'pne'), ('pines', 'pnes'),
+					('pkway', 'pkwy'), ('pkwy', 'pkwy'), ('pkwys', 'pkwy'), ('pky', 'pkwy'), ('pl', 'pl'),
+					('place', 'pl'), ('plain', 'pln'), ('plains', 'plns'), ('plaza', 'plz'), ('pln', 'pln'),
+					('plns', 'plns'), ('plz', 'plz'), ('plza', 'plz'), ('pne', 'pne'), ('pnes', 'pnes'),
+					('point', 'pt'), ('pr', 'pr'), ('prairie', 'pr'), ('prr', 'pr'), ('pt', 'pt'),
+					('ranch', 'rnch'), ('ranches', 'rnch'), ('rd', 'rd'), ('rdg', 'rdg'), ('rdge', 'rdg'),
+					('ridge', 'rdg'), ('rm', 'rm'), ('rnch', 'rnch'), ('rnchs', 'rnch'), ('road', 'rd'),
+					('room', 'rm'), ('route', 'rte'), ('rte', 'rte'), ('s', 's'), ('se', 'se'), ('shore', 'shr'),
+					('shores', 'shrs'), ('shr', 'shr'), ('shrs', 'shrs'), ('smt', 'smt'), ('south', 's'),
+					('southeast', 'se'), ('southwest', 'sw'), ('spg', 'spg'), ('spgs', 'spgs'), ('spng', 'spg'),
+					('spngs', 'spgs'), ('spring', 'spg'), ('springs', 'spgs'), ('sprng', 'spg'),
+					('sprngs', 'spgs'), ('sq', 'sq'), ('sqr', 'sq'), ('sqre', 'sq'), ('squ', 'sq'),
+					('square', 'sq'), ('st', 'st'), ('sta', 'sta'), ('station', 'sta'), ('statn', 'sta'),
+					('ste', 'ste'), ('stn', 'sta'), ('str', 'st'), ('street', 'st'), ('strt', 'st'),
+					('suite', 'ste'), ('sumit', 'smt'), ('sumitt', 'smt'), ('summit', 'smt'), ('sw', 'sw'),
+					('ter', 'ter'), ('terr', 'ter'), ('terrace', 'ter'), ('tpke', 'tpke'), ('trace', 'trce'),
+					('traces', 'trce'), ('trail', 'trl'), ('trails', 'trl'), ('trce', 'trce'), ('trl', 'trl'),
+					('trls', 'trl'), ('trnpk', 'tpke'), ('tunel', 'tunl'), ('tunl', 'tunl'), ('tunls', 'tunl'),
+					('tunnel', 'tunl'), ('tunnels', 'tunl'), ('tunnl', 'tunl'), ('turnpike', 'tpke'),
+					('turnpk', 'tpke'), ('unit', 'unit'), ('valley', 'vly'), ('vally', 'vly'), ('view', 'vw'),
+					('vill', 'vlg'), ('villag', 'vlg'), ('village', 'vlg'), ('villg', 'vlg'), ('villiage', 'vlg'),
+					('vis', 'vis'), ('vist', 'vis'), ('vista', 'vis'), ('vlg', 'vlg'), ('vlly', 'vly'),
+					('vly', 'vly'), ('vst', 'vis'), ('vsta', 'vis'), ('vw', 'vw'), ('w', 'w'), ('west', 'w'),
+					('xing', 'xing')
+				) AS a(token, canonical) ON a.token = w.token
+				WHERE w.token <> ''
+			), '');`,
+	},
+}
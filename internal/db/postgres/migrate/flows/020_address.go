@@ -0,0 +1,15 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow020Address adds the unit line and country of a property address, an
+// empty country is the US
+var Flow020Address = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 20, 1),
+		Up: `
+			ALTER TABLE properties
+				ADD COLUMN IF NOT EXISTS unit TEXT NOT NULL DEFAULT '',
+				ADD COLUMN IF NOT EXISTS country TEXT NOT NULL DEFAULT '';`,
+	},
+}
@@ -0,0 +1,77 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow021Units adds the units of properties, every existing property gets a
+// default unit which its leases are moved to, leases then only overlap on
+// the same unit. A lease without a unit is of the whole property, the
+// exclusion constraint can not compare it with the leases of its units so a
+// trigger does, it locks the property so concurrent leases are checked in turn
+var Flow021Units = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 21, 1),
+		Up: `
+			CREATE TABLE IF NOT EXISTS units (
+				id                VARCHAR(36) PRIMARY KEY,
+				property_id       VARCHAR(36) NOT NULL REFERENCES properties(id),
+				number            TEXT NOT NULL DEFAULT '',
+				beds              INTEGER NOT NULL DEFAULT 0,
+				baths             NUMERIC(4,1) NOT NULL DEFAULT 0,
+				sqft              INTEGER NOT NULL DEFAULT 0,
+				rent_target_minor BIGINT NOT NULL DEFAULT 0,
+				currency          VARCHAR(3) NOT NULL DEFAULT '',
+				created_at        TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				updated_at        TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+				UNIQUE (property_id, number)
+			);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 21, 2),
+		Up: `
+			INSERT INTO units (id, property_id, number)
+			SELECT gen_random_uuid()::TEXT, p.id, TRIM(p.unit)
+			FROM properties p
+			WHERE NOT EXISTS (SELECT 1 FROM units u WHERE u.property_id = p.id);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 21, 3),
+		Up: `
+			ALTER TABLE leases ADD COLUMN IF NOT EXISTS unit_id VARCHAR(36) REFERENCES units(id);
+			UPDATE leases l SET unit_id = u.id
+			FROM units u
+			WHERE u.property_id = l.property_id AND l.unit_id IS NULL;
+			CREATE INDEX IF NOT EXISTS leases_unit_id_idx ON leases (unit_id);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 21, 4),
+		Up: `
+			ALTER TABLE leases DROP CONSTRAINT IF EXISTS lease_term_no_overlap;
+			ALTER TABLE leases ADD CONSTRAINT lease_term_no_overlap EXCLUDE USING gist (
+				(COALESCE(unit_id, property_id)) WITH =,
+				daterange(start_date, end_date, '[]') WITH &&
+			);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 21, 5),
+		Up: `
+			CREATE OR REPLACE FUNCTION leases_whole_property_no_overlap() RETURNS trigger AS $$
+			BEGIN
+				PERFORM 1 FROM properties WHERE id = NEW.property_id FOR UPDATE;
+				IF EXISTS (
+					SELECT 1 FROM leases l
+					WHERE l.property_id = NEW.property_id
+						AND l.id <> NEW.id
+						AND (l.unit_id IS NULL OR NEW.unit_id IS NULL)
+						AND daterange(l.start_date, l.end_date, '[]') && daterange(NEW.start_date, NEW.end_date, '[]')
+				) THEN
+					RAISE EXCEPTION 'lease[%] overlaps a lease of property[%]', NEW.id, NEW.property_id
+						USING ERRCODE = 'exclusion_violation', CONSTRAINT = 'lease_term_no_overlap';
+				END IF;
+				RETURN NEW;
+			END;
+			$$ LANGUAGE plpgsql;
+			CREATE TRIGGER leases_whole_property_no_overlap
+				BEFORE INSERT OR UPDATE OF property_id, unit_id, start_date, end_date ON leases
+				FOR EACH ROW EXECUTE FUNCTION leases_whole_property_no_overlap();`,
+	},
+}
@@ -0,0 +1,48 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow022LeaseVersionTerms keeps the property, unit and renewed lease on every
+// lease version so a version reads as the lease stood then rather than as it
+// is now, existing versions are given those of their lease. Every lease which
+// still has no version gets its current terms as version 1
+var Flow022LeaseVersionTerms = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 22, 1),
+		Up: `
+			ALTER TABLE lease_versions
+				ADD COLUMN IF NOT EXISTS property_id VARCHAR(36),
+				ADD COLUMN IF NOT EXISTS unit_id     VARCHAR(36),
+				ADD COLUMN IF NOT EXISTS renews_id   VARCHAR(36);`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 22, 2),
+		Up: `
+			UPDATE lease_versions v
+			SET property_id = l.property_id, unit_id = l.unit_id, renews_id = l.renews_id
+			FROM leases l
+			WHERE l.id = v.lease_id AND v.property_id IS NULL;`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 22, 3),
+		Up: `
+			INSERT INTO lease_versions (
+				lease_id, version, change, effective_date, property_id, unit_id, renews_id,
+				start_date, end_date, deposit_minor, rent_minor, currency, rent_interval,
+				tenant_ids, created_at
+			)
+			SELECT l.id, 1, CASE WHEN l.renews_id IS NULL THEN 'created' ELSE 'renewed' END,
+				l.start_date, l.property_id, l.unit_id, l.renews_id,
+				l.start_date, l.end_date, l.deposit_minor, l.rent_minor, l.currency, l.rent_interval,
+				ARRAY_REMOVE(ARRAY_AGG(lt.tenant_id), NULL), l.created_at
+			FROM leases l
+			LEFT JOIN lease_tenants lt ON lt.lease_id = l.id
+			WHERE NOT EXISTS (SELECT 1 FROM lease_versions v WHERE v.lease_id = l.id)
+			GROUP BY l.id;`,
+	},
+	{
+		ID: mig.MakeID(idPrefix, 22, 4),
+		Up: `
+			ALTER TABLE lease_versions ALTER COLUMN property_id SET NOT NULL;`,
+	},
+}
@@ -0,0 +1,18 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow023LedgerCurrency keeps the currency of each ledger entry amount beside
+// it, the entries stored before are in the currency of their lease
+var Flow023LedgerCurrency = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 23, 1),
+		Up: `
+			ALTER TABLE ledger_entries ADD COLUMN IF NOT EXISTS currency VARCHAR(3);
+			ALTER TABLE ledger_entries DISABLE TRIGGER ledger_entries_append_only;
+			UPDATE ledger_entries e SET currency = l.currency
+				FROM leases l WHERE l.id = e.lease_id AND e.currency IS NULL;
+			ALTER TABLE ledger_entries ENABLE TRIGGER ledger_entries_append_only;
+			ALTER TABLE ledger_entries ALTER COLUMN currency SET NOT NULL;`,
+	},
+}
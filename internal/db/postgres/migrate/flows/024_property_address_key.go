@@ -0,0 +1,17 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow024PropertyAddressKey keeps entity.Address.Key of each property so two
+// properties which were not removed can not share an address, the key is
+// written by the repo in go, Flow027PropertyAddressKeyBackfill gives the
+// properties stored before theirs
+var Flow024PropertyAddressKey = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 24, 1),
+		Up: `
+			ALTER TABLE properties ADD COLUMN IF NOT EXISTS address_key TEXT;
+			CREATE UNIQUE INDEX IF NOT EXISTS property_address_key
+				ON properties (address_key) WHERE deleted_at IS NULL;`,
+	},
+}
@@ -0,0 +1,19 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow025MoreListOrder indexes the orders of the lists paged after properties
+// and tenants, like Flow018ListOrder a page is read from an index instead of
+// sorting the whole table
+var Flow025MoreListOrder = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 25, 1),
+		Up: `
+			CREATE INDEX IF NOT EXISTS lease_start ON leases(start_date, id COLLATE "C");
+			CREATE INDEX IF NOT EXISTS lease_end ON leases((COALESCE(end_date, DATE '9999-12-31')), id COLLATE "C");
+			CREATE INDEX IF NOT EXISTS rental_application_created ON rental_applications(created_at, id COLLATE "C");
+			CREATE INDEX IF NOT EXISTS outbox_created ON outbox(created_at, id COLLATE "C");
+			CREATE INDEX IF NOT EXISTS webhook_deliveries_created ON webhook_deliveries(created_at, id COLLATE "C");
+			CREATE INDEX IF NOT EXISTS audit_log_created ON audit_log(created_at, id COLLATE "C");`,
+	},
+}
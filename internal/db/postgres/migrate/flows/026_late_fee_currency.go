@@ -0,0 +1,23 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow026LateFeeCurrency keeps the currency of the late fee policy fees beside
+// them, it is empty for a policy which only charges a percent of the rent
+//
+// lease policies stored before are in the currency of their lease, property
+// policies in the one their leases share, or USD, the lease default, when
+// their leases are in more than one currency
+var Flow026LateFeeCurrency = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 26, 1),
+		Up: `
+			ALTER TABLE late_fee_policies ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT '';
+			UPDATE late_fee_policies p SET currency = COALESCE(
+				(SELECT l.currency FROM leases l WHERE l.id = p.lease_id),
+				(SELECT MIN(l.currency) FROM leases l WHERE l.property_id = p.property_id
+					HAVING COUNT(DISTINCT l.currency) = 1),
+				'USD')
+			WHERE p.currency = '' AND (p.flat_fee > 0 OR p.daily_fee > 0 OR p.max_fee > 0);`,
+	},
+}
@@ -0,0 +1,100 @@
+package flows
+
+import (
+	"database/sql"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal/lib/mig"
+)
+
+// Flow027PropertyAddressKeyBackfill gives the properties stored before
+// Flow024PropertyAddressKey their entity.Address.Key, it is computed in go so
+// it is the same key the repo writes
+//
+// properties which were not removed and share an address were stored before
+// the unique index existed, the oldest of them gets the key and the others
+// are logged as its duplicates and keep a NULL address_key, which lists them:
+//
+//	SELECT id FROM properties WHERE address_key IS NULL AND deleted_at IS NULL
+//
+// storing one of them fails as a duplicate address until it is removed or its
+// address is corrected, removed properties always get their key
+var Flow027PropertyAddressKeyBackfill = mig.Flow{
+	{
+		ID:     mig.MakeID(idPrefix, 27, 1),
+		UpFunc: backfillPropertyAddressKeys,
+	},
+}
+
+func backfillPropertyAddressKeys(tx *sql.Tx, log mig.Logger) error {
+	type property struct {
+		id      string
+		address entity.Address
+		removed bool
+	}
+	var (
+		held  = make(map[string]string) // property id by the key it holds
+		todo  = make([]property, 0)
+		query = `
+			SELECT id, address_key FROM properties
+			WHERE address_key IS NOT NULL AND deleted_at IS NULL;`
+	)
+	rows, err := tx.Query(query)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var id, key string
+		if err := rows.Scan(&id, &key); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		held[key] = id
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	query = `
+		SELECT id, street, unit, city, state, zip, country, deleted_at IS NOT NULL
+		FROM properties
+		WHERE address_key IS NULL
+		ORDER BY created_at, id;`
+	rows, err = tx.Query(query)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var (
+			p property
+			a = &p.address
+		)
+		if err := rows.Scan(&p.id, &a.Street, &a.Unit, &a.City, &a.StateCode, &a.Zip, &a.Country, &p.removed); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		todo = append(todo, p)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	var duplicates int
+	for _, p := range todo {
+		key := p.address.Key()
+		if !p.removed {
+			if dup, ok := held[key]; ok {
+				duplicates++
+				log.Warn("property has the address of another, its address_key is left NULL",
+					"propertyID", p.id, "duplicateOf", dup)
+				continue
+			}
+			held[key] = p.id
+		}
+		if _, err := tx.Exec("UPDATE properties SET address_key = $1 WHERE id = $2", key, p.id); err != nil {
+			return err
+		}
+	}
+	log.Info("backfilled property address keys", "properties", len(todo)-duplicates, "duplicates", duplicates)
+	return nil
+}
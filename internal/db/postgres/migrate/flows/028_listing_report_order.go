@@ -0,0 +1,14 @@
+package flows
+
+import "github.com/tempcke/rpm/internal/lib/mig"
+
+// Flow028ListingReportOrder indexes the orders of public listings and
+// screening reports now that they are paged like the lists of Flow025MoreListOrder
+var Flow028ListingReportOrder = mig.Flow{
+	{
+		ID: mig.MakeID(idPrefix, 28, 1),
+		Up: `
+			CREATE INDEX IF NOT EXISTS listing_published ON listings((COALESCE(published_at, TIMESTAMPTZ '0001-01-01 00:00:00+00')), id COLLATE "C");
+			CREATE INDEX IF NOT EXISTS screening_report_created ON screening_reports(application_id, created_at, id COLLATE "C");`,
+	},
+}
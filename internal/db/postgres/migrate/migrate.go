@@ -11,6 +11,32 @@ import (
 var allFlows = []*mig.Flow{
 	&flows.Flow001Properties,
 	&flows.Flow002Tenants,
+	&flows.Flow003Leases,
+	&flows.Flow004LeaseOverlap,
+	&flows.Flow005Ledger,
+	&flows.Flow006LateFees,
+	&flows.Flow007Money,
+	&flows.Flow008Deposits,
+	&flows.Flow009LeaseVersions,
+	&flows.Flow010RentalApplications,
+	&flows.Flow011Screening,
+	&flows.Flow012PII,
+	&flows.Flow013Listings,
+	&flows.Flow014Outbox,
+	&flows.Flow015Webhooks,
+	&flows.Flow016Audit,
+	&flows.Flow017SoftDelete,
+	&flows.Flow018ListOrder,
+	&flows.Flow019PropertySearch,
+	&flows.Flow020Address,
+	&flows.Flow021Units,
+	&flows.Flow022LeaseVersionTerms,
+	&flows.Flow023LedgerCurrency,
+	&flows.Flow024PropertyAddressKey,
+	&flows.Flow025MoreListOrder,
+	&flows.Flow026LateFeeCurrency,
+	&flows.Flow027PropertyAddressKeyBackfill,
+	&flows.Flow028ListingReportOrder,
 }
 
 func Up(db *sql.DB, log *slog.Logger) error {
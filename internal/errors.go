@@ -12,6 +12,7 @@ var (
 	ErrInternal       = knownErr("internal error")
 	ErrEntityInvalid  = knownErr("entity state invalid")
 	ErrEntityNotFound = knownErr("entity not found")
+	ErrConflict       = knownErr("conflict")
 )
 
 type Errors []error
@@ -49,6 +50,38 @@ func (e Errors) ErrorOrNil() error {
 	return nil
 }
 
+// FieldError explains why a single field of an entity is invalid
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+func NewFieldError(field, reason string) FieldError {
+	return FieldError{Field: field, Reason: reason}
+}
+func (e FieldError) Error() string {
+	return e.Field + " " + e.Reason
+}
+
+// FieldErrors returns every FieldError found in err, in the order they were added
+func FieldErrors(err error) []FieldError {
+	var list []FieldError
+	switch e := err.(type) {
+	case FieldError:
+		list = append(list, e)
+	case Errors:
+		for _, err := range e {
+			list = append(list, FieldErrors(err)...)
+		}
+	default:
+		var errs Errors
+		if errors.As(err, &errs) {
+			return FieldErrors(errs)
+		}
+	}
+	return list
+}
+
 type knownErr string
 
 func IsKnownErr(err error) bool {
@@ -55,3 +55,17 @@ func TestKnownErrors(t *testing.T) {
 	wrappedErr := internal.MakeErr(internal.ErrBadRequest, uuid.NewString())
 	require.True(t, internal.IsKnownErr(wrappedErr))
 }
+func TestFieldErrors(t *testing.T) {
+	var (
+		fe1 = internal.NewFieldError("name", "is required")
+		fe2 = internal.NewFieldError("age", "must not be negative")
+		fe3 = internal.NewFieldError("dob", "is required")
+		err = internal.NewErrors(internal.ErrEntityInvalid, fe1, fe2).
+			Append(internal.NewErrors(fe3))
+	)
+	assert.ErrorIs(t, err, internal.ErrEntityInvalid)
+	assert.ErrorIs(t, err, fe2)
+	assert.Equal(t, "age must not be negative", fe2.Error())
+	assert.Equal(t, []internal.FieldError{fe1, fe2, fe3}, internal.FieldErrors(err))
+	assert.Empty(t, internal.FieldErrors(internal.ErrEntityInvalid))
+}
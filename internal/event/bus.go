@@ -0,0 +1,139 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/tempcke/rpm/internal/lib/log"
+)
+
+type (
+	// Publisher is what the use cases publish to after a successful write
+	Publisher interface {
+		Publish(context.Context, ...Event)
+	}
+	// Bus is a Publisher which handlers can subscribe to
+	Bus interface {
+		Publisher
+		// Subscribe the handler to events with any of the names, or to every
+		// event when no names are given
+		Subscribe(h Handler, names ...string)
+	}
+	// Deliverer delivers an event to its subscribers, failing when any of them does
+	Deliverer interface {
+		Deliver(context.Context, Event) error
+	}
+	// Handler of an event, an error is logged and does not stop other
+	// handlers from handling the event
+	Handler func(context.Context, Event) error
+)
+
+// Discard is a Publisher which drops every event
+var Discard Publisher = discard{}
+
+type discard struct{}
+
+func (discard) Publish(context.Context, ...Event) {}
+
+// Dispatcher is a synchronous in-process Bus, Publish returns once every
+// subscribed handler has handled every event
+type Dispatcher struct {
+	mu       *sync.RWMutex
+	handlers map[string][]Handler
+	all      *[]Handler
+}
+
+func NewDispatcher() Dispatcher {
+	return Dispatcher{
+		mu:       &sync.RWMutex{},
+		handlers: make(map[string][]Handler),
+		all:      &[]Handler{},
+	}
+}
+func (d Dispatcher) Subscribe(h Handler, names ...string) {
+	if h == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(names) == 0 {
+		*d.all = append(*d.all, h)
+		return
+	}
+	for _, name := range names {
+		d.handlers[name] = append(d.handlers[name], h)
+	}
+}
+func (d Dispatcher) Publish(ctx context.Context, events ...Event) {
+	for _, e := range events {
+		if err := d.Deliver(ctx, e); err != nil {
+			log.WithError(err).Error("event handler failed", "event", e.Name())
+		}
+	}
+}
+
+// Deliver the event to every subscribed handler, the error joins the errors
+// of every handler which failed
+func (d Dispatcher) Deliver(ctx context.Context, e Event) error {
+	var errs []error
+	for _, h := range d.subscribers(e.Name()) {
+		if err := h(ctx, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+func (d Dispatcher) subscribers(name string) []Handler {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	list := make([]Handler, 0, len(*d.all)+len(d.handlers[name]))
+	list = append(list, *d.all...)
+	return append(list, d.handlers[name]...)
+}
+
+// AsyncDispatcher publishes events without waiting for the handlers, the
+// events are handled in order by a single goroutine until it is closed
+type AsyncDispatcher struct {
+	Dispatcher
+	queue chan published
+	done  chan struct{}
+	once  *sync.Once
+}
+type published struct {
+	ctx    context.Context
+	events []Event
+}
+
+// NewAsyncDispatcher with room to queue size publishes before Publish blocks
+func NewAsyncDispatcher(size int) AsyncDispatcher {
+	d := AsyncDispatcher{
+		Dispatcher: NewDispatcher(),
+		queue:      make(chan published, size),
+		done:       make(chan struct{}),
+		once:       &sync.Once{},
+	}
+	go d.run()
+	return d
+}
+
+// Publish queues the events, the handlers get a context which is not
+// canceled along with the request which published them
+func (d AsyncDispatcher) Publish(ctx context.Context, events ...Event) {
+	if len(events) == 0 {
+		return
+	}
+	d.queue <- published{ctx: context.WithoutCancel(ctx), events: events}
+}
+
+// Close stops accepting events and waits for the queued ones to be handled
+func (d AsyncDispatcher) Close() {
+	d.once.Do(func() { close(d.queue) })
+	<-d.done
+}
+func (d AsyncDispatcher) run() {
+	defer close(d.done)
+	for p := range d.queue {
+		d.Dispatcher.Publish(p.ctx, p.events...)
+	}
+}
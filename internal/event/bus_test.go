@@ -0,0 +1,79 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/internal/event"
+)
+
+var ctx = context.Background()
+
+func TestDispatcher(t *testing.T) {
+	var (
+		d      = event.NewDispatcher()
+		all    []event.Event
+		added  []event.Event
+		failed int
+		e1     = event.RentalAdded{PropertyID: "p1"}
+		e2     = event.TenantAdded{TenantID: "t1"}
+		e3     = event.RentalAdded{PropertyID: "p2"}
+
+		// force dispatchers to implement interface
+		_ event.Bus = event.Dispatcher{}
+		_ event.Bus = event.AsyncDispatcher{}
+	)
+	d.Subscribe(func(context.Context, event.Event) error {
+		failed++
+		return errors.New("handler failed")
+	})
+	d.Subscribe(func(_ context.Context, e event.Event) error {
+		all = append(all, e)
+		return nil
+	})
+	d.Subscribe(func(_ context.Context, e event.Event) error {
+		added = append(added, e)
+		return nil
+	}, event.NameRentalAdded)
+
+	d.Publish(ctx, e1, e2)
+	d.Publish(ctx, e3)
+
+	// a failing handler does not stop the others
+	assert.Equal(t, 3, failed)
+	assert.Equal(t, []event.Event{e1, e2, e3}, all)
+	assert.Equal(t, []event.Event{e1, e3}, added)
+}
+
+func TestAsyncDispatcher(t *testing.T) {
+	var (
+		d    = event.NewAsyncDispatcher(2)
+		mu   sync.Mutex
+		got  []string
+		want = []string{"p1", "p2", "p3"}
+	)
+	d.Subscribe(func(_ context.Context, e event.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, e.(event.RentalAdded).PropertyID)
+		return nil
+	}, event.NameRentalAdded)
+
+	for _, id := range want {
+		d.Publish(ctx, event.RentalAdded{PropertyID: id})
+	}
+	d.Close()
+	d.Close() // closing twice is fine
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, want, got)
+}
+
+func TestDiscard(t *testing.T) {
+	event.Discard.Publish(ctx, event.RentalAdded{PropertyID: "p1"})
+}
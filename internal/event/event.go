@@ -1,16 +1,123 @@
 package event
 
+import "github.com/tempcke/schedule"
+
+// Event is something which happened to an entity, events are published once
+// the change they describe has been stored
 type Event interface {
+	// Name identifies the kind of event, ex: "rental.added"
+	Name() string
 	isEvent()
 }
 
+const (
+	NameRentalAdded     = "rental.added"
+	NameRentalUpdated   = "rental.updated"
+	NameRentalRemoved   = "rental.removed"
+	NameRentalRestored  = "rental.restored"
+	NameRentalListed    = "rental.listed"
+	NameRentalLeased    = "rental.leased"
+	NameTenantAdded     = "tenant.added"
+	NameTenantUpdated   = "tenant.updated"
+	NameTenantRemoved   = "tenant.removed"
+	NameTenantRestored  = "tenant.restored"
+	NameLeaseUpdated    = "lease.updated"
+	NameLeaseTerminated = "lease.terminated"
+	NameLeaseAmended    = "lease.amended"
+	NameLeaseRenewed    = "lease.renewed"
+)
+
 type RentalAdded struct {
-	PropertyID string
+	PropertyID string `json:"propertyID"`
+}
+type RentalUpdated struct {
+	PropertyID string `json:"propertyID"`
+}
+type RentalRemoved struct {
+	PropertyID string `json:"propertyID"`
+}
+
+// RentalRestored is published when a removed property is restored
+type RentalRestored struct {
+	PropertyID string `json:"propertyID"`
 }
+
+// RentalListed is published when the listing of a property is published
 type RentalListed struct {
-	PropertyID string
+	PropertyID string `json:"propertyID"`
+	ListingID  string `json:"listingID"`
 }
+
+// RentalLeased is published when a new lease is stored, renewals are LeaseRenewed
 type RentalLeased struct {
-	PropertyID string
-	LeaseID    string
+	PropertyID string   `json:"propertyID"`
+	LeaseID    string   `json:"leaseID"`
+	TenantIDs  []string `json:"tenantIDs"`
+}
+type TenantAdded struct {
+	TenantID string `json:"tenantID"`
+}
+type TenantUpdated struct {
+	TenantID string `json:"tenantID"`
 }
+type TenantRemoved struct {
+	TenantID string `json:"tenantID"`
+}
+
+// TenantRestored is published when a removed tenant is restored
+type TenantRestored struct {
+	TenantID string `json:"tenantID"`
+}
+type LeaseUpdated struct {
+	PropertyID string `json:"propertyID"`
+	LeaseID    string `json:"leaseID"`
+}
+type LeaseTerminated struct {
+	PropertyID string        `json:"propertyID"`
+	LeaseID    string        `json:"leaseID"`
+	EndDate    schedule.Date `json:"endDate"`
+	Reason     string        `json:"reason,omitempty"`
+}
+type LeaseAmended struct {
+	PropertyID string        `json:"propertyID"`
+	LeaseID    string        `json:"leaseID"`
+	Effective  schedule.Date `json:"effective"`
+	Reason     string        `json:"reason,omitempty"`
+}
+
+// LeaseRenewed is published when LeaseID renews RenewsID for the next term
+type LeaseRenewed struct {
+	PropertyID string `json:"propertyID"`
+	LeaseID    string `json:"leaseID"`
+	RenewsID   string `json:"renewsID"`
+}
+
+func (RentalAdded) Name() string     { return NameRentalAdded }
+func (RentalUpdated) Name() string   { return NameRentalUpdated }
+func (RentalRemoved) Name() string   { return NameRentalRemoved }
+func (RentalRestored) Name() string  { return NameRentalRestored }
+func (RentalListed) Name() string    { return NameRentalListed }
+func (RentalLeased) Name() string    { return NameRentalLeased }
+func (TenantAdded) Name() string     { return NameTenantAdded }
+func (TenantUpdated) Name() string   { return NameTenantUpdated }
+func (TenantRemoved) Name() string   { return NameTenantRemoved }
+func (TenantRestored) Name() string  { return NameTenantRestored }
+func (LeaseUpdated) Name() string    { return NameLeaseUpdated }
+func (LeaseTerminated) Name() string { return NameLeaseTerminated }
+func (LeaseAmended) Name() string    { return NameLeaseAmended }
+func (LeaseRenewed) Name() string    { return NameLeaseRenewed }
+
+func (RentalAdded) isEvent()     {}
+func (RentalUpdated) isEvent()   {}
+func (RentalRemoved) isEvent()   {}
+func (RentalRestored) isEvent()  {}
+func (RentalListed) isEvent()    {}
+func (RentalLeased) isEvent()    {}
+func (TenantAdded) isEvent()     {}
+func (TenantUpdated) isEvent()   {}
+func (TenantRemoved) isEvent()   {}
+func (TenantRestored) isEvent()  {}
+func (LeaseUpdated) isEvent()    {}
+func (LeaseTerminated) isEvent() {}
+func (LeaseAmended) isEvent()    {}
+func (LeaseRenewed) isEvent()    {}
@@ -0,0 +1,151 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageStatus = string
+
+const (
+	MessagePending   MessageStatus = "pending"
+	MessageDelivered MessageStatus = "delivered"
+	MessageDead      MessageStatus = "dead" // gave up after too many attempts, only replayed by hand
+)
+
+var ErrUnknownEvent = errors.New("unknown event")
+
+// Message is an event stored in the outbox until it is delivered to every
+// subscriber, it is retried at NextAttempt while pending
+type Message struct {
+	ID          string
+	Name        string
+	Payload     json.RawMessage
+	Status      MessageStatus
+	Attempts    int
+	LastError   string
+	CreatedAt   time.Time
+	NextAttempt time.Time
+	DeliveredAt time.Time
+}
+
+// NewMessage holding the event, pending delivery from now
+func NewMessage(e Event, now time.Time) (Message, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		ID:          uuid.NewString(),
+		Name:        e.Name(),
+		Payload:     payload,
+		Status:      MessagePending,
+		CreatedAt:   now,
+		NextAttempt: now,
+	}, nil
+}
+
+func (m Message) GetID() string { return m.ID }
+
+// Event decoded from the payload, ErrUnknownEvent when no event has the name
+func (m Message) Event() (Event, error) {
+	decode, ok := decoders[m.Name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, m.Name)
+	}
+	return decode(m.Payload)
+}
+func (m Message) Delivered(now time.Time) Message {
+	m.Status = MessageDelivered
+	m.Attempts++
+	m.LastError = ""
+	m.DeliveredAt = now
+	return m
+}
+
+// Failed delivery attempt, retried at next or dead when next is zero
+func (m Message) Failed(err error, next time.Time) Message {
+	m.Attempts++
+	m.LastError = err.Error()
+	if next.IsZero() {
+		m.Status = MessageDead
+		return m
+	}
+	m.NextAttempt = next
+	return m
+}
+
+// Replay the message from now as though it was never attempted
+func (m Message) Replay(now time.Time) Message {
+	m.Status = MessagePending
+	m.Attempts = 0
+	m.LastError = ""
+	m.NextAttempt = now
+	m.DeliveredAt = time.Time{}
+	return m
+}
+
+type stagedKey struct{}
+
+// Stage events in the context of a write, a repository with an outbox stores
+// them in the same transaction as the change they describe
+func Stage(ctx context.Context, events ...Event) context.Context {
+	staged := append(Staged(ctx), events...)
+	return context.WithValue(ctx, stagedKey{}, staged)
+}
+
+// Staged events of the context, nil when none are
+func Staged(ctx context.Context) []Event {
+	staged, _ := ctx.Value(stagedKey{}).([]Event)
+	return staged[:len(staged):len(staged)]
+}
+
+type messageIDKey struct{}
+
+// WithMessageID of the outbox message the event is being delivered from,
+// subscribers can use it to tell a redelivery from a new event
+func WithMessageID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, messageIDKey{}, id)
+}
+
+// MessageID the event is being delivered from, empty when it was not relayed from the outbox
+func MessageID(ctx context.Context) string {
+	id, _ := ctx.Value(messageIDKey{}).(string)
+	return id
+}
+
+// Known is true for the name of every event
+func Known(name string) bool {
+	_, ok := decoders[name]
+	return ok
+}
+
+var decoders = map[string]func([]byte) (Event, error){
+	NameRentalAdded:     decode[RentalAdded],
+	NameRentalUpdated:   decode[RentalUpdated],
+	NameRentalRemoved:   decode[RentalRemoved],
+	NameRentalRestored:  decode[RentalRestored],
+	NameRentalListed:    decode[RentalListed],
+	NameRentalLeased:    decode[RentalLeased],
+	NameTenantAdded:     decode[TenantAdded],
+	NameTenantUpdated:   decode[TenantUpdated],
+	NameTenantRemoved:   decode[TenantRemoved],
+	NameTenantRestored:  decode[TenantRestored],
+	NameLeaseUpdated:    decode[LeaseUpdated],
+	NameLeaseTerminated: decode[LeaseTerminated],
+	NameLeaseAmended:    decode[LeaseAmended],
+	NameLeaseRenewed:    decode[LeaseRenewed],
+}
+
+func decode[E Event](payload []byte) (Event, error) {
+	var e E
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
@@ -0,0 +1,81 @@
+package event_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/schedule"
+)
+
+func TestStage(t *testing.T) {
+	var (
+		e1 = event.RentalAdded{PropertyID: "p1"}
+		e2 = event.TenantAdded{TenantID: "t1"}
+		e3 = event.RentalRemoved{PropertyID: "p1"}
+	)
+	assert.Empty(t, event.Staged(ctx))
+
+	staged := event.Stage(ctx, e1)
+	a, b := event.Stage(staged, e2), event.Stage(staged, e3)
+	assert.Equal(t, []event.Event{e1}, event.Staged(staged))
+	assert.Equal(t, []event.Event{e1, e2}, event.Staged(a))
+	assert.Equal(t, []event.Event{e1, e3}, event.Staged(b))
+}
+
+func TestMessageID(t *testing.T) {
+	assert.Empty(t, event.MessageID(ctx))
+	assert.Equal(t, "m1", event.MessageID(event.WithMessageID(ctx, "m1")))
+	assert.True(t, event.Known(event.NameLeaseRenewed))
+	assert.False(t, event.Known("lease.signed"))
+}
+
+func TestMessage(t *testing.T) {
+	var (
+		now = time.Now()
+		in  = event.LeaseTerminated{
+			PropertyID: "p1",
+			LeaseID:    "l1",
+			EndDate:    schedule.NewDate(2024, time.March, 31),
+			Reason:     "moving out",
+		}
+	)
+	m, err := event.NewMessage(in, now)
+	require.NoError(t, err)
+	assert.NotEmpty(t, m.ID)
+	assert.Equal(t, event.NameLeaseTerminated, m.Name)
+	assert.Equal(t, event.MessagePending, m.Status)
+	assert.Equal(t, now, m.NextAttempt)
+
+	out, err := m.Event()
+	require.NoError(t, err)
+	assert.Equal(t, in, out)
+
+	failed := m.Failed(errors.New("down"), now.Add(time.Minute))
+	assert.Equal(t, event.MessagePending, failed.Status)
+	assert.Equal(t, 1, failed.Attempts)
+	assert.Equal(t, "down", failed.LastError)
+	assert.Equal(t, now.Add(time.Minute), failed.NextAttempt)
+
+	dead := failed.Failed(errors.New("still down"), time.Time{})
+	assert.Equal(t, event.MessageDead, dead.Status)
+	assert.Equal(t, 2, dead.Attempts)
+
+	replayed := dead.Replay(now.Add(time.Hour))
+	assert.Equal(t, event.MessagePending, replayed.Status)
+	assert.Zero(t, replayed.Attempts)
+	assert.Empty(t, replayed.LastError)
+	assert.Equal(t, now.Add(time.Hour), replayed.NextAttempt)
+
+	delivered := replayed.Delivered(now.Add(time.Hour))
+	assert.Equal(t, event.MessageDelivered, delivered.Status)
+	assert.Equal(t, 1, delivered.Attempts)
+	assert.Equal(t, now.Add(time.Hour), delivered.DeliveredAt)
+
+	m.Name = "rental.unknown"
+	_, err = m.Event()
+	assert.ErrorIs(t, err, event.ErrUnknownEvent)
+}
@@ -0,0 +1,61 @@
+package filters
+
+import (
+	"github.com/tempcke/rpm/internal/audit"
+)
+
+type AuditFilter struct {
+	EntityType string // ex: "property"
+	EntityID   string
+	Actor      string
+
+	Page Page // order of the list and the part of it to return
+}
+
+func NewAuditFilter() AuditFilter {
+	return AuditFilter{}
+}
+func (f AuditFilter) WithEntity(entityType, id string) AuditFilter {
+	f.EntityType, f.EntityID = entityType, id
+	return f
+}
+func (f AuditFilter) WithActor(actor string) AuditFilter {
+	f.Actor = actor
+	return f
+}
+func (f AuditFilter) WithPage(p Page) AuditFilter {
+	f.Page = p
+	return f
+}
+
+// MergeAuditFilters combines filters, the last non-empty value of each field wins
+func MergeAuditFilters(filter ...AuditFilter) AuditFilter {
+	var f AuditFilter
+	for _, v := range filter {
+		if v.EntityType != "" {
+			f.EntityType = v.EntityType
+		}
+		if v.EntityID != "" {
+			f.EntityID = v.EntityID
+		}
+		if v.Actor != "" {
+			f.Actor = v.Actor
+		}
+		f.Page = MergePages(f.Page, v.Page)
+	}
+	return f
+}
+
+// Match is used by repositories that can't filter in a query
+func (f AuditFilter) Match(e audit.Entry) bool {
+	if f.EntityType != "" && e.EntityType != f.EntityType {
+		return false
+	}
+	if f.EntityID != "" && e.EntityID != f.EntityID {
+		return false
+	}
+	if f.Actor != "" && e.Actor != f.Actor {
+		return false
+	}
+	return true
+}
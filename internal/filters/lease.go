@@ -0,0 +1,102 @@
+package filters
+
+import (
+	"github.com/tempcke/rpm/entity"
+)
+
+type LeaseFilter struct {
+	IDs        []entity.ID
+	PropertyID entity.ID
+	UnitID     entity.ID
+	TenantID   entity.ID // leases the tenant is on
+	RenewsID   entity.ID // the lease which renews this one
+
+	Page Page // order of the list and the part of it to return
+}
+
+func NewLeaseFilter() LeaseFilter {
+	return LeaseFilter{}
+}
+func (f LeaseFilter) WithPropertyID(id entity.ID) LeaseFilter {
+	f.PropertyID = id
+	return f
+}
+func (f LeaseFilter) WithUnitID(id entity.ID) LeaseFilter {
+	f.UnitID = id
+	return f
+}
+func (f LeaseFilter) WithTenantID(id entity.ID) LeaseFilter {
+	f.TenantID = id
+	return f
+}
+
+func (f LeaseFilter) WithRenewsID(id entity.ID) LeaseFilter {
+	f.RenewsID = id
+	return f
+}
+func (f LeaseFilter) WithPage(p Page) LeaseFilter {
+	f.Page = p
+	return f
+}
+
+// Paging is the page of the list, leases are ordered by their start date
+// unless another sort is asked for
+func (f LeaseFilter) Paging() Page {
+	if f.Page.Sort == "" {
+		return f.Page.WithSort(SortStartDate, false)
+	}
+	return f.Page
+}
+
+// MergeLeaseFilters combines filters, the last non-empty value of each field wins
+func MergeLeaseFilters(filter ...LeaseFilter) LeaseFilter {
+	var f LeaseFilter
+	for _, v := range filter {
+		if v.PropertyID != "" {
+			f.PropertyID = v.PropertyID
+		}
+		if v.UnitID != "" {
+			f.UnitID = v.UnitID
+		}
+		if v.TenantID != "" {
+			f.TenantID = v.TenantID
+		}
+		if v.RenewsID != "" {
+			f.RenewsID = v.RenewsID
+		}
+		if len(v.IDs) > 0 {
+			f.IDs = v.IDs
+		}
+		f.Page = MergePages(f.Page, v.Page)
+	}
+	return f
+}
+
+// Match is used by repositories that can't filter in a query
+func (f LeaseFilter) Match(l entity.Lease) bool {
+	if f.PropertyID != "" && l.PropertyID != f.PropertyID {
+		return false
+	}
+	if f.UnitID != "" && l.UnitID != f.UnitID {
+		return false
+	}
+	if f.TenantID != "" && !l.HasTenant(f.TenantID) {
+		return false
+	}
+	if f.RenewsID != "" && l.RenewsID != f.RenewsID {
+		return false
+	}
+	if len(f.IDs) > 0 && !containsID(f.IDs, l.ID) {
+		return false
+	}
+	return true
+}
+
+func containsID(ids []entity.ID, id entity.ID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package filters
+
+import (
+	"strings"
+
+	"github.com/tempcke/rpm/entity"
+)
+
+// ListingFilter narrows down listings, a zero value field does not filter
+// a rent range only matches listings asking rent in the same currency
+type ListingFilter struct {
+	Status      entity.ListingStatus
+	City        string // case insensitive
+	MinRent     entity.Money
+	MaxRent     entity.Money
+	PetsAllowed bool // only listings which allow pets
+
+	Page Page // order of the list and the part of it to return
+}
+
+func NewListingFilter() ListingFilter {
+	return ListingFilter{}
+}
+func (f ListingFilter) WithStatus(s entity.ListingStatus) ListingFilter {
+	f.Status = s
+	return f
+}
+func (f ListingFilter) WithCity(city string) ListingFilter {
+	f.City = city
+	return f
+}
+func (f ListingFilter) WithRentRange(min, max entity.Money) ListingFilter {
+	f.MinRent, f.MaxRent = min, max
+	return f
+}
+func (f ListingFilter) WithPetsAllowed() ListingFilter {
+	f.PetsAllowed = true
+	return f
+}
+
+func (f ListingFilter) WithPage(p Page) ListingFilter {
+	f.Page = p
+	return f
+}
+
+// Paging is the page of the list, listings are ordered by when they were
+// published, newest first, unless another sort is asked for
+func (f ListingFilter) Paging() Page {
+	if f.Page.Sort == "" {
+		return f.Page.WithSort(SortPublishedAt, true)
+	}
+	return f.Page
+}
+
+// MergeListingFilters combines filters, the last non-empty value of each field wins
+func MergeListingFilters(filter ...ListingFilter) ListingFilter {
+	var f ListingFilter
+	for _, v := range filter {
+		if v.Status != "" {
+			f.Status = v.Status
+		}
+		if v.City != "" {
+			f.City = v.City
+		}
+		if v.MinRent != (entity.Money{}) {
+			f.MinRent = v.MinRent
+		}
+		if v.MaxRent != (entity.Money{}) {
+			f.MaxRent = v.MaxRent
+		}
+		if v.PetsAllowed {
+			f.PetsAllowed = true
+		}
+		f.Page = MergePages(f.Page, v.Page)
+	}
+	return f
+}
+
+// Match is used by repositories that can't filter in a query, p is the
+// property of the listing, the listing of a removed property never matches
+func (f ListingFilter) Match(l entity.Listing, p entity.Property) bool {
+	if p.Deleted() {
+		return false
+	}
+	if f.Status != "" && l.Status != f.Status {
+		return false
+	}
+	if f.City != "" && !strings.EqualFold(strings.TrimSpace(p.City), strings.TrimSpace(f.City)) {
+		return false
+	}
+	if f.MinRent != (entity.Money{}) {
+		if l.Rent.Currency != f.MinRent.Currency || l.Rent.Minor < f.MinRent.Minor {
+			return false
+		}
+	}
+	if f.MaxRent != (entity.Money{}) {
+		if l.Rent.Currency != f.MaxRent.Currency || l.Rent.Minor > f.MaxRent.Minor {
+			return false
+		}
+	}
+	if f.PetsAllowed && !l.Details.AllowPets {
+		return false
+	}
+	return true
+}
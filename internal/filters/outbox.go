@@ -0,0 +1,54 @@
+package filters
+
+import (
+	"github.com/tempcke/rpm/internal/event"
+)
+
+type OutboxFilter struct {
+	Status event.MessageStatus
+	Name   string // event name, ex: "rental.added"
+
+	Page Page // order of the list and the part of it to return
+}
+
+func NewOutboxFilter() OutboxFilter {
+	return OutboxFilter{}
+}
+func (f OutboxFilter) WithStatus(s event.MessageStatus) OutboxFilter {
+	f.Status = s
+	return f
+}
+func (f OutboxFilter) WithName(name string) OutboxFilter {
+	f.Name = name
+	return f
+}
+func (f OutboxFilter) WithPage(p Page) OutboxFilter {
+	f.Page = p
+	return f
+}
+
+// MergeOutboxFilters combines filters, the last non-empty value of each field wins
+func MergeOutboxFilters(filter ...OutboxFilter) OutboxFilter {
+	var f OutboxFilter
+	for _, v := range filter {
+		if v.Status != "" {
+			f.Status = v.Status
+		}
+		if v.Name != "" {
+			f.Name = v.Name
+		}
+		f.Page = MergePages(f.Page, v.Page)
+	}
+	return f
+}
+
+// Match is used by repositories that can't filter in a query
+func (f OutboxFilter) Match(m event.Message) bool {
+	if f.Status != "" && m.Status != f.Status {
+		return false
+	}
+	if f.Name != "" && m.Name != f.Name {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,352 @@
+package filters
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/event"
+)
+
+// SortField is what a list is ordered by, rows with the same value are
+// ordered by their id so the order of a list is always the same
+type SortField string
+
+const (
+	SortCreatedAt   SortField = "createdAt"
+	SortCity        SortField = "city"
+	SortZip         SortField = "zip"
+	SortName        SortField = "name"
+	SortRank        SortField = "rank" // relevance to a search, the default order of a search
+	SortStartDate   SortField = "startDate"
+	SortEndDate     SortField = "endDate"     // leases without an end date are last
+	SortPublishedAt SortField = "publishedAt" // listings which are not published are last when descending
+)
+
+const (
+	DefaultPageSize = 100  // page size of api lists which do not ask for one
+	MaxPageSize     = 1000 // largest page size api lists return
+)
+
+// sortTimeLayout has a fixed width so times in it sort like the times do
+const sortTimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// Page selects the order of a list and the part of it which is returned, the
+// zero value is the whole list in the order the rows were created
+type Page struct {
+	Sort   SortField // SortCreatedAt when empty
+	Desc   bool
+	Size   int    // the whole list when zero
+	Cursor string // NextCursor of the previous page, empty for the first page
+}
+
+func NewPage() Page {
+	return Page{}
+}
+func (p Page) WithSort(field SortField, desc bool) Page {
+	p.Sort, p.Desc = field, desc
+	return p
+}
+func (p Page) WithSize(size int) Page {
+	p.Size = size
+	return p
+}
+func (p Page) WithCursor(cursor string) Page {
+	p.Cursor = cursor
+	return p
+}
+
+// ParseSort reads the sort of a list as the api takes it, the name of the
+// field preceded by a minus when it is descending, ex: -createdAt
+func ParseSort(s string) (SortField, bool) {
+	if strings.HasPrefix(s, "-") {
+		return SortField(s[1:]), true
+	}
+	return SortField(s), false
+}
+
+// SortString is the sort of the page the way ParseSort reads it
+func (p Page) SortString() string {
+	if p.Sort == "" {
+		return ""
+	}
+	if p.Desc {
+		return "-" + string(p.Sort)
+	}
+	return string(p.Sort)
+}
+
+// SortBy is the field the list is ordered by
+func (p Page) SortBy() SortField {
+	if p.Sort == "" {
+		return SortCreatedAt
+	}
+	return p.Sort
+}
+
+// Limited gives the page DefaultPageSize when it has no size and caps the size
+// at MaxPageSize, apis use it so a client never gets every row at once
+func (p Page) Limited() Page {
+	if p.Size <= 0 {
+		p.Size = DefaultPageSize
+	}
+	if p.Size > MaxPageSize {
+		p.Size = MaxPageSize
+	}
+	return p
+}
+
+// MergePages combines pages, the last non-empty value of each field wins
+func MergePages(pages ...Page) Page {
+	var p Page
+	for _, v := range pages {
+		if v.Sort != "" {
+			p.Sort, p.Desc = v.Sort, v.Desc
+		}
+		if v.Size != 0 {
+			p.Size = v.Size
+		}
+		if v.Cursor != "" {
+			p.Cursor = v.Cursor
+		}
+	}
+	return p
+}
+
+// Validate fails with internal.ErrEntityInvalid unless the page sorts by one
+// of the fields and its cursor was made for the same order
+func (p Page) Validate(fields ...SortField) error {
+	var errs = internal.NewErrors(internal.ErrEntityInvalid)
+	if !containsSortField(fields, p.SortBy()) {
+		var names = make([]string, len(fields))
+		for i, f := range fields {
+			names[i] = string(f)
+		}
+		errs = errs.Append(internal.NewFieldError("sort", "must be one of "+strings.Join(names, ", ")))
+	}
+	if p.Size < 0 {
+		errs = errs.Append(internal.NewFieldError("pageSize", "can not be negative"))
+	}
+	if _, err := p.After(); err != nil {
+		errs = errs.Append(internal.NewFieldError("cursor", "is not valid for this sort"))
+	}
+	if len(errs) > 1 {
+		return errs
+	}
+	return nil
+}
+
+// After is the decoded cursor of the page, the zero Cursor for the first page
+func (p Page) After() (Cursor, error) {
+	if p.Cursor == "" {
+		return Cursor{}, nil
+	}
+	c, err := ParseCursor(p.Cursor)
+	if err != nil {
+		return Cursor{}, err
+	}
+	if c.Sort != p.SortBy() || c.Desc != p.Desc {
+		return Cursor{}, internal.MakeErr(internal.ErrBadRequest, "cursor of another sort")
+	}
+	return c, nil
+}
+
+// Less is true when the row with the sort value and id of a comes before the
+// one of b in the order of the page
+func (p Page) Less(a, b Cursor) bool {
+	if a.Value != b.Value {
+		return (a.Value < b.Value) != p.Desc
+	}
+	if a.ID != b.ID {
+		return (a.ID < b.ID) != p.Desc
+	}
+	return false
+}
+
+// Cursor is the position of a row in the order of a Page, the next page
+// starts after it, clients get it encoded so they can not depend on its content
+type Cursor struct {
+	Sort  SortField `json:"s"`
+	Desc  bool      `json:"d,omitempty"`
+	Value string    `json:"v"`
+	ID    entity.ID `json:"i"`
+}
+
+func ParseCursor(s string) (Cursor, error) {
+	var c Cursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, internal.MakeErr(internal.ErrBadRequest, "cursor "+err.Error())
+	}
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" {
+		return Cursor{}, internal.MakeErr(internal.ErrBadRequest, "cursor is malformed")
+	}
+	return c, nil
+}
+func (c Cursor) String() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Time is the value of a cursor of a list sorted by a time
+func (c Cursor) Time() (time.Time, error) {
+	return time.Parse(sortTimeLayout, c.Value)
+}
+
+// SortValue returns the value of a row for a sort field, every value is a
+// string which sorts the way the field does
+type SortValue[T entity.Entity] func(e T, field SortField) string
+
+// PropertySorts are the fields properties can be sorted by
+var PropertySorts = []SortField{SortCreatedAt, SortCity, SortZip, SortRank}
+
+// TenantSorts are the fields tenants can be sorted by
+var TenantSorts = []SortField{SortCreatedAt, SortName}
+
+// LeaseSorts are the fields leases can be sorted by
+var LeaseSorts = []SortField{SortStartDate, SortEndDate}
+
+// ListingSorts are the fields listings can be sorted by
+var ListingSorts = []SortField{SortPublishedAt}
+
+// CreatedSorts are the fields of lists which are only ordered by when their
+// rows were created: applications, outbox messages, webhook deliveries,
+// screening reports and audit entries
+var CreatedSorts = []SortField{SortCreatedAt}
+
+// openEndDate is the sort value of a lease without an end date
+const openEndDate = "9999-12-31"
+
+// PropertySortValue is the SortValue of properties, the city is compared
+// without case and the rank, which is from 0 to 1, has 4 decimals
+func PropertySortValue(p entity.Property, field SortField) string {
+	switch field {
+	case SortCity:
+		return strings.ToLower(p.City)
+	case SortZip:
+		return p.Zip
+	case SortRank:
+		return strconv.FormatFloat(p.Rank, 'f', 4, 64)
+	default:
+		return sortTime(p.CreatedAt)
+	}
+}
+
+// TenantSortValue is the SortValue of tenants, the name is compared without case
+func TenantSortValue(t entity.Tenant, field SortField) string {
+	switch field {
+	case SortName:
+		return strings.ToLower(t.FullName)
+	default:
+		return sortTime(t.CreatedAt)
+	}
+}
+
+// LeaseSortValue is the SortValue of leases, sorted by their start date
+// unless another field is asked for
+func LeaseSortValue(l entity.Lease, field SortField) string {
+	switch field {
+	case SortEndDate:
+		if l.EndDate.IsZero() {
+			return openEndDate
+		}
+		return l.EndDate.String()
+	default:
+		return l.StartDate.String()
+	}
+}
+
+// ListingSortValue is the SortValue of listings, a listing which was never
+// published sorts as the zero time
+func ListingSortValue(l entity.Listing, _ SortField) string {
+	return sortTime(l.PublishedAt)
+}
+
+// ApplicationSortValue is the SortValue of rental applications
+func ApplicationSortValue(a entity.RentalApplication, _ SortField) string {
+	return sortTime(a.SubmittedAt)
+}
+
+// OutboxSortValue is the SortValue of outbox messages
+func OutboxSortValue(m event.Message, _ SortField) string {
+	return sortTime(m.CreatedAt)
+}
+
+// AuditSortValue is the SortValue of audit entries
+func AuditSortValue(e audit.Entry, _ SortField) string {
+	return sortTime(e.CreatedAt)
+}
+
+// ScreeningReportSortValue is the SortValue of screening reports
+func ScreeningReportSortValue(r entity.ScreeningReport, _ SortField) string {
+	return sortTime(r.CreatedAt)
+}
+
+// DeliverySortValue is the SortValue of webhook deliveries
+func DeliverySortValue(d entity.WebhookDelivery, _ SortField) string {
+	return sortTime(d.CreatedAt)
+}
+
+// CursorOf is the cursor of the row in the order of the page
+func CursorOf[T entity.Entity](e T, p Page, value SortValue[T]) Cursor {
+	by := p.SortBy()
+	return Cursor{Sort: by, Desc: p.Desc, Value: value(e, by), ID: e.GetID()}
+}
+
+// NextCursor is the cursor of the page after the list, it is empty unless the
+// list is a full page, a full page which happens to be the last one is
+// followed by an empty page
+func NextCursor[T entity.Entity](list []T, p Page, value SortValue[T]) string {
+	if p.Size <= 0 || len(list) < p.Size {
+		return ""
+	}
+	return CursorOf(list[len(list)-1], p, value).String()
+}
+
+// Paginate is used by repositories that can't page in a query, it orders the
+// list and returns the rows of the page
+func Paginate[T entity.Entity](list []T, p Page, value SortValue[T]) ([]T, error) {
+	after, err := p.After()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		keys   = make(map[entity.ID]Cursor, len(list))
+		sorted = append([]T{}, list...)
+		page   = make([]T, 0, len(list))
+	)
+	for _, e := range list {
+		keys[e.GetID()] = CursorOf(e, p, value)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return p.Less(keys[sorted[i].GetID()], keys[sorted[j].GetID()])
+	})
+	for _, e := range sorted {
+		if after.ID != "" && !p.Less(after, keys[e.GetID()]) {
+			continue
+		}
+		if p.Size > 0 && len(page) == p.Size {
+			break
+		}
+		page = append(page, e)
+	}
+	return page, nil
+}
+
+func sortTime(t time.Time) string {
+	return t.UTC().Format(sortTimeLayout)
+}
+func containsSortField(fields []SortField, field SortField) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package filters
+
+import (
+	"github.com/tempcke/rpm/entity"
+)
+
+type ApplicationFilter struct {
+	PropertyID entity.ID
+	Status     entity.ApplicationStatus
+
+	Page Page // order of the list and the part of it to return
+}
+
+func NewApplicationFilter() ApplicationFilter {
+	return ApplicationFilter{}
+}
+func (f ApplicationFilter) WithPropertyID(id entity.ID) ApplicationFilter {
+	f.PropertyID = id
+	return f
+}
+func (f ApplicationFilter) WithStatus(s entity.ApplicationStatus) ApplicationFilter {
+	f.Status = s
+	return f
+}
+func (f ApplicationFilter) WithPage(p Page) ApplicationFilter {
+	f.Page = p
+	return f
+}
+
+// MergeApplicationFilters combines filters, the last non-empty value of each field wins
+func MergeApplicationFilters(filter ...ApplicationFilter) ApplicationFilter {
+	var f ApplicationFilter
+	for _, v := range filter {
+		if v.PropertyID != "" {
+			f.PropertyID = v.PropertyID
+		}
+		if v.Status != "" {
+			f.Status = v.Status
+		}
+		f.Page = MergePages(f.Page, v.Page)
+	}
+	return f
+}
+
+// Match is used by repositories that can't filter in a query
+func (f ApplicationFilter) Match(a entity.RentalApplication) bool {
+	if f.PropertyID != "" && a.PropertyID != f.PropertyID {
+		return false
+	}
+	if f.Status != "" && a.Status != f.Status {
+		return false
+	}
+	return true
+}
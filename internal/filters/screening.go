@@ -0,0 +1,42 @@
+package filters
+
+import (
+	"github.com/tempcke/rpm/entity"
+)
+
+// ScreeningReportFilter narrows down the screening reports of an application,
+// they are listed oldest first
+type ScreeningReportFilter struct {
+	ApplicationID entity.ID
+
+	Page Page // order of the list and the part of it to return
+}
+
+func NewScreeningReportFilter() ScreeningReportFilter {
+	return ScreeningReportFilter{}
+}
+func (f ScreeningReportFilter) WithApplicationID(id entity.ID) ScreeningReportFilter {
+	f.ApplicationID = id
+	return f
+}
+func (f ScreeningReportFilter) WithPage(p Page) ScreeningReportFilter {
+	f.Page = p
+	return f
+}
+
+// MergeScreeningReportFilters combines filters, the last non-empty value of each field wins
+func MergeScreeningReportFilters(filter ...ScreeningReportFilter) ScreeningReportFilter {
+	var f ScreeningReportFilter
+	for _, v := range filter {
+		if v.ApplicationID != "" {
+			f.ApplicationID = v.ApplicationID
+		}
+		f.Page = MergePages(f.Page, v.Page)
+	}
+	return f
+}
+
+// Match is used by repositories that can't filter in a query
+func (f ScreeningReportFilter) Match(r entity.ScreeningReport) bool {
+	return f.ApplicationID == "" || r.ApplicationID == f.ApplicationID
+}
@@ -1,13 +1,190 @@
 package filters
 
 import (
+	"strings"
+
 	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/schedule"
 )
 
 type TenantFilter struct {
-	IDs []entity.ID
+	IDs            []entity.ID
+	Name           string // part of the full name, case is ignored
+	Phone          string // only the digits are compared, 555-555-1234 matches 5555551234
+	DLState        string // drivers license state, case is ignored
+	DOBFrom        schedule.Date
+	DOBUntil       schedule.Date // inclusive
+	IncludeDeleted bool          // removed tenants are left out unless this is set
+
+	// LeasingPropertyID limits the list to tenants on a lease of the property
+	// whose term includes LeasingOn, today when LeasingOn is zero
+	LeasingPropertyID entity.ID
+	LeasingOn         schedule.Date
+
+	Page Page // order of the list and the part of it to return
 }
 
 func NewTenantFilter() TenantFilter {
 	return TenantFilter{}
 }
+func (f TenantFilter) WithIDs(ids ...entity.ID) TenantFilter {
+	f.IDs = ids
+	return f
+}
+func (f TenantFilter) WithName(name string) TenantFilter {
+	f.Name = name
+	return f
+}
+func (f TenantFilter) WithPhone(number string) TenantFilter {
+	f.Phone = number
+	return f
+}
+func (f TenantFilter) WithDLState(state string) TenantFilter {
+	f.DLState = state
+	return f
+}
+
+// WithDOB limits the list to tenants born from the first date until the
+// second date inclusive, a zero date leaves that end of the range open
+func (f TenantFilter) WithDOB(from, until schedule.Date) TenantFilter {
+	f.DOBFrom, f.DOBUntil = from, until
+	return f
+}
+func (f TenantFilter) WithLeasingProperty(propertyID entity.ID) TenantFilter {
+	f.LeasingPropertyID = propertyID
+	return f
+}
+func (f TenantFilter) WithLeasingOn(d schedule.Date) TenantFilter {
+	f.LeasingOn = d
+	return f
+}
+func (f TenantFilter) WithPage(p Page) TenantFilter {
+	f.Page = p
+	return f
+}
+func (f TenantFilter) WithDeleted() TenantFilter {
+	f.IncludeDeleted = true
+	return f
+}
+
+// LeasingDate is the day a tenant must be leasing LeasingPropertyID on
+func (f TenantFilter) LeasingDate() schedule.Date {
+	if f.LeasingOn.IsZero() {
+		return schedule.Today()
+	}
+	return f.LeasingOn
+}
+
+// PhoneDigits is the Phone filter without anything but its digits
+func (f TenantFilter) PhoneDigits() string {
+	return digits(f.Phone)
+}
+
+// MergeTenantFilters combines filters, the last non-empty value of each field
+// wins, removed tenants are included when any filter includes them
+func MergeTenantFilters(filter ...TenantFilter) TenantFilter {
+	var f TenantFilter
+	for _, v := range filter {
+		if len(v.IDs) > 0 {
+			f.IDs = v.IDs
+		}
+		if v.Name != "" {
+			f.Name = v.Name
+		}
+		if v.Phone != "" {
+			f.Phone = v.Phone
+		}
+		if v.DLState != "" {
+			f.DLState = v.DLState
+		}
+		if !v.DOBFrom.IsZero() {
+			f.DOBFrom = v.DOBFrom
+		}
+		if !v.DOBUntil.IsZero() {
+			f.DOBUntil = v.DOBUntil
+		}
+		if v.LeasingPropertyID != "" {
+			f.LeasingPropertyID = v.LeasingPropertyID
+		}
+		if !v.LeasingOn.IsZero() {
+			f.LeasingOn = v.LeasingOn
+		}
+		if v.IncludeDeleted {
+			f.IncludeDeleted = true
+		}
+		f.Page = MergePages(f.Page, v.Page)
+	}
+	return f
+}
+
+// Match is used by repositories that can't filter in a query, leases are
+// only needed to match LeasingPropertyID, any lease can be passed
+func (f TenantFilter) Match(t entity.Tenant, leases ...entity.Lease) bool {
+	if t.Deleted() && !f.IncludeDeleted {
+		return false
+	}
+	if len(f.IDs) > 0 && !containsID(f.IDs, t.ID) {
+		return false
+	}
+	if f.Name != "" && !strings.Contains(strings.ToLower(t.FullName), strings.ToLower(f.Name)) {
+		return false
+	}
+	if f.DLState != "" && !strings.EqualFold(t.DLState, f.DLState) {
+		return false
+	}
+	if n := f.PhoneDigits(); n != "" && !hasPhone(t, n) {
+		return false
+	}
+	if !f.MatchDateOfBirth(t.DateOfBirth) {
+		return false
+	}
+	if f.LeasingPropertyID != "" && !f.leasing(t, leases) {
+		return false
+	}
+	return true
+}
+
+// MatchDateOfBirth is true when dob is in the DOBFrom DOBUntil range, it is
+// separate from Match because the date of birth is encrypted at rest so a
+// query can not filter by it
+func (f TenantFilter) MatchDateOfBirth(dob schedule.Date) bool {
+	if f.DOBFrom.IsZero() && f.DOBUntil.IsZero() {
+		return true
+	}
+	if dob.IsZero() {
+		return false
+	}
+	if !f.DOBFrom.IsZero() && dob.Before(f.DOBFrom) {
+		return false
+	}
+	if !f.DOBUntil.IsZero() && dob.After(f.DOBUntil) {
+		return false
+	}
+	return true
+}
+func (f TenantFilter) leasing(t entity.Tenant, leases []entity.Lease) bool {
+	day := f.LeasingDate()
+	for _, l := range leases {
+		if l.PropertyID == f.LeasingPropertyID && l.HasTenant(t.ID) && l.InTerm(day) {
+			return true
+		}
+	}
+	return false
+}
+func hasPhone(t entity.Tenant, number string) bool {
+	for _, p := range t.Phones {
+		if digits(p.Number) == number {
+			return true
+		}
+	}
+	return false
+}
+func digits(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,63 @@
+package filters
+
+import (
+	"github.com/tempcke/rpm/entity"
+)
+
+type WebhookDeliveryFilter struct {
+	WebhookID entity.ID
+	Status    entity.DeliveryStatus
+
+	Page Page // order of the list and the part of it to return
+}
+
+func NewWebhookDeliveryFilter() WebhookDeliveryFilter {
+	return WebhookDeliveryFilter{}
+}
+func (f WebhookDeliveryFilter) WithWebhookID(id entity.ID) WebhookDeliveryFilter {
+	f.WebhookID = id
+	return f
+}
+func (f WebhookDeliveryFilter) WithStatus(s entity.DeliveryStatus) WebhookDeliveryFilter {
+	f.Status = s
+	return f
+}
+func (f WebhookDeliveryFilter) WithPage(p Page) WebhookDeliveryFilter {
+	f.Page = p
+	return f
+}
+
+// Paging is the page of the list, the most recent delivery is first unless
+// another sort is asked for
+func (f WebhookDeliveryFilter) Paging() Page {
+	if f.Page.Sort == "" {
+		return f.Page.WithSort(SortCreatedAt, true)
+	}
+	return f.Page
+}
+
+// MergeWebhookDeliveryFilters combines filters, the last non-empty value of each field wins
+func MergeWebhookDeliveryFilters(filter ...WebhookDeliveryFilter) WebhookDeliveryFilter {
+	var f WebhookDeliveryFilter
+	for _, v := range filter {
+		if v.WebhookID != "" {
+			f.WebhookID = v.WebhookID
+		}
+		if v.Status != "" {
+			f.Status = v.Status
+		}
+		f.Page = MergePages(f.Page, v.Page)
+	}
+	return f
+}
+
+// Match is used by repositories that can't filter in a query
+func (f WebhookDeliveryFilter) Match(d entity.WebhookDelivery) bool {
+	if f.WebhookID != "" && d.WebhookID != f.WebhookID {
+		return false
+	}
+	if f.Status != "" && d.Status != f.Status {
+		return false
+	}
+	return true
+}
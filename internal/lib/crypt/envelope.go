@@ -0,0 +1,153 @@
+package crypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix marks a sealed value, anything without it is treated as plaintext
+// written before encryption was turned on
+const prefix = "enc:v1:"
+
+var ErrMalformed = errors.New("malformed encrypted value")
+
+// Envelope encrypts values with envelope encryption, every value gets its own
+// random data key which encrypts the value and is itself encrypted (wrapped)
+// by the current master key of the KeyProvider
+//
+// a sealed value is a string in the form
+// enc:v1:<key id>:<base64 wrapped data key>:<base64 ciphertext>
+// so it can be stored in a text column and opened with the key it names
+// even after the current key has been rotated
+type Envelope struct {
+	keys KeyProvider
+}
+
+func NewEnvelope(keys KeyProvider) Envelope {
+	return Envelope{keys: keys}
+}
+
+// Seal encrypts plaintext with the current key, an empty string stays empty
+func (e Envelope) Seal(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	key, err := e.keys.CurrentKey()
+	if err != nil {
+		return "", err
+	}
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	wrapped, err := encrypt(key.Secret, dataKey)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := encrypt(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	return prefix + key.ID + ":" +
+		base64.RawStdEncoding.EncodeToString(wrapped) + ":" +
+		base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Open decrypts a value created by Seal, values which were never sealed are
+// returned as they are
+func (e Envelope) Open(value string) (string, error) {
+	if !IsSealed(value) {
+		return value, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if len(parts) != 3 {
+		return "", ErrMalformed
+	}
+	key, err := e.keys.Key(parts[0])
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrMalformed, err.Error())
+	}
+	ciphertext, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrMalformed, err.Error())
+	}
+	dataKey, err := decrypt(key.Secret, wrapped)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := decrypt(dataKey, ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation is true when the value is not empty and is either plaintext
+// or sealed with a key other than the current one
+func (e Envelope) NeedsRotation(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+	if !IsSealed(value) {
+		return true, nil
+	}
+	key, err := e.keys.CurrentKey()
+	if err != nil {
+		return false, err
+	}
+	return KeyID(value) != key.ID, nil
+}
+
+// IsSealed reports whether the value was created by Seal
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// KeyID of the master key a sealed value was created with, empty for plaintext
+func KeyID(value string) string {
+	if !IsSealed(value) {
+		return ""
+	}
+	id, _, _ := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	return id
+}
+
+// encrypt with AES-GCM, the nonce is prepended to the ciphertext
+func encrypt(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+func decrypt(key, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, ErrMalformed
+	}
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, sealed, nil)
+}
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
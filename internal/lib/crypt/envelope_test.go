@@ -0,0 +1,123 @@
+package crypt_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/internal/lib/crypt"
+)
+
+func TestEnvelope(t *testing.T) {
+	var (
+		k1        = newKey(t, "k1")
+		k2        = newKey(t, "k2")
+		ring1     = newKeyRing(t, k1)
+		ring2     = newKeyRing(t, k2, k1)
+		plaintext = "123-45-6789"
+	)
+	e1 := crypt.NewEnvelope(ring1)
+	sealed, err := e1.Seal(plaintext)
+	require.NoError(t, err)
+	assert.True(t, crypt.IsSealed(sealed))
+	assert.Equal(t, "k1", crypt.KeyID(sealed))
+	assert.NotContains(t, sealed, plaintext)
+
+	again, err := e1.Seal(plaintext)
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again, "every value gets its own data key and nonce")
+
+	opened, err := e1.Open(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, opened)
+
+	t.Run("empty stays empty", func(t *testing.T) {
+		s, err := e1.Seal("")
+		require.NoError(t, err)
+		assert.Equal(t, "", s)
+	})
+	t.Run("plaintext is opened as is", func(t *testing.T) {
+		s, err := e1.Open(plaintext)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, s)
+	})
+	t.Run("rotation", func(t *testing.T) {
+		e2 := crypt.NewEnvelope(ring2)
+		opened, err := e2.Open(sealed)
+		require.NoError(t, err, "older keys can still open")
+		assert.Equal(t, plaintext, opened)
+
+		rotate, err := e2.NeedsRotation(sealed)
+		require.NoError(t, err)
+		assert.True(t, rotate)
+		rotate, err = e2.NeedsRotation(plaintext)
+		require.NoError(t, err)
+		assert.True(t, rotate)
+		rotate, err = e2.NeedsRotation("")
+		require.NoError(t, err)
+		assert.False(t, rotate)
+
+		resealed, err := e2.Seal(opened)
+		require.NoError(t, err)
+		assert.Equal(t, "k2", crypt.KeyID(resealed))
+		rotate, err = e2.NeedsRotation(resealed)
+		require.NoError(t, err)
+		assert.False(t, rotate)
+
+		_, err = e1.Open(resealed)
+		require.ErrorIs(t, err, crypt.ErrKeyNotFound)
+	})
+	t.Run("tampered", func(t *testing.T) {
+		tampered := sealed[:len(sealed)-2] + "AA"
+		_, err := e1.Open(tampered)
+		require.Error(t, err)
+		_, err = e1.Open("enc:v1:k1:abc")
+		require.ErrorIs(t, err, crypt.ErrMalformed)
+	})
+}
+
+func TestLoadKeyFile(t *testing.T) {
+	var (
+		k1   = newKey(t, "k1")
+		k2   = newKey(t, "k2")
+		path = filepath.Join(t.TempDir(), "pii.keys")
+	)
+	content := strings.Join([]string{"# oldest first", k1.Encode(), "", k2.Encode()}, "\n")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	ring, err := crypt.LoadKeyFile(path)
+	require.NoError(t, err)
+	current, err := ring.CurrentKey()
+	require.NoError(t, err)
+	assert.Equal(t, k2, current, "the last key is current")
+	older, err := ring.Key("k1")
+	require.NoError(t, err)
+	assert.Equal(t, k1, older)
+
+	t.Run("empty", func(t *testing.T) {
+		empty := filepath.Join(t.TempDir(), "empty.keys")
+		require.NoError(t, os.WriteFile(empty, []byte("# no keys\n"), 0o600))
+		_, err := crypt.LoadKeyFile(empty)
+		require.ErrorIs(t, err, crypt.ErrNoKeys)
+	})
+	t.Run("short secret", func(t *testing.T) {
+		short := filepath.Join(t.TempDir(), "short.keys")
+		require.NoError(t, os.WriteFile(short, []byte("k1:c2hvcnQ=\n"), 0o600))
+		_, err := crypt.LoadKeyFile(short)
+		require.ErrorIs(t, err, crypt.ErrInvalidKey)
+	})
+}
+
+func newKey(t testing.TB, id string) crypt.Key {
+	k, err := crypt.GenerateKey(id)
+	require.NoError(t, err)
+	return k
+}
+func newKeyRing(t testing.TB, current crypt.Key, older ...crypt.Key) crypt.KeyRing {
+	ring, err := crypt.NewKeyRing(current, older...)
+	require.NoError(t, err)
+	return ring
+}
@@ -0,0 +1,124 @@
+package crypt
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeySize is the length in bytes of every key, AES-256
+const KeySize = 32
+
+var (
+	ErrKeyNotFound = errors.New("encryption key not found")
+	ErrNoKeys      = errors.New("no encryption keys configured")
+	ErrInvalidKey  = errors.New("encryption key must be 32 bytes")
+)
+
+// Key is a master key used to wrap the data keys of an envelope
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+// KeyProvider supplies master keys, the current key is used to encrypt new
+// values while older keys are kept so existing values can still be decrypted
+// until they are re-encrypted with the current key
+type KeyProvider interface {
+	CurrentKey() (Key, error)
+	Key(id string) (Key, error)
+}
+
+// KeyRing is a KeyProvider holding its keys in memory
+type KeyRing struct {
+	current string
+	keys    map[string]Key
+}
+
+// NewKeyRing with current as the key to encrypt with, older keys are only
+// used to decrypt
+func NewKeyRing(current Key, older ...Key) (KeyRing, error) {
+	ring := KeyRing{
+		current: current.ID,
+		keys:    make(map[string]Key, len(older)+1),
+	}
+	for _, k := range append(append([]Key{}, older...), current) {
+		if k.ID == "" || strings.Contains(k.ID, ":") {
+			return KeyRing{}, fmt.Errorf("invalid key id %q", k.ID)
+		}
+		if len(k.Secret) != KeySize {
+			return KeyRing{}, fmt.Errorf("%w: key[%s]", ErrInvalidKey, k.ID)
+		}
+		ring.keys[k.ID] = k
+	}
+	return ring, nil
+}
+
+// GenerateKey with a random secret
+func GenerateKey(id string) (Key, error) {
+	secret := make([]byte, KeySize)
+	if _, err := rand.Read(secret); err != nil {
+		return Key{}, err
+	}
+	return Key{ID: id, Secret: secret}, nil
+}
+
+// LoadKeyFile reads a local key file, meant for dev and test
+// every line is a key in the form <id>:<base64 secret>, blank lines and lines
+// starting with # are ignored, the last key in the file is the current key
+// so rotating is done by appending a new key
+func LoadKeyFile(path string) (KeyRing, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return KeyRing{}, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var keys []Key
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		id, encoded, ok := strings.Cut(line, ":")
+		if !ok {
+			return KeyRing{}, fmt.Errorf("%s line %d: expected <id>:<base64 secret>", path, n)
+		}
+		secret, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return KeyRing{}, fmt.Errorf("%s line %d: %w", path, n, err)
+		}
+		keys = append(keys, Key{ID: strings.TrimSpace(id), Secret: secret})
+	}
+	if err := scanner.Err(); err != nil {
+		return KeyRing{}, err
+	}
+	if len(keys) == 0 {
+		return KeyRing{}, fmt.Errorf("%w: %s", ErrNoKeys, path)
+	}
+	return NewKeyRing(keys[len(keys)-1], keys[:len(keys)-1]...)
+}
+
+// Encode the key as the line LoadKeyFile expects
+func (k Key) Encode() string {
+	return k.ID + ":" + base64.StdEncoding.EncodeToString(k.Secret)
+}
+
+func (r KeyRing) CurrentKey() (Key, error) {
+	if r.current == "" {
+		return Key{}, ErrNoKeys
+	}
+	return r.Key(r.current)
+}
+func (r KeyRing) Key(id string) (Key, error) {
+	k, ok := r.keys[id]
+	if !ok {
+		return Key{}, fmt.Errorf("%w: key[%s]", ErrKeyNotFound, id)
+	}
+	return k, nil
+}
@@ -1,9 +1,17 @@
 package mig
 
+import "database/sql"
+
 type Flow []Step
 
 type Step struct {
 	ID   string
 	Up   string
 	Down string
+
+	// UpFunc is run instead of Up for a step which needs go, such as one which
+	// fills a column with values computed in go, it runs in a transaction once
+	// the steps before it are applied and may run again if the process stops
+	// before the step is recorded, so it must only change what is not done yet
+	UpFunc func(*sql.Tx, Logger) error
 }
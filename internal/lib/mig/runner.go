@@ -16,11 +16,11 @@ type (
 	Runner struct {
 		db      *sql.DB
 		dialect string
-		logger  logger // slog.Logger
+		logger  Logger // slog.Logger
 		flows   []*Flow
 		migSet  migrate.MigrationSet
 	}
-	logger = interface {
+	Logger = interface {
 		Info(msg string, args ...any)
 		Warn(msg string, args ...any)
 		Error(msg string, args ...any)
@@ -40,7 +40,7 @@ func (r Runner) WithFlows(flows ...*Flow) Runner {
 	r.flows = append(r.flows, flows...)
 	return r
 }
-func (r Runner) WithLogger(l logger) Runner {
+func (r Runner) WithLogger(l Logger) Runner {
 	r.logger = l
 	return r
 }
@@ -74,36 +74,82 @@ func (r Runner) WithDialect(dialect string) Runner {
 }
 
 func (r *Runner) Up() error {
-	migrationSource := r.migrations()
+	var steps = make([]Step, 0)
+	for _, flow := range r.migFlows() {
+		steps = append(steps, flow...)
+	}
 
-	if len(migrationSource.Migrations) == 0 {
+	if len(steps) == 0 {
 		r.log().Warn("no migrations, was Up called more than once?")
 		return nil
 	}
 
+	var (
+		migrationSource = &migrate.MemoryMigrationSource{}
+		applied         int
+	)
+	for _, step := range steps {
+		if step.UpFunc != nil {
+			// the steps before it are applied first, the ones after it may
+			// already be applied so they are not unknown to this partial set
+			migSet := r.migSet
+			migSet.IgnoreUnknown = true
+			n, err := migSet.Exec(r.db, r.dialect, migrationSource, migrate.Up)
+			if err != nil {
+				return err
+			}
+			applied += n
+			if err := r.runFunc(step); err != nil {
+				return fmt.Errorf("migration %s: %w", step.ID, err)
+			}
+		}
+		migrationSource.Migrations = append(migrationSource.Migrations, migration(step))
+	}
+
 	n, err := r.migSet.Exec(r.db, r.dialect, migrationSource, migrate.Up)
 	if err != nil {
 		return err
 	}
-	r.log().Info(fmt.Sprintf("Applied %d migrations in %s schema!", n, r.migSet.SchemaName))
+	applied += n
+	r.log().Info(fmt.Sprintf("Applied %d migrations in %s schema!", applied, r.migSet.SchemaName))
 	return nil
 }
 
-func (r *Runner) migrations() *migrate.MemoryMigrationSource {
-	var migrations = make([]*migrate.Migration, 0)
-	for _, flow := range r.migFlows() {
-		for _, step := range flow {
-			migration := &migrate.Migration{
-				Id:   step.ID,
-				Up:   []string{step.Up},
-				Down: []string{step.Down},
-			}
-			migrations = append(migrations, migration)
+// migration of a step, the one of a step with an UpFunc has no query so
+// applying it only records that the func was run
+func migration(step Step) *migrate.Migration {
+	m := &migrate.Migration{
+		Id:   step.ID,
+		Up:   []string{step.Up},
+		Down: []string{step.Down},
+	}
+	if step.UpFunc != nil {
+		m.Up = nil
+	}
+	return m
+}
+
+// runFunc runs the UpFunc of the step in a transaction unless it is recorded
+// as applied already
+func (r *Runner) runFunc(step Step) error {
+	records, err := r.migSet.GetMigrationRecords(r.db, r.dialect)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if record.Id == step.ID {
+			return nil
 		}
 	}
-	return &migrate.MemoryMigrationSource{
-		Migrations: migrations,
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := step.UpFunc(tx, r.log()); err != nil {
+		return err
 	}
+	return tx.Commit()
 }
 
 // migFlows collects and returns the flows
@@ -122,7 +168,7 @@ func (r *Runner) migFlows() []Flow {
 	return result
 }
 
-func (r Runner) log() logger {
+func (r Runner) log() Logger {
 	if r.logger == nil {
 		r.logger = slog.Default().With("mig", "mig.Runner")
 	}
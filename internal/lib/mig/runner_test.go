@@ -21,7 +21,12 @@ var (
 
 // in addition to being a test, this is really an example of how to use it
 func TestRunner(t *testing.T) {
-	var db = test.DB(t)
+	var (
+		db = test.DB(t)
+
+		// copied before the runner empties it to run the same flow again
+		companiesAgain = append(mig.Flow{}, Flow001Companies...)
+	)
 
 	// construct the runner
 	runner := mig.NewRunner(db).
@@ -43,6 +48,13 @@ func TestRunner(t *testing.T) {
 	assert.Empty(t, Flow001Companies)
 	assert.Empty(t, Flow002Addresses)
 
+	// the step with an UpFunc ran once the table existed, and never runs again
+	again := mig.NewRunner(db).WithSchema(schema).WithFlows(&companiesAgain)
+	require.NoError(t, again.Up())
+	var companies int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM `+schema+`.companies`).Scan(&companies))
+	assert.Equal(t, 1, companies)
+
 	// cleanup
 	if schema != "" && schema != "public" {
 		_, err := db.Exec(`DROP SCHEMA IF EXISTS ` + schema + ` CASCADE`)
@@ -99,6 +111,15 @@ var Flow001Companies = mig.Flow{
 				DROP version,
 				DROP status;`,
 	},
+	{
+		// a step in go, such as one which fills a new column
+		ID: mig.MakeID(idPrefix, 1, 3),
+		UpFunc: func(tx *sql.Tx, log mig.Logger) error {
+			log.Info("adding the first company")
+			_, err := tx.Exec(`INSERT INTO ` + schema + `.companies (org_id, name) VALUES ('org1', 'first')`)
+			return err
+		},
+	},
 }
 
 // flows/002_addresses.go
@@ -0,0 +1,56 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+func testAudit(t *testing.T, r auditRepo) {
+	var (
+		property = fake.Property()
+		moved    = property.WithZip("75402")
+		tenant   = fake.Tenant()
+		actor    = audit.Actor{ID: uuid.NewString(), Transport: audit.TransportGRPC}
+		actx     = audit.WithActor(ctx, actor)
+		pFilter  = filters.NewAuditFilter().WithEntity(audit.EntityProperty, property.ID)
+	)
+	created := audit.Change{EntityType: audit.EntityProperty, EntityID: property.ID, New: property}
+	require.NoError(t, r.StoreProperty(audit.Stage(actx, created), property))
+	// only staged changes are recorded
+	require.NoError(t, r.StoreProperty(actx, property))
+	updated := audit.Change{EntityType: audit.EntityProperty, EntityID: property.ID, Old: property, New: moved}
+	require.NoError(t, r.StoreProperty(audit.Stage(actx, updated), moved))
+	deleted := audit.Change{EntityType: audit.EntityProperty, EntityID: property.ID, Old: moved}
+	require.NoError(t, r.DeleteProperty(audit.Stage(actx, deleted), property.ID))
+
+	tenantAdded := audit.Change{EntityType: audit.EntityTenant, EntityID: tenant.ID, New: tenant.MaskPII()}
+	require.NoError(t, r.StoreTenant(audit.Stage(ctx, tenantAdded), tenant))
+
+	list, err := r.ListAudit(ctx, pFilter)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	for i, action := range []audit.Action{audit.ActionCreate, audit.ActionUpdate, audit.ActionDelete} {
+		assert.Equal(t, action, list[i].Action)
+		assert.Equal(t, actor.ID, list[i].Actor)
+		assert.Equal(t, actor.Transport, list[i].Transport)
+		assert.Equal(t, property.ID, list[i].EntityID)
+		assert.False(t, list[i].CreatedAt.IsZero())
+	}
+	assert.JSONEq(t, `{"Zip": {"old": "`+property.Zip+`", "new": "75402"}}`, string(list[1].Diff))
+
+	list, err = r.ListAudit(ctx, filters.NewAuditFilter().WithActor(actor.ID))
+	require.NoError(t, err)
+	assert.Len(t, list, 3)
+
+	list, err = r.ListAudit(ctx, filters.NewAuditFilter().WithEntity(audit.EntityTenant, tenant.ID))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, audit.ActionCreate, list[0].Action)
+	assert.Empty(t, list[0].Actor)
+}
@@ -0,0 +1,71 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+)
+
+func testDeposit(t *testing.T, r depositRepo) {
+	var (
+		property = fake.Property()
+		tenant   = fake.Tenant()
+		lease    = fake.Lease(property.ID, tenant.ID)
+		half     = lease.Deposit.Minor / 2
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	require.NoError(t, r.StoreTenant(ctx, tenant))
+	require.NoError(t, r.StoreLease(ctx, lease))
+
+	var (
+		first  = entity.NewDepositReceipt(lease.ID, entity.NewMoney(half, lease.Currency()), lease.StartDate).WithMemo("check 1001")
+		second = entity.NewDepositReceipt(lease.ID, entity.NewMoney(lease.Deposit.Minor-half, lease.Currency()), lease.StartDate.Next())
+	)
+	require.NoError(t, r.AddDepositReceipt(ctx, first))
+	require.NoError(t, r.AddDepositReceipt(ctx, second))
+	assert.ErrorIs(t, r.AddDepositReceipt(ctx, first), internal.ErrConflict)
+
+	receipts, err := r.ListDepositReceipts(ctx, lease.ID)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	assertEntityInSet(t, first.ID, receipts...)
+	assertEntityInSet(t, second.ID, receipts...)
+	for _, got := range receipts {
+		assert.False(t, got.CreatedAt.IsZero())
+		if got.ID == first.ID {
+			assert.True(t, first.Equal(got))
+		}
+	}
+
+	receipts, err = r.ListDepositReceipts(ctx, entity.NewID())
+	require.NoError(t, err)
+	assert.Len(t, receipts, 0)
+
+	// disposition
+	_, err = r.GetDepositDisposition(ctx, lease.ID)
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+	disposition, err := entity.NewDepositDisposition(lease.ID, lease.EndDate).
+		WithDeduction(
+			entity.NewDeduction(entity.DeductionCleaning, entity.NewMoney(15000, lease.Currency()), "carpet cleaning"),
+			entity.NewDeduction(entity.DeductionDamage, entity.NewMoney(4000, lease.Currency()), "broken blinds"),
+		).
+		Settle(lease.Deposit)
+	require.NoError(t, err)
+	require.NoError(t, r.StoreDepositDisposition(ctx, disposition))
+
+	got, err := r.GetDepositDisposition(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.True(t, disposition.Equal(*got))
+	assert.False(t, got.CreatedAt.IsZero())
+
+	// only one disposition per lease
+	again := entity.NewDepositDisposition(lease.ID, lease.EndDate)
+	again, err = again.Settle(lease.Deposit)
+	require.NoError(t, err)
+	assert.ErrorIs(t, r.StoreDepositDisposition(ctx, again), internal.ErrConflict)
+}
@@ -2,13 +2,13 @@ package repository
 
 import (
 	"context"
-	"strings"
 	"sync"
 	"time"
 
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/internal"
 	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/search"
 	"github.com/tempcke/rpm/usecase"
 )
 
@@ -34,11 +34,25 @@ func (r InMemory) WithEntityErr(id string, err error) InMemory {
 	return r
 }
 
-func (r InMemory) StoreProperty(_ context.Context, property entity.Property) error {
+func (r InMemory) StoreProperty(ctx context.Context, property entity.Property) error {
 	if property.CreatedAt.IsZero() {
-		property.CreatedAt = time.Now()
+		property.CreatedAt = r.createdAt(property.ID)
 	}
-	return r.storeEntity(property)
+	property.DeletedAt = time.Time{}
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[property.GetID()]; err != nil {
+		return err
+	}
+	if cur, ok := r.entities[property.GetID()].(entity.Property); ok && cur.Deleted() {
+		return internal.MakeErr(internal.ErrConflict, "property["+property.ID+"] was removed")
+	}
+	if dup := r.addressTaken(property); dup != "" {
+		return usecase.DuplicateAddressError{PropertyID: dup}
+	}
+	r.entities[property.GetID()] = property
+	r.addDefaultUnit(property)
+	return r.stage(ctx)
 }
 func (r InMemory) NewProperty(street, city, state, zip string) entity.Property {
 	return entity.NewProperty(street, city, state, zip)
@@ -48,7 +62,11 @@ func (r InMemory) GetProperty(_ context.Context, id string) (entity.Property, er
 	if err != nil {
 		return entity.Property{}, err
 	}
-	return e.(entity.Property), nil
+	p := e.(entity.Property)
+	if p.Deleted() {
+		return entity.Property{}, internal.MakeErr(internal.ErrEntityNotFound, id)
+	}
+	return p, nil
 }
 func (r InMemory) PropertyList(_ context.Context, f usecase.PropertyFilter) ([]entity.Property, error) {
 	for _, err := range r.entityErrs {
@@ -63,41 +81,208 @@ func (r InMemory) PropertyList(_ context.Context, f usecase.PropertyFilter) ([]e
 			all = append(all, p)
 		}
 	}
-	var list []entity.Property
+	var (
+		list  []entity.Property
+		fuzzy []entity.Property
+		q     = search.Parse(f.Search)
+	)
+	// like the postgres repo, similar addresses are only listed when no
+	// address matches the search
 	for _, p := range all {
-		// WARNING: this is not identical behavior to the postgres repo
-		// but it is good enough for in memory tests
-		if strings.Contains(p.String(), f.Search) {
+		if !f.IncludeDeleted && p.Deleted() {
+			continue
+		}
+		if q.Empty() {
+			list = append(list, p)
+			continue
+		}
+		doc := search.Normalize(p.String())
+		p.Rank = q.Rank(doc)
+		if q.Match(doc) {
 			list = append(list, p)
+		} else if q.Similar(doc) {
+			fuzzy = append(fuzzy, p)
+		}
+	}
+	if len(list) == 0 {
+		list = fuzzy
+	}
+	return filters.Paginate(list, f.Paging(), filters.PropertySortValue)
+}
+func (r InMemory) DeleteProperty(ctx context.Context, id string) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[id]; err != nil {
+		return err
+	}
+	if p, ok := r.entities[id].(entity.Property); ok && !p.Deleted() {
+		p.DeletedAt = time.Now()
+		r.entities[id] = p
+	}
+	return r.stage(ctx)
+}
+func (r InMemory) RestoreProperty(ctx context.Context, id string) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[id]; err != nil {
+		return err
+	}
+	p, ok := r.entities[id].(entity.Property)
+	if !ok || !p.Deleted() {
+		return internal.MakeErr(internal.ErrEntityNotFound, "removed property["+id+"]")
+	}
+	if dup := r.addressTaken(p); dup != "" {
+		return usecase.DuplicateAddressError{PropertyID: dup}
+	}
+	p.DeletedAt = time.Time{}
+	r.entities[id] = p
+	return r.stage(ctx)
+}
+
+// addressTaken is the id of another property which was not removed and has
+// the address of p, like the unique address key index of the postgres repo,
+// the lock must be held
+func (r InMemory) addressTaken(p entity.Property) entity.ID {
+	key := p.Address.Key()
+	for id, e := range r.entities {
+		if other, ok := e.(entity.Property); ok && id != p.ID && !other.Deleted() && other.Address.Key() == key {
+			return id
 		}
 	}
-	return list, nil
+	return ""
 }
-func (r InMemory) DeleteProperty(_ context.Context, id string) error { return r.delEntity(id) }
 
-func (r InMemory) StoreTenant(_ context.Context, e entity.Tenant) error { return r.storeEntity(e) }
+func (r InMemory) StoreTenant(ctx context.Context, e entity.Tenant) error {
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = r.createdAt(e.ID)
+	}
+	e.DeletedAt = time.Time{}
+	if cur, err := r.getEntity(e.ID); err == nil && cur.(entity.Tenant).Deleted() {
+		return internal.MakeErr(internal.ErrConflict, "tenant["+e.ID+"] was removed")
+	}
+	if err := r.storeEntity(e); err != nil {
+		return err
+	}
+	return r.stageOutbox(ctx)
+}
 func (r InMemory) GetTenant(_ context.Context, id entity.ID) (*entity.Tenant, error) {
 	e, err := r.getEntity(id)
 	if err != nil {
 		return nil, err
 	}
 	t := e.(entity.Tenant) // only used in tests, we want it to panic if it is wrong
+	if t.Deleted() {
+		return nil, internal.MakeErr(internal.ErrEntityNotFound, id)
+	}
 	return &t, nil
 }
-func (r InMemory) ListTenants(_ context.Context, _ ...filters.TenantFilter) ([]entity.Tenant, error) {
+func (r InMemory) ListTenants(_ context.Context, filter ...filters.TenantFilter) ([]entity.Tenant, error) {
 	for _, err := range r.entityErrs {
 		return nil, err
 	}
-	list := make([]entity.Tenant, 0, len(r.entities))
+	var (
+		f      = filters.MergeTenantFilters(filter...)
+		list   = make([]entity.Tenant, 0, len(r.entities))
+		leases []entity.Lease
+	)
+	if f.LeasingPropertyID != "" {
+		for _, e := range r.entities {
+			if l, ok := e.(entity.Lease); ok && l.PropertyID == f.LeasingPropertyID {
+				leases = append(leases, l)
+			}
+		}
+	}
 	for _, e := range r.entities {
-		if item, ok := e.(entity.Tenant); ok {
+		if item, ok := e.(entity.Tenant); ok && f.Match(item, leases...) {
 			if _, err := r.getEntity(e.GetID()); err != nil {
 				return nil, err
 			}
 			list = append(list, item)
 		}
 	}
-	return list, nil
+	return filters.Paginate(list, f.Page, filters.TenantSortValue)
+}
+func (r InMemory) DeleteTenant(ctx context.Context, id entity.ID) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[id]; err != nil {
+		return err
+	}
+	if t, ok := r.entities[id].(entity.Tenant); ok && !t.Deleted() {
+		t.DeletedAt = time.Now()
+		r.entities[id] = t
+	}
+	return r.stage(ctx)
+}
+func (r InMemory) RestoreTenant(ctx context.Context, id entity.ID) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[id]; err != nil {
+		return err
+	}
+	t, ok := r.entities[id].(entity.Tenant)
+	if !ok || !t.Deleted() {
+		return internal.MakeErr(internal.ErrEntityNotFound, "removed tenant["+id+"]")
+	}
+	t.DeletedAt = time.Time{}
+	r.entities[id] = t
+	return r.stage(ctx)
+}
+
+func (r InMemory) StoreLease(ctx context.Context, e entity.Lease) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[e.GetID()]; err != nil {
+		return err
+	}
+	if err := r.leaseRefs(e); err != nil {
+		return err
+	}
+	r.entities[e.GetID()] = e
+	return r.stage(ctx)
+}
+
+// leaseRefs fails like the foreign keys of the postgres repo when the
+// property, unit or a tenant of the lease was never stored, the lock must be held
+func (r InMemory) leaseRefs(l entity.Lease) error {
+	if _, ok := r.entities[l.PropertyID].(entity.Property); !ok {
+		return internal.MakeErr(internal.ErrEntityInvalid, "lease["+l.ID+"] property or unit does not exist")
+	}
+	if _, ok := r.entities[l.UnitID].(entity.Unit); l.UnitID != "" && !ok {
+		return internal.MakeErr(internal.ErrEntityInvalid, "lease["+l.ID+"] property or unit does not exist")
+	}
+	for _, id := range l.TenantIDs {
+		if _, ok := r.entities[id].(entity.Tenant); !ok {
+			return internal.MakeErr(internal.ErrEntityInvalid, "lease["+l.ID+"] tenant["+id+"] does not exist")
+		}
+	}
+	return nil
+}
+func (r InMemory) GetLease(_ context.Context, id entity.ID) (*entity.Lease, error) {
+	e, err := r.getEntity(id)
+	if err != nil {
+		return nil, err
+	}
+	l := e.(entity.Lease) // only used in tests, we want it to panic if it is wrong
+	return &l, nil
+}
+func (r InMemory) ListLeases(_ context.Context, filter ...filters.LeaseFilter) ([]entity.Lease, error) {
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	var (
+		f    = filters.MergeLeaseFilters(filter...)
+		list = make([]entity.Lease, 0)
+	)
+	for _, e := range r.entities {
+		if item, ok := e.(entity.Lease); ok && f.Match(item) {
+			if _, err := r.getEntity(e.GetID()); err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+	}
+	return filters.Paginate(list, f.Paging(), filters.LeaseSortValue)
 }
 
 func (r InMemory) storeEntity(e entity.Entity) error {
@@ -109,6 +294,19 @@ func (r InMemory) storeEntity(e entity.Entity) error {
 	r.entities[e.GetID()] = e
 	return nil
 }
+
+// createdAt is when the entity with the id was first stored, now when it is new
+func (r InMemory) createdAt(id entity.ID) time.Time {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	switch e := r.entities[id].(type) {
+	case entity.Property:
+		return e.CreatedAt
+	case entity.Tenant:
+		return e.CreatedAt
+	}
+	return time.Now()
+}
 func (r InMemory) getEntity(id entity.ID) (entity.Entity, error) {
 	rwMutex.RLock()
 	defer rwMutex.RUnlock()
@@ -121,12 +319,3 @@ func (r InMemory) getEntity(id entity.ID) (entity.Entity, error) {
 	}
 	return e, nil
 }
-func (r InMemory) delEntity(id entity.ID) error {
-	rwMutex.Lock()
-	defer rwMutex.Unlock()
-	if err := r.entityErrs[id]; err != nil {
-		return err
-	}
-	delete(r.entities, id)
-	return nil
-}
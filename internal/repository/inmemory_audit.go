@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// ListAudit entries matching the filter, oldest first
+func (r InMemory) ListAudit(_ context.Context, filter ...filters.AuditFilter) ([]audit.Entry, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	f := filters.MergeAuditFilters(filter...)
+	list := make([]audit.Entry, 0)
+	for _, e := range r.entities {
+		if entry, ok := e.(audit.Entry); ok && f.Match(entry) {
+			list = append(list, entry)
+		}
+	}
+	return filters.Paginate(list, f.Page, filters.AuditSortValue)
+}
+
+// stageAudit records the changes staged in the context in the audit log,
+// callers must hold the lock
+func (r InMemory) stageAudit(ctx context.Context) error {
+	for _, c := range audit.Staged(ctx) {
+		e, err := audit.NewEntry(ctx, c, time.Now())
+		if err != nil {
+			return err
+		}
+		r.entities[e.ID] = e
+	}
+	return nil
+}
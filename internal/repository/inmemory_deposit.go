@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+)
+
+// AddDepositReceipt mirrors the postgres constraints, a receipt can only be added once
+func (r InMemory) AddDepositReceipt(_ context.Context, e entity.DepositReceipt) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[e.GetID()]; err != nil {
+		return err
+	}
+	if _, ok := r.entities[e.GetID()]; ok {
+		return internal.MakeErr(internal.ErrConflict, "deposit receipt["+e.ID+"] exists")
+	}
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+	r.entities[e.GetID()] = e
+	return nil
+}
+func (r InMemory) ListDepositReceipts(_ context.Context, leaseID entity.ID) ([]entity.DepositReceipt, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	list := make([]entity.DepositReceipt, 0)
+	for _, e := range r.entities {
+		if item, ok := e.(entity.DepositReceipt); ok && item.LeaseID == leaseID {
+			list = append(list, item)
+		}
+	}
+	return list, nil
+}
+
+// StoreDepositDisposition mirrors the postgres constraints, a lease can only have one
+func (r InMemory) StoreDepositDisposition(_ context.Context, e entity.DepositDisposition) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[e.GetID()]; err != nil {
+		return err
+	}
+	for _, cur := range r.entities {
+		if item, ok := cur.(entity.DepositDisposition); ok && (item.ID == e.ID || item.LeaseID == e.LeaseID) {
+			return internal.MakeErr(internal.ErrConflict, "deposit for lease["+e.LeaseID+"] already disposed")
+		}
+	}
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+	r.entities[e.GetID()] = e
+	return nil
+}
+func (r InMemory) GetDepositDisposition(_ context.Context, leaseID entity.ID) (*entity.DepositDisposition, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	for _, e := range r.entities {
+		if item, ok := e.(entity.DepositDisposition); ok && item.LeaseID == leaseID {
+			return &item, nil
+		}
+	}
+	return nil, internal.MakeErr(internal.ErrEntityNotFound, "deposit disposition for lease["+leaseID+"]")
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/tempcke/rpm/entity"
+)
+
+func (r InMemory) StoreLateFeePolicy(_ context.Context, p entity.LateFeePolicy) error {
+	if cur, err := r.getEntity(p.ID); err == nil {
+		p.CreatedAt = cur.(entity.LateFeePolicy).CreatedAt
+	}
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = time.Now()
+	}
+	return r.storeEntity(p)
+}
+func (r InMemory) ListLateFeePolicies(_ context.Context, scopeIDs ...entity.ID) ([]entity.LateFeePolicy, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	list := make([]entity.LateFeePolicy, 0)
+	for _, e := range r.entities {
+		if p, ok := e.(entity.LateFeePolicy); ok {
+			if (p.LeaseID != "" && slices.Contains(scopeIDs, p.LeaseID)) ||
+				(p.PropertyID != "" && slices.Contains(scopeIDs, p.PropertyID)) {
+				list = append(list, p)
+			}
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+)
+
+// StoreLeaseVersion mirrors the postgres constraints, a version can only be
+// stored once and a lease can only be renewed once
+func (r InMemory) StoreLeaseVersion(ctx context.Context, v entity.LeaseVersion) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[v.LeaseID]; err != nil {
+		return err
+	}
+	if _, ok := r.entities[v.GetID()]; ok {
+		return internal.MakeErr(internal.ErrConflict, "lease version["+v.GetID()+"] exists")
+	}
+	if err := r.leaseRefs(v.Terms); err != nil {
+		return err
+	}
+	if renews := v.Terms.RenewsID; renews != "" {
+		for _, e := range r.entities {
+			if item, ok := e.(entity.Lease); ok && item.ID != v.LeaseID && item.RenewsID == renews {
+				return internal.MakeErr(internal.ErrConflict, "lease["+renews+"] already renewed")
+			}
+		}
+	}
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = time.Now()
+	}
+	r.entities[v.LeaseID] = v.Terms
+	r.entities[v.GetID()] = v
+	return r.stage(ctx)
+}
+func (r InMemory) ListLeaseVersions(_ context.Context, leaseID entity.ID) ([]entity.LeaseVersion, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	list := make([]entity.LeaseVersion, 0)
+	for _, e := range r.entities {
+		if item, ok := e.(entity.LeaseVersion); ok && item.LeaseID == leaseID {
+			list = append(list, item)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	return list, nil
+}
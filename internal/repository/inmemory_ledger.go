@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+)
+
+// AppendLedgerEntry mirrors the postgres constraints, an entry can only be added once
+// and only one entry may reverse another
+func (r InMemory) AppendLedgerEntry(_ context.Context, e entity.LedgerEntry) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[e.GetID()]; err != nil {
+		return err
+	}
+	for _, cur := range r.entities {
+		if cur.GetID() == e.GetID() {
+			return internal.MakeErr(internal.ErrConflict, "ledger entry["+e.ID+"] exists")
+		}
+		if item, ok := cur.(entity.LedgerEntry); ok && e.IsReversal() && item.ReversesID == e.ReversesID {
+			return internal.MakeErr(internal.ErrConflict, "ledger entry["+e.ReversesID+"] already reversed")
+		}
+	}
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+	r.entities[e.GetID()] = e
+	return nil
+}
+func (r InMemory) GetLedgerEntry(_ context.Context, id entity.ID) (*entity.LedgerEntry, error) {
+	e, err := r.getEntity(id)
+	if err != nil {
+		return nil, err
+	}
+	entry := e.(entity.LedgerEntry) // only used in tests, we want it to panic if it is wrong
+	return &entry, nil
+}
+func (r InMemory) ListLedgerEntries(_ context.Context, leaseID entity.ID) ([]entity.LedgerEntry, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	list := make([]entity.LedgerEntry, 0)
+	for _, e := range r.entities {
+		if item, ok := e.(entity.LedgerEntry); ok && item.LeaseID == leaseID {
+			list = append(list, item)
+		}
+	}
+	return list, nil
+}
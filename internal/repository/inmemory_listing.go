@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// StoreListing mirrors the postgres constraints, a property has at most one listing
+func (r InMemory) StoreListing(ctx context.Context, l entity.Listing) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[l.GetID()]; err != nil {
+		return err
+	}
+	for _, e := range r.entities {
+		if cur, ok := e.(entity.Listing); ok && cur.PropertyID == l.PropertyID && cur.ID != l.ID {
+			return internal.MakeErr(internal.ErrConflict, "listing for property["+l.PropertyID+"]")
+		}
+	}
+	l.Photos = append([]entity.ListingPhoto{}, l.Photos...)
+	l.UpdatedAt = time.Now()
+	r.entities[l.GetID()] = l
+	return r.stage(ctx)
+}
+
+// GetListing of the property, internal.ErrEntityNotFound when it has none
+func (r InMemory) GetListing(_ context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	if err := r.entityErrs[propertyID]; err != nil {
+		return nil, err
+	}
+	for _, e := range r.entities {
+		if l, ok := e.(entity.Listing); ok && l.PropertyID == propertyID {
+			return &l, nil
+		}
+	}
+	return nil, internal.MakeErr(internal.ErrEntityNotFound, "listing for property["+propertyID+"]")
+}
+
+// ListListings matching the filter, most recently published first then drafts
+func (r InMemory) ListListings(_ context.Context, filter ...filters.ListingFilter) ([]entity.Listing, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	f := filters.MergeListingFilters(filter...)
+	list := make([]entity.Listing, 0)
+	for _, e := range r.entities {
+		l, ok := e.(entity.Listing)
+		if !ok {
+			continue
+		}
+		p, _ := r.entities[l.PropertyID].(entity.Property)
+		if f.Match(l, p) {
+			list = append(list, l)
+		}
+	}
+	return filters.Paginate(list, f.Paging(), filters.ListingSortValue)
+}
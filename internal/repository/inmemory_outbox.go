@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// ClaimOutbox pending messages due at now, oldest first
+func (r InMemory) ClaimOutbox(_ context.Context, now, lockedUntil time.Time, limit int) ([]event.Message, error) {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	due := r.outbox(filters.NewOutboxFilter().WithStatus(event.MessagePending))
+	list := make([]event.Message, 0)
+	for _, m := range due {
+		if len(list) == limit {
+			break
+		}
+		if m.NextAttempt.After(now) {
+			continue
+		}
+		m.NextAttempt = lockedUntil
+		r.entities[m.ID] = m
+		list = append(list, m)
+	}
+	return list, nil
+}
+func (r InMemory) StoreOutboxMessage(_ context.Context, m event.Message) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[m.ID]; err != nil {
+		return err
+	}
+	if _, ok := r.entities[m.ID].(event.Message); !ok {
+		return internal.MakeErr(internal.ErrEntityNotFound, "outbox message["+m.ID+"]")
+	}
+	r.entities[m.ID] = m
+	return nil
+}
+func (r InMemory) GetOutboxMessage(_ context.Context, id string) (*event.Message, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	if err := r.entityErrs[id]; err != nil {
+		return nil, err
+	}
+	m, ok := r.entities[id].(event.Message)
+	if !ok {
+		return nil, internal.MakeErr(internal.ErrEntityNotFound, "outbox message["+id+"]")
+	}
+	return &m, nil
+}
+
+// ListOutbox messages matching the filter, oldest first
+func (r InMemory) ListOutbox(_ context.Context, filter ...filters.OutboxFilter) ([]event.Message, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	f := filters.MergeOutboxFilters(filter...)
+	return filters.Paginate(r.outbox(f), f.Page, filters.OutboxSortValue)
+}
+func (r InMemory) outbox(filter ...filters.OutboxFilter) []event.Message {
+	f := filters.MergeOutboxFilters(filter...)
+	list := make([]event.Message, 0)
+	for _, e := range r.entities {
+		if m, ok := e.(event.Message); ok && f.Match(m) {
+			list = append(list, m)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.Before(list[j].CreatedAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list
+}
+
+// stageOutbox stores the events staged in the context in the outbox and
+// records the changes staged with them in the audit log, it is called once
+// the write they describe succeeded
+func (r InMemory) stageOutbox(ctx context.Context) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	return r.stage(ctx)
+}
+
+// stage is stageOutbox for callers already holding the lock
+func (r InMemory) stage(ctx context.Context) error {
+	for _, e := range event.Staged(ctx) {
+		m, err := event.NewMessage(e, time.Now())
+		if err != nil {
+			return err
+		}
+		r.entities[m.ID] = m
+	}
+	return r.stageAudit(ctx)
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tempcke/rpm/entity"
+)
+
+// PurgeProperties removed before the time along with their units, listing,
+// late fee and screening policies, a property a lease, rental application or
+// screening report refers to is kept
+func (r InMemory) PurgeProperties(_ context.Context, before time.Time) (int, error) {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	kept := make(map[entity.ID]bool)
+	for _, e := range r.entities {
+		switch v := e.(type) {
+		case entity.Lease:
+			kept[v.PropertyID] = true
+		case entity.RentalApplication:
+			kept[v.PropertyID] = true
+		case entity.ScreeningReport:
+			kept[v.PropertyID] = true
+		}
+	}
+	purged := make(map[entity.ID]bool)
+	for id, e := range r.entities {
+		if p, ok := e.(entity.Property); ok && p.Deleted() && p.DeletedAt.Before(before) && !kept[id] {
+			purged[id] = true
+		}
+	}
+	for id, e := range r.entities {
+		switch v := e.(type) {
+		case entity.Property:
+			if purged[v.ID] {
+				delete(r.entities, id)
+			}
+		case entity.Unit:
+			if purged[v.PropertyID] {
+				delete(r.entities, id)
+			}
+		case entity.Listing:
+			if purged[v.PropertyID] {
+				delete(r.entities, id)
+			}
+		case entity.LateFeePolicy:
+			if purged[v.PropertyID] {
+				delete(r.entities, id)
+			}
+		case entity.ScreeningPolicy:
+			if purged[v.PropertyID] {
+				delete(r.entities, id)
+			}
+		}
+	}
+	return len(purged), nil
+}
+
+// PurgeTenants removed before the time, a tenant a lease or converted rental
+// application refers to is kept
+func (r InMemory) PurgeTenants(_ context.Context, before time.Time) (int, error) {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	kept := make(map[entity.ID]bool)
+	for _, e := range r.entities {
+		switch v := e.(type) {
+		case entity.Lease:
+			for _, id := range v.TenantIDs {
+				kept[id] = true
+			}
+		case entity.RentalApplication:
+			for _, id := range v.TenantIDs {
+				kept[id] = true
+			}
+		}
+	}
+	var n int
+	for id, e := range r.entities {
+		if t, ok := e.(entity.Tenant); ok && t.Deleted() && t.DeletedAt.Before(before) && !kept[id] {
+			delete(r.entities, id)
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+func (r InMemory) StoreRentalApplication(_ context.Context, a entity.RentalApplication) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[a.GetID()]; err != nil {
+		return err
+	}
+	now := time.Now()
+	if cur, ok := r.entities[a.GetID()].(entity.RentalApplication); ok {
+		a.SubmittedAt = cur.SubmittedAt
+	} else if a.SubmittedAt.IsZero() {
+		a.SubmittedAt = now
+	}
+	a.UpdatedAt = now
+	a.Notes = append([]entity.ApplicationNote{}, a.Notes...)
+	for i := range a.Notes {
+		if a.Notes[i].CreatedAt.IsZero() {
+			a.Notes[i].CreatedAt = now
+		}
+	}
+	r.entities[a.GetID()] = a
+	return nil
+}
+
+// ConvertRentalApplication mirrors the postgres constraints, an application
+// can only be converted once
+func (r InMemory) ConvertRentalApplication(ctx context.Context, a entity.RentalApplication, tenants []entity.Tenant) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[a.GetID()]; err != nil {
+		return err
+	}
+	cur, ok := r.entities[a.GetID()].(entity.RentalApplication)
+	if !ok {
+		return internal.MakeErr(internal.ErrEntityNotFound, "application["+a.ID+"]")
+	}
+	if cur.Converted() {
+		return internal.NewErrors(internal.ErrConflict, entity.ErrApplicationConverted)
+	}
+	cur.TenantIDs = make([]entity.ID, 0, len(tenants))
+	for _, t := range tenants {
+		r.entities[t.GetID()] = t
+		cur.TenantIDs = append(cur.TenantIDs, t.ID)
+	}
+	cur.UpdatedAt = time.Now()
+	r.entities[cur.GetID()] = cur
+	return r.stage(ctx)
+}
+func (r InMemory) GetRentalApplication(_ context.Context, id entity.ID) (*entity.RentalApplication, error) {
+	e, err := r.getEntity(id)
+	if err != nil {
+		return nil, err
+	}
+	a := e.(entity.RentalApplication) // only used in tests, we want it to panic if it is wrong
+	return &a, nil
+}
+func (r InMemory) ListRentalApplications(_ context.Context, filter ...filters.ApplicationFilter) ([]entity.RentalApplication, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	f := filters.MergeApplicationFilters(filter...)
+	list := make([]entity.RentalApplication, 0)
+	for _, e := range r.entities {
+		if item, ok := e.(entity.RentalApplication); ok && f.Match(item) {
+			list = append(list, item)
+		}
+	}
+	return filters.Paginate(list, f.Page, filters.ApplicationSortValue)
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+func (r InMemory) StoreScreeningPolicy(_ context.Context, p entity.ScreeningPolicy) error {
+	p.Rules = append([]entity.ScreeningRule{}, p.Rules...)
+	p.UpdatedAt = time.Now()
+	return r.storeEntity(p)
+}
+
+// GetScreeningPolicy of the property, internal.ErrEntityNotFound when it has none
+func (r InMemory) GetScreeningPolicy(_ context.Context, propertyID entity.ID) (*entity.ScreeningPolicy, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	if err := r.entityErrs[propertyID]; err != nil {
+		return nil, err
+	}
+	for _, e := range r.entities {
+		if p, ok := e.(entity.ScreeningPolicy); ok && p.PropertyID == propertyID {
+			return &p, nil
+		}
+	}
+	return nil, internal.MakeErr(internal.ErrEntityNotFound, "screening policy for property["+propertyID+"]")
+}
+func (r InMemory) AddScreeningReport(_ context.Context, report entity.ScreeningReport) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[report.ApplicationID]; err != nil {
+		return err
+	}
+	if _, ok := r.entities[report.GetID()]; ok {
+		return internal.MakeErr(internal.ErrConflict, "screening report["+report.ID+"]")
+	}
+	if report.CreatedAt.IsZero() {
+		report.CreatedAt = time.Now()
+	}
+	r.entities[report.GetID()] = report
+	return nil
+}
+
+// ListScreeningReports matching the filter, oldest first
+func (r InMemory) ListScreeningReports(_ context.Context, filter ...filters.ScreeningReportFilter) ([]entity.ScreeningReport, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	var (
+		f    = filters.MergeScreeningReportFilters(filter...)
+		list = make([]entity.ScreeningReport, 0)
+	)
+	for _, e := range r.entities {
+		if report, ok := e.(entity.ScreeningReport); ok && f.Match(report) {
+			list = append(list, report)
+		}
+	}
+	return filters.Paginate(list, f.Page, filters.ScreeningReportSortValue)
+}
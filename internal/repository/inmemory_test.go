@@ -10,17 +10,23 @@ import (
 var (
 	_ usecase.PropertyRepo = (*repository.InMemory)(nil)
 	_ usecase.TenantRepo   = (*repository.InMemory)(nil)
+	_ usecase.LeaseRepo    = (*repository.InMemory)(nil)
 )
 
 func TestPropertyRepo_InMemory(t *testing.T) {
 	var tests = map[string]struct {
 		fn func(*testing.T, propertyRepo)
 	}{
-		"store":  {testStoreAndRetrieveProperty},
-		"update": {testUpdateProperty},
-		"list":   {testListProperties},
-		"remove": {testRemoveProperty},
-		"get":    {testGetProperty},
+		"store":   {testStoreAndRetrieveProperty},
+		"update":  {testUpdateProperty},
+		"list":    {testListProperties},
+		"page":    {testListPropertiesPage},
+		"search":  {testSearchProperties},
+		"remove":  {testRemoveProperty},
+		"restore": {testRestoreProperty},
+		"address": {testDuplicateAddress},
+		"purge":   {testPurgeProperties},
+		"get":     {testGetProperty},
 	}
 
 	r := repository.NewInMemoryRepo()
@@ -33,6 +39,141 @@ func TestPropertyRepo_InMemory(t *testing.T) {
 func TestTenantRepo_InMemory(t *testing.T) {
 	var tests = map[string]struct{ fn func(*testing.T, tenantRepo) }{
 		"store get list": {testTenant},
+		"remove":         {testRemoveTenant},
+		"filter":         {testListTenantsFilter},
+		"page":           {testListTenantsPage},
+	}
+
+	r := repository.NewInMemoryRepo()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestLeaseRepo_InMemory(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, leaseRepo) }{
+		"store get list":  {testLease},
+		"versions":        {testLeaseVersions},
+		"purge leased":    {testPurgeLeased},
+		"tenants leasing": {testListTenantsLeasing},
+		"list page":       {testListLeasesPage},
+	}
+
+	r := repository.NewInMemoryRepo()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+
+func TestLedgerRepo_InMemory(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, ledgerRepo) }{
+		"append get list": {testLedger},
+	}
+
+	r := repository.NewInMemoryRepo()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestLateFeeRepo_InMemory(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, lateFeeRepo) }{
+		"store list policies": {testLateFeePolicies},
+	}
+
+	r := repository.NewInMemoryRepo()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestDepositRepo_InMemory(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, depositRepo) }{
+		"receipts and disposition": {testDeposit},
+	}
+
+	r := repository.NewInMemoryRepo()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestApplicationRepo_InMemory(t *testing.T) {
+	var tests = map[string]struct {
+		fn func(*testing.T, applicationRepo)
+	}{
+		"store get list convert": {testRentalApplication},
+		"screening":              {testScreening},
+		"list page":              {testListApplicationsPage},
+		"screening list page":    {testListScreeningReportsPage},
+	}
+
+	r := repository.NewInMemoryRepo()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestListingRepo_InMemory(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, listingRepo) }{
+		"store get list": {testListing},
+		"list page":      {testListListingsPage},
+	}
+
+	r := repository.NewInMemoryRepo()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestUnitRepo_InMemory(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, unitRepo) }{
+		"store get list delete": {testUnit},
+	}
+
+	r := repository.NewInMemoryRepo()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestOutboxRepo_InMemory(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, outboxRepo) }{
+		"stage claim store list": {testOutbox},
+	}
+
+	r := repository.NewInMemoryRepo()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestWebhookRepo_InMemory(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, webhookRepo) }{
+		"store get list delete": {testWebhooks},
+		"deliveries":            {testWebhookDeliveries},
+	}
+
+	r := repository.NewInMemoryRepo()
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestAuditRepo_InMemory(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, auditRepo) }{
+		"stage list": {testAudit},
 	}
 
 	r := repository.NewInMemoryRepo()
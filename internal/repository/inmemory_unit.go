@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+)
+
+// StoreUnit mirrors the postgres constraints, the number of a unit is unique
+// within its property
+func (r InMemory) StoreUnit(ctx context.Context, u entity.Unit) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[u.GetID()]; err != nil {
+		return err
+	}
+	for _, e := range r.entities {
+		if cur, ok := e.(entity.Unit); ok && cur.PropertyID == u.PropertyID && cur.Number == u.Number && cur.ID != u.ID {
+			return internal.MakeErr(internal.ErrConflict, "unit["+u.Number+"] of property["+u.PropertyID+"]")
+		}
+	}
+	u.LeaseID = "" // occupancy is read from the leases
+	r.entities[u.GetID()] = u
+	return r.stage(ctx)
+}
+
+// addDefaultUnit gives a property without units its default unit, the caller
+// holds the lock so it is stored along with the property
+func (r InMemory) addDefaultUnit(p entity.Property) {
+	for _, e := range r.entities {
+		if u, ok := e.(entity.Unit); ok && u.PropertyID == p.ID {
+			return
+		}
+	}
+	u := entity.DefaultUnit(p)
+	r.entities[u.GetID()] = u
+}
+func (r InMemory) GetUnit(_ context.Context, id entity.ID) (*entity.Unit, error) {
+	e, err := r.getEntity(id)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := e.(entity.Unit)
+	if !ok {
+		return nil, internal.MakeErr(internal.ErrEntityNotFound, "unit["+id+"]")
+	}
+	return &u, nil
+}
+
+// ListUnits of the property ordered by number
+func (r InMemory) ListUnits(_ context.Context, propertyID entity.ID) ([]entity.Unit, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	list := make([]entity.Unit, 0)
+	for _, e := range r.entities {
+		if u, ok := e.(entity.Unit); ok && u.PropertyID == propertyID {
+			list = append(list, u)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Number != list[j].Number {
+			return list[i].Number < list[j].Number
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list, nil
+}
+func (r InMemory) DeleteUnit(ctx context.Context, id entity.ID) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[id]; err != nil {
+		return err
+	}
+	if _, ok := r.entities[id].(entity.Unit); ok {
+		delete(r.entities, id)
+	}
+	return r.stage(ctx)
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+func (r InMemory) StoreWebhook(_ context.Context, w entity.Webhook) error {
+	w.Events = append([]string{}, w.Events...)
+	return r.storeEntity(w)
+}
+func (r InMemory) GetWebhook(_ context.Context, id entity.ID) (*entity.Webhook, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	if err := r.entityErrs[id]; err != nil {
+		return nil, err
+	}
+	w, ok := r.entities[id].(entity.Webhook)
+	if !ok {
+		return nil, internal.MakeErr(internal.ErrEntityNotFound, "webhook["+id+"]")
+	}
+	return &w, nil
+}
+
+// ListWebhooks oldest first
+func (r InMemory) ListWebhooks(_ context.Context) ([]entity.Webhook, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	list := make([]entity.Webhook, 0)
+	for _, e := range r.entities {
+		if w, ok := e.(entity.Webhook); ok {
+			list = append(list, w)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.Before(list[j].CreatedAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list, nil
+}
+
+// DeleteWebhook along with its deliveries
+func (r InMemory) DeleteWebhook(_ context.Context, id entity.ID) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[id]; err != nil {
+		return err
+	}
+	for _, d := range r.deliveries(filters.NewWebhookDeliveryFilter().WithWebhookID(id)) {
+		delete(r.entities, d.ID)
+	}
+	delete(r.entities, id)
+	return nil
+}
+
+// StoreWebhookDelivery mirrors the postgres constraints, the webhook must exist
+func (r InMemory) StoreWebhookDelivery(_ context.Context, d entity.WebhookDelivery) error {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	if err := r.entityErrs[d.ID]; err != nil {
+		return err
+	}
+	if _, ok := r.entities[d.WebhookID].(entity.Webhook); !ok {
+		return internal.MakeErr(internal.ErrEntityNotFound, "webhook["+d.WebhookID+"]")
+	}
+	r.entities[d.ID] = d
+	return nil
+}
+func (r InMemory) GetWebhookDelivery(_ context.Context, id entity.ID) (*entity.WebhookDelivery, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	if err := r.entityErrs[id]; err != nil {
+		return nil, err
+	}
+	d, ok := r.entities[id].(entity.WebhookDelivery)
+	if !ok {
+		return nil, internal.MakeErr(internal.ErrEntityNotFound, "webhook delivery["+id+"]")
+	}
+	return &d, nil
+}
+
+// ListWebhookDeliveries matching the filter, most recent first
+func (r InMemory) ListWebhookDeliveries(_ context.Context, filter ...filters.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	rwMutex.RLock()
+	defer rwMutex.RUnlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	f := filters.MergeWebhookDeliveryFilters(filter...)
+	return filters.Paginate(r.deliveries(f), f.Paging(), filters.DeliverySortValue)
+}
+
+// ClaimWebhookDeliveries pending deliveries due at now, oldest first
+func (r InMemory) ClaimWebhookDeliveries(_ context.Context, now, lockedUntil time.Time, limit int) ([]entity.WebhookDelivery, error) {
+	rwMutex.Lock()
+	defer rwMutex.Unlock()
+	for _, err := range r.entityErrs {
+		return nil, err
+	}
+	due := r.deliveries(filters.NewWebhookDeliveryFilter().WithStatus(entity.DeliveryPending))
+	list := make([]entity.WebhookDelivery, 0)
+	for _, d := range due {
+		if len(list) == limit {
+			break
+		}
+		if d.NextAttempt.After(now) {
+			continue
+		}
+		d.NextAttempt = lockedUntil
+		r.entities[d.ID] = d
+		list = append(list, d)
+	}
+	return list, nil
+}
+
+// deliveries matching the filter, oldest first
+func (r InMemory) deliveries(filter ...filters.WebhookDeliveryFilter) []entity.WebhookDelivery {
+	f := filters.MergeWebhookDeliveryFilters(filter...)
+	list := make([]entity.WebhookDelivery, 0)
+	for _, e := range r.entities {
+		if d, ok := e.(entity.WebhookDelivery); ok && f.Match(d) {
+			list = append(list, d)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.Before(list[j].CreatedAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list
+}
@@ -0,0 +1,52 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+)
+
+func testLateFeePolicies(t *testing.T, r lateFeeRepo) {
+	var (
+		property = fake.Property()
+		tenant   = fake.Tenant()
+		lease    = fake.Lease(property.ID, tenant.ID)
+
+		amount      = func(minor int) entity.Money { return entity.NewMoney(minor, lease.Currency()) }
+		propPolicy  = entity.NewLateFeePolicy().ForProperty(property.ID).WithGraceDays(5).WithFlatFee(amount(50))
+		leasePolicy = entity.NewLateFeePolicy().ForLease(lease.ID).WithRentPercent(500).WithDailyFee(amount(10), amount(100))
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	require.NoError(t, r.StoreTenant(ctx, tenant))
+	require.NoError(t, r.StoreLease(ctx, lease))
+	require.NoError(t, r.StoreLateFeePolicy(ctx, propPolicy))
+	require.NoError(t, r.StoreLateFeePolicy(ctx, leasePolicy))
+
+	// list by lease and property
+	list, err := r.ListLateFeePolicies(ctx, lease.ID, property.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assertEntityInSet(t, propPolicy.ID, list...)
+	assertEntityInSet(t, leasePolicy.ID, list...)
+
+	list, err = r.ListLateFeePolicies(ctx, property.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.True(t, propPolicy.Equal(list[0]))
+	assert.False(t, list[0].CreatedAt.IsZero())
+
+	list, err = r.ListLateFeePolicies(ctx, entity.NewID())
+	require.NoError(t, err)
+	assert.Len(t, list, 0)
+
+	// update
+	updated := propPolicy.WithFlatFee(amount(75))
+	require.NoError(t, r.StoreLateFeePolicy(ctx, updated))
+	list, err = r.ListLateFeePolicies(ctx, property.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.True(t, updated.Equal(list[0]))
+}
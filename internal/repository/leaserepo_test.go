@@ -0,0 +1,280 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/schedule"
+)
+
+func testLease(t *testing.T, r leaseRepo) {
+	var (
+		property1 = fake.Property()
+		property2 = fake.Property()
+		tenant1   = fake.Tenant()
+		tenant2   = fake.Tenant()
+	)
+	require.NoError(t, r.StoreProperty(ctx, property1))
+	require.NoError(t, r.StoreProperty(ctx, property2))
+	require.NoError(t, r.StoreTenant(ctx, tenant1))
+	require.NoError(t, r.StoreTenant(ctx, tenant2))
+
+	// store 1,2
+	in1 := fake.Lease(property1.ID, tenant1.ID, tenant2.ID)
+	in2 := fake.Lease(property2.ID, tenant2.ID)
+	require.NoError(t, r.StoreLease(ctx, in1))
+	require.NoError(t, r.StoreLease(ctx, in2))
+
+	// get 1
+	out1, err := r.GetLease(ctx, in1.GetID())
+	require.NoError(t, err)
+	require.NotNil(t, out1)
+	assert.True(t, out1.Equal(in1), "got %+v\nwant %+v", *out1, in1)
+
+	// get not exists
+	out3, err := r.GetLease(ctx, entity.NewID())
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	require.Nil(t, out3)
+
+	// list
+	list, err := r.ListLeases(ctx)
+	require.NoError(t, err)
+	assertEntityInSet(t, in1.GetID(), list...)
+	assertEntityInSet(t, in2.GetID(), list...)
+
+	// list by property
+	list, err = r.ListLeases(ctx, filters.NewLeaseFilter().WithPropertyID(property2.ID))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, in2.ID, list[0].ID)
+
+	// update, removing a tenant
+	in1b := in1.WithRent(in1.RentAmount.Mul(2))
+	in1b.TenantIDs = []entity.ID{tenant1.ID}
+	require.NoError(t, r.StoreLease(ctx, in1b))
+	out1b, err := r.GetLease(ctx, in1b.ID)
+	require.NoError(t, err)
+	require.NotNil(t, out1b)
+	assert.True(t, out1b.Equal(in1b), "got %+v\nwant %+v", *out1b, in1b)
+
+	// unknown property or tenant
+	for _, in := range []entity.Lease{
+		fake.Lease(entity.NewID(), tenant1.ID),
+		fake.Lease(property1.ID, entity.NewID()).WithTerm(in1.EndDate.Next(), schedule.Date{}),
+	} {
+		require.ErrorIs(t, r.StoreLease(ctx, in), internal.ErrEntityInvalid)
+		_, err = r.GetLease(ctx, in.ID)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	}
+}
+
+func testListLeasesPage(t *testing.T, r leaseRepo) {
+	var (
+		tenant = fake.Tenant()
+		day    = schedule.NewDate
+		terms  = [][2]schedule.Date{
+			{day(2020, 1, 1), day(2020, 12, 31)},
+			{day(2019, 1, 1), {}},
+			{day(2021, 1, 1), day(2021, 6, 30)},
+			{day(2018, 1, 1), day(2022, 1, 1)},
+		}
+		leases []entity.Lease
+	)
+	require.NoError(t, r.StoreTenant(ctx, tenant))
+	for _, term := range terms {
+		property := fake.Property()
+		require.NoError(t, r.StoreProperty(ctx, property))
+		in := fake.Lease(property.ID, tenant.ID).WithTerm(term[0], term[1])
+		require.NoError(t, r.StoreLease(ctx, in))
+		leases = append(leases, in)
+	}
+	var (
+		f    = filters.NewLeaseFilter().WithTenantID(tenant.ID)
+		list = func(p filters.Page) ([]entity.Lease, error) {
+			return r.ListLeases(ctx, f.WithPage(p))
+		}
+		// the sort of the page must be set for its cursor, the repo defaults to startDate
+		byStart = filters.NewPage().WithSort(filters.SortStartDate, false).WithSize(3)
+		byEnd   = filters.NewPage().WithSort(filters.SortEndDate, true).WithSize(3)
+	)
+
+	t.Run("start date", func(t *testing.T) {
+		got := readPages(t, byStart, filters.LeaseSortValue, list)
+		assert.Equal(t, []entity.ID{leases[3].ID, leases[1].ID, leases[0].ID, leases[2].ID}, entityIDs(got))
+	})
+	t.Run("end date desc", func(t *testing.T) {
+		got := readPages(t, byEnd, filters.LeaseSortValue, list)
+		assert.Equal(t, []entity.ID{leases[1].ID, leases[3].ID, leases[2].ID, leases[0].ID}, entityIDs(got))
+	})
+	t.Run("default", func(t *testing.T) {
+		got, err := r.ListLeases(ctx, f)
+		require.NoError(t, err)
+		assert.Equal(t, []entity.ID{leases[3].ID, leases[1].ID, leases[0].ID, leases[2].ID}, entityIDs(got))
+	})
+}
+
+// testLeaseOverlap is for repositories which enforce the lease term in storage
+func testLeaseOverlap(t *testing.T, r leaseRepo) {
+	var (
+		property = fake.Property()
+		tenant   = fake.Tenant()
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	require.NoError(t, r.StoreTenant(ctx, tenant))
+
+	lease1 := fake.Lease(property.ID, tenant.ID)
+	require.NoError(t, r.StoreLease(ctx, lease1))
+
+	// back to back is fine
+	start := lease1.EndDate.AddDate(0, 0, 1)
+	lease2 := fake.Lease(property.ID, tenant.ID).WithTerm(start, start.AddDate(1, 0, -1))
+	require.NoError(t, r.StoreLease(ctx, lease2))
+
+	// sharing the last day of lease2 is not
+	lease3 := fake.Lease(property.ID, tenant.ID).WithTerm(lease2.EndDate, lease2.EndDate.AddDate(1, 0, 0))
+	err := r.StoreLease(ctx, lease3)
+	require.ErrorIs(t, err, internal.ErrConflict)
+	_, err = r.GetLease(ctx, lease3.ID)
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+	// nor is a lease without an end date which starts before lease1 ends
+	var noEnd schedule.Date
+	lease4 := fake.Lease(property.ID, tenant.ID).WithTerm(lease1.EndDate, noEnd)
+	require.ErrorIs(t, r.StoreLease(ctx, lease4), internal.ErrConflict)
+}
+func testLeaseVersions(t *testing.T, r leaseRepo) {
+	var (
+		property = fake.Property()
+		tenant1  = fake.Tenant()
+		tenant2  = fake.Tenant()
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	require.NoError(t, r.StoreTenant(ctx, tenant1))
+	require.NoError(t, r.StoreTenant(ctx, tenant2))
+
+	lease := fake.Lease(property.ID, tenant1.ID)
+	v1 := entity.NewLeaseVersion(lease)
+	require.NoError(t, r.StoreLeaseVersion(ctx, v1))
+
+	// the version terms are stored as the lease
+	out, err := r.GetLease(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.True(t, out.Equal(lease), "got %+v\nwant %+v", *out, lease)
+
+	amended := lease.WithRent(lease.RentAmount.Mul(2)).WithTenant(tenant2.ID)
+	v2 := v1.Next(entity.LeaseAmended, lease.StartDate.AddDate(0, 1, 0), "roommate", amended)
+	require.NoError(t, r.StoreLeaseVersion(ctx, v2))
+
+	out, err = r.GetLease(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.True(t, out.Equal(amended), "got %+v\nwant %+v", *out, amended)
+
+	list, err := r.ListLeaseVersions(ctx, lease.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.True(t, list[0].Equal(v1), "got %+v\nwant %+v", list[0], v1)
+	assert.True(t, list[1].Equal(v2), "got %+v\nwant %+v", list[1], v2)
+	assert.False(t, list[1].CreatedAt.IsZero())
+
+	t.Run("version exists", func(t *testing.T) {
+		err := r.StoreLeaseVersion(ctx, v2)
+		require.ErrorIs(t, err, internal.ErrConflict)
+	})
+
+	// renewal is linked to the lease it renews
+	renewal, err := entity.NewLeaseRenewal(lease.EndDate.AddDate(1, 0, 0)).Apply(amended)
+	require.NoError(t, err)
+	require.NoError(t, r.StoreLeaseVersion(ctx, entity.NewLeaseVersion(renewal)))
+	renewals, err := r.ListLeases(ctx, filters.NewLeaseFilter().WithRenewsID(lease.ID))
+	require.NoError(t, err)
+	require.Len(t, renewals, 1)
+	assert.True(t, renewals[0].Equal(renewal), "got %+v\nwant %+v", renewals[0], renewal)
+
+	t.Run("renewed twice", func(t *testing.T) {
+		again, err := entity.NewLeaseRenewal(lease.EndDate.AddDate(2, 0, 0)).Apply(amended)
+		require.NoError(t, err)
+		again = again.WithTerm(renewal.EndDate.Next(), renewal.EndDate.AddDate(1, 0, 0))
+		err = r.StoreLeaseVersion(ctx, entity.NewLeaseVersion(again))
+		require.ErrorIs(t, err, internal.ErrConflict)
+		_, err = r.GetLease(ctx, again.ID)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("versions keep their own terms", func(t *testing.T) {
+		other := fake.Property()
+		require.NoError(t, r.StoreProperty(ctx, other))
+		moved := renewal
+		moved.PropertyID = other.ID
+		require.NoError(t, r.StoreLease(ctx, moved))
+		list, err := r.ListLeaseVersions(ctx, renewal.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, property.ID, list[0].Terms.PropertyID)
+		assert.Equal(t, lease.ID, list[0].Terms.RenewsID)
+	})
+}
+
+func testPurgeLeased(t *testing.T, r leaseRepo) {
+	var (
+		property = fake.Property()
+		tenant   = fake.Tenant()
+		lease    = fake.Lease(property.ID, tenant.ID)
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	require.NoError(t, r.StoreTenant(ctx, tenant))
+	require.NoError(t, r.StoreLease(ctx, lease))
+
+	list, err := r.ListLeases(ctx, filters.NewLeaseFilter().WithTenantID(tenant.ID))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, lease.ID, list[0].ID)
+
+	// removed but still referenced by the lease so neither is purged
+	require.NoError(t, r.DeleteProperty(ctx, property.ID))
+	require.NoError(t, r.DeleteTenant(ctx, tenant.ID))
+	_, err = r.PurgeProperties(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = r.PurgeTenants(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	require.NoError(t, r.RestoreProperty(ctx, property.ID))
+	require.NoError(t, r.RestoreTenant(ctx, tenant.ID))
+}
+func testListTenantsLeasing(t *testing.T, r leaseRepo) {
+	var (
+		property = fake.Property()
+		current  = fake.Tenant()
+		former   = fake.Tenant()
+		today    = schedule.Today()
+		start    = today.AddDate(-2, 0, 0)
+		lease1   = fake.Lease(property.ID, former.ID).WithTerm(start, start.AddDate(1, 0, -1))
+		lease2   = fake.Lease(property.ID, current.ID).WithTerm(start.AddDate(1, 0, 0), schedule.Date{})
+		leasing  = filters.NewTenantFilter().WithLeasingProperty(property.ID)
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	for _, in := range []entity.Tenant{current, former} {
+		require.NoError(t, r.StoreTenant(ctx, in))
+	}
+	require.NoError(t, r.StoreLease(ctx, lease1))
+	require.NoError(t, r.StoreLease(ctx, lease2))
+
+	list, err := r.ListTenants(ctx, leasing)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, current.ID, list[0].ID)
+
+	// on the last day of the first lease
+	list, err = r.ListTenants(ctx, leasing.WithLeasingOn(lease1.EndDate))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, former.ID, list[0].ID)
+
+	list, err = r.ListTenants(ctx, leasing.WithLeasingOn(start.AddDate(-1, 0, 0)))
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
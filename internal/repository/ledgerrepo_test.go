@@ -0,0 +1,67 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+)
+
+func testLedger(t *testing.T, r ledgerRepo) {
+	var (
+		property = fake.Property()
+		tenant   = fake.Tenant()
+		lease1   = fake.Lease(property.ID, tenant.ID)
+		lease2   = fake.Lease(fake.Property().ID, tenant.ID)
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	require.NoError(t, r.StoreProperty(ctx, fake.Property().WithID(lease2.PropertyID)))
+	require.NoError(t, r.StoreTenant(ctx, tenant))
+	require.NoError(t, r.StoreLease(ctx, lease1))
+	require.NoError(t, r.StoreLease(ctx, lease2))
+
+	var (
+		charge   = entity.NewLedgerEntry(lease1.ID, entity.EntryCharge, lease1.RentAmount, lease1.StartDate).WithRef("rent")
+		payment  = entity.NewLedgerEntry(lease1.ID, entity.EntryPayment, lease1.RentAmount, lease1.StartDate.Next()).WithMemo("check 1001")
+		reversal = payment.Reversal(lease1.StartDate.AddDate(0, 0, 5), "check bounced")
+		other    = entity.NewLedgerEntry(lease2.ID, entity.EntryCharge, lease2.RentAmount, lease2.StartDate)
+	)
+	for _, e := range []entity.LedgerEntry{charge, payment, reversal, other} {
+		require.NoError(t, r.AppendLedgerEntry(ctx, e))
+	}
+
+	// get
+	got, err := r.GetLedgerEntry(ctx, reversal.ID)
+	require.NoError(t, err)
+	assert.True(t, reversal.Equal(*got))
+	assert.False(t, got.CreatedAt.IsZero())
+
+	got, err = r.GetLedgerEntry(ctx, charge.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "rent", got.Ref)
+
+	got, err = r.GetLedgerEntry(ctx, payment.ID)
+	require.NoError(t, err)
+	assert.True(t, payment.Equal(*got))
+	assert.Empty(t, got.ReversesID)
+
+	_, err = r.GetLedgerEntry(ctx, entity.NewID())
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+	// list only lease1 entries
+	list, err := r.ListLedgerEntries(ctx, lease1.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	assertEntityInSet(t, charge.ID, list...)
+	assertEntityInSet(t, payment.ID, list...)
+	assertEntityInSet(t, reversal.ID, list...)
+
+	// append only
+	err = r.AppendLedgerEntry(ctx, payment.WithMemo("edited"))
+	require.ErrorIs(t, err, internal.ErrConflict)
+	err = r.AppendLedgerEntry(ctx, payment.Reversal(lease1.StartDate.AddDate(0, 0, 6), "twice"))
+	require.ErrorIs(t, err, internal.ErrConflict)
+}
@@ -0,0 +1,124 @@
+package repository_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+func testListing(t *testing.T, r listingRepo) {
+	var (
+		property = fake.Property()
+		other    = fake.Property()
+		usd      = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		listing  = fake.Listing(property.ID).WithDetails(entity.RentalDetails{AllowPets: true, ParkingSpaces: 2})
+		draft    = fake.Listing(other.ID).WithDetails(entity.RentalDetails{AllowPets: false})
+		byCity   = filters.NewListingFilter().WithCity(property.City)
+	)
+	other.City = property.City
+	listing.Rent, draft.Rent = usd(150000), usd(90000)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	require.NoError(t, r.StoreProperty(ctx, other))
+
+	_, err := r.GetListing(ctx, property.ID)
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+	require.NoError(t, r.StoreListing(ctx, listing))
+	got, err := r.GetListing(ctx, property.ID)
+	require.NoError(t, err)
+	assert.True(t, listing.Equal(*got))
+	assert.Equal(t, entity.ListingDraft, got.Status)
+	assert.True(t, got.PublishedAt.IsZero())
+
+	// a property has one listing
+	assert.ErrorIs(t, r.StoreListing(ctx, fake.Listing(property.ID)), internal.ErrConflict)
+
+	// photos are replaced and published at is kept
+	publishedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	listing.Photos = nil
+	listing = listing.WithPhoto(entity.ListingPhoto{URL: "https://example.com/2.jpg", Caption: "kitchen"})
+	listing, err = listing.Publish(publishedAt)
+	require.NoError(t, err)
+	require.NoError(t, r.StoreListing(ctx, listing))
+	require.NoError(t, r.StoreListing(ctx, draft))
+	got, err = r.GetListing(ctx, property.ID)
+	require.NoError(t, err)
+	assert.Equal(t, listing.Photos, got.Photos)
+	assert.Equal(t, entity.ListingPublished, got.Status)
+	assert.True(t, publishedAt.Equal(got.PublishedAt))
+
+	tests := map[string]struct {
+		filter filters.ListingFilter
+		want   []entity.ID
+	}{
+		"city":          {byCity, []entity.ID{listing.ID, draft.ID}},
+		"city any case": {filters.NewListingFilter().WithCity(" " + property.City + " "), []entity.ID{listing.ID, draft.ID}},
+		"published":     {byCity.WithStatus(entity.ListingPublished), []entity.ID{listing.ID}},
+		"drafts":        {byCity.WithStatus(entity.ListingDraft), []entity.ID{draft.ID}},
+		"pets":          {byCity.WithPetsAllowed(), []entity.ID{listing.ID}},
+		"rent range":    {byCity.WithRentRange(usd(100000), usd(150000)), []entity.ID{listing.ID}},
+		"min rent":      {byCity.WithRentRange(usd(90000), entity.Money{}), []entity.ID{listing.ID, draft.ID}},
+		"max rent":      {byCity.WithRentRange(entity.Money{}, usd(149999)), []entity.ID{draft.ID}},
+		"currency":      {byCity.WithRentRange(entity.NewMoney(1, "EUR"), entity.Money{}), []entity.ID{}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			list, err := r.ListListings(ctx, tc.filter)
+			require.NoError(t, err)
+			ids := make([]entity.ID, 0, len(list))
+			for _, l := range list {
+				ids = append(ids, l.ID)
+			}
+			assert.Equal(t, tc.want, ids, "published first then drafts")
+		})
+	}
+}
+func testListListingsPage(t *testing.T, r listingRepo) {
+	var (
+		city        = "page " + entity.NewID()
+		publishedAt = time.Now().Add(-time.Hour).Truncate(time.Second)
+		published   []entity.Listing
+		drafts      []entity.Listing
+	)
+	for i := 0; i < 4; i++ {
+		p := fake.Property()
+		p.City = city
+		require.NoError(t, r.StoreProperty(ctx, p))
+		l := fake.Listing(p.ID)
+		if i < 2 {
+			var err error
+			l, err = l.Publish(publishedAt.Add(time.Duration(i) * time.Minute))
+			require.NoError(t, err)
+			published = append(published, l)
+		} else {
+			drafts = append(drafts, l)
+		}
+		require.NoError(t, r.StoreListing(ctx, l))
+	}
+	sort.Slice(drafts, func(i, j int) bool { return drafts[i].ID < drafts[j].ID })
+	var (
+		f    = filters.NewListingFilter().WithCity(city)
+		list = func(p filters.Page) ([]entity.Listing, error) {
+			return r.ListListings(ctx, f.WithPage(p))
+		}
+		// drafts were never published so they are the oldest
+		oldestFirst = []entity.ID{drafts[0].ID, drafts[1].ID, published[0].ID, published[1].ID}
+		newestFirst = []entity.ID{published[1].ID, published[0].ID, drafts[1].ID, drafts[0].ID}
+	)
+	got := readPages(t, filters.NewPage().WithSort(filters.SortPublishedAt, false).WithSize(2), filters.ListingSortValue, list)
+	assert.Equal(t, oldestFirst, entityIDs(got))
+	got = readPages(t, filters.NewPage().WithSort(filters.SortPublishedAt, true).WithSize(2), filters.ListingSortValue, list)
+	assert.Equal(t, newestFirst, entityIDs(got))
+
+	// most recently published first when no sort is asked for
+	got, err := r.ListListings(ctx, f)
+	require.NoError(t, err)
+	assert.Equal(t, newestFirst, entityIDs(got))
+}
@@ -0,0 +1,108 @@
+package repository_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+func testOutbox(t *testing.T, r outboxRepo) {
+	var (
+		property = fake.Property()
+		gone     = fake.Property()
+		added    = event.RentalAdded{PropertyID: property.ID}
+		listed   = event.RentalListed{PropertyID: property.ID, ListingID: entity.NewID()}
+		removed  = event.RentalRemoved{PropertyID: gone.ID}
+	)
+	require.NoError(t, r.StoreProperty(event.Stage(ctx, added), property))
+	require.NoError(t, r.StoreProperty(ctx, gone))
+	require.NoError(t, r.StoreListing(ctx, fake.Listing(property.ID)))
+
+	// events of a write which failed are not stored
+	err := r.StoreListing(event.Stage(ctx, listed), fake.Listing(property.ID))
+	require.ErrorIs(t, err, internal.ErrConflict)
+
+	require.NoError(t, r.StoreProperty(ctx, property.WithZip("75402")))
+	require.NoError(t, r.DeleteProperty(event.Stage(ctx, removed), gone.ID))
+
+	list := outboxOf(t, r, property.ID, gone.ID)
+	require.Len(t, list, 2)
+	assert.Equal(t, event.NameRentalAdded, list[0].Name)
+	assert.Equal(t, event.NameRentalRemoved, list[1].Name)
+	for _, m := range list {
+		assert.Equal(t, event.MessagePending, m.Status)
+		assert.Zero(t, m.Attempts)
+	}
+
+	// claimed messages are not due again until they are unlocked
+	now := time.Now()
+	claimed, err := r.ClaimOutbox(ctx, now, now.Add(time.Minute), 1000)
+	require.NoError(t, err)
+	assertEntityInSet(t, list[0].ID, claimed...)
+	assertEntityInSet(t, list[1].ID, claimed...)
+	claimed, err = r.ClaimOutbox(ctx, now, now.Add(time.Minute), 1000)
+	require.NoError(t, err)
+	for _, m := range claimed {
+		assert.NotContains(t, []string{list[0].ID, list[1].ID}, m.ID)
+	}
+
+	delivered := list[0].Delivered(now)
+	failed := list[1].Failed(assert.AnError, now.Add(time.Hour))
+	require.NoError(t, r.StoreOutboxMessage(ctx, delivered))
+	require.NoError(t, r.StoreOutboxMessage(ctx, failed))
+
+	got, err := r.GetOutboxMessage(ctx, delivered.ID)
+	require.NoError(t, err)
+	assert.Equal(t, event.MessageDelivered, got.Status)
+	assert.Equal(t, 1, got.Attempts)
+	assert.WithinDuration(t, now, got.DeliveredAt, time.Second)
+	e, err := got.Event()
+	require.NoError(t, err)
+	assert.Equal(t, added, e)
+
+	got, err = r.GetOutboxMessage(ctx, failed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, event.MessagePending, got.Status)
+	assert.Equal(t, assert.AnError.Error(), got.LastError)
+	assert.WithinDuration(t, now.Add(time.Hour), got.NextAttempt, time.Second)
+
+	byStatus, err := r.ListOutbox(ctx, filters.NewOutboxFilter().
+		WithStatus(event.MessageDelivered).
+		WithName(event.NameRentalAdded))
+	require.NoError(t, err)
+	assertEntityInSet(t, delivered.ID, byStatus...)
+	for _, m := range byStatus {
+		assert.Equal(t, event.MessageDelivered, m.Status)
+		assert.Equal(t, event.NameRentalAdded, m.Name)
+	}
+
+	_, err = r.GetOutboxMessage(ctx, uuid.NewString())
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound)
+	err = r.StoreOutboxMessage(ctx, event.Message{ID: uuid.NewString()})
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound)
+}
+
+// outboxOf lists the messages of events about any of the properties, oldest first
+func outboxOf(t *testing.T, r outboxRepo, propertyIDs ...entity.ID) []event.Message {
+	t.Helper()
+	all, err := r.ListOutbox(ctx)
+	require.NoError(t, err)
+	var list []event.Message
+	for _, m := range all {
+		for _, id := range propertyIDs {
+			if strings.Contains(string(m.Payload), id) {
+				list = append(list, m)
+			}
+		}
+	}
+	return list
+}
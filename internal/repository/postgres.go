@@ -3,12 +3,16 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"strings"
 
 	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/internal"
 	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/lib/crypt"
+	"github.com/tempcke/rpm/internal/search"
 	"github.com/tempcke/rpm/usecase"
 )
 
@@ -16,6 +20,7 @@ import (
 type Postgres struct {
 	db    *sql.DB
 	clock clockwork.Clock
+	pii   *crypt.Envelope
 }
 
 // NewPostgresRepo constructs a Postgres repository
@@ -32,11 +37,12 @@ func (r Postgres) NewProperty(street, city, state, zip string) entity.Property {
 func (r Postgres) StoreProperty(ctx context.Context, property entity.Property) error {
 	const query = `
 		INSERT INTO properties (
-			id, street, city, state, zip, created_at
-		) VALUES ($1, $2, $3, $4, $5, $6)
+			id, street, city, state, zip, created_at, search_text, unit, country, address_key
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 
 		ON CONFLICT (id) DO UPDATE SET
-			street=$2, city=$3, state=$4, zip=$5`
+			street=$2, city=$3, state=$4, zip=$5, search_text=$7, unit=$8, country=$9, address_key=$10
+		WHERE properties.deleted_at IS NULL`
 
 	qArgs := []any{
 		property.ID,
@@ -45,31 +51,46 @@ func (r Postgres) StoreProperty(ctx context.Context, property entity.Property) e
 		property.StateCode,
 		property.Zip,
 		property.CreatedAt,
+		search.Normalize(property.String()),
+		property.Unit,
+		property.Country,
+		property.Address.Key(),
 	}
 
-	stmt, err := r.db.PrepareContext(ctx, query)
+	tx, err := r.db.Begin()
 	if err != nil {
 		return err
 	}
-	defer func() { _ = stmt.Close() }()
-
-	_, err = stmt.ExecContext(ctx, qArgs...)
+	defer func() { _ = tx.Rollback() }()
 
+	res, err := tx.ExecContext(ctx, query, qArgs...)
 	if err != nil {
+		if isUniqueViolation(err) {
+			return r.duplicateAddress(ctx, property.Address.Key(), property.ID)
+		}
 		return err
 	}
-	return nil
+	if err := notRemoved(res, "property["+property.ID+"]"); err != nil {
+		return err
+	}
+	if err := r.storeDefaultUnit(ctx, tx, property); err != nil {
+		return err
+	}
+	if err := r.stageOutbox(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 func (r Postgres) GetProperty(ctx context.Context, id string) (entity.Property, error) {
 	const query = `
-		SELECT id, street, city, state, zip, created_at
-		FROM properties WHERE id = $1;`
+		SELECT id, street, unit, city, state, zip, country, created_at
+		FROM properties WHERE id = $1 AND deleted_at IS NULL;`
 
 	var (
 		p        = entity.Property{}
 		scanArgs = []any{
-			&p.ID, &p.Street, &p.City,
-			&p.StateCode, &p.Zip, &p.CreatedAt,
+			&p.ID, &p.Street, &p.Unit, &p.City,
+			&p.StateCode, &p.Zip, &p.Country, &p.CreatedAt,
 		}
 	)
 	err := r.db.QueryRowContext(ctx, query, id).Scan(scanArgs...)
@@ -86,21 +107,31 @@ func (r Postgres) GetProperty(ctx context.Context, id string) (entity.Property,
 }
 func (r Postgres) PropertyList(ctx context.Context, f usecase.PropertyFilter) ([]entity.Property, error) {
 	var (
-		search   = ""
+		q        = search.Parse(f.Search)
 		propList = make([]entity.Property, 0)
 	)
 
-	if s := f.Search; s != "" {
-		search = "%" + removeChars(s, ".", ",") + "%"
+	page, err := newPageQuery(f.Paging(), propertySortColumns, "p.id", 4)
+	if err != nil {
+		return propList, err
 	}
 
-	const query = `
-		SELECT id, street, city, state, zip, created_at
+	// an address matches when its search_vector has every term, addresses
+	// similar to the search are only listed when no address matches it
+	query := `
+		SELECT id, street, unit, city, state, zip, country, created_at, deleted_at, ` + propertyRank + `
 		FROM properties p
-		WHERE $1 = '' OR TRANSLATE(CONCAT(p.street, ' ', p.city, ' ', p.state, ' ', p.zip), '.', '') ILIKE $1
-	`
+		WHERE ($2 OR p.deleted_at IS NULL)
+		  AND ($1 = '' OR p.search_vector @@ TO_TSQUERY('simple', $1) OR (
+		      $3 <% p.search_text AND NOT EXISTS (
+		          SELECT 1 FROM properties m
+		          WHERE m.search_vector @@ TO_TSQUERY('simple', $1)
+		            AND ($2 OR m.deleted_at IS NULL))))
+		  AND ` + page.after + `
+		` + page.orderBy + `;`
 
-	rows, err := r.db.QueryContext(ctx, query, search)
+	qArgs := append([]any{q.TSQuery(), f.IncludeDeleted, q.Text}, page.args...)
+	rows, err := r.db.QueryContext(ctx, query, qArgs...)
 
 	if err != nil {
 		return propList, err
@@ -108,34 +139,70 @@ func (r Postgres) PropertyList(ctx context.Context, f usecase.PropertyFilter) ([
 	defer func() { _ = rows.Close() }()
 
 	for rows.Next() {
-		p := entity.Property{}
+		var (
+			p         = entity.Property{}
+			deletedAt sql.NullTime
+		)
 
 		err = rows.Scan(
-			&p.ID, &p.Street, &p.City,
-			&p.StateCode, &p.Zip, &p.CreatedAt,
+			&p.ID, &p.Street, &p.Unit, &p.City,
+			&p.StateCode, &p.Zip, &p.Country, &p.CreatedAt, &deletedAt, &p.Rank,
 		)
 		if err != nil {
 			return propList, err
 		}
 
 		p.CreatedAt = p.CreatedAt.Local()
+		if deletedAt.Valid {
+			p.DeletedAt = deletedAt.Time.Local()
+		}
 		propList = append(propList, p)
 	}
 
 	return propList, nil
 }
 func (r Postgres) DeleteProperty(ctx context.Context, id string) error {
-	query := "DELETE FROM properties WHERE id = $1"
-	stmt, err := r.db.PrepareContext(ctx, query)
+	query := "UPDATE properties SET deleted_at = $2 WHERE id = $1 AND deleted_at IS NULL"
+	tx, err := r.db.Begin()
 	if err != nil {
 		return err
 	}
-	defer func() { _ = stmt.Close() }()
+	defer func() { _ = tx.Rollback() }()
 
-	_, err = stmt.ExecContext(ctx, id)
+	if _, err := tx.ExecContext(ctx, query, id, r.clock.Now()); err != nil {
+		return err
+	}
+	if err := r.stageOutbox(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+func (r Postgres) RestoreProperty(ctx context.Context, id string) error {
+	query := "UPDATE properties SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL"
+	err := r.restore(ctx, query, id)
+	if isUniqueViolation(err) {
+		var key string
+		if err := r.db.QueryRowContext(ctx, "SELECT address_key FROM properties WHERE id = $1", id).Scan(&key); err != nil {
+			return err
+		}
+		return r.duplicateAddress(ctx, key, id)
+	}
 	return err
 }
 
+// duplicateAddress is the usecase.DuplicateAddressError for the property
+// which was not removed and has the address key, other than the property id
+func (r Postgres) duplicateAddress(ctx context.Context, key string, id entity.ID) error {
+	const query = `
+		SELECT id FROM properties
+		WHERE address_key = $1 AND id <> $2 AND deleted_at IS NULL;`
+	var dup entity.ID
+	if err := r.db.QueryRowContext(ctx, query, key, id).Scan(&dup); err != nil {
+		return err
+	}
+	return usecase.DuplicateAddressError{PropertyID: dup}
+}
+
 func (r Postgres) StoreTenant(ctx context.Context, tenant entity.Tenant) error {
 	tx, err := r.db.Begin()
 	if err != nil {
@@ -146,6 +213,9 @@ func (r Postgres) StoreTenant(ctx context.Context, tenant entity.Tenant) error {
 	if err := r.storeTenant(ctx, tx, tenant); err != nil {
 		return err
 	}
+	if err := r.stageOutbox(ctx, tx); err != nil {
+		return err
+	}
 	if err := tx.Commit(); err != nil {
 		return err
 	}
@@ -153,11 +223,12 @@ func (r Postgres) StoreTenant(ctx context.Context, tenant entity.Tenant) error {
 }
 func (r Postgres) GetTenant(ctx context.Context, id entity.ID) (*entity.Tenant, error) {
 	const query = `
-		SELECT id, full_name, dl_num, dl_state, dob
-		FROM tenants WHERE id=$1;`
+		SELECT id, full_name, dl_num, dl_state, dob, dob_enc, created_at
+		FROM tenants WHERE id=$1 AND deleted_at IS NULL;`
 	var (
 		tenant   = entity.Tenant{}
-		scanArgs = []any{&tenant.ID, &tenant.FullName, &tenant.DLNum, &tenant.DLState, &tenant.DateOfBirth}
+		dobEnc   string
+		scanArgs = []any{&tenant.ID, &tenant.FullName, &tenant.DLNum, &tenant.DLState, &tenant.DateOfBirth, &dobEnc, &tenant.CreatedAt}
 	)
 	if err := r.db.QueryRowContext(ctx, query, id).Scan(scanArgs...); err != nil {
 		if strings.Contains(err.Error(), "no rows") {
@@ -165,6 +236,10 @@ func (r Postgres) GetTenant(ctx context.Context, id entity.ID) (*entity.Tenant,
 		}
 		return nil, err
 	}
+	tenant.CreatedAt = tenant.CreatedAt.Local()
+	if err := r.openTenantPII(&tenant, dobEnc); err != nil {
+		return nil, err
+	}
 	phones, err := r.getTenantPhones(ctx, id)
 	if err != nil {
 		return nil, err
@@ -173,9 +248,58 @@ func (r Postgres) GetTenant(ctx context.Context, id entity.ID) (*entity.Tenant,
 	return &tenant, nil
 }
 func (r Postgres) ListTenants(ctx context.Context, filter ...filters.TenantFilter) ([]entity.Tenant, error) {
-	const query = `SELECT id, full_name, dl_num, dl_state, dob FROM tenants;`
-	var tenants []entity.Tenant
-	rows, err := r.db.QueryContext(ctx, query)
+	var (
+		f       = filters.MergeTenantFilters(filter...)
+		tenants []entity.Tenant
+	)
+	for {
+		list, err := r.listTenants(ctx, f)
+		if err != nil {
+			return tenants, err
+		}
+		// the date of birth is encrypted so it can only be filtered once opened,
+		// pages are read until one is filled or the list ends
+		for _, tenant := range list {
+			if f.MatchDateOfBirth(tenant.DateOfBirth) && (f.Page.Size == 0 || len(tenants) < f.Page.Size) {
+				tenants = append(tenants, tenant)
+			}
+		}
+		if f.Page.Size == 0 || len(tenants) == f.Page.Size || len(list) < f.Page.Size {
+			return tenants, nil
+		}
+		f.Page.Cursor = filters.CursorOf(list[len(list)-1], f.Page, filters.TenantSortValue).String()
+	}
+}
+func (r Postgres) listTenants(ctx context.Context, f filters.TenantFilter) ([]entity.Tenant, error) {
+	page, err := newPageQuery(f.Page, tenantSortColumns, "t.id", 8)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		SELECT id, full_name, dl_num, dl_state, dob, dob_enc, created_at, deleted_at
+		FROM tenants t
+		WHERE (COALESCE(CARDINALITY($1::VARCHAR[]), 0) = 0 OR id = ANY($1::VARCHAR[]))
+		  AND ($2 OR deleted_at IS NULL)
+		  AND ($3 = '' OR POSITION(LOWER($3) IN LOWER(full_name)) > 0)
+		  AND ($4 = '' OR EXISTS (
+			SELECT 1 FROM tenant_phones p
+			WHERE p.tenant_id = t.id AND REGEXP_REPLACE(p.phone_num, '[^0-9]', '', 'g') = $4))
+		  AND ($5 = '' OR UPPER(dl_state) = UPPER($5))
+		  AND ($6 = '' OR EXISTS (
+			SELECT 1 FROM leases l JOIN lease_tenants lt ON lt.lease_id = l.id
+			WHERE l.property_id = $6 AND lt.tenant_id = t.id
+			  AND DATERANGE(l.start_date, l.end_date, '[]') @> $7::DATE))
+		  AND ` + page.after + `
+		` + page.orderBy + `;`
+	var (
+		tenants []entity.Tenant
+		qArgs   = append([]any{
+			pq.Array(f.IDs), f.IncludeDeleted,
+			f.Name, f.PhoneDigits(), f.DLState,
+			f.LeasingPropertyID, f.LeasingDate(),
+		}, page.args...)
+	)
+	rows, err := r.db.QueryContext(ctx, query, qArgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -183,35 +307,103 @@ func (r Postgres) ListTenants(ctx context.Context, filter ...filters.TenantFilte
 
 	for rows.Next() {
 		var (
-			tenant   entity.Tenant
-			scanArgs = []any{
+			tenant    entity.Tenant
+			dobEnc    string
+			deletedAt sql.NullTime
+			scanArgs  = []any{
 				&tenant.ID, &tenant.FullName,
 				&tenant.DLNum, &tenant.DLState,
-				&tenant.DateOfBirth,
+				&tenant.DateOfBirth, &dobEnc,
+				&tenant.CreatedAt, &deletedAt,
 			}
 		)
 		if err := rows.Scan(scanArgs...); err != nil {
 			return tenants, err
 		}
+		tenant.CreatedAt = tenant.CreatedAt.Local()
+		if deletedAt.Valid {
+			tenant.DeletedAt = deletedAt.Time.Local()
+		}
+		if err := r.openTenantPII(&tenant, dobEnc); err != nil {
+			return tenants, err
+		}
 		tenants = append(tenants, tenant)
 	}
 
 	return tenants, nil
 }
+func (r Postgres) DeleteTenant(ctx context.Context, id entity.ID) error {
+	query := "UPDATE tenants SET deleted_at = $2 WHERE id = $1 AND deleted_at IS NULL"
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, query, id, r.clock.Now()); err != nil {
+		return err
+	}
+	if err := r.stageOutbox(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+func (r Postgres) RestoreTenant(ctx context.Context, id entity.ID) error {
+	query := "UPDATE tenants SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL"
+	return r.restore(ctx, query, id)
+}
+
+// restore runs the query which clears deleted_at of the row with the id, no
+// row being updated means there was no removed row to restore
+func (r Postgres) restore(ctx context.Context, query string, id entity.ID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return internal.ErrEntityNotFound
+	}
+	if err := r.stageOutbox(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
 func (r Postgres) storeTenant(ctx context.Context, tx *sql.Tx, tenant entity.Tenant) error {
 	const query = `
-			INSERT INTO tenants (id, full_name, dl_num, dl_state, dob, created_at)
-			VALUES ($1, $2, $3, $4, $5, $6)
-			ON CONFLICT (id) DO UPDATE SET full_name=$2, dl_num=$3, dl_state=$4, dob=$5, updated_at=$6;`
+			INSERT INTO tenants (id, full_name, dl_num, dl_state, dob, dob_enc, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (id) DO UPDATE SET full_name=$2, dl_num=$3, dl_state=$4, dob=$5, dob_enc=$6, updated_at=$7
+			WHERE tenants.deleted_at IS NULL;`
+	dlNum, err := r.sealPII(tenant.DLNum)
+	if err != nil {
+		return err
+	}
+	dob, dobEnc, err := r.sealDOB(tenant.DateOfBirth)
+	if err != nil {
+		return err
+	}
 	qArgs := []any{
 		tenant.ID,
 		tenant.FullName,
-		tenant.DLNum,
+		dlNum,
 		tenant.DLState,
-		tenant.DateOfBirth,
+		dob,
+		dobEnc,
 		r.clock.Now(),
 	}
-	if _, err := tx.ExecContext(ctx, query, qArgs...); err != nil {
+	res, err := tx.ExecContext(ctx, query, qArgs...)
+	if err != nil {
+		return err
+	}
+	if err := notRemoved(res, "tenant["+tenant.ID+"]"); err != nil {
 		return err
 	}
 	return r.storeTenantPhones(ctx, tx, tenant)
@@ -261,9 +453,173 @@ func (r Postgres) getTenantPhones(ctx context.Context, tenantID string) ([]entit
 	return phones, nil
 }
 
-func removeChars(s string, chars ...string) string {
-	for _, char := range chars {
-		s = strings.ReplaceAll(s, char, "")
+func (r Postgres) StoreLease(ctx context.Context, lease entity.Lease) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := r.storeLease(ctx, tx, lease); err != nil {
+		return err
+	}
+	if err := r.stageOutbox(ctx, tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+func (r Postgres) GetLease(ctx context.Context, id entity.ID) (*entity.Lease, error) {
+	list, err := r.ListLeases(ctx, filters.LeaseFilter{IDs: []entity.ID{id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, internal.ErrEntityNotFound
+	}
+	return &list[0], nil
+}
+func (r Postgres) ListLeases(ctx context.Context, filter ...filters.LeaseFilter) ([]entity.Lease, error) {
+	f := filters.MergeLeaseFilters(filter...)
+	page, err := newPageQuery(f.Paging(), leaseSortColumns, "l.id", 6)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		SELECT l.id, l.property_id, COALESCE(l.unit_id, ''), l.start_date, l.end_date,
+			l.deposit_minor, l.rent_minor, l.currency, l.rent_interval,
+			COALESCE(l.renews_id, ''), ARRAY_REMOVE(ARRAY_AGG(lt.tenant_id), NULL)
+		FROM leases l
+		LEFT JOIN lease_tenants lt ON lt.lease_id = l.id
+		WHERE ($1 = '' OR l.property_id = $1)
+		  AND (COALESCE(CARDINALITY($2::VARCHAR[]), 0) = 0 OR l.id = ANY($2::VARCHAR[]))
+		  AND ($3 = '' OR l.renews_id = $3)
+		  AND ($4 = '' OR EXISTS (
+			SELECT 1 FROM lease_tenants x WHERE x.lease_id = l.id AND x.tenant_id = $4))
+		  AND ($5 = '' OR l.unit_id = $5)
+		  AND ` + page.after + `
+		GROUP BY l.id
+		` + page.orderBy + `;`
+	var (
+		leases = make([]entity.Lease, 0)
+		qArgs  = append([]any{f.PropertyID, pq.Array(f.IDs), f.RenewsID, f.TenantID, f.UnitID}, page.args...)
+	)
+	rows, err := r.db.QueryContext(ctx, query, qArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var (
+			lease     entity.Lease
+			currency  string
+			tenantIDs []string
+			scanArgs  = []any{
+				&lease.ID, &lease.PropertyID, &lease.UnitID, &lease.StartDate, &lease.EndDate,
+				&lease.Deposit.Minor, &lease.RentAmount.Minor, &currency, &lease.RentInterval,
+				&lease.RenewsID, pq.Array(&tenantIDs),
+			}
+		)
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, err
+		}
+		lease = lease.WithCurrency(currency)
+		lease.TenantIDs = tenantIDs
+		leases = append(leases, lease)
+	}
+	return leases, rows.Err()
+}
+func (r Postgres) storeLease(ctx context.Context, tx *sql.Tx, lease entity.Lease) error {
+	const query = `
+		INSERT INTO leases (
+			id, property_id, start_date, end_date,
+			deposit_minor, rent_minor, currency, rent_interval, renews_id, created_at, unit_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), $10, NULLIF($11, ''))
+		ON CONFLICT (id) DO UPDATE SET
+			property_id=$2, start_date=$3, end_date=$4,
+			deposit_minor=$5, rent_minor=$6, currency=$7, rent_interval=$8,
+			renews_id=NULLIF($9, ''), updated_at=$10, unit_id=NULLIF($11, '');`
+	qArgs := []any{
+		lease.ID,
+		lease.PropertyID,
+		lease.StartDate,
+		lease.EndDate,
+		lease.Deposit.Minor,
+		lease.RentAmount.Minor,
+		lease.Currency(),
+		lease.RentInterval,
+		lease.RenewsID,
+		r.clock.Now(),
+		lease.UnitID,
+	}
+	if _, err := tx.ExecContext(ctx, query, qArgs...); err != nil {
+		if isExclusionViolation(err) || isUniqueViolation(err) {
+			return internal.MakeErr(internal.ErrConflict, err.Error())
+		}
+		if isForeignKeyViolation(err) {
+			return internal.MakeErr(internal.ErrEntityInvalid, "lease["+lease.ID+"] property or unit does not exist")
+		}
+		return err
+	}
+	return r.storeLeaseTenants(ctx, tx, lease)
+}
+func (r Postgres) storeLeaseTenants(ctx context.Context, tx *sql.Tx, lease entity.Lease) error {
+	const (
+		delQuery = `DELETE FROM lease_tenants WHERE lease_id=$1;`
+		insQuery = `INSERT INTO lease_tenants (lease_id, tenant_id) VALUES ($1, $2);`
+	)
+	if _, err := tx.ExecContext(ctx, delQuery, lease.ID); err != nil {
+		return err
+	}
+	if len(lease.TenantIDs) == 0 {
+		return nil
+	}
+	stmt, err := tx.PrepareContext(ctx, insQuery)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stmt.Close() }()
+	for _, tenantID := range lease.TenantIDs {
+		if _, err := stmt.ExecContext(ctx, lease.ID, tenantID); err != nil {
+			if isForeignKeyViolation(err) {
+				return internal.MakeErr(internal.ErrEntityInvalid, "lease["+lease.ID+"] tenant["+tenantID+"] does not exist")
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// notRemoved fails with internal.ErrConflict when an upsert which skips removed
+// rows stored nothing, a removed row is only brought back by restoring it
+func notRemoved(res sql.Result, name string) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return internal.MakeErr(internal.ErrConflict, name+" was removed")
 	}
-	return s
+	return nil
+}
+
+// isExclusionViolation is true when an EXCLUDE constraint rejected the row
+func isExclusionViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == "exclusion_violation"
+}
+
+// isUniqueViolation is true when a primary key or unique constraint rejected the row
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation"
+}
+
+// isForeignKeyViolation is true when the row references one which does not exist
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation"
 }
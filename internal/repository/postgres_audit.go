@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// ListAudit entries matching the filter, oldest first
+func (r Postgres) ListAudit(ctx context.Context, filter ...filters.AuditFilter) ([]audit.Entry, error) {
+	f := filters.MergeAuditFilters(filter...)
+	page, err := newPageQuery(f.Page, createdSortColumns, "id", 4)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		SELECT id, actor, transport, entity_type, entity_id, action, diff, created_at
+		FROM audit_log
+		WHERE ($1 = '' OR entity_type = $1)
+		  AND ($2 = '' OR entity_id = $2)
+		  AND ($3 = '' OR actor = $3)
+		  AND ` + page.after + `
+		` + page.orderBy + `;`
+	qArgs := append([]any{f.EntityType, f.EntityID, f.Actor}, page.args...)
+	rows, err := r.db.QueryContext(ctx, query, qArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]audit.Entry, 0)
+	for rows.Next() {
+		var (
+			e    audit.Entry
+			diff []byte
+		)
+		if err := rows.Scan(
+			&e.ID, &e.Actor, &e.Transport, &e.EntityType, &e.EntityID, &e.Action, &diff, &e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		e.Diff = diff
+		list = append(list, e)
+	}
+	return list, rows.Err()
+}
+
+// stageAudit records the changes staged in the context in the audit log as
+// part of the transaction making them
+func (r Postgres) stageAudit(ctx context.Context, tx *sql.Tx) error {
+	const query = `
+		INSERT INTO audit_log (id, actor, transport, entity_type, entity_id, action, diff, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8);`
+	for _, c := range audit.Staged(ctx) {
+		e, err := audit.NewEntry(ctx, c, r.clock.Now())
+		if err != nil {
+			return err
+		}
+		qArgs := []any{e.ID, e.Actor, e.Transport, e.EntityType, e.EntityID, e.Action, []byte(e.Diff), e.CreatedAt}
+		if _, err := tx.ExecContext(ctx, query, qArgs...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+)
+
+func (r Postgres) AddDepositReceipt(ctx context.Context, e entity.DepositReceipt) error {
+	const query = `
+		INSERT INTO deposit_receipts (
+			id, lease_id, amount_minor, currency, received_on, memo, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7);`
+	qArgs := []any{
+		e.ID,
+		e.LeaseID,
+		e.Amount.Minor,
+		e.Amount.Currency,
+		e.Date,
+		e.Memo,
+		r.clock.Now(),
+	}
+	if _, err := r.db.ExecContext(ctx, query, qArgs...); err != nil {
+		if isUniqueViolation(err) {
+			return internal.MakeErr(internal.ErrConflict, err.Error())
+		}
+		return err
+	}
+	return nil
+}
+func (r Postgres) ListDepositReceipts(ctx context.Context, leaseID entity.ID) ([]entity.DepositReceipt, error) {
+	const query = `
+		SELECT id, lease_id, amount_minor, currency, received_on, memo, created_at
+		FROM deposit_receipts
+		WHERE lease_id = $1
+		ORDER BY received_on, created_at, id;`
+	rows, err := r.db.QueryContext(ctx, query, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]entity.DepositReceipt, 0)
+	for rows.Next() {
+		var e entity.DepositReceipt
+		if err := rows.Scan(
+			&e.ID, &e.LeaseID, &e.Amount.Minor, &e.Amount.Currency, &e.Date, &e.Memo, &e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, e)
+	}
+	return list, rows.Err()
+}
+func (r Postgres) StoreDepositDisposition(ctx context.Context, d entity.DepositDisposition) error {
+	const (
+		query = `
+			INSERT INTO deposit_dispositions (
+				id, lease_id, move_out_date, held_minor, refund_minor, owed_minor, currency, created_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8);`
+		deductionQuery = `
+			INSERT INTO deposit_deductions (
+				disposition_id, position, category, amount_minor, description
+			) VALUES ($1, $2, $3, $4, $5);`
+	)
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qArgs := []any{
+		d.ID,
+		d.LeaseID,
+		d.MoveOutDate,
+		d.Held.Minor,
+		d.Refund.Minor,
+		d.Owed.Minor,
+		d.Held.Currency,
+		r.clock.Now(),
+	}
+	if _, err := tx.ExecContext(ctx, query, qArgs...); err != nil {
+		if isUniqueViolation(err) {
+			return internal.MakeErr(internal.ErrConflict, err.Error())
+		}
+		return err
+	}
+	for i, ded := range d.Deductions {
+		if _, err := tx.ExecContext(ctx, deductionQuery,
+			d.ID, i, ded.Category, ded.Amount.Minor, ded.Description,
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+func (r Postgres) GetDepositDisposition(ctx context.Context, leaseID entity.ID) (*entity.DepositDisposition, error) {
+	const (
+		query = `
+			SELECT id, lease_id, move_out_date, held_minor, refund_minor, owed_minor, currency, created_at
+			FROM deposit_dispositions WHERE lease_id = $1;`
+		deductionQuery = `
+			SELECT category, amount_minor, description
+			FROM deposit_deductions
+			WHERE disposition_id = $1
+			ORDER BY position;`
+	)
+	var (
+		d        entity.DepositDisposition
+		currency string
+	)
+	row := r.db.QueryRowContext(ctx, query, leaseID)
+	if err := row.Scan(
+		&d.ID, &d.LeaseID, &d.MoveOutDate, &d.Held.Minor, &d.Refund.Minor, &d.Owed.Minor, &currency, &d.CreatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internal.MakeErr(internal.ErrEntityNotFound, "deposit disposition for lease["+leaseID+"]")
+		}
+		return nil, err
+	}
+	d.Held.Currency, d.Refund.Currency, d.Owed.Currency = currency, currency, currency
+
+	rows, err := r.db.QueryContext(ctx, deductionQuery, d.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		ded := entity.Deduction{Amount: entity.Money{Currency: currency}}
+		if err := rows.Scan(&ded.Category, &ded.Amount.Minor, &ded.Description); err != nil {
+			return nil, err
+		}
+		d.Deductions = append(d.Deductions, ded)
+	}
+	return &d, rows.Err()
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/tempcke/rpm/entity"
+)
+
+func (r Postgres) StoreLateFeePolicy(ctx context.Context, p entity.LateFeePolicy) error {
+	const query = `
+		INSERT INTO late_fee_policies (
+			id, property_id, lease_id, grace_days, flat_fee, rent_percent,
+			daily_fee, max_fee, currency, created_at
+		) VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), $4, $5, $6, $7, $8, $9, $10)
+
+		ON CONFLICT (id) DO UPDATE SET
+			grace_days=$4, flat_fee=$5, rent_percent=$6, daily_fee=$7, max_fee=$8,
+			currency=$9`
+	qArgs := []any{
+		p.ID,
+		p.PropertyID,
+		p.LeaseID,
+		p.GraceDays,
+		p.FlatFee.Minor,
+		p.RentPercent,
+		p.DailyFee.Minor,
+		p.MaxFee.Minor,
+		p.Currency(),
+		r.clock.Now(),
+	}
+	_, err := r.db.ExecContext(ctx, query, qArgs...)
+	return err
+}
+func (r Postgres) ListLateFeePolicies(ctx context.Context, scopeIDs ...entity.ID) ([]entity.LateFeePolicy, error) {
+	const query = `
+		SELECT id, COALESCE(property_id, ''), COALESCE(lease_id, ''), grace_days,
+			flat_fee, rent_percent, daily_fee, max_fee, currency, created_at
+		FROM late_fee_policies
+		WHERE property_id = ANY($1::VARCHAR[]) OR lease_id = ANY($1::VARCHAR[])
+		ORDER BY id;`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(scopeIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]entity.LateFeePolicy, 0)
+	for rows.Next() {
+		var (
+			p                         entity.LateFeePolicy
+			flatFee, dailyFee, maxFee int
+			currency                  string
+		)
+		if err := rows.Scan(
+			&p.ID, &p.PropertyID, &p.LeaseID, &p.GraceDays,
+			&flatFee, &p.RentPercent, &dailyFee, &maxFee, &currency, &p.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if currency != "" {
+			p.FlatFee = entity.NewMoney(flatFee, currency)
+			p.DailyFee = entity.NewMoney(dailyFee, currency)
+			p.MaxFee = entity.NewMoney(maxFee, currency)
+		}
+		list = append(list, p)
+	}
+	return list, rows.Err()
+}
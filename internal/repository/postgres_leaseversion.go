@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+)
+
+// StoreLeaseVersion stores the terms of the version as the lease and appends
+// the version in one transaction, so the lease is always its latest version
+func (r Postgres) StoreLeaseVersion(ctx context.Context, v entity.LeaseVersion) error {
+	const query = `
+		INSERT INTO lease_versions (
+			lease_id, version, change, effective_date, reason, start_date, end_date,
+			deposit_minor, rent_minor, currency, rent_interval, tenant_ids, created_at,
+			property_id, unit_id, renews_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NULLIF($15, ''), NULLIF($16, ''));`
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := r.storeLease(ctx, tx, v.Terms); err != nil {
+		return err
+	}
+	tenantIDs := v.Terms.TenantIDs
+	if tenantIDs == nil {
+		tenantIDs = []entity.ID{}
+	}
+	qArgs := []any{
+		v.LeaseID,
+		v.Version,
+		v.Change,
+		v.Effective,
+		v.Reason,
+		v.Terms.StartDate,
+		v.Terms.EndDate,
+		v.Terms.Deposit.Minor,
+		v.Terms.RentAmount.Minor,
+		v.Terms.Currency(),
+		v.Terms.RentInterval,
+		pq.Array(tenantIDs),
+		r.clock.Now(),
+		v.Terms.PropertyID,
+		v.Terms.UnitID,
+		v.Terms.RenewsID,
+	}
+	if _, err := tx.ExecContext(ctx, query, qArgs...); err != nil {
+		if isUniqueViolation(err) {
+			return internal.MakeErr(internal.ErrConflict, err.Error())
+		}
+		return err
+	}
+	if err := r.stageOutbox(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ListLeaseVersions of one lease ordered by version, each with its terms as
+// they were stored with the version
+func (r Postgres) ListLeaseVersions(ctx context.Context, leaseID entity.ID) ([]entity.LeaseVersion, error) {
+	const query = `
+		SELECT lease_id, version, change, effective_date, reason,
+			property_id, COALESCE(unit_id, ''), COALESCE(renews_id, ''), start_date, end_date,
+			deposit_minor, rent_minor, currency, rent_interval, tenant_ids, created_at
+		FROM lease_versions
+		WHERE lease_id = $1
+		ORDER BY version;`
+	rows, err := r.db.QueryContext(ctx, query, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]entity.LeaseVersion, 0)
+	for rows.Next() {
+		var (
+			v         entity.LeaseVersion
+			currency  string
+			tenantIDs []string
+			scanArgs  = []any{
+				&v.LeaseID, &v.Version, &v.Change, &v.Effective, &v.Reason,
+				&v.Terms.PropertyID, &v.Terms.UnitID, &v.Terms.RenewsID, &v.Terms.StartDate, &v.Terms.EndDate,
+				&v.Terms.Deposit.Minor, &v.Terms.RentAmount.Minor, &currency, &v.Terms.RentInterval,
+				pq.Array(&tenantIDs), &v.CreatedAt,
+			}
+		)
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, err
+		}
+		v.Terms.ID = v.LeaseID
+		v.Terms = v.Terms.WithCurrency(currency)
+		v.Terms.TenantIDs = tenantIDs
+		list = append(list, v)
+	}
+	return list, rows.Err()
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+)
+
+const ledgerEntryColumns = `id, lease_id, entry_type, amount, currency, entry_date, memo,
+	COALESCE(reverses_id, ''), ref, created_at`
+
+func (r Postgres) AppendLedgerEntry(ctx context.Context, e entity.LedgerEntry) error {
+	const query = `
+		INSERT INTO ledger_entries (
+			id, lease_id, entry_type, amount, currency, entry_date, memo, reverses_id, ref, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), $9, $10);`
+	qArgs := []any{
+		e.ID,
+		e.LeaseID,
+		e.Type,
+		e.Amount.Minor,
+		e.Amount.Currency,
+		e.Date,
+		e.Memo,
+		e.ReversesID,
+		e.Ref,
+		r.clock.Now(),
+	}
+	if _, err := r.db.ExecContext(ctx, query, qArgs...); err != nil {
+		if isUniqueViolation(err) {
+			return internal.MakeErr(internal.ErrConflict, err.Error())
+		}
+		return err
+	}
+	return nil
+}
+func (r Postgres) GetLedgerEntry(ctx context.Context, id entity.ID) (*entity.LedgerEntry, error) {
+	const query = `SELECT ` + ledgerEntryColumns + ` FROM ledger_entries WHERE id = $1;`
+	var e entity.LedgerEntry
+	row := r.db.QueryRowContext(ctx, query, id)
+	if err := row.Scan(ledgerEntryScanArgs(&e)...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internal.MakeErr(internal.ErrEntityNotFound, "ledger entry["+id+"]")
+		}
+		return nil, err
+	}
+	return &e, nil
+}
+func (r Postgres) ListLedgerEntries(ctx context.Context, leaseID entity.ID) ([]entity.LedgerEntry, error) {
+	const query = `
+		SELECT ` + ledgerEntryColumns + `
+		FROM ledger_entries
+		WHERE lease_id = $1
+		ORDER BY entry_date, created_at, id;`
+	rows, err := r.db.QueryContext(ctx, query, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]entity.LedgerEntry, 0)
+	for rows.Next() {
+		var e entity.LedgerEntry
+		if err := rows.Scan(ledgerEntryScanArgs(&e)...); err != nil {
+			return nil, err
+		}
+		list = append(list, e)
+	}
+	return list, rows.Err()
+}
+func ledgerEntryScanArgs(e *entity.LedgerEntry) []any {
+	return []any{
+		&e.ID, &e.LeaseID, &e.Type, &e.Amount.Minor, &e.Amount.Currency, &e.Date, &e.Memo,
+		&e.ReversesID, &e.Ref, &e.CreatedAt,
+	}
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+const listingColumns = `
+	l.id, l.property_id, l.rent_minor, l.currency, l.available_from, l.description,
+	l.allow_smoking, l.allow_pets, l.parking_spaces, l.parking_desc,
+	l.status, l.published_at, l.updated_at`
+
+// StoreListing inserts or replaces the listing along with all of its photos
+func (r Postgres) StoreListing(ctx context.Context, l entity.Listing) error {
+	const (
+		query = `
+			INSERT INTO listings (
+				id, property_id, rent_minor, currency, available_from, description,
+				allow_smoking, allow_pets, parking_spaces, parking_desc,
+				status, published_at, created_at, updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $13)
+			ON CONFLICT (id) DO UPDATE SET
+				rent_minor=$3, currency=$4, available_from=$5, description=$6,
+				allow_smoking=$7, allow_pets=$8, parking_spaces=$9, parking_desc=$10,
+				status=$11, published_at=$12, updated_at=$13;`
+		delPhotosQuery = `DELETE FROM listing_photos WHERE listing_id=$1;`
+		photoQuery     = `
+			INSERT INTO listing_photos (listing_id, position, url, caption, width, height)
+			VALUES ($1, $2, $3, $4, $5, $6);`
+	)
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	publishedAt := sql.NullTime{Time: l.PublishedAt, Valid: !l.PublishedAt.IsZero()}
+	qArgs := []any{
+		l.ID, l.PropertyID, l.Rent.Minor, l.Rent.Currency, l.AvailableFrom, l.Description,
+		l.Details.AllowSmoking, l.Details.AllowPets, l.Details.ParkingSpaces, l.Details.ParkingDesc,
+		l.Status, publishedAt, r.clock.Now(),
+	}
+	if _, err := tx.ExecContext(ctx, query, qArgs...); err != nil {
+		if isUniqueViolation(err) {
+			return internal.MakeErr(internal.ErrConflict, err.Error())
+		}
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, delPhotosQuery, l.ID); err != nil {
+		return err
+	}
+	for i, p := range l.Photos {
+		if _, err := tx.ExecContext(ctx, photoQuery, l.ID, i, p.URL, p.Caption, p.Width, p.Height); err != nil {
+			return err
+		}
+	}
+	if err := r.stageOutbox(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetListing of the property, internal.ErrEntityNotFound when it has none
+func (r Postgres) GetListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	const query = `SELECT ` + listingColumns + ` FROM listings l WHERE l.property_id=$1;`
+	l, err := scanListing(r.db.QueryRowContext(ctx, query, propertyID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internal.MakeErr(internal.ErrEntityNotFound, "listing for property["+propertyID+"]")
+		}
+		return nil, err
+	}
+	if l.Photos, err = r.listingPhotos(ctx, l.ID); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// ListListings matching the filter, most recently published first then drafts
+func (r Postgres) ListListings(ctx context.Context, filter ...filters.ListingFilter) ([]entity.Listing, error) {
+	f := filters.MergeListingFilters(filter...)
+	page, err := newPageQuery(f.Paging(), listingSortColumns, "l.id", 8)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		SELECT ` + listingColumns + `
+		FROM listings l
+		JOIN properties p ON p.id = l.property_id
+		WHERE p.deleted_at IS NULL
+		  AND ($1 = '' OR l.status = $1)
+		  AND ($2 = '' OR LOWER(TRIM(p.city)) = LOWER(TRIM($2)))
+		  AND ($3 = '' OR (l.currency = $3 AND l.rent_minor >= $4))
+		  AND ($5 = '' OR (l.currency = $5 AND l.rent_minor <= $6))
+		  AND (NOT $7 OR l.allow_pets)
+		  AND ` + page.after + `
+		` + page.orderBy + `;`
+	var (
+		list  = make([]entity.Listing, 0)
+		qArgs = append([]any{
+			f.Status, f.City,
+			f.MinRent.Currency, f.MinRent.Minor,
+			f.MaxRent.Currency, f.MaxRent.Minor,
+			f.PetsAllowed,
+		}, page.args...)
+	)
+	rows, err := r.db.QueryContext(ctx, query, qArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		l, err := scanListing(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for i := range list {
+		if list[i].Photos, err = r.listingPhotos(ctx, list[i].ID); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func (r Postgres) listingPhotos(ctx context.Context, listingID entity.ID) ([]entity.ListingPhoto, error) {
+	const query = `
+		SELECT url, caption, width, height
+		FROM listing_photos
+		WHERE listing_id=$1
+		ORDER BY position;`
+	rows, err := r.db.QueryContext(ctx, query, listingID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var photos []entity.ListingPhoto
+	for rows.Next() {
+		var p entity.ListingPhoto
+		if err := rows.Scan(&p.URL, &p.Caption, &p.Width, &p.Height); err != nil {
+			return nil, err
+		}
+		photos = append(photos, p)
+	}
+	return photos, rows.Err()
+}
+
+func scanListing(row interface{ Scan(...any) error }) (entity.Listing, error) {
+	var (
+		l           entity.Listing
+		publishedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&l.ID, &l.PropertyID, &l.Rent.Minor, &l.Rent.Currency, &l.AvailableFrom, &l.Description,
+		&l.Details.AllowSmoking, &l.Details.AllowPets, &l.Details.ParkingSpaces, &l.Details.ParkingDesc,
+		&l.Status, &publishedAt, &l.UpdatedAt,
+	); err != nil {
+		return l, err
+	}
+	l.Details.PropertyID = l.PropertyID
+	if publishedAt.Valid {
+		l.PublishedAt = publishedAt.Time
+	}
+	return l, nil
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+const outboxColumns = `
+	id, name, payload, status, attempts, last_error,
+	created_at, next_attempt_at, delivered_at`
+
+// ClaimOutbox pending messages due at now, oldest first, messages another
+// relay is claiming at the same time are skipped rather than waited on
+func (r Postgres) ClaimOutbox(ctx context.Context, now, lockedUntil time.Time, limit int) ([]event.Message, error) {
+	const query = `
+		UPDATE outbox SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE status = $3 AND next_attempt_at <= $1
+			ORDER BY created_at, id
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + outboxColumns + `;`
+	list, err := r.queryOutbox(ctx, query, now, lockedUntil, event.MessagePending, limit)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.Before(list[j].CreatedAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list, nil
+}
+
+// StoreOutboxMessage updates the delivery state of a message in the outbox
+func (r Postgres) StoreOutboxMessage(ctx context.Context, m event.Message) error {
+	const query = `
+		UPDATE outbox SET
+			status=$2, attempts=$3, last_error=$4, next_attempt_at=$5, delivered_at=$6
+		WHERE id=$1;`
+	deliveredAt := sql.NullTime{Time: m.DeliveredAt, Valid: !m.DeliveredAt.IsZero()}
+	res, err := r.db.ExecContext(ctx, query, m.ID, m.Status, m.Attempts, m.LastError, m.NextAttempt, deliveredAt)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return internal.MakeErr(internal.ErrEntityNotFound, "outbox message["+m.ID+"]")
+	}
+	return nil
+}
+func (r Postgres) GetOutboxMessage(ctx context.Context, id string) (*event.Message, error) {
+	const query = `SELECT ` + outboxColumns + ` FROM outbox WHERE id=$1;`
+	m, err := scanOutboxMessage(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internal.MakeErr(internal.ErrEntityNotFound, "outbox message["+id+"]")
+		}
+		return nil, err
+	}
+	return &m, nil
+}
+
+// ListOutbox messages matching the filter, oldest first
+func (r Postgres) ListOutbox(ctx context.Context, filter ...filters.OutboxFilter) ([]event.Message, error) {
+	f := filters.MergeOutboxFilters(filter...)
+	page, err := newPageQuery(f.Page, createdSortColumns, "id", 3)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		SELECT ` + outboxColumns + `
+		FROM outbox
+		WHERE ($1 = '' OR status = $1)
+		  AND ($2 = '' OR name = $2)
+		  AND ` + page.after + `
+		` + page.orderBy + `;`
+	return r.queryOutbox(ctx, query, append([]any{f.Status, f.Name}, page.args...)...)
+}
+func (r Postgres) queryOutbox(ctx context.Context, query string, qArgs ...any) ([]event.Message, error) {
+	rows, err := r.db.QueryContext(ctx, query, qArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]event.Message, 0)
+	for rows.Next() {
+		m, err := scanOutboxMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, rows.Err()
+}
+
+// stageOutbox inserts the events staged in the context into the outbox as
+// part of the transaction storing the change they describe, along with the
+// entries of the changes staged for the audit log
+func (r Postgres) stageOutbox(ctx context.Context, tx *sql.Tx) error {
+	const query = `
+		INSERT INTO outbox (id, name, payload, status, created_at, next_attempt_at)
+		VALUES ($1, $2, $3, $4, $5, $5);`
+	for _, e := range event.Staged(ctx) {
+		m, err := event.NewMessage(e, r.clock.Now())
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query, m.ID, m.Name, []byte(m.Payload), m.Status, m.CreatedAt); err != nil {
+			return err
+		}
+	}
+	return r.stageAudit(ctx, tx)
+}
+
+func scanOutboxMessage(row interface{ Scan(...any) error }) (event.Message, error) {
+	var (
+		m           event.Message
+		payload     []byte
+		deliveredAt sql.NullTime
+	)
+	if err := row.Scan(
+		&m.ID, &m.Name, &payload, &m.Status, &m.Attempts, &m.LastError,
+		&m.CreatedAt, &m.NextAttempt, &deliveredAt,
+	); err != nil {
+		return m, err
+	}
+	m.Payload = payload
+	if deliveredAt.Valid {
+		m.DeliveredAt = deliveredAt.Time
+	}
+	return m, nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// sortColumn is what a query orders by for a filters.SortField, text is
+// compared in the "C" collation so the order is the one of Go strings
+type sortColumn struct {
+	expr   string
+	isTime bool
+}
+
+// propertyRank is the rank of a property in PropertyList, whose $3 is the
+// normalized search, it is rounded like search.Query.Rank
+const propertyRank = `ROUND(WORD_SIMILARITY($3, p.search_text)::NUMERIC, 4)`
+
+var (
+	propertySortColumns = map[filters.SortField]sortColumn{
+		filters.SortCreatedAt: {expr: `p.created_at`, isTime: true},
+		filters.SortCity:      {expr: `LOWER(p.city) COLLATE "C"`},
+		filters.SortZip:       {expr: `p.zip COLLATE "C"`},
+		filters.SortRank:      {expr: propertyRank},
+	}
+	tenantSortColumns = map[filters.SortField]sortColumn{
+		filters.SortCreatedAt: {expr: `t.created_at`, isTime: true},
+		filters.SortName:      {expr: `LOWER(t.full_name) COLLATE "C"`},
+	}
+	leaseSortColumns = map[filters.SortField]sortColumn{
+		filters.SortStartDate: {expr: `l.start_date`},
+		filters.SortEndDate:   {expr: `COALESCE(l.end_date, DATE '9999-12-31')`},
+	}
+	listingSortColumns = map[filters.SortField]sortColumn{
+		filters.SortPublishedAt: {expr: `COALESCE(l.published_at, TIMESTAMPTZ '0001-01-01 00:00:00+00')`, isTime: true},
+	}
+	// createdSortColumns are the ones of the lists in filters.CreatedSorts,
+	// their queries select from a single table without an alias
+	createdSortColumns = map[filters.SortField]sortColumn{
+		filters.SortCreatedAt: {expr: `created_at`, isTime: true},
+	}
+)
+
+// pageQuery is the part of a list query which selects a filters.Page, after
+// is a condition which is TRUE without a cursor, its placeholders start at
+// the number given to newPageQuery and args are their values
+type pageQuery struct {
+	after   string
+	orderBy string
+	args    []any
+}
+
+func newPageQuery(p filters.Page, columns map[filters.SortField]sortColumn, idColumn string, n int) (pageQuery, error) {
+	col, ok := columns[p.SortBy()]
+	if !ok {
+		return pageQuery{}, internal.MakeErr(internal.ErrBadRequest, "sort by "+string(p.SortBy()))
+	}
+	cursor, err := p.After()
+	if err != nil {
+		return pageQuery{}, err
+	}
+	var (
+		q       = pageQuery{after: "TRUE"}
+		id      = idColumn + ` COLLATE "C"`
+		dir, op = "ASC", ">"
+	)
+	if p.Desc {
+		dir, op = "DESC", "<"
+	}
+	if cursor.ID != "" {
+		var value any = cursor.Value
+		if col.isTime {
+			if value, err = cursor.Time(); err != nil {
+				return pageQuery{}, internal.MakeErr(internal.ErrBadRequest, "cursor is malformed")
+			}
+		}
+		q.after = fmt.Sprintf("(%s, %s) %s ($%d, $%d)", col.expr, id, op, n, n+1)
+		q.args = []any{value, cursor.ID}
+	}
+	q.orderBy = fmt.Sprintf("ORDER BY %s %s, %s %s", col.expr, dir, id, dir)
+	if p.Size > 0 {
+		q.orderBy += fmt.Sprintf(" LIMIT %d", p.Size)
+	}
+	return q, nil
+}
@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal/lib/crypt"
+	"github.com/tempcke/schedule"
+)
+
+var ErrNoPIIEnvelope = errors.New("personal information is encrypted but no encryption keys are configured")
+
+// piiFields are the personal information columns of a tenant or applicant row
+// as they are stored, tenants have no ssn
+type piiFields struct {
+	ssn    string
+	dlNum  string
+	dob    schedule.Date
+	dobEnc string
+}
+
+// WithPIIEnvelope encrypts the ssn, drivers license number and date of birth
+// of tenants and applicants before they are written and decrypts them when
+// read, without it values are stored as plaintext
+func (r Postgres) WithPIIEnvelope(e crypt.Envelope) Postgres {
+	r.pii = &e
+	return r
+}
+
+// sealPII encrypts the value when the repo has an envelope
+func (r Postgres) sealPII(value string) (string, error) {
+	if r.pii == nil {
+		return value, nil
+	}
+	return r.pii.Seal(value)
+}
+
+// openPII decrypts a sealed value, plaintext is returned as is
+func (r Postgres) openPII(value string) (string, error) {
+	if !crypt.IsSealed(value) {
+		return value, nil
+	}
+	if r.pii == nil {
+		return "", ErrNoPIIEnvelope
+	}
+	return r.pii.Open(value)
+}
+
+// sealDOB returns the values for the dob and dob_enc columns
+// once sealed the dob column is left NULL
+func (r Postgres) sealDOB(dob schedule.Date) (schedule.Date, string, error) {
+	if r.pii == nil || dob.IsZero() {
+		return dob, "", nil
+	}
+	sealed, err := r.pii.Seal(dob.String())
+	return schedule.Date{}, sealed, err
+}
+func (r Postgres) openDOB(dob schedule.Date, dobEnc string) (schedule.Date, error) {
+	if dobEnc == "" {
+		return dob, nil
+	}
+	s, err := r.openPII(dobEnc)
+	if err != nil {
+		return schedule.Date{}, err
+	}
+	d := schedule.ParseDate(s)
+	if d == nil {
+		return schedule.Date{}, schedule.ErrInvalidDateString
+	}
+	return *d, nil
+}
+
+// openTenantPII decrypts the tenant fields which were sealed when stored
+func (r Postgres) openTenantPII(t *entity.Tenant, dobEnc string) error {
+	var err error
+	if t.DLNum, err = r.openPII(t.DLNum); err != nil {
+		return err
+	}
+	t.DateOfBirth, err = r.openDOB(t.DateOfBirth, dobEnc)
+	return err
+}
+
+// openApplicantPII decrypts the applicant fields which were sealed when stored
+func (r Postgres) openApplicantPII(ap *entity.Applicant, dobEnc string) error {
+	var err error
+	if ap.SSN, err = r.openPII(ap.SSN); err != nil {
+		return err
+	}
+	if ap.DLNum, err = r.openPII(ap.DLNum); err != nil {
+		return err
+	}
+	ap.DateOfBirth, err = r.openDOB(ap.DateOfBirth, dobEnc)
+	return err
+}
+
+// ReencryptPII seals every tenant and applicant value which is still
+// plaintext or was sealed with an older key with the current key and returns
+// how many rows were rewritten
+// after a new key is added run this, once it is done the older keys are no
+// longer needed
+func (r Postgres) ReencryptPII(ctx context.Context) (int, error) {
+	if r.pii == nil {
+		return 0, ErrNoPIIEnvelope
+	}
+	tenants, err := r.reencryptTenants(ctx)
+	if err != nil {
+		return tenants, err
+	}
+	applicants, err := r.reencryptApplicants(ctx)
+	return tenants + applicants, err
+}
+func (r Postgres) reencryptTenants(ctx context.Context) (int, error) {
+	const (
+		query       = `SELECT id, dl_num, dob, dob_enc FROM tenants FOR UPDATE;`
+		updateQuery = `UPDATE tenants SET dl_num=$2, dob=$3, dob_enc=$4 WHERE id=$1;`
+	)
+	type row struct {
+		id string
+		piiFields
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	var list []row
+	for rows.Next() {
+		var t row
+		if err := rows.Scan(&t.id, &t.dlNum, &t.dob, &t.dobEnc); err != nil {
+			_ = rows.Close()
+			return 0, err
+		}
+		list = append(list, t)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, t := range list {
+		changed, err := r.rotatePII(&t.piiFields)
+		if err != nil {
+			return 0, err
+		}
+		if !changed {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, updateQuery, t.id, t.dlNum, t.dob, t.dobEnc); err != nil {
+			return 0, err
+		}
+		n++
+	}
+	return n, tx.Commit()
+}
+func (r Postgres) reencryptApplicants(ctx context.Context) (int, error) {
+	const (
+		query = `
+			SELECT application_id, position, ssn, dl_num, dob, dob_enc
+			FROM rental_applicants FOR UPDATE;`
+		updateQuery = `
+			UPDATE rental_applicants SET ssn=$3, dl_num=$4, dob=$5, dob_enc=$6
+			WHERE application_id=$1 AND position=$2;`
+	)
+	type row struct {
+		applicationID string
+		position      int
+		piiFields
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	var list []row
+	for rows.Next() {
+		var ap row
+		if err := rows.Scan(&ap.applicationID, &ap.position, &ap.ssn, &ap.dlNum, &ap.dob, &ap.dobEnc); err != nil {
+			_ = rows.Close()
+			return 0, err
+		}
+		list = append(list, ap)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, ap := range list {
+		changed, err := r.rotatePII(&ap.piiFields)
+		if err != nil {
+			return 0, err
+		}
+		if !changed {
+			continue
+		}
+		qArgs := []any{ap.applicationID, ap.position, ap.ssn, ap.dlNum, ap.dob, ap.dobEnc}
+		if _, err := tx.ExecContext(ctx, updateQuery, qArgs...); err != nil {
+			return 0, err
+		}
+		n++
+	}
+	return n, tx.Commit()
+}
+
+// rotatePII seals every field which is plaintext or sealed with an older key
+// with the current key, it is true when any field changed
+func (r Postgres) rotatePII(f *piiFields) (bool, error) {
+	var changed bool
+	rotate := func(value *string) error {
+		needed, err := r.pii.NeedsRotation(*value)
+		if err != nil || !needed {
+			return err
+		}
+		plaintext, err := r.openPII(*value)
+		if err != nil {
+			return err
+		}
+		if *value, err = r.pii.Seal(plaintext); err != nil {
+			return err
+		}
+		changed = true
+		return nil
+	}
+	if err := rotate(&f.ssn); err != nil {
+		return false, err
+	}
+	if err := rotate(&f.dlNum); err != nil {
+		return false, err
+	}
+	if f.dobEnc == "" && !f.dob.IsZero() {
+		f.dobEnc = f.dob.String()
+		f.dob = schedule.Date{}
+	}
+	if err := rotate(&f.dobEnc); err != nil {
+		return false, err
+	}
+	return changed, nil
+}
+
+// sealApplicantPII returns the applicant fields as they are to be stored
+func (r Postgres) sealApplicantPII(ap entity.Applicant) (piiFields, error) {
+	var (
+		f   piiFields
+		err error
+	)
+	if f.ssn, err = r.sealPII(ap.SSN); err != nil {
+		return f, err
+	}
+	if f.dlNum, err = r.sealPII(ap.DLNum); err != nil {
+		return f, err
+	}
+	f.dob, f.dobEnc, err = r.sealDOB(ap.DateOfBirth)
+	return f, err
+}
@@ -0,0 +1,107 @@
+//go:build withDocker
+// +build withDocker
+
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal/lib/crypt"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/internal/test"
+)
+
+func TestPII_Postgres(t *testing.T) {
+	var (
+		db       = test.DB(t)
+		devKeys  = test.PIIKeys(t)
+		plain    = repository.NewPostgresRepo(db)
+		r        = postgresRepo(t)
+		tenant   = fake.Tenant()
+		legacy   = fake.Tenant()
+		property = fake.Property()
+		ap       = fake.Applicant()
+	)
+	devKey, err := devKeys.CurrentKey()
+	require.NoError(t, err)
+	newKey, err := crypt.GenerateKey("test-" + uuid.NewString())
+	require.NoError(t, err)
+	rotated := plain.WithPIIEnvelope(crypt.NewEnvelope(rotatedKeys{current: newKey, KeyProvider: devKeys}))
+
+	tenantKeyIDs := func(id string) []string {
+		const query = `SELECT dl_num, dob_enc FROM tenants WHERE id=$1;`
+		var dlNum, dobEnc string
+		require.NoError(t, db.QueryRowContext(ctx, query, id).Scan(&dlNum, &dobEnc))
+		return []string{crypt.KeyID(dlNum), crypt.KeyID(dobEnc)}
+	}
+
+	ap.SSN = "123-45-6789"
+	app := fake.RentalApplication(property.ID).WithApplicant(ap)
+	require.NoError(t, r.StoreProperty(ctx, property))
+
+	// stored sealed
+	require.NoError(t, r.StoreTenant(ctx, tenant))
+	require.NoError(t, r.StoreRentalApplication(ctx, app))
+	assert.Equal(t, []string{devKey.ID, devKey.ID}, tenantKeyIDs(tenant.ID))
+
+	out, err := r.GetTenant(ctx, tenant.ID)
+	require.NoError(t, err)
+	assert.True(t, tenant.Equal(*out))
+	assert.Equal(t, tenant.DLNum, out.DLNum)
+	gotApp, err := r.GetRentalApplication(ctx, app.ID)
+	require.NoError(t, err)
+	assert.Equal(t, app.Applicants, gotApp.Applicants)
+
+	_, err = plain.GetTenant(ctx, tenant.ID)
+	require.ErrorIs(t, err, repository.ErrNoPIIEnvelope)
+
+	// stored before encryption was turned on
+	require.NoError(t, plain.StoreTenant(ctx, legacy))
+	assert.Equal(t, []string{"", ""}, tenantKeyIDs(legacy.ID))
+	out, err = r.GetTenant(ctx, legacy.ID)
+	require.NoError(t, err)
+	assert.True(t, legacy.Equal(*out))
+
+	// rotate to a new key, then back to the dev key so the rest of the
+	// tests and the app can still read every row
+	t.Cleanup(func() {
+		_, err := r.ReencryptPII(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{devKey.ID, devKey.ID}, tenantKeyIDs(legacy.ID))
+	})
+	n, err := rotated.ReencryptPII(ctx)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, n, 3)
+	for _, id := range []string{tenant.ID, legacy.ID} {
+		assert.Equal(t, []string{newKey.ID, newKey.ID}, tenantKeyIDs(id))
+	}
+	out, err = rotated.GetTenant(ctx, legacy.ID)
+	require.NoError(t, err)
+	assert.True(t, legacy.Equal(*out))
+	gotApp, err = rotated.GetRentalApplication(ctx, app.ID)
+	require.NoError(t, err)
+	assert.Equal(t, app.Applicants, gotApp.Applicants)
+
+	n, err = rotated.ReencryptPII(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n, "nothing left to rotate")
+}
+
+// rotatedKeys makes current the key new values are sealed with while the
+// KeyProvider still opens everything sealed before
+type rotatedKeys struct {
+	current crypt.Key
+	crypt.KeyProvider
+}
+
+func (k rotatedKeys) CurrentKey() (crypt.Key, error) { return k.current, nil }
+func (k rotatedKeys) Key(id string) (crypt.Key, error) {
+	if id == k.current.ID {
+		return k.current, nil
+	}
+	return k.KeyProvider.Key(id)
+}
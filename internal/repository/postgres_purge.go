@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// PurgeProperties removed before the time along with their units, listing,
+// late fee and screening policies, a property a lease, rental application or
+// screening report refers to is kept
+func (r Postgres) PurgeProperties(ctx context.Context, before time.Time) (int, error) {
+	const query = `
+		SELECT p.id FROM properties p
+		WHERE p.deleted_at < $1
+		  AND NOT EXISTS (SELECT 1 FROM leases l WHERE l.property_id = p.id)
+		  AND NOT EXISTS (SELECT 1 FROM rental_applications a WHERE a.property_id = p.id)
+		  AND NOT EXISTS (SELECT 1 FROM screening_reports s WHERE s.property_id = p.id)
+		FOR UPDATE;`
+	return r.purge(ctx, query, before,
+		`DELETE FROM listings WHERE property_id = ANY($1::VARCHAR[]);`,
+		`DELETE FROM late_fee_policies WHERE property_id = ANY($1::VARCHAR[]);`,
+		`DELETE FROM screening_policies WHERE property_id = ANY($1::VARCHAR[]);`,
+		`DELETE FROM units WHERE property_id = ANY($1::VARCHAR[]);`,
+		`DELETE FROM properties WHERE id = ANY($1::VARCHAR[]);`,
+	)
+}
+
+// PurgeTenants removed before the time along with their phones, a tenant a
+// lease or rental applicant refers to is kept
+func (r Postgres) PurgeTenants(ctx context.Context, before time.Time) (int, error) {
+	const query = `
+		SELECT t.id FROM tenants t
+		WHERE t.deleted_at < $1
+		  AND NOT EXISTS (SELECT 1 FROM lease_tenants l WHERE l.tenant_id = t.id)
+		  AND NOT EXISTS (SELECT 1 FROM rental_applicants a WHERE a.tenant_id = t.id)
+		FOR UPDATE;`
+	return r.purge(ctx, query, before,
+		`DELETE FROM tenants WHERE id = ANY($1::VARCHAR[]);`,
+	)
+}
+
+// purge selects the ids to purge with the query and then runs each delete
+// with them, all in one transaction
+func (r Postgres) purge(ctx context.Context, query string, before time.Time, deletes ...string) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, query, before)
+	if err != nil {
+		return 0, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	for _, d := range deletes {
+		if _, err := tx.ExecContext(ctx, d, pq.Array(ids)); err != nil {
+			return 0, err
+		}
+	}
+	return len(ids), tx.Commit()
+}
@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// StoreRentalApplication inserts or updates the application, notes are only
+// ever appended
+func (r Postgres) StoreRentalApplication(ctx context.Context, a entity.RentalApplication) error {
+	const (
+		query = `
+			INSERT INTO rental_applications (id, property_id, move_in_date, status, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (id) DO UPDATE SET
+				property_id=$2, move_in_date=$3, status=$4, updated_at=$5;`
+		delApplicantsQuery = `DELETE FROM rental_applicants WHERE application_id=$1;`
+		applicantQuery     = `
+			INSERT INTO rental_applicants (
+				application_id, position, full_name, dob, dob_enc, ssn, dl_num, dl_state,
+				has_pets, pets_desc, vehicle_count, vehicle_desc,
+				crime_conviction, crime_desc, bankruptcy_filed, bankruptcy_desc,
+				income_minor, income_currency, tenant_id
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, NULLIF($19, ''));`
+		noteQuery = `
+			INSERT INTO application_notes (application_id, position, status, note, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (application_id, position) DO NOTHING;`
+	)
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := r.clock.Now()
+	if _, err := tx.ExecContext(ctx, query, a.ID, a.PropertyID, a.MoveInDate, a.Status, now); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, delApplicantsQuery, a.ID); err != nil {
+		return err
+	}
+	for i, ap := range a.Applicants {
+		var tenantID entity.ID
+		if i < len(a.TenantIDs) {
+			tenantID = a.TenantIDs[i]
+		}
+		pii, err := r.sealApplicantPII(ap)
+		if err != nil {
+			return err
+		}
+		qArgs := []any{
+			a.ID, i, ap.FullName, pii.dob, pii.dobEnc, pii.ssn, pii.dlNum, ap.DLState,
+			ap.HasPets, ap.PetsDesc, ap.VehicleCount, ap.VehicleDesc,
+			ap.CrimeConviction, ap.CrimeDesc, ap.BankruptcyFiled, ap.BankruptcyDesc,
+			ap.MonthlyIncome.Minor, ap.MonthlyIncome.Currency, tenantID,
+		}
+		if _, err := tx.ExecContext(ctx, applicantQuery, qArgs...); err != nil {
+			if isUniqueViolation(err) {
+				return internal.MakeErr(internal.ErrConflict, err.Error())
+			}
+			return err
+		}
+	}
+	for i, n := range a.Notes {
+		createdAt := n.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = now
+		}
+		if _, err := tx.ExecContext(ctx, noteQuery, a.ID, i, n.Status, n.Note, createdAt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ConvertRentalApplication stores the tenants created from the applicants and
+// links each applicant to its tenant in one transaction along with the staged
+// events and audit changes, an application which has already been converted
+// is internal.ErrConflict
+func (r Postgres) ConvertRentalApplication(ctx context.Context, a entity.RentalApplication, tenants []entity.Tenant) error {
+	const (
+		query = `
+			UPDATE rental_applicants SET tenant_id=$3
+			WHERE application_id=$1 AND position=$2 AND tenant_id IS NULL;`
+		touchQuery = `UPDATE rental_applications SET updated_at=$2 WHERE id=$1;`
+	)
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for i, t := range tenants {
+		if err := r.storeTenant(ctx, tx, t); err != nil {
+			return err
+		}
+		res, err := tx.ExecContext(ctx, query, a.ID, i, t.ID)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n != 1 {
+			return internal.NewErrors(internal.ErrConflict, entity.ErrApplicationConverted)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, touchQuery, a.ID, r.clock.Now()); err != nil {
+		return err
+	}
+	if err := r.stageOutbox(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+func (r Postgres) GetRentalApplication(ctx context.Context, id entity.ID) (*entity.RentalApplication, error) {
+	const query = `
+		SELECT id, property_id, move_in_date, status, created_at, updated_at
+		FROM rental_applications WHERE id=$1;`
+	var a entity.RentalApplication
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&a.ID, &a.PropertyID, &a.MoveInDate, &a.Status, &a.SubmittedAt, &a.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internal.MakeErr(internal.ErrEntityNotFound, "application["+id+"]")
+		}
+		return nil, err
+	}
+	if err := r.loadApplicationDetails(ctx, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+func (r Postgres) ListRentalApplications(ctx context.Context, filter ...filters.ApplicationFilter) ([]entity.RentalApplication, error) {
+	f := filters.MergeApplicationFilters(filter...)
+	page, err := newPageQuery(f.Page, createdSortColumns, "id", 3)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		SELECT id, property_id, move_in_date, status, created_at, updated_at
+		FROM rental_applications
+		WHERE ($1 = '' OR property_id = $1)
+		  AND ($2 = '' OR status = $2)
+		  AND ` + page.after + `
+		` + page.orderBy + `;`
+	var (
+		list  = make([]entity.RentalApplication, 0)
+		qArgs = append([]any{f.PropertyID, f.Status}, page.args...)
+	)
+	rows, err := r.db.QueryContext(ctx, query, qArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var a entity.RentalApplication
+		if err := rows.Scan(
+			&a.ID, &a.PropertyID, &a.MoveInDate, &a.Status, &a.SubmittedAt, &a.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for i := range list {
+		if err := r.loadApplicationDetails(ctx, &list[i]); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// loadApplicationDetails fills in the applicants, tenant ids and notes
+func (r Postgres) loadApplicationDetails(ctx context.Context, a *entity.RentalApplication) error {
+	const (
+		applicantQuery = `
+			SELECT full_name, dob, dob_enc, ssn, dl_num, dl_state,
+				has_pets, pets_desc, vehicle_count, vehicle_desc,
+				crime_conviction, crime_desc, bankruptcy_filed, bankruptcy_desc,
+				income_minor, income_currency, COALESCE(tenant_id, '')
+			FROM rental_applicants
+			WHERE application_id=$1
+			ORDER BY position;`
+		noteQuery = `
+			SELECT status, note, created_at
+			FROM application_notes
+			WHERE application_id=$1
+			ORDER BY position;`
+	)
+	rows, err := r.db.QueryContext(ctx, applicantQuery, a.ID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var (
+			ap       entity.Applicant
+			dobEnc   string
+			tenantID entity.ID
+		)
+		if err := rows.Scan(
+			&ap.FullName, &ap.DateOfBirth, &dobEnc, &ap.SSN, &ap.DLNum, &ap.DLState,
+			&ap.HasPets, &ap.PetsDesc, &ap.VehicleCount, &ap.VehicleDesc,
+			&ap.CrimeConviction, &ap.CrimeDesc, &ap.BankruptcyFiled, &ap.BankruptcyDesc,
+			&ap.MonthlyIncome.Minor, &ap.MonthlyIncome.Currency, &tenantID,
+		); err != nil {
+			return err
+		}
+		if err := r.openApplicantPII(&ap, dobEnc); err != nil {
+			return err
+		}
+		a.Applicants = append(a.Applicants, ap)
+		if tenantID != "" {
+			a.TenantIDs = append(a.TenantIDs, tenantID)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	noteRows, err := r.db.QueryContext(ctx, noteQuery, a.ID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = noteRows.Close() }()
+	for noteRows.Next() {
+		var n entity.ApplicationNote
+		if err := noteRows.Scan(&n.Status, &n.Note, &n.CreatedAt); err != nil {
+			return err
+		}
+		a.Notes = append(a.Notes, n)
+	}
+	return noteRows.Err()
+}
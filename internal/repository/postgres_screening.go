@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// StoreScreeningPolicy inserts or replaces the policy along with all of its rules
+func (r Postgres) StoreScreeningPolicy(ctx context.Context, p entity.ScreeningPolicy) error {
+	const (
+		query = `
+			INSERT INTO screening_policies (id, property_id, rent_minor, currency, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $5)
+			ON CONFLICT (id) DO UPDATE SET
+				rent_minor=$3, currency=$4, updated_at=$5;`
+		delRulesQuery = `DELETE FROM screening_rules WHERE policy_id=$1;`
+		ruleQuery     = `
+			INSERT INTO screening_rules (policy_id, position, criterion, "limit", action)
+			VALUES ($1, $2, $3, $4, $5);`
+	)
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, query, p.ID, p.PropertyID, p.Rent.Minor, p.Rent.Currency, r.clock.Now()); err != nil {
+		if isUniqueViolation(err) {
+			return internal.MakeErr(internal.ErrConflict, err.Error())
+		}
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, delRulesQuery, p.ID); err != nil {
+		return err
+	}
+	for i, rule := range p.Rules {
+		if _, err := tx.ExecContext(ctx, ruleQuery, p.ID, i, rule.Criterion, rule.Limit, rule.Action); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetScreeningPolicy of the property, internal.ErrEntityNotFound when it has none
+func (r Postgres) GetScreeningPolicy(ctx context.Context, propertyID entity.ID) (*entity.ScreeningPolicy, error) {
+	const (
+		query = `
+			SELECT id, property_id, rent_minor, currency, updated_at
+			FROM screening_policies WHERE property_id=$1;`
+		ruleQuery = `
+			SELECT criterion, "limit", action
+			FROM screening_rules WHERE policy_id=$1
+			ORDER BY position;`
+	)
+	var p entity.ScreeningPolicy
+	if err := r.db.QueryRowContext(ctx, query, propertyID).Scan(
+		&p.ID, &p.PropertyID, &p.Rent.Minor, &p.Rent.Currency, &p.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internal.MakeErr(internal.ErrEntityNotFound, "screening policy for property["+propertyID+"]")
+		}
+		return nil, err
+	}
+	rules, err := r.screeningRules(ctx, ruleQuery, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	p.Rules = rules
+	return &p, nil
+}
+
+// AddScreeningReport stores the report with its copy of the rules and findings
+func (r Postgres) AddScreeningReport(ctx context.Context, report entity.ScreeningReport) error {
+	const (
+		query = `
+			INSERT INTO screening_reports (id, application_id, property_id, result, rent_minor, currency, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7);`
+		ruleQuery = `
+			INSERT INTO screening_report_rules (report_id, position, criterion, "limit", action)
+			VALUES ($1, $2, $3, $4, $5);`
+		findingQuery = `
+			INSERT INTO screening_findings (report_id, position, rule_position, applicant, reason)
+			VALUES ($1, $2, $3, $4, $5);`
+	)
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	createdAt := report.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = r.clock.Now()
+	}
+	qArgs := []any{
+		report.ID, report.ApplicationID, report.PropertyID, report.Result,
+		report.Rent.Minor, report.Rent.Currency, createdAt,
+	}
+	if _, err := tx.ExecContext(ctx, query, qArgs...); err != nil {
+		if isUniqueViolation(err) {
+			return internal.MakeErr(internal.ErrConflict, err.Error())
+		}
+		return err
+	}
+	for i, rule := range report.Rules {
+		if _, err := tx.ExecContext(ctx, ruleQuery, report.ID, i, rule.Criterion, rule.Limit, rule.Action); err != nil {
+			return err
+		}
+	}
+	for i, f := range report.Findings {
+		pos := ruleIndex(report.Rules, f.Rule)
+		if _, err := tx.ExecContext(ctx, findingQuery, report.ID, i, pos, f.Applicant, f.Reason); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ListScreeningReports matching the filter, oldest first
+func (r Postgres) ListScreeningReports(ctx context.Context, filter ...filters.ScreeningReportFilter) ([]entity.ScreeningReport, error) {
+	f := filters.MergeScreeningReportFilters(filter...)
+	page, err := newPageQuery(f.Page, createdSortColumns, "id", 2)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		SELECT id, application_id, property_id, result, rent_minor, currency, created_at
+		FROM screening_reports
+		WHERE ($1 = '' OR application_id = $1)
+		  AND ` + page.after + `
+		` + page.orderBy + `;`
+	const (
+		ruleQuery = `
+			SELECT criterion, "limit", action
+			FROM screening_report_rules WHERE report_id=$1
+			ORDER BY position;`
+		findingQuery = `
+			SELECT rule_position, applicant, reason
+			FROM screening_findings WHERE report_id=$1
+			ORDER BY position;`
+	)
+	rows, err := r.db.QueryContext(ctx, query, append([]any{f.ApplicationID}, page.args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]entity.ScreeningReport, 0)
+	for rows.Next() {
+		var report entity.ScreeningReport
+		if err := rows.Scan(
+			&report.ID, &report.ApplicationID, &report.PropertyID, &report.Result,
+			&report.Rent.Minor, &report.Rent.Currency, &report.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for i := range list {
+		report := &list[i]
+		if report.Rules, err = r.screeningRules(ctx, ruleQuery, report.ID); err != nil {
+			return nil, err
+		}
+		report.Findings, err = r.screeningFindings(ctx, findingQuery, report.ID, report.Rules)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+func (r Postgres) screeningRules(ctx context.Context, query string, id entity.ID) ([]entity.ScreeningRule, error) {
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	rules := make([]entity.ScreeningRule, 0)
+	for rows.Next() {
+		var rule entity.ScreeningRule
+		if err := rows.Scan(&rule.Criterion, &rule.Limit, &rule.Action); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+func (r Postgres) screeningFindings(ctx context.Context, query string, id entity.ID, rules []entity.ScreeningRule) ([]entity.ScreeningFinding, error) {
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	findings := make([]entity.ScreeningFinding, 0)
+	for rows.Next() {
+		var (
+			f   entity.ScreeningFinding
+			pos int
+		)
+		if err := rows.Scan(&pos, &f.Applicant, &f.Reason); err != nil {
+			return nil, err
+		}
+		if pos < len(rules) {
+			f.Rule = rules[pos]
+		}
+		findings = append(findings, f)
+	}
+	return findings, rows.Err()
+}
+
+// ruleIndex is the position of the first rule equal to rule
+func ruleIndex(rules []entity.ScreeningRule, rule entity.ScreeningRule) int {
+	for i, r := range rules {
+		if r == rule {
+			return i
+		}
+	}
+	return -1
+}
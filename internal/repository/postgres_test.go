@@ -6,6 +6,7 @@ package repository_test
 import (
 	"testing"
 
+	"github.com/tempcke/rpm/internal/lib/crypt"
 	"github.com/tempcke/rpm/internal/repository"
 	"github.com/tempcke/rpm/internal/test"
 )
@@ -14,14 +15,19 @@ func TestPropertyRepo_Postgres(t *testing.T) {
 	var tests = map[string]struct {
 		fn func(*testing.T, propertyRepo)
 	}{
-		"store":  {testStoreAndRetrieveProperty},
-		"update": {testUpdateProperty},
-		"list":   {testListProperties},
-		"remove": {testRemoveProperty},
-		"get":    {testGetProperty},
+		"store":   {testStoreAndRetrieveProperty},
+		"update":  {testUpdateProperty},
+		"list":    {testListProperties},
+		"page":    {testListPropertiesPage},
+		"search":  {testSearchProperties},
+		"remove":  {testRemoveProperty},
+		"restore": {testRestoreProperty},
+		"address": {testDuplicateAddress},
+		"purge":   {testPurgeProperties},
+		"get":     {testGetProperty},
 	}
 
-	r := repository.NewPostgresRepo(test.DB(t))
+	r := postgresRepo(t)
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			tc.fn(t, r)
@@ -32,12 +38,156 @@ func TestPropertyRepo_Postgres(t *testing.T) {
 func TestTenantRepo_Postgres(t *testing.T) {
 	var tests = map[string]struct{ fn func(*testing.T, tenantRepo) }{
 		"store get list": {testTenant},
+		"remove":         {testRemoveTenant},
+		"filter":         {testListTenantsFilter},
+		"page":           {testListTenantsPage},
 	}
 
-	r := repository.NewPostgresRepo(test.DB(t))
+	r := postgresRepo(t)
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			tc.fn(t, r)
 		})
 	}
 }
+
+func TestLeaseRepo_Postgres(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, leaseRepo) }{
+		"store get list":     {testLease},
+		"overlap constraint": {testLeaseOverlap},
+		"versions":           {testLeaseVersions},
+		"purge leased":       {testPurgeLeased},
+		"list page":          {testListLeasesPage},
+	}
+
+	r := postgresRepo(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+
+func TestLedgerRepo_Postgres(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, ledgerRepo) }{
+		"append get list": {testLedger},
+	}
+
+	r := postgresRepo(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestLateFeeRepo_Postgres(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, lateFeeRepo) }{
+		"store list policies": {testLateFeePolicies},
+	}
+
+	r := postgresRepo(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestDepositRepo_Postgres(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, depositRepo) }{
+		"receipts and disposition": {testDeposit},
+	}
+
+	r := postgresRepo(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestApplicationRepo_Postgres(t *testing.T) {
+	var tests = map[string]struct {
+		fn func(*testing.T, applicationRepo)
+	}{
+		"store get list convert": {testRentalApplication},
+		"screening":              {testScreening},
+		"list page":              {testListApplicationsPage},
+		"screening list page":    {testListScreeningReportsPage},
+	}
+
+	r := postgresRepo(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestListingRepo_Postgres(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, listingRepo) }{
+		"store get list": {testListing},
+		"list page":      {testListListingsPage},
+	}
+
+	r := postgresRepo(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestUnitRepo_Postgres(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, unitRepo) }{
+		"store get list delete": {testUnit},
+		"overlap constraint":    {testUnitOverlap},
+	}
+
+	r := postgresRepo(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestOutboxRepo_Postgres(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, outboxRepo) }{
+		"stage claim store list": {testOutbox},
+	}
+
+	r := postgresRepo(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestWebhookRepo_Postgres(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, webhookRepo) }{
+		"store get list delete": {testWebhooks},
+		"deliveries":            {testWebhookDeliveries},
+	}
+
+	r := postgresRepo(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+func TestAuditRepo_Postgres(t *testing.T) {
+	var tests = map[string]struct{ fn func(*testing.T, auditRepo) }{
+		"stage list": {testAudit},
+	}
+
+	r := postgresRepo(t)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.fn(t, r)
+		})
+	}
+}
+
+// postgresRepo encrypts personal information with the dev keys just like the
+// app running in docker does
+func postgresRepo(t testing.TB) repository.Postgres {
+	return repository.NewPostgresRepo(test.DB(t)).
+		WithPIIEnvelope(crypt.NewEnvelope(test.PIIKeys(t)))
+}
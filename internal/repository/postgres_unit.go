@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+)
+
+const unitColumns = `
+	u.id, u.property_id, u.number, u.beds, u.baths, u.sqft,
+	u.rent_target_minor, u.currency`
+
+// StoreUnit inserts or replaces the unit, the units_property_id_number_key
+// constraint rejects a number already used by another unit of the property
+func (r Postgres) StoreUnit(ctx context.Context, u entity.Unit) error {
+	const query = `
+		INSERT INTO units (
+			id, property_id, number, beds, baths, sqft,
+			rent_target_minor, currency, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+		ON CONFLICT (id) DO UPDATE SET
+			number=$3, beds=$4, baths=$5, sqft=$6,
+			rent_target_minor=$7, currency=$8, updated_at=$9;`
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qArgs := []any{
+		u.ID, u.PropertyID, u.Number, u.Beds, u.Baths, u.SqFt,
+		u.RentTarget.Minor, u.RentTarget.Currency, r.clock.Now(),
+	}
+	if _, err := tx.ExecContext(ctx, query, qArgs...); err != nil {
+		if isUniqueViolation(err) {
+			return internal.MakeErr(internal.ErrConflict, err.Error())
+		}
+		return err
+	}
+	if err := r.stageOutbox(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// storeDefaultUnit gives a property without units its default unit in the
+// transaction the property is stored in
+func (r Postgres) storeDefaultUnit(ctx context.Context, tx *sql.Tx, p entity.Property) error {
+	const query = `
+		INSERT INTO units (id, property_id, number, created_at, updated_at)
+		SELECT $1, $2, $3, $4, $4
+		WHERE NOT EXISTS (SELECT 1 FROM units WHERE property_id = $2)
+		ON CONFLICT DO NOTHING;`
+	u := entity.DefaultUnit(p)
+	_, err := tx.ExecContext(ctx, query, u.ID, u.PropertyID, u.Number, r.clock.Now())
+	return err
+}
+func (r Postgres) GetUnit(ctx context.Context, id entity.ID) (*entity.Unit, error) {
+	const query = `SELECT ` + unitColumns + ` FROM units u WHERE u.id=$1;`
+	u, err := scanUnit(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internal.MakeErr(internal.ErrEntityNotFound, "unit["+id+"]")
+		}
+		return nil, err
+	}
+	return &u, nil
+}
+
+// ListUnits of the property ordered by number
+func (r Postgres) ListUnits(ctx context.Context, propertyID entity.ID) ([]entity.Unit, error) {
+	const query = `
+		SELECT ` + unitColumns + `
+		FROM units u
+		WHERE u.property_id = $1
+		ORDER BY u.number, u.id;`
+	rows, err := r.db.QueryContext(ctx, query, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]entity.Unit, 0)
+	for rows.Next() {
+		u, err := scanUnit(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, u)
+	}
+	return list, rows.Err()
+}
+func (r Postgres) DeleteUnit(ctx context.Context, id entity.ID) error {
+	const query = `DELETE FROM units WHERE id=$1;`
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, query, id); err != nil {
+		return err
+	}
+	if err := r.stageOutbox(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func scanUnit(row interface{ Scan(...any) error }) (entity.Unit, error) {
+	var u entity.Unit
+	err := row.Scan(
+		&u.ID, &u.PropertyID, &u.Number, &u.Beds, &u.Baths, &u.SqFt,
+		&u.RentTarget.Minor, &u.RentTarget.Currency,
+	)
+	return u, err
+}
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+const deliveryColumns = `
+	id, webhook_id, event, payload, status, status_code, attempts, last_error,
+	redelivery_of, created_at, next_attempt_at, delivered_at`
+
+// StoreWebhook inserts or replaces the webhook, the time it was created is kept
+func (r Postgres) StoreWebhook(ctx context.Context, w entity.Webhook) error {
+	const query = `
+		INSERT INTO webhooks (id, url, events, secret, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE SET url=$2, events=$3, secret=$4;`
+	createdAt := w.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = r.clock.Now()
+	}
+	events := append([]string{}, w.Events...)
+	_, err := r.db.ExecContext(ctx, query, w.ID, w.URL, pq.Array(events), w.Secret, createdAt)
+	return err
+}
+func (r Postgres) GetWebhook(ctx context.Context, id entity.ID) (*entity.Webhook, error) {
+	const query = `SELECT id, url, events, secret, created_at FROM webhooks WHERE id=$1;`
+	w, err := scanWebhook(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internal.MakeErr(internal.ErrEntityNotFound, "webhook["+id+"]")
+		}
+		return nil, err
+	}
+	return &w, nil
+}
+
+// ListWebhooks oldest first
+func (r Postgres) ListWebhooks(ctx context.Context) ([]entity.Webhook, error) {
+	const query = `SELECT id, url, events, secret, created_at FROM webhooks ORDER BY created_at, id;`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]entity.Webhook, 0)
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, w)
+	}
+	return list, rows.Err()
+}
+
+// DeleteWebhook along with its deliveries, they cascade
+func (r Postgres) DeleteWebhook(ctx context.Context, id entity.ID) error {
+	const query = `DELETE FROM webhooks WHERE id=$1;`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// StoreWebhookDelivery inserts or updates the delivery, internal.ErrEntityNotFound
+// when the webhook does not exist
+func (r Postgres) StoreWebhookDelivery(ctx context.Context, d entity.WebhookDelivery) error {
+	const query = `
+		INSERT INTO webhook_deliveries (` + deliveryColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE SET
+			status=$5, status_code=$6, attempts=$7, last_error=$8,
+			next_attempt_at=$11, delivered_at=$12;`
+	var (
+		deliveredAt = sql.NullTime{Time: d.DeliveredAt, Valid: !d.DeliveredAt.IsZero()}
+		qArgs       = []any{
+			d.ID, d.WebhookID, d.Event, d.Payload, d.Status, d.StatusCode, d.Attempts, d.LastError,
+			d.RedeliveryOf, d.CreatedAt, d.NextAttempt, deliveredAt,
+		}
+	)
+	if _, err := r.db.ExecContext(ctx, query, qArgs...); err != nil {
+		if isForeignKeyViolation(err) {
+			return internal.MakeErr(internal.ErrEntityNotFound, "webhook["+d.WebhookID+"]")
+		}
+		return err
+	}
+	return nil
+}
+func (r Postgres) GetWebhookDelivery(ctx context.Context, id entity.ID) (*entity.WebhookDelivery, error) {
+	const query = `SELECT ` + deliveryColumns + ` FROM webhook_deliveries WHERE id=$1;`
+	d, err := scanWebhookDelivery(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internal.MakeErr(internal.ErrEntityNotFound, "webhook delivery["+id+"]")
+		}
+		return nil, err
+	}
+	return &d, nil
+}
+
+// ListWebhookDeliveries matching the filter, most recent first
+func (r Postgres) ListWebhookDeliveries(ctx context.Context, filter ...filters.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	f := filters.MergeWebhookDeliveryFilters(filter...)
+	page, err := newPageQuery(f.Paging(), createdSortColumns, "id", 3)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries
+		WHERE ($1 = '' OR webhook_id = $1)
+		  AND ($2 = '' OR status = $2)
+		  AND ` + page.after + `
+		` + page.orderBy + `;`
+	return r.queryWebhookDeliveries(ctx, query, append([]any{f.WebhookID, f.Status}, page.args...)...)
+}
+
+// ClaimWebhookDeliveries pending deliveries due at now, oldest first, the ones
+// another process is claiming at the same time are skipped rather than waited on
+func (r Postgres) ClaimWebhookDeliveries(ctx context.Context, now, lockedUntil time.Time, limit int) ([]entity.WebhookDelivery, error) {
+	const query = `
+		UPDATE webhook_deliveries SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = $3 AND next_attempt_at <= $1
+			ORDER BY created_at, id
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + deliveryColumns + `;`
+	list, err := r.queryWebhookDeliveries(ctx, query, now, lockedUntil, entity.DeliveryPending, limit)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].CreatedAt.Before(list[j].CreatedAt)
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list, nil
+}
+func (r Postgres) queryWebhookDeliveries(ctx context.Context, query string, qArgs ...any) ([]entity.WebhookDelivery, error) {
+	rows, err := r.db.QueryContext(ctx, query, qArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]entity.WebhookDelivery, 0)
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, d)
+	}
+	return list, rows.Err()
+}
+
+func scanWebhook(row interface{ Scan(...any) error }) (entity.Webhook, error) {
+	var (
+		w      entity.Webhook
+		events []string
+	)
+	if err := row.Scan(&w.ID, &w.URL, pq.Array(&events), &w.Secret, &w.CreatedAt); err != nil {
+		return w, err
+	}
+	if len(events) > 0 {
+		w.Events = events
+	}
+	return w, nil
+}
+func scanWebhookDelivery(row interface{ Scan(...any) error }) (entity.WebhookDelivery, error) {
+	var (
+		d           entity.WebhookDelivery
+		deliveredAt sql.NullTime
+	)
+	if err := row.Scan(
+		&d.ID, &d.WebhookID, &d.Event, &d.Payload, &d.Status, &d.StatusCode, &d.Attempts, &d.LastError,
+		&d.RedeliveryOf, &d.CreatedAt, &d.NextAttempt, &deliveredAt,
+	); err != nil {
+		return d, err
+	}
+	if deliveredAt.Valid {
+		d.DeliveredAt = deliveredAt.Time
+	}
+	return d, nil
+}
@@ -1,27 +1,40 @@
 package repository_test
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/entity/fake"
 	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/usecase"
 )
 
 func testStoreAndRetrieveProperty(t *testing.T, r propertyRepo) {
 	pIn := newPropertyFixture(r)
+	pIn.Address = pIn.Address.WithUnit("Apt 5").WithCountry("CA")
 	require.NoError(t, r.StoreProperty(ctx, pIn))
 	pOut, err := r.GetProperty(ctx, pIn.ID)
 	require.NoError(t, err)
 	assert.Equal(t, pIn.ID, pOut.ID)
 	assert.Equal(t, pIn.Street, pOut.Street)
+	assert.Equal(t, pIn.Unit, pOut.Unit)
 	assert.Equal(t, pIn.City, pOut.City)
 	assert.Equal(t, pIn.StateCode, pOut.StateCode)
 	assert.Equal(t, pIn.Zip, pOut.Zip)
+	assert.Equal(t, pIn.Country, pOut.Country)
 	assertTimestampMatch(t, pIn.CreatedAt, pOut.CreatedAt)
+
+	list, err := r.PropertyList(ctx, usecase.NewPropertyFilter().WithSearch(pIn.Street+" apt 5"))
+	require.NoError(t, err)
+	require.Len(t, list, 1, "the unit is part of the address which is searched")
+	assert.Equal(t, pIn.Address, list[0].Address)
 }
 func testUpdateProperty(t *testing.T, r propertyRepo) {
 	// insert
@@ -73,6 +86,135 @@ func testListProperties(t *testing.T, r propertyRepo) {
 	// properties are deleted as they are found so there should be none left
 	assert.Len(t, props, 0)
 }
+func testListPropertiesPage(t *testing.T, r propertyRepo) {
+	var (
+		street  = entity.NewID()[:8] // so the search only finds these properties
+		cities  = []string{"Dallas", "austin", "Chicago", "Austin", "boston"}
+		created = time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+		stored  []entity.Property
+	)
+	for i, city := range cities {
+		p := r.NewProperty(fmt.Sprintf("%d %s St", i+1, street), city, "TX", fmt.Sprintf("7520%d", len(cities)-i))
+		p.CreatedAt = created.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, r.StoreProperty(ctx, p))
+		stored = append(stored, p)
+	}
+	sorted := func(less func(a, b entity.Property) bool) []entity.ID {
+		list := append([]entity.Property{}, stored...)
+		sort.Slice(list, func(i, j int) bool { return less(list[i], list[j]) })
+		return entityIDs(list)
+	}
+	reversed := func(ids []entity.ID) []entity.ID {
+		var out = make([]entity.ID, len(ids))
+		for i, id := range ids {
+			out[len(ids)-1-i] = id
+		}
+		return out
+	}
+	byCity := sorted(func(a, b entity.Property) bool {
+		if ca, cb := strings.ToLower(a.City), strings.ToLower(b.City); ca != cb {
+			return ca < cb
+		}
+		return a.ID < b.ID
+	})
+
+	tests := map[string]struct {
+		page   filters.Page
+		expect []entity.ID
+	}{
+		"created":          {filters.NewPage().WithSort(filters.SortCreatedAt, false), entityIDs(stored)},
+		"created desc":     {filters.NewPage().WithSort(filters.SortCreatedAt, true), reversed(entityIDs(stored))},
+		"city ignore case": {filters.NewPage().WithSort(filters.SortCity, false), byCity},
+		"city desc":        {filters.NewPage().WithSort(filters.SortCity, true), reversed(byCity)},
+		"zip":              {filters.NewPage().WithSort(filters.SortZip, false), reversed(entityIDs(stored))},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			list := readPages(t, tc.page.WithSize(2), filters.PropertySortValue,
+				func(p filters.Page) ([]entity.Property, error) {
+					return r.PropertyList(ctx, usecase.NewPropertyFilter().WithSearch(street).WithPage(p))
+				})
+			assert.Equal(t, tc.expect, entityIDs(list))
+		})
+	}
+	t.Run("cursor of another sort", func(t *testing.T) {
+		list, err := r.PropertyList(ctx, usecase.NewPropertyFilter().WithSearch(street).
+			WithPage(filters.NewPage().WithSize(2)))
+		require.NoError(t, err)
+		cursor := filters.NextCursor(list, filters.NewPage().WithSize(2), filters.PropertySortValue)
+		_, err = r.PropertyList(ctx, usecase.NewPropertyFilter().
+			WithPage(filters.NewPage().WithSort(filters.SortCity, false).WithCursor(cursor)))
+		assert.ErrorIs(t, err, internal.ErrBadRequest)
+	})
+}
+func testSearchProperties(t *testing.T, r propertyRepo) {
+	var (
+		word = "w" + entity.NewID()[:8] // so the search only finds these properties
+		a    = r.NewProperty("100 North "+word+" Street", "Dallas", "TX", "75001")
+		b    = r.NewProperty("200 "+word+" Avenue", "Austin", "TX", "73301")
+		c    = r.NewProperty("300 S "+word+" Blvd.", "Dallas", "TX", "75002")
+	)
+	for _, p := range []entity.Property{a, b, c} {
+		require.NoError(t, r.StoreProperty(ctx, p))
+	}
+	list := func(t *testing.T, search string) []entity.Property {
+		t.Helper()
+		props, err := r.PropertyList(ctx, usecase.NewPropertyFilter().WithSearch(search))
+		require.NoError(t, err)
+		return props
+	}
+	assertRanked := func(t *testing.T, props []entity.Property) {
+		t.Helper()
+		for i, p := range props {
+			assert.Greater(t, p.Rank, 0.0)
+			assert.LessOrEqual(t, p.Rank, 1.0)
+			if i > 0 {
+				assert.GreaterOrEqual(t, props[i-1].Rank, p.Rank)
+			}
+		}
+	}
+
+	t.Run("abbreviations", func(t *testing.T) {
+		for _, search := range []string{
+			word + " st",
+			"N. " + word + " St.",
+			"north " + word + " street",
+			word + " street dallas",
+		} {
+			props := list(t, search)
+			assert.Equal(t, []entity.ID{a.ID}, entityIDs(props), search)
+			assertRanked(t, props)
+		}
+		assert.Equal(t, []entity.ID{c.ID}, entityIDs(list(t, "south "+word+" boulevard")))
+	})
+	t.Run("ranked by relevance", func(t *testing.T) {
+		props := list(t, word+" dal")
+		assert.ElementsMatch(t, []entity.ID{a.ID, c.ID}, entityIDs(props))
+		assertRanked(t, props)
+
+		props = list(t, "200 "+word+" ave austin")
+		require.NotEmpty(t, props)
+		assert.Equal(t, b.ID, props[0].ID)
+		assert.Equal(t, 1.0, props[0].Rank)
+	})
+	t.Run("fuzzy when nothing matches", func(t *testing.T) {
+		typo := word[:len(word)-1] + "z"
+		props := list(t, typo)
+		assert.ElementsMatch(t, []entity.ID{a.ID, b.ID, c.ID}, entityIDs(props))
+		assertRanked(t, props)
+		for _, p := range props {
+			assert.Less(t, p.Rank, 1.0)
+		}
+	})
+	t.Run("not listed without a search", func(t *testing.T) {
+		props, err := r.PropertyList(ctx, usecase.NewPropertyFilter().
+			WithPage(filters.NewPage().WithSort(filters.SortCreatedAt, true).WithSize(3)))
+		require.NoError(t, err)
+		for _, p := range props {
+			assert.Zero(t, p.Rank)
+		}
+	})
+}
 func testRemoveProperty(t *testing.T, r propertyRepo) {
 	// create and store property
 	p := newPropertyFixture(r)
@@ -84,6 +226,74 @@ func testRemoveProperty(t *testing.T, r propertyRepo) {
 	_, err = r.GetProperty(ctx, p.ID)
 	assert.Error(t, err)
 }
+func testRestoreProperty(t *testing.T, r propertyRepo) {
+	p := newPropertyFixture(r)
+	require.NoError(t, r.StoreProperty(ctx, p))
+
+	// only a removed property can be restored
+	require.ErrorIs(t, r.RestoreProperty(ctx, p.ID), internal.ErrEntityNotFound)
+
+	require.NoError(t, r.DeleteProperty(ctx, p.ID))
+	list, err := r.PropertyList(ctx, usecase.AllProperties)
+	require.NoError(t, err)
+	assertEntityNotInSet(t, p.ID, list...)
+	list, err = r.PropertyList(ctx, usecase.NewPropertyFilter().WithDeleted())
+	require.NoError(t, err)
+	assertEntityInSet(t, p.ID, list...)
+
+	require.NoError(t, r.RestoreProperty(ctx, p.ID))
+	pOut, err := r.GetProperty(ctx, p.ID)
+	require.NoError(t, err)
+	assert.True(t, p.Equal(pOut))
+	assert.False(t, pOut.Deleted())
+}
+func testDuplicateAddress(t *testing.T, r propertyRepo) {
+	var (
+		p   = newPropertyFixture(r)
+		dup = r.NewProperty(strings.ToUpper(p.Street), p.City, p.StateCode, p.Zip)
+	)
+	require.NoError(t, r.StoreProperty(ctx, p))
+
+	// the use case looks for the address first, the repo refuses the one
+	// stored with it since
+	err := r.StoreProperty(ctx, dup)
+	require.ErrorIs(t, err, usecase.ErrDuplicateAddress)
+	var dupErr usecase.DuplicateAddressError
+	require.ErrorAs(t, err, &dupErr)
+	assert.Equal(t, p.ID, dupErr.PropertyID)
+	_, err = r.GetProperty(ctx, dup.ID)
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+	// a removed property does not keep its address
+	require.NoError(t, r.DeleteProperty(ctx, p.ID))
+	require.NoError(t, r.StoreProperty(ctx, dup))
+	err = r.RestoreProperty(ctx, p.ID)
+	require.ErrorAs(t, err, &dupErr)
+	assert.Equal(t, dup.ID, dupErr.PropertyID)
+}
+func testPurgeProperties(t *testing.T, r propertyRepo) {
+	var (
+		removed = newPropertyFixture(r)
+		kept    = newPropertyFixture(r)
+	)
+	require.NoError(t, r.StoreProperty(ctx, removed))
+	require.NoError(t, r.StoreProperty(ctx, kept))
+	require.NoError(t, r.DeleteProperty(ctx, removed.ID))
+
+	_, err := r.PurgeProperties(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	list, err := r.PropertyList(ctx, usecase.NewPropertyFilter().WithDeleted())
+	require.NoError(t, err)
+	assertEntityInSet(t, removed.ID, list...)
+
+	n, err := r.PurgeProperties(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, n, 1)
+	list, err = r.PropertyList(ctx, usecase.NewPropertyFilter().WithDeleted())
+	require.NoError(t, err)
+	assertEntityNotInSet(t, removed.ID, list...)
+	assertEntityInSet(t, kept.ID, list...)
+}
 func testGetProperty(t *testing.T, r propertyRepo) {
 	id := "id-does-not-exist"
 	pOut, err := r.GetProperty(ctx, id)
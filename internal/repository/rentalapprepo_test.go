@@ -0,0 +1,105 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+func testRentalApplication(t *testing.T, r applicationRepo) {
+	var (
+		property = fake.Property()
+		app      = fake.RentalApplication(property.ID).WithApplicant(fake.Applicant())
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+
+	_, err := r.GetRentalApplication(ctx, app.ID)
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+	require.NoError(t, r.StoreRentalApplication(ctx, app))
+	got, err := r.GetRentalApplication(ctx, app.ID)
+	require.NoError(t, err)
+	assert.True(t, app.Equal(*got))
+	assert.False(t, got.SubmittedAt.IsZero())
+
+	// status changes append notes
+	app, err = app.Transition(entity.ApplicationUnderReview, "")
+	require.NoError(t, err)
+	app, err = app.Transition(entity.ApplicationApproved, "references check out")
+	require.NoError(t, err)
+	require.NoError(t, r.StoreRentalApplication(ctx, app))
+	got, err = r.GetRentalApplication(ctx, app.ID)
+	require.NoError(t, err)
+	assert.True(t, app.Equal(*got))
+	require.Len(t, got.Notes, 2)
+	assert.False(t, got.Notes[1].CreatedAt.IsZero())
+
+	// filter by property and status
+	other := fake.RentalApplication(property.ID)
+	require.NoError(t, r.StoreRentalApplication(ctx, other))
+	list, err := r.ListRentalApplications(ctx, filters.NewApplicationFilter().WithPropertyID(property.ID))
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assertEntityInSet(t, app.ID, list...)
+	assertEntityInSet(t, other.ID, list...)
+
+	list, err = r.ListRentalApplications(ctx,
+		filters.NewApplicationFilter().WithPropertyID(property.ID).WithStatus(entity.ApplicationApproved))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, app.ID, list[0].ID)
+
+	// convert once
+	tenants := []entity.Tenant{app.Applicants[0].ToTenant(), app.Applicants[1].ToTenant()}
+	require.NoError(t, r.ConvertRentalApplication(ctx, app, tenants))
+	got, err = r.GetRentalApplication(ctx, app.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.ID{tenants[0].ID, tenants[1].ID}, got.TenantIDs)
+	tenant, err := r.GetTenant(ctx, tenants[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, app.Applicants[1].FullName, tenant.FullName)
+
+	again := []entity.Tenant{app.Applicants[0].ToTenant(), app.Applicants[1].ToTenant()}
+	assert.ErrorIs(t, r.ConvertRentalApplication(ctx, app, again), internal.ErrConflict)
+
+	// storing the application again keeps the tenants
+	require.NoError(t, r.StoreRentalApplication(ctx, *got))
+	got, err = r.GetRentalApplication(ctx, app.ID)
+	require.NoError(t, err)
+	assert.Len(t, got.TenantIDs, 2)
+}
+func testListApplicationsPage(t *testing.T, r applicationRepo) {
+	var (
+		property = fake.Property()
+		stored   []entity.RentalApplication
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	for i := 0; i < 3; i++ {
+		in := fake.RentalApplication(property.ID)
+		require.NoError(t, r.StoreRentalApplication(ctx, in))
+		stored = append(stored, in)
+	}
+	var (
+		f    = filters.NewApplicationFilter().WithPropertyID(property.ID)
+		list = func(p filters.Page) ([]entity.RentalApplication, error) {
+			return r.ListRentalApplications(ctx, f.WithPage(p))
+		}
+	)
+	for _, desc := range []bool{false, true} {
+		got := readPages(t, filters.NewPage().WithSort(filters.SortCreatedAt, desc).WithSize(2), filters.ApplicationSortValue, list)
+		require.Len(t, got, len(stored))
+		assert.ElementsMatch(t, entityIDs(stored), entityIDs(got))
+		for i := 1; i < len(got); i++ {
+			prev, cur := got[i-1].SubmittedAt, got[i].SubmittedAt
+			if desc {
+				prev, cur = cur, prev
+			}
+			assert.False(t, cur.Before(prev))
+		}
+	}
+}
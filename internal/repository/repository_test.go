@@ -6,13 +6,57 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/usecase"
 )
 
 type (
 	propertyRepo = usecase.PropertyRepo
 	tenantRepo   = usecase.TenantRepo
+	leaseRepo    interface {
+		usecase.LeaseRepo
+		propertyRepo
+		tenantRepo
+	}
+	ledgerRepo interface {
+		usecase.LedgerRepo
+		leaseRepo
+	}
+	lateFeeRepo interface {
+		usecase.LateFeeRepo
+		leaseRepo
+	}
+	depositRepo interface {
+		usecase.DepositRepo
+		leaseRepo
+	}
+	applicationRepo interface {
+		usecase.ApplicationRepo
+		propertyRepo
+		tenantRepo
+	}
+	listingRepo interface {
+		usecase.ListingRepo
+		propertyRepo
+	}
+	outboxRepo interface {
+		usecase.OutboxRepo
+		listingRepo
+	}
+	unitRepo interface {
+		usecase.UnitRepo
+		leaseRepo
+	}
+	webhookRepo interface {
+		usecase.WebhookRepo
+	}
+	auditRepo interface {
+		usecase.AuditRepo
+		propertyRepo
+		tenantRepo
+	}
 )
 
 var ctx = context.Background()
@@ -39,3 +83,39 @@ func assertEntityInSet[T entity.Entity](t testing.TB, id entity.ID, set ...T) {
 	}
 	t.Fatalf("entityID '%s' not found in set of length %d", id, len(set))
 }
+func assertEntityNotInSet[T entity.Entity](t testing.TB, id entity.ID, set ...T) {
+	t.Helper()
+	for _, got := range set {
+		if got.GetID() == id {
+			t.Fatalf("entityID '%s' found in set of length %d", id, len(set))
+		}
+	}
+}
+
+// readPages lists every page after the first one until a page is not full
+// and returns the rows of all of them
+func readPages[T entity.Entity](t *testing.T, first filters.Page, value filters.SortValue[T], list func(filters.Page) ([]T, error)) []T {
+	t.Helper()
+	var (
+		all  []T
+		page = first
+	)
+	for {
+		rows, err := list(page)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(rows), first.Size)
+		all = append(all, rows...)
+		cursor := filters.NextCursor(rows, page, value)
+		if cursor == "" {
+			return all
+		}
+		page = page.WithCursor(cursor)
+	}
+}
+func entityIDs[T entity.Entity](list []T) []entity.ID {
+	var ids = make([]entity.ID, len(list))
+	for i, e := range list {
+		ids[i] = e.GetID()
+	}
+	return ids
+}
@@ -0,0 +1,104 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+func testScreening(t *testing.T, r applicationRepo) {
+	var (
+		property = fake.Property()
+		noPets   = entity.NewScreeningRule(entity.CriterionNoPets, entity.ScreeningActionFail)
+		cars     = entity.NewScreeningRule(entity.CriterionMaxVehicles, entity.ScreeningActionReview).WithLimit(1)
+		income   = entity.NewScreeningRule(entity.CriterionMinIncome, entity.ScreeningActionReview).WithLimit(300)
+		policy   = entity.NewScreeningPolicy(property.ID, noPets, cars).
+				WithRent(entity.NewMoney(125000, entity.CurrencyUSD))
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+
+	_, err := r.GetScreeningPolicy(ctx, property.ID)
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+	require.NoError(t, r.StoreScreeningPolicy(ctx, policy))
+	got, err := r.GetScreeningPolicy(ctx, property.ID)
+	require.NoError(t, err)
+	assert.True(t, policy.Equal(*got))
+
+	// rules are replaced
+	policy = policy.WithRule(income)
+	policy.Rules = policy.Rules[1:]
+	require.NoError(t, r.StoreScreeningPolicy(ctx, policy))
+	got, err = r.GetScreeningPolicy(ctx, property.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.ScreeningRule{cars, income}, got.Rules)
+
+	// income is stored with the applicant
+	ap := fake.Applicant()
+	ap.VehicleCount = 2
+	ap.MonthlyIncome = entity.NewMoney(100000, entity.CurrencyUSD)
+	app := entity.NewRentalApplication(property.ID, ap).WithMoveInDate(fake.RentalApplication(property.ID).MoveInDate)
+	require.NoError(t, r.StoreRentalApplication(ctx, app))
+	byApp := filters.NewScreeningReportFilter().WithApplicationID(app.ID)
+	stored, err := r.GetRentalApplication(ctx, app.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ap.MonthlyIncome, stored.Applicants[0].MonthlyIncome)
+
+	reports, err := r.ListScreeningReports(ctx, byApp)
+	require.NoError(t, err)
+	assert.Len(t, reports, 0)
+
+	report := got.Evaluate(*stored)
+	require.Len(t, report.Findings, 2)
+	require.NoError(t, r.AddScreeningReport(ctx, report))
+	assert.ErrorIs(t, r.AddScreeningReport(ctx, report), internal.ErrConflict)
+
+	reports, err = r.ListScreeningReports(ctx, byApp)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, report.ID, reports[0].ID)
+	assert.Equal(t, entity.ScreeningNeedsReview, reports[0].Result)
+	assert.Equal(t, report.Rent, reports[0].Rent)
+	assert.Equal(t, report.Rules, reports[0].Rules)
+	assert.Equal(t, report.Findings, reports[0].Findings)
+	assert.False(t, reports[0].CreatedAt.IsZero())
+}
+func testListScreeningReportsPage(t *testing.T, r applicationRepo) {
+	var (
+		property = fake.Property()
+		app      = fake.RentalApplication(property.ID)
+		policy   = entity.NewScreeningPolicy(property.ID,
+			entity.NewScreeningRule(entity.CriterionNoPets, entity.ScreeningActionFail))
+		stored []entity.ScreeningReport
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	require.NoError(t, r.StoreRentalApplication(ctx, app))
+	for i := 0; i < 3; i++ {
+		report := policy.Evaluate(app)
+		require.NoError(t, r.AddScreeningReport(ctx, report))
+		stored = append(stored, report)
+	}
+	var (
+		f    = filters.NewScreeningReportFilter().WithApplicationID(app.ID)
+		list = func(p filters.Page) ([]entity.ScreeningReport, error) {
+			return r.ListScreeningReports(ctx, f.WithPage(p))
+		}
+	)
+	for _, desc := range []bool{false, true} {
+		got := readPages(t, filters.NewPage().WithSort(filters.SortCreatedAt, desc).WithSize(2), filters.ScreeningReportSortValue, list)
+		require.Len(t, got, len(stored))
+		assert.ElementsMatch(t, entityIDs(stored), entityIDs(got))
+		for i := 1; i < len(got); i++ {
+			prev, cur := got[i-1].CreatedAt, got[i].CreatedAt
+			if desc {
+				prev, cur = cur, prev
+			}
+			assert.False(t, cur.Before(prev))
+		}
+	}
+}
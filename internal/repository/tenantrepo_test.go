@@ -2,12 +2,15 @@ package repository_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/entity/fake"
 	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/schedule"
 )
 
 func testTenant(t *testing.T, driver tenantRepo) {
@@ -52,3 +55,123 @@ func testTenant(t *testing.T, driver tenantRepo) {
 	require.NotNil(t, out1b)
 	require.True(t, out1b.Equal(in1b))
 }
+func testRemoveTenant(t *testing.T, driver tenantRepo) {
+	in := fake.Tenant()
+	require.NoError(t, driver.StoreTenant(ctx, in))
+
+	// only a removed tenant can be restored
+	require.ErrorIs(t, driver.RestoreTenant(ctx, in.ID), internal.ErrEntityNotFound)
+
+	require.NoError(t, driver.DeleteTenant(ctx, in.ID))
+	_, err := driver.GetTenant(ctx, in.ID)
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	list, err := driver.ListTenants(ctx)
+	require.NoError(t, err)
+	assertEntityNotInSet(t, in.ID, list...)
+	list, err = driver.ListTenants(ctx, filters.NewTenantFilter().WithDeleted().WithIDs(in.ID))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.True(t, list[0].Deleted())
+
+	require.NoError(t, driver.RestoreTenant(ctx, in.ID))
+	out, err := driver.GetTenant(ctx, in.ID)
+	require.NoError(t, err)
+	require.True(t, out.Equal(in))
+
+	// purge
+	require.NoError(t, driver.DeleteTenant(ctx, in.ID))
+	n, err := driver.PurgeTenants(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, n, 1)
+	list, err = driver.ListTenants(ctx, filters.NewTenantFilter().WithDeleted().WithIDs(in.ID))
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
+func testListTenantsFilter(t *testing.T, driver tenantRepo) {
+	var (
+		dob = schedule.NewDate(1985, 6, 15)
+		in1 = entity.NewTenant("Alice Quartermaine", dob).
+			WithPhone(entity.NewPhone("555-201-0001"))
+		in2 = entity.NewTenant("Bob Quartermaine", dob.AddDate(10, 0, 0)).
+			WithPhone(entity.NewPhone("(555) 201-0002"))
+		in3 = entity.NewTenant("Carol Smith", dob.AddDate(-10, 0, 0))
+		ids = filters.NewTenantFilter().WithIDs(in1.ID, in2.ID, in3.ID)
+	)
+	in1.DLState, in2.DLState, in3.DLState = "TX", "tx", "OK"
+	for _, in := range []entity.Tenant{in1, in2, in3} {
+		require.NoError(t, driver.StoreTenant(ctx, in))
+	}
+
+	tests := map[string]struct {
+		filter filters.TenantFilter
+		expect []entity.ID
+	}{
+		"ids":          {ids, []entity.ID{in1.ID, in2.ID, in3.ID}},
+		"some ids":     {filters.NewTenantFilter().WithIDs(in1.ID, in3.ID), []entity.ID{in1.ID, in3.ID}},
+		"name":         {ids.WithName("quarter"), []entity.ID{in1.ID, in2.ID}},
+		"full name":    {ids.WithName("carol smith"), []entity.ID{in3.ID}},
+		"phone":        {ids.WithPhone("555-201-0002"), []entity.ID{in2.ID}},
+		"phone digits": {ids.WithPhone("5552010001"), []entity.ID{in1.ID}},
+		"dl state":     {ids.WithDLState("Tx"), []entity.ID{in1.ID, in2.ID}},
+		"dob range":    {ids.WithDOB(dob, dob.AddDate(10, 0, 0)), []entity.ID{in1.ID, in2.ID}},
+		"dob from":     {ids.WithDOB(dob.Next(), schedule.Date{}), []entity.ID{in2.ID}},
+		"dob until":    {ids.WithDOB(schedule.Date{}, dob), []entity.ID{in1.ID, in3.ID}},
+		"combined":     {ids.WithName("quarter").WithDOB(schedule.Date{}, dob), []entity.ID{in1.ID}},
+		"none":         {ids.WithName("nobody"), nil},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			list, err := driver.ListTenants(ctx, tc.filter)
+			require.NoError(t, err)
+			var got []entity.ID
+			for _, tenant := range list {
+				got = append(got, tenant.ID)
+			}
+			assert.ElementsMatch(t, tc.expect, got)
+		})
+	}
+}
+func testListTenantsPage(t *testing.T, driver tenantRepo) {
+	var (
+		dob    = schedule.NewDate(1990, 3, 1)
+		names  = []string{"erin Page", "Dana Page", "carl Page", "Bea Page", "ada Page"}
+		stored []entity.Tenant
+	)
+	for i, name := range names {
+		in := entity.NewTenant(name, dob.AddDate(i%2, 0, 0))
+		require.NoError(t, driver.StoreTenant(ctx, in))
+		stored = append(stored, in)
+	}
+	var (
+		ids    = filters.NewTenantFilter().WithIDs(entityIDs(stored)...)
+		byName = []entity.ID{stored[4].ID, stored[3].ID, stored[2].ID, stored[1].ID, stored[0].ID}
+		list   = func(f filters.TenantFilter) func(filters.Page) ([]entity.Tenant, error) {
+			return func(p filters.Page) ([]entity.Tenant, error) {
+				return driver.ListTenants(ctx, f.WithPage(p))
+			}
+		}
+	)
+
+	t.Run("name", func(t *testing.T) {
+		got := readPages(t, filters.NewPage().WithSort(filters.SortName, false).WithSize(2), filters.TenantSortValue, list(ids))
+		assert.Equal(t, byName, entityIDs(got))
+	})
+	t.Run("name desc", func(t *testing.T) {
+		got := readPages(t, filters.NewPage().WithSort(filters.SortName, true).WithSize(2), filters.TenantSortValue, list(ids))
+		assert.Equal(t, []entity.ID{stored[0].ID, stored[1].ID, stored[2].ID, stored[3].ID, stored[4].ID}, entityIDs(got))
+	})
+	t.Run("created", func(t *testing.T) {
+		got := readPages(t, filters.NewPage().WithSize(2), filters.TenantSortValue, list(ids))
+		require.Len(t, got, len(stored))
+		assert.ElementsMatch(t, entityIDs(stored), entityIDs(got))
+		for i := 1; i < len(got); i++ {
+			assert.False(t, got[i].CreatedAt.Before(got[i-1].CreatedAt))
+		}
+	})
+	t.Run("date of birth", func(t *testing.T) {
+		// the date of birth is filtered after the query so pages must still be full
+		f := ids.WithDOB(dob, dob)
+		got := readPages(t, filters.NewPage().WithSort(filters.SortName, false).WithSize(1), filters.TenantSortValue, list(f))
+		assert.Equal(t, []entity.ID{stored[4].ID, stored[2].ID, stored[0].ID}, entityIDs(got))
+	})
+}
@@ -0,0 +1,110 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/schedule"
+)
+
+func testUnit(t *testing.T, r unitRepo) {
+	var (
+		property = fake.Property()
+		tenant   = fake.Tenant()
+		rent     = entity.NewMoney(145000, entity.CurrencyUSD)
+		unitA    = entity.NewUnit(property.ID, "A").WithRooms(2, 1.5).WithSqFt(900).WithRentTarget(rent)
+		unitB    = entity.NewUnit(property.ID, "B")
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	require.NoError(t, r.StoreTenant(ctx, tenant))
+
+	// the property was stored along with its default unit, only once
+	require.NoError(t, r.StoreProperty(ctx, property))
+	list, err := r.ListUnits(ctx, property.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	first := list[0]
+	assert.True(t, entity.DefaultUnit(property).WithID(first.ID).Equal(first))
+
+	_, err = r.GetUnit(ctx, unitA.ID)
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+	require.NoError(t, r.StoreUnit(ctx, unitB))
+	require.NoError(t, r.StoreUnit(ctx, unitA))
+	got, err := r.GetUnit(ctx, unitA.ID)
+	require.NoError(t, err)
+	assert.True(t, unitA.Equal(*got), "got %+v\nwant %+v", *got, unitA)
+
+	// the number is unique within the property
+	assert.ErrorIs(t, r.StoreUnit(ctx, entity.NewUnit(property.ID, "A")), internal.ErrConflict)
+
+	unitA = unitA.WithRooms(3, 2)
+	require.NoError(t, r.StoreUnit(ctx, unitA))
+	list, err = r.ListUnits(ctx, property.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	assert.Equal(t, first.ID, list[0].ID, "ordered by number")
+	assert.True(t, unitA.Equal(list[1]))
+	assert.True(t, unitB.Equal(list[2]))
+
+	// leases keep their unit
+	lease := fake.Lease(property.ID, tenant.ID).WithUnit(unitA.ID)
+	whole := fake.Lease(property.ID, tenant.ID).WithTerm(lease.EndDate.Next(), lease.EndDate.AddDate(1, 0, 0))
+	require.NoError(t, r.StoreLeaseVersion(ctx, entity.NewLeaseVersion(lease)))
+	require.NoError(t, r.StoreLease(ctx, whole))
+	leases, err := r.ListLeases(ctx, filters.NewLeaseFilter().WithUnitID(unitA.ID))
+	require.NoError(t, err)
+	require.Len(t, leases, 1)
+	assert.True(t, lease.Equal(leases[0]))
+	assert.Equal(t, unitA.ID, leases[0].UnitID)
+	versions, err := r.ListLeaseVersions(ctx, lease.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, unitA.ID, versions[0].Terms.UnitID)
+	got2, err := r.GetLease(ctx, whole.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got2.UnitID, "the whole property was leased")
+
+	require.NoError(t, r.DeleteUnit(ctx, unitB.ID))
+	_, err = r.GetUnit(ctx, unitB.ID)
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound)
+	list, err = r.ListUnits(ctx, property.ID)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+}
+
+// testUnitOverlap is for repositories which enforce the lease term in storage,
+// a lease of the whole property overlaps the leases of each of its units
+func testUnitOverlap(t *testing.T, r unitRepo) {
+	var (
+		property = fake.Property()
+		tenant   = fake.Tenant()
+		unitA    = entity.NewUnit(property.ID, "A")
+		unitB    = entity.NewUnit(property.ID, "B")
+	)
+	require.NoError(t, r.StoreProperty(ctx, property))
+	require.NoError(t, r.StoreTenant(ctx, tenant))
+	require.NoError(t, r.StoreUnit(ctx, unitA))
+	require.NoError(t, r.StoreUnit(ctx, unitB))
+
+	leaseA := fake.Lease(property.ID, tenant.ID).WithUnit(unitA.ID)
+	require.NoError(t, r.StoreLease(ctx, leaseA))
+	leaseB := fake.Lease(property.ID, tenant.ID).WithUnit(unitB.ID).WithTerm(leaseA.StartDate, leaseA.EndDate)
+	require.NoError(t, r.StoreLease(ctx, leaseB), "another unit is leased on its own")
+
+	whole := fake.Lease(property.ID, tenant.ID).WithTerm(leaseA.EndDate, schedule.Date{})
+	require.ErrorIs(t, r.StoreLease(ctx, whole), internal.ErrConflict)
+	_, err := r.GetLease(ctx, whole.ID)
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+	// and a unit is not leased during a lease of the whole property
+	whole = whole.WithTerm(leaseA.EndDate.Next(), leaseA.EndDate.AddDate(1, 0, 0))
+	require.NoError(t, r.StoreLease(ctx, whole))
+	late := fake.Lease(property.ID, tenant.ID).WithUnit(unitB.ID).WithTerm(whole.EndDate, schedule.Date{})
+	require.ErrorIs(t, r.StoreLease(ctx, late), internal.ErrConflict)
+}
@@ -0,0 +1,126 @@
+package repository_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+func testWebhooks(t *testing.T, r webhookRepo) {
+	var (
+		now = time.Now().Truncate(time.Microsecond)
+		w1  = fake.Webhook("rental.added", "lease.renewed")
+		w2  = fake.Webhook()
+	)
+	w1.CreatedAt, w2.CreatedAt = now, now.Add(time.Second)
+	require.NoError(t, r.StoreWebhook(ctx, w1))
+	require.NoError(t, r.StoreWebhook(ctx, w2))
+
+	got, err := r.GetWebhook(ctx, w1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, w1.URL, got.URL)
+	assert.Equal(t, w1.Events, got.Events)
+	assert.Equal(t, w1.Secret, got.Secret)
+	assertTimestampMatch(t, now, got.CreatedAt)
+
+	got, err = r.GetWebhook(ctx, w2.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Events)
+
+	// replaced in place
+	w1.URL = "https://example.com/moved"
+	w1.Events = []string{"tenant.added"}
+	require.NoError(t, r.StoreWebhook(ctx, w1))
+	got, err = r.GetWebhook(ctx, w1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, w1.URL, got.URL)
+	assert.Equal(t, w1.Events, got.Events)
+
+	list, err := r.ListWebhooks(ctx)
+	require.NoError(t, err)
+	assertEntityInSet(t, w1.ID, list...)
+	assertEntityInSet(t, w2.ID, list...)
+
+	require.NoError(t, r.DeleteWebhook(ctx, w2.ID))
+	_, err = r.GetWebhook(ctx, w2.ID)
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound)
+}
+
+func testWebhookDeliveries(t *testing.T, r webhookRepo) {
+	var (
+		now     = time.Now().Truncate(time.Microsecond)
+		w       = fake.Webhook()
+		payload = []byte(`{"event": "rental.added"}`)
+		d1      = entity.NewWebhookDelivery(w.ID, "rental.added", payload, now)
+		d2      = entity.NewWebhookDelivery(w.ID, "rental.added", payload, now.Add(time.Second))
+	)
+	require.NoError(t, r.StoreWebhook(ctx, w))
+	require.NoError(t, r.StoreWebhookDelivery(ctx, d1))
+	require.NoError(t, r.StoreWebhookDelivery(ctx, d2))
+
+	// claimed deliveries are not due again until they are unlocked
+	at := now.Add(time.Minute)
+	claimed, err := r.ClaimWebhookDeliveries(ctx, at, at.Add(time.Minute), 1000)
+	require.NoError(t, err)
+	assertEntityInSet(t, d1.ID, claimed...)
+	assertEntityInSet(t, d2.ID, claimed...)
+	claimed, err = r.ClaimWebhookDeliveries(ctx, at, at.Add(time.Minute), 1000)
+	require.NoError(t, err)
+	for _, d := range claimed {
+		assert.NotContains(t, []string{d1.ID, d2.ID}, d.ID)
+	}
+
+	delivered := d1.Delivered(http.StatusOK, at)
+	failed := d2.Failed(http.StatusBadGateway, assert.AnError, at.Add(time.Hour))
+	require.NoError(t, r.StoreWebhookDelivery(ctx, delivered))
+	require.NoError(t, r.StoreWebhookDelivery(ctx, failed))
+
+	got, err := r.GetWebhookDelivery(ctx, delivered.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.DeliveryDelivered, got.Status)
+	assert.Equal(t, http.StatusOK, got.StatusCode)
+	assert.Equal(t, 1, got.Attempts)
+	assert.JSONEq(t, string(payload), string(got.Payload))
+	assertTimestampMatch(t, at, got.DeliveredAt)
+
+	got, err = r.GetWebhookDelivery(ctx, failed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.DeliveryPending, got.Status)
+	assert.Equal(t, http.StatusBadGateway, got.StatusCode)
+	assert.Equal(t, assert.AnError.Error(), got.LastError)
+	assertTimestampMatch(t, at.Add(time.Hour), got.NextAttempt)
+
+	again := failed.Redeliver(at)
+	require.NoError(t, r.StoreWebhookDelivery(ctx, again))
+	list, err := r.ListWebhookDeliveries(ctx, filters.NewWebhookDeliveryFilter().WithWebhookID(w.ID))
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	assert.Equal(t, []string{again.ID, d2.ID, d1.ID}, []string{list[0].ID, list[1].ID, list[2].ID},
+		"most recent first")
+	assert.Equal(t, failed.ID, list[0].RedeliveryOf)
+
+	list, err = r.ListWebhookDeliveries(ctx, filters.NewWebhookDeliveryFilter().
+		WithWebhookID(w.ID).
+		WithStatus(entity.DeliveryDelivered))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, d1.ID, list[0].ID)
+
+	_, err = r.GetWebhookDelivery(ctx, uuid.NewString())
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound)
+	err = r.StoreWebhookDelivery(ctx, entity.NewWebhookDelivery(uuid.NewString(), "rental.added", payload, now))
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound, "the webhook must exist")
+
+	// deliveries are deleted along with the webhook
+	require.NoError(t, r.DeleteWebhook(ctx, w.ID))
+	_, err = r.GetWebhookDelivery(ctx, d1.ID)
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound)
+}
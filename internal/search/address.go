@@ -0,0 +1,160 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Tokens splits text into its lower case words, a word is a run of letters
+// and digits so punctuation only separates words: "N. Main St." is n main st
+func Tokens(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Token is the canonical form of an address token, the USPS abbreviation of
+// street suffixes, directionals and unit designators, ex: "street" is st,
+// "north" is n, any other token is returned as it is
+func Token(t string) string {
+	if a, ok := abbreviations[t]; ok {
+		return a
+	}
+	return t
+}
+
+// Normalize returns the tokens of the address in their canonical form
+// separated by a space, "1234 North Main Street, #5" is "1234 n main st 5"
+func Normalize(s string) string {
+	tokens := Tokens(s)
+	for i, t := range tokens {
+		tokens[i] = Token(t)
+	}
+	return strings.Join(tokens, " ")
+}
+
+// addressToken is true for the tokens Token knows, they are directionals,
+// street suffixes and unit designators which are only matched whole
+func addressToken(t string) bool {
+	_, ok := abbreviations[t]
+	return ok
+}
+
+// abbreviations is built from the canonical form of each token to the other
+// spellings of it found in addresses, most are from USPS publication 28
+var abbreviations = func() map[string]string {
+	var (
+		forms = map[string][]string{
+			// directionals
+			"n":  {"north"},
+			"s":  {"south"},
+			"e":  {"east"},
+			"w":  {"west"},
+			"ne": {"northeast"},
+			"nw": {"northwest"},
+			"se": {"southeast"},
+			"sw": {"southwest"},
+
+			// street suffixes
+			"aly":  {"alley", "allee", "ally"},
+			"ave":  {"avenue", "av", "aven", "avenu", "avn", "avnue"},
+			"blvd": {"boulevard", "boul", "boulv"},
+			"bnd":  {"bend"},
+			"brg":  {"bridge", "brdge"},
+			"brk":  {"brook"},
+			"byp":  {"bypass", "bypa", "bypas", "byps"},
+			"cir":  {"circle", "circ", "circl", "crcl", "crcle"},
+			"clf":  {"cliff"},
+			"cres": {"crescent", "crsent", "crsnt"},
+			"crk":  {"creek"},
+			"cswy": {"causeway", "causwa"},
+			"ct":   {"court"},
+			"cts":  {"courts"},
+			"ctr":  {"center", "centre", "cen", "cent", "centr", "cnter", "cntr"},
+			"cv":   {"cove"},
+			"cyn":  {"canyon", "canyn", "cnyn"},
+			"dr":   {"drive", "driv", "drv"},
+			"est":  {"estate"},
+			"ests": {"estates"},
+			"expy": {"expressway", "exp", "expr", "express", "expw"},
+			"ext":  {"extension", "extn", "extnsn"},
+			"fld":  {"field"},
+			"flds": {"fields"},
+			"frk":  {"fork"},
+			"frst": {"forest", "forests"},
+			"fwy":  {"freeway", "frway", "frwy"},
+			"gdn":  {"garden", "gardn", "grden", "grdn"},
+			"gdns": {"gardens", "grdns"},
+			"gln":  {"glen"},
+			"grn":  {"green"},
+			"grv":  {"grove", "grov"},
+			"gtwy": {"gateway", "gatewy", "gatway", "gtway"},
+			"hbr":  {"harbor", "harb", "harbr", "hrbor"},
+			"hl":   {"hill"},
+			"hls":  {"hills"},
+			"hts":  {"heights", "ht"},
+			"hwy":  {"highway", "highwy", "hiway", "hiwy", "hway"},
+			"is":   {"island", "islnd"},
+			"jct":  {"junction", "jction", "jctn", "junctn", "juncton"},
+			"lk":   {"lake"},
+			"lks":  {"lakes"},
+			"ln":   {"lane"},
+			"lndg": {"landing", "lndng"},
+			"loop": {"loops"},
+			"mdws": {"meadows", "mdw", "medows"},
+			"ml":   {"mill"},
+			"mnr":  {"manor"},
+			"mt":   {"mount", "mnt"},
+			"mtn":  {"mountain", "mntain", "mntn", "mountin", "mtin"},
+			"orch": {"orchard", "orchrd"},
+			"pkwy": {"parkway", "parkwy", "pkway", "pky", "parkways", "pkwys"},
+			"pl":   {"place"},
+			"pln":  {"plain"},
+			"plns": {"plains"},
+			"plz":  {"plaza", "plza"},
+			"pne":  {"pine"},
+			"pnes": {"pines"},
+			"pr":   {"prairie", "prr"},
+			"pt":   {"point"},
+			"rd":   {"road"},
+			"rdg":  {"ridge", "rdge"},
+			"rnch": {"ranch", "ranches", "rnchs"},
+			"rte":  {"route"},
+			"shr":  {"shore"},
+			"shrs": {"shores"},
+			"smt":  {"summit", "sumit", "sumitt"},
+			"spg":  {"spring", "spng", "sprng"},
+			"spgs": {"springs", "spngs", "sprngs"},
+			"sq":   {"square", "sqr", "sqre", "squ"},
+			"st":   {"street", "strt", "str"},
+			"sta":  {"station", "statn", "stn"},
+			"ter":  {"terrace", "terr"},
+			"tpke": {"turnpike", "trnpk", "turnpk"},
+			"trce": {"trace", "traces"},
+			"trl":  {"trail", "trails", "trls"},
+			"tunl": {"tunnel", "tunel", "tunls", "tunnels", "tunnl"},
+			"vis":  {"vista", "vist", "vst", "vsta"},
+			"vlg":  {"village", "vill", "villag", "villg", "villiage"},
+			"vly":  {"valley", "vally", "vlly"},
+			"vw":   {"view"},
+			"xing": {"crossing", "crssng"},
+
+			// unit designators
+			"apt":  {"apartment"},
+			"bldg": {"building"},
+			"dept": {"department"},
+			"fl":   {"floor"},
+			"rm":   {"room"},
+			"ste":  {"suite"},
+			"unit": {},
+		}
+		m = make(map[string]string)
+	)
+	for canonical, spellings := range forms {
+		m[canonical] = canonical
+		for _, s := range spellings {
+			m[s] = canonical
+		}
+	}
+	return m
+}()
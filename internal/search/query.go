@@ -0,0 +1,92 @@
+package search
+
+import (
+	"math"
+	"strings"
+)
+
+// FuzzyThreshold is the least WordSimilarity of a fuzzy match, it is the
+// default pg_trgm.word_similarity_threshold which the <% operator uses
+const FuzzyThreshold = 0.6
+
+// Query is a search of addresses, a document matches when every term of the
+// query matches one of its tokens, when nothing matches the search is fuzzy
+// and documents similar to the text of the query are found instead
+type Query struct {
+	Text  string // the normalized search, documents are ranked by their similarity to it
+	Terms []Term
+}
+
+// Term is a token of a Query, a term which is not an address token also
+// matches tokens it is the start of, so "dall" finds Dallas but "n" is only n
+type Term struct {
+	Token  string
+	Prefix bool
+}
+
+// Parse reads a search the way a user types it, the text is normalized so
+// "N Main Street" and "North Main St." are the same query
+func Parse(s string) Query {
+	var (
+		q    Query
+		seen = make(map[string]bool)
+	)
+	for _, t := range Tokens(s) {
+		t = Token(t)
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		q.Terms = append(q.Terms, Term{Token: t, Prefix: !addressToken(t)})
+	}
+	q.Text = Normalize(s)
+	return q
+}
+
+// Empty is true when the search has no words, everything matches it
+func (q Query) Empty() bool {
+	return len(q.Terms) == 0
+}
+
+// TSQuery is the query in the syntax of postgres to_tsquery, ex: main:* & n & st
+func (q Query) TSQuery() string {
+	terms := make([]string, len(q.Terms))
+	for i, t := range q.Terms {
+		terms[i] = t.Token
+		if t.Prefix {
+			terms[i] += ":*"
+		}
+	}
+	return strings.Join(terms, " & ")
+}
+
+// Match is true when every term matches a token of the normalized document
+func (q Query) Match(doc string) bool {
+	tokens := strings.Fields(doc)
+	for _, t := range q.Terms {
+		if !t.match(tokens) {
+			return false
+		}
+	}
+	return true
+}
+
+// Similar is true when the normalized document is a fuzzy match of the query
+func (q Query) Similar(doc string) bool {
+	return WordSimilarity(q.Text, doc) >= FuzzyThreshold
+}
+
+// Rank is the relevance of the normalized document to the query, its
+// WordSimilarity rounded to 4 decimals the way the postgres repository does
+func (q Query) Rank(doc string) float64 {
+	return math.Round(WordSimilarity(q.Text, doc)*1e4) / 1e4
+}
+
+func (t Term) match(tokens []string) bool {
+	for _, token := range tokens {
+		if token == t.Token || (t.Prefix && strings.HasPrefix(token, t.Token)) {
+			return true
+		}
+	}
+	return false
+}
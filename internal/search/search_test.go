@@ -0,0 +1,77 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tempcke/rpm/internal/search"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]struct {
+		addr, want string
+	}{
+		"suffix and directional":  {"1234 North Main Street", "1234 n main st"},
+		"abbreviations unchanged": {"1234 N. Main St.", "1234 n main st"},
+		"unit designator":         {"55 Elm Avenue, Apartment #5", "55 elm ave apt 5"},
+		"punctuation separates":   {"Dallas,TX 75001-1234", "dallas tx 75001 1234"},
+		"other words kept":        {"Mount Vernon, NY", "mt vernon ny"},
+		"empty":                   {" ., ", ""},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, search.Normalize(tc.addr))
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	var doc = search.Normalize("1234 North Main Street, Dallas, TX 75001")
+
+	t.Run("terms", func(t *testing.T) {
+		q := search.Parse("N. main st, Dall")
+		assert.Equal(t, "n main st dall", q.Text)
+		assert.Equal(t, "n & main:* & st & dall:*", q.TSQuery())
+		assert.False(t, q.Empty())
+		assert.True(t, search.Parse(" , ").Empty())
+	})
+	t.Run("match", func(t *testing.T) {
+		for _, s := range []string{
+			"north main street",
+			"N Main St",
+			"main dallas",
+			"dall 750",
+			"MAIN, TX",
+		} {
+			assert.True(t, search.Parse(s).Match(doc), s)
+		}
+		for _, s := range []string{
+			"south main st",  // another directional
+			"main ave",       // another suffix
+			"main s",         // address tokens are not prefixes
+			"main st austin", // every term must match
+		} {
+			assert.False(t, search.Parse(s).Match(doc), s)
+		}
+	})
+	t.Run("fuzzy", func(t *testing.T) {
+		for _, s := range []string{"Maine St Dallas", "Dalas", "main stret"} {
+			assert.True(t, search.Parse(s).Similar(doc), s)
+			assert.False(t, search.Parse(s).Match(doc), s)
+		}
+		assert.False(t, search.Parse("elm ave houston").Similar(doc))
+	})
+	t.Run("rank", func(t *testing.T) {
+		q := search.Parse("main st")
+		assert.Equal(t, 1.0, q.Rank(doc))
+		assert.Greater(t, q.Rank(doc), q.Rank(search.Normalize("12 Mainland Street, Austin")))
+		assert.Greater(t, search.Parse("dall").Rank(doc), search.Parse("dal").Rank(doc))
+	})
+}
+
+func TestWordSimilarity(t *testing.T) {
+	// values documented by pg_trgm
+	assert.InDelta(t, 0.8, search.WordSimilarity("word", "two words"), 1e-6)
+	assert.InDelta(t, 0.0, search.WordSimilarity("", "two words"), 1e-6)
+	assert.InDelta(t, 1.0, search.WordSimilarity("two", "two words"), 1e-6)
+}
@@ -0,0 +1,50 @@
+package search
+
+// WordSimilarity is the greatest similarity of the trigrams of a to those of
+// any continuous extent of the trigrams of b, it follows the definition of
+// word_similarity in the pg_trgm extension of postgres, which finds the
+// extent with a heuristic, so the two may rarely differ in the last decimals
+func WordSimilarity(a, b string) float64 {
+	var (
+		want = make(map[string]bool)
+		seq  = trigrams(b)
+		best float64
+	)
+	for _, t := range trigrams(a) {
+		want[t] = true
+	}
+	if len(want) == 0 {
+		return 0
+	}
+	for i := range seq {
+		var (
+			extent = make(map[string]bool)
+			shared int
+		)
+		for _, t := range seq[i:] {
+			if !extent[t] {
+				extent[t] = true
+				if want[t] {
+					shared++
+				}
+			}
+			if sim := float64(shared) / float64(len(want)+len(extent)-shared); sim > best {
+				best = sim
+			}
+		}
+	}
+	return best
+}
+
+// trigrams of each word of s in order, a word is padded with two spaces
+// before it and one after it the way pg_trgm does, so "st" is "  s", " st", "st "
+func trigrams(s string) []string {
+	var list []string
+	for _, w := range Tokens(s) {
+		r := []rune("  " + w + " ")
+		for i := 0; i+3 <= len(r); i++ {
+			list = append(list, string(r[i:i+3]))
+		}
+	}
+	return list
+}
@@ -0,0 +1,35 @@
+package test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/lib/crypt"
+)
+
+// PIIKeys loads the key file named by PII_KEY_FILE, the same keys the app
+// running in docker uses so both can read what the other stored
+func PIIKeys(t testing.TB) crypt.KeyRing {
+	t.Helper()
+	path := findFile(Config().GetString(internal.EnvPIIKeyFile))
+	require.NotEmpty(t, path, internal.EnvPIIKeyFile+" not found, run `make init`")
+	keys, err := crypt.LoadKeyFile(path)
+	require.NoError(t, err)
+	return keys
+}
+
+func findFile(relPath string) string {
+	if relPath == "" {
+		return ""
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := os.Stat(relPath); err == nil {
+			return relPath
+		}
+		relPath = fmt.Sprintf("../%s", relPath)
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+// Package webhook posts webhook deliveries, signing every request body with
+// the secret of the webhook so the receiver can verify it came from us
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tempcke/rpm/entity"
+)
+
+const (
+	HeaderEvent     = "X-RPM-Event"
+	HeaderDelivery  = "X-RPM-Delivery"
+	HeaderSignature = "X-RPM-Signature-256"
+
+	signaturePrefix = "sha256="
+	timeout         = 10 * time.Second
+)
+
+// Client posts deliveries, a response with a status other than 2xx fails the delivery
+type Client struct {
+	http *http.Client
+}
+
+// NewClient using c, or a client which gives up after 10 seconds when c is nil
+func NewClient(c *http.Client) Client {
+	if c == nil {
+		c = &http.Client{Timeout: timeout}
+	}
+	return Client{http: c}
+}
+
+// Send the delivery to the webhook, the status code is zero when the
+// receiver did not answer
+func (c Client) Send(ctx context.Context, w entity.Webhook, d entity.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "rpm-webhook")
+	req.Header.Set(HeaderEvent, d.Event)
+	req.Header.Set(HeaderDelivery, d.ID)
+	req.Header.Set(HeaderSignature, Sign(w.Secret, d.Payload))
+	res, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("webhook responded with %d %s", res.StatusCode, http.StatusText(res.StatusCode))
+	}
+	return res.StatusCode, nil
+}
+
+// Sign the body with the secret, the value of the X-RPM-Signature-256 header
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify the signature of the body is the one the secret makes
+func Verify(secret string, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
@@ -0,0 +1,76 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal/webhook"
+)
+
+func TestClient_Send(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		payload  = []byte(`{"event":"rental.added"}`)
+		got      *http.Request
+		body     []byte
+		code     = http.StatusNoContent
+		receiver = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(code)
+		}))
+		c = webhook.NewClient(receiver.Client())
+		w = fake.Webhook()
+		d = entity.NewWebhookDelivery(w.ID, "rental.added", payload, time.Now())
+	)
+	defer receiver.Close()
+	w.URL = receiver.URL + "/hooks"
+
+	status, err := c.Send(ctx, w, d)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+	require.NotNil(t, got)
+	assert.Equal(t, http.MethodPost, got.Method)
+	assert.Equal(t, "/hooks", got.URL.Path)
+	assert.Equal(t, "application/json", got.Header.Get("Content-Type"))
+	assert.Equal(t, "rental.added", got.Header.Get(webhook.HeaderEvent))
+	assert.Equal(t, d.ID, got.Header.Get(webhook.HeaderDelivery))
+	assert.Equal(t, payload, body)
+	assert.True(t, webhook.Verify(w.Secret, body, got.Header.Get(webhook.HeaderSignature)))
+
+	t.Run("receiver fails", func(t *testing.T) {
+		code = http.StatusServiceUnavailable
+		status, err := c.Send(ctx, w, d)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+	})
+	t.Run("receiver down", func(t *testing.T) {
+		down := httptest.NewServer(http.NotFoundHandler())
+		down.Close()
+		w := w
+		w.URL = down.URL
+		status, err := c.Send(ctx, w, d)
+		require.Error(t, err)
+		assert.Zero(t, status)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	var (
+		secret = fake.LowerString(24)
+		body   = []byte(`{"event":"tenant.added"}`)
+		sig    = webhook.Sign(secret, body)
+	)
+	assert.True(t, webhook.Verify(secret, body, sig))
+	assert.False(t, webhook.Verify("another secret!!", body, sig))
+	assert.False(t, webhook.Verify(secret, []byte(`{}`), sig))
+	assert.False(t, webhook.Verify(secret, body, sig[len("sha256="):]))
+}
@@ -2,39 +2,193 @@ package specifications
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/internal/test"
 	"github.com/tempcke/rpm/usecase"
+	"github.com/tempcke/schedule"
 )
 
 var ctx = context.Background()
 
 type Driver interface {
 	PropertyDriver
+	TenantDriver
+	LeaseDriver
+	LedgerDriver
+	LateFeeDriver
+	DepositDriver
+	ApplicationDriver
+	ListingDriver
+	OutboxDriver
+	WebhookDriver
+	AuditDriver
+	UnitDriver
 }
 type PropertyDriver interface {
 	StoreProperty(context.Context, entity.Property) (entity.ID, error)
 	GetProperty(context.Context, entity.ID) (*entity.Property, error)
 	ListProperties(context.Context, usecase.PropertyFilter) ([]entity.Property, error)
 	RemoveProperty(context.Context, entity.ID) error
+	RestoreProperty(context.Context, entity.ID) (*entity.Property, error)
 }
 type TenantDriver interface {
 	StoreTenant(context.Context, entity.Tenant) (*entity.Tenant, error)
 	GetTenant(context.Context, entity.ID) (*entity.Tenant, error)
-	ListTenants(context.Context) ([]entity.Tenant, error)
+	ListTenants(context.Context, ...filters.TenantFilter) ([]entity.Tenant, error)
+	PatchTenant(context.Context, entity.ID, entity.TenantPatch) (*entity.Tenant, error)
+	RemoveTenant(context.Context, entity.ID) error
+	RestoreTenant(context.Context, entity.ID) (*entity.Tenant, error)
+	AddTenantPhone(context.Context, entity.ID, entity.Phone) (*entity.Tenant, error)
+	UpdateTenantPhone(ctx context.Context, id entity.ID, number string, phone entity.Phone) (*entity.Tenant, error)
+	RemoveTenantPhone(ctx context.Context, id entity.ID, number string) error
 }
 
-func RunAllTests(t *testing.T, pDriver PropertyDriver, tDriver TenantDriver) {
+// LeaseDriver also needs to store properties and tenants
+// because a lease can only reference ones which exist
+type LeaseDriver interface {
+	PropertyDriver
+	TenantDriver
+	LeaseProperty(context.Context, entity.Lease) (*entity.Lease, error)
+	GetLease(context.Context, entity.ID) (*entity.Lease, error)
+	ListLeases(context.Context, ...filters.LeaseFilter) ([]entity.Lease, error)
+	TerminateLease(ctx context.Context, id entity.ID, endDate schedule.Date, reason string) (*entity.Lease, error)
+	RenewLease(context.Context, entity.ID, entity.LeaseRenewal) (*entity.Lease, error)
+	AmendLease(context.Context, entity.ID, entity.LeaseAmendment) (*entity.Lease, error)
+	GetLeaseHistory(context.Context, entity.ID) (*entity.LeaseHistory, error)
+	GetRentSchedule(context.Context, entity.ID, entity.ScheduleOptions) ([]entity.RentDue, error)
+}
+
+// LedgerDriver needs to lease a property before posting to its ledger
+type LedgerDriver interface {
+	LeaseDriver
+	PostLedgerEntry(context.Context, entity.LedgerEntry) (*entity.LedgerEntry, error)
+	ReverseLedgerEntry(ctx context.Context, leaseID, entryID entity.ID, date schedule.Date, memo string) (*entity.LedgerEntry, error)
+	GetBalance(ctx context.Context, leaseID entity.ID, asOf schedule.Date) (entity.Money, error)
+	GetStatement(ctx context.Context, leaseID entity.ID, from, until schedule.Date) (*entity.Statement, error)
+}
+
+// LateFeeDriver charges late fees to the ledger, asOf must not be after today
+type LateFeeDriver interface {
+	LedgerDriver
+	StoreLateFeePolicy(context.Context, entity.LateFeePolicy) (*entity.LateFeePolicy, error)
+	GetLateFeePolicy(ctx context.Context, leaseID entity.ID) (*entity.LateFeePolicy, error)
+	AssessLateFees(ctx context.Context, leaseID entity.ID, asOf schedule.Date) ([]entity.LateFee, error)
+	ApplyLateFees(ctx context.Context, leaseID entity.ID, asOf schedule.Date) ([]entity.LedgerEntry, error)
+}
+
+// DepositDriver holds the security deposit of a lease until it is disposed at move out
+type DepositDriver interface {
+	LeaseDriver
+	RecordDepositReceipt(context.Context, entity.DepositReceipt) (*entity.DepositReceipt, error)
+	GetDeposit(ctx context.Context, leaseID entity.ID) (*entity.DepositAccount, error)
+	DisposeDeposit(context.Context, entity.DepositDisposition) (*entity.DepositDisposition, error)
+	GetDepositStatement(ctx context.Context, leaseID entity.ID) (string, error)
+}
+
+// ApplicationDriver takes rental applications for a property, screens them
+// against the property screening policy and converts approved ones into tenants
+type ApplicationDriver interface {
+	PropertyDriver
+	TenantDriver
+	SubmitApplication(context.Context, entity.RentalApplication) (*entity.RentalApplication, error)
+	GetApplication(context.Context, entity.ID) (*entity.RentalApplication, error)
+	ListApplications(context.Context, filters.ApplicationFilter) ([]entity.RentalApplication, error)
+	UpdateApplicationStatus(ctx context.Context, id entity.ID, status entity.ApplicationStatus, note string) (*entity.RentalApplication, error)
+	ConvertApplication(context.Context, entity.ID) (*usecase.ApplicationConversion, error)
+	StoreScreeningPolicy(context.Context, entity.ScreeningPolicy) (*entity.ScreeningPolicy, error)
+	GetScreeningPolicy(ctx context.Context, propertyID entity.ID) (*entity.ScreeningPolicy, error)
+	ScreenApplication(ctx context.Context, applicationID entity.ID) (*entity.ScreeningReport, error)
+	ListScreeningReports(context.Context, filters.ScreeningReportFilter) ([]entity.ScreeningReport, error)
+}
+
+// ListingDriver advertises properties, published listings are public
+type ListingDriver interface {
+	PropertyDriver
+	StoreListing(context.Context, entity.Listing) (*entity.Listing, error)
+	GetListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error)
+	PublishListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error)
+	UnpublishListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error)
+	ListPublicListings(context.Context, filters.ListingFilter) ([]usecase.PublicListing, error)
+}
+
+// OutboxDriver inspects the events waiting to be delivered and replays stuck ones
+type OutboxDriver interface {
+	PropertyDriver
+	ListOutbox(context.Context, filters.OutboxFilter) ([]event.Message, error)
+	GetOutboxMessage(ctx context.Context, id string) (*event.Message, error)
+	ReplayOutboxMessage(ctx context.Context, id string) (*event.Message, error)
+}
+
+// WebhookDriver subscribes urls to events and redelivers what was posted to them
+type WebhookDriver interface {
+	StoreWebhook(context.Context, entity.Webhook) (*entity.Webhook, error)
+	GetWebhook(context.Context, entity.ID) (*entity.Webhook, error)
+	ListWebhooks(context.Context) ([]entity.Webhook, error)
+	RemoveWebhook(context.Context, entity.ID) error
+	ListWebhookDeliveries(context.Context, filters.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error)
+	GetWebhookDelivery(context.Context, entity.ID) (*entity.WebhookDelivery, error)
+	RedeliverWebhook(ctx context.Context, deliveryID entity.ID) (*entity.WebhookDelivery, error)
+}
+
+// UnitDriver keeps the units of properties and leases them on their own
+type UnitDriver interface {
+	LeaseDriver
+	StoreUnit(context.Context, entity.Unit) (*entity.Unit, error)
+	GetUnit(ctx context.Context, propertyID, id entity.ID) (*entity.Unit, error)
+	ListUnits(ctx context.Context, propertyID entity.ID) ([]entity.Unit, error)
+	RemoveUnit(ctx context.Context, propertyID, id entity.ID) error
+}
+type AuditDriver interface {
+	PropertyDriver
+	ListAudit(context.Context, filters.AuditFilter) ([]audit.Entry, error)
+}
+
+func RunAllTests(t *testing.T, driver Driver) {
 	t.Run("property", func(t *testing.T) {
-		RunAllPropertyTests(t, pDriver)
+		RunAllPropertyTests(t, driver)
 	})
 	t.Run("tenant", func(t *testing.T) {
-		RunAllTenantTests(t, tDriver)
+		RunAllTenantTests(t, driver)
+	})
+	t.Run("lease", func(t *testing.T) {
+		RunAllLeaseTests(t, driver)
+	})
+	t.Run("ledger", func(t *testing.T) {
+		RunAllLedgerTests(t, driver)
+	})
+	t.Run("late fee", func(t *testing.T) {
+		RunAllLateFeeTests(t, driver)
+	})
+	t.Run("deposit", func(t *testing.T) {
+		RunAllDepositTests(t, driver)
+	})
+	t.Run("application", func(t *testing.T) {
+		RunAllApplicationTests(t, driver)
+	})
+	t.Run("listing", func(t *testing.T) {
+		RunAllListingTests(t, driver)
+	})
+	t.Run("outbox", func(t *testing.T) {
+		RunAllOutboxTests(t, driver)
+	})
+	t.Run("webhook", func(t *testing.T) {
+		RunAllWebhookTests(t, driver)
+	})
+	t.Run("audit", func(t *testing.T) {
+		RunAllAuditTests(t, driver)
+	})
+	t.Run("unit", func(t *testing.T) {
+		RunAllUnitTests(t, driver)
 	})
 }
 func RunAllPropertyTests(t *testing.T, driver PropertyDriver) {
@@ -42,9 +196,11 @@ func RunAllPropertyTests(t *testing.T, driver PropertyDriver) {
 		SpecTest func(*testing.T, PropertyDriver)
 	}{
 		"StoreProperty":    {AddRental},
+		"DuplicateAddress": {StoreDuplicateAddress},
 		"GetProperty":      {GetProperty},
 		"ListProperties":   {ListProperties},
 		"SearchProperties": {SearchProperties},
+		"PageProperties":   {PageProperties},
 		"RemoveProperty":   {RemoveProperty},
 	}
 	for name, tc := range PropertyTests {
@@ -57,9 +213,13 @@ func RunAllTenantTests(t *testing.T, driver TenantDriver) {
 	var TenantTests = map[string]struct {
 		SpecTest func(*testing.T, TenantDriver)
 	}{
-		"StoreTenant": {AddTenant},
-		"GetTenant":   {GetTenant},
-		"ListTenants": {ListTenants},
+		"StoreTenant":  {AddTenant},
+		"GetTenant":    {GetTenant},
+		"ListTenants":  {ListTenants},
+		"PageTenants":  {PageTenants},
+		"PatchTenant":  {PatchTenant},
+		"RemoveTenant": {RemoveTenant},
+		"TenantPhones": {TenantPhones},
 	}
 	for name, tc := range TenantTests {
 		t.Run(name, func(t *testing.T) {
@@ -67,6 +227,148 @@ func RunAllTenantTests(t *testing.T, driver TenantDriver) {
 		})
 	}
 }
+func RunAllLeaseTests(t *testing.T, driver LeaseDriver) {
+	var LeaseTests = map[string]struct {
+		SpecTest func(*testing.T, LeaseDriver)
+	}{
+		"LeaseProperty":  {LeaseProperty},
+		"GetLease":       {GetLease},
+		"ListLeases":     {ListLeases},
+		"PageLeases":     {PageLeases},
+		"TerminateLease": {TerminateLease},
+		"RenewLease":     {RenewLease},
+		"AmendLease":     {AmendLease},
+		"RentSchedule":   {RentSchedule},
+		"RemoveLeased":   {RemoveLeasedProperty},
+		"RemoveTenant":   {RemoveLeasedTenant},
+		"TenantsLeasing": {ListTenantsLeasing},
+	}
+	for name, tc := range LeaseTests {
+		t.Run(name, func(t *testing.T) {
+			tc.SpecTest(t, driver)
+		})
+	}
+}
+func RunAllLedgerTests(t *testing.T, driver LedgerDriver) {
+	var LedgerTests = map[string]struct {
+		SpecTest func(*testing.T, LedgerDriver)
+	}{
+		"PostLedgerEntry":    {PostLedgerEntry},
+		"ReverseLedgerEntry": {ReverseLedgerEntry},
+		"LedgerStatement":    {LedgerStatement},
+	}
+	for name, tc := range LedgerTests {
+		t.Run(name, func(t *testing.T) {
+			tc.SpecTest(t, driver)
+		})
+	}
+}
+func RunAllLateFeeTests(t *testing.T, driver LateFeeDriver) {
+	var LateFeeTests = map[string]struct {
+		SpecTest func(*testing.T, LateFeeDriver)
+	}{
+		"LateFeePolicy": {LateFeePolicy},
+		"ApplyLateFees": {ApplyLateFees},
+	}
+	for name, tc := range LateFeeTests {
+		t.Run(name, func(t *testing.T) {
+			tc.SpecTest(t, driver)
+		})
+	}
+}
+func RunAllDepositTests(t *testing.T, driver DepositDriver) {
+	var DepositTests = map[string]struct {
+		SpecTest func(*testing.T, DepositDriver)
+	}{
+		"RecordDepositReceipt": {RecordDepositReceipt},
+		"DisposeDeposit":       {DisposeDeposit},
+	}
+	for name, tc := range DepositTests {
+		t.Run(name, func(t *testing.T) {
+			tc.SpecTest(t, driver)
+		})
+	}
+}
+func RunAllApplicationTests(t *testing.T, driver ApplicationDriver) {
+	var ApplicationTests = map[string]struct {
+		SpecTest func(*testing.T, ApplicationDriver)
+	}{
+		"SubmitApplication":  {SubmitApplication},
+		"ConvertApplication": {ConvertApplication},
+		"ScreenApplication":  {ScreenApplication},
+	}
+	for name, tc := range ApplicationTests {
+		t.Run(name, func(t *testing.T) {
+			tc.SpecTest(t, driver)
+		})
+	}
+}
+func RunAllListingTests(t *testing.T, driver ListingDriver) {
+	var ListingTests = map[string]struct {
+		SpecTest func(*testing.T, ListingDriver)
+	}{
+		"PublishListing":     {PublishListing},
+		"ListPublicListings": {ListPublicListings},
+	}
+	for name, tc := range ListingTests {
+		t.Run(name, func(t *testing.T) {
+			tc.SpecTest(t, driver)
+		})
+	}
+}
+func RunAllOutboxTests(t *testing.T, driver OutboxDriver) {
+	var OutboxTests = map[string]struct {
+		SpecTest func(*testing.T, OutboxDriver)
+	}{
+		"ReplayOutboxMessage": {ReplayOutboxMessage},
+	}
+	for name, tc := range OutboxTests {
+		t.Run(name, func(t *testing.T) {
+			tc.SpecTest(t, driver)
+		})
+	}
+}
+func RunAllWebhookTests(t *testing.T, driver WebhookDriver) {
+	var WebhookTests = map[string]struct {
+		SpecTest func(*testing.T, WebhookDriver)
+	}{
+		"StoreWebhook":     {StoreWebhook},
+		"ListWebhooks":     {ListWebhooks},
+		"RemoveWebhook":    {RemoveWebhook},
+		"RedeliverWebhook": {RedeliverWebhook},
+	}
+	for name, tc := range WebhookTests {
+		t.Run(name, func(t *testing.T) {
+			tc.SpecTest(t, driver)
+		})
+	}
+}
+func RunAllAuditTests(t *testing.T, driver AuditDriver) {
+	var AuditTests = map[string]struct {
+		SpecTest func(*testing.T, AuditDriver)
+	}{
+		"AuditProperty": {AuditProperty},
+	}
+	for name, tc := range AuditTests {
+		t.Run(name, func(t *testing.T) {
+			tc.SpecTest(t, driver)
+		})
+	}
+}
+func RunAllUnitTests(t *testing.T, driver UnitDriver) {
+	var UnitTests = map[string]struct {
+		SpecTest func(*testing.T, UnitDriver)
+	}{
+		"StoreUnit":  {StoreUnit},
+		"LeaseUnit":  {LeaseUnit},
+		"RemoveUnit": {RemoveUnit},
+	}
+	for name, tc := range UnitTests {
+		t.Run(name, func(t *testing.T) {
+			tc.SpecTest(t, driver)
+		})
+	}
+}
 
 func AddRental(t *testing.T, driver PropertyDriver) {
 	t.Run("without ID", func(t *testing.T) {
@@ -90,6 +392,46 @@ func AddRental(t *testing.T, driver PropertyDriver) {
 		assert.NotNil(t, pOut)
 		assert.True(t, pIn.Equal(*pOut))
 	})
+	t.Run("with unit and country", func(t *testing.T) {
+		var pIn = fake.Property()
+		pIn.Address = entity.NewAddress(pIn.Street, "Toronto", "ON", "M5H 2N2").
+			WithUnit("Suite 300").
+			WithCountry("CA")
+		id, err := driver.StoreProperty(ctx, pIn)
+		require.NoError(t, err)
+		pOut, err := driver.GetProperty(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, pIn.Address, pOut.Address)
+	})
+	t.Run("invalid address", func(t *testing.T) {
+		var pIn = fake.Property().WithZip("7540")
+		_, err := driver.StoreProperty(ctx, pIn)
+		require.Error(t, err)
+		_, err = driver.GetProperty(ctx, pIn.ID)
+		assert.Error(t, err)
+	})
+}
+func StoreDuplicateAddress(t *testing.T, driver PropertyDriver) {
+	var (
+		p   = fake.Property()
+		dup = entity.NewProperty( // the same address written another way
+			strings.ToUpper(strings.Replace(p.Street, " N ", " North ", 1)),
+			strings.ToLower(p.City), p.StateCode, p.Zip+"-0001")
+	)
+	_, err := driver.StoreProperty(ctx, p)
+	require.NoError(t, err)
+	_, err = driver.StoreProperty(ctx, p)
+	require.NoError(t, err, "a property can be stored again")
+
+	_, err = driver.StoreProperty(ctx, dup)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, p.ID, "the error names the property with the address")
+	_, err = driver.GetProperty(ctx, dup.ID)
+	assert.Error(t, err)
+
+	// a unit of the building is another address
+	_, err = driver.StoreProperty(ctx, dup.WithAddress(dup.Address.WithUnit("Apt 1")))
+	require.NoError(t, err)
 }
 func GetProperty(t *testing.T, driver PropertyDriver) {
 	var pIn = fake.Property()
@@ -135,6 +477,67 @@ func SearchProperties(t *testing.T, driver PropertyDriver) {
 	require.NoError(t, err)
 	assert.Len(t, list, 1, city1)
 	assert.Equal(t, p1.ID, list[0].ID)
+
+	for _, search := range []string{
+		"100 Main Street, " + city1,       // abbreviations are normalized
+		city1[:len(city1)-1] + "0",        // typos find similar addresses
+		strings.ToUpper(city1) + ", oh 1", // case is ignored and words are prefixes
+	} {
+		list, err := driver.ListProperties(ctx, f.WithSearch(search))
+		require.NoError(t, err)
+		require.Len(t, list, 1, search)
+		assert.Equal(t, p1.ID, list[0].ID, search)
+		assert.Greater(t, list[0].Rank, 0.0, search)
+	}
+}
+func PageProperties(t *testing.T, driver PropertyDriver) {
+	var (
+		city  = "Pageville" + test.RandString(5)
+		zips  = []string{"30003", "30001", "30004", "30002", "30005"}
+		byZip = make([]entity.ID, len(zips))
+		f     = usecase.NewPropertyFilter().WithSearch(city)
+	)
+	for i, zip := range zips {
+		p := entity.NewProperty(strconv.Itoa(i+1)+" Main st", city, "OH", zip)
+		_, err := driver.StoreProperty(ctx, p)
+		require.NoError(t, err)
+		n, _ := strconv.Atoi(zip[4:])
+		byZip[n-1] = p.ID
+	}
+	byZipPage := filters.NewPage().WithSort(filters.SortZip, false)
+
+	t.Run("whole list", func(t *testing.T) {
+		list, err := driver.ListProperties(ctx, f.WithPage(byZipPage))
+		require.NoError(t, err)
+		assert.Equal(t, byZip, entityIDs(list))
+	})
+	t.Run("pages", func(t *testing.T) {
+		var (
+			page = byZipPage.WithSize(2)
+			ids  []entity.ID
+		)
+		for {
+			list, err := driver.ListProperties(ctx, f.WithPage(page))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(list), 2)
+			ids = append(ids, entityIDs(list)...)
+			cursor := filters.NextCursor(list, page, filters.PropertySortValue)
+			if cursor == "" {
+				break
+			}
+			page = page.WithCursor(cursor)
+		}
+		assert.Equal(t, byZip, ids)
+	})
+	t.Run("descending", func(t *testing.T) {
+		list, err := driver.ListProperties(ctx, f.WithPage(byZipPage.WithSort(filters.SortZip, true).WithSize(2)))
+		require.NoError(t, err)
+		assert.Equal(t, []entity.ID{byZip[4], byZip[3]}, entityIDs(list))
+	})
+	t.Run("invalid sort", func(t *testing.T) {
+		_, err := driver.ListProperties(ctx, f.WithPage(filters.NewPage().WithSort(filters.SortName, false)))
+		assert.Error(t, err)
+	})
 }
 func RemoveProperty(t *testing.T, driver PropertyDriver) {
 	in1 := fake.Property()
@@ -154,6 +557,41 @@ func RemoveProperty(t *testing.T, driver PropertyDriver) {
 	m := entityMap(list...)
 	require.NotContains(t, m, in1.GetID())
 	require.Contains(t, m, in2.GetID())
+
+	// removed properties are kept until they are purged
+	list, err = driver.ListProperties(ctx, usecase.NewPropertyFilter().WithDeleted())
+	require.NoError(t, err)
+	m = entityMap(list...)
+	require.Contains(t, m, in1.GetID())
+	require.Contains(t, m, in2.GetID())
+	assert.True(t, m[in1.GetID()].(entity.Property).Deleted())
+	assert.False(t, m[in2.GetID()].(entity.Property).Deleted())
+
+	// storing it does not bring it back, it has to be restored
+	_, err = driver.StoreProperty(ctx, in1)
+	require.Error(t, err)
+	_, err = driver.GetProperty(ctx, in1.ID)
+	require.Error(t, err)
+
+	t.Run("restore", func(t *testing.T) {
+		pOut, err := driver.RestoreProperty(ctx, in1.ID)
+		require.NoError(t, err)
+		require.NotNil(t, pOut)
+		assert.True(t, in1.Equal(*pOut))
+		assert.False(t, pOut.Deleted())
+
+		pOut, err = driver.GetProperty(ctx, in1.ID)
+		require.NoError(t, err)
+		assert.True(t, in1.Equal(*pOut))
+
+		// once restored it can be stored again
+		_, err = driver.StoreProperty(ctx, in1)
+		require.NoError(t, err)
+
+		// only a removed property can be restored
+		_, err = driver.RestoreProperty(ctx, in2.ID)
+		require.Error(t, err)
+	})
 }
 
 func AddTenant(t *testing.T, driver TenantDriver) {
@@ -199,12 +637,1232 @@ func ListTenants(t *testing.T, driver TenantDriver) {
 	m := entityMap(list...)
 	require.Contains(t, m, in1.GetID())
 	require.Contains(t, m, in2.GetID())
+
+	t.Run("filter", func(t *testing.T) {
+		both := filters.NewTenantFilter().WithIDs(in1.GetID(), in2.GetID())
+		list, err := driver.ListTenants(ctx, both.WithName(in1.FullName))
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, in1.GetID(), list[0].GetID())
+
+		list, err = driver.ListTenants(ctx, both.WithDOB(in2.DateOfBirth, in2.DateOfBirth))
+		require.NoError(t, err)
+		assert.Contains(t, entityMap(list...), in2.GetID())
+		for _, tenant := range list {
+			assert.True(t, in2.DateOfBirth.Equal(tenant.DateOfBirth))
+		}
+	})
+}
+func PageTenants(t *testing.T, driver TenantDriver) {
+	var (
+		scope  = test.RandString(5)
+		names  = []string{"Cy " + scope, "al " + scope, "Bo " + scope}
+		stored = make([]entity.ID, len(names))
+	)
+	for i, name := range names {
+		in := fake.Tenant().WithName(name)
+		_, err := driver.StoreTenant(ctx, in)
+		require.NoError(t, err)
+		stored[i] = in.GetID()
+	}
+	var (
+		f      = filters.NewTenantFilter().WithName(scope)
+		page   = filters.NewPage().WithSort(filters.SortName, true).WithSize(2)
+		byName = []entity.ID{stored[0], stored[2], stored[1]} // descending, case is ignored
+	)
+	list, err := driver.ListTenants(ctx, f.WithPage(page))
+	require.NoError(t, err)
+	assert.Equal(t, byName[:2], entityIDs(list))
+
+	page = page.WithCursor(filters.NextCursor(list, page, filters.TenantSortValue))
+	list, err = driver.ListTenants(ctx, f.WithPage(page))
+	require.NoError(t, err)
+	assert.Equal(t, byName[2:], entityIDs(list))
+
+	t.Run("cursor of another sort", func(t *testing.T) {
+		_, err := driver.ListTenants(ctx, f.WithPage(page.WithSort(filters.SortName, false)))
+		assert.Error(t, err)
+	})
 }
+func PatchTenant(t *testing.T, driver TenantDriver) {
+	in := fake.Tenant().WithPhone(fake.Phone())
+	_, err := driver.StoreTenant(ctx, in)
+	require.NoError(t, err)
 
-func entityMap[T entity.Entity](entities ...T) map[entity.ID]entity.Entity {
-	var m = make(map[entity.ID]entity.Entity)
-	for _, e := range entities {
-		m[e.GetID()] = e
+	name := fake.FullName()
+	out, err := driver.PatchTenant(ctx, in.GetID(), entity.NewTenantPatch().WithName(name))
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	assert.True(t, in.WithName(name).Equal(*out), "only the name should change")
+
+	out, err = driver.PatchTenant(ctx, in.GetID(), entity.NewTenantPatch().WithDL("", "OK").WithPhones())
+	require.NoError(t, err)
+	assert.Equal(t, "OK", out.DLState)
+	assert.Empty(t, out.Phones)
+
+	got, err := driver.GetTenant(ctx, in.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, name, got.FullName)
+	assert.Equal(t, "OK", got.DLState)
+	assert.True(t, in.DateOfBirth.Equal(got.DateOfBirth))
+	assert.Empty(t, got.Phones)
+
+	t.Run("name can not be removed", func(t *testing.T) {
+		_, err := driver.PatchTenant(ctx, in.GetID(), entity.NewTenantPatch().WithName(""))
+		assert.Error(t, err)
+	})
+	t.Run("unknown tenant", func(t *testing.T) {
+		_, err := driver.PatchTenant(ctx, entity.NewID(), entity.NewTenantPatch().WithName(name))
+		assert.Error(t, err)
+	})
+}
+func RemoveTenant(t *testing.T, driver TenantDriver) {
+	in := fake.Tenant()
+	_, err := driver.StoreTenant(ctx, in)
+	require.NoError(t, err)
+
+	require.NoError(t, driver.RemoveTenant(ctx, in.GetID()))
+	_, err = driver.GetTenant(ctx, in.GetID())
+	require.Error(t, err)
+
+	list, err := driver.ListTenants(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, entityMap(list...), in.GetID())
+
+	list, err = driver.ListTenants(ctx, filters.NewTenantFilter().WithDeleted())
+	require.NoError(t, err)
+	m := entityMap(list...)
+	require.Contains(t, m, in.GetID())
+	assert.True(t, m[in.GetID()].(entity.Tenant).Deleted())
+
+	// removing it again is fine, the client wants it gone and it is
+	require.NoError(t, driver.RemoveTenant(ctx, in.GetID()))
+
+	// storing it does not bring it back, it has to be restored
+	_, err = driver.StoreTenant(ctx, in)
+	require.Error(t, err)
+	_, err = driver.GetTenant(ctx, in.GetID())
+	require.Error(t, err)
+
+	t.Run("restore", func(t *testing.T) {
+		out, err := driver.RestoreTenant(ctx, in.GetID())
+		require.NoError(t, err)
+		require.NotNil(t, out)
+		assert.True(t, in.Equal(*out))
+		assert.False(t, out.Deleted())
+
+		// once restored it can be stored again
+		_, err = driver.StoreTenant(ctx, in)
+		require.NoError(t, err)
+
+		_, err = driver.RestoreTenant(ctx, in.GetID())
+		assert.Error(t, err, "tenant is no longer removed")
+	})
+}
+func TenantPhones(t *testing.T, driver TenantDriver) {
+	var (
+		p1 = fake.Phone()
+		p2 = fake.Phone()
+		in = fake.Tenant().WithPhone(p1)
+	)
+	_, err := driver.StoreTenant(ctx, in)
+	require.NoError(t, err)
+
+	out, err := driver.AddTenantPhone(ctx, in.GetID(), p2)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	assert.True(t, in.WithPhone(p2).Equal(*out))
+
+	t.Run("number already added", func(t *testing.T) {
+		_, err := driver.AddTenantPhone(ctx, in.GetID(), p1.WithNotes("again"))
+		assert.Error(t, err)
+	})
+	t.Run("update", func(t *testing.T) {
+		p3 := fake.Phone().WithNotes("work")
+		out, err := driver.UpdateTenantPhone(ctx, in.GetID(), p2.Number, p3)
+		require.NoError(t, err)
+		assert.True(t, in.WithPhone(p3).Equal(*out))
+
+		_, err = driver.UpdateTenantPhone(ctx, in.GetID(), p2.Number, p2)
+		assert.Error(t, err, "the number was changed")
+		_, err = driver.UpdateTenantPhone(ctx, in.GetID(), p3.Number, p1)
+		assert.Error(t, err, "the tenant already has the new number")
+
+		require.NoError(t, driver.RemoveTenantPhone(ctx, in.GetID(), p3.Number))
+		got, err := driver.GetTenant(ctx, in.GetID())
+		require.NoError(t, err)
+		assert.True(t, in.Equal(*got))
+
+		// removing it again is fine
+		require.NoError(t, driver.RemoveTenantPhone(ctx, in.GetID(), p3.Number))
+	})
+	t.Run("unknown tenant", func(t *testing.T) {
+		_, err := driver.AddTenantPhone(ctx, entity.NewID(), p2)
+		assert.Error(t, err)
+		assert.Error(t, driver.RemoveTenantPhone(ctx, entity.NewID(), p2.Number))
+	})
+}
+
+func LeaseProperty(t *testing.T, driver LeaseDriver) {
+	var in = newLease(t, driver).WithID("")
+	out, err := driver.LeaseProperty(ctx, in)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	require.NotEmpty(t, out.GetID(), "expected ID to be assigned")
+	assert.True(t, in.Equal(*out))
+
+	t.Run("overlapping lease fails", func(t *testing.T) {
+		lease2 := fake.Lease(in.PropertyID, in.TenantIDs...).WithID("")
+		out2, err := driver.LeaseProperty(ctx, lease2)
+		assert.Error(t, err)
+		assert.Nil(t, out2)
+	})
+}
+func GetLease(t *testing.T, driver LeaseDriver) {
+	in, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+
+	out, err := driver.GetLease(ctx, in.GetID())
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	assert.True(t, in.Equal(*out))
+
+	t.Run("not found", func(t *testing.T) {
+		out, err := driver.GetLease(ctx, entity.NewID())
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+func ListLeases(t *testing.T, driver LeaseDriver) {
+	in1, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+	in2, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+
+	list, err := driver.ListLeases(ctx)
+	require.NoError(t, err)
+	m := entityMap(list...)
+	require.Contains(t, m, in1.GetID())
+	require.Contains(t, m, in2.GetID())
+
+	f := filters.NewLeaseFilter().WithPropertyID(in1.PropertyID)
+	list, err = driver.ListLeases(ctx, f)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.True(t, in1.Equal(list[0]))
+}
+func PageLeases(t *testing.T, driver LeaseDriver) {
+	var (
+		first  = newLease(t, driver)
+		years  = []int{2020, 2021, 2019}
+		stored = make([]entity.ID, len(years))
+	)
+	for i, year := range years {
+		start := schedule.NewDate(year, 1, 1)
+		in, err := driver.LeaseProperty(ctx, first.WithID("").WithTerm(start, start.AddDate(1, 0, -1)))
+		require.NoError(t, err)
+		stored[i] = in.GetID()
 	}
-	return m
+	var (
+		f     = filters.NewLeaseFilter().WithPropertyID(first.PropertyID)
+		page  = filters.NewPage().WithSort(filters.SortEndDate, true).WithSize(2)
+		byEnd = []entity.ID{stored[1], stored[0], stored[2]}
+	)
+	list, err := driver.ListLeases(ctx, f.WithPage(page))
+	require.NoError(t, err)
+	assert.Equal(t, byEnd[:2], entityIDs(list))
+
+	page = page.WithCursor(filters.NextCursor(list, page, filters.LeaseSortValue))
+	list, err = driver.ListLeases(ctx, f.WithPage(page))
+	require.NoError(t, err)
+	assert.Equal(t, byEnd[2:], entityIDs(list))
+
+	t.Run("sort leases do not have", func(t *testing.T) {
+		_, err := driver.ListLeases(ctx, f.WithPage(filters.NewPage().WithSort(filters.SortName, false)))
+		assert.Error(t, err)
+	})
+}
+func TerminateLease(t *testing.T, driver LeaseDriver) {
+	in, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+	endDate := in.StartDate.AddDate(0, 2, -1)
+
+	out, err := driver.TerminateLease(ctx, in.GetID(), endDate, "tenant relocating")
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	assert.Equal(t, endDate.String(), out.EndDate.String())
+
+	out, err = driver.GetLease(ctx, in.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, endDate.String(), out.EndDate.String())
+
+	h, err := driver.GetLeaseHistory(ctx, in.GetID())
+	require.NoError(t, err)
+	require.Len(t, h.Versions, 2)
+	assert.Equal(t, entity.LeaseCreated, h.Versions[0].Change)
+	assert.Equal(t, entity.LeaseTerminated, h.Versions[1].Change)
+	assert.Equal(t, "tenant relocating", h.Versions[1].Reason)
+	assert.Equal(t, endDate.String(), h.Versions[1].Effective.String())
+
+	t.Run("date outside of term fails", func(t *testing.T) {
+		out, err := driver.TerminateLease(ctx, in.GetID(), in.StartDate.AddDate(0, 0, -1), "")
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+func RemoveLeasedProperty(t *testing.T, driver LeaseDriver) {
+	in, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+
+	// the lease has not ended so the property can not be removed
+	require.Error(t, driver.RemoveProperty(ctx, in.PropertyID))
+	p, err := driver.GetProperty(ctx, in.PropertyID)
+	require.NoError(t, err)
+	assert.False(t, p.Deleted())
+}
+func ListTenantsLeasing(t *testing.T, driver LeaseDriver) {
+	var (
+		today   = schedule.Today()
+		current = newLease(t, driver).WithTerm(today.AddDate(0, -1, 0), today.AddDate(0, 11, 0))
+		future  = newLease(t, driver)
+	)
+	_, err := driver.LeaseProperty(ctx, current)
+	require.NoError(t, err)
+	_, err = driver.LeaseProperty(ctx, future)
+	require.NoError(t, err)
+
+	list, err := driver.ListTenants(ctx, filters.NewTenantFilter().WithLeasingProperty(current.PropertyID))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, current.TenantIDs[0], list[0].GetID())
+
+	// the lease of the other property has not started yet
+	list, err = driver.ListTenants(ctx, filters.NewTenantFilter().WithLeasingProperty(future.PropertyID))
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
+func RemoveLeasedTenant(t *testing.T, driver LeaseDriver) {
+	in, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+
+	// the lease has not ended so its tenants can not be removed
+	require.Error(t, driver.RemoveTenant(ctx, in.TenantIDs[0]))
+	_, err = driver.GetTenant(ctx, in.TenantIDs[0])
+	require.NoError(t, err)
+}
+func RenewLease(t *testing.T, driver LeaseDriver) {
+	in, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+	var (
+		endDate = in.EndDate.AddDate(1, 0, 0)
+		raised  = entity.NewMoney(in.RentAmount.Minor+5000, in.Currency())
+	)
+	out, err := driver.RenewLease(ctx, in.GetID(), entity.NewLeaseRenewal(endDate).WithRent(raised))
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	assert.NotEqual(t, in.GetID(), out.GetID())
+	assert.Equal(t, in.GetID(), out.RenewsID)
+	assert.Equal(t, in.EndDate.Next().String(), out.StartDate.String())
+	assert.Equal(t, endDate.String(), out.EndDate.String())
+	assert.Equal(t, raised, out.RentAmount)
+	assert.Equal(t, in.PropertyID, out.PropertyID)
+
+	// the chain is the same from either lease
+	for _, id := range []entity.ID{in.GetID(), out.GetID()} {
+		h, err := driver.GetLeaseHistory(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, h.Versions, 2)
+		assert.Equal(t, in.GetID(), h.Versions[0].LeaseID)
+		assert.Equal(t, entity.LeaseCreated, h.Versions[0].Change)
+		assert.Equal(t, out.GetID(), h.Versions[1].LeaseID)
+		assert.Equal(t, entity.LeaseRenewed, h.Versions[1].Change)
+	}
+
+	t.Run("renewed twice fails", func(t *testing.T) {
+		out, err := driver.RenewLease(ctx, in.GetID(), entity.NewLeaseRenewal(endDate.AddDate(1, 0, 0)))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+func AmendLease(t *testing.T, driver LeaseDriver) {
+	in, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+	tenant, err := driver.StoreTenant(ctx, fake.Tenant())
+	require.NoError(t, err)
+	var (
+		effective = in.StartDate.AddDate(0, 1, 0)
+		rent      = entity.NewMoney(in.RentAmount.Minor+1000, in.Currency())
+		amendment = entity.NewLeaseAmendment(effective).
+				WithRent(rent).
+				WithTenantsAdded(tenant.GetID()).
+				WithReason("roommate moving in")
+	)
+	out, err := driver.AmendLease(ctx, in.GetID(), amendment)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	assert.Equal(t, in.GetID(), out.GetID())
+	assert.Equal(t, rent, out.RentAmount)
+	assert.True(t, out.HasTenant(tenant.GetID()))
+
+	h, err := driver.GetLeaseHistory(ctx, in.GetID())
+	require.NoError(t, err)
+	require.Len(t, h.Versions, 2)
+	assert.True(t, in.Equal(h.Versions[0].Terms), "prior version kept")
+	assert.Equal(t, entity.LeaseAmended, h.Versions[1].Change)
+	assert.Equal(t, effective.String(), h.Versions[1].Effective.String())
+	assert.Equal(t, "roommate moving in", h.Versions[1].Reason)
+	assert.True(t, out.Equal(h.Versions[1].Terms))
+
+	// rent due before the amendment is at the prior rent
+	list, err := driver.GetRentSchedule(ctx, in.GetID(), entity.NewScheduleOptions())
+	require.NoError(t, err)
+	require.NotEmpty(t, list)
+	for _, due := range list {
+		if due.Prorated {
+			continue
+		}
+		if due.DueDate.Before(effective) {
+			assert.Equal(t, in.RentAmount, due.Amount, due.DueDate.String())
+		} else {
+			assert.Equal(t, rent, due.Amount, due.DueDate.String())
+		}
+	}
+
+	t.Run("effective outside of term fails", func(t *testing.T) {
+		late := entity.NewLeaseAmendment(in.EndDate.Next()).WithRent(rent)
+		out, err := driver.AmendLease(ctx, in.GetID(), late)
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+func RentSchedule(t *testing.T, driver LeaseDriver) {
+	var (
+		lease = newLease(t, driver)
+		start = lease.StartDate.AddDate(0, 0, 14) // mid month
+		end   = start.AddDate(0, 3, 0)
+	)
+	in, err := driver.LeaseProperty(ctx, lease.WithTerm(start, end))
+	require.NoError(t, err)
+
+	list, err := driver.GetRentSchedule(ctx, in.GetID(), entity.NewScheduleOptions())
+	require.NoError(t, err)
+	require.Len(t, list, 4) // partial, full, full, partial
+	expect, err := in.RentSchedule(entity.NewScheduleOptions())
+	require.NoError(t, err)
+	for i := range expect {
+		assert.Equal(t, expect[i].DueDate.String(), list[i].DueDate.String())
+		assert.Equal(t, expect[i].Period.DayCount(), list[i].Period.DayCount())
+		assert.Equal(t, expect[i].Amount, list[i].Amount)
+		assert.Equal(t, expect[i].Prorated, list[i].Prorated)
+	}
+	assert.True(t, list[0].Prorated)
+	assert.False(t, list[1].Prorated)
+
+	t.Run("unbounded lease requires until", func(t *testing.T) {
+		var noEnd schedule.Date
+		openEnded, err := driver.LeaseProperty(ctx, newLease(t, driver).WithTerm(start, noEnd))
+		require.NoError(t, err)
+		_, err = driver.GetRentSchedule(ctx, openEnded.GetID(), entity.NewScheduleOptions())
+		assert.Error(t, err)
+
+		opts := entity.NewScheduleOptions().WithUntil(start.AddDate(0, 1, 0)).WithDueDay(start.Day())
+		list, err := driver.GetRentSchedule(ctx, openEnded.GetID(), opts)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.False(t, list[0].Prorated)
+	})
+}
+
+func PostLedgerEntry(t *testing.T, driver LedgerDriver) {
+	lease, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+	var (
+		day1   = lease.StartDate
+		day2   = day1.Next()
+		charge = entity.NewLedgerEntry(lease.ID, entity.EntryCharge, lease.RentAmount, day1).WithID("").WithMemo("rent")
+		amount = func(minor int) entity.Money { return entity.NewMoney(minor, lease.Currency()) }
+	)
+	out, err := driver.PostLedgerEntry(ctx, charge)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	require.NotEmpty(t, out.GetID(), "expected ID to be assigned")
+	assert.True(t, charge.Equal(*out))
+
+	_, err = driver.PostLedgerEntry(ctx, entity.NewLedgerEntry(lease.ID, entity.EntryPayment, amount(100), day2))
+	require.NoError(t, err)
+
+	balance, err := driver.GetBalance(ctx, lease.ID, day1)
+	require.NoError(t, err)
+	assert.Equal(t, lease.RentAmount, balance)
+	balance, err = driver.GetBalance(ctx, lease.ID, schedule.Date{})
+	require.NoError(t, err)
+	assert.Equal(t, amount(lease.RentAmount.Minor-100), balance)
+
+	t.Run("invalid entry fails", func(t *testing.T) {
+		out, err := driver.PostLedgerEntry(ctx, entity.NewLedgerEntry(lease.ID, entity.EntryPayment, amount(0), day2))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+	t.Run("other currency than the lease fails", func(t *testing.T) {
+		out, err := driver.PostLedgerEntry(ctx, entity.NewLedgerEntry(lease.ID, entity.EntryPayment, entity.NewMoney(100, "EUR"), day2))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+	t.Run("unknown lease fails", func(t *testing.T) {
+		out, err := driver.PostLedgerEntry(ctx, entity.NewLedgerEntry(entity.NewID(), entity.EntryPayment, amount(1), day2))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+func ReverseLedgerEntry(t *testing.T, driver LedgerDriver) {
+	lease, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+	var day1 = lease.StartDate
+	payment, err := driver.PostLedgerEntry(ctx, entity.NewLedgerEntry(lease.ID, entity.EntryPayment, entity.NewMoney(500, lease.Currency()), day1))
+	require.NoError(t, err)
+
+	reversal, err := driver.ReverseLedgerEntry(ctx, lease.ID, payment.ID, day1.Next(), "nsf")
+	require.NoError(t, err)
+	require.NotNil(t, reversal)
+	assert.Equal(t, payment.ID, reversal.ReversesID)
+	assert.Equal(t, payment.Amount, reversal.Amount)
+	assert.Equal(t, "nsf", reversal.Memo)
+
+	balance, err := driver.GetBalance(ctx, lease.ID, schedule.Date{})
+	require.NoError(t, err)
+	assert.Equal(t, lease.RentAmount.Zero(), balance)
+
+	t.Run("reversing twice fails", func(t *testing.T) {
+		out, err := driver.ReverseLedgerEntry(ctx, lease.ID, payment.ID, day1.Next(), "")
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+func LedgerStatement(t *testing.T, driver LedgerDriver) {
+	lease, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+	var (
+		month1 = lease.StartDate
+		month2 = month1.AddDate(0, 1, 0)
+		rent   = lease.RentAmount.Minor
+		amount = func(minor int) entity.Money { return entity.NewMoney(minor, lease.Currency()) }
+	)
+	for _, e := range []entity.LedgerEntry{
+		entity.NewLedgerEntry(lease.ID, entity.EntryCharge, amount(rent), month1),
+		entity.NewLedgerEntry(lease.ID, entity.EntryPayment, amount(rent-50), month1.Next()),
+		entity.NewLedgerEntry(lease.ID, entity.EntryCharge, amount(rent), month2),
+		entity.NewLedgerEntry(lease.ID, entity.EntryCredit, amount(20), month2.Next()),
+	} {
+		_, err := driver.PostLedgerEntry(ctx, e)
+		require.NoError(t, err)
+	}
+
+	s, err := driver.GetStatement(ctx, lease.ID, month2, schedule.Date{})
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	assert.Equal(t, lease.ID, s.LeaseID)
+	assert.Equal(t, amount(50), s.OpeningBalance)
+	require.Len(t, s.Lines, 2)
+	assert.Equal(t, amount(50+rent), s.Lines[0].Balance)
+	assert.Equal(t, amount(30+rent), s.ClosingBalance)
+
+	s, err = driver.GetStatement(ctx, lease.ID, schedule.Date{}, schedule.Date{})
+	require.NoError(t, err)
+	require.Len(t, s.Lines, 4)
+	assert.Equal(t, amount(0), s.OpeningBalance)
+	assert.Equal(t, amount(30+rent), s.ClosingBalance)
+}
+
+func LateFeePolicy(t *testing.T, driver LateFeeDriver) {
+	lease, err := driver.LeaseProperty(ctx, newLease(t, driver))
+	require.NoError(t, err)
+	amount := func(minor int) entity.Money { return entity.NewMoney(minor, lease.Currency()) }
+
+	// the property policy applies until the lease has its own
+	propPolicy := entity.NewLateFeePolicy().WithID("").ForProperty(lease.PropertyID).WithFlatFee(amount(25))
+	out, err := driver.StoreLateFeePolicy(ctx, propPolicy)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	require.NotEmpty(t, out.GetID(), "expected ID to be assigned")
+	assert.True(t, propPolicy.Equal(*out))
+
+	got, err := driver.GetLateFeePolicy(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.True(t, propPolicy.Equal(*got))
+
+	leasePolicy := entity.NewLateFeePolicy().WithID("").ForLease(lease.ID).
+		WithGraceDays(3).WithFlatFee(amount(40)).WithRentPercent(250).WithDailyFee(amount(5), amount(150))
+	_, err = driver.StoreLateFeePolicy(ctx, leasePolicy)
+	require.NoError(t, err)
+	got, err = driver.GetLateFeePolicy(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.True(t, leasePolicy.Equal(*got))
+
+	t.Run("invalid policy fails", func(t *testing.T) {
+		out, err := driver.StoreLateFeePolicy(ctx, leasePolicy.WithFlatFee(amount(-1)))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+	t.Run("policy in another currency than the lease fails", func(t *testing.T) {
+		other := entity.NewMoney(40, "EUR")
+		if lease.Currency() == other.Currency {
+			other.Currency = entity.CurrencyUSD
+		}
+		out, err := driver.StoreLateFeePolicy(ctx, leasePolicy.WithFlatFee(other).WithDailyFee(entity.Money{}, entity.Money{}))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+	t.Run("lease without a policy fails", func(t *testing.T) {
+		other, err := driver.LeaseProperty(ctx, newLease(t, driver))
+		require.NoError(t, err)
+		out, err := driver.GetLateFeePolicy(ctx, other.ID)
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+func ApplyLateFees(t *testing.T, driver LateFeeDriver) {
+	var (
+		// started on the 1st two months ago so rent is already late
+		today = schedule.Today()
+		start = schedule.NewDate(today.Year(), today.Month(), 1).AddDate(0, -2, 0)
+		asOf  = start.AddDate(0, 0, 10)
+		usd   = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+	)
+	lease, err := driver.LeaseProperty(ctx, newLease(t, driver).
+		WithTerm(start, start.AddDate(1, 0, -1)).WithRent(usd(1000)))
+	require.NoError(t, err)
+	_, err = driver.StoreLateFeePolicy(ctx, entity.NewLateFeePolicy().ForLease(lease.ID).
+		WithGraceDays(5).WithFlatFee(usd(50)).WithDailyFee(usd(10), entity.Money{}))
+	require.NoError(t, err)
+
+	// half paid on time, first payment is late by 5 days as of asOf
+	_, err = driver.PostLedgerEntry(ctx, entity.NewLedgerEntry(lease.ID, entity.EntryPayment, usd(500), start))
+	require.NoError(t, err)
+
+	fees, err := driver.AssessLateFees(ctx, lease.ID, asOf)
+	require.NoError(t, err)
+	require.Len(t, fees, 1)
+	assert.Equal(t, start.String(), fees[0].DueDate.String())
+	assert.Equal(t, usd(500), fees[0].Unpaid)
+	assert.Equal(t, 5, fees[0].DaysLate)
+	assert.Equal(t, usd(100), fees[0].Amount)
+
+	posted, err := driver.ApplyLateFees(ctx, lease.ID, asOf)
+	require.NoError(t, err)
+	require.Len(t, posted, 1)
+	assert.Equal(t, entity.EntryCharge, posted[0].Type)
+	assert.Equal(t, usd(100), posted[0].Amount)
+	assert.Equal(t, fees[0].Ref(), posted[0].Ref)
+
+	// running again never charges twice
+	posted, err = driver.ApplyLateFees(ctx, lease.ID, asOf)
+	require.NoError(t, err)
+	assert.Len(t, posted, 0)
+
+	balance, err := driver.GetBalance(ctx, lease.ID, asOf)
+	require.NoError(t, err)
+	assert.Equal(t, entity.NewMoney(100-500, lease.Currency()), balance, "rent is not charged to the ledger, only the fee and payment")
+
+	t.Run("asOf in the future fails", func(t *testing.T) {
+		_, err := driver.ApplyLateFees(ctx, lease.ID, schedule.Today().AddDate(0, 0, 2))
+		assert.Error(t, err)
+	})
+}
+func RecordDepositReceipt(t *testing.T, driver DepositDriver) {
+	lease, err := driver.LeaseProperty(ctx, newLease(t, driver).
+		WithDeposit(entity.NewMoney(150000, entity.CurrencyUSD)))
+	require.NoError(t, err)
+
+	account, err := driver.GetDeposit(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.Equal(t, lease.ID, account.LeaseID)
+	assert.Equal(t, entity.NewMoney(150000, entity.CurrencyUSD), account.Required)
+	assert.Equal(t, entity.DepositDue, account.Status())
+	assert.Len(t, account.Receipts, 0)
+
+	receipt := entity.NewDepositReceipt(lease.ID, entity.NewMoney(150000, entity.CurrencyUSD), lease.StartDate).
+		WithID("").WithMemo("check 1001")
+	out, err := driver.RecordDepositReceipt(ctx, receipt)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	require.NotEmpty(t, out.GetID(), "expected ID to be assigned")
+	assert.True(t, receipt.Equal(*out))
+
+	account, err = driver.GetDeposit(ctx, lease.ID)
+	require.NoError(t, err)
+	require.Len(t, account.Receipts, 1)
+	assert.True(t, out.Equal(account.Receipts[0]))
+	assert.Equal(t, entity.DepositHeld, account.Status())
+
+	t.Run("invalid receipt fails", func(t *testing.T) {
+		out, err := driver.RecordDepositReceipt(ctx, receipt.WithID("").WithMemo("").
+			WithAmount(entity.NewMoney(-1, entity.CurrencyUSD)))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+	t.Run("unknown lease fails", func(t *testing.T) {
+		_, err := driver.GetDeposit(ctx, entity.NewID())
+		assert.Error(t, err)
+	})
+}
+func DisposeDeposit(t *testing.T, driver DepositDriver) {
+	var usd = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+	lease, err := driver.LeaseProperty(ctx, newLease(t, driver).WithDeposit(usd(100000)))
+	require.NoError(t, err)
+	_, err = driver.RecordDepositReceipt(ctx, entity.NewDepositReceipt(lease.ID, usd(100000), lease.StartDate))
+	require.NoError(t, err)
+
+	in := entity.NewDepositDisposition(lease.ID, lease.EndDate).WithID("").WithDeduction(
+		entity.NewDeduction(entity.DeductionCleaning, usd(12500), "carpet cleaning"),
+		entity.NewDeduction(entity.DeductionDamage, usd(7550), "broken blinds"),
+	)
+	out, err := driver.DisposeDeposit(ctx, in)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	require.NotEmpty(t, out.GetID(), "expected ID to be assigned")
+	assert.Equal(t, usd(100000), out.Held)
+	assert.Equal(t, usd(79950), out.Refund)
+	assert.True(t, out.Owed.IsZero())
+	require.Len(t, out.Deductions, 2)
+	assert.Equal(t, in.Deductions, out.Deductions)
+
+	account, err := driver.GetDeposit(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.DepositDisposed, account.Status())
+	require.NotNil(t, account.Disposition)
+	assert.True(t, out.Equal(*account.Disposition))
+
+	statement, err := driver.GetDepositStatement(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.Contains(t, statement, "cleaning: carpet cleaning")
+	assert.Contains(t, statement, "Refund due to tenant")
+	assert.Contains(t, statement, "799.50 USD")
+
+	t.Run("disposed only once", func(t *testing.T) {
+		out, err := driver.DisposeDeposit(ctx, in)
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+}
+
+// newLease stores a property and tenant for the lease to reference
+func newLease(t *testing.T, driver LeaseDriver) entity.Lease {
+	t.Helper()
+	propertyID, err := driver.StoreProperty(ctx, fake.Property())
+	require.NoError(t, err)
+	tenant, err := driver.StoreTenant(ctx, fake.Tenant())
+	require.NoError(t, err)
+	return fake.Lease(propertyID, tenant.GetID())
+}
+
+func entityIDs[T entity.Entity](list []T) []entity.ID {
+	var ids = make([]entity.ID, len(list))
+	for i, e := range list {
+		ids[i] = e.GetID()
+	}
+	return ids
+}
+func entityMap[T entity.Entity](entities ...T) map[entity.ID]entity.Entity {
+	var m = make(map[entity.ID]entity.Entity)
+	for _, e := range entities {
+		m[e.GetID()] = e
+	}
+	return m
+}
+
+func SubmitApplication(t *testing.T, driver ApplicationDriver) {
+	propertyID, err := driver.StoreProperty(ctx, fake.Property())
+	require.NoError(t, err)
+
+	in := fake.RentalApplication(propertyID).WithID("").WithApplicant(fake.Applicant())
+	out, err := driver.SubmitApplication(ctx, in)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	require.NotEmpty(t, out.GetID(), "expected ID to be assigned")
+	assert.True(t, in.Equal(*out))
+	assert.Equal(t, entity.ApplicationSubmitted, out.Status)
+
+	got, err := driver.GetApplication(ctx, out.ID)
+	require.NoError(t, err)
+	assert.True(t, out.Equal(*got))
+
+	list, err := driver.ListApplications(ctx, filters.NewApplicationFilter().WithPropertyID(propertyID))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.True(t, out.Equal(list[0]))
+
+	t.Run("unknown property fails", func(t *testing.T) {
+		out, err := driver.SubmitApplication(ctx, fake.RentalApplication(entity.NewID()).WithID(""))
+		assert.Error(t, err)
+		assert.Nil(t, out)
+	})
+	t.Run("deny requires a note", func(t *testing.T) {
+		_, err := driver.UpdateApplicationStatus(ctx, out.ID, entity.ApplicationDenied, "")
+		assert.Error(t, err)
+	})
+	t.Run("withdrawn is final", func(t *testing.T) {
+		app, err := driver.SubmitApplication(ctx, fake.RentalApplication(propertyID).WithID(""))
+		require.NoError(t, err)
+		app, err = driver.UpdateApplicationStatus(ctx, app.ID, entity.ApplicationWithdrawn, "found another place")
+		require.NoError(t, err)
+		assert.Equal(t, entity.ApplicationWithdrawn, app.Status)
+		_, err = driver.UpdateApplicationStatus(ctx, app.ID, entity.ApplicationApproved, "")
+		assert.Error(t, err)
+	})
+}
+func ConvertApplication(t *testing.T, driver ApplicationDriver) {
+	propertyID, err := driver.StoreProperty(ctx, fake.Property())
+	require.NoError(t, err)
+	app, err := driver.SubmitApplication(ctx, fake.RentalApplication(propertyID).WithID("").WithApplicant(fake.Applicant()))
+	require.NoError(t, err)
+
+	_, err = driver.ConvertApplication(ctx, app.ID)
+	assert.Error(t, err, "only approved applications can be converted")
+
+	_, err = driver.UpdateApplicationStatus(ctx, app.ID, entity.ApplicationUnderReview, "")
+	require.NoError(t, err)
+	app, err = driver.UpdateApplicationStatus(ctx, app.ID, entity.ApplicationApproved, "income verified")
+	require.NoError(t, err)
+	assert.Equal(t, entity.ApplicationApproved, app.Status)
+	require.Len(t, app.Notes, 2)
+	assert.Equal(t, "income verified", app.Notes[1].Note)
+
+	conversion, err := driver.ConvertApplication(ctx, app.ID)
+	require.NoError(t, err)
+	require.Len(t, conversion.Tenants, 2)
+	assert.Len(t, conversion.Application.TenantIDs, 2)
+	for i, tenant := range conversion.Tenants {
+		got, err := driver.GetTenant(ctx, tenant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, app.Applicants[i].FullName, got.FullName)
+	}
+	lease := conversion.Lease
+	assert.Equal(t, propertyID, lease.PropertyID)
+	assert.True(t, app.MoveInDate.Equal(lease.StartDate))
+	assert.ElementsMatch(t, conversion.Application.TenantIDs, lease.TenantIDs)
+
+	_, err = driver.ConvertApplication(ctx, app.ID)
+	assert.Error(t, err, "an application can only be converted once")
+}
+func ScreenApplication(t *testing.T, driver ApplicationDriver) {
+	var (
+		noPets = entity.NewScreeningRule(entity.CriterionNoPets, entity.ScreeningActionFail)
+		cars   = entity.NewScreeningRule(entity.CriterionMaxVehicles, entity.ScreeningActionReview).WithLimit(1)
+		income = entity.NewScreeningRule(entity.CriterionMinIncome, entity.ScreeningActionReview).WithLimit(300)
+	)
+	propertyID, err := driver.StoreProperty(ctx, fake.Property())
+	require.NoError(t, err)
+
+	_, err = driver.GetScreeningPolicy(ctx, propertyID)
+	assert.Error(t, err, "no policy yet")
+
+	in := entity.NewScreeningPolicy(propertyID, noPets, cars, income).
+		WithID("").WithRent(entity.NewMoney(150000, entity.CurrencyUSD))
+	policy, err := driver.StoreScreeningPolicy(ctx, in)
+	require.NoError(t, err)
+	require.NotEmpty(t, policy.ID, "expected ID to be assigned")
+	assert.True(t, in.Equal(*policy))
+
+	got, err := driver.GetScreeningPolicy(ctx, propertyID)
+	require.NoError(t, err)
+	assert.True(t, policy.Equal(*got))
+
+	// screened on submit, two vehicles need a review
+	ap := fake.Applicant()
+	ap.HasPets = false
+	ap.VehicleCount = 2
+	ap.MonthlyIncome = entity.NewMoney(500000, entity.CurrencyUSD)
+	app, err := driver.SubmitApplication(ctx, entity.NewRentalApplication(propertyID, ap).
+		WithID("").WithMoveInDate(fake.RentalApplication(propertyID).MoveInDate))
+	require.NoError(t, err)
+	assert.Equal(t, ap.MonthlyIncome, app.Applicants[0].MonthlyIncome)
+
+	reports, err := driver.ListScreeningReports(ctx, filters.NewScreeningReportFilter().WithApplicationID(app.ID))
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, entity.ScreeningNeedsReview, reports[0].Result)
+	assert.Equal(t, policy.Rules, reports[0].Rules)
+	require.Len(t, reports[0].Findings, 1)
+	assert.Equal(t, cars, reports[0].Findings[0].Rule)
+	assert.NotEmpty(t, reports[0].Findings[0].Reason)
+
+	// a stricter policy applies when screened again, the first report is kept
+	_, err = driver.StoreScreeningPolicy(ctx, in.WithRule(
+		entity.NewScreeningRule(entity.CriterionMaxVehicles, entity.ScreeningActionFail).WithLimit(1)))
+	require.NoError(t, err)
+	report, err := driver.ScreenApplication(ctx, app.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ScreeningFail, report.Result)
+	assert.Len(t, report.Findings, 2)
+
+	reports, err = driver.ListScreeningReports(ctx, filters.NewScreeningReportFilter().WithApplicationID(app.ID))
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, entity.ScreeningNeedsReview, reports[0].Result)
+	assert.Equal(t, entity.ScreeningFail, reports[1].Result)
+
+	reports, err = driver.ListScreeningReports(ctx, filters.NewScreeningReportFilter().
+		WithApplicationID(app.ID).WithPage(filters.NewPage().WithSize(1)))
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, entity.ScreeningNeedsReview, reports[0].Result)
+
+	t.Run("invalid policy", func(t *testing.T) {
+		_, err := driver.StoreScreeningPolicy(ctx, entity.NewScreeningPolicy(propertyID, income).WithID(""))
+		assert.Error(t, err, "min_income requires rent")
+	})
+	t.Run("unknown application", func(t *testing.T) {
+		_, err := driver.ScreenApplication(ctx, entity.NewID())
+		assert.Error(t, err)
+	})
+}
+func PublishListing(t *testing.T, driver ListingDriver) {
+	propertyID, err := driver.StoreProperty(ctx, fake.Property())
+	require.NoError(t, err)
+
+	_, err = driver.GetListing(ctx, propertyID)
+	assert.Error(t, err, "no listing yet")
+
+	in := fake.Listing(propertyID).WithID("").
+		WithDetails(entity.RentalDetails{AllowPets: true, ParkingSpaces: 1, ParkingDesc: "garage"}).
+		WithPhoto(entity.ListingPhoto{URL: "https://example.com/kitchen.jpg", Caption: "kitchen"})
+	listing, err := driver.StoreListing(ctx, in)
+	require.NoError(t, err)
+	require.NotEmpty(t, listing.ID, "expected ID to be assigned")
+	assert.True(t, in.Equal(*listing))
+	assert.Equal(t, entity.ListingDraft, listing.Status)
+
+	published, err := driver.PublishListing(ctx, propertyID)
+	require.NoError(t, err)
+	assert.Equal(t, listing.ID, published.ID)
+	assert.Equal(t, entity.ListingPublished, published.Status)
+	assert.False(t, published.PublishedAt.IsZero())
+
+	got, err := driver.GetListing(ctx, propertyID)
+	require.NoError(t, err)
+	assert.True(t, published.Equal(*got))
+	assert.True(t, got.IsPublished())
+
+	unpublished, err := driver.UnpublishListing(ctx, propertyID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ListingDraft, unpublished.Status)
+	assert.True(t, unpublished.PublishedAt.IsZero())
+
+	t.Run("not publishable", func(t *testing.T) {
+		propertyID, err := driver.StoreProperty(ctx, fake.Property())
+		require.NoError(t, err)
+		_, err = driver.StoreListing(ctx, entity.NewListing(propertyID, entity.Money{}, schedule.Date{}).WithID(""))
+		require.NoError(t, err)
+		_, err = driver.PublishListing(ctx, propertyID)
+		assert.Error(t, err, "rent and available from are required to publish")
+	})
+	t.Run("unknown property", func(t *testing.T) {
+		_, err := driver.StoreListing(ctx, fake.Listing(entity.NewID()).WithID(""))
+		assert.Error(t, err)
+	})
+}
+func ListPublicListings(t *testing.T, driver ListingDriver) {
+	var (
+		usd      = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		property = fake.Property()
+		city     = property.City
+		byCity   = filters.NewListingFilter().WithCity(city)
+		ids      = func(list []usecase.PublicListing) []entity.ID {
+			out := make([]entity.ID, 0, len(list))
+			for _, pl := range list {
+				out = append(out, pl.Listing.ID)
+			}
+			return out
+		}
+	)
+	publish := func(rent int, pets, published bool) entity.Listing {
+		p := fake.Property()
+		p.City = city
+		propertyID, err := driver.StoreProperty(ctx, p.WithID(""))
+		require.NoError(t, err)
+		in := fake.Listing(propertyID).WithID("").WithDetails(entity.RentalDetails{AllowPets: pets})
+		in.Rent = usd(rent)
+		l, err := driver.StoreListing(ctx, in)
+		require.NoError(t, err)
+		if published {
+			l, err = driver.PublishListing(ctx, propertyID)
+			require.NoError(t, err)
+		}
+		return *l
+	}
+	var (
+		cheap = publish(90000, false, true)
+		pets  = publish(150000, true, true)
+		draft = publish(120000, true, false)
+	)
+
+	list, err := driver.ListPublicListings(ctx, byCity)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []entity.ID{cheap.ID, pets.ID}, ids(list))
+	assert.NotContains(t, ids(list), draft.ID, "drafts are not public")
+	for _, pl := range list {
+		assert.Equal(t, pl.Listing.PropertyID, pl.Property.ID)
+		assert.Equal(t, city, pl.Property.City)
+	}
+
+	list, err = driver.ListPublicListings(ctx, byCity.WithPetsAllowed())
+	require.NoError(t, err)
+	assert.Equal(t, []entity.ID{pets.ID}, ids(list))
+
+	list, err = driver.ListPublicListings(ctx, byCity.WithRentRange(usd(80000), usd(100000)))
+	require.NoError(t, err)
+	assert.Equal(t, []entity.ID{cheap.ID}, ids(list))
+
+	// the most recently published first
+	list, err = driver.ListPublicListings(ctx, byCity.WithPage(filters.NewPage().WithSize(1)))
+	require.NoError(t, err)
+	assert.Equal(t, []entity.ID{pets.ID}, ids(list))
+}
+func ReplayOutboxMessage(t *testing.T, driver OutboxDriver) {
+	propertyID, err := driver.StoreProperty(ctx, fake.Property())
+	require.NoError(t, err)
+
+	list, err := driver.ListOutbox(ctx, filters.NewOutboxFilter().WithName(event.NameRentalAdded))
+	require.NoError(t, err)
+	var added *event.Message
+	for i, m := range list {
+		if e, err := m.Event(); err == nil && e == (event.RentalAdded{PropertyID: propertyID}) {
+			added = &list[i]
+		}
+	}
+	require.NotNil(t, added, "expected the stored property in the outbox")
+
+	got, err := driver.GetOutboxMessage(ctx, added.ID)
+	require.NoError(t, err)
+	assert.Equal(t, added.ID, got.ID)
+	assert.Equal(t, event.NameRentalAdded, got.Name)
+
+	replayed, err := driver.ReplayOutboxMessage(ctx, added.ID)
+	require.NoError(t, err)
+	assert.Equal(t, added.ID, replayed.ID)
+	assert.Equal(t, event.MessagePending, replayed.Status)
+	assert.Zero(t, replayed.Attempts)
+
+	t.Run("unknown message", func(t *testing.T) {
+		_, err := driver.ReplayOutboxMessage(ctx, entity.NewID())
+		assert.Error(t, err)
+	})
+}
+func StoreWebhook(t *testing.T, driver WebhookDriver) {
+	in := fake.Webhook(event.NameRentalAdded, event.NameLeaseTerminated)
+	stored, err := driver.StoreWebhook(ctx, in)
+	require.NoError(t, err)
+	assert.Equal(t, in.ID, stored.ID)
+	assert.Equal(t, in.URL, stored.URL)
+	assert.Equal(t, in.Events, stored.Events)
+	assert.Equal(t, in.Secret, stored.Secret)
+	assert.False(t, stored.CreatedAt.IsZero())
+
+	got, err := driver.GetWebhook(ctx, in.ID)
+	require.NoError(t, err)
+	assert.Equal(t, in.URL, got.URL)
+	assert.Equal(t, in.Events, got.Events)
+	assert.Empty(t, got.Secret, "the secret can not be read back")
+
+	replaced, err := driver.StoreWebhook(ctx, entity.NewWebhook(in.URL, "").WithID(in.ID))
+	require.NoError(t, err)
+	assert.Empty(t, replaced.Secret, "the secret is only sent back when the webhook is added")
+
+	t.Run("without a secret", func(t *testing.T) {
+		stored, err := driver.StoreWebhook(ctx, entity.NewWebhook(in.URL, ""))
+		require.NoError(t, err)
+		assert.NotEmpty(t, stored.Secret, "a secret is made up")
+		assert.Empty(t, stored.Events, "subscribed to every event")
+	})
+	t.Run("invalid url", func(t *testing.T) {
+		_, err := driver.StoreWebhook(ctx, entity.NewWebhook("example.com", in.Secret))
+		assert.Error(t, err)
+	})
+	t.Run("unknown event", func(t *testing.T) {
+		_, err := driver.StoreWebhook(ctx, fake.Webhook("lease.signed"))
+		assert.Error(t, err)
+	})
+	t.Run("unknown webhook", func(t *testing.T) {
+		_, err := driver.GetWebhook(ctx, entity.NewID())
+		assert.Error(t, err)
+	})
+}
+func ListWebhooks(t *testing.T, driver WebhookDriver) {
+	w1, err := driver.StoreWebhook(ctx, fake.Webhook())
+	require.NoError(t, err)
+	w2, err := driver.StoreWebhook(ctx, fake.Webhook(event.NameTenantAdded))
+	require.NoError(t, err)
+
+	list, err := driver.ListWebhooks(ctx)
+	require.NoError(t, err)
+	var ids []entity.ID
+	for _, w := range list {
+		ids = append(ids, w.ID)
+		assert.Empty(t, w.Secret)
+	}
+	assert.Contains(t, ids, w1.ID)
+	assert.Contains(t, ids, w2.ID)
+}
+func RemoveWebhook(t *testing.T, driver WebhookDriver) {
+	w, err := driver.StoreWebhook(ctx, fake.Webhook())
+	require.NoError(t, err)
+	require.NoError(t, driver.RemoveWebhook(ctx, w.ID))
+	_, err = driver.GetWebhook(ctx, w.ID)
+	assert.Error(t, err)
+
+	list, err := driver.ListWebhookDeliveries(ctx, filters.NewWebhookDeliveryFilter().WithWebhookID(w.ID))
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
+func RedeliverWebhook(t *testing.T, driver WebhookDriver) {
+	t.Run("unknown delivery", func(t *testing.T) {
+		_, err := driver.RedeliverWebhook(ctx, entity.NewID())
+		assert.Error(t, err)
+		_, err = driver.GetWebhookDelivery(ctx, entity.NewID())
+		assert.Error(t, err)
+	})
+}
+func AuditProperty(t *testing.T, driver AuditDriver) {
+	var (
+		p     = fake.Property()
+		moved = p
+	)
+	moved.City = "Elsewhere"
+	_, err := driver.StoreProperty(ctx, p)
+	require.NoError(t, err)
+	_, err = driver.StoreProperty(ctx, moved)
+	require.NoError(t, err)
+	require.NoError(t, driver.RemoveProperty(ctx, p.ID))
+
+	list, err := driver.ListAudit(ctx, filters.NewAuditFilter().WithEntity(audit.EntityProperty, p.ID))
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	for i, action := range []audit.Action{audit.ActionCreate, audit.ActionUpdate, audit.ActionDelete} {
+		assert.Equal(t, action, list[i].Action)
+		assert.Equal(t, audit.EntityProperty, list[i].EntityType)
+		assert.Equal(t, p.ID, list[i].EntityID)
+		assert.False(t, list[i].CreatedAt.IsZero())
+	}
+	assert.JSONEq(t, `{"City": {"old": "`+p.City+`", "new": "Elsewhere"}}`, string(list[1].Diff))
+}
+
+func StoreUnit(t *testing.T, driver UnitDriver) {
+	propertyID, err := driver.StoreProperty(ctx, fake.Property())
+	require.NoError(t, err)
+	units, err := driver.ListUnits(ctx, propertyID)
+	require.NoError(t, err)
+	require.Len(t, units, 1, "every property gets a default unit")
+
+	in := fake.Unit(propertyID).WithID("")
+	out, err := driver.StoreUnit(ctx, in)
+	require.NoError(t, err)
+	require.NotEmpty(t, out.ID, "expected ID to be assigned")
+	assert.True(t, in.Equal(*out))
+	assert.False(t, out.Occupied())
+
+	updated, err := driver.StoreUnit(ctx, out.WithSqFt(out.SqFt+100))
+	require.NoError(t, err)
+	assert.Equal(t, out.ID, updated.ID)
+	got, err := driver.GetUnit(ctx, propertyID, out.ID)
+	require.NoError(t, err)
+	assert.True(t, updated.Equal(*got))
+
+	units, err = driver.ListUnits(ctx, propertyID)
+	require.NoError(t, err)
+	assert.Len(t, units, 2)
+	assert.Contains(t, entityIDs(units), out.ID)
+
+	t.Run("duplicate number", func(t *testing.T) {
+		_, err := driver.StoreUnit(ctx, entity.NewUnit(propertyID, in.Number).WithID(""))
+		assert.Error(t, err)
+	})
+	t.Run("unit of another property", func(t *testing.T) {
+		otherID, err := driver.StoreProperty(ctx, fake.Property())
+		require.NoError(t, err)
+		_, err = driver.GetUnit(ctx, otherID, out.ID)
+		assert.Error(t, err)
+	})
+	t.Run("unknown property", func(t *testing.T) {
+		_, err := driver.StoreUnit(ctx, fake.Unit(entity.NewID()).WithID(""))
+		assert.Error(t, err)
+	})
+}
+func LeaseUnit(t *testing.T, driver UnitDriver) {
+	var (
+		today = schedule.Today()
+		start = today.AddDate(0, 0, -1)
+		end   = today.AddDate(1, 0, 0)
+	)
+	lease := newLease(t, driver)
+	units, err := driver.ListUnits(ctx, lease.PropertyID)
+	require.NoError(t, err)
+	require.Len(t, units, 1)
+	vacant := units[0]
+	unitA, err := driver.StoreUnit(ctx, fake.Unit(lease.PropertyID).WithID(""))
+	require.NoError(t, err)
+	unitB, err := driver.StoreUnit(ctx, fake.Unit(lease.PropertyID).WithID(""))
+	require.NoError(t, err)
+
+	_, err = driver.LeaseProperty(ctx, lease.WithID(""))
+	assert.Error(t, err, "the unit is required once the property has more than one")
+
+	leaseA, err := driver.LeaseProperty(ctx, lease.WithID("").WithUnit(unitA.ID).WithTerm(start, end))
+	require.NoError(t, err)
+	assert.Equal(t, unitA.ID, leaseA.UnitID)
+	leaseB, err := driver.LeaseProperty(ctx, lease.WithID("").WithUnit(unitB.ID).WithTerm(start, end))
+	require.NoError(t, err, "units are leased on their own")
+
+	t.Run("overlapping lease of the unit fails", func(t *testing.T) {
+		_, err := driver.LeaseProperty(ctx, lease.WithID("").WithUnit(unitA.ID))
+		assert.Error(t, err)
+	})
+	t.Run("unit of another property fails", func(t *testing.T) {
+		other := newLease(t, driver)
+		_, err := driver.LeaseProperty(ctx, other.WithID("").WithUnit(unitA.ID))
+		assert.Error(t, err)
+	})
+
+	units, err = driver.ListUnits(ctx, lease.PropertyID)
+	require.NoError(t, err)
+	occupancy := make(map[entity.ID]entity.ID)
+	for _, u := range units {
+		occupancy[u.ID] = u.LeaseID
+	}
+	assert.Equal(t, map[entity.ID]entity.ID{
+		unitA.ID:  leaseA.ID,
+		unitB.ID:  leaseB.ID,
+		vacant.ID: "",
+	}, occupancy)
+
+	got, err := driver.GetUnit(ctx, lease.PropertyID, unitA.ID)
+	require.NoError(t, err)
+	assert.Equal(t, leaseA.ID, got.LeaseID)
+
+	list, err := driver.ListLeases(ctx, filters.NewLeaseFilter().WithUnitID(unitA.ID))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, leaseA.ID, list[0].ID)
+}
+func RemoveUnit(t *testing.T, driver UnitDriver) {
+	propertyID, err := driver.StoreProperty(ctx, fake.Property())
+	require.NoError(t, err)
+	u, err := driver.StoreUnit(ctx, fake.Unit(propertyID).WithID(""))
+	require.NoError(t, err)
+
+	require.NoError(t, driver.RemoveUnit(ctx, propertyID, u.ID))
+	_, err = driver.GetUnit(ctx, propertyID, u.ID)
+	assert.Error(t, err)
+	units, err := driver.ListUnits(ctx, propertyID)
+	require.NoError(t, err)
+	assert.NotContains(t, entityIDs(units), u.ID)
+	require.NoError(t, driver.RemoveUnit(ctx, propertyID, u.ID), "already removed")
+
+	t.Run("leased unit", func(t *testing.T) {
+		lease, err := driver.LeaseProperty(ctx, newLease(t, driver))
+		require.NoError(t, err)
+		require.NotEmpty(t, lease.UnitID, "the only unit of the property is leased")
+		assert.Error(t, driver.RemoveUnit(ctx, lease.PropertyID, lease.UnitID))
+		_, err = driver.GetUnit(ctx, lease.PropertyID, lease.UnitID)
+		assert.NoError(t, err)
+	})
 }
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// AuditManager reads the audit log the repositories record along with every
+// change to a property, tenant or lease
+type AuditManager struct {
+	repo AuditRepo
+}
+type AuditRepo interface {
+	ListAudit(context.Context, ...filters.AuditFilter) ([]audit.Entry, error)
+}
+
+func NewAuditManager(repo AuditRepo) AuditManager {
+	return AuditManager{repo: repo}
+}
+
+// List the entries matching the filter, oldest first
+func (uc AuditManager) List(ctx context.Context, filter ...filters.AuditFilter) ([]audit.Entry, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	f := filters.MergeAuditFilters(filter...)
+	if err := f.Page.Validate(filters.CreatedSorts...); err != nil {
+		return nil, err
+	}
+	list, err := uc.repo.ListAudit(ctx, f)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return list, nil
+}
+func (uc AuditManager) Validate() error {
+	if uc.repo == nil {
+		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
+	}
+	return nil
+}
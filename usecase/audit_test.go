@@ -0,0 +1,128 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/usecase"
+)
+
+func TestAuditUC(t *testing.T) {
+	var (
+		repo  = repository.NewInMemoryRepo()
+		uc    = usecase.NewAuditManager(repo)
+		actor = audit.Actor{ID: uuid.NewString(), Transport: audit.TransportREST}
+		actx  = audit.WithActor(ctx, actor)
+
+		// force repo to implement interface
+		_ usecase.AuditRepo = (*repository.InMemory)(nil)
+	)
+	t.Run("property", func(t *testing.T) {
+		var (
+			props = usecase.NewPropertyManager(repo)
+			p     = fake.Property()
+			moved = p
+		)
+		moved.City = "Elsewhere"
+		require.NoError(t, props.Store(actx, p))
+		require.NoError(t, props.Store(actx, moved))
+		require.NoError(t, props.Remove(actx, p.ID))
+
+		list, err := uc.List(ctx, filters.NewAuditFilter().WithEntity(audit.EntityProperty, p.ID))
+		require.NoError(t, err)
+		require.Len(t, list, 3)
+		for i, action := range []audit.Action{audit.ActionCreate, audit.ActionUpdate, audit.ActionDelete} {
+			assert.Equal(t, action, list[i].Action)
+			assert.Equal(t, actor.ID, list[i].Actor)
+			assert.Equal(t, actor.Transport, list[i].Transport)
+			assert.Equal(t, p.ID, list[i].EntityID)
+		}
+		assertDiff(t, list[1], "City", p.City, moved.City)
+		assert.NotContains(t, diffFields(t, list[1]), "CreatedAt", "the repo keeps when it was first stored")
+	})
+	t.Run("tenant pii is masked", func(t *testing.T) {
+		var (
+			tenants = usecase.NewTenantManager(repo)
+			tenant  = fake.Tenant()
+			renamed = tenant.WithName(fake.FullName())
+		)
+		renamed.DLNum = "ab12345678"
+		_, err := tenants.Store(actx, tenant)
+		require.NoError(t, err)
+		_, err = tenants.Store(actx, renamed)
+		require.NoError(t, err)
+
+		list, err := uc.List(ctx, filters.NewAuditFilter().WithEntity(audit.EntityTenant, tenant.ID))
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assertDiff(t, list[1], "FullName", tenant.FullName, renamed.FullName)
+		assertDiff(t, list[1], "DLNum", entity.MaskLast4(tenant.DLNum), entity.MaskLast4(renamed.DLNum))
+		assert.NotContains(t, string(list[0].Diff), tenant.DLNum)
+	})
+	t.Run("lease", func(t *testing.T) {
+		var (
+			leases = usecase.NewLeaseManager(repo)
+			lease  = fake.Lease(entity.NewID(), entity.NewID())
+			end    = lease.EndDate.AddDate(0, -1, 0)
+		)
+		storeParties(t, repo, lease)
+		_, err := leases.Store(actx, lease)
+		require.NoError(t, err)
+		_, err = leases.Terminate(actx, lease.ID, end, "moving out")
+		require.NoError(t, err)
+
+		list, err := uc.List(ctx, filters.NewAuditFilter().WithEntity(audit.EntityLease, lease.ID))
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, audit.ActionCreate, list[0].Action)
+		assertDiff(t, list[1], "EndDate", lease.EndDate, end)
+	})
+	t.Run("by actor", func(t *testing.T) {
+		list, err := uc.List(ctx, filters.NewAuditFilter().WithActor(actor.ID))
+		require.NoError(t, err)
+		assert.Len(t, list, 7)
+	})
+}
+
+func TestAuditUC_fail(t *testing.T) {
+	t.Run("uc without a repo", func(t *testing.T) {
+		_, err := usecase.NewAuditManager(nil).List(ctx)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		var (
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(uuid.NewString(), repoErr)
+		)
+		_, err := usecase.NewAuditManager(repo).List(ctx)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+	})
+}
+
+func diffFields(t testing.TB, e audit.Entry) map[string]map[string]any {
+	t.Helper()
+	var diff map[string]map[string]any
+	require.NoError(t, json.Unmarshal(e.Diff, &diff))
+	return diff
+}
+func assertDiff(t testing.TB, e audit.Entry, field string, old, new any) {
+	t.Helper()
+	oldJSON, err := json.Marshal(old)
+	require.NoError(t, err)
+	newJSON, err := json.Marshal(new)
+	require.NoError(t, err)
+	var want map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{"old":`+string(oldJSON)+`,"new":`+string(newJSON)+`}`), &want))
+	assert.Equal(t, want, diffFields(t, e)[field], field)
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+)
+
+// DepositManager tracks the security deposit of a lease from receipt until it
+// is disposed at move out, the deposit is kept apart from the rent ledger
+type DepositManager struct {
+	repo DepositRepo
+}
+type DepositRepo interface {
+	GetLease(context.Context, entity.ID) (*entity.Lease, error)
+	AddDepositReceipt(context.Context, entity.DepositReceipt) error
+	ListDepositReceipts(ctx context.Context, leaseID entity.ID) ([]entity.DepositReceipt, error)
+	// StoreDepositDisposition must fail with internal.ErrConflict when the lease already has one
+	StoreDepositDisposition(context.Context, entity.DepositDisposition) error
+	GetDepositDisposition(ctx context.Context, leaseID entity.ID) (*entity.DepositDisposition, error)
+}
+
+var ErrDepositDisposed = errors.New("deposit already disposed")
+
+func NewDepositManager(repo DepositRepo) DepositManager {
+	return DepositManager{repo: repo}
+}
+
+// Receive records money received toward the deposit, it must be in the lease currency
+func (uc DepositManager) Receive(ctx context.Context, r entity.DepositReceipt) (*entity.DepositReceipt, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	account, err := uc.Account(ctx, r.LeaseID)
+	if err != nil {
+		return nil, err
+	}
+	if r.Amount.Currency != account.Required.Currency {
+		return nil, internal.NewErrors(internal.ErrEntityInvalid).Append(
+			internal.NewFieldError("amount", "must be in the lease currency"))
+	}
+	if account.Disposition != nil {
+		return nil, internal.NewErrors(internal.ErrConflict, ErrDepositDisposed)
+	}
+	if err := uc.repo.AddDepositReceipt(ctx, r); err != nil {
+		if errors.Is(err, internal.ErrConflict) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return &r, nil
+}
+
+// Account of the lease deposit with every receipt and the disposition once there is one
+func (uc DepositManager) Account(ctx context.Context, leaseID entity.ID) (*entity.DepositAccount, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	lease, err := uc.repo.GetLease(ctx, leaseID)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	receipts, err := uc.repo.ListDepositReceipts(ctx, leaseID)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	sort.SliceStable(receipts, func(i, j int) bool {
+		a, b := receipts[i], receipts[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+	disposition, err := uc.repo.GetDepositDisposition(ctx, leaseID)
+	if err != nil && !errors.Is(err, internal.ErrEntityNotFound) {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	required := lease.Deposit
+	required.Currency = lease.Currency() // a lease without a deposit may not have set one
+	return &entity.DepositAccount{
+		LeaseID:     leaseID,
+		Required:    required,
+		Receipts:    receipts,
+		Disposition: disposition,
+	}, nil
+}
+
+// Dispose settles the deposit at move out, itemizing the deductions and
+// computing the refund, a deposit can only be disposed once
+func (uc DepositManager) Dispose(ctx context.Context, d entity.DepositDisposition) (*entity.DepositDisposition, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	account, err := uc.Account(ctx, d.LeaseID)
+	if err != nil {
+		return nil, err
+	}
+	if account.Disposition != nil {
+		return nil, internal.NewErrors(internal.ErrConflict, ErrDepositDisposed)
+	}
+	settled, err := d.Settle(account.Held())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.StoreDepositDisposition(ctx, settled); err != nil {
+		if errors.Is(err, internal.ErrConflict) {
+			return nil, internal.NewErrors(internal.ErrConflict, ErrDepositDisposed)
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return &settled, nil
+}
+
+// Statement is the itemized deposit statement as plain text
+func (uc DepositManager) Statement(ctx context.Context, leaseID entity.ID) (string, error) {
+	account, err := uc.Account(ctx, leaseID)
+	if err != nil {
+		return "", err
+	}
+	return account.Statement(), nil
+}
+func (uc DepositManager) Validate() error {
+	if uc.repo == nil {
+		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
+	}
+	return nil
+}
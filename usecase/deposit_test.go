@@ -0,0 +1,112 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/usecase"
+	"github.com/tempcke/schedule"
+)
+
+func TestDepositUC(t *testing.T) {
+	var (
+		repo  = repository.NewInMemoryRepo()
+		uc    = usecase.NewDepositManager(repo)
+		jan1  = schedule.NewDate(2024, time.January, 1)
+		usd   = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		lease = fake.Lease(entity.NewID(), entity.NewID()).
+			WithTerm(jan1, jan1.AddDate(1, 0, -1)).WithDeposit(usd(150000))
+
+		// force repo to implement interface
+		_ usecase.DepositRepo = (*repository.InMemory)(nil)
+	)
+	storeParties(t, repo, lease)
+	_, err := usecase.NewLeaseManager(repo).Store(ctx, lease)
+	require.NoError(t, err)
+
+	account, err := uc.Account(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.Equal(t, usd(150000), account.Required)
+	assert.Equal(t, entity.DepositDue, account.Status())
+
+	// received in two parts, listed by date
+	_, err = uc.Receive(ctx, entity.NewDepositReceipt(lease.ID, usd(50000), jan1.AddDate(0, 0, 14)))
+	require.NoError(t, err)
+	_, err = uc.Receive(ctx, entity.NewDepositReceipt(lease.ID, usd(100000), jan1))
+	require.NoError(t, err)
+
+	account, err = uc.Account(ctx, lease.ID)
+	require.NoError(t, err)
+	require.Len(t, account.Receipts, 2)
+	assert.Equal(t, jan1, account.Receipts[0].Date)
+	assert.Equal(t, usd(150000), account.Held())
+	assert.Equal(t, entity.DepositHeld, account.Status())
+
+	t.Run("receipt in another currency", func(t *testing.T) {
+		_, err := uc.Receive(ctx, entity.NewDepositReceipt(lease.ID, entity.NewMoney(100, "EUR"), jan1))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+
+	disposition, err := uc.Dispose(ctx, entity.NewDepositDisposition(lease.ID, lease.EndDate).WithDeduction(
+		entity.NewDeduction(entity.DeductionCleaning, usd(12500), "carpet cleaning"),
+		entity.NewDeduction(entity.DeductionDamage, usd(7500), "broken blinds"),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, usd(150000), disposition.Held)
+	assert.Equal(t, usd(130000), disposition.Refund)
+	assert.True(t, disposition.Owed.IsZero())
+
+	statement, err := uc.Statement(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.Contains(t, statement, "Refund due to tenant")
+	assert.Contains(t, statement, "1,300.00 USD")
+
+	t.Run("disposed only once", func(t *testing.T) {
+		_, err := uc.Dispose(ctx, entity.NewDepositDisposition(lease.ID, lease.EndDate))
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, usecase.ErrDepositDisposed)
+	})
+	t.Run("no receipts after disposed", func(t *testing.T) {
+		_, err := uc.Receive(ctx, entity.NewDepositReceipt(lease.ID, usd(100), lease.EndDate))
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, usecase.ErrDepositDisposed)
+	})
+	t.Run("unknown lease", func(t *testing.T) {
+		_, err := uc.Receive(ctx, entity.NewDepositReceipt(entity.NewID(), usd(100), jan1))
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("invalid disposition", func(t *testing.T) {
+		_, err := uc.Dispose(ctx, entity.NewDepositDisposition(lease.ID, schedule.Date{}))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+}
+func TestDepositUC_fail(t *testing.T) {
+	var leaseID = entity.NewID()
+	t.Run("uc without a repo", func(t *testing.T) {
+		var (
+			repo usecase.DepositRepo
+			uc   = usecase.NewDepositManager(repo)
+		)
+		_, err := uc.Account(ctx, leaseID)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		var (
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(leaseID, repoErr)
+			uc      = usecase.NewDepositManager(repo)
+		)
+		_, err := uc.Account(ctx, leaseID)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+	})
+}
@@ -7,4 +7,7 @@ import (
 var (
 	ErrRepoNotSet = errors.New("use case repo is required")
 	ErrRepo       = errors.New("error from repository")
+	// ErrStoreRemoved is the reason a record which was removed is not stored,
+	// it has to be restored first
+	ErrStoreRemoved = errors.New("it was removed, restore it before storing it")
 )
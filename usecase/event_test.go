@@ -0,0 +1,144 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/usecase"
+)
+
+func TestEvents(t *testing.T) {
+	t.Run("property", func(t *testing.T) {
+		var (
+			repo, events = eventRepo(t)
+			uc           = usecase.NewPropertyManager(repo).WithPublisher(events)
+			p            = fake.Property()
+		)
+		require.NoError(t, uc.Store(ctx, p))
+		require.NoError(t, uc.Store(ctx, p.WithZip("75402")))
+		require.NoError(t, uc.Remove(ctx, p.ID))
+		assert.Equal(t, []event.Event{
+			event.RentalAdded{PropertyID: p.ID},
+			event.RentalUpdated{PropertyID: p.ID},
+			event.RentalRemoved{PropertyID: p.ID},
+		}, *events.list)
+	})
+	t.Run("tenant", func(t *testing.T) {
+		var (
+			repo, events = eventRepo(t)
+			uc           = usecase.NewTenantManager(repo).WithPublisher(events)
+			tenant       = fake.Tenant()
+		)
+		_, err := uc.Store(ctx, tenant)
+		require.NoError(t, err)
+		_, err = uc.Store(ctx, tenant.WithName("Jane Doe"))
+		require.NoError(t, err)
+		assert.Equal(t, []event.Event{
+			event.TenantAdded{TenantID: tenant.ID},
+			event.TenantUpdated{TenantID: tenant.ID},
+		}, *events.list)
+	})
+	t.Run("lease", func(t *testing.T) {
+		var (
+			repo, events = eventRepo(t)
+			uc           = usecase.NewLeaseManager(repo).WithPublisher(events)
+			tenant       = entity.NewID()
+			lease        = fake.Lease(entity.NewID(), tenant)
+			effective    = lease.StartDate.AddDate(0, 3, 0)
+			endDay       = lease.EndDate.AddDate(0, 6, 0)
+		)
+		storeParties(t, repo, lease)
+		_, err := uc.Store(ctx, lease)
+		require.NoError(t, err)
+		_, err = uc.Store(ctx, lease.WithRent(lease.RentAmount.Mul(2)))
+		require.NoError(t, err)
+		_, err = uc.Amend(ctx, lease.ID, entity.NewLeaseAmendment(effective).WithRent(lease.RentAmount).WithReason("discount"))
+		require.NoError(t, err)
+		next, err := uc.Renew(ctx, lease.ID, entity.NewLeaseRenewal(lease.EndDate.AddDate(1, 0, 0)))
+		require.NoError(t, err)
+		_, err = uc.Terminate(ctx, next.ID, endDay, "moving out")
+		require.NoError(t, err)
+		assert.Equal(t, []event.Event{
+			event.RentalLeased{PropertyID: lease.PropertyID, LeaseID: lease.ID, TenantIDs: []entity.ID{tenant}},
+			event.LeaseUpdated{PropertyID: lease.PropertyID, LeaseID: lease.ID},
+			event.LeaseAmended{PropertyID: lease.PropertyID, LeaseID: lease.ID, Effective: effective, Reason: "discount"},
+			event.LeaseRenewed{PropertyID: lease.PropertyID, LeaseID: next.ID, RenewsID: lease.ID},
+			event.LeaseTerminated{PropertyID: lease.PropertyID, LeaseID: next.ID, EndDate: endDay, Reason: "moving out"},
+		}, *events.list)
+	})
+	t.Run("listing", func(t *testing.T) {
+		var (
+			repo, events = eventRepo(t)
+			uc           = usecase.NewListingManager(repo).WithPublisher(events)
+			p            = fake.Property()
+		)
+		require.NoError(t, repo.StoreProperty(ctx, p))
+		l, err := uc.Store(ctx, fake.Listing(p.ID))
+		require.NoError(t, err)
+		_, err = uc.Publish(ctx, p.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []event.Event{
+			event.RentalListed{PropertyID: p.ID, ListingID: l.ID},
+		}, *events.list)
+	})
+	t.Run("application conversion", func(t *testing.T) {
+		var (
+			repo, events = eventRepo(t)
+			uc           = usecase.NewApplicationManager(repo).WithPublisher(events)
+			p            = fake.Property()
+		)
+		require.NoError(t, repo.StoreProperty(ctx, p))
+		app, err := uc.Submit(ctx, fake.RentalApplication(p.ID))
+		require.NoError(t, err)
+		_, err = uc.Transition(ctx, app.ID, entity.ApplicationApproved, "")
+		require.NoError(t, err)
+		conversion, err := uc.Convert(ctx, app.ID)
+		require.NoError(t, err)
+		want := make([]event.Event, 0, len(conversion.Tenants))
+		for _, tenant := range conversion.Tenants {
+			want = append(want, event.TenantAdded{TenantID: tenant.ID})
+		}
+		assert.Equal(t, want, *events.list)
+	})
+	t.Run("nothing is published when the write fails", func(t *testing.T) {
+		var (
+			p       = fake.Property()
+			tenant  = fake.Tenant()
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(p.ID, repoErr).WithEntityErr(tenant.ID, repoErr)
+			events  = newRecorder()
+		)
+		require.Error(t, usecase.NewPropertyManager(repo).WithPublisher(events).Store(ctx, p))
+		_, err := usecase.NewTenantManager(&repo).WithPublisher(events).Store(ctx, tenant)
+		require.Error(t, err)
+		assert.Empty(t, *events.list)
+	})
+}
+
+// recorder is subscribed to every event on a synchronous dispatcher
+type recorder struct {
+	event.Dispatcher
+	list *[]event.Event
+}
+
+func newRecorder() recorder {
+	r := recorder{Dispatcher: event.NewDispatcher(), list: &[]event.Event{}}
+	r.Subscribe(func(_ context.Context, e event.Event) error {
+		*r.list = append(*r.list, e)
+		return nil
+	})
+	return r
+}
+func eventRepo(t *testing.T) (*repository.InMemory, recorder) {
+	t.Helper()
+	repo := repository.NewInMemoryRepo()
+	return &repo, newRecorder()
+}
@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+// LateFeeManager stores late fee policies and charges the fees they produce
+// to the lease ledger, charging is idempotent so it can run as often as needed
+type LateFeeManager struct {
+	repo  LateFeeRepo
+	clock clockwork.Clock
+}
+type LateFeeRepo interface {
+	LedgerRepo
+	GetProperty(ctx context.Context, id string) (entity.Property, error)
+	StoreLateFeePolicy(context.Context, entity.LateFeePolicy) error
+	// ListLateFeePolicies for any of the lease or property ids
+	ListLateFeePolicies(ctx context.Context, scopeIDs ...entity.ID) ([]entity.LateFeePolicy, error)
+	ListLeaseVersions(ctx context.Context, leaseID entity.ID) ([]entity.LeaseVersion, error)
+}
+
+var (
+	ErrNoLateFeePolicy = errors.New("no late fee policy for lease")
+	ErrAsOfInFuture    = errors.New("asOf can not be after today")
+	ErrLateFeeCurrency = errors.New("late fee policy is not in the lease currency")
+
+	// lateFeeNamespace seeds the deterministic ids of late fee charges
+	lateFeeNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("github.com/tempcke/rpm/latefee"))
+)
+
+func NewLateFeeManager(repo LateFeeRepo) LateFeeManager {
+	return LateFeeManager{
+		repo:  repo,
+		clock: clockwork.NewRealClock(),
+	}
+}
+func (uc LateFeeManager) WithClock(clock clockwork.Clock) LateFeeManager {
+	if clock != nil {
+		uc.clock = clock
+	}
+	return uc
+}
+
+// StorePolicy for a lease or property, it replaces any policy already
+// stored for the same lease or property, a lease policy must be in the
+// lease currency
+func (uc LateFeeManager) StorePolicy(ctx context.Context, p entity.LateFeePolicy) (*entity.LateFeePolicy, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	scopeID := p.LeaseID
+	if p.LeaseID != "" {
+		lease, err := uc.ledgerMan().getLease(ctx, p.LeaseID)
+		if err != nil {
+			return nil, err
+		}
+		if c := p.Currency(); c != "" && c != lease.Currency() {
+			return nil, internal.NewErrors(internal.ErrEntityInvalid).Append(
+				internal.NewFieldError("flatFee", "must be in the lease currency"))
+		}
+	} else {
+		scopeID = p.PropertyID
+		if _, err := uc.repo.GetProperty(ctx, p.PropertyID); err != nil {
+			if errors.Is(err, internal.ErrEntityNotFound) {
+				return nil, err
+			}
+			// TODO: make sure the error is logged here or in the repo layer
+			return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+		}
+	}
+	current, err := uc.policyFor(ctx, scopeID)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil {
+		p.ID = current.ID
+	}
+	if err := uc.repo.StoreLateFeePolicy(ctx, p); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return uc.policyFor(ctx, scopeID)
+}
+
+// Policy which applies to the lease, its own policy or else its property policy
+func (uc LateFeeManager) Policy(ctx context.Context, leaseID entity.ID) (*entity.LateFeePolicy, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	lease, err := uc.ledgerMan().getLease(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.policy(ctx, *lease)
+}
+
+// Assess the late fees owed on the lease as of the end of asOf, a zero asOf is today
+func (uc LateFeeManager) Assess(ctx context.Context, leaseID entity.ID, asOf schedule.Date) ([]entity.LateFee, error) {
+	fees, _, err := uc.assess(ctx, leaseID, asOf)
+	return fees, err
+}
+
+// Apply charges any late fees which have not already been charged
+// running it again on the same day never charges a fee twice
+func (uc LateFeeManager) Apply(ctx context.Context, leaseID entity.ID, asOf schedule.Date) ([]entity.LedgerEntry, error) {
+	if asOf.IsZero() {
+		asOf = uc.today()
+	}
+	fees, ledger, err := uc.assess(ctx, leaseID, asOf)
+	if err != nil {
+		return nil, err
+	}
+	posted := make([]entity.LedgerEntry, 0)
+	for _, fee := range fees {
+		owed := fee.Amount
+		owed.Minor -= ledger.Assessed(fee.Ref())
+		if owed.Minor <= 0 {
+			continue
+		}
+		entry := entity.NewLedgerEntry(leaseID, entity.EntryCharge, owed, asOf).
+			WithID(lateFeeEntryID(leaseID, fee.Ref(), asOf)).
+			WithMemo("late fee for rent due " + fee.DueDate.String()).
+			WithRef(fee.Ref())
+		out, err := uc.ledgerMan().Post(ctx, entry)
+		if err != nil {
+			if errors.Is(err, internal.ErrConflict) {
+				continue // charged by another run for the same day
+			}
+			return nil, err
+		}
+		posted = append(posted, *out)
+	}
+	return posted, nil
+}
+func (uc LateFeeManager) Validate() error {
+	if uc.repo == nil {
+		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
+	}
+	return nil
+}
+
+func (uc LateFeeManager) assess(ctx context.Context, leaseID entity.ID, asOf schedule.Date) ([]entity.LateFee, entity.Ledger, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if asOf.IsZero() {
+		asOf = uc.today()
+	}
+	if asOf.After(uc.today()) {
+		return nil, nil, internal.NewErrors(internal.ErrBadRequest, ErrAsOfInFuture)
+	}
+	lease, err := uc.ledgerMan().getLease(ctx, leaseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	policy, err := uc.policy(ctx, *lease)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c := policy.Currency(); c != "" && c != lease.Currency() {
+		// a property policy applies to leases in any currency
+		return nil, nil, internal.NewErrors(internal.ErrConflict, ErrLateFeeCurrency)
+	}
+	ledger, err := uc.ledgerMan().Ledger(ctx, leaseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if asOf.Before(lease.StartDate) {
+		return make([]entity.LateFee, 0), ledger, nil
+	}
+	// each payment is at the rent in effect on its due date, an amendment
+	// does not change what was owed before it
+	versions, err := uc.repo.ListLeaseVersions(ctx, leaseID)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	history := entity.LeaseHistory{Versions: versions}
+	rent, err := lease.AmendedRentSchedule(entity.NewScheduleOptions().WithUntil(asOf), history)
+	if err != nil {
+		return nil, nil, internal.NewErrors(internal.ErrBadRequest, err)
+	}
+	return policy.Assess(rent, ledger, asOf), ledger, nil
+}
+func (uc LateFeeManager) policy(ctx context.Context, lease entity.Lease) (*entity.LateFeePolicy, error) {
+	list, err := uc.repo.ListLateFeePolicies(ctx, lease.ID, lease.PropertyID)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	var policy *entity.LateFeePolicy
+	for i := range list {
+		if list[i].LeaseID == lease.ID {
+			return &list[i], nil
+		}
+		if list[i].PropertyID == lease.PropertyID {
+			policy = &list[i]
+		}
+	}
+	if policy == nil {
+		return nil, internal.NewErrors(internal.ErrEntityNotFound, ErrNoLateFeePolicy)
+	}
+	return policy, nil
+}
+
+// policyFor returns the policy stored for exactly this lease or property, nil when there is none
+func (uc LateFeeManager) policyFor(ctx context.Context, scopeID entity.ID) (*entity.LateFeePolicy, error) {
+	list, err := uc.repo.ListLateFeePolicies(ctx, scopeID)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return &list[0], nil
+}
+func (uc LateFeeManager) ledgerMan() LedgerManager {
+	return NewLedgerManager(uc.repo)
+}
+func (uc LateFeeManager) today() schedule.Date {
+	return schedule.NewDateFromTime(uc.clock.Now())
+}
+
+// lateFeeEntryID is the same for every run on the same day so two runs racing
+// each other conflict in the repo instead of both charging the fee
+func lateFeeEntryID(leaseID entity.ID, ref string, asOf schedule.Date) entity.ID {
+	return uuid.NewSHA1(lateFeeNamespace, []byte(leaseID+"/"+ref+"/"+asOf.String())).String()
+}
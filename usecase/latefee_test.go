@@ -0,0 +1,185 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/usecase"
+	"github.com/tempcke/schedule"
+)
+
+func TestLateFeeUC(t *testing.T) {
+	var (
+		repo     = repository.NewInMemoryRepo()
+		clock    = clockwork.NewFakeClockAt(time.Date(2024, time.February, 10, 12, 0, 0, 0, time.UTC))
+		uc       = usecase.NewLateFeeManager(repo).WithClock(clock)
+		property = fake.Property()
+		jan1     = schedule.NewDate(2024, time.January, 1)
+		lease    = fake.Lease(property.ID, entity.NewID()).
+				WithTerm(jan1, jan1.AddDate(1, 0, -1)).WithRent(entity.NewMoney(1000, entity.CurrencyUSD))
+		usd         = func(minor int) entity.Money { return entity.NewMoney(minor, entity.CurrencyUSD) }
+		propPolicy  = entity.NewLateFeePolicy().ForProperty(property.ID).WithFlatFee(usd(25))
+		leasePolicy = entity.NewLateFeePolicy().ForLease(lease.ID).
+				WithGraceDays(5).WithFlatFee(usd(50)).WithDailyFee(usd(10), usd(200))
+
+		// force repo to implement interface
+		_ usecase.LateFeeRepo = (*repository.InMemory)(nil)
+	)
+	require.NoError(t, repo.StoreProperty(ctx, property))
+	storeParties(t, repo, lease)
+	_, err := usecase.NewLeaseManager(repo).Store(ctx, lease)
+	require.NoError(t, err)
+
+	// property policy applies until the lease has its own
+	_, err = uc.StorePolicy(ctx, propPolicy)
+	require.NoError(t, err)
+	policy, err := uc.Policy(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.True(t, propPolicy.Equal(*policy))
+
+	_, err = uc.StorePolicy(ctx, leasePolicy.WithFlatFee(usd(1)))
+	require.NoError(t, err)
+	stored, err := uc.StorePolicy(ctx, leasePolicy.WithID(entity.NewID()))
+	require.NoError(t, err)
+	assert.Equal(t, leasePolicy.ID, stored.ID, "should replace the existing lease policy")
+	policy, err = uc.Policy(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.True(t, leasePolicy.Equal(*policy))
+
+	// january is capped, february is 4 days late
+	fees, err := uc.Assess(ctx, lease.ID, schedule.Date{})
+	require.NoError(t, err)
+	require.Len(t, fees, 2)
+	assert.Equal(t, entity.NewMoney(200, entity.CurrencyUSD), fees[0].Amount)
+	assert.Equal(t, entity.NewMoney(90, entity.CurrencyUSD), fees[1].Amount)
+
+	posted, err := uc.Apply(ctx, lease.ID, schedule.Date{})
+	require.NoError(t, err)
+	require.Len(t, posted, 2)
+	for i, e := range posted {
+		assert.Equal(t, entity.EntryCharge, e.Type)
+		assert.Equal(t, fees[i].Amount, e.Amount)
+		assert.Equal(t, fees[i].Ref(), e.Ref)
+		assert.Equal(t, "2024-02-10", e.Date.String())
+	}
+
+	t.Run("running again the same day charges nothing", func(t *testing.T) {
+		posted, err := uc.Apply(ctx, lease.ID, schedule.Date{})
+		require.NoError(t, err)
+		assert.Len(t, posted, 0)
+	})
+	t.Run("next day only charges what accrued", func(t *testing.T) {
+		clock.Advance(24 * time.Hour)
+		posted, err := uc.Apply(ctx, lease.ID, schedule.Date{})
+		require.NoError(t, err)
+		require.Len(t, posted, 1)
+		assert.Equal(t, entity.NewMoney(10, entity.CurrencyUSD), posted[0].Amount)
+		assert.Equal(t, "2024-02-11", posted[0].Date.String())
+	})
+	t.Run("waived fee is not charged again", func(t *testing.T) {
+		ledgerUC := usecase.NewLedgerManager(repo)
+		_, err := ledgerUC.Reverse(ctx, lease.ID, posted[1].ID, posted[1].Date.Next(), "waived")
+		require.NoError(t, err)
+		posted, err := uc.Apply(ctx, lease.ID, schedule.Date{})
+		require.NoError(t, err)
+		assert.Len(t, posted, 0)
+
+		balance, err := ledgerUC.Balance(ctx, lease.ID, schedule.Date{})
+		require.NoError(t, err)
+		assert.Equal(t, entity.NewMoney(200+10, lease.Currency()), balance)
+	})
+	t.Run("amended rent does not change what was owed before it", func(t *testing.T) {
+		other := fake.Property()
+		require.NoError(t, repo.StoreProperty(ctx, other))
+		amended := fake.Lease(other.ID, entity.NewID()).
+			WithTerm(jan1, jan1.AddDate(1, 0, -1)).WithRent(usd(1000))
+		storeParties(t, repo, amended)
+		leaseUC := usecase.NewLeaseManager(repo)
+		_, err := leaseUC.Store(ctx, amended)
+		require.NoError(t, err)
+		feb1 := jan1.AddDate(0, 1, 0)
+		_, err = leaseUC.Amend(ctx, amended.ID, entity.NewLeaseAmendment(feb1).WithRent(usd(2000)))
+		require.NoError(t, err)
+		_, err = uc.StorePolicy(ctx, entity.NewLateFeePolicy().ForLease(amended.ID).WithGraceDays(5).WithRentPercent(1000))
+		require.NoError(t, err)
+
+		// january was unpaid before the amendment, february after it
+		fees, err := uc.Assess(ctx, amended.ID, feb1.AddDate(0, 0, 8))
+		require.NoError(t, err)
+		require.Len(t, fees, 2)
+		assert.Equal(t, usd(1000), fees[0].Unpaid)
+		assert.Equal(t, usd(100), fees[0].Amount)
+		assert.Equal(t, usd(2000), fees[1].Unpaid)
+		assert.Equal(t, usd(200), fees[1].Amount)
+	})
+	t.Run("asOf in the future", func(t *testing.T) {
+		_, err := uc.Apply(ctx, lease.ID, schedule.NewDateFromTime(clock.Now()).Next())
+		require.ErrorIs(t, err, internal.ErrBadRequest)
+		require.ErrorIs(t, err, usecase.ErrAsOfInFuture)
+	})
+	t.Run("lease without a policy", func(t *testing.T) {
+		other := fake.Lease(entity.NewID(), entity.NewID())
+		storeParties(t, repo, other)
+		_, err := usecase.NewLeaseManager(repo).Store(ctx, other)
+		require.NoError(t, err)
+		_, err = uc.Apply(ctx, other.ID, schedule.Date{})
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+		require.ErrorIs(t, err, usecase.ErrNoLateFeePolicy)
+	})
+	t.Run("policy for unknown property", func(t *testing.T) {
+		_, err := uc.StorePolicy(ctx, propPolicy.ForProperty(entity.NewID()))
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("invalid policy", func(t *testing.T) {
+		_, err := uc.StorePolicy(ctx, propPolicy.WithFlatFee(usd(-1)))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("lease policy in another currency", func(t *testing.T) {
+		_, err := uc.StorePolicy(ctx, leasePolicy.WithFlatFee(entity.NewMoney(50, "EUR")).WithDailyFee(entity.Money{}, entity.Money{}))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("property policy in another currency than the lease", func(t *testing.T) {
+		other := fake.Property()
+		require.NoError(t, repo.StoreProperty(ctx, other))
+		otherLease := fake.Lease(other.ID, entity.NewID()).WithCurrency(entity.CurrencyUSD)
+		storeParties(t, repo, otherLease)
+		_, err := usecase.NewLeaseManager(repo).Store(ctx, otherLease)
+		require.NoError(t, err)
+		_, err = uc.StorePolicy(ctx, propPolicy.WithID(entity.NewID()).ForProperty(other.ID).WithFlatFee(entity.NewMoney(25, "EUR")))
+		require.NoError(t, err)
+		_, err = uc.Assess(ctx, otherLease.ID, schedule.Date{})
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, usecase.ErrLateFeeCurrency)
+	})
+}
+func TestLateFeeUC_fail(t *testing.T) {
+	var leaseID = entity.NewID()
+	t.Run("uc without a repo", func(t *testing.T) {
+		var (
+			repo usecase.LateFeeRepo
+			uc   = usecase.NewLateFeeManager(repo)
+		)
+		_, err := uc.Apply(ctx, leaseID, schedule.Date{})
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		var (
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(leaseID, repoErr)
+			uc      = usecase.NewLateFeeManager(repo)
+		)
+		_, err := uc.Apply(ctx, leaseID, schedule.Date{})
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+	})
+}
@@ -0,0 +1,505 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/schedule"
+)
+
+type LeaseManager struct {
+	repo       LeaseRepo
+	units      UnitReader
+	properties LeasePropertyReader
+	tenants    LeaseTenantReader
+	events     event.Publisher
+}
+type LeaseRepo interface {
+	StoreLease(context.Context, entity.Lease) error
+	GetLease(context.Context, entity.ID) (*entity.Lease, error)
+	ListLeases(context.Context, ...filters.LeaseFilter) ([]entity.Lease, error)
+	// StoreLeaseVersion stores the version terms as the lease along with the version,
+	// it must fail with internal.ErrConflict when the version exists or the lease it
+	// renews was already renewed
+	StoreLeaseVersion(context.Context, entity.LeaseVersion) error
+	ListLeaseVersions(ctx context.Context, leaseID entity.ID) ([]entity.LeaseVersion, error)
+}
+
+// LeasePropertyReader and LeaseTenantReader are used to refuse leasing a
+// property or tenant which does not exist, both must fail with
+// internal.ErrEntityNotFound for a removed one
+type (
+	LeasePropertyReader interface {
+		GetProperty(ctx context.Context, id string) (entity.Property, error)
+	}
+	LeaseTenantReader interface {
+		GetTenant(context.Context, entity.ID) (*entity.Tenant, error)
+	}
+)
+
+var (
+	ErrPropertyLeased       = errors.New("property already leased")
+	ErrInvalidTerminateDate = errors.New("terminate date must be within the lease term")
+	ErrLeaseRenewed         = errors.New("lease already renewed")
+	ErrLeaseChanged         = errors.New("lease changed by another request, try again")
+)
+
+// LeaseConflictError is returned when a lease term overlaps another lease on
+// the same property, ConflictID is empty when only the repo detected it
+type LeaseConflictError struct {
+	LeaseID    entity.ID
+	PropertyID entity.ID
+	ConflictID entity.ID
+}
+
+func (e LeaseConflictError) Error() string {
+	if e.ConflictID == "" {
+		return fmt.Sprintf("%s: %s: property[%s]", internal.ErrConflict, ErrPropertyLeased, e.PropertyID)
+	}
+	return fmt.Sprintf("%s: %s: lease[%s]", internal.ErrConflict, ErrPropertyLeased, e.ConflictID)
+}
+func (e LeaseConflictError) Unwrap() []error {
+	return []error{internal.ErrConflict, ErrPropertyLeased}
+}
+
+func NewLeaseManager(repo LeaseRepo) LeaseManager {
+	return LeaseManager{
+		repo:   repo,
+		events: event.Discard,
+	}
+}
+
+// WithPublisher to publish lease events to once they are stored
+func (uc LeaseManager) WithPublisher(p event.Publisher) LeaseManager {
+	if p != nil {
+		uc.events = p
+	}
+	return uc
+}
+
+// WithUnits to lease the units of a property, a lease without a unit is given
+// the only unit of its property
+func (uc LeaseManager) WithUnits(u UnitReader) LeaseManager {
+	if u != nil {
+		uc.units = u
+	}
+	return uc
+}
+
+// WithParties to make sure the property and tenants of a lease exist before
+// it is stored
+func (uc LeaseManager) WithParties(p LeasePropertyReader, t LeaseTenantReader) LeaseManager {
+	if p != nil {
+		uc.properties = p
+	}
+	if t != nil {
+		uc.tenants = t
+	}
+	return uc
+}
+
+// Store a lease, it will fail with a LeaseConflictError if
+// any other lease on the same unit overlaps its term
+// a new lease is stored as version 1 of its history
+func (uc LeaseManager) Store(ctx context.Context, lease entity.Lease) (*entity.Lease, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	if lease.Currency() == "" {
+		lease.RentAmount.Currency = entity.CurrencyUSD
+	}
+	if lease.Deposit.Currency == "" {
+		lease.Deposit.Currency = lease.Currency()
+	}
+	if err := lease.Validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.checkParties(ctx, lease); err != nil {
+		return nil, err
+	}
+	lease, err := uc.assignUnit(ctx, lease)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkOverlap(ctx, lease); err != nil {
+		return nil, err
+	}
+	var e event.Event
+	cur, err := uc.repo.GetLease(ctx, lease.ID)
+	switch {
+	case errors.Is(err, internal.ErrEntityNotFound):
+		e = event.RentalLeased{PropertyID: lease.PropertyID, LeaseID: lease.ID, TenantIDs: lease.TenantIDs}
+		err = uc.repo.StoreLeaseVersion(stageLease(ctx, e, nil, lease), entity.NewLeaseVersion(lease))
+	case err == nil:
+		e = event.LeaseUpdated{PropertyID: lease.PropertyID, LeaseID: lease.ID}
+		err = uc.repo.StoreLease(stageLease(ctx, e, cur, lease), lease)
+	}
+	if err != nil {
+		if errors.Is(err, internal.ErrConflict) {
+			// another request stored an overlapping lease after checkOverlap
+			return nil, LeaseConflictError{LeaseID: lease.ID, PropertyID: lease.PropertyID}
+		}
+		if errors.Is(err, internal.ErrEntityInvalid) {
+			// the repo refuses a property, unit or tenant which does not exist
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	uc.events.Publish(ctx, e)
+	return &lease, nil
+}
+func (uc LeaseManager) Get(ctx context.Context, id entity.ID) (*entity.Lease, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	e, err := uc.repo.GetLease(ctx, id)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return e, nil
+}
+func (uc LeaseManager) List(ctx context.Context, filter ...filters.LeaseFilter) ([]entity.Lease, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	f := filters.MergeLeaseFilters(filter...)
+	if err := f.Paging().Validate(filters.LeaseSorts...); err != nil {
+		return nil, err
+	}
+	list, err := uc.repo.ListLeases(ctx, f)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return list, nil
+}
+
+// Terminate a lease by ending its term on the given date, the date must
+// fall on or after the StartDate and not after the current EndDate
+func (uc LeaseManager) Terminate(ctx context.Context, id entity.ID, endDate schedule.Date, reason string) (*entity.Lease, error) {
+	lease, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if endDate.IsZero() || !lease.InTerm(endDate) {
+		return nil, internal.NewErrors(internal.ErrBadRequest, ErrInvalidTerminateDate)
+	}
+	latest, err := uc.latestVersion(ctx, *lease)
+	if err != nil {
+		return nil, err
+	}
+	terminated := lease.WithTerm(lease.StartDate, endDate)
+	e := event.LeaseTerminated{
+		PropertyID: lease.PropertyID,
+		LeaseID:    lease.ID,
+		EndDate:    endDate,
+		Reason:     reason,
+	}
+	if err := uc.storeVersion(stageLease(ctx, e, lease, terminated), latest.Next(entity.LeaseTerminated, endDate, reason, terminated)); err != nil {
+		return nil, err
+	}
+	uc.events.Publish(ctx, e)
+	return &terminated, nil
+}
+
+// Amend the rent or tenants of a lease from the amendment effective date on,
+// the prior terms are kept as an earlier version
+func (uc LeaseManager) Amend(ctx context.Context, id entity.ID, a entity.LeaseAmendment) (*entity.Lease, error) {
+	lease, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	amended, err := a.Apply(*lease)
+	if err != nil {
+		return nil, err
+	}
+	if err := amended.Validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.checkParties(ctx, amended); err != nil {
+		return nil, err
+	}
+	latest, err := uc.latestVersion(ctx, *lease)
+	if err != nil {
+		return nil, err
+	}
+	if a.Effective.Before(latest.Effective) {
+		return nil, internal.NewErrors(internal.ErrEntityInvalid).Append(
+			internal.NewFieldError("effectiveDate", "must not be before the last change on "+latest.Effective.String()))
+	}
+	e := event.LeaseAmended{
+		PropertyID: lease.PropertyID,
+		LeaseID:    lease.ID,
+		Effective:  a.Effective,
+		Reason:     a.Reason,
+	}
+	if err := uc.storeVersion(stageLease(ctx, e, lease, amended), latest.Next(entity.LeaseAmended, a.Effective, a.Reason, amended)); err != nil {
+		return nil, err
+	}
+	uc.events.Publish(ctx, e)
+	return &amended, nil
+}
+
+// Renew a lease with a new lease for the next term, linked to the lease it
+// renews, a lease can only be renewed once
+func (uc LeaseManager) Renew(ctx context.Context, id entity.ID, r entity.LeaseRenewal) (*entity.Lease, error) {
+	prev, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkNotRenewed(ctx, id); err != nil {
+		return nil, err
+	}
+	lease, err := r.Apply(*prev)
+	if err != nil {
+		return nil, err
+	}
+	if err := lease.Validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.checkParties(ctx, lease); err != nil {
+		return nil, err
+	}
+	if err := uc.checkOverlap(ctx, lease); err != nil {
+		return nil, err
+	}
+	v := entity.NewLeaseVersion(lease)
+	v.Reason = r.Reason
+	e := event.LeaseRenewed{PropertyID: lease.PropertyID, LeaseID: lease.ID, RenewsID: id}
+	if err := uc.repo.StoreLeaseVersion(stageLease(ctx, e, nil, lease), v); err != nil {
+		if errors.Is(err, internal.ErrConflict) {
+			if err := uc.checkNotRenewed(ctx, id); err != nil {
+				return nil, err
+			}
+			return nil, LeaseConflictError{LeaseID: lease.ID, PropertyID: lease.PropertyID}
+		}
+		if errors.Is(err, internal.ErrEntityInvalid) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	uc.events.Publish(ctx, e)
+	return &lease, nil
+}
+
+// History is the version chain of the lease, every version of the leases it
+// renews and the leases which renew it, oldest first
+func (uc LeaseManager) History(ctx context.Context, id entity.ID) (*entity.LeaseHistory, error) {
+	lease, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	chain := []entity.Lease{*lease}
+	for first := *lease; first.RenewsID != ""; first = chain[0] {
+		prev, err := uc.Get(ctx, first.RenewsID)
+		if err != nil {
+			return nil, err
+		}
+		chain = append([]entity.Lease{*prev}, chain...)
+	}
+	for last := *lease; ; last = chain[len(chain)-1] {
+		next, err := uc.List(ctx, filters.NewLeaseFilter().WithRenewsID(last.ID))
+		if err != nil {
+			return nil, err
+		}
+		if len(next) == 0 {
+			break
+		}
+		chain = append(chain, next[0])
+	}
+	var h entity.LeaseHistory
+	for _, l := range chain {
+		versions, err := uc.versions(ctx, l)
+		if err != nil {
+			return nil, err
+		}
+		h.Versions = append(h.Versions, versions...)
+	}
+	return &h, nil
+}
+
+// RentSchedule lists the rent payments due over the lease term, each at the
+// rent in effect on its due date
+func (uc LeaseManager) RentSchedule(ctx context.Context, id entity.ID, opts entity.ScheduleOptions) ([]entity.RentDue, error) {
+	lease, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := uc.versions(ctx, *lease)
+	if err != nil {
+		return nil, err
+	}
+	list, err := lease.AmendedRentSchedule(opts, entity.LeaseHistory{Versions: versions})
+	if err != nil {
+		return nil, internal.NewErrors(internal.ErrBadRequest, err)
+	}
+	return list, nil
+}
+func (uc LeaseManager) Validate() error {
+	if uc.repo == nil {
+		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
+	}
+	return nil
+}
+
+// stageLease stages the event and the change from old to the lease for the
+// write storing it, old is nil when the lease is new
+func stageLease(ctx context.Context, e event.Event, old *entity.Lease, lease entity.Lease) context.Context {
+	c := audit.Change{EntityType: audit.EntityLease, EntityID: lease.ID, New: lease}
+	if old != nil {
+		c.Old = *old
+	}
+	return audit.Stage(event.Stage(ctx, e), c)
+}
+
+// checkParties fails with internal.ErrEntityInvalid and a field error for the
+// property and every tenant of the lease which does not exist or was removed
+func (uc LeaseManager) checkParties(ctx context.Context, lease entity.Lease) error {
+	var errs []error
+	if uc.properties != nil {
+		if _, err := uc.properties.GetProperty(ctx, lease.PropertyID); err != nil {
+			if !errors.Is(err, internal.ErrEntityNotFound) {
+				// TODO: make sure the error is logged here or in the repo layer
+				return internal.NewErrors(internal.ErrInternal, ErrRepo)
+			}
+			errs = append(errs, internal.NewFieldError("propertyID", "property["+lease.PropertyID+"] does not exist or was removed"))
+		}
+	}
+	if uc.tenants != nil {
+		for _, id := range lease.TenantIDs {
+			if _, err := uc.tenants.GetTenant(ctx, id); err != nil {
+				if !errors.Is(err, internal.ErrEntityNotFound) {
+					// TODO: make sure the error is logged here or in the repo layer
+					return internal.NewErrors(internal.ErrInternal, ErrRepo)
+				}
+				errs = append(errs, internal.NewFieldError("tenantIDs", "tenant["+id+"] does not exist or was removed"))
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+
+// assignUnit makes sure the unit of the lease is a unit of its property, a
+// lease without a unit is given the only unit of the property, a property
+// without units is leased as a whole
+func (uc LeaseManager) assignUnit(ctx context.Context, lease entity.Lease) (entity.Lease, error) {
+	if uc.units == nil {
+		return lease, nil
+	}
+	units, err := uc.units.ListUnits(ctx, lease.PropertyID)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return lease, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	if lease.UnitID == "" {
+		switch len(units) {
+		case 0:
+			return lease, nil
+		case 1:
+			return lease.WithUnit(units[0].ID), nil
+		}
+		return lease, internal.NewErrors(internal.ErrEntityInvalid).Append(
+			internal.NewFieldError("unitID", "is required, the property has more than one unit"))
+	}
+	for _, u := range units {
+		if u.ID == lease.UnitID {
+			return lease, nil
+		}
+	}
+	return lease, internal.NewErrors(internal.ErrEntityInvalid).Append(
+		internal.NewFieldError("unitID", "is not a unit of the property"))
+}
+
+func (uc LeaseManager) checkOverlap(ctx context.Context, lease entity.Lease) error {
+	f := filters.NewLeaseFilter().WithPropertyID(lease.PropertyID)
+	existing, err := uc.List(ctx, f)
+	if err != nil {
+		return err
+	}
+	for _, l := range existing {
+		if l.ID != lease.ID && l.Overlaps(lease) {
+			return LeaseConflictError{
+				LeaseID:    lease.ID,
+				PropertyID: lease.PropertyID,
+				ConflictID: l.ID,
+			}
+		}
+	}
+	return nil
+}
+
+func (uc LeaseManager) checkNotRenewed(ctx context.Context, id entity.ID) error {
+	renewals, err := uc.List(ctx, filters.NewLeaseFilter().WithRenewsID(id))
+	if err != nil {
+		return err
+	}
+	if len(renewals) > 0 {
+		return internal.NewErrors(internal.ErrConflict, ErrLeaseRenewed)
+	}
+	return nil
+}
+
+// versions of the lease, it never writes, a lease stored without versions
+// reads as its current terms as version 1 until it is changed
+func (uc LeaseManager) versions(ctx context.Context, lease entity.Lease) ([]entity.LeaseVersion, error) {
+	versions, err := uc.repo.ListLeaseVersions(ctx, lease.ID)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	if len(versions) > 0 {
+		return versions, nil
+	}
+	return []entity.LeaseVersion{entity.NewLeaseVersion(lease)}, nil
+}
+
+// latestVersion of the lease which is about to be changed, a lease stored
+// without versions is given its current terms as version 1 first so the
+// change does not lose them
+func (uc LeaseManager) latestVersion(ctx context.Context, lease entity.Lease) (entity.LeaseVersion, error) {
+	versions, err := uc.repo.ListLeaseVersions(ctx, lease.ID)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return entity.LeaseVersion{}, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	if len(versions) > 0 {
+		return versions[len(versions)-1], nil
+	}
+	v := entity.NewLeaseVersion(lease)
+	if err := uc.repo.StoreLeaseVersion(ctx, v); err != nil && !errors.Is(err, internal.ErrConflict) {
+		// TODO: make sure the error is logged here or in the repo layer
+		return entity.LeaseVersion{}, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return v, nil
+}
+
+// storeVersion of an existing lease, the version number is taken when another
+// request changed the lease after the latest version was read
+func (uc LeaseManager) storeVersion(ctx context.Context, v entity.LeaseVersion) error {
+	if err := uc.repo.StoreLeaseVersion(ctx, v); err != nil {
+		if errors.Is(err, internal.ErrConflict) {
+			return internal.NewErrors(internal.ErrConflict, ErrLeaseChanged)
+		}
+		if errors.Is(err, internal.ErrEntityInvalid) {
+			return err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return nil
+}
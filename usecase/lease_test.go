@@ -0,0 +1,432 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/usecase"
+	"github.com/tempcke/schedule"
+)
+
+func TestLeaseUC(t *testing.T) {
+	var (
+		property = fake.Property()
+		tenant   = fake.Tenant()
+		in1      = fake.Lease(property.ID, tenant.ID)
+		in2      = fake.Lease(fake.Property().ID, tenant.ID)
+		repo     = repository.NewInMemoryRepo()
+		uc       = usecase.NewLeaseManager(repo)
+
+		// force repo to implement interface
+		_ usecase.LeaseRepo = (*repository.InMemory)(nil)
+	)
+
+	// store lease
+	storeParties(t, repo, in1)
+	out, err := uc.Store(ctx, in1)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+
+	// get lease
+	out, err = uc.Get(ctx, in1.GetID())
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	require.True(t, in1.Equal(*out))
+
+	storeParties(t, repo, in2)
+	_, err = uc.Store(ctx, in2)
+	require.NoError(t, err)
+
+	// list all
+	leases, err := uc.List(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(leases))
+
+	// list by property
+	leases, err = uc.List(ctx, filters.NewLeaseFilter().WithPropertyID(property.ID))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(leases))
+	assert.Equal(t, in1.ID, leases[0].ID)
+
+	// updating a lease should not conflict with itself
+	_, err = uc.Store(ctx, in1.WithRent(in1.RentAmount.Mul(2)))
+	require.NoError(t, err)
+}
+func TestLeaseUC_currencyDefault(t *testing.T) {
+	var (
+		repo = repository.NewInMemoryRepo()
+		uc   = usecase.NewLeaseManager(repo)
+		in   = fake.Lease(entity.NewID(), entity.NewID()).WithCurrency("")
+	)
+	storeParties(t, repo, in)
+	out, err := uc.Store(ctx, in)
+	require.NoError(t, err)
+	assert.Equal(t, entity.CurrencyUSD, out.Currency())
+	assert.Equal(t, entity.CurrencyUSD, out.Deposit.Currency)
+}
+func TestLeaseUC_invalid(t *testing.T) {
+	var (
+		repo = repository.NewInMemoryRepo()
+		uc   = usecase.NewLeaseManager(repo)
+		in   = fake.Lease(entity.NewID(), entity.NewID()).WithRent(entity.NewMoney(-1, entity.CurrencyUSD))
+	)
+	out, err := uc.Store(ctx, in)
+	require.Nil(t, out)
+	require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	require.Len(t, internal.FieldErrors(err), 1)
+	_, err = uc.Get(ctx, in.ID)
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+}
+func TestLeaseUC_parties(t *testing.T) {
+	var (
+		repo    = repository.NewInMemoryRepo()
+		uc      = usecase.NewLeaseManager(repo).WithParties(repo, repo)
+		tenant  = fake.Tenant()
+		removed = fake.Tenant()
+		in      = fake.Lease(entity.NewID(), tenant.ID, removed.ID)
+	)
+	require.NoError(t, repo.StoreTenant(ctx, tenant))
+	require.NoError(t, repo.StoreTenant(ctx, removed))
+	require.NoError(t, repo.DeleteTenant(ctx, removed.ID))
+
+	out, err := uc.Store(ctx, in)
+	require.Nil(t, out)
+	require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	fields := internal.FieldErrors(err)
+	require.Len(t, fields, 2)
+	assert.Equal(t, "propertyID", fields[0].Field)
+	assert.Equal(t, "tenantIDs", fields[1].Field)
+	_, err = uc.Get(ctx, in.ID)
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+	t.Run("repo refuses them without readers", func(t *testing.T) {
+		_, err := usecase.NewLeaseManager(repo).Store(ctx, in)
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+}
+func TestLeaseUC_overlap(t *testing.T) {
+	var (
+		property = fake.Property()
+		repo     = repository.NewInMemoryRepo()
+		uc       = usecase.NewLeaseManager(repo)
+		lease1   = fake.Lease(property.ID, entity.NewID())
+	)
+	storeParties(t, repo, lease1)
+	_, err := uc.Store(ctx, lease1)
+	require.NoError(t, err)
+
+	t.Run("overlapping term conflicts", func(t *testing.T) {
+		lease2 := fake.Lease(property.ID, entity.NewID()).
+			WithTerm(lease1.EndDate, lease1.EndDate.AddDate(1, 0, 0))
+		out, err := uc.Store(ctx, lease2)
+		require.Nil(t, out)
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, usecase.ErrPropertyLeased)
+		var conflict usecase.LeaseConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, lease2.ID, conflict.LeaseID)
+		assert.Equal(t, property.ID, conflict.PropertyID)
+		assert.Equal(t, lease1.ID, conflict.ConflictID)
+		_, err = uc.Get(ctx, lease2.ID)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("back to back term is fine", func(t *testing.T) {
+		start := lease1.EndDate.AddDate(0, 0, 1)
+		lease2 := fake.Lease(property.ID, entity.NewID()).
+			WithTerm(start, start.AddDate(1, 0, -1))
+		storeParties(t, repo, lease2)
+		_, err := uc.Store(ctx, lease2)
+		require.NoError(t, err)
+	})
+}
+func TestLeaseUC_repoConflict(t *testing.T) {
+	// the repo may detect an overlap which was stored after checkOverlap ran
+	var (
+		repo  = conflictRepo{repository.NewInMemoryRepo()}
+		uc    = usecase.NewLeaseManager(repo)
+		lease = fake.Lease(entity.NewID(), entity.NewID())
+	)
+	out, err := uc.Store(ctx, lease)
+	require.Nil(t, out)
+	require.ErrorIs(t, err, internal.ErrConflict)
+	require.NotErrorIs(t, err, internal.ErrInternal)
+	var conflict usecase.LeaseConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, lease.ID, conflict.LeaseID)
+	assert.Empty(t, conflict.ConflictID)
+}
+func TestLeaseUC_terminate(t *testing.T) {
+	var (
+		repo   = repository.NewInMemoryRepo()
+		uc     = usecase.NewLeaseManager(repo)
+		lease  = fake.Lease(entity.NewID(), entity.NewID())
+		endDay = lease.StartDate.AddDate(0, 3, -1)
+	)
+	storeParties(t, repo, lease)
+	_, err := uc.Store(ctx, lease)
+	require.NoError(t, err)
+
+	t.Run("date outside of term", func(t *testing.T) {
+		for name, d := range map[string]schedule.Date{
+			"zero":         {},
+			"before start": lease.StartDate.AddDate(0, 0, -1),
+			"after end":    lease.EndDate.AddDate(0, 0, 1),
+		} {
+			out, err := uc.Terminate(ctx, lease.ID, d, "")
+			require.Nil(t, out, name)
+			require.ErrorIs(t, err, internal.ErrBadRequest, name)
+			require.ErrorIs(t, err, usecase.ErrInvalidTerminateDate, name)
+		}
+	})
+	t.Run("unknown lease", func(t *testing.T) {
+		_, err := uc.Terminate(ctx, entity.NewID(), endDay, "")
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("success", func(t *testing.T) {
+		out, err := uc.Terminate(ctx, lease.ID, endDay, "moving out")
+		require.NoError(t, err)
+		require.NotNil(t, out)
+		assert.Equal(t, endDay, out.EndDate)
+		assert.Equal(t, lease.StartDate, out.StartDate)
+
+		stored, err := uc.Get(ctx, lease.ID)
+		require.NoError(t, err)
+		assert.True(t, out.Equal(*stored))
+
+		h, err := uc.History(ctx, lease.ID)
+		require.NoError(t, err)
+		require.Len(t, h.Versions, 2)
+		assert.Equal(t, entity.LeaseTerminated, h.Versions[1].Change)
+		assert.Equal(t, endDay, h.Versions[1].Effective)
+		assert.Equal(t, "moving out", h.Versions[1].Reason)
+	})
+}
+func TestLeaseUC_amend(t *testing.T) {
+	var (
+		repo      = repository.NewInMemoryRepo()
+		uc        = usecase.NewLeaseManager(repo)
+		tenant    = entity.NewID()
+		lease     = fake.Lease(entity.NewID(), entity.NewID())
+		effective = lease.StartDate.AddDate(0, 3, 0)
+		rent      = lease.RentAmount.Mul(2)
+	)
+	storeParties(t, repo, lease.WithTenant(tenant))
+	_, err := uc.Store(ctx, lease)
+	require.NoError(t, err)
+
+	out, err := uc.Amend(ctx, lease.ID, entity.NewLeaseAmendment(effective).
+		WithRent(rent).
+		WithTenantsAdded(tenant).
+		WithReason("roommate"))
+	require.NoError(t, err)
+	require.NotNil(t, out)
+	assert.Equal(t, rent, out.RentAmount)
+	assert.True(t, out.HasTenant(tenant))
+
+	stored, err := uc.Get(ctx, lease.ID)
+	require.NoError(t, err)
+	assert.True(t, out.Equal(*stored))
+
+	h, err := uc.History(ctx, lease.ID)
+	require.NoError(t, err)
+	require.Len(t, h.Versions, 2)
+	assert.Equal(t, entity.LeaseCreated, h.Versions[0].Change)
+	assert.True(t, lease.Equal(h.Versions[0].Terms))
+	assert.Equal(t, entity.LeaseAmended, h.Versions[1].Change)
+	assert.Equal(t, "roommate", h.Versions[1].Reason)
+
+	list, err := uc.RentSchedule(ctx, lease.ID, entity.NewScheduleOptions())
+	require.NoError(t, err)
+	require.Len(t, list, 12)
+	assert.Equal(t, lease.RentAmount, list[2].Amount)
+	assert.Equal(t, rent, list[3].Amount)
+
+	t.Run("effective before last change", func(t *testing.T) {
+		_, err := uc.Amend(ctx, lease.ID, entity.NewLeaseAmendment(effective.AddDate(0, 0, -1)).
+			WithRent(lease.RentAmount))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+		require.Len(t, internal.FieldErrors(err), 1)
+		assert.Equal(t, "effectiveDate", internal.FieldErrors(err)[0].Field)
+	})
+	t.Run("unknown lease", func(t *testing.T) {
+		_, err := uc.Amend(ctx, entity.NewID(), entity.NewLeaseAmendment(effective).WithRent(rent))
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("lease stored without versions", func(t *testing.T) {
+		legacy := fake.Lease(entity.NewID(), entity.NewID())
+		storeParties(t, repo, legacy)
+		require.NoError(t, repo.StoreLease(ctx, legacy))
+
+		// reading the history does not write version 1
+		h, err := uc.History(ctx, legacy.ID)
+		require.NoError(t, err)
+		require.Len(t, h.Versions, 1)
+		stored, err := repo.ListLeaseVersions(ctx, legacy.ID)
+		require.NoError(t, err)
+		assert.Empty(t, stored)
+
+		_, err = uc.Amend(ctx, legacy.ID, entity.NewLeaseAmendment(legacy.StartDate).WithRent(rent))
+		require.NoError(t, err)
+		h, err = uc.History(ctx, legacy.ID)
+		require.NoError(t, err)
+		require.Len(t, h.Versions, 2)
+		assert.True(t, legacy.Equal(h.Versions[0].Terms))
+	})
+}
+func TestLeaseUC_renew(t *testing.T) {
+	var (
+		repo  = repository.NewInMemoryRepo()
+		uc    = usecase.NewLeaseManager(repo)
+		lease = fake.Lease(entity.NewID(), entity.NewID())
+		end   = lease.EndDate.AddDate(1, 0, 0)
+	)
+	storeParties(t, repo, lease)
+	_, err := uc.Store(ctx, lease)
+	require.NoError(t, err)
+
+	next, err := uc.Renew(ctx, lease.ID, entity.NewLeaseRenewal(end).WithReason("annual"))
+	require.NoError(t, err)
+	require.NotNil(t, next)
+	assert.Equal(t, lease.ID, next.RenewsID)
+	assert.Equal(t, lease.EndDate.Next(), next.StartDate)
+
+	// renew the renewal and terminate it early
+	last, err := uc.Renew(ctx, next.ID, entity.NewLeaseRenewal(end.AddDate(1, 0, 0)))
+	require.NoError(t, err)
+	_, err = uc.Terminate(ctx, last.ID, last.StartDate.AddDate(0, 1, 0), "job transfer")
+	require.NoError(t, err)
+
+	// the whole chain is returned from any lease in it
+	for _, id := range []entity.ID{lease.ID, next.ID, last.ID} {
+		h, err := uc.History(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, h.Versions, 4)
+		var changes []entity.LeaseChange
+		for _, v := range h.Versions {
+			changes = append(changes, v.Change)
+		}
+		assert.Equal(t, []entity.LeaseChange{
+			entity.LeaseCreated, entity.LeaseRenewed, entity.LeaseRenewed, entity.LeaseTerminated,
+		}, changes)
+		assert.Equal(t, "annual", h.Versions[1].Reason)
+		assert.Equal(t, next.ID, h.RenewedBy(lease.ID))
+	}
+
+	t.Run("renewed twice", func(t *testing.T) {
+		out, err := uc.Renew(ctx, lease.ID, entity.NewLeaseRenewal(end))
+		require.Nil(t, out)
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, usecase.ErrLeaseRenewed)
+	})
+	t.Run("unknown lease", func(t *testing.T) {
+		_, err := uc.Renew(ctx, entity.NewID(), entity.NewLeaseRenewal(end))
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+}
+func TestLeaseUC_rentSchedule(t *testing.T) {
+	var (
+		repo  = repository.NewInMemoryRepo()
+		uc    = usecase.NewLeaseManager(repo)
+		lease = fake.Lease(entity.NewID(), entity.NewID())
+		noEnd schedule.Date
+	)
+	storeParties(t, repo, lease)
+	_, err := uc.Store(ctx, lease)
+	require.NoError(t, err)
+
+	list, err := uc.RentSchedule(ctx, lease.ID, entity.NewScheduleOptions())
+	require.NoError(t, err)
+	require.Len(t, list, 12)
+	assert.Equal(t, lease.StartDate, list[0].DueDate)
+
+	t.Run("unknown lease", func(t *testing.T) {
+		_, err := uc.RentSchedule(ctx, entity.NewID(), entity.NewScheduleOptions())
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("open ended lease requires until", func(t *testing.T) {
+		openEnded := fake.Lease(entity.NewID(), entity.NewID())
+		openEnded = openEnded.WithTerm(openEnded.StartDate, noEnd)
+		storeParties(t, repo, openEnded)
+		_, err := uc.Store(ctx, openEnded)
+		require.NoError(t, err)
+		_, err = uc.RentSchedule(ctx, openEnded.ID, entity.NewScheduleOptions())
+		require.ErrorIs(t, err, internal.ErrBadRequest)
+		require.ErrorIs(t, err, entity.ErrScheduleUnbounded)
+	})
+}
+func TestLeaseUC_fail(t *testing.T) {
+	t.Run("uc without a repo", func(t *testing.T) {
+		var (
+			in   = fake.Lease(entity.NewID(), entity.NewID())
+			repo usecase.LeaseRepo
+			uc   = usecase.NewLeaseManager(repo)
+		)
+
+		out, err := uc.Store(ctx, in)
+		require.Nil(t, out)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+
+		out, err = uc.Get(ctx, in.ID)
+		require.Nil(t, out)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+
+		_, err = uc.List(ctx)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		var (
+			in      = fake.Lease(entity.NewID(), entity.NewID())
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(in.ID, repoErr)
+			uc      = usecase.NewLeaseManager(repo)
+		)
+
+		out, err := uc.Store(ctx, in)
+		require.Nil(t, out)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+
+		out, err = uc.Get(ctx, in.GetID())
+		require.Nil(t, out)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+
+		_, err = uc.List(ctx)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+	})
+	t.Run("entity not found", func(t *testing.T) {
+		var (
+			repo = repository.NewInMemoryRepo()
+			uc   = usecase.NewLeaseManager(repo)
+		)
+
+		out, err := uc.Get(ctx, entity.NewID())
+		require.Nil(t, out)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+		require.NotErrorIs(t, err, internal.ErrInternal)
+	})
+}
+
+type conflictRepo struct {
+	usecase.LeaseRepo
+}
+
+func (r conflictRepo) StoreLease(context.Context, entity.Lease) error {
+	return internal.MakeErr(internal.ErrConflict, "lease_term_no_overlap")
+}
+func (r conflictRepo) StoreLeaseVersion(context.Context, entity.LeaseVersion) error {
+	return internal.MakeErr(internal.ErrConflict, "lease_term_no_overlap")
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/schedule"
+)
+
+// LedgerManager posts charges, payments, credits and refunds against a lease
+// entries are append only, corrections are made by reversing an entry
+type LedgerManager struct {
+	repo LedgerRepo
+}
+type LedgerRepo interface {
+	GetLease(context.Context, entity.ID) (*entity.Lease, error)
+	AppendLedgerEntry(context.Context, entity.LedgerEntry) error
+	GetLedgerEntry(context.Context, entity.ID) (*entity.LedgerEntry, error)
+	ListLedgerEntries(ctx context.Context, leaseID entity.ID) ([]entity.LedgerEntry, error)
+}
+
+var (
+	ErrAlreadyReversed = errors.New("ledger entry already reversed")
+	ErrReverseReversal = errors.New("a reversing entry can not be reversed")
+	ErrPostReversal    = errors.New("use reverse to post a reversing entry")
+)
+
+func NewLedgerManager(repo LedgerRepo) LedgerManager {
+	return LedgerManager{repo: repo}
+}
+
+// Post appends a new entry to the lease ledger, it must be in the lease currency
+func (uc LedgerManager) Post(ctx context.Context, e entity.LedgerEntry) (*entity.LedgerEntry, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	if e.IsReversal() {
+		return nil, internal.NewErrors(internal.ErrBadRequest, ErrPostReversal)
+	}
+	lease, err := uc.getLease(ctx, e.LeaseID)
+	if err != nil {
+		return nil, err
+	}
+	if e.Amount.Currency != lease.Currency() {
+		return nil, internal.NewErrors(internal.ErrEntityInvalid).Append(
+			internal.NewFieldError("amount", "must be in the lease currency"))
+	}
+	return uc.append(ctx, e)
+}
+
+// Reverse cancels an entry by appending a reversing entry on the given date
+func (uc LedgerManager) Reverse(ctx context.Context, leaseID, entryID entity.ID, date schedule.Date, memo string) (*entity.LedgerEntry, error) {
+	entry, err := uc.GetEntry(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry.LeaseID != leaseID {
+		return nil, internal.MakeErr(internal.ErrEntityNotFound, "ledger entry["+entryID+"]")
+	}
+	if entry.IsReversal() {
+		return nil, internal.NewErrors(internal.ErrBadRequest, ErrReverseReversal)
+	}
+	ledger, err := uc.Ledger(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	if ledger.Reversed(entry.ID) {
+		return nil, internal.NewErrors(internal.ErrConflict, ErrAlreadyReversed)
+	}
+	reversal := entry.Reversal(date, memo)
+	if err := reversal.Validate(); err != nil {
+		return nil, err
+	}
+	return uc.append(ctx, reversal)
+}
+func (uc LedgerManager) GetEntry(ctx context.Context, id entity.ID) (*entity.LedgerEntry, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	e, err := uc.repo.GetLedgerEntry(ctx, id)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return e, nil
+}
+
+// Ledger returns every entry for the lease sorted by date
+func (uc LedgerManager) Ledger(ctx context.Context, leaseID entity.ID) (entity.Ledger, error) {
+	_, ledger, err := uc.leaseLedger(ctx, leaseID)
+	return ledger, err
+}
+
+// Balance owed on the lease at the end of asOf in the lease currency, a zero
+// date includes every entry
+func (uc LedgerManager) Balance(ctx context.Context, leaseID entity.ID, asOf schedule.Date) (entity.Money, error) {
+	lease, ledger, err := uc.leaseLedger(ctx, leaseID)
+	if err != nil {
+		return entity.Money{}, err
+	}
+	return ledger.Balance(lease.Currency(), asOf), nil
+}
+func (uc LedgerManager) Statement(ctx context.Context, leaseID entity.ID, from, until schedule.Date) (*entity.Statement, error) {
+	lease, ledger, err := uc.leaseLedger(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	s := ledger.Statement(leaseID, lease.Currency(), from, until)
+	return &s, nil
+}
+func (uc LedgerManager) Validate() error {
+	if uc.repo == nil {
+		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
+	}
+	return nil
+}
+
+func (uc LedgerManager) leaseLedger(ctx context.Context, leaseID entity.ID) (*entity.Lease, entity.Ledger, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, nil, err
+	}
+	lease, err := uc.getLease(ctx, leaseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	list, err := uc.repo.ListLedgerEntries(ctx, leaseID)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return lease, entity.Ledger(list).Sorted(), nil
+}
+func (uc LedgerManager) append(ctx context.Context, e entity.LedgerEntry) (*entity.LedgerEntry, error) {
+	if err := uc.repo.AppendLedgerEntry(ctx, e); err != nil {
+		if errors.Is(err, internal.ErrConflict) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return uc.GetEntry(ctx, e.ID)
+}
+func (uc LedgerManager) getLease(ctx context.Context, id entity.ID) (*entity.Lease, error) {
+	lease, err := uc.repo.GetLease(ctx, id)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return lease, nil
+}
@@ -0,0 +1,125 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/usecase"
+)
+
+func TestLedgerUC(t *testing.T) {
+	var (
+		repo  = repository.NewInMemoryRepo()
+		lease = fake.Lease(entity.NewID(), entity.NewID())
+		uc    = usecase.NewLedgerManager(repo)
+		day1  = lease.StartDate
+		day2  = day1.Next()
+
+		// force repo to implement interface
+		_ usecase.LedgerRepo = (*repository.InMemory)(nil)
+	)
+	storeParties(t, repo, lease)
+	_, err := usecase.NewLeaseManager(repo).Store(ctx, lease)
+	require.NoError(t, err)
+
+	// charge rent then pay it
+	charge, err := uc.Post(ctx, entity.NewLedgerEntry(lease.ID, entity.EntryCharge, lease.RentAmount, day1))
+	require.NoError(t, err)
+	require.NotNil(t, charge)
+	payment, err := uc.Post(ctx, entity.NewLedgerEntry(lease.ID, entity.EntryPayment, lease.RentAmount, day2))
+	require.NoError(t, err)
+
+	balance, err := uc.Balance(ctx, lease.ID, day1)
+	require.NoError(t, err)
+	assert.Equal(t, lease.RentAmount, balance)
+	balance, err = uc.Balance(ctx, lease.ID, day2)
+	require.NoError(t, err)
+	assert.Equal(t, lease.RentAmount.Zero(), balance)
+
+	// payment bounced
+	reversal, err := uc.Reverse(ctx, lease.ID, payment.ID, day2.Next(), "nsf")
+	require.NoError(t, err)
+	assert.Equal(t, payment.ID, reversal.ReversesID)
+	assert.Equal(t, "nsf", reversal.Memo)
+
+	s, err := uc.Statement(ctx, lease.ID, day2, day2.Next())
+	require.NoError(t, err)
+	assert.Equal(t, lease.RentAmount, s.OpeningBalance)
+	require.Len(t, s.Lines, 2)
+	assert.Equal(t, lease.RentAmount, s.ClosingBalance)
+
+	ledger, err := uc.Ledger(ctx, lease.ID)
+	require.NoError(t, err)
+	require.Len(t, ledger, 3)
+	assert.Equal(t, charge.ID, ledger[0].ID)
+
+	t.Run("already reversed", func(t *testing.T) {
+		_, err := uc.Reverse(ctx, lease.ID, payment.ID, day2.Next(), "again")
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, usecase.ErrAlreadyReversed)
+	})
+	t.Run("reverse a reversal", func(t *testing.T) {
+		_, err := uc.Reverse(ctx, lease.ID, reversal.ID, day2.Next(), "")
+		require.ErrorIs(t, err, internal.ErrBadRequest)
+		require.ErrorIs(t, err, usecase.ErrReverseReversal)
+	})
+	t.Run("reverse entry from another lease", func(t *testing.T) {
+		_, err := uc.Reverse(ctx, entity.NewID(), charge.ID, day2, "")
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("post a reversal", func(t *testing.T) {
+		_, err := uc.Post(ctx, charge.Reversal(day2, ""))
+		require.ErrorIs(t, err, usecase.ErrPostReversal)
+	})
+	t.Run("invalid entry", func(t *testing.T) {
+		_, err := uc.Post(ctx, entity.NewLedgerEntry(lease.ID, entity.EntryPayment, entity.NewMoney(0, lease.Currency()), day2))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("other currency than the lease", func(t *testing.T) {
+		_, err := uc.Post(ctx, entity.NewLedgerEntry(lease.ID, entity.EntryPayment, entity.NewMoney(1, "EUR"), day2))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("unknown lease", func(t *testing.T) {
+		_, err := uc.Post(ctx, entity.NewLedgerEntry(entity.NewID(), entity.EntryPayment, lease.RentAmount, day2))
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+		_, err = uc.Balance(ctx, entity.NewID(), day2)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+}
+func TestLedgerUC_fail(t *testing.T) {
+	var entry = entity.NewLedgerEntry(entity.NewID(), entity.EntryCharge, entity.NewMoney(100, entity.CurrencyUSD), fake.Lease("").StartDate)
+	t.Run("uc without a repo", func(t *testing.T) {
+		var (
+			repo usecase.LedgerRepo
+			uc   = usecase.NewLedgerManager(repo)
+		)
+		_, err := uc.Post(ctx, entry)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+
+		_, err = uc.Balance(ctx, entry.LeaseID, entry.Date)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		var (
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(entry.LeaseID, repoErr)
+			uc      = usecase.NewLedgerManager(repo)
+		)
+		_, err := uc.Post(ctx, entry)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+
+		_, err = uc.Statement(ctx, entry.LeaseID, entry.Date, entry.Date)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+	})
+}
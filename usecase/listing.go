@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// ListingManager advertises vacant properties, each property has at most one
+// listing which is kept as a draft until it is published
+type ListingManager struct {
+	repo   ListingRepo
+	clock  clockwork.Clock
+	events event.Publisher
+}
+type ListingRepo interface {
+	GetProperty(context.Context, string) (entity.Property, error)
+	StoreListing(context.Context, entity.Listing) error
+	// GetListing must fail with internal.ErrEntityNotFound when the property has none
+	GetListing(ctx context.Context, propertyID entity.ID) (*entity.Listing, error)
+	ListListings(context.Context, ...filters.ListingFilter) ([]entity.Listing, error)
+}
+
+// PublicListing is a published listing along with the property it advertises
+type PublicListing struct {
+	Listing  entity.Listing
+	Property entity.Property
+}
+
+// GetID is the id of the listing so public listings are paged like listings
+func (l PublicListing) GetID() entity.ID { return l.Listing.ID }
+
+// PublicListingSortValue is the filters.SortValue of public listings
+func PublicListingSortValue(l PublicListing, field filters.SortField) string {
+	return filters.ListingSortValue(l.Listing, field)
+}
+
+var ErrNoListing = errors.New("no listing for property")
+
+func NewListingManager(repo ListingRepo) ListingManager {
+	return ListingManager{
+		repo:   repo,
+		clock:  clockwork.NewRealClock(),
+		events: event.Discard,
+	}
+}
+func (uc ListingManager) WithClock(clock clockwork.Clock) ListingManager {
+	if clock != nil {
+		uc.clock = clock
+	}
+	return uc
+}
+func (uc ListingManager) WithPublisher(p event.Publisher) ListingManager {
+	if p != nil {
+		uc.events = p
+	}
+	return uc
+}
+
+// Store the listing of a property replacing the one already stored for it
+// storing does not change whether the listing is published, a published
+// listing must stay publishable
+func (uc ListingManager) Store(ctx context.Context, l entity.Listing) (*entity.Listing, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := uc.repo.GetProperty(ctx, l.PropertyID); err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	l = l.Unpublish()
+	current, err := uc.Get(ctx, l.PropertyID)
+	switch {
+	case err == nil:
+		l.ID = current.ID
+		l.Status, l.PublishedAt = current.Status, current.PublishedAt
+	case !errors.Is(err, ErrNoListing):
+		return nil, err
+	}
+	l.Details.PropertyID = l.PropertyID
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+	if l.IsPublished() {
+		if err := l.CanPublish(); err != nil {
+			return nil, err
+		}
+	}
+	return uc.store(ctx, l)
+}
+
+// Get the listing of the property, ErrNoListing when it has none
+func (uc ListingManager) Get(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	l, err := uc.repo.GetListing(ctx, propertyID)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, internal.NewErrors(internal.ErrEntityNotFound, ErrNoListing)
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return l, nil
+}
+
+// Publish the listing of the property so it is shown to the public, it must
+// have an asking rent and an available from date
+func (uc ListingManager) Publish(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	l, err := uc.Get(ctx, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	published, err := l.Publish(uc.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	e := event.RentalListed{PropertyID: published.PropertyID, ListingID: published.ID}
+	out, err := uc.store(event.Stage(ctx, e), published)
+	if err != nil {
+		return nil, err
+	}
+	uc.events.Publish(ctx, e)
+	return out, nil
+}
+
+// Unpublish the listing of the property, it is kept as a draft
+func (uc ListingManager) Unpublish(ctx context.Context, propertyID entity.ID) (*entity.Listing, error) {
+	l, err := uc.Get(ctx, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.store(ctx, l.Unpublish())
+}
+
+// List every listing matching the filter, drafts included
+func (uc ListingManager) List(ctx context.Context, filter ...filters.ListingFilter) ([]entity.Listing, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	f := filters.MergeListingFilters(filter...)
+	if err := f.Paging().Validate(filters.ListingSorts...); err != nil {
+		return nil, err
+	}
+	list, err := uc.repo.ListListings(ctx, f)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return list, nil
+}
+
+// Public lists the published listings matching the filter along with their
+// property, whatever status the filter asks for only published are listed
+func (uc ListingManager) Public(ctx context.Context, filter ...filters.ListingFilter) ([]PublicListing, error) {
+	f := filters.MergeListingFilters(filter...).WithStatus(entity.ListingPublished)
+	list, err := uc.List(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	public := make([]PublicListing, 0, len(list))
+	for _, l := range list {
+		p, err := uc.repo.GetProperty(ctx, l.PropertyID)
+		if err != nil {
+			// TODO: make sure the error is logged here or in the repo layer
+			return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+		}
+		public = append(public, PublicListing{Listing: l, Property: p})
+	}
+	return public, nil
+}
+
+func (uc ListingManager) Validate() error {
+	if uc.repo == nil {
+		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
+	}
+	return nil
+}
+func (uc ListingManager) store(ctx context.Context, l entity.Listing) (*entity.Listing, error) {
+	if err := uc.repo.StoreListing(ctx, l); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return uc.Get(ctx, l.PropertyID)
+}
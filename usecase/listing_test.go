@@ -0,0 +1,120 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/usecase"
+	"github.com/tempcke/schedule"
+)
+
+func TestListingUC(t *testing.T) {
+	var (
+		repo     = repository.NewInMemoryRepo()
+		clock    = clockwork.NewFakeClockAt(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))
+		uc       = usecase.NewListingManager(repo).WithClock(clock)
+		property = fake.Property()
+		byCity   = filters.NewListingFilter().WithCity(property.City)
+
+		// force repo to implement interface
+		_ usecase.ListingRepo = (*repository.InMemory)(nil)
+	)
+	require.NoError(t, repo.StoreProperty(ctx, property))
+
+	_, err := uc.Get(ctx, property.ID)
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	require.ErrorIs(t, err, usecase.ErrNoListing)
+
+	// a draft may be stored without rent, it can not be published yet
+	draft, err := uc.Store(ctx, entity.NewListing(property.ID, entity.Money{}, schedule.Date{}))
+	require.NoError(t, err)
+	assert.Equal(t, entity.ListingDraft, draft.Status)
+	_, err = uc.Publish(ctx, property.ID)
+	require.ErrorIs(t, err, entity.ErrListingNotPublishable)
+
+	// storing again replaces the same listing
+	listing := fake.Listing(property.ID)
+	stored, err := uc.Store(ctx, listing)
+	require.NoError(t, err)
+	assert.Equal(t, draft.ID, stored.ID)
+	assert.True(t, listing.WithID(draft.ID).Equal(*stored))
+
+	public, err := uc.Public(ctx, byCity)
+	require.NoError(t, err)
+	assert.Len(t, public, 0, "drafts are not public")
+
+	published, err := uc.Publish(ctx, property.ID)
+	require.NoError(t, err)
+	assert.True(t, published.IsPublished())
+	assert.Equal(t, clock.Now(), published.PublishedAt)
+
+	// storing keeps it published, it must stay publishable
+	clock.Advance(time.Hour)
+	stored, err = uc.Store(ctx, listing.WithDescription("updated"))
+	require.NoError(t, err)
+	assert.True(t, stored.IsPublished())
+	assert.Equal(t, published.PublishedAt, stored.PublishedAt)
+	_, err = uc.Store(ctx, entity.NewListing(property.ID, entity.Money{}, listing.AvailableFrom))
+	require.ErrorIs(t, err, entity.ErrListingNotPublishable)
+
+	public, err = uc.Public(ctx, byCity.WithStatus(entity.ListingDraft))
+	require.NoError(t, err)
+	require.Len(t, public, 1, "only published listings are public whatever the filter asks for")
+	assert.Equal(t, stored.ID, public[0].Listing.ID)
+	assert.Equal(t, property.ID, public[0].Property.ID)
+
+	unpublished, err := uc.Unpublish(ctx, property.ID)
+	require.NoError(t, err)
+	assert.False(t, unpublished.IsPublished())
+	public, err = uc.Public(ctx, byCity)
+	require.NoError(t, err)
+	assert.Len(t, public, 0)
+	all, err := uc.List(ctx, byCity)
+	require.NoError(t, err)
+	assert.Len(t, all, 1)
+
+	t.Run("property not found", func(t *testing.T) {
+		_, err := uc.Store(ctx, fake.Listing(entity.NewID()))
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("invalid listing", func(t *testing.T) {
+		_, err := uc.Store(ctx, listing.WithPhoto(entity.ListingPhoto{}))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("invalid sort", func(t *testing.T) {
+		_, err := uc.Public(ctx, byCity.WithPage(filters.NewPage().WithSort(filters.SortName, false)))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+}
+func TestListingUC_fail(t *testing.T) {
+	var propertyID = entity.NewID()
+	t.Run("uc without a repo", func(t *testing.T) {
+		var (
+			repo usecase.ListingRepo
+			uc   = usecase.NewListingManager(repo)
+		)
+		_, err := uc.Get(ctx, propertyID)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		var (
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(propertyID, repoErr)
+			uc      = usecase.NewListingManager(repo)
+		)
+		_, err := uc.Get(ctx, propertyID)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+	})
+}
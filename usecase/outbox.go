@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// OutboxManager relays the events repositories stored in their outbox along
+// with the change they describe, a message is retried with exponential
+// backoff until it is delivered or it runs out of attempts and is dead
+type OutboxManager struct {
+	repo  OutboxRepo
+	clock clockwork.Clock
+	retry RetryPolicy
+}
+type OutboxRepo interface {
+	// ClaimOutbox claims up to limit pending messages due at now, they are
+	// not due again until lockedUntil so no other relay claims them meanwhile
+	ClaimOutbox(ctx context.Context, now, lockedUntil time.Time, limit int) ([]event.Message, error)
+	// StoreOutboxMessage must fail with internal.ErrEntityNotFound when the message is not in the outbox
+	StoreOutboxMessage(context.Context, event.Message) error
+	GetOutboxMessage(ctx context.Context, id string) (*event.Message, error)
+	ListOutbox(context.Context, ...filters.OutboxFilter) ([]event.Message, error)
+}
+
+// RetryPolicy for a delivery which failed, the delay doubles with each
+// attempt up to MaxBackoff
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 10,
+	Backoff:     time.Second,
+	MaxBackoff:  time.Hour,
+}
+
+// Next attempt after the failed attempt number, zero once out of attempts
+func (p RetryPolicy) Next(now time.Time, attempt int) time.Time {
+	if attempt >= p.MaxAttempts {
+		return time.Time{}
+	}
+	delay := p.Backoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return now.Add(min(delay, p.MaxBackoff))
+}
+
+const (
+	relayBatch = 100
+	relayLock  = time.Minute // how long a claimed message may take to be delivered
+)
+
+func NewOutboxManager(repo OutboxRepo) OutboxManager {
+	return OutboxManager{
+		repo:  repo,
+		clock: clockwork.NewRealClock(),
+		retry: DefaultRetryPolicy,
+	}
+}
+func (uc OutboxManager) WithClock(clock clockwork.Clock) OutboxManager {
+	if clock != nil {
+		uc.clock = clock
+	}
+	return uc
+}
+func (uc OutboxManager) WithRetryPolicy(p RetryPolicy) OutboxManager {
+	if p.MaxAttempts > 0 && p.Backoff > 0 {
+		uc.retry = p
+	}
+	return uc
+}
+
+// Relay the pending messages which are due to the subscribers of the event,
+// it returns how many were delivered
+func (uc OutboxManager) Relay(ctx context.Context, d event.Deliverer) (int, error) {
+	if err := uc.Validate(); err != nil {
+		return 0, err
+	}
+	now := uc.clock.Now()
+	list, err := uc.repo.ClaimOutbox(ctx, now, now.Add(relayLock), relayBatch)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return 0, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	var delivered int
+	for _, m := range list {
+		m = uc.deliver(ctx, d, m)
+		if err := uc.repo.StoreOutboxMessage(ctx, m); err != nil {
+			// TODO: make sure the error is logged here or in the repo layer
+			return delivered, internal.NewErrors(internal.ErrInternal, ErrRepo)
+		}
+		if m.Status == event.MessageDelivered {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+// Get a message from the outbox
+func (uc OutboxManager) Get(ctx context.Context, id string) (*event.Message, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	m, err := uc.repo.GetOutboxMessage(ctx, id)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return m, nil
+}
+
+// List the messages in the outbox matching the filter, oldest first
+func (uc OutboxManager) List(ctx context.Context, filter ...filters.OutboxFilter) ([]event.Message, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	f := filters.MergeOutboxFilters(filter...)
+	if err := f.Page.Validate(filters.CreatedSorts...); err != nil {
+		return nil, err
+	}
+	list, err := uc.repo.ListOutbox(ctx, f)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return list, nil
+}
+
+// Replay a message, it is pending again with all of its attempts left and
+// relayed on the next run, delivered messages are delivered once more
+func (uc OutboxManager) Replay(ctx context.Context, id string) (*event.Message, error) {
+	m, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	replayed := m.Replay(uc.clock.Now())
+	if err := uc.repo.StoreOutboxMessage(ctx, replayed); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return &replayed, nil
+}
+func (uc OutboxManager) Validate() error {
+	if uc.repo == nil {
+		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
+	}
+	return nil
+}
+
+func (uc OutboxManager) deliver(ctx context.Context, d event.Deliverer, m event.Message) event.Message {
+	e, err := m.Event()
+	if err != nil {
+		// retrying will not make the payload any more readable
+		return m.Failed(err, time.Time{})
+	}
+	if err := d.Deliver(event.WithMessageID(ctx, m.ID), e); err != nil {
+		return m.Failed(err, uc.retry.Next(uc.clock.Now(), m.Attempts+1))
+	}
+	return m.Delivered(uc.clock.Now())
+}
@@ -0,0 +1,134 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/usecase"
+)
+
+func TestOutboxUC(t *testing.T) {
+	var (
+		repo  = repository.NewInMemoryRepo()
+		clock = clockwork.NewFakeClockAt(time.Now())
+		retry = usecase.RetryPolicy{MaxAttempts: 3, Backoff: time.Second, MaxBackoff: time.Minute}
+		uc    = usecase.NewOutboxManager(repo).WithClock(clock).WithRetryPolicy(retry)
+		p     = fake.Property()
+		bus   = event.NewDispatcher()
+		fail  = true
+		got   []event.Event
+		msgID string
+
+		// force repo to implement interface
+		_ usecase.OutboxRepo = (*repository.InMemory)(nil)
+	)
+	bus.Subscribe(func(ctx context.Context, e event.Event) error {
+		if fail {
+			return errors.New("subscriber down")
+		}
+		got, msgID = append(got, e), event.MessageID(ctx)
+		return nil
+	})
+
+	// the use case stages the event, the repo stores it in the outbox
+	require.NoError(t, usecase.NewPropertyManager(repo).Store(ctx, p))
+	pending, err := uc.List(ctx, filters.NewOutboxFilter().WithStatus(event.MessagePending))
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	id := pending[0].ID
+
+	// retried with exponential backoff until out of attempts, the first wait
+	// covers the message being stored just after the clock was set
+	for i, wait := range []time.Duration{time.Second, time.Second, 2 * time.Second} {
+		clock.Advance(wait)
+		n, err := uc.Relay(ctx, bus)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		m, err := uc.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, i+1, m.Attempts)
+		assert.Equal(t, "subscriber down", m.LastError)
+
+		// not due again before the backoff is over
+		n, err = uc.Relay(ctx, bus)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	}
+	dead, err := uc.List(ctx, filters.NewOutboxFilter().WithStatus(event.MessageDead))
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	assert.Equal(t, id, dead[0].ID)
+
+	// replayed once the subscriber is back
+	fail = false
+	clock.Advance(time.Hour)
+	n, err := uc.Relay(ctx, bus)
+	require.NoError(t, err)
+	assert.Zero(t, n, "dead messages are not relayed")
+
+	replayed, err := uc.Replay(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, event.MessagePending, replayed.Status)
+	assert.Zero(t, replayed.Attempts)
+
+	n, err = uc.Relay(ctx, bus)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []event.Event{event.RentalAdded{PropertyID: p.ID}}, got)
+	assert.Equal(t, id, msgID)
+	m, err := uc.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, event.MessageDelivered, m.Status)
+	assert.Equal(t, clock.Now(), m.DeliveredAt)
+
+	_, err = uc.Replay(ctx, uuid.NewString())
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+}
+
+func TestRetryPolicy(t *testing.T) {
+	var (
+		now = time.Now()
+		p   = usecase.RetryPolicy{MaxAttempts: 5, Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	)
+	assert.Equal(t, now.Add(time.Second), p.Next(now, 1))
+	assert.Equal(t, now.Add(2*time.Second), p.Next(now, 2))
+	assert.Equal(t, now.Add(4*time.Second), p.Next(now, 3))
+	assert.Equal(t, now.Add(5*time.Second), p.Next(now, 4))
+	assert.True(t, p.Next(now, 5).IsZero())
+}
+
+func TestOutboxUC_fail(t *testing.T) {
+	t.Run("uc without a repo", func(t *testing.T) {
+		uc := usecase.NewOutboxManager(nil)
+		_, err := uc.Relay(ctx, event.NewDispatcher())
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+		_, err = uc.List(ctx)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+		_, err = uc.Replay(ctx, uuid.NewString())
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		var (
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(uuid.NewString(), repoErr)
+			uc      = usecase.NewOutboxManager(repo)
+		)
+		_, err := uc.Relay(ctx, event.NewDispatcher())
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+		_, err = uc.List(ctx)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+	})
+}
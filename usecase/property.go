@@ -3,15 +3,26 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/search"
+	"github.com/tempcke/schedule"
 )
 
 // PropertyReader allows queries regarding properties
 type (
 	PropertyManager struct {
 		propRepo PropertyRepo
+		leases   LeaseLister
+		clock    clockwork.Clock
+		events   event.Publisher
 	}
 	PropertyReader interface {
 		GetProperty(ctx context.Context, id string) (entity.Property, error)
@@ -19,24 +30,82 @@ type (
 	}
 	PropertyWriter interface {
 		NewProperty(street, city, state, zip string) entity.Property
+		// StoreProperty must give a property without units its default unit,
+		// entity.DefaultUnit, in the same transaction as the property, it must
+		// fail with internal.ErrConflict when the property with the id was removed
 		StoreProperty(context.Context, entity.Property) error
+		// DeleteProperty marks the property as removed, it is kept until purged
 		DeleteProperty(ctx context.Context, id string) error
+		// RestoreProperty must fail with internal.ErrEntityNotFound when there
+		// is no removed property with the id
+		RestoreProperty(ctx context.Context, id string) error
+		// PurgeProperties removed before the time, except those a lease or a
+		// rental application still refers to, returns how many were purged
+		PurgeProperties(ctx context.Context, before time.Time) (int, error)
 	}
 	PropertyRepo interface {
 		PropertyReader
 		PropertyWriter
 	}
 	PropertyFilter struct {
-		Search string
+		Search         string       // words of the address, read by search.Parse
+		IncludeDeleted bool         // removed properties are left out unless this is set
+		Page           filters.Page // order of the list and the part of it to return
+	}
+	// LeaseLister is used to refuse removing a property or tenant with an active lease
+	LeaseLister interface {
+		ListLeases(context.Context, ...filters.LeaseFilter) ([]entity.Lease, error)
 	}
 )
 
 var (
 	noProperty = entity.Property{}
+
+	ErrRemoveLeasedProperty = errors.New("can not remove a property with an active lease")
+	ErrDuplicateAddress     = errors.New("another property has the same address")
 )
 
+// DuplicateAddressError is returned by PropertyManager.Store when a property
+// which was not removed already has the address, PropertyID is that property
+type DuplicateAddressError struct {
+	PropertyID entity.ID
+}
+
+func (e DuplicateAddressError) Error() string {
+	return fmt.Sprintf("%s: %s: property[%s]", internal.ErrConflict, ErrDuplicateAddress, e.PropertyID)
+}
+func (e DuplicateAddressError) Unwrap() []error {
+	return []error{internal.ErrConflict, ErrDuplicateAddress}
+}
+
 func NewPropertyManager(repo PropertyRepo) PropertyManager {
-	return PropertyManager{propRepo: repo}
+	return PropertyManager{
+		propRepo: repo,
+		clock:    clockwork.NewRealClock(),
+		events:   event.Discard,
+	}
+}
+
+// WithLeases so a property with an active lease is not removed
+func (uc PropertyManager) WithLeases(l LeaseLister) PropertyManager {
+	if l != nil {
+		uc.leases = l
+	}
+	return uc
+}
+func (uc PropertyManager) WithClock(clock clockwork.Clock) PropertyManager {
+	if clock != nil {
+		uc.clock = clock
+	}
+	return uc
+}
+
+// WithPublisher to publish rental events to once they are stored
+func (uc PropertyManager) WithPublisher(p event.Publisher) PropertyManager {
+	if p != nil {
+		uc.events = p
+	}
+	return uc
 }
 func (uc PropertyManager) Store(ctx context.Context, p entity.Property) error {
 	if err := uc.Validate(); err != nil {
@@ -45,10 +114,42 @@ func (uc PropertyManager) Store(ctx context.Context, p entity.Property) error {
 	if err := p.Validate(); err != nil {
 		return err
 	}
-	if err := uc.propRepo.StoreProperty(ctx, p); err != nil {
+	dup, err := uc.findAddress(ctx, p.Address, p.ID)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	if dup != "" {
+		return DuplicateAddressError{PropertyID: dup}
+	}
+	var (
+		e event.Event = event.RentalAdded{PropertyID: p.ID}
+		c             = audit.Change{EntityType: audit.EntityProperty, EntityID: p.ID, New: p}
+	)
+	switch cur, err := uc.propRepo.GetProperty(ctx, p.ID); {
+	case err == nil:
+		// the repo keeps when the property was first stored
+		p.CreatedAt = cur.CreatedAt
+		e = event.RentalUpdated{PropertyID: p.ID}
+		c.Old, c.New = cur, p
+	case !errors.Is(err, internal.ErrEntityNotFound):
 		// TODO: make sure the error is logged here or in the repo layer
 		return internal.NewErrors(internal.ErrInternal, ErrRepo)
 	}
+	if err := uc.propRepo.StoreProperty(audit.Stage(event.Stage(ctx, e), c), p); err != nil {
+		// another property may have been stored with the address since it
+		// was looked for, the repo refuses it
+		var dup DuplicateAddressError
+		if errors.As(err, &dup) {
+			return dup
+		}
+		if errors.Is(err, internal.ErrConflict) {
+			return internal.NewErrors(internal.ErrConflict, fmt.Errorf("%w: property[%s]", ErrStoreRemoved, p.ID))
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	uc.events.Publish(ctx, e)
 	return nil
 }
 func (uc PropertyManager) Get(ctx context.Context, id string) (entity.Property, error) {
@@ -69,18 +170,73 @@ func (uc PropertyManager) List(ctx context.Context, f PropertyFilter) ([]entity.
 	if err := uc.Validate(); err != nil {
 		return nil, err
 	}
+	if err := f.Paging().Validate(filters.PropertySorts...); err != nil {
+		return nil, err
+	}
 	return uc.propRepo.PropertyList(ctx, f)
 }
+
+// Remove the property, it is kept so it can be restored until it is purged,
+// it fails with internal.ErrConflict when the property has an active lease
 func (uc PropertyManager) Remove(ctx context.Context, id string) error {
-	if err := uc.Validate(); err != nil {
+	cur, err := uc.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			// already removed or never existed
+			return nil
+		}
+		return err
+	}
+	if err := uc.refuseActiveLease(ctx, filters.NewLeaseFilter().WithPropertyID(id), ErrRemoveLeasedProperty); err != nil {
 		return err
 	}
-	if err := uc.propRepo.DeleteProperty(ctx, id); err != nil {
+	var (
+		e = event.RentalRemoved{PropertyID: id}
+		c = audit.Change{EntityType: audit.EntityProperty, EntityID: id, Old: cur}
+	)
+	if err := uc.propRepo.DeleteProperty(audit.Stage(event.Stage(ctx, e), c), id); err != nil {
 		// TODO: make sure the error is logged here or in the repo layer
 		return internal.NewErrors(internal.ErrInternal, ErrRepo)
 	}
+	uc.events.Publish(ctx, e)
 	return nil
 }
+
+// Restore a removed property, it fails with internal.ErrEntityNotFound when
+// there is no removed property with the id
+func (uc PropertyManager) Restore(ctx context.Context, id string) (entity.Property, error) {
+	if err := uc.Validate(); err != nil {
+		return noProperty, err
+	}
+	var (
+		e = event.RentalRestored{PropertyID: id}
+		c = audit.Change{EntityType: audit.EntityProperty, EntityID: id, Action: audit.ActionRestore}
+	)
+	if err := uc.propRepo.RestoreProperty(audit.Stage(event.Stage(ctx, e), c), id); err != nil {
+		var dup DuplicateAddressError
+		if errors.Is(err, internal.ErrEntityNotFound) || errors.As(err, &dup) {
+			return noProperty, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return noProperty, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	uc.events.Publish(ctx, e)
+	return uc.Get(ctx, id)
+}
+
+// Purge the properties removed longer ago than the retention, returns how
+// many were purged
+func (uc PropertyManager) Purge(ctx context.Context, retention time.Duration) (int, error) {
+	if err := uc.Validate(); err != nil {
+		return 0, err
+	}
+	n, err := uc.propRepo.PurgeProperties(ctx, uc.clock.Now().Add(-retention))
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return 0, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return n, nil
+}
 func (uc PropertyManager) Search(ctx context.Context, substr string) ([]entity.Property, error) {
 	return uc.List(ctx, PropertyFilter{Search: substr})
 }
@@ -91,7 +247,60 @@ func (uc PropertyManager) Validate() error {
 	return nil
 }
 
+// findAddress returns the id of a property other than the one with the id
+// skip which was not removed and has the same Address.Key, the search lists
+// every property which may have it
+func (uc PropertyManager) findAddress(ctx context.Context, a entity.Address, skip entity.ID) (entity.ID, error) {
+	var (
+		key = a.Key()
+		n   = a.Normalized()
+	)
+	list, err := uc.propRepo.PropertyList(ctx, NewPropertyFilter().WithSearch(n.Street+" "+n.City+" "+n.Zip5()))
+	if err != nil {
+		return "", err
+	}
+	for _, p := range list {
+		if p.ID != skip && p.Address.Key() == key {
+			return p.ID, nil
+		}
+	}
+	return "", nil
+}
+func (uc PropertyManager) refuseActiveLease(ctx context.Context, f filters.LeaseFilter, reason error) error {
+	return refuseActiveLease(ctx, uc.leases, schedule.NewDateFromTime(uc.clock.Now()), f, reason)
+}
+
+// refuseActiveLease fails with internal.ErrConflict when any lease matching
+// the filter has not ended by today, nothing is refused without leases
+func refuseActiveLease(ctx context.Context, leases LeaseLister, today schedule.Date, f filters.LeaseFilter, reason error) error {
+	if leases == nil {
+		return nil
+	}
+	list, err := leases.ListLeases(ctx, f)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	for _, l := range list {
+		if !l.Ended(today) {
+			return internal.NewErrors(internal.ErrConflict, fmt.Errorf("%w: lease[%s]", reason, l.ID))
+		}
+	}
+	return nil
+}
+
 var AllProperties = PropertyFilter{}
 
-func NewPropertyFilter() PropertyFilter                     { return PropertyFilter{} }
-func (f PropertyFilter) WithSearch(s string) PropertyFilter { f.Search = s; return f }
+func NewPropertyFilter() PropertyFilter                         { return PropertyFilter{} }
+func (f PropertyFilter) WithSearch(s string) PropertyFilter     { f.Search = s; return f }
+func (f PropertyFilter) WithDeleted() PropertyFilter            { f.IncludeDeleted = true; return f }
+func (f PropertyFilter) WithPage(p filters.Page) PropertyFilter { f.Page = p; return f }
+
+// Paging is the page of the list, a search without a sort is ordered by rank
+// with the most relevant property first
+func (f PropertyFilter) Paging() filters.Page {
+	if f.Page.Sort == "" && !search.Parse(f.Search).Empty() {
+		return f.Page.WithSort(filters.SortRank, true)
+	}
+	return f.Page
+}
@@ -5,15 +5,19 @@ package usecase_test
 
 import (
 	"fmt"
+	"math/rand"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/entity/fake"
 	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/internal/repository"
 	"github.com/tempcke/rpm/internal/test"
 	"github.com/tempcke/rpm/usecase"
@@ -50,6 +54,47 @@ func TestAddProperty(t *testing.T) {
 		_, err = repo.GetProperty(ctx, p.ID)
 		require.Error(t, err)
 	})
+	t.Run("duplicate address", func(t *testing.T) {
+		var (
+			repo = repository.NewInMemoryRepo()
+			uc   = usecase.NewPropertyManager(repo)
+			p    = repo.NewProperty("1234 N Main st.", "Dallas", "TX", "75401")
+			dup  = repo.NewProperty("1234 north main STREET", "dallas", "tx", "75401-1234")
+		)
+		require.NoError(t, uc.Store(ctx, p))
+		require.NoError(t, uc.Store(ctx, p), "a property is not a duplicate of itself")
+
+		err := uc.Store(ctx, dup)
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, usecase.ErrDuplicateAddress)
+		var dupErr usecase.DuplicateAddressError
+		require.ErrorAs(t, err, &dupErr)
+		assert.Equal(t, p.ID, dupErr.PropertyID)
+		_, err = repo.GetProperty(ctx, dup.ID)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+		// another unit of the building is another address
+		require.NoError(t, uc.Store(ctx, dup.WithAddress(dup.Address.WithUnit("Apt 2"))))
+
+		// the address of a removed property can be used again
+		require.NoError(t, uc.Remove(ctx, p.ID))
+		require.NoError(t, uc.Store(ctx, dup))
+
+		// so it can not be restored while the other one has it
+		_, err = uc.Restore(ctx, p.ID)
+		require.ErrorAs(t, err, &dupErr)
+		assert.Equal(t, dup.ID, dupErr.PropertyID)
+	})
+	t.Run("invalid address", func(t *testing.T) {
+		p := repo.NewProperty("1234 N Main st.", "Dallas", "XX", "754")
+		err := uc.Store(ctx, p)
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+		var fields []string
+		for _, fe := range internal.FieldErrors(err) {
+			fields = append(fields, fe.Field)
+		}
+		assert.Equal(t, []string{"state", "zip"}, fields)
+	})
 	t.Run("any repo error should be internal", func(t *testing.T) {
 		var (
 			p       = entity.NewProperty("1234 N Main st.", "Dallas", "TX", "75401")
@@ -77,7 +122,7 @@ func TestListProperties(t *testing.T) {
 	})
 	t.Run("two properties", func(t *testing.T) {
 		p1 := r.NewProperty("100 N Main st.", "Dallas", "TX", "75401")
-		p2 := r.NewProperty("100 N Main st.", "Dallas", "TX", "75401")
+		p2 := r.NewProperty("101 N Main st.", "Dallas", "TX", "75401")
 		require.NoError(t, r.StoreProperty(ctx, p1))
 		require.NoError(t, r.StoreProperty(ctx, p2))
 
@@ -91,6 +136,17 @@ func TestListProperties(t *testing.T) {
 		assert.ErrorIs(t, err, internal.ErrInternal)
 		assert.ErrorIs(t, err, usecase.ErrRepoNotSet)
 	})
+	t.Run("invalid page", func(t *testing.T) {
+		pages := map[string]filters.Page{
+			"sort":      filters.NewPage().WithSort(filters.SortName, false),
+			"page size": filters.NewPage().WithSize(-1),
+			"cursor":    filters.NewPage().WithCursor("nonsense"),
+		}
+		for name, page := range pages {
+			_, err := usecase.NewPropertyManager(r).List(ctx, usecase.NewPropertyFilter().WithPage(page))
+			assert.ErrorIs(t, err, internal.ErrEntityInvalid, name)
+		}
+	})
 }
 func TestGetProperty(t *testing.T) {
 	repo := repository.NewInMemoryRepo()
@@ -145,6 +201,57 @@ func TestDelProperty(t *testing.T) {
 		err := usecase.NewPropertyManager(repo).Remove(ctx, id)
 		assert.Nil(t, err)
 	})
+	t.Run("removed property is kept until purged", func(t *testing.T) {
+		var (
+			p    = fake.Property()
+			repo = repository.NewInMemoryRepo()
+			uc   = usecase.NewPropertyManager(repo)
+		)
+		require.NoError(t, uc.Store(ctx, p))
+		require.NoError(t, uc.Remove(ctx, p.ID))
+
+		list, err := uc.List(ctx, usecase.AllProperties)
+		require.NoError(t, err)
+		assert.NotContains(t, propertyIDs(list), p.ID)
+		list, err = uc.List(ctx, usecase.NewPropertyFilter().WithDeleted())
+		require.NoError(t, err)
+		assert.Contains(t, propertyIDs(list), p.ID)
+
+		out, err := uc.Restore(ctx, p.ID)
+		require.NoError(t, err)
+		assert.True(t, p.Equal(out))
+
+		// only a removed property can be restored
+		_, err = uc.Restore(ctx, p.ID)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+		require.NoError(t, uc.Remove(ctx, p.ID))
+		n, err := uc.WithClock(clockwork.NewFakeClockAt(time.Now().Add(48*time.Hour))).Purge(ctx, 24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		list, err = uc.List(ctx, usecase.NewPropertyFilter().WithDeleted())
+		require.NoError(t, err)
+		assert.NotContains(t, propertyIDs(list), p.ID)
+	})
+	t.Run("property with an active lease is not removed", func(t *testing.T) {
+		var (
+			p     = fake.Property()
+			uc    = usecase.NewPropertyManager(repo).WithLeases(repo)
+			lease = fake.Lease(p.ID, fake.Tenant().ID)
+		)
+		require.NoError(t, uc.Store(ctx, p))
+		require.NoError(t, repo.StoreLease(ctx, lease))
+
+		err := uc.Remove(ctx, p.ID)
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, usecase.ErrRemoveLeasedProperty)
+		_, err = uc.Get(ctx, p.ID)
+		require.NoError(t, err)
+
+		// the lease has ended by the day after its last day
+		afterLease := clockwork.NewFakeClockAt(lease.EndDate.Next().ToTime())
+		require.NoError(t, uc.WithClock(afterLease).Remove(ctx, p.ID))
+	})
 }
 func TestListProperties_Search(t *testing.T) {
 	var (
@@ -153,10 +260,11 @@ func TestListProperties_Search(t *testing.T) {
 		street = ucFirst(scope) + " st" // capitalize first letter
 		city1  = "City1-" + scope
 		city2  = "City2-" + scope
-		state1 = strings.ToUpper(scope[0:2])
-		zip1   = "10001-" + scope
-		zip2   = "10002-" + scope
-		zip3   = "10003-" + scope
+		state1 = "OH"
+		plus4  = fmt.Sprintf("%04d", rand.Intn(10000)) // zips must be valid, the +4 keeps them unique
+		zip1   = "10001-" + plus4
+		zip2   = "10002-" + plus4
+		zip3   = "10003-" + plus4
 		p1     = entity.NewProperty("100 "+street, city1, state1, zip1)
 		p2     = entity.NewProperty("101 "+street, city1, state1, zip2)
 		p3     = entity.NewProperty("102 "+street, city2, state1, zip3)
@@ -200,6 +308,13 @@ func TestListProperties_Search(t *testing.T) {
 	}
 }
 
+func propertyIDs(list []entity.Property) []entity.ID {
+	var ids = make([]entity.ID, len(list))
+	for i, p := range list {
+		ids[i] = p.ID
+	}
+	return ids
+}
 func newPropertyFixture(r usecase.PropertyRepo) entity.Property {
 	return r.NewProperty("1234 N Main st.", "Dallas", "TX", "75401")
 }
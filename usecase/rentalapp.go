@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// ApplicationManager takes rental applications from submission through review
+// and converts approved applications into tenants and a draft lease
+// applications are screened on submission when their property has a policy
+type ApplicationManager struct {
+	repo   ApplicationRepo
+	events event.Publisher
+}
+type ApplicationRepo interface {
+	ScreeningRepo
+	StoreRentalApplication(context.Context, entity.RentalApplication) error
+	ListRentalApplications(context.Context, ...filters.ApplicationFilter) ([]entity.RentalApplication, error)
+	// ConvertRentalApplication stores the tenants and links them to the
+	// applicants, it must fail with internal.ErrConflict when already converted
+	// and stage the events and audit changes of the context in the same transaction
+	ConvertRentalApplication(context.Context, entity.RentalApplication, []entity.Tenant) error
+}
+
+// ApplicationConversion is the result of converting an approved application
+// the Lease is a draft which has not been stored, rent and deposit must be set
+// before it is stored with the LeaseManager
+type ApplicationConversion struct {
+	Application entity.RentalApplication
+	Tenants     []entity.Tenant
+	Lease       entity.Lease
+}
+
+var ErrApplicationExists = errors.New("application already submitted")
+
+func NewApplicationManager(repo ApplicationRepo) ApplicationManager {
+	return ApplicationManager{repo: repo, events: event.Discard}
+}
+
+// WithPublisher to publish the events of the tenants added by a conversion to
+func (uc ApplicationManager) WithPublisher(p event.Publisher) ApplicationManager {
+	if p != nil {
+		uc.events = p
+	}
+	return uc
+}
+
+// Submit a new application, it always starts as entity.ApplicationSubmitted
+// and is screened right away when the property has a screening policy
+func (uc ApplicationManager) Submit(ctx context.Context, a entity.RentalApplication) (*entity.RentalApplication, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	a.Status = entity.ApplicationSubmitted
+	a.Notes = nil
+	a.TenantIDs = nil
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := uc.repo.GetProperty(ctx, a.PropertyID); err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, internal.NewErrors(internal.ErrEntityInvalid).Append(
+				internal.NewFieldError("propertyID", "property not found"))
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	if _, err := uc.repo.GetRentalApplication(ctx, a.ID); err == nil {
+		return nil, internal.NewErrors(internal.ErrConflict, ErrApplicationExists)
+	} else if !errors.Is(err, internal.ErrEntityNotFound) {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	if err := uc.repo.StoreRentalApplication(ctx, a); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	if _, err := uc.screeningMan().Screen(ctx, a.ID); err != nil && !errors.Is(err, ErrNoScreeningPolicy) {
+		return nil, err
+	}
+	return uc.Get(ctx, a.ID)
+}
+func (uc ApplicationManager) Get(ctx context.Context, id entity.ID) (*entity.RentalApplication, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	a, err := uc.repo.GetRentalApplication(ctx, id)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return a, nil
+}
+func (uc ApplicationManager) List(ctx context.Context, filter ...filters.ApplicationFilter) ([]entity.RentalApplication, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	f := filters.MergeApplicationFilters(filter...)
+	if err := f.Page.Validate(filters.CreatedSorts...); err != nil {
+		return nil, err
+	}
+	list, err := uc.repo.ListRentalApplications(ctx, f)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return list, nil
+}
+
+// Transition moves the application to status recording the decision note
+// see entity.RentalApplication.Transition for which changes are allowed
+func (uc ApplicationManager) Transition(ctx context.Context, id entity.ID, status entity.ApplicationStatus, note string) (*entity.RentalApplication, error) {
+	a, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	next, err := a.Transition(status, note)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.StoreRentalApplication(ctx, next); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return uc.Get(ctx, id)
+}
+
+// Convert an approved application into a tenant for every applicant and a
+// draft lease for them which starts on the move in date and runs for a year,
+// each tenant is added with its event and audit entry like TenantManager adds it
+func (uc ApplicationManager) Convert(ctx context.Context, id entity.ID) (*ApplicationConversion, error) {
+	a, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a.Converted() {
+		return nil, internal.NewErrors(internal.ErrConflict, entity.ErrApplicationConverted)
+	}
+	if a.Status != entity.ApplicationApproved {
+		return nil, internal.NewErrors(internal.ErrConflict, entity.ErrApplicationNotReady)
+	}
+	var (
+		tenants = make([]entity.Tenant, 0, len(a.Applicants))
+		events  = make([]event.Event, 0, len(a.Applicants))
+		changes = make([]audit.Change, 0, len(a.Applicants))
+	)
+	for _, ap := range a.Applicants {
+		t := ap.ToTenant()
+		tenants = append(tenants, t)
+		events = append(events, event.TenantAdded{TenantID: t.ID})
+		// personal information is masked so the audit log does not keep it
+		changes = append(changes, audit.Change{EntityType: audit.EntityTenant, EntityID: t.ID, New: t.MaskPII()})
+	}
+	if err := uc.repo.ConvertRentalApplication(audit.Stage(event.Stage(ctx, events...), changes...), *a, tenants); err != nil {
+		if errors.Is(err, internal.ErrConflict) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	uc.events.Publish(ctx, events...)
+	converted, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	lease := entity.NewLease(a.PropertyID).
+		WithTenant(converted.TenantIDs...).
+		WithTerm(a.MoveInDate, a.MoveInDate.AddDate(1, 0, -1)).
+		WithCurrency(entity.CurrencyUSD).
+		WithRentInterval(entity.IntervalMonthly)
+	return &ApplicationConversion{
+		Application: *converted,
+		Tenants:     tenants,
+		Lease:       lease,
+	}, nil
+}
+func (uc ApplicationManager) Validate() error {
+	if uc.repo == nil {
+		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
+	}
+	return nil
+}
+func (uc ApplicationManager) screeningMan() ScreeningManager {
+	return NewScreeningManager(uc.repo)
+}
@@ -0,0 +1,141 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/usecase"
+)
+
+func TestApplicationUC(t *testing.T) {
+	var (
+		repo     = repository.NewInMemoryRepo()
+		uc       = usecase.NewApplicationManager(repo)
+		property = fake.Property()
+
+		// force repo to implement interface
+		_ usecase.ApplicationRepo = (*repository.InMemory)(nil)
+	)
+	require.NoError(t, repo.StoreProperty(ctx, property))
+
+	app := fake.RentalApplication(property.ID).WithApplicant(fake.Applicant())
+	got, err := uc.Submit(ctx, app)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ApplicationSubmitted, got.Status)
+
+	_, err = uc.Submit(ctx, app)
+	assert.ErrorIs(t, err, internal.ErrConflict, "already submitted")
+
+	// can not convert until approved
+	_, err = uc.Convert(ctx, app.ID)
+	assert.ErrorIs(t, err, entity.ErrApplicationNotReady)
+
+	_, err = uc.Transition(ctx, app.ID, entity.ApplicationUnderReview, "")
+	require.NoError(t, err)
+	got, err = uc.Transition(ctx, app.ID, entity.ApplicationApproved, "income verified")
+	require.NoError(t, err)
+	assert.Equal(t, entity.ApplicationApproved, got.Status)
+	require.Len(t, got.Notes, 2)
+
+	list, err := uc.List(ctx, filters.NewApplicationFilter().WithStatus(entity.ApplicationApproved))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, app.ID, list[0].ID)
+
+	conversion, err := uc.Convert(ctx, app.ID)
+	require.NoError(t, err)
+	require.Len(t, conversion.Tenants, 2)
+	assert.Equal(t, app.Applicants[0].FullName, conversion.Tenants[0].FullName)
+	assert.Equal(t, []entity.ID{conversion.Tenants[0].ID, conversion.Tenants[1].ID}, conversion.Application.TenantIDs)
+	tenant, err := repo.GetTenant(ctx, conversion.Tenants[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, app.Applicants[1].FullName, tenant.FullName)
+
+	// the tenants are audited like tenants added by the TenantManager
+	for _, tenant := range conversion.Tenants {
+		entries, err := usecase.NewAuditManager(repo).List(ctx, filters.NewAuditFilter().WithEntity(audit.EntityTenant, tenant.ID))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, audit.ActionCreate, entries[0].Action)
+	}
+
+	lease := conversion.Lease
+	assert.Equal(t, property.ID, lease.PropertyID)
+	assert.Equal(t, conversion.Application.TenantIDs, lease.TenantIDs)
+	assert.True(t, app.MoveInDate.Equal(lease.StartDate))
+	_, err = repo.GetLease(ctx, lease.ID)
+	assert.ErrorIs(t, err, internal.ErrEntityNotFound, "draft lease must not be stored")
+
+	_, err = uc.Convert(ctx, app.ID)
+	assert.ErrorIs(t, err, internal.ErrConflict)
+	assert.ErrorIs(t, err, entity.ErrApplicationConverted)
+}
+func TestApplicationUC_invalid(t *testing.T) {
+	var (
+		repo     = repository.NewInMemoryRepo()
+		uc       = usecase.NewApplicationManager(repo)
+		property = fake.Property()
+	)
+	require.NoError(t, repo.StoreProperty(ctx, property))
+
+	t.Run("property not found", func(t *testing.T) {
+		_, err := uc.Submit(ctx, fake.RentalApplication(entity.NewID()))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+		assert.Equal(t, "propertyID", internal.FieldErrors(err)[0].Field)
+	})
+	t.Run("no applicants", func(t *testing.T) {
+		app := fake.RentalApplication(property.ID)
+		app.Applicants = nil
+		_, err := uc.Submit(ctx, app)
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("deny without a note", func(t *testing.T) {
+		app, err := uc.Submit(ctx, fake.RentalApplication(property.ID))
+		require.NoError(t, err)
+		_, err = uc.Transition(ctx, app.ID, entity.ApplicationDenied, "")
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("final status", func(t *testing.T) {
+		app, err := uc.Submit(ctx, fake.RentalApplication(property.ID))
+		require.NoError(t, err)
+		_, err = uc.Transition(ctx, app.ID, entity.ApplicationDenied, "credit score")
+		require.NoError(t, err)
+		_, err = uc.Transition(ctx, app.ID, entity.ApplicationApproved, "")
+		require.ErrorIs(t, err, internal.ErrConflict)
+	})
+	t.Run("not found", func(t *testing.T) {
+		_, err := uc.Transition(ctx, entity.NewID(), entity.ApplicationApproved, "")
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+}
+func TestApplicationUC_fail(t *testing.T) {
+	var appID = entity.NewID()
+	t.Run("uc without a repo", func(t *testing.T) {
+		var (
+			repo usecase.ApplicationRepo
+			uc   = usecase.NewApplicationManager(repo)
+		)
+		_, err := uc.Get(ctx, appID)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		var (
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(appID, repoErr)
+			uc      = usecase.NewApplicationManager(repo)
+		)
+		_, err := uc.Get(ctx, appID)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+	})
+}
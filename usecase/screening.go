@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+)
+
+// ScreeningManager stores the screening policy of each property and screens
+// rental applications against it, every screening is kept as a report so it
+// can be seen later which criteria were applied to an application
+type ScreeningManager struct {
+	repo ScreeningRepo
+}
+type ScreeningRepo interface {
+	GetProperty(context.Context, string) (entity.Property, error)
+	GetRentalApplication(context.Context, entity.ID) (*entity.RentalApplication, error)
+	StoreScreeningPolicy(context.Context, entity.ScreeningPolicy) error
+	// GetScreeningPolicy must fail with internal.ErrEntityNotFound when the property has none
+	GetScreeningPolicy(ctx context.Context, propertyID entity.ID) (*entity.ScreeningPolicy, error)
+	AddScreeningReport(context.Context, entity.ScreeningReport) error
+	// ListScreeningReports matching the filter, oldest first
+	ListScreeningReports(context.Context, ...filters.ScreeningReportFilter) ([]entity.ScreeningReport, error)
+}
+
+var ErrNoScreeningPolicy = errors.New("no screening policy for property")
+
+func NewScreeningManager(repo ScreeningRepo) ScreeningManager {
+	return ScreeningManager{repo: repo}
+}
+
+// StorePolicy for a property, it replaces the policy already stored for it
+// applications already screened keep the reports made with the old policy
+func (uc ScreeningManager) StorePolicy(ctx context.Context, p entity.ScreeningPolicy) (*entity.ScreeningPolicy, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := uc.repo.GetProperty(ctx, p.PropertyID); err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	current, err := uc.Policy(ctx, p.PropertyID)
+	switch {
+	case err == nil:
+		p.ID = current.ID
+	case !errors.Is(err, ErrNoScreeningPolicy):
+		return nil, err
+	}
+	if err := uc.repo.StoreScreeningPolicy(ctx, p); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return uc.Policy(ctx, p.PropertyID)
+}
+
+// Policy of the property, ErrNoScreeningPolicy when it has none
+func (uc ScreeningManager) Policy(ctx context.Context, propertyID entity.ID) (*entity.ScreeningPolicy, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	p, err := uc.repo.GetScreeningPolicy(ctx, propertyID)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, internal.NewErrors(internal.ErrEntityNotFound, ErrNoScreeningPolicy)
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return p, nil
+}
+
+// Screen the application against the current policy of its property and
+// store the report, ErrNoScreeningPolicy when the property has none
+// screening never changes the status of the application, that is left to a person
+func (uc ScreeningManager) Screen(ctx context.Context, applicationID entity.ID) (*entity.ScreeningReport, error) {
+	a, err := uc.application(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := uc.Policy(ctx, a.PropertyID)
+	if err != nil {
+		return nil, err
+	}
+	report := policy.Evaluate(*a)
+	if err := uc.repo.AddScreeningReport(ctx, report); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return &report, nil
+}
+
+// Reports made for the application of the filter, oldest first
+func (uc ScreeningManager) Reports(ctx context.Context, filter ...filters.ScreeningReportFilter) ([]entity.ScreeningReport, error) {
+	f := filters.MergeScreeningReportFilters(filter...)
+	if _, err := uc.application(ctx, f.ApplicationID); err != nil {
+		return nil, err
+	}
+	if err := f.Page.Validate(filters.CreatedSorts...); err != nil {
+		return nil, err
+	}
+	list, err := uc.repo.ListScreeningReports(ctx, f)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return list, nil
+}
+func (uc ScreeningManager) Validate() error {
+	if uc.repo == nil {
+		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
+	}
+	return nil
+}
+
+func (uc ScreeningManager) application(ctx context.Context, id entity.ID) (*entity.RentalApplication, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	a, err := uc.repo.GetRentalApplication(ctx, id)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return a, nil
+}
@@ -0,0 +1,121 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/usecase"
+)
+
+func TestScreeningUC(t *testing.T) {
+	var (
+		repo     = repository.NewInMemoryRepo()
+		uc       = usecase.NewScreeningManager(repo)
+		appMan   = usecase.NewApplicationManager(repo)
+		property = fake.Property()
+		noPets   = entity.NewScreeningRule(entity.CriterionNoPets, entity.ScreeningActionFail)
+		income   = entity.NewScreeningRule(entity.CriterionMinIncome, entity.ScreeningActionReview).WithLimit(300)
+
+		// force repo to implement interface
+		_ usecase.ScreeningRepo = (*repository.InMemory)(nil)
+	)
+	require.NoError(t, repo.StoreProperty(ctx, property))
+
+	// submitted before there is a policy, nothing to screen against
+	early, err := appMan.Submit(ctx, fake.RentalApplication(property.ID))
+	require.NoError(t, err)
+	reports, err := uc.Reports(ctx, filters.NewScreeningReportFilter().WithApplicationID(early.ID))
+	require.NoError(t, err)
+	assert.Len(t, reports, 0)
+	_, err = uc.Screen(ctx, early.ID)
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	require.ErrorIs(t, err, usecase.ErrNoScreeningPolicy)
+
+	policy, err := uc.StorePolicy(ctx, entity.NewScreeningPolicy(property.ID, noPets))
+	require.NoError(t, err)
+	assert.Equal(t, []entity.ScreeningRule{noPets}, policy.Rules)
+
+	// storing again replaces the rules of the same policy
+	replaced, err := uc.StorePolicy(ctx, entity.NewScreeningPolicy(property.ID, noPets, income).
+		WithRent(entity.NewMoney(100000, entity.CurrencyUSD)))
+	require.NoError(t, err)
+	assert.Equal(t, policy.ID, replaced.ID)
+	assert.Len(t, replaced.Rules, 2)
+
+	// screened on submit
+	ap := fake.Applicant()
+	ap.HasPets = true
+	ap.MonthlyIncome = entity.NewMoney(400000, entity.CurrencyUSD)
+	app, err := appMan.Submit(ctx, fake.RentalApplication(property.ID).WithApplicant(ap))
+	require.NoError(t, err)
+	assert.Equal(t, entity.ApplicationSubmitted, app.Status, "screening does not change the status")
+	reports, err = uc.Reports(ctx, filters.NewScreeningReportFilter().WithApplicationID(app.ID))
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, entity.ScreeningFail, reports[0].Result)
+	assert.Equal(t, replaced.Rules, reports[0].Rules)
+	require.Len(t, reports[0].Findings, 1)
+	assert.Equal(t, noPets, reports[0].Findings[0].Rule)
+	assert.Equal(t, ap.FullName, reports[0].Findings[0].Applicant)
+
+	// the early application can now be screened on request
+	report, err := uc.Screen(ctx, early.ID)
+	require.NoError(t, err)
+	reports, err = uc.Reports(ctx, filters.NewScreeningReportFilter().WithApplicationID(early.ID))
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, report.ID, reports[0].ID)
+
+	t.Run("property not found", func(t *testing.T) {
+		_, err := uc.StorePolicy(ctx, entity.NewScreeningPolicy(entity.NewID(), noPets))
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("invalid policy", func(t *testing.T) {
+		_, err := uc.StorePolicy(ctx, entity.NewScreeningPolicy(property.ID, income))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("page", func(t *testing.T) {
+		reports, err := uc.Reports(ctx, filters.NewScreeningReportFilter().
+			WithApplicationID(early.ID).WithPage(filters.NewPage().WithSize(1)))
+		require.NoError(t, err)
+		assert.Len(t, reports, 1)
+
+		_, err = uc.Reports(ctx, filters.NewScreeningReportFilter().
+			WithApplicationID(early.ID).WithPage(filters.NewPage().WithSort(filters.SortName, false)))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("application not found", func(t *testing.T) {
+		_, err := uc.Reports(ctx, filters.NewScreeningReportFilter().WithApplicationID(entity.NewID()))
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+}
+func TestScreeningUC_fail(t *testing.T) {
+	var propertyID = entity.NewID()
+	t.Run("uc without a repo", func(t *testing.T) {
+		var (
+			repo usecase.ScreeningRepo
+			uc   = usecase.NewScreeningManager(repo)
+		)
+		_, err := uc.Policy(ctx, propertyID)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		var (
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(propertyID, repoErr)
+			uc      = usecase.NewScreeningManager(repo)
+		)
+		_, err := uc.Policy(ctx, propertyID)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+	})
+}
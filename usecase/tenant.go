@@ -3,33 +3,168 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/audit"
+	"github.com/tempcke/rpm/internal/event"
 	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/schedule"
 )
 
 type TenantManager struct {
-	repo TenantRepo
+	repo   TenantRepo
+	leases LeaseLister
+	clock  clockwork.Clock
+	events event.Publisher
 }
 type TenantRepo interface {
+	// StoreTenant must fail with internal.ErrConflict when the tenant with the
+	// id was removed
 	StoreTenant(context.Context, entity.Tenant) error
 	GetTenant(context.Context, entity.ID) (*entity.Tenant, error)
 	ListTenants(context.Context, ...filters.TenantFilter) ([]entity.Tenant, error)
+	// DeleteTenant marks the tenant as removed, it is kept until purged
+	DeleteTenant(context.Context, entity.ID) error
+	// RestoreTenant must fail with internal.ErrEntityNotFound when there is
+	// no removed tenant with the id
+	RestoreTenant(context.Context, entity.ID) error
+	// PurgeTenants removed before the time, except those a lease or a rental
+	// application still refers to, returns how many were purged
+	PurgeTenants(ctx context.Context, before time.Time) (int, error)
 }
 
+var (
+	ErrRemoveLeasedTenant = errors.New("can not remove a tenant on an active lease")
+	ErrPhoneExists        = errors.New("tenant already has a phone with the number")
+)
+
 func NewTenantManager(repo TenantRepo) TenantManager {
-	return TenantManager{repo: repo}
+	return TenantManager{
+		repo:   repo,
+		clock:  clockwork.NewRealClock(),
+		events: event.Discard,
+	}
+}
+
+// WithLeases so a tenant on an active lease is not removed
+func (uc TenantManager) WithLeases(l LeaseLister) TenantManager {
+	if l != nil {
+		uc.leases = l
+	}
+	return uc
+}
+func (uc TenantManager) WithClock(clock clockwork.Clock) TenantManager {
+	if clock != nil {
+		uc.clock = clock
+	}
+	return uc
+}
+
+// WithPublisher to publish tenant events to once they are stored
+func (uc TenantManager) WithPublisher(p event.Publisher) TenantManager {
+	if p != nil {
+		uc.events = p
+	}
+	return uc
 }
 
 func (uc TenantManager) Store(ctx context.Context, tenant entity.Tenant) (*entity.Tenant, error) {
 	if err := uc.Validate(); err != nil {
 		return nil, err
 	}
-	if err := uc.repo.StoreTenant(ctx, tenant); err != nil {
+	cur, err := uc.repo.GetTenant(ctx, tenant.ID)
+	if err != nil && !errors.Is(err, internal.ErrEntityNotFound) {
 		// TODO: make sure the error is logged here or in the repo layer
 		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
 	}
+	return uc.store(ctx, cur, tenant)
+}
+
+// Patch changes only the fields of the patch, it fails with
+// internal.ErrEntityNotFound when there is no tenant with the id
+func (uc TenantManager) Patch(ctx context.Context, id entity.ID, patch entity.TenantPatch) (*entity.Tenant, error) {
+	if err := patch.Validate(); err != nil {
+		return nil, err
+	}
+	cur, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return uc.store(ctx, cur, patch.Apply(*cur))
+}
+
+// AddPhone to the tenant, it fails with internal.ErrConflict when the tenant
+// already has a phone with the number
+func (uc TenantManager) AddPhone(ctx context.Context, id entity.ID, phone entity.Phone) (*entity.Tenant, error) {
+	if err := phone.Validate(); err != nil {
+		return nil, err
+	}
+	cur, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cur.HasPhone(phone.Number) {
+		return nil, internal.NewErrors(internal.ErrConflict, ErrPhoneExists)
+	}
+	return uc.store(ctx, cur, cur.WithPhone(phone))
+}
+
+// UpdatePhone replaces the phone with the number, the number itself may be
+// changed as long as the tenant has no other phone with the new one
+func (uc TenantManager) UpdatePhone(ctx context.Context, id entity.ID, number string, phone entity.Phone) (*entity.Tenant, error) {
+	if err := phone.Validate(); err != nil {
+		return nil, err
+	}
+	cur, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !cur.HasPhone(number) {
+		return nil, internal.MakeErr(internal.ErrEntityNotFound, "phone["+number+"]")
+	}
+	if phone.Number != number && cur.HasPhone(phone.Number) {
+		return nil, internal.NewErrors(internal.ErrConflict, ErrPhoneExists)
+	}
+	return uc.store(ctx, cur, cur.WithPhoneReplaced(number, phone))
+}
+
+// RemovePhone with the number from the tenant, nothing is changed when the
+// tenant has no phone with the number
+func (uc TenantManager) RemovePhone(ctx context.Context, id entity.ID, number string) (*entity.Tenant, error) {
+	cur, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !cur.HasPhone(number) {
+		return cur, nil
+	}
+	return uc.store(ctx, cur, cur.WithoutPhone(number))
+}
+
+// store the tenant, cur is the tenant as it was stored before or nil when it
+// is a new tenant
+func (uc TenantManager) store(ctx context.Context, cur *entity.Tenant, tenant entity.Tenant) (*entity.Tenant, error) {
+	var (
+		e event.Event = event.TenantAdded{TenantID: tenant.ID}
+		// personal information is masked so the audit log does not keep it
+		c = audit.Change{EntityType: audit.EntityTenant, EntityID: tenant.ID, New: tenant.MaskPII()}
+	)
+	if cur != nil {
+		e = event.TenantUpdated{TenantID: tenant.ID}
+		c.Old = cur.MaskPII()
+	}
+	if err := uc.repo.StoreTenant(audit.Stage(event.Stage(ctx, e), c), tenant); err != nil {
+		if errors.Is(err, internal.ErrConflict) {
+			return nil, internal.NewErrors(internal.ErrConflict, fmt.Errorf("%w: tenant[%s]", ErrStoreRemoved, tenant.ID))
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	uc.events.Publish(ctx, e)
 	return &tenant, nil
 }
 func (uc TenantManager) Get(ctx context.Context, id entity.ID) (*entity.Tenant, error) {
@@ -50,13 +185,83 @@ func (uc TenantManager) List(ctx context.Context, filter ...filters.TenantFilter
 	if err := uc.Validate(); err != nil {
 		return nil, err
 	}
-	list, err := uc.repo.ListTenants(ctx, filter...)
+	f := filters.MergeTenantFilters(filter...)
+	if err := f.Page.Validate(filters.TenantSorts...); err != nil {
+		return nil, err
+	}
+	if f.LeasingPropertyID != "" && f.LeasingOn.IsZero() {
+		// today by the clock of the use case rather than the one of the repo
+		f.LeasingOn = schedule.NewDateFromTime(uc.clock.Now())
+	}
+	list, err := uc.repo.ListTenants(ctx, f)
 	if err != nil {
 		// TODO: make sure the error is logged here or in the repo layer
 		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
 	}
 	return list, nil
 }
+
+// Remove the tenant, it is kept so it can be restored until it is purged,
+// it fails with internal.ErrConflict when the tenant is on an active lease
+func (uc TenantManager) Remove(ctx context.Context, id entity.ID) error {
+	cur, err := uc.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			// already removed or never existed
+			return nil
+		}
+		return err
+	}
+	today := schedule.NewDateFromTime(uc.clock.Now())
+	if err := refuseActiveLease(ctx, uc.leases, today, filters.NewLeaseFilter().WithTenantID(id), ErrRemoveLeasedTenant); err != nil {
+		return err
+	}
+	var (
+		e = event.TenantRemoved{TenantID: id}
+		c = audit.Change{EntityType: audit.EntityTenant, EntityID: id, Old: cur.MaskPII()}
+	)
+	if err := uc.repo.DeleteTenant(audit.Stage(event.Stage(ctx, e), c), id); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	uc.events.Publish(ctx, e)
+	return nil
+}
+
+// Restore a removed tenant, it fails with internal.ErrEntityNotFound when
+// there is no removed tenant with the id
+func (uc TenantManager) Restore(ctx context.Context, id entity.ID) (*entity.Tenant, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	var (
+		e = event.TenantRestored{TenantID: id}
+		c = audit.Change{EntityType: audit.EntityTenant, EntityID: id, Action: audit.ActionRestore}
+	)
+	if err := uc.repo.RestoreTenant(audit.Stage(event.Stage(ctx, e), c), id); err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	uc.events.Publish(ctx, e)
+	return uc.Get(ctx, id)
+}
+
+// Purge the tenants removed longer ago than the retention, returns how many
+// were purged
+func (uc TenantManager) Purge(ctx context.Context, retention time.Duration) (int, error) {
+	if err := uc.Validate(); err != nil {
+		return 0, err
+	}
+	n, err := uc.repo.PurgeTenants(ctx, uc.clock.Now().Add(-retention))
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return 0, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return n, nil
+}
 func (uc TenantManager) Validate() error {
 	if uc.repo == nil {
 		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
@@ -3,14 +3,19 @@ package usecase_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
 	"github.com/tempcke/rpm/entity/fake"
 	"github.com/tempcke/rpm/internal"
 	"github.com/tempcke/rpm/internal/filters"
 	"github.com/tempcke/rpm/internal/repository"
 	"github.com/tempcke/rpm/usecase"
+	"github.com/tempcke/schedule"
 )
 
 func TestTenantUC(t *testing.T) {
@@ -101,3 +106,181 @@ func TestTenantUC_fail(t *testing.T) {
 		require.NotErrorIs(t, err, internal.ErrInternal)
 	})
 }
+
+func TestTenantUC_Remove(t *testing.T) {
+	var (
+		repo  = repository.NewInMemoryRepo()
+		clock = clockwork.NewFakeClock()
+		uc    = usecase.NewTenantManager(repo).WithLeases(repo).WithClock(clock)
+		in1   = fake.Tenant()
+		in2   = fake.Tenant()
+	)
+	for _, in := range []entity.Tenant{in1, in2} {
+		_, err := uc.Store(ctx, in)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, uc.Remove(ctx, in1.ID))
+	_, err := uc.Get(ctx, in1.ID)
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+
+	// removed tenants are only listed when asked for
+	list, err := uc.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, in2.ID, list[0].ID)
+
+	list, err = uc.List(ctx, filters.NewTenantFilter().WithDeleted())
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	t.Run("remove is idempotent", func(t *testing.T) {
+		require.NoError(t, uc.Remove(ctx, in1.ID))
+		require.NoError(t, uc.Remove(ctx, "doesNotExist"))
+	})
+	t.Run("restore", func(t *testing.T) {
+		out, err := uc.Restore(ctx, in1.ID)
+		require.NoError(t, err)
+		require.NotNil(t, out)
+		assert.True(t, in1.Equal(*out))
+		assert.False(t, out.Deleted())
+
+		// only a removed tenant can be restored
+		_, err = uc.Restore(ctx, in1.ID)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("tenant on an active lease", func(t *testing.T) {
+		var (
+			tenant = fake.Tenant()
+			lease  = fake.Lease(fake.Property().ID, tenant.ID)
+		)
+		_, err := uc.Store(ctx, tenant)
+		require.NoError(t, err)
+		storeParties(t, repo, lease)
+		require.NoError(t, repo.StoreLease(ctx, lease))
+
+		err = uc.Remove(ctx, tenant.ID)
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, usecase.ErrRemoveLeasedTenant)
+		_, err = uc.Get(ctx, tenant.ID)
+		require.NoError(t, err)
+
+		// once the lease ended the tenant can be removed
+		clock.Advance(lease.EndDate.Next().ToTime().Sub(clock.Now()) + 24*time.Hour)
+		require.NoError(t, uc.Remove(ctx, tenant.ID))
+	})
+	t.Run("purge", func(t *testing.T) {
+		var (
+			tenant = fake.Tenant()
+			leased = fake.Tenant()
+			start  = schedule.Today().AddDate(-2, 0, 0)
+			lease  = fake.Lease(fake.Property().ID, leased.ID).WithTerm(start, start.AddDate(1, 0, -1))
+			uc     = uc.WithClock(clockwork.NewFakeClockAt(time.Now().Add(48 * time.Hour)))
+		)
+		for _, in := range []entity.Tenant{tenant, leased} {
+			_, err := uc.Store(ctx, in)
+			require.NoError(t, err)
+		}
+		storeParties(t, repo, lease)
+		require.NoError(t, repo.StoreLease(ctx, lease))
+		require.NoError(t, uc.Remove(ctx, tenant.ID))
+		require.NoError(t, uc.Remove(ctx, leased.ID))
+		removed := filters.NewTenantFilter().WithDeleted().WithIDs(tenant.ID, leased.ID)
+
+		// still within the retention
+		n, err := uc.Purge(ctx, 72*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+
+		n, err = uc.Purge(ctx, 24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		// a tenant on a lease is kept for the history of the lease
+		list, err := uc.List(ctx, removed)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, leased.ID, list[0].ID)
+	})
+}
+func TestTenantUC_Patch(t *testing.T) {
+	var (
+		repo = repository.NewInMemoryRepo()
+		uc   = usecase.NewTenantManager(repo)
+		in   = fake.Tenant()
+	)
+	in.DLNum, in.DLState = "646673153", "TX"
+	_, err := uc.Store(ctx, in)
+	require.NoError(t, err)
+
+	name := fake.FullName()
+	out, err := uc.Patch(ctx, in.ID, entity.NewTenantPatch().WithName(name).WithDL("", ""))
+	require.NoError(t, err)
+	assert.Equal(t, name, out.FullName)
+	assert.Equal(t, "", out.DLNum)
+	assert.Equal(t, "", out.DLState)
+	assert.Equal(t, in.DateOfBirth, out.DateOfBirth)
+	assert.Equal(t, in.Phones, out.Phones)
+
+	got, err := uc.Get(ctx, in.ID)
+	require.NoError(t, err)
+	assert.Equal(t, *out, *got)
+
+	t.Run("invalid patch", func(t *testing.T) {
+		_, err := uc.Patch(ctx, in.ID, entity.NewTenantPatch().WithDOB(schedule.Date{}))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+		_, err = uc.Patch(ctx, in.ID, entity.NewTenantPatch().WithField("ssn", in))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("unknown tenant", func(t *testing.T) {
+		_, err := uc.Patch(ctx, entity.NewID(), entity.NewTenantPatch().WithName(name))
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+}
+func TestTenantUC_Phones(t *testing.T) {
+	var (
+		repo = repository.NewInMemoryRepo()
+		uc   = usecase.NewTenantManager(repo)
+		p1   = entity.NewPhone("555-010-0001").WithNotes("mobile")
+		p2   = entity.NewPhone("555-010-0002").WithNotes("work")
+		in   = fake.Tenant().WithID(entity.NewID())
+	)
+	in.Phones = []entity.Phone{p1}
+	_, err := uc.Store(ctx, in)
+	require.NoError(t, err)
+
+	out, err := uc.AddPhone(ctx, in.ID, p2)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.Phone{p1, p2}, out.Phones)
+
+	_, err = uc.AddPhone(ctx, in.ID, p2.WithNotes("again"))
+	require.ErrorIs(t, err, internal.ErrConflict)
+	require.ErrorIs(t, err, usecase.ErrPhoneExists)
+	_, err = uc.AddPhone(ctx, in.ID, entity.Phone{})
+	require.ErrorIs(t, err, internal.ErrEntityInvalid)
+
+	// the number itself can be changed
+	p3 := entity.NewPhone("555-010-0003")
+	out, err = uc.UpdatePhone(ctx, in.ID, p2.Number, p3)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.Phone{p1, p3}, out.Phones)
+
+	_, err = uc.UpdatePhone(ctx, in.ID, p2.Number, p2)
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	_, err = uc.UpdatePhone(ctx, in.ID, p3.Number, p1)
+	require.ErrorIs(t, err, internal.ErrConflict)
+
+	out, err = uc.RemovePhone(ctx, in.ID, p1.Number)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.Phone{p3}, out.Phones)
+	out, err = uc.RemovePhone(ctx, in.ID, p1.Number)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.Phone{p3}, out.Phones)
+
+	got, err := uc.Get(ctx, in.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.Phone{p3}, got.Phones)
+
+	_, err = uc.RemovePhone(ctx, entity.NewID(), p1.Number)
+	require.ErrorIs(t, err, internal.ErrEntityNotFound)
+}
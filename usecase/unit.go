@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/schedule"
+)
+
+// UnitManager keeps the units of properties, every property gets one unit
+// when it is added and a multi-unit building gets one for each home in it
+type (
+	UnitManager struct {
+		repo   UnitRepo
+		leases LeaseLister
+		clock  clockwork.Clock
+	}
+	UnitReader interface {
+		// GetUnit must fail with internal.ErrEntityNotFound when there is no unit with the id
+		GetUnit(ctx context.Context, id entity.ID) (*entity.Unit, error)
+		// ListUnits of the property ordered by number
+		ListUnits(ctx context.Context, propertyID entity.ID) ([]entity.Unit, error)
+	}
+	UnitWriter interface {
+		// StoreUnit must fail with internal.ErrConflict when another unit of
+		// the property has the same number
+		StoreUnit(context.Context, entity.Unit) error
+		DeleteUnit(ctx context.Context, id entity.ID) error
+	}
+	UnitRepo interface {
+		GetProperty(ctx context.Context, id string) (entity.Property, error)
+		UnitReader
+		UnitWriter
+	}
+)
+
+var (
+	ErrDuplicateUnit    = errors.New("another unit of the property has the number")
+	ErrRemoveLeasedUnit = errors.New("can not remove a unit which was leased")
+)
+
+func NewUnitManager(repo UnitRepo) UnitManager {
+	return UnitManager{
+		repo:  repo,
+		clock: clockwork.NewRealClock(),
+	}
+}
+
+// WithLeases to tell which units are occupied and refuse removing leased units
+func (uc UnitManager) WithLeases(l LeaseLister) UnitManager {
+	if l != nil {
+		uc.leases = l
+	}
+	return uc
+}
+
+// WithClock decides what today is for the occupancy of units
+func (uc UnitManager) WithClock(clock clockwork.Clock) UnitManager {
+	if clock != nil {
+		uc.clock = clock
+	}
+	return uc
+}
+
+// Store a unit of an existing property, the number must be unique within the
+// property, the rent target is in USD when it has no currency
+func (uc UnitManager) Store(ctx context.Context, u entity.Unit) (*entity.Unit, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	if !u.RentTarget.IsZero() && u.RentTarget.Currency == "" {
+		u.RentTarget.Currency = entity.CurrencyUSD
+	}
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := uc.repo.GetProperty(ctx, u.PropertyID); err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, internal.MakeErr(internal.ErrEntityNotFound, "property["+u.PropertyID+"]")
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	cur, err := uc.repo.GetUnit(ctx, u.ID)
+	switch {
+	case err == nil && cur.PropertyID != u.PropertyID:
+		// a unit can not be moved to another property
+		return nil, unitNotFound(u.PropertyID, u.ID)
+	case err != nil && !errors.Is(err, internal.ErrEntityNotFound):
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	units, err := uc.repo.ListUnits(ctx, u.PropertyID)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	for _, other := range units {
+		if other.ID != u.ID && other.Number == u.Number {
+			return nil, duplicateUnit(other)
+		}
+	}
+	if err := uc.repo.StoreUnit(ctx, u); err != nil {
+		if errors.Is(err, internal.ErrConflict) {
+			// another request stored a unit with the number after it was checked
+			return nil, internal.NewErrors(internal.ErrConflict, ErrDuplicateUnit)
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return uc.Get(ctx, u.PropertyID, u.ID)
+}
+
+// Get the unit of the property along with the lease occupying it today, a
+// unit of another property is not found
+func (uc UnitManager) Get(ctx context.Context, propertyID, id entity.ID) (*entity.Unit, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	u, err := uc.repo.GetUnit(ctx, id)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, unitNotFound(propertyID, id)
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	if u.PropertyID != propertyID {
+		return nil, unitNotFound(propertyID, id)
+	}
+	list, err := uc.occupy(ctx, propertyID, *u)
+	if err != nil {
+		return nil, err
+	}
+	return &list[0], nil
+}
+
+// List the units of the property ordered by number, each along with the
+// lease occupying it today
+func (uc UnitManager) List(ctx context.Context, propertyID entity.ID) ([]entity.Unit, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := uc.repo.GetProperty(ctx, propertyID); err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, internal.MakeErr(internal.ErrEntityNotFound, "property["+propertyID+"]")
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	units, err := uc.repo.ListUnits(ctx, propertyID)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return uc.occupy(ctx, propertyID, units...)
+}
+
+// Remove the unit of the property, a unit which was ever leased is kept along
+// with its leases and fails with internal.ErrConflict
+func (uc UnitManager) Remove(ctx context.Context, propertyID, id entity.ID) error {
+	if _, err := uc.Get(ctx, propertyID, id); err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			// already removed or never existed
+			return nil
+		}
+		return err
+	}
+	if uc.leases != nil {
+		leases, err := uc.leases.ListLeases(ctx, filters.NewLeaseFilter().WithPropertyID(propertyID).WithUnitID(id))
+		if err != nil {
+			// TODO: make sure the error is logged here or in the repo layer
+			return internal.NewErrors(internal.ErrInternal, ErrRepo)
+		}
+		if len(leases) > 0 {
+			return internal.NewErrors(internal.ErrConflict, fmt.Errorf("%w: lease[%s]", ErrRemoveLeasedUnit, leases[0].ID))
+		}
+	}
+	if err := uc.repo.DeleteUnit(ctx, id); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return nil
+}
+func (uc UnitManager) Validate() error {
+	if uc.repo == nil {
+		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
+	}
+	return nil
+}
+
+// occupy sets the lease of each unit of the property in term today, units
+// are left vacant without leases
+func (uc UnitManager) occupy(ctx context.Context, propertyID entity.ID, units ...entity.Unit) ([]entity.Unit, error) {
+	if uc.leases == nil {
+		return units, nil
+	}
+	leases, err := uc.leases.ListLeases(ctx, filters.NewLeaseFilter().WithPropertyID(propertyID))
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	today := schedule.NewDateFromTime(uc.clock.Now())
+	for i := range units {
+		units[i] = units[i].Occupy(today, leases...)
+	}
+	return units, nil
+}
+func unitNotFound(propertyID, id entity.ID) error {
+	return internal.MakeErr(internal.ErrEntityNotFound, "unit["+id+"] of property["+propertyID+"]")
+}
+func duplicateUnit(u entity.Unit) error {
+	return internal.NewErrors(internal.ErrConflict, fmt.Errorf("%w: unit[%s]", ErrDuplicateUnit, u.ID))
+}
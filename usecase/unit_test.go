@@ -0,0 +1,157 @@
+package usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/usecase"
+	"github.com/tempcke/schedule"
+)
+
+func TestUnitUC(t *testing.T) {
+	var (
+		repo     = repository.NewInMemoryRepo()
+		clock    = clockwork.NewFakeClockAt(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))
+		uc       = usecase.NewUnitManager(repo).WithLeases(repo).WithClock(clock)
+		leaseUC  = usecase.NewLeaseManager(repo).WithUnits(repo)
+		property = fake.Property()
+		tenant   = fake.Tenant()
+		mar1     = schedule.NewDate(2024, time.March, 1)
+		lease    = fake.Lease(property.ID, tenant.ID).WithTerm(mar1, mar1.AddDate(1, 0, -1))
+
+		// force repo to implement interface
+		_ usecase.UnitRepo = (*repository.InMemory)(nil)
+	)
+	require.NoError(t, usecase.NewPropertyManager(repo).Store(ctx, property))
+	require.NoError(t, repo.StoreTenant(ctx, tenant))
+
+	units, err := uc.List(ctx, property.ID)
+	require.NoError(t, err)
+	require.Len(t, units, 1, "the property was given its default unit")
+	first := units[0]
+	assert.True(t, entity.DefaultUnit(property).WithID(first.ID).Equal(first))
+
+	// the only unit of the property is leased when the lease has no unit
+	leased, err := leaseUC.Store(ctx, lease)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, leased.UnitID)
+	got, err := uc.Get(ctx, property.ID, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, lease.ID, got.LeaseID)
+
+	// the rent target is in USD unless it says otherwise
+	in := entity.NewUnit(property.ID, "B").WithRooms(1, 1).WithRentTarget(entity.Money{Minor: 95000})
+	unitB, err := uc.Store(ctx, in)
+	require.NoError(t, err)
+	assert.Equal(t, entity.CurrencyUSD, unitB.RentTarget.Currency)
+	assert.False(t, unitB.Occupied())
+
+	// with a second unit the lease must say which unit it is for
+	_, err = leaseUC.Store(ctx, fake.Lease(property.ID, tenant.ID).WithTerm(mar1, mar1.AddDate(1, 0, -1)))
+	require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	leaseB := fake.Lease(property.ID, tenant.ID).WithUnit(unitB.ID).WithTerm(mar1, mar1.AddDate(1, 0, -1))
+	_, err = leaseUC.Store(ctx, leaseB)
+	require.NoError(t, err, "another unit is leased on its own")
+	_, err = leaseUC.Store(ctx, fake.Lease(property.ID, tenant.ID).WithUnit(unitB.ID).WithTerm(mar1.AddDate(0, 6, 0), schedule.Date{}))
+	require.ErrorIs(t, err, usecase.ErrPropertyLeased)
+
+	units, err = uc.List(ctx, property.ID)
+	require.NoError(t, err)
+	require.Len(t, units, 2)
+	assert.Equal(t, []entity.ID{first.ID, unitB.ID}, []entity.ID{units[0].ID, units[1].ID}, "ordered by number")
+	assert.Equal(t, lease.ID, units[0].LeaseID)
+	assert.Equal(t, leaseB.ID, units[1].LeaseID)
+
+	// occupancy follows the clock
+	clock.Advance(366 * 24 * time.Hour)
+	got, err = uc.Get(ctx, property.ID, unitB.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Occupied(), "the lease ended")
+
+	t.Run("duplicate number", func(t *testing.T) {
+		_, err := uc.Store(ctx, entity.NewUnit(property.ID, "B"))
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, usecase.ErrDuplicateUnit)
+	})
+	t.Run("unit of another property", func(t *testing.T) {
+		other := fake.Property()
+		require.NoError(t, repo.StoreProperty(ctx, other))
+		_, err := uc.Get(ctx, other.ID, unitB.ID)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+		_, err = uc.Store(ctx, unitB.WithRooms(2, 1))
+		require.NoError(t, err)
+		moved := *unitB
+		moved.PropertyID = other.ID
+		_, err = uc.Store(ctx, moved)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound, "a unit can not be moved")
+		_, err = leaseUC.Store(ctx, fake.Lease(other.ID, tenant.ID).WithUnit(unitB.ID))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("property not found", func(t *testing.T) {
+		_, err := uc.Store(ctx, fake.Unit(entity.NewID()))
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+		_, err = uc.List(ctx, entity.NewID())
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("invalid unit", func(t *testing.T) {
+		_, err := uc.Store(ctx, fake.Unit(property.ID).WithSqFt(-1))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+	})
+	t.Run("remove", func(t *testing.T) {
+		err := uc.Remove(ctx, property.ID, unitB.ID)
+		require.ErrorIs(t, err, internal.ErrConflict)
+		require.ErrorIs(t, err, usecase.ErrRemoveLeasedUnit)
+
+		vacant, err := uc.Store(ctx, fake.Unit(property.ID))
+		require.NoError(t, err)
+		require.NoError(t, uc.Remove(ctx, property.ID, vacant.ID))
+		_, err = uc.Get(ctx, property.ID, vacant.ID)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+		require.NoError(t, uc.Remove(ctx, property.ID, vacant.ID), "already removed")
+	})
+	t.Run("leases by unit", func(t *testing.T) {
+		list, err := leaseUC.List(ctx, filters.NewLeaseFilter().WithUnitID(unitB.ID))
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, leaseB.ID, list[0].ID)
+	})
+}
+func TestUnitUC_fail(t *testing.T) {
+	var (
+		property = fake.Property()
+		unit     = entity.DefaultUnit(property)
+	)
+	t.Run("uc without a repo", func(t *testing.T) {
+		var (
+			repo usecase.UnitRepo
+			uc   = usecase.NewUnitManager(repo)
+		)
+		_, err := uc.Get(ctx, property.ID, unit.ID)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		var (
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(unit.ID, repoErr)
+			uc      = usecase.NewUnitManager(repo)
+		)
+		require.NoError(t, repo.StoreProperty(ctx, property))
+		_, err := uc.Get(ctx, property.ID, unit.ID)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+		_, err = uc.Store(ctx, unit)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+	})
+}
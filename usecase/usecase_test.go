@@ -2,6 +2,33 @@ package usecase_test
 
 import (
 	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/usecase"
 )
 
 var ctx = context.Background()
+
+// storeParties stores a property and tenants with the ids of the lease
+// unless they are already stored, a lease is only stored with them
+func storeParties(t testing.TB, repo interface {
+	usecase.PropertyRepo
+	usecase.TenantRepo
+}, lease entity.Lease) {
+	t.Helper()
+	if _, err := repo.GetProperty(ctx, lease.PropertyID); err != nil {
+		p := fake.Property()
+		p.ID = lease.PropertyID
+		require.NoError(t, repo.StoreProperty(ctx, p))
+	}
+	for _, id := range lease.TenantIDs {
+		if _, err := repo.GetTenant(ctx, id); err != nil {
+			tenant := fake.Tenant()
+			tenant.ID = id
+			require.NoError(t, repo.StoreTenant(ctx, tenant))
+		}
+	}
+}
@@ -0,0 +1,351 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/webhook"
+)
+
+// WebhookManager posts events to the webhooks subscribed to them, Enqueue
+// records a pending delivery for each webhook and Deliver sends the ones which
+// are due, a delivery is retried with exponential backoff until it is
+// delivered or it runs out of attempts and is dead
+type WebhookManager struct {
+	repo   WebhookRepo
+	sender WebhookSender
+	clock  clockwork.Clock
+	retry  RetryPolicy
+}
+type WebhookRepo interface {
+	StoreWebhook(context.Context, entity.Webhook) error
+	// GetWebhook must fail with internal.ErrEntityNotFound when there is no such webhook
+	GetWebhook(ctx context.Context, id entity.ID) (*entity.Webhook, error)
+	ListWebhooks(context.Context) ([]entity.Webhook, error)
+	// DeleteWebhook along with its deliveries
+	DeleteWebhook(ctx context.Context, id entity.ID) error
+	StoreWebhookDelivery(context.Context, entity.WebhookDelivery) error
+	// GetWebhookDelivery must fail with internal.ErrEntityNotFound when there is no such delivery
+	GetWebhookDelivery(ctx context.Context, id entity.ID) (*entity.WebhookDelivery, error)
+	ListWebhookDeliveries(context.Context, ...filters.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error)
+	// ClaimWebhookDeliveries claims up to limit pending deliveries due at now,
+	// they are not due again until lockedUntil so no one else claims them meanwhile
+	ClaimWebhookDeliveries(ctx context.Context, now, lockedUntil time.Time, limit int) ([]entity.WebhookDelivery, error)
+}
+
+// WebhookSender posts a delivery to the webhook, statusCode is zero when the
+// receiver did not answer
+type WebhookSender interface {
+	Send(context.Context, entity.Webhook, entity.WebhookDelivery) (statusCode int, err error)
+}
+
+// WebhookPayload is the body posted to a webhook, ID is the same for every
+// delivery of the event so the receiver can ignore the ones it already has
+type WebhookPayload struct {
+	ID        string      `json:"id"`
+	Event     string      `json:"event"`
+	CreatedAt time.Time   `json:"createdAt"`
+	Data      event.Event `json:"data"`
+}
+
+var ErrWebhookRemoved = errors.New("webhook removed")
+
+const (
+	deliveryBatch = 100
+	deliveryLock  = time.Minute // how long a claimed delivery may take to be sent
+)
+
+func NewWebhookManager(repo WebhookRepo) WebhookManager {
+	return WebhookManager{
+		repo:   repo,
+		sender: webhook.NewClient(nil),
+		clock:  clockwork.NewRealClock(),
+		retry:  DefaultRetryPolicy,
+	}
+}
+func (uc WebhookManager) WithSender(s WebhookSender) WebhookManager {
+	if s != nil {
+		uc.sender = s
+	}
+	return uc
+}
+func (uc WebhookManager) WithClock(clock clockwork.Clock) WebhookManager {
+	if clock != nil {
+		uc.clock = clock
+	}
+	return uc
+}
+func (uc WebhookManager) WithRetryPolicy(p RetryPolicy) WebhookManager {
+	if p.MaxAttempts > 0 && p.Backoff > 0 {
+		uc.retry = p
+	}
+	return uc
+}
+
+// Store the webhook replacing the one with the same ID, without a secret a
+// replaced webhook keeps its own so receivers still verify what is posted to
+// them, a new webhook gets one made up. Giving a secret rotates it. The secret
+// is only returned when the webhook is added, it can not be read back
+func (uc WebhookManager) Store(ctx context.Context, w entity.Webhook) (*entity.Webhook, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	w.CreatedAt = uc.clock.Now()
+	cur, err := uc.get(ctx, w.ID)
+	switch {
+	case err == nil:
+		w.CreatedAt = cur.CreatedAt
+		if w.Secret == "" {
+			w.Secret = cur.Secret
+		}
+	case !errors.Is(err, internal.ErrEntityNotFound):
+		return nil, err
+	}
+	if w.Secret == "" {
+		w.Secret = newWebhookSecret()
+	}
+	if err := validWebhook(w); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.StoreWebhook(ctx, w); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	if cur != nil {
+		w.Secret = ""
+	}
+	return &w, nil
+}
+
+// Get the webhook without its secret
+func (uc WebhookManager) Get(ctx context.Context, id entity.ID) (*entity.Webhook, error) {
+	w, err := uc.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	w.Secret = ""
+	return w, nil
+}
+
+// get the webhook along with the secret deliveries are signed with
+func (uc WebhookManager) get(ctx context.Context, id entity.ID) (*entity.Webhook, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	w, err := uc.repo.GetWebhook(ctx, id)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return w, nil
+}
+
+// List every webhook without its secret, oldest first
+func (uc WebhookManager) List(ctx context.Context) ([]entity.Webhook, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	list, err := uc.repo.ListWebhooks(ctx)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	for i := range list {
+		list[i].Secret = ""
+	}
+	return list, nil
+}
+
+// Remove the webhook along with its deliveries, the ones still pending are not sent
+func (uc WebhookManager) Remove(ctx context.Context, id entity.ID) error {
+	if err := uc.Validate(); err != nil {
+		return err
+	}
+	if err := uc.repo.DeleteWebhook(ctx, id); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return nil
+}
+
+// Enqueue a delivery of the event to every webhook subscribed to it, it is an
+// event.Handler so it can be subscribed to the events relayed from the outbox
+func (uc WebhookManager) Enqueue(ctx context.Context, e event.Event) error {
+	if err := uc.Validate(); err != nil {
+		return err
+	}
+	webhooks, err := uc.List(ctx)
+	if err != nil {
+		return err
+	}
+	var (
+		now     = uc.clock.Now()
+		payload []byte
+	)
+	for _, w := range webhooks {
+		if !w.Subscribed(e.Name()) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = newWebhookPayload(ctx, e, now); err != nil {
+				return err
+			}
+		}
+		d := entity.NewWebhookDelivery(w.ID, e.Name(), payload, now)
+		if err := uc.repo.StoreWebhookDelivery(ctx, d); err != nil {
+			// TODO: make sure the error is logged here or in the repo layer
+			return internal.NewErrors(internal.ErrInternal, ErrRepo)
+		}
+	}
+	return nil
+}
+
+// Deliver the pending deliveries which are due, it returns how many were delivered
+func (uc WebhookManager) Deliver(ctx context.Context) (int, error) {
+	if err := uc.Validate(); err != nil {
+		return 0, err
+	}
+	now := uc.clock.Now()
+	list, err := uc.repo.ClaimWebhookDeliveries(ctx, now, now.Add(deliveryLock), deliveryBatch)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return 0, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	var delivered int
+	for _, d := range list {
+		sent, err := uc.send(ctx, d)
+		if err != nil {
+			return delivered, err
+		}
+		if sent.Status == entity.DeliveryDelivered {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+// Redeliver the payload of a delivery right away as a new delivery, which is
+// retried like any other when it fails
+func (uc WebhookManager) Redeliver(ctx context.Context, deliveryID entity.ID) (*entity.WebhookDelivery, error) {
+	d, err := uc.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	again, err := uc.send(ctx, d.Redeliver(uc.clock.Now()))
+	if err != nil {
+		return nil, err
+	}
+	return &again, nil
+}
+func (uc WebhookManager) GetDelivery(ctx context.Context, id entity.ID) (*entity.WebhookDelivery, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	d, err := uc.repo.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		if errors.Is(err, internal.ErrEntityNotFound) {
+			return nil, err
+		}
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return d, nil
+}
+
+// ListDeliveries matching the filter, most recent first
+func (uc WebhookManager) ListDeliveries(ctx context.Context, filter ...filters.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	if err := uc.Validate(); err != nil {
+		return nil, err
+	}
+	f := filters.MergeWebhookDeliveryFilters(filter...)
+	if err := f.Paging().Validate(filters.CreatedSorts...); err != nil {
+		return nil, err
+	}
+	list, err := uc.repo.ListWebhookDeliveries(ctx, f)
+	if err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return nil, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return list, nil
+}
+func (uc WebhookManager) Validate() error {
+	if uc.repo == nil {
+		return internal.NewErrors(internal.ErrInternal, ErrRepoNotSet)
+	}
+	return nil
+}
+
+// send the delivery and store the outcome, a delivery to a webhook which was
+// removed meanwhile is dead
+func (uc WebhookManager) send(ctx context.Context, d entity.WebhookDelivery) (entity.WebhookDelivery, error) {
+	w, err := uc.get(ctx, d.WebhookID)
+	switch {
+	case errors.Is(err, internal.ErrEntityNotFound):
+		d = d.Failed(0, ErrWebhookRemoved, time.Time{})
+	case err != nil:
+		return d, err
+	default:
+		code, err := uc.sender.Send(ctx, *w, d)
+		if err != nil {
+			d = d.Failed(code, err, uc.retry.Next(uc.clock.Now(), d.Attempts+1))
+		} else {
+			d = d.Delivered(code, uc.clock.Now())
+		}
+	}
+	if err := uc.repo.StoreWebhookDelivery(ctx, d); err != nil {
+		// TODO: make sure the error is logged here or in the repo layer
+		return d, internal.NewErrors(internal.ErrInternal, ErrRepo)
+	}
+	return d, nil
+}
+
+// validWebhook is the webhook valid and subscribed to events which exist
+func validWebhook(w entity.Webhook) error {
+	var errs []error
+	for _, fe := range internal.FieldErrors(w.Validate()) {
+		errs = append(errs, fe)
+	}
+	for i, name := range w.Events {
+		if name != "" && !event.Known(name) {
+			errs = append(errs, internal.NewFieldError(fmt.Sprintf("events[%d]", i), "unknown event "+name))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return internal.NewErrors(internal.ErrEntityInvalid).Append(errs...)
+}
+
+// newWebhookPayload of the event, the ID of the outbox message the event was
+// relayed from identifies it when there is one
+func newWebhookPayload(ctx context.Context, e event.Event, now time.Time) ([]byte, error) {
+	id := event.MessageID(ctx)
+	if id == "" {
+		id = uuid.NewString()
+	}
+	return json.Marshal(WebhookPayload{
+		ID:        id,
+		Event:     e.Name(),
+		CreatedAt: now,
+		Data:      e,
+	})
+}
+func newWebhookSecret() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
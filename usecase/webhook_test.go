@@ -0,0 +1,212 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tempcke/rpm/entity"
+	"github.com/tempcke/rpm/entity/fake"
+	"github.com/tempcke/rpm/internal"
+	"github.com/tempcke/rpm/internal/event"
+	"github.com/tempcke/rpm/internal/filters"
+	"github.com/tempcke/rpm/internal/repository"
+	"github.com/tempcke/rpm/internal/webhook"
+	"github.com/tempcke/rpm/usecase"
+)
+
+func TestWebhookUC(t *testing.T) {
+	var (
+		repo     = repository.NewInMemoryRepo()
+		clock    = clockwork.NewFakeClockAt(time.Now())
+		retry    = usecase.RetryPolicy{MaxAttempts: 3, Backoff: time.Second, MaxBackoff: time.Minute}
+		receiver = newReceiver(t)
+		uc       = usecase.NewWebhookManager(repo).WithClock(clock).WithRetryPolicy(retry)
+		outbox   = usecase.NewOutboxManager(repo).WithClock(clock)
+		bus      = event.NewDispatcher()
+		p        = fake.Property()
+
+		// force repo to implement interface
+		_ usecase.WebhookRepo = (*repository.InMemory)(nil)
+	)
+	bus.Subscribe(uc.Enqueue)
+
+	w, err := uc.Store(ctx, entity.NewWebhook(receiver.URL, "", event.NameRentalAdded))
+	require.NoError(t, err)
+	assert.NotEmpty(t, w.Secret, "a secret is made up when none is given")
+	secret := w.Secret
+	got, err := uc.Get(ctx, w.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Secret, "the secret can not be read back")
+
+	// replacing the webhook without a secret keeps it, giving one rotates it
+	replaced, err := uc.Store(ctx, entity.NewWebhook(receiver.URL, "", event.NameRentalAdded).WithID(w.ID))
+	require.NoError(t, err)
+	assert.Empty(t, replaced.Secret, "the secret is only returned when the webhook is added")
+	stored, err := repo.GetWebhook(ctx, w.ID)
+	require.NoError(t, err)
+	assert.Equal(t, secret, stored.Secret)
+	secret = fake.Webhook().Secret
+	_, err = uc.Store(ctx, entity.NewWebhook(receiver.URL, secret, event.NameRentalAdded).WithID(w.ID))
+	require.NoError(t, err)
+	stored, err = repo.GetWebhook(ctx, w.ID)
+	require.NoError(t, err)
+	assert.Equal(t, secret, stored.Secret)
+	other, err := uc.Store(ctx, fake.Webhook(event.NameTenantAdded))
+	require.NoError(t, err)
+
+	// the event is relayed from the outbox to the webhooks subscribed to it
+	require.NoError(t, usecase.NewPropertyManager(repo).Store(ctx, p))
+	clock.Advance(time.Second)
+	_, err = outbox.Relay(ctx, bus)
+	require.NoError(t, err)
+	list, err := uc.ListDeliveries(ctx, filters.NewWebhookDeliveryFilter().WithWebhookID(w.ID))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	pending := list[0]
+	assert.Equal(t, entity.DeliveryPending, pending.Status)
+	assert.Equal(t, event.NameRentalAdded, pending.Event)
+	list, err = uc.ListDeliveries(ctx, filters.NewWebhookDeliveryFilter().WithWebhookID(other.ID))
+	require.NoError(t, err)
+	assert.Empty(t, list, "not subscribed to the event")
+
+	// retried with exponential backoff until out of attempts
+	receiver.status(http.StatusServiceUnavailable)
+	for i, wait := range []time.Duration{0, time.Second, 2 * time.Second} {
+		clock.Advance(wait)
+		n, err := uc.Deliver(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		d, err := uc.GetDelivery(ctx, pending.ID)
+		require.NoError(t, err)
+		assert.Equal(t, i+1, d.Attempts)
+		assert.Equal(t, http.StatusServiceUnavailable, d.StatusCode)
+		assert.NotEmpty(t, d.LastError)
+	}
+	dead, err := uc.GetDelivery(ctx, pending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.DeliveryDead, dead.Status)
+	require.Len(t, receiver.requests(), 3)
+
+	// redelivered as a new delivery once the receiver is back
+	receiver.status(http.StatusOK)
+	again, err := uc.Redeliver(ctx, pending.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, pending.ID, again.ID)
+	assert.Equal(t, pending.ID, again.RedeliveryOf)
+	assert.Equal(t, entity.DeliveryDelivered, again.Status)
+	assert.Equal(t, http.StatusOK, again.StatusCode)
+	assert.Equal(t, clock.Now(), again.DeliveredAt)
+
+	reqs := receiver.requests()
+	require.Len(t, reqs, 4)
+	last := reqs[3]
+	assert.Equal(t, again.ID, last.header.Get(webhook.HeaderDelivery))
+	assert.Equal(t, event.NameRentalAdded, last.header.Get(webhook.HeaderEvent))
+	assert.True(t, webhook.Verify(secret, last.body, last.header.Get(webhook.HeaderSignature)))
+	assert.Equal(t, reqs[0].body, last.body, "the same payload is redelivered")
+
+	var payload struct {
+		ID    string
+		Event string
+		Data  event.RentalAdded
+	}
+	require.NoError(t, json.Unmarshal(last.body, &payload))
+	assert.Equal(t, event.NameRentalAdded, payload.Event)
+	assert.Equal(t, p.ID, payload.Data.PropertyID)
+	added, err := outbox.List(ctx, filters.NewOutboxFilter().WithName(event.NameRentalAdded))
+	require.NoError(t, err)
+	var ids []string
+	for _, m := range added {
+		ids = append(ids, m.ID)
+	}
+	assert.Contains(t, ids, payload.ID, "identified by the outbox message it was relayed from")
+
+	t.Run("webhook removed", func(t *testing.T) {
+		require.NoError(t, uc.Remove(ctx, w.ID))
+		_, err := uc.Get(ctx, w.ID)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+		_, err = uc.Redeliver(ctx, pending.ID)
+		require.ErrorIs(t, err, internal.ErrEntityNotFound)
+	})
+	t.Run("invalid webhook", func(t *testing.T) {
+		_, err := uc.Store(ctx, entity.NewWebhook("not a url", "", "lease.signed"))
+		require.ErrorIs(t, err, internal.ErrEntityInvalid)
+		var fields []string
+		for _, fe := range internal.FieldErrors(err) {
+			fields = append(fields, fe.Field)
+		}
+		assert.Equal(t, []string{"url", "events[0]"}, fields)
+	})
+}
+
+func TestWebhookUC_fail(t *testing.T) {
+	t.Run("uc without a repo", func(t *testing.T) {
+		uc := usecase.NewWebhookManager(nil)
+		_, err := uc.Store(ctx, fake.Webhook())
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+		_, err = uc.Deliver(ctx)
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+		err = uc.Enqueue(ctx, event.RentalAdded{PropertyID: uuid.NewString()})
+		require.ErrorIs(t, err, usecase.ErrRepoNotSet)
+	})
+	t.Run("repo error", func(t *testing.T) {
+		var (
+			repoErr = errors.New(t.Name() + "_" + uuid.NewString())
+			repo    = repository.NewInMemoryRepo().WithEntityErr(uuid.NewString(), repoErr)
+			uc      = usecase.NewWebhookManager(repo)
+		)
+		_, err := uc.List(ctx)
+		require.ErrorIs(t, err, internal.ErrInternal)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+		err = uc.Enqueue(ctx, event.RentalAdded{PropertyID: uuid.NewString()})
+		require.ErrorIs(t, err, usecase.ErrRepo)
+		_, err = uc.Deliver(ctx)
+		require.ErrorIs(t, err, usecase.ErrRepo)
+	})
+}
+
+// receiver is a webhook endpoint recording every request it gets
+type receiver struct {
+	*httptest.Server
+	mu   *sync.Mutex
+	code *int
+	reqs *[]receivedReq
+}
+type receivedReq struct {
+	header http.Header
+	body   []byte
+}
+
+func newReceiver(t *testing.T) receiver {
+	r := receiver{mu: &sync.Mutex{}, code: new(int), reqs: &[]receivedReq{}}
+	*r.code = http.StatusOK
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		*r.reqs = append(*r.reqs, receivedReq{header: req.Header, body: body})
+		w.WriteHeader(*r.code)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+func (r receiver) status(code int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	*r.code = code
+}
+func (r receiver) requests() []receivedReq {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedReq{}, *r.reqs...)
+}